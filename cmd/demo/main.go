@@ -194,10 +194,10 @@ func runFullDemo(ctx context.Context, db *mongo.Database) {
 	transactionRepo := mongodb.NewTransactionRepository(db)
 
 	// Initialize use cases
-	accountUC := usecase.NewAccountUseCase(accountRepo)
-	creditCardUC := usecase.NewCreditCardUseCase(creditCardRepo, accountRepo)
+	accountUC := usecase.NewAccountUseCase(accountRepo, transactionRepo)
+	creditCardUC := usecase.NewCreditCardUseCase(creditCardRepo, accountRepo, mongodb.NewCreditCardInvoiceRepository(db))
 	personUC := usecase.NewPersonUseCase(personRepo)
-	billUC := usecase.NewBillUseCase(billRepo)
+	billUC := usecase.NewBillUseCase(billRepo, transactionRepo)
 	transactionUC := usecase.NewTransactionUseCase(transactionRepo, accountRepo, creditCardRepo, billRepo)
 
 	// Demo operations
@@ -211,7 +211,7 @@ func runFullDemo(ctx context.Context, db *mongo.Database) {
 	fmt.Printf("✅ Created account: %s (Balance: %s)\n", account.Name, account.Balance.String())
 
 	fmt.Println("💳 Creating credit card...")
-	card, err := creditCardUC.CreateCreditCard(ctx, account.ID, "Demo Card", "5678", 2000.0, "BRL", 15)
+	card, err := creditCardUC.CreateCreditCard(ctx, account.ID, "Demo Card", "5678", 2000.0, "BRL", 15, entity.DefaultMinimumPaymentPercentage)
 	if err != nil {
 		fmt.Printf("❌ Error creating credit card: %v\n", err)
 		return
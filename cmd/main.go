@@ -2,59 +2,946 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"financli/internal/application/usecase"
+	"financli/internal/domain/repository"
 	"financli/internal/infrastructure/config"
+	"financli/internal/infrastructure/desktop"
+	"financli/internal/infrastructure/email"
+	"financli/internal/infrastructure/imap"
+	"financli/internal/infrastructure/keychain"
+	"financli/internal/infrastructure/ocr"
+	"financli/internal/infrastructure/pdf"
+	"financli/internal/infrastructure/persistence/bolt"
 	"financli/internal/infrastructure/persistence/mongodb"
+	"financli/internal/infrastructure/persistence/sqlite"
+	"financli/internal/infrastructure/webhook"
+	"financli/internal/interfaces/server"
 	"financli/internal/interfaces/tui"
+	"financli/internal/interfaces/tui/screen"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/term"
 )
 
+// passcodeAccount is the keychain entry holding the passcode's hash
+const passcodeAccount = "passcode-hash"
+
 func main() {
+	start := time.Now()
 	ctx := context.Background()
 
+	// "--profile-startup" launches the TUI, quits once the dashboard and the
+	// catch-up jobs are done and reports how long each step took
+	var profile *screen.StartupProfile
+	if len(os.Args) > 1 && os.Args[1] == "--profile-startup" {
+		profile = screen.NewStartupProfile(start)
+	}
+
+	doneConfig := profile.Measure("config")
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatal("Failed to load config:", err)
 	}
+	doneConfig()
 
-	db, err := mongodb.NewConnection(mongodb.Config{
-		URI:      cfg.MongoDB.URI,
-		Database: cfg.MongoDB.Database,
-	})
+	// "passcode [--keychain]" hashes a new passcode for the lock, printing the
+	// hash for FINANCLI_PASSCODE_HASH or storing it in the OS keychain
+	if len(os.Args) > 1 && os.Args[1] == "passcode" {
+		if err := runPasscodeCommand(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// The TUI connects lazily so it can draw before a slow network answers;
+	// the subcommands need the storage right away anyway
+	lazy := len(os.Args) == 1 || profile != nil
+	doneStorage := profile.Measure("storage: open")
+	repos, err := openRepositories(cfg, lazy)
 	if err != nil {
-		log.Fatal("Failed to connect to MongoDB:", err)
+		log.Fatal("Failed to open storage:", err)
 	}
+	doneStorage()
 
-	// Initialize repositories
-	accountRepo := mongodb.NewAccountRepository(db)
-	creditCardRepo := mongodb.NewCreditCardRepository(db)
-	creditCardInvoiceRepo := mongodb.NewCreditCardInvoiceRepository(db)
-	personRepo := mongodb.NewPersonRepository(db)
-	billRepo := mongodb.NewBillRepository(db)
-	transactionRepo := mongodb.NewTransactionRepository(db)
+	// "--json", anywhere after the subcommand, prints its result as JSON
+	args, jsonOutput := splitJSONFlag(os.Args[1:])
 
-	// Initialize use cases
-	useCases := tui.UseCases{
-		Account:           usecase.NewAccountUseCase(accountRepo),
-		CreditCard:        usecase.NewCreditCardUseCase(creditCardRepo, accountRepo),
-		CreditCardInvoice: usecase.NewCreditCardInvoiceUseCase(creditCardInvoiceRepo, creditCardRepo),
-		Bill:              usecase.NewBillUseCase(billRepo),
-		Transaction:       usecase.NewTransactionUseCaseWithInvoice(transactionRepo, accountRepo, creditCardRepo, creditCardInvoiceRepo, billRepo),
-		Person:            usecase.NewPersonUseCase(personRepo),
-		Report:            usecase.NewReportUseCase(transactionRepo, personRepo, billRepo),
+	// "export [dir]" and "import <dir>" move the whole dataset in and out as CSV
+	// files, without starting the TUI or running the startup jobs
+	if len(args) > 0 && (args[0] == "export" || args[0] == "import") {
+		periodLocks := usecase.NewPeriodLockUseCase(repos.accountingPeriod)
+		datasetExchange := usecase.NewDatasetExchangeUseCase(repos.account, repos.creditCard, repos.creditCardInvoice, repos.bill, repos.person,
+			usecase.LockClosedPeriods(repos.transaction, periodLocks), cfg.Export.Dir)
+		datasetExchange.SetArchive(repos.transactionArchive)
+		datasetExchange.SetPeriodLocks(periodLocks)
+		datasetExchange.SetPlanning(usecase.DatasetPlanning{
+			Categories:         repos.category,
+			CategoryRules:      repos.categoryRule,
+			Budgets:            repos.budget,
+			StandingOrders:     repos.standingOrder,
+			SinkingFunds:       repos.sinkingFund,
+			EmergencyFund:      repos.emergencyFund,
+			Goals:              repos.goal,
+			Holdings:           repos.holding,
+			Wishlist:           repos.wishlist,
+			ScheduledTransfers: repos.scheduledTransfer,
+			Periods:            repos.accountingPeriod,
+		})
+		if err := runDatasetCommand(ctx, datasetExchange, args[0], args[1:], jsonOutput); err != nil {
+			log.Fatal(err)
+		}
+		return
 	}
 
+	// "journal [beancount|ledger] [file]" writes a plaintext accounting journal
+	if len(args) > 0 && args[0] == "journal" {
+		journalExport := usecase.NewJournalExportUseCase(repos.account, repos.creditCard, repos.person, repos.transaction, cfg.Export.Dir)
+		if err := runJournalCommand(ctx, journalExport, args[1:], jsonOutput); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// "report <template> [YYYY-MM]" renders a user-written report template, and
+	// "report --json [YYYY-MM]" prints the data templates render
+	if len(args) > 0 && args[0] == "report" {
+		reportTemplates := usecase.NewReportTemplateUseCase(repos.account, repos.creditCard, repos.person, repos.transaction, cfg.Reports.TemplatesDir)
+		if cfg.Reports.IncludeArchive {
+			reportTemplates.SetIncludeArchive(repos.transactionArchive)
+		}
+		if err := runReportCommand(ctx, reportTemplates, args[1:], jsonOutput); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// "serve [addr]" accepts the bank notifications forwarded from the phone
+	// and queues their purchases in the review inbox
+	if len(args) > 0 && args[0] == "serve" {
+		useCases, _ := wireUseCases(cfg, repos)
+		cardAlerts := usecase.NewCardAlertUseCase(useCases.Inbox, repos.creditCard)
+		if err := runServeCommand(cardAlerts, cfg.Server, args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// "notify" prints the bills and invoices coming due and shows them on the
+	// desktop once a day, to run from cron
+	if len(args) > 0 && args[0] == "notify" {
+		useCases, _ := wireUseCases(cfg, repos)
+		if err := runNotifyCommand(ctx, useCases.DueReminder, jsonOutput); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	passcodeHash, err := loadPasscodeHash(cfg.Security)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	useCases, startupJobs := wireUseCases(cfg, repos)
+
 	// Initialize and run TUI
 	app := tui.NewApp(ctx, useCases)
+	app.SetRefreshInterval(time.Duration(cfg.Refresh.IntervalSeconds) * time.Second)
+	app.SetItemsPerPage(cfg.List.ItemsPerPage)
+	app.SetFormDrafts(cfg.Forms.Drafts)
+	app.SetPasscodeLock(passcodeHash, time.Duration(cfg.Security.AutoLockMinutes)*time.Minute)
+	app.SetStartupProfile(profile)
+	app.SetBusinessMode(cfg.Business.Enabled)
+	if len(cfg.Workspace.Names) > 1 {
+		app.SetWorkspaces(cfg.Workspace.Names, cfg.Workspace.Current, workspaceOpener(cfg, useCases))
+	}
+	// Setting up the program asks the terminal for its colors
+	doneTerminal := profile.Measure("terminal setup")
 	p := tea.NewProgram(app, tea.WithAltScreen())
+	doneTerminal()
+
+	go func() {
+		p.Send(tui.StartupJobsDoneMsg{Warnings: runStartupJobs(ctx, startupJobs, profile)})
+	}()
 
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error running program: %v", err)
 		os.Exit(1)
 	}
+	if err := app.FlushDrafts(); err != nil {
+		fmt.Printf("Warning: failed to save the form drafts: %v\n", err)
+	}
+
+	if profile != nil {
+		fmt.Print(profile.Report())
+	}
+}
+
+// wireUseCases builds the use cases the TUI runs on over repos, along with the
+// catch-up jobs to run behind it
+func wireUseCases(cfg *config.Config, repos *repositories) (tui.UseCases, []startupJob) {
+	accountRepo := repos.account
+	creditCardRepo := repos.creditCard
+	creditCardInvoiceRepo := repos.creditCardInvoice
+	personRepo := repos.person
+	billRepo := repos.bill
+	// No transaction write reaches a month whose books are closed, and reports
+	// are cached until a transaction changes, so every write goes through both
+	periodLockUseCase := usecase.NewPeriodLockUseCase(repos.accountingPeriod)
+	transactionChanges := usecase.NewChangeTracker()
+	transactionRepo := usecase.TrackTransactionChanges(usecase.LockClosedPeriods(repos.transaction, periodLockUseCase), transactionChanges)
+	importSessionRepo := repos.importSession
+	pendingPaymentRepo := repos.pendingPayment
+	sinkingFundRepo := repos.sinkingFund
+	wishlistRepo := repos.wishlist
+	subscriptionPriceRepo := repos.subscriptionPrice
+	changeRecordRepo := repos.changeRecord
+	inboxRepo := repos.inbox
+	filterPresetRepo := repos.filterPreset
+	categoryAppearanceRepo := repos.categoryAppearance
+	macroRepo := repos.macro
+	transactionArchiveRepo := repos.transactionArchive
+	notificationRepo := repos.notification
+	budgetRepo := repos.budget
+	standingOrderRepo := repos.standingOrder
+	emergencyFundRepo := repos.emergencyFund
+	categoryClassifierRepo := repos.categoryClassifier
+	categoryRuleRepo := repos.categoryRule
+	goalRepo := repos.goal
+	scheduledTransferRepo := repos.scheduledTransfer
+	holdingRepo := repos.holding
+	reportSnapshotRepo := repos.reportSnapshot
+	categoryRepo := repos.category
+	formDraftRepo := repos.formDraft
+
+	// Initialize use cases
+	changeHistoryUseCase := usecase.NewChangeHistoryUseCase(changeRecordRepo, transactionRepo, billRepo)
+	transactionUseCase := usecase.NewTransactionUseCaseWithInvoice(transactionRepo, accountRepo, creditCardRepo, creditCardInvoiceRepo, billRepo)
+	transactionUseCase.SetChangeHistory(changeHistoryUseCase)
+	if repos.unitOfWork != nil {
+		transactionUseCase.SetUnitOfWork(repos.unitOfWork)
+	}
+	transactionUseCase.SetPeriodLocks(periodLockUseCase)
+	changeHistoryUseCase.SetPeriodLocks(periodLockUseCase)
+	categorySuggestionUseCase := usecase.NewCategorySuggestionUseCase(categoryClassifierRepo, transactionRepo)
+	transactionUseCase.SetCategorySuggestions(categorySuggestionUseCase)
+	billUseCase := usecase.NewBillUseCase(billRepo, transactionRepo)
+	billUseCase.SetChangeHistory(changeHistoryUseCase)
+	billUseCase.SetPeriodLocks(periodLockUseCase)
+	creditCardUseCase := usecase.NewCreditCardUseCase(creditCardRepo, accountRepo, creditCardInvoiceRepo)
+	creditCardInvoiceUseCase := usecase.NewCreditCardInvoiceUseCase(creditCardInvoiceRepo, creditCardRepo)
+	creditCardInvoiceUseCase.SetInvoiceSplits(transactionRepo)
+	periodLockUseCase.SetCardInvoices(creditCardRepo, creditCardInvoiceRepo)
+	reportUseCase := usecase.NewReportUseCase(transactionRepo, personRepo, billRepo)
+	reportUseCase.SetExcludeIgnored(cfg.Reports.ExcludeIgnored)
+	reportUseCase.SetChangeTracker(transactionChanges)
+	if cfg.Reports.IncludeArchive {
+		reportUseCase.SetIncludeArchive(transactionArchiveRepo)
+	}
+	reportSnapshotUseCase := usecase.NewReportSnapshotUseCase(reportSnapshotRepo, reportUseCase)
+	periodLockUseCase.SetReportSnapshots(reportSnapshotUseCase)
+
+	transactionArchiveUseCase := usecase.NewTransactionArchiveUseCase(transactionArchiveRepo)
+	yieldUseCase := usecase.NewYieldUseCase(accountRepo, transactionRepo, cfg.Yield.CDIAnnualRate)
+	yieldUseCase.SetPeriodLocks(periodLockUseCase)
+	accountFeeUseCase := usecase.NewAccountFeeUseCase(accountRepo, transactionRepo)
+	accountFeeUseCase.SetPeriodLocks(periodLockUseCase)
+	overdraftUseCase := usecase.NewOverdraftUseCase(accountRepo, transactionRepo)
+	overdraftUseCase.SetPeriodLocks(periodLockUseCase)
+	standingOrderUseCase := usecase.NewStandingOrderUseCase(standingOrderRepo, accountRepo, transactionRepo)
+	standingOrderUseCase.SetPeriodLocks(periodLockUseCase)
+	scheduledTransferUseCase := usecase.NewScheduledTransferUseCase(scheduledTransferRepo, accountRepo, transactionRepo)
+	scheduledTransferUseCase.SetPeriodLocks(periodLockUseCase)
+	subscriptionUseCase := usecase.NewSubscriptionUseCase(transactionRepo, subscriptionPriceRepo)
+	pendingPaymentUseCase := usecase.NewPendingPaymentUseCase(pendingPaymentRepo, accountRepo, creditCardRepo, creditCardInvoiceRepo, creditCardUseCase, creditCardInvoiceUseCase)
+	notificationUseCase := usecase.NewNotificationUseCase(notificationRepo)
+	transactionUseCase.SetNotifications(notificationUseCase)
+
+	var mailer usecase.Mailer
+	if cfg.SMTP.Host != "" {
+		mailer = email.NewSMTPMailer(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.From)
+	}
+	weeklyDigestUseCase := usecase.NewWeeklyDigestUseCase(transactionRepo, accountRepo, billRepo, notificationUseCase, cfg.Digest.Channels)
+	if mailer != nil {
+		weeklyDigestUseCase.SetMailer(mailer, cfg.Digest.Email)
+	}
+	if cfg.Digest.WebhookURL != "" {
+		weeklyDigestUseCase.SetWebhook(webhook.NewPoster(cfg.Digest.WebhookURL))
+	}
+
+	// The catch-up jobs run in order behind the TUI, so the dashboard shows
+	// without waiting on them, and reloads once they are done
+	startupJobs := []startupJob{
+		{name: "index check", warning: "failed to check storage indexes", run: repos.ensureIndexes},
+		{name: "transaction check", warning: "writes are not atomic", run: repos.checkUnitOfWork},
+		// Move the years past the configured window to the archive, keeping everyday queries small
+		{name: "archive", warning: "failed to archive old transactions", run: func(ctx context.Context) error {
+			_, err := transactionArchiveUseCase.ArchiveOlderThan(ctx, cfg.Archive.AfterYears, time.Now())
+			return err
+		}},
+		// Credit interest for any month that closed since the last run
+		{name: "account yield", warning: "failed to accrue account yield", run: func(ctx context.Context) error {
+			_, err := yieldUseCase.AccrueMonthlyYield(ctx, time.Now())
+			return err
+		}},
+		// Charge the bank fees that came due since the last run
+		{name: "account fees", warning: "failed to post account fees", run: func(ctx context.Context) error {
+			_, err := accountFeeUseCase.PostDueFees(ctx, time.Now())
+			return err
+		}},
+		// Charge the overdraft interest of the days that closed since the last run
+		{name: "overdraft interest", warning: "failed to accrue overdraft interest", run: func(ctx context.Context) error {
+			_, err := overdraftUseCase.AccrueDailyInterest(ctx, time.Now())
+			return err
+		}},
+		// Make the standing-order transfers that came due since the last run
+		{name: "standing orders", warning: "failed to run standing orders", run: func(ctx context.Context) error {
+			_, err := standingOrderUseCase.RunDueOrders(ctx, time.Now())
+			return err
+		}},
+		// Make the one-off transfers whose date has arrived
+		{name: "scheduled transfers", warning: "failed to run scheduled transfers", run: func(ctx context.Context) error {
+			_, err := scheduledTransferUseCase.RunDueTransfers(ctx, time.Now())
+			return err
+		}},
+		// Close the card invoices whose closing day went by since the last run
+		{name: "invoice closing", warning: "failed to close due invoices", run: func(ctx context.Context) error {
+			_, err := creditCardInvoiceUseCase.CloseDueInvoices(ctx, time.Now())
+			return err
+		}},
+		// Keep the report of every month that ended since the last run
+		{name: "report snapshots", warning: "failed to snapshot monthly reports", run: func(ctx context.Context) error {
+			_, err := reportSnapshotUseCase.SnapshotEndedMonths(ctx, time.Now())
+			return err
+		}},
+		// Clear scheduled card payments whose date has arrived
+		{name: "scheduled payments", warning: "failed to resolve scheduled payments", run: func(ctx context.Context) error {
+			_, err := pendingPaymentUseCase.ResolveDuePayments(ctx, time.Now())
+			return err
+		}},
+	}
+	// Email the monthly owed-amount summaries that are due, once the month has closed
+	if mailer != nil {
+		owedNoticeUseCase := usecase.NewOwedNoticeUseCase(personRepo, reportUseCase, mailer)
+		startupJobs = append(startupJobs, startupJob{name: "owed-amount emails", warning: "failed to send owed-amount emails", run: func(ctx context.Context) error {
+			_, err := owedNoticeUseCase.SendMonthlyNotices(ctx, time.Now())
+			return err
+		}})
+	}
+	// Remind of the card invoices coming due or overdue, once per stage
+	invoiceReminderUseCase := usecase.NewInvoiceReminderUseCase(creditCardInvoiceRepo, creditCardRepo, notificationUseCase)
+	if mailer != nil {
+		invoiceReminderUseCase.SetMailer(mailer, cfg.Digest.Email)
+	}
+	if cfg.Digest.WebhookURL != "" {
+		invoiceReminderUseCase.SetWebhook(webhook.NewPoster(cfg.Digest.WebhookURL))
+	}
+	startupJobs = append(startupJobs, startupJob{name: "invoice reminders", warning: "failed to send invoice reminders", run: func(ctx context.Context) error {
+		_, err := invoiceReminderUseCase.SendDueReminders(ctx, time.Now())
+		return err
+	}})
+	// Show the bills and invoices coming due on the desktop, once a day
+	dueReminderUseCase := usecase.NewDueReminderUseCase(billRepo, creditCardInvoiceRepo, creditCardRepo, notificationUseCase, cfg.Reminders.DaysAhead)
+	if cfg.Reminders.Desktop {
+		dueReminderUseCase.SetDesktopNotifier(desktop.NewNotifier(cfg.Reminders.DesktopCommand))
+		startupJobs = append(startupJobs, startupJob{name: "due reminders", warning: "failed to show due reminders", run: func(ctx context.Context) error {
+			_, err := dueReminderUseCase.NotifyDue(ctx, time.Now())
+			return err
+		}})
+	}
+	// Deliver last week's digest, once per week
+	startupJobs = append(startupJobs, startupJob{name: "weekly digest", warning: "failed to send weekly digest", run: func(ctx context.Context) error {
+		_, err := weeklyDigestUseCase.SendWeeklyDigest(ctx, time.Now())
+		return err
+	}})
+
+	inboxUseCase := usecase.NewInboxUseCase(inboxRepo, transactionUseCase)
+	categoryRuleUseCase := usecase.NewCategoryRuleUseCase(categoryRuleRepo, transactionRepo, transactionUseCase)
+	importUseCase := usecase.NewImportUseCase(importSessionRepo, accountRepo, transactionRepo, transactionUseCase)
+	importUseCase.SetCategoryRules(categoryRuleUseCase)
+	if cfg.Import.ReviewInbox {
+		importUseCase.SetReviewInbox(inboxUseCase)
+	}
+
+	// Queue the purchases of new e-receipts and card alerts for review
+	if cfg.IMAP.Host != "" {
+		mailbox := imap.NewMailbox(cfg.IMAP.Host, cfg.IMAP.Port, cfg.IMAP.Username, cfg.IMAP.Password, cfg.IMAP.Mailbox)
+		emailReceiptUseCase := usecase.NewEmailReceiptUseCase(mailbox, inboxUseCase, creditCardRepo)
+		startupJobs = append(startupJobs, startupJob{name: "email receipts", warning: "failed to read e-receipts", run: func(ctx context.Context) error {
+			_, err := emailReceiptUseCase.IngestReceipts(ctx, time.Now())
+			return err
+		}})
+	}
+
+	receiptUseCase := usecase.NewReceiptUseCase()
+	if cfg.OCR.Command != "" {
+		receiptUseCase.SetOCR(ocr.NewCommand(cfg.OCR.Command))
+	} else if cfg.OCR.URL != "" {
+		receiptUseCase.SetOCR(ocr.NewAPI(cfg.OCR.URL))
+	}
+
+	accountUseCase := usecase.NewAccountUseCase(accountRepo, transactionRepo)
+	accountUseCase.SetPeriodLocks(periodLockUseCase)
+	if repos.unitOfWork != nil {
+		accountUseCase.SetUnitOfWork(repos.unitOfWork)
+	}
+
+	kpiUseCase := usecase.NewKPIUseCase(accountRepo, creditCardRepo, creditCardInvoiceRepo, billRepo, transactionRepo)
+	kpiUseCase.SetFormulas(cfg.Dashboard.KPIs)
+
+	useCases := tui.UseCases{
+		Account:            accountUseCase,
+		CreditCard:         creditCardUseCase,
+		CreditCardInvoice:  creditCardInvoiceUseCase,
+		Bill:               billUseCase,
+		Transaction:        transactionUseCase,
+		Person:             usecase.NewPersonUseCase(personRepo),
+		Report:             reportUseCase,
+		InvoiceExport:      usecase.NewInvoiceExportUseCase(creditCardInvoiceRepo, creditCardRepo, transactionRepo, cfg.Export.Dir),
+		InvoiceForecast:    usecase.NewInvoiceForecastUseCase(creditCardInvoiceRepo, creditCardRepo, transactionRepo),
+		Yield:              yieldUseCase,
+		Import:             importUseCase,
+		PendingPayment:     pendingPaymentUseCase,
+		SinkingFund:        usecase.NewSinkingFundUseCase(sinkingFundRepo, transactionRepo),
+		Wishlist:           usecase.NewWishlistUseCase(wishlistRepo, accountRepo, transactionRepo, transactionUseCase),
+		Subscription:       subscriptionUseCase,
+		ChangeHistory:      changeHistoryUseCase,
+		Inbox:              inboxUseCase,
+		FilterPreset:       usecase.NewFilterPresetUseCase(filterPresetRepo),
+		PeopleExchange:     usecase.NewPeopleExchangeUseCase(personRepo, transactionRepo, cfg.Export.Dir),
+		CategoryAppearance: usecase.NewCategoryAppearanceUseCase(categoryAppearanceRepo),
+		Macro:              usecase.NewMacroUseCase(macroRepo),
+		Notification:       notificationUseCase,
+		DueReminder:        dueReminderUseCase,
+		AccountFee:         accountFeeUseCase,
+		Overdraft:          overdraftUseCase,
+		Budget:             usecase.NewBudgetUseCase(budgetRepo, transactionRepo),
+		Goal:               usecase.NewGoalUseCase(goalRepo, accountRepo, transactionRepo),
+		Investment:         usecase.NewInvestmentUseCase(holdingRepo, accountRepo, transactionRepo),
+		PeriodLock:         periodLockUseCase,
+		ReportSnapshot:     reportSnapshotUseCase,
+		Category:           usecase.NewCategoryUseCase(categoryRepo, transactionRepo),
+		FormDraft:          usecase.NewFormDraftUseCase(formDraftRepo),
+		StandingOrder:      standingOrderUseCase,
+		ScheduledTransfer:  scheduledTransferUseCase,
+		TransferSuggestion: usecase.NewTransferSuggestionUseCase(accountRepo, transactionRepo, billRepo, creditCardRepo, creditCardInvoiceRepo, pendingPaymentRepo, standingOrderRepo, scheduledTransferUseCase),
+		EmergencyFund:      usecase.NewEmergencyFundUseCase(emergencyFundRepo, accountRepo, transactionRepo),
+		KPI:                kpiUseCase,
+		Dashboard:          usecase.NewDashboardUseCase(accountRepo, transactionRepo),
+		CategorySuggestion: categorySuggestionUseCase,
+		CategoryRule:       categoryRuleUseCase,
+		ProjectExport:      usecase.NewProjectExpenseExportUseCase(reportUseCase, cfg.Export.Dir),
+		Receipt:            receiptUseCase,
+		StatementExport:    usecase.NewStatementExportUseCase(accountRepo, creditCardInvoiceRepo, creditCardRepo, transactionRepo, pdf.NewStatementWriter(), cfg.Export.Dir),
+		YearReviewExport:   usecase.NewYearReviewExportUseCase(reportUseCase, cfg.Export.Dir),
+		Variance:           usecase.NewVarianceUseCase(billRepo, transactionRepo, subscriptionPriceRepo, subscriptionUseCase),
+	}
+
+	return useCases, startupJobs
+}
+
+// workspaceOpener opens the workspaces picked in the TUI. Each is wired once
+// and kept, so switching back reuses its connection; the workspace of cfg is
+// already wired into useCases.
+func workspaceOpener(cfg *config.Config, useCases tui.UseCases) tui.WorkspaceOpener {
+	var mu sync.Mutex
+	opened := map[string]tui.UseCases{cfg.Workspace.Current: useCases}
+
+	return func(ctx context.Context, name string) (tui.UseCases, func(ctx context.Context) []string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if useCases, ok := opened[name]; ok {
+			return useCases, nil, nil
+		}
+
+		workspaceCfg, err := cfg.ForWorkspace(name)
+		if err != nil {
+			return tui.UseCases{}, nil, err
+		}
+		repos, err := openRepositories(workspaceCfg, false)
+		if err != nil {
+			return tui.UseCases{}, nil, err
+		}
+		useCases, startupJobs := wireUseCases(workspaceCfg, repos)
+		opened[name] = useCases
+
+		// The catch-up jobs run the first time a workspace is opened, as on launch
+		return useCases, func(ctx context.Context) []string {
+			return runStartupJobs(ctx, startupJobs, nil)
+		}, nil
+	}
+}
+
+// startupJob is a catch-up job run on every launch, such as posting the fees
+// that came due since the last run
+type startupJob struct {
+	name    string
+	warning string
+	run     func(ctx context.Context) error
+}
+
+// runStartupJobs runs the jobs one after the other, returning the warnings of
+// those that failed
+func runStartupJobs(ctx context.Context, jobs []startupJob, profile *screen.StartupProfile) []string {
+	var warnings []string
+	for _, job := range jobs {
+		if job.run == nil {
+			continue
+		}
+		done := profile.Measure("startup job: " + job.name)
+		if err := job.run(ctx); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", job.warning, err))
+		}
+		done()
+	}
+	return warnings
+}
+
+// splitJSONFlag takes "--json" out of args, reporting whether it was there
+func splitJSONFlag(args []string) ([]string, bool) {
+	rest := make([]string, 0, len(args))
+	found := false
+	for _, arg := range args {
+		if arg == "--json" {
+			found = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, found
+}
+
+// printJSON writes v to standard output as indented JSON, for jq and scripts
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// exportedFile is the JSON output of the commands that write a file
+type exportedFile struct {
+	Path   string `json:"path"`
+	Format string `json:"format,omitempty"`
+}
+
+func runDatasetCommand(ctx context.Context, datasetExchange *usecase.DatasetExchangeUseCase, command string, args []string, jsonOutput bool) error {
+	if command == "export" {
+		dir := ""
+		if len(args) > 0 {
+			dir = args[0]
+		}
+		path, err := datasetExchange.ExportDataset(ctx, dir, time.Now())
+		if err != nil {
+			return err
+		}
+		if jsonOutput {
+			return printJSON(exportedFile{Path: path})
+		}
+		fmt.Printf("Dataset exported to %s\n", path)
+		return nil
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("usage: financli import <dataset-dir>")
+	}
+	result, err := datasetExchange.ImportDataset(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		return printJSON(result)
+	}
+	fmt.Printf("Imported %d people, %d accounts, %d credit cards, %d bills, %d invoices, %d transactions and %d budgets, funds, goals and other plans (%d already present)\n",
+		result.People, result.Accounts, result.CreditCards, result.Bills, result.Invoices, result.Transactions, result.Planning(), result.Skipped)
+	return nil
+}
+
+func runJournalCommand(ctx context.Context, journalExport *usecase.JournalExportUseCase, args []string, jsonOutput bool) error {
+	format := usecase.JournalFormatBeancount
+	if len(args) > 0 {
+		parsed, err := usecase.ParseJournalFormat(args[0])
+		if err != nil {
+			return err
+		}
+		format = parsed
+	}
+	path := ""
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	path, err := journalExport.ExportJournal(ctx, format, path, time.Now())
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		return printJSON(exportedFile{Path: path, Format: string(format)})
+	}
+	fmt.Printf("Journal exported to %s\n", path)
+	return nil
+}
+
+// dueItem is the JSON output of "notify"
+type dueItem struct {
+	Kind     string  `json:"kind"`
+	Name     string  `json:"name"`
+	Amount   float64 `json:"amount"`
+	DueDate  string  `json:"due_date"`
+	DaysLeft int     `json:"days_left"`
+}
+
+func runNotifyCommand(ctx context.Context, dueReminders *usecase.DueReminderUseCase, jsonOutput bool) error {
+	items, err := dueReminders.NotifyDue(ctx, time.Now())
+	if err != nil && items == nil {
+		return err
+	}
+
+	if jsonOutput {
+		due := make([]dueItem, 0, len(items))
+		for _, item := range items {
+			due = append(due, dueItem{Kind: string(item.Kind), Name: item.Name, Amount: item.Amount, DueDate: item.DueDate.Format("2006-01-02"), DaysLeft: item.DaysLeft})
+		}
+		if printErr := printJSON(due); printErr != nil {
+			return printErr
+		}
+		return err
+	}
+
+	if len(items) == 0 {
+		fmt.Printf("Nothing due in the next %d days\n", dueReminders.DaysAhead())
+		return nil
+	}
+	for _, item := range items {
+		fmt.Printf("%-10s %-30s R$ %10.2f  %s\n", item.DueDate.Format("2006-01-02"), item.Name, item.Amount, item.When())
+	}
+	// A failed desktop notification still leaves the list printed
+	return err
+}
+
+func runReportCommand(ctx context.Context, reportTemplates *usecase.ReportTemplateUseCase, args []string, jsonOutput bool) error {
+	if jsonOutput {
+		return runReportDataCommand(ctx, reportTemplates, args)
+	}
+	if len(args) == 0 {
+		names, err := reportTemplates.ListTemplates()
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("usage: financli report <template> [YYYY-MM] (no templates found)")
+		}
+		return fmt.Errorf("usage: financli report <template> [YYYY-MM] (available: %s)", strings.Join(names, ", "))
+	}
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if len(args) > 1 {
+		parsed, err := parseReportMonth(args[1], now)
+		if err != nil {
+			return err
+		}
+		month = parsed
+	}
+
+	output, err := reportTemplates.RenderTemplate(ctx, args[0], month, month.AddDate(0, 1, 0).Add(-time.Nanosecond), now)
+	if err != nil {
+		return err
+	}
+	fmt.Print(output)
+	return nil
+}
+
+// runReportDataCommand prints the month's report data as JSON: the accounts,
+// cards, transactions, totals and breakdowns templates render. A template
+// name before the month is accepted and ignored, since the data is the same.
+func runReportDataCommand(ctx context.Context, reportTemplates *usecase.ReportTemplateUseCase, args []string) error {
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	// The month is the last argument, unless that is the template's name
+	if last := len(args) - 1; last >= 0 && (last > 0 || strings.IndexFunc(args[last], unicode.IsLetter) < 0) {
+		parsed, err := parseReportMonth(args[last], now)
+		if err != nil {
+			return err
+		}
+		month = parsed
+	}
+
+	data, err := reportTemplates.BuildTemplateData(ctx, month, month.AddDate(0, 1, 0).Add(-time.Nanosecond), now)
+	if err != nil {
+		return err
+	}
+	return printJSON(data)
+}
+
+func parseReportMonth(value string, now time.Time) (time.Time, error) {
+	month, err := time.ParseInLocation("2006-01", value, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid month %q, expected YYYY-MM", value)
+	}
+	return month, nil
+}
+
+func runServeCommand(cardAlerts *usecase.CardAlertUseCase, cfg config.ServerConfig, args []string) error {
+	addr := cfg.Addr
+	if len(args) > 0 {
+		addr = args[0]
+	}
+	if cfg.Token == "" {
+		if !server.IsLoopback(addr) {
+			return fmt.Errorf("FINANCLI_SERVER_TOKEN must be set to listen on %s, reachable from other machines", addr)
+		}
+		fmt.Println("Warning: FINANCLI_SERVER_TOKEN is not set, so any program on this machine can queue transactions")
+	}
+
+	srv := &http.Server{
+		Addr:         addr,
+		Handler:      server.NewServer(cardAlerts, cfg.Token).Handler(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	fmt.Printf("Listening for card notifications on %s/alerts\n", addr)
+	return srv.ListenAndServe()
+}
+
+// repositories holds every repository, implemented by the configured storage backend
+type repositories struct {
+	account            repository.AccountRepository
+	creditCard         repository.CreditCardRepository
+	creditCardInvoice  repository.CreditCardInvoiceRepository
+	person             repository.PersonRepository
+	bill               repository.BillRepository
+	transaction        repository.TransactionRepository
+	transactionArchive repository.TransactionArchiveRepository
+	importSession      repository.ImportSessionRepository
+	pendingPayment     repository.PendingPaymentRepository
+	sinkingFund        repository.SinkingFundRepository
+	wishlist           repository.WishlistRepository
+	subscriptionPrice  repository.SubscriptionPriceRepository
+	changeRecord       repository.ChangeRecordRepository
+	inbox              repository.InboxTransactionRepository
+	filterPreset       repository.FilterPresetRepository
+	categoryAppearance repository.CategoryAppearanceRepository
+	macro              repository.MacroRepository
+	notification       repository.NotificationRepository
+	budget             repository.BudgetRepository
+	standingOrder      repository.StandingOrderRepository
+	emergencyFund      repository.EmergencyFundRepository
+	categoryClassifier repository.CategoryClassifierRepository
+	categoryRule       repository.CategoryRuleRepository
+	goal               repository.GoalRepository
+	scheduledTransfer  repository.ScheduledTransferRepository
+	holding            repository.HoldingRepository
+	accountingPeriod   repository.AccountingPeriodRepository
+	reportSnapshot     repository.ReportSnapshotRepository
+	category           repository.CategoryRepository
+	formDraft          repository.FormDraftRepository
+
+	// unitOfWork makes a change's writes atomic, for the backends that can
+	unitOfWork repository.UnitOfWork
+	// checkUnitOfWork fails when the unit of work can't be atomic after all
+	checkUnitOfWork func(ctx context.Context) error
+
+	// ensureIndexes creates missing indexes, for the backends that don't do it when opening
+	ensureIndexes func(ctx context.Context) error
+}
+
+// loadPasscodeHash returns the bcrypt hash the lock checks the passcode against,
+// from the keychain when configured so
+func loadPasscodeHash(cfg config.SecurityConfig) (string, error) {
+	hash := cfg.PasscodeHash
+	if cfg.PasscodeKeychain {
+		var err error
+		if hash, err = keychain.New().Get(passcodeAccount); err != nil {
+			return "", fmt.Errorf("failed to read the passcode from the keychain: %w", err)
+		}
+	}
+	if hash == "" {
+		return "", nil
+	}
+
+	// Refused here rather than locking the app for good, as happens with a
+	// hash of an older kind
+	if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+		return "", fmt.Errorf("the passcode hash is not a bcrypt hash, run \"financli passcode\" to make one: %w", err)
+	}
+	return hash, nil
+}
+
+func runPasscodeCommand(args []string) error {
+	useKeychain := len(args) > 0 && args[0] == "--keychain"
+
+	fmt.Print("New passcode: ")
+	passcode, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return fmt.Errorf("failed to read the passcode: %w", err)
+	}
+	if len(passcode) == 0 {
+		return fmt.Errorf("the passcode can't be empty")
+	}
+	fmt.Print("Repeat it: ")
+	confirmation, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return fmt.Errorf("failed to read the passcode: %w", err)
+	}
+	if string(confirmation) != string(passcode) {
+		return fmt.Errorf("the passcodes don't match")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword(passcode, bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash the passcode: %w", err)
+	}
+
+	if useKeychain {
+		if err := keychain.New().Set(passcodeAccount, string(hash)); err != nil {
+			return err
+		}
+		fmt.Println("Passcode stored in the keychain; set FINANCLI_PASSCODE_KEYCHAIN=true to use it")
+		return nil
+	}
+	fmt.Println(string(hash))
+	return nil
+}
+
+// openRepositories opens the configured backend. With lazy set, backends on
+// the network skip the round trip that checks the server is there.
+func openRepositories(cfg *config.Config, lazy bool) (*repositories, error) {
+	if cfg.Storage.Backend == "sqlite" {
+		db, err := sqlite.NewConnection(sqlite.Config{Path: cfg.Storage.SQLitePath})
+		if err != nil {
+			return nil, err
+		}
+		return &repositories{
+			account:            sqlite.NewAccountRepository(db),
+			creditCard:         sqlite.NewCreditCardRepository(db),
+			creditCardInvoice:  sqlite.NewCreditCardInvoiceRepository(db),
+			person:             sqlite.NewPersonRepository(db),
+			bill:               sqlite.NewBillRepository(db),
+			transaction:        sqlite.NewTransactionRepository(db),
+			transactionArchive: sqlite.NewTransactionArchiveRepository(db),
+			importSession:      sqlite.NewImportSessionRepository(db),
+			pendingPayment:     sqlite.NewPendingPaymentRepository(db),
+			sinkingFund:        sqlite.NewSinkingFundRepository(db),
+			wishlist:           sqlite.NewWishlistRepository(db),
+			subscriptionPrice:  sqlite.NewSubscriptionPriceRepository(db),
+			changeRecord:       sqlite.NewChangeRecordRepository(db),
+			inbox:              sqlite.NewInboxTransactionRepository(db),
+			filterPreset:       sqlite.NewFilterPresetRepository(db),
+			categoryAppearance: sqlite.NewCategoryAppearanceRepository(db),
+			macro:              sqlite.NewMacroRepository(db),
+			notification:       sqlite.NewNotificationRepository(db),
+			budget:             sqlite.NewBudgetRepository(db),
+			standingOrder:      sqlite.NewStandingOrderRepository(db),
+			emergencyFund:      sqlite.NewEmergencyFundRepository(db),
+			categoryClassifier: sqlite.NewCategoryClassifierRepository(db),
+			categoryRule:       sqlite.NewCategoryRuleRepository(db),
+			goal:               sqlite.NewGoalRepository(db),
+			scheduledTransfer:  sqlite.NewScheduledTransferRepository(db),
+			holding:            sqlite.NewHoldingRepository(db),
+			accountingPeriod:   sqlite.NewAccountingPeriodRepository(db),
+			reportSnapshot:     sqlite.NewReportSnapshotRepository(db),
+			category:           sqlite.NewCategoryRepository(db),
+			formDraft:          sqlite.NewFormDraftRepository(db),
+		}, nil
+	}
+
+	if cfg.Storage.Backend == "bolt" {
+		db, err := bolt.NewConnection(bolt.Config{Path: cfg.Storage.BoltPath})
+		if err != nil {
+			return nil, err
+		}
+		return &repositories{
+			account:            bolt.NewAccountRepository(db),
+			creditCard:         bolt.NewCreditCardRepository(db),
+			creditCardInvoice:  bolt.NewCreditCardInvoiceRepository(db),
+			person:             bolt.NewPersonRepository(db),
+			bill:               bolt.NewBillRepository(db),
+			transaction:        bolt.NewTransactionRepository(db),
+			transactionArchive: bolt.NewTransactionArchiveRepository(db),
+			importSession:      bolt.NewImportSessionRepository(db),
+			pendingPayment:     bolt.NewPendingPaymentRepository(db),
+			sinkingFund:        bolt.NewSinkingFundRepository(db),
+			wishlist:           bolt.NewWishlistRepository(db),
+			subscriptionPrice:  bolt.NewSubscriptionPriceRepository(db),
+			changeRecord:       bolt.NewChangeRecordRepository(db),
+			inbox:              bolt.NewInboxTransactionRepository(db),
+			filterPreset:       bolt.NewFilterPresetRepository(db),
+			categoryAppearance: bolt.NewCategoryAppearanceRepository(db),
+			macro:              bolt.NewMacroRepository(db),
+			notification:       bolt.NewNotificationRepository(db),
+			budget:             bolt.NewBudgetRepository(db),
+			standingOrder:      bolt.NewStandingOrderRepository(db),
+			emergencyFund:      bolt.NewEmergencyFundRepository(db),
+			categoryClassifier: bolt.NewCategoryClassifierRepository(db),
+			categoryRule:       bolt.NewCategoryRuleRepository(db),
+			goal:               bolt.NewGoalRepository(db),
+			scheduledTransfer:  bolt.NewScheduledTransferRepository(db),
+			holding:            bolt.NewHoldingRepository(db),
+			accountingPeriod:   bolt.NewAccountingPeriodRepository(db),
+			reportSnapshot:     bolt.NewReportSnapshotRepository(db),
+			category:           bolt.NewCategoryRepository(db),
+			formDraft:          bolt.NewFormDraftRepository(db),
+		}, nil
+	}
+
+	db, err := mongodb.NewConnection(mongodb.Config{
+		URI:      cfg.MongoDB.URI,
+		Database: cfg.MongoDB.Database,
+		Lazy:     lazy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &repositories{
+		account:            mongodb.NewAccountRepository(db),
+		creditCard:         mongodb.NewCreditCardRepository(db),
+		creditCardInvoice:  mongodb.NewCreditCardInvoiceRepository(db),
+		person:             mongodb.NewPersonRepository(db),
+		bill:               mongodb.NewBillRepository(db),
+		transaction:        mongodb.NewTransactionRepository(db),
+		transactionArchive: mongodb.NewTransactionArchiveRepository(db),
+		importSession:      mongodb.NewImportSessionRepository(db),
+		pendingPayment:     mongodb.NewPendingPaymentRepository(db),
+		sinkingFund:        mongodb.NewSinkingFundRepository(db),
+		wishlist:           mongodb.NewWishlistRepository(db),
+		subscriptionPrice:  mongodb.NewSubscriptionPriceRepository(db),
+		changeRecord:       mongodb.NewChangeRecordRepository(db),
+		inbox:              mongodb.NewInboxTransactionRepository(db),
+		filterPreset:       mongodb.NewFilterPresetRepository(db),
+		categoryAppearance: mongodb.NewCategoryAppearanceRepository(db),
+		macro:              mongodb.NewMacroRepository(db),
+		notification:       mongodb.NewNotificationRepository(db),
+		budget:             mongodb.NewBudgetRepository(db),
+		standingOrder:      mongodb.NewStandingOrderRepository(db),
+		emergencyFund:      mongodb.NewEmergencyFundRepository(db),
+		categoryClassifier: mongodb.NewCategoryClassifierRepository(db),
+		categoryRule:       mongodb.NewCategoryRuleRepository(db),
+		goal:               mongodb.NewGoalRepository(db),
+		scheduledTransfer:  mongodb.NewScheduledTransferRepository(db),
+		holding:            mongodb.NewHoldingRepository(db),
+		accountingPeriod:   mongodb.NewAccountingPeriodRepository(db),
+		reportSnapshot:     mongodb.NewReportSnapshotRepository(db),
+		category:           mongodb.NewCategoryRepository(db),
+		formDraft:          mongodb.NewFormDraftRepository(db),
+		unitOfWork:         mongodb.NewUnitOfWork(db),
+		checkUnitOfWork: func(ctx context.Context) error {
+			return mongodb.CheckTransactions(ctx, db)
+		},
+		ensureIndexes: func(ctx context.Context) error {
+			return mongodb.EnsureIndexes(ctx, db)
+		},
+	}, nil
 }
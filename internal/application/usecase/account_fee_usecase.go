@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// AccountFeesPaid is what one account charged in fees over a year
+type AccountFeesPaid struct {
+	Account *entity.Account
+	Charges []entity.FeeCharge
+	Total   float64
+}
+
+// FeesReport sums the bank fees paid in a year, the most expensive account first
+type FeesReport struct {
+	Year     int
+	Accounts []AccountFeesPaid
+	Total    float64
+}
+
+type AccountFeeUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	periods         *PeriodLockUseCase
+}
+
+func NewAccountFeeUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *AccountFeeUseCase {
+	return &AccountFeeUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetPeriodLocks keeps fees from being charged in months whose books are
+// closed; they're posted once the month is reopened
+func (uc *AccountFeeUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+func (uc *AccountFeeUseCase) AddFee(ctx context.Context, accountID uuid.UUID, name string, amount float64, dayOfMonth int) (*entity.AccountFee, error) {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+
+	fee, err := account.AddFee(name, valueobject.NewMoney(amount, account.Balance.Currency()), dayOfMonth, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return fee, nil
+}
+
+func (uc *AccountFeeUseCase) RemoveFee(ctx context.Context, accountID, feeID uuid.UUID) error {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	if err := account.RemoveFee(feeID); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return nil
+}
+
+// PostDueFees debits every fee that came due since the last run and records
+// it as an expense on its account, so this is safe to run on every launch
+func (uc *AccountFeeUseCase) PostDueFees(ctx context.Context, now time.Time) ([]*entity.Transaction, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	var posted []*entity.Transaction
+	for _, account := range accounts {
+		if len(account.Fees) == 0 {
+			continue
+		}
+
+		charges, err := account.ChargeFees(now)
+		if err != nil {
+			return posted, fmt.Errorf("failed to charge fees for %s: %w", account.Name, err)
+		}
+		if len(charges) == 0 {
+			continue
+		}
+		dates := make([]time.Time, len(charges))
+		for i, charge := range charges {
+			dates[i] = charge.Date
+		}
+		if err := uc.periods.CheckOpen(ctx, dates...); err != nil {
+			return posted, fmt.Errorf("failed to charge fees for %s: %w", account.Name, err)
+		}
+
+		// Persist the account first so a failure never charges a fee twice
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return posted, fmt.Errorf("failed to update account: %w", err)
+		}
+
+		for _, charge := range charges {
+			transaction := entity.NewTransaction(&account.ID, nil, entity.TransactionTypeDebit, entity.TransactionCategoryOther,
+				charge.Amount, fmt.Sprintf("Fee: %s (%s)", charge.FeeName, charge.Month), charge.Date)
+			if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+				return posted, fmt.Errorf("failed to create fee transaction: %w", err)
+			}
+			posted = append(posted, transaction)
+		}
+	}
+
+	return posted, nil
+}
+
+// GetFeesReport sums the fees each account charged in the year
+func (uc *AccountFeeUseCase) GetFeesReport(ctx context.Context, year int) (*FeesReport, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	report := &FeesReport{Year: year}
+	for _, account := range accounts {
+		charges := account.FeesPaidIn(year)
+		if len(charges) == 0 {
+			continue
+		}
+
+		paid := AccountFeesPaid{Account: account, Charges: charges}
+		for _, charge := range charges {
+			paid.Total += charge.Amount.Amount()
+		}
+		report.Accounts = append(report.Accounts, paid)
+		report.Total += paid.Total
+	}
+
+	sort.SliceStable(report.Accounts, func(i, j int) bool {
+		return report.Accounts[i].Total > report.Accounts[j].Total
+	})
+
+	return report, nil
+}
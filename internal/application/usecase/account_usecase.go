@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"financli/internal/domain/entity"
 	"financli/internal/domain/repository"
@@ -11,15 +13,30 @@ import (
 )
 
 type AccountUseCase struct {
-	accountRepo repository.AccountRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	unitOfWork      repository.UnitOfWork
+	periods         *PeriodLockUseCase
 }
 
-func NewAccountUseCase(accountRepo repository.AccountRepository) *AccountUseCase {
+func NewAccountUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *AccountUseCase {
 	return &AccountUseCase{
-		accountRepo: accountRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
 	}
 }
 
+// SetUnitOfWork makes the balance and transaction writes of a transfer commit
+// or roll back together
+func (uc *AccountUseCase) SetUnitOfWork(unitOfWork repository.UnitOfWork) {
+	uc.unitOfWork = unitOfWork
+}
+
+// SetPeriodLocks keeps transfers out of months whose books are closed
+func (uc *AccountUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
 func (uc *AccountUseCase) CreateAccount(ctx context.Context, name string, accountType entity.AccountType, initialBalance float64, currency, description string) (*entity.Account, error) {
 	money := valueobject.NewMoney(initialBalance, currency)
 	account := entity.NewAccount(name, accountType, money, description)
@@ -85,38 +102,110 @@ func (uc *AccountUseCase) UpdateAccount(ctx context.Context, id uuid.UUID, name
 	return account, nil
 }
 
-func (uc *AccountUseCase) DeleteAccount(ctx context.Context, id uuid.UUID) error {
-	return uc.accountRepo.Delete(ctx, id)
-}
-
-func (uc *AccountUseCase) Transfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount float64, currency string) error {
-	fromAccount, err := uc.accountRepo.FindByID(ctx, fromAccountID)
+func (uc *AccountUseCase) SetTransactionDefaults(ctx context.Context, id uuid.UUID, category entity.TransactionCategory, transactionType entity.TransactionType) error {
+	account, err := uc.accountRepo.FindByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("source account not found: %w", err)
+		return fmt.Errorf("account not found: %w", err)
 	}
 
-	toAccount, err := uc.accountRepo.FindByID(ctx, toAccountID)
+	account.SetTransactionDefaults(category, transactionType)
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return nil
+}
+
+// SetMinimumBalance sets the balance below which the account raises a low
+// balance alert; nil removes the alert
+func (uc *AccountUseCase) SetMinimumBalance(ctx context.Context, id uuid.UUID, threshold *float64) error {
+	account, err := uc.accountRepo.FindByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("destination account not found: %w", err)
+		return fmt.Errorf("account not found: %w", err)
 	}
 
-	money := valueobject.NewMoney(amount, currency)
+	var minimum *valueobject.Money
+	if threshold != nil {
+		money := valueobject.NewMoney(*threshold, account.Balance.Currency())
+		minimum = &money
+	}
+	if err := account.SetMinimumBalance(minimum); err != nil {
+		return err
+	}
 
-	if err := fromAccount.Withdraw(money); err != nil {
-		return fmt.Errorf("failed to withdraw from source account: %w", err)
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
 	}
 
-	if err := toAccount.Deposit(money); err != nil {
-		return fmt.Errorf("failed to deposit to destination account: %w", err)
+	return nil
+}
+
+func (uc *AccountUseCase) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	return uc.accountRepo.Delete(ctx, id)
+}
+
+// Transfer moves money between two accounts, recording both sides as linked
+// transactions in the Transfer category so they don't count as income or
+// expense. It returns the debit on the source and the credit on the destination.
+func (uc *AccountUseCase) Transfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount float64, description string, date time.Time) (*entity.Transaction, *entity.Transaction, error) {
+	if fromAccountID == toAccountID {
+		return nil, nil, fmt.Errorf("source and destination accounts must be different")
+	}
+	if amount <= 0 {
+		return nil, nil, fmt.Errorf("transfer amount must be positive")
+	}
+	if err := uc.periods.CheckOpen(ctx, date); err != nil {
+		return nil, nil, err
 	}
 
-	if err := uc.accountRepo.Update(ctx, fromAccount); err != nil {
-		return fmt.Errorf("failed to update source account: %w", err)
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = "Transfer"
 	}
 
-	if err := uc.accountRepo.Update(ctx, toAccount); err != nil {
-		return fmt.Errorf("failed to update destination account: %w", err)
+	// The accounts are loaded inside the unit of work, which may run it again
+	var debit, credit *entity.Transaction
+	err := inUnitOfWork(ctx, uc.unitOfWork, func(ctx context.Context) error {
+		fromAccount, err := uc.accountRepo.FindByID(ctx, fromAccountID)
+		if err != nil {
+			return fmt.Errorf("source account not found: %w", err)
+		}
+
+		toAccount, err := uc.accountRepo.FindByID(ctx, toAccountID)
+		if err != nil {
+			return fmt.Errorf("destination account not found: %w", err)
+		}
+
+		money := valueobject.NewMoney(amount, fromAccount.Balance.Currency())
+
+		if err := fromAccount.Withdraw(money); err != nil {
+			return fmt.Errorf("failed to withdraw from source account: %w", err)
+		}
+
+		if err := toAccount.Deposit(money); err != nil {
+			return fmt.Errorf("failed to deposit to destination account: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, fromAccount); err != nil {
+			return fmt.Errorf("failed to update source account: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, toAccount); err != nil {
+			return fmt.Errorf("failed to update destination account: %w", err)
+		}
+
+		debit, credit = entity.NewTransfer(fromAccount.ID, toAccount.ID, money,
+			fmt.Sprintf("%s → %s", description, toAccount.Name), fmt.Sprintf("%s ← %s", description, fromAccount.Name), date)
+
+		if err := uc.transactionRepo.CreateMany(ctx, []*entity.Transaction{debit, credit}); err != nil {
+			return fmt.Errorf("failed to create transfer transactions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	return debit, credit, nil
 }
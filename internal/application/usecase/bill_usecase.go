@@ -12,12 +12,37 @@ import (
 )
 
 type BillUseCase struct {
-	billRepo repository.BillRepository
+	billRepo        repository.BillRepository
+	transactionRepo repository.TransactionRepository
+	history         *ChangeHistoryUseCase
+	periods         *PeriodLockUseCase
 }
 
-func NewBillUseCase(billRepo repository.BillRepository) *BillUseCase {
+func NewBillUseCase(billRepo repository.BillRepository, transactionRepo repository.TransactionRepository) *BillUseCase {
 	return &BillUseCase{
-		billRepo: billRepo,
+		billRepo:        billRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetChangeHistory enables recording of bill edits
+func (uc *BillUseCase) SetChangeHistory(history *ChangeHistoryUseCase) {
+	uc.history = history
+}
+
+// SetPeriodLocks keeps deleting a bill from unlinking the transactions of
+// months whose books are closed
+func (uc *BillUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+func (uc *BillUseCase) recordChange(ctx context.Context, bill *entity.Bill, summary string, before entity.Snapshot) {
+	if uc.history == nil {
+		return
+	}
+	if err := uc.history.RecordChange(ctx, entity.ChangeEntityBill, bill.ID, summary, before, bill.Snapshot()); err != nil {
+		// The edit itself succeeded, so only warn about the history
+		fmt.Printf("Warning: %v\n", err)
 	}
 }
 
@@ -35,6 +60,26 @@ func (uc *BillUseCase) CreateBill(ctx context.Context, name, description string,
 	return bill, nil
 }
 
+func (uc *BillUseCase) UpdateBill(ctx context.Context, id uuid.UUID, name, description string, startDate, endDate, dueDate time.Time, totalAmount float64) (*entity.Bill, error) {
+	bill, err := uc.billRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("bill not found: %w", err)
+	}
+
+	before := bill.Snapshot()
+	money := valueobject.NewMoney(totalAmount, bill.TotalAmount.Currency())
+	if err := bill.Update(name, description, startDate, endDate, dueDate, money); err != nil {
+		return nil, err
+	}
+
+	if err := uc.billRepo.Update(ctx, bill); err != nil {
+		return nil, fmt.Errorf("failed to update bill: %w", err)
+	}
+	uc.recordChange(ctx, bill, "Bill edited", before)
+
+	return bill, nil
+}
+
 func (uc *BillUseCase) GetBill(ctx context.Context, id uuid.UUID) (*entity.Bill, error) {
 	return uc.billRepo.FindByID(ctx, id)
 }
@@ -61,12 +106,17 @@ func (uc *BillUseCase) AddPayment(ctx context.Context, billID uuid.UUID, amount
 		return err
 	}
 
+	before := bill.Snapshot()
 	money := valueobject.NewMoney(amount, currency)
 	if err := bill.AddPayment(money); err != nil {
 		return err
 	}
 
-	return uc.billRepo.Update(ctx, bill)
+	if err := uc.billRepo.Update(ctx, bill); err != nil {
+		return err
+	}
+	uc.recordChange(ctx, bill, fmt.Sprintf("Payment of %s", money.String()), before)
+	return nil
 }
 
 func (uc *BillUseCase) CloseBill(ctx context.Context, billID uuid.UUID) error {
@@ -75,11 +125,16 @@ func (uc *BillUseCase) CloseBill(ctx context.Context, billID uuid.UUID) error {
 		return err
 	}
 
+	before := bill.Snapshot()
 	if err := bill.Close(); err != nil {
 		return err
 	}
 
-	return uc.billRepo.Update(ctx, bill)
+	if err := uc.billRepo.Update(ctx, bill); err != nil {
+		return err
+	}
+	uc.recordChange(ctx, bill, "Bill closed", before)
+	return nil
 }
 
 func (uc *BillUseCase) DeleteBill(ctx context.Context, billID uuid.UUID) error {
@@ -89,6 +144,21 @@ func (uc *BillUseCase) DeleteBill(ctx context.Context, billID uuid.UUID) error {
 		return fmt.Errorf("bill not found: %w", err)
 	}
 
+	// Unlink its transactions first so none is left pointing at a missing bill
+	transactions, err := uc.transactionRepo.FindByBillID(ctx, billID)
+	if err != nil {
+		return fmt.Errorf("failed to get bill transactions: %w", err)
+	}
+	if err := uc.periods.CheckOpen(ctx, transactionDates(transactions)...); err != nil {
+		return err
+	}
+	for _, transaction := range transactions {
+		transaction.UnassignFromBill()
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			return fmt.Errorf("failed to unlink transaction from bill: %w", err)
+		}
+	}
+
 	// Delete the bill
 	if err := uc.billRepo.Delete(ctx, billID); err != nil {
 		return fmt.Errorf("failed to delete bill: %w", err)
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// BudgetProgress is how much of a budget was spent in a month
+type BudgetProgress struct {
+	Budget  *entity.Budget
+	Carried float64 // Rolled over from the months before, negative for overspending
+	Spent   float64
+	Count   int
+}
+
+// Available is the month's limit with what the earlier months rolled over
+func (p *BudgetProgress) Available() float64 {
+	return p.Budget.MonthlyLimit.Amount() + p.Carried
+}
+
+func (p *BudgetProgress) Remaining() float64 {
+	return p.Available() - p.Spent
+}
+
+func (p *BudgetProgress) Percentage() float64 {
+	// With the month's budget all taken by earlier overspending, any expense is over
+	if p.Available() <= 0 {
+		if p.Spent > 0 {
+			return 100 + p.Spent/p.Budget.MonthlyLimit.Amount()*100
+		}
+		return 100
+	}
+	return p.Spent / p.Available() * 100
+}
+
+func (p *BudgetProgress) IsOver() bool {
+	return p.Spent > p.Available()
+}
+
+type BudgetUseCase struct {
+	budgetRepo      repository.BudgetRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewBudgetUseCase(budgetRepo repository.BudgetRepository, transactionRepo repository.TransactionRepository) *BudgetUseCase {
+	return &BudgetUseCase{
+		budgetRepo:      budgetRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (uc *BudgetUseCase) CreateBudget(ctx context.Context, category entity.TransactionCategory, monthlyLimit float64, currency string, rollover entity.BudgetRollover) (*entity.Budget, error) {
+	budgets, err := uc.budgetRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+	for _, budget := range budgets {
+		if budget.Category == category {
+			return nil, fmt.Errorf("%s already has a budget", category)
+		}
+	}
+
+	budget, err := entity.NewBudget(category, valueobject.NewMoney(monthlyLimit, currency))
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.SetRollover(rollover); err != nil {
+		return nil, err
+	}
+
+	if err := uc.budgetRepo.Create(ctx, budget); err != nil {
+		return nil, fmt.Errorf("failed to save budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+func (uc *BudgetUseCase) UpdateBudget(ctx context.Context, id uuid.UUID, monthlyLimit float64, rollover entity.BudgetRollover) (*entity.Budget, error) {
+	budget, err := uc.budgetRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := budget.SetLimit(valueobject.NewMoney(monthlyLimit, budget.MonthlyLimit.Currency())); err != nil {
+		return nil, err
+	}
+	if err := budget.SetRollover(rollover); err != nil {
+		return nil, err
+	}
+
+	if err := uc.budgetRepo.Update(ctx, budget); err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+func (uc *BudgetUseCase) DeleteBudget(ctx context.Context, id uuid.UUID) error {
+	return uc.budgetRepo.Delete(ctx, id)
+}
+
+func (uc *BudgetUseCase) ListBudgets(ctx context.Context) ([]*entity.Budget, error) {
+	return uc.budgetRepo.FindAll(ctx)
+}
+
+// GetMonthProgress adds up the month's expenses against each budget, along with
+// what the budgets that roll over carried into the month
+func (uc *BudgetUseCase) GetMonthProgress(ctx context.Context, year int, month time.Month) ([]*BudgetProgress, error) {
+	budgets, err := uc.budgetRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	progress := make([]*BudgetProgress, len(budgets))
+	for i, budget := range budgets {
+		progress[i] = &BudgetProgress{Budget: budget}
+		for _, txn := range transactions {
+			if budget.Counts(txn) {
+				progress[i].Spent += txn.Amount.Amount()
+				progress[i].Count++
+			}
+		}
+	}
+
+	if err := uc.addCarried(ctx, progress, start); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// addCarried works out what each rolling budget carried into the month starting
+// at start, going month by month from the month the budget was created. What a
+// month passes on is saved with the budget the first time it's worked out after
+// the month is over, and read back from then on, so later changes to the
+// month's expenses don't move the months after it.
+func (uc *BudgetUseCase) addCarried(ctx context.Context, progress []*BudgetProgress, start time.Time) error {
+	// Only the expenses of the months not carried yet are needed
+	first := start
+	for _, p := range progress {
+		if !p.Budget.RollsOver() {
+			continue
+		}
+		month := budgetStart(p.Budget)
+		for ; month.Before(first); month = month.AddDate(0, 1, 0) {
+			if _, ok := p.Budget.CarriedFrom(month.Format("2006-01")); !ok {
+				break
+			}
+		}
+		if month.Before(first) {
+			first = month
+		}
+	}
+
+	var transactions []*entity.Transaction
+	if first.Before(start) {
+		var err error
+		transactions, err = uc.transactionRepo.FindByDateRange(ctx, first, start.Add(-time.Nanosecond))
+		if err != nil {
+			return fmt.Errorf("failed to get transactions: %w", err)
+		}
+	}
+
+	current := entity.PeriodMonth(time.Now())
+	for _, p := range progress {
+		budget := p.Budget
+		if !budget.RollsOver() {
+			continue
+		}
+
+		spent := make(map[string]float64)
+		for _, txn := range transactions {
+			if budget.Counts(txn) {
+				spent[txn.Date.Format("2006-01")] += txn.Amount.Amount()
+			}
+		}
+
+		recorded := false
+		for month := budgetStart(budget); month.Before(start); month = month.AddDate(0, 1, 0) {
+			key := month.Format("2006-01")
+			if carry, ok := budget.CarriedFrom(key); ok {
+				p.Carried = carry
+				continue
+			}
+
+			remaining := budget.MonthlyLimit.Amount() + p.Carried - spent[key]
+			p.Carried = budget.Carry(remaining)
+			if month.Before(current) {
+				budget.RecordCarry(key, p.Carried)
+				recorded = true
+			}
+		}
+
+		if recorded {
+			if err := uc.budgetRepo.Update(ctx, budget); err != nil {
+				return fmt.Errorf("failed to save budget carry-over: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// budgetStart is the first month of the budget, the one it was created in
+func budgetStart(budget *entity.Budget) time.Time {
+	return time.Date(budget.CreatedAt.Year(), budget.CreatedAt.Month(), 1, 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetUseCase_GetMonthProgress_Carried(t *testing.T) {
+	ctx := context.Background()
+	current := entity.PeriodMonth(time.Now())
+	twoMonthsAgo, nextMonth := current.AddDate(0, -2, 0), current.AddDate(0, 1, 0)
+
+	expense := func(amount float64, month time.Time) *entity.Transaction {
+		return entity.NewTransaction(nil, nil, entity.TransactionTypeDebit, entity.TransactionCategoryFood, valueobject.NewMoney(amount, "BRL"), "Groceries", month.AddDate(0, 0, 4))
+	}
+
+	tests := []struct {
+		name       string
+		rollover   entity.BudgetRollover
+		month      time.Time
+		later      *entity.Transaction
+		switchTo   entity.BudgetRollover
+		wantBefore float64
+		wantAfter  float64
+	}{
+		{
+			name:       "an ended month's carry is kept",
+			rollover:   entity.BudgetRolloverUnspent,
+			month:      current,
+			later:      expense(50, twoMonthsAgo),
+			wantBefore: 160,
+			wantAfter:  160,
+		},
+		{
+			name:       "the current month's carry follows its expenses",
+			rollover:   entity.BudgetRolloverUnspent,
+			month:      nextMonth,
+			later:      expense(50, current),
+			wantBefore: 260,
+			wantAfter:  210,
+		},
+		{
+			name:       "changing the rollover works the carry out again",
+			rollover:   entity.BudgetRolloverOverspend,
+			month:      current,
+			later:      expense(50, twoMonthsAgo),
+			switchTo:   entity.BudgetRolloverUnspent,
+			wantBefore: 0,
+			wantAfter:  110,
+		},
+		{
+			name:       "a reset budget carries nothing",
+			rollover:   entity.BudgetRolloverReset,
+			month:      current,
+			wantBefore: 0,
+			wantAfter:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget, err := entity.NewBudget(entity.TransactionCategoryFood, valueobject.NewMoney(100, "BRL"))
+			require.NoError(t, err)
+			require.NoError(t, budget.SetRollover(tt.rollover))
+			budget.CreatedAt = twoMonthsAgo
+
+			budgets := newFakeBudgetRepo(budget)
+			transactions := newFakeTransactionRepo(expense(40, twoMonthsAgo))
+			uc := NewBudgetUseCase(budgets, transactions)
+
+			progress, err := uc.GetMonthProgress(ctx, tt.month.Year(), tt.month.Month())
+			require.NoError(t, err)
+			require.Len(t, progress, 1)
+			assert.Equal(t, tt.wantBefore, progress[0].Carried)
+
+			if tt.later != nil {
+				require.NoError(t, transactions.Create(ctx, tt.later))
+			}
+			if tt.switchTo != "" {
+				_, err := uc.UpdateBudget(ctx, budget.ID, 100, tt.switchTo)
+				require.NoError(t, err)
+			}
+
+			progress, err = uc.GetMonthProgress(ctx, tt.month.Year(), tt.month.Month())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAfter, progress[0].Carried)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+var (
+	// cardDigitsPattern matches the last digits card alerts name the card by,
+	// such as "final 1234" or "ending in 1234"
+	cardDigitsPattern = regexp.MustCompile(`(?i)(?:final|ending in|terminado em)\s*(\d{4})`)
+	// cardMerchantPattern matches the merchant after the amount of a card alert,
+	// such as "R$ 45,90 em PADARIA CENTRAL" or "$45.90 at CORNER SHOP", up to
+	// the next preposition, line or sentence
+	cardMerchantPattern = regexp.MustCompile(`(?i)\d[,.]\d{2}\s+(?:aprovada\s+)?(?:em|at|no|na)\s+([^\n,;]+?)(?:\s+(?:em|on|às|at|para|for)\s|[.,;]?\s*$|[.,;]?\n|[.;]\s)`)
+)
+
+// cardAlertRejections mark notifications about purchases that didn't go through
+var cardAlertRejections = []string{"recusada", "negada", "não aprovada", "nao aprovada", "declined", "estorno", "cancelada"}
+
+// cardAlert is what a bank's purchase notification says about the purchase
+type cardAlert struct {
+	amount     float64
+	merchant   string
+	cardDigits string
+}
+
+// CardAlertUseCase turns the purchase notifications of banks, forwarded from
+// the phone by automation apps, into pending transactions in the review inbox
+type CardAlertUseCase struct {
+	inboxUseCase   *InboxUseCase
+	creditCardRepo repository.CreditCardRepository
+}
+
+func NewCardAlertUseCase(inboxUseCase *InboxUseCase, creditCardRepo repository.CreditCardRepository) *CardAlertUseCase {
+	return &CardAlertUseCase{
+		inboxUseCase:   inboxUseCase,
+		creditCardRepo: creditCardRepo,
+	}
+}
+
+// IngestAlert queues the purchase described by the notification text for
+// review, on the card it names or the only card there is
+func (uc *CardAlertUseCase) IngestAlert(ctx context.Context, source, text string, now time.Time) (*entity.InboxTransaction, error) {
+	alert, ok := parseCardAlert(text)
+	if !ok {
+		return nil, fmt.Errorf("no card purchase found in the notification")
+	}
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credit cards: %w", err)
+	}
+	card := alertCard(cards, alert.cardDigits)
+	if card == nil {
+		if alert.cardDigits != "" {
+			return nil, fmt.Errorf("no credit card ending in %s", alert.cardDigits)
+		}
+		return nil, fmt.Errorf("the notification doesn't name the card and there is more than one")
+	}
+
+	items, err := uc.inboxUseCase.Submit(ctx, source, []*entity.Transaction{alert.transaction(card, now)})
+	if err != nil {
+		return nil, err
+	}
+	return items[0], nil
+}
+
+// transaction is the purchase as a charge on card
+func (a *cardAlert) transaction(card *entity.CreditCard, date time.Time) *entity.Transaction {
+	description := a.merchant
+	if description == "" {
+		description = "Card purchase"
+	}
+	cardID := card.ID
+	return entity.NewTransaction(
+		nil,
+		&cardID,
+		entity.TransactionTypeDebit,
+		entity.TransactionCategoryOther,
+		valueobject.NewMoney(a.amount, card.CurrentBalance.Currency()),
+		description,
+		date,
+	)
+}
+
+// parseCardAlert reads the amount, merchant and card of a purchase
+// notification. Declined and refunded purchases are not purchases.
+func parseCardAlert(text string) (*cardAlert, bool) {
+	lower := strings.ToLower(text)
+	for _, word := range cardAlertRejections {
+		if strings.Contains(lower, word) {
+			return nil, false
+		}
+	}
+
+	// The amount of the purchase comes first, before any available limit
+	match := receiptAmountPattern.FindStringSubmatch(text)
+	if match == nil {
+		return nil, false
+	}
+	alert := &cardAlert{amount: parseReceiptAmount(match)}
+	if alert.amount <= 0 {
+		return nil, false
+	}
+
+	if match := cardMerchantPattern.FindStringSubmatch(text); match != nil {
+		alert.merchant = strings.TrimSpace(match[1])
+	}
+	if match := cardDigitsPattern.FindStringSubmatch(text); match != nil {
+		alert.cardDigits = match[1]
+	}
+	return alert, true
+}
+
+// isCardAlert tells purchase notifications from the rest of a mailbox
+func isCardAlert(lower string) bool {
+	for _, phrase := range []string{"compra aprovada", "compra realizada", "purchase approved", "card purchase", "transação aprovada"} {
+		if strings.Contains(lower, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// alertCard picks the card with the last digits an alert names, or the only
+// card when it names none
+func alertCard(cards []*entity.CreditCard, digits string) *entity.CreditCard {
+	if digits == "" {
+		if len(cards) == 1 {
+			return cards[0]
+		}
+		return nil
+	}
+
+	var found *entity.CreditCard
+	for _, card := range cards {
+		if card.LastFourDigits == digits {
+			if found != nil {
+				return nil
+			}
+			found = card
+		}
+	}
+	return found
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"testing"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCardAlert(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		amount     float64
+		merchant   string
+		cardDigits string
+	}{
+		{
+			name:       "portuguese",
+			text:       "Compra aprovada no seu cartão final 1234: R$ 45,90 em PADARIA CENTRAL em 12/03 às 10:15.",
+			amount:     45.9,
+			merchant:   "PADARIA CENTRAL",
+			cardDigits: "1234",
+		},
+		{
+			name:       "thousands separator and approval after the amount",
+			text:       "Compra de R$ 1.234,56 APROVADA em AMAZON BR para o cartão com final 9876.",
+			amount:     1234.56,
+			merchant:   "AMAZON BR",
+			cardDigits: "9876",
+		},
+		{
+			name:       "english",
+			text:       "Purchase approved: $45.90 at CORNER SHOP on your card ending in 4321",
+			amount:     45.9,
+			merchant:   "CORNER SHOP",
+			cardDigits: "4321",
+		},
+		{
+			name:     "available limit after the purchase",
+			text:     "Compra de R$ 50,00 aprovada em LOJA X. Limite disponível: R$ 1.950,00",
+			amount:   50,
+			merchant: "LOJA X",
+		},
+		{
+			name:       "merchant at the end of a line",
+			text:       "Transação aprovada\nR$ 19,99 no SPOTIFY\nCartão terminado em 5555",
+			amount:     19.99,
+			merchant:   "SPOTIFY",
+			cardDigits: "5555",
+		},
+		{
+			name:     "dots inside the merchant",
+			text:     "Compra aprovada: R$ 89,90 em AMAZON.COM.BR. Limite disponível: R$ 910,10",
+			amount:   89.9,
+			merchant: "AMAZON.COM.BR",
+		},
+		{
+			name:   "no merchant nor card",
+			text:   "Compra realizada: R$ 12,00",
+			amount: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert, ok := parseCardAlert(tt.text)
+			require.True(t, ok)
+			assert.InDelta(t, tt.amount, alert.amount, 0.0001)
+			assert.Equal(t, tt.merchant, alert.merchant)
+			assert.Equal(t, tt.cardDigits, alert.cardDigits)
+		})
+	}
+}
+
+func TestParseCardAlert_NotAPurchase(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"declined", "Compra recusada no cartão final 1234: R$ 45,90 em PADARIA"},
+		{"not approved", "Compra não aprovada: R$ 45,90 em PADARIA"},
+		{"declined in english", "Purchase declined: $45.90 at CORNER SHOP"},
+		{"refund", "Estorno de R$ 45,90 em PADARIA no cartão final 1234"},
+		{"no amount", "Seu cartão final 1234 foi bloqueado"},
+		{"zero amount", "Compra aprovada: R$ 0,00 em TESTE"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := parseCardAlert(tt.text)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestAlertCard(t *testing.T) {
+	newCard := func(digits string) *entity.CreditCard {
+		card, err := entity.NewCreditCard(uuid.New(), "Card "+digits, digits, valueobject.NewMoney(1000, "BRL"), 10)
+		require.NoError(t, err)
+		return card
+	}
+	visa, master, twin := newCard("1234"), newCard("5678"), newCard("5678")
+
+	assert.Equal(t, visa, alertCard([]*entity.CreditCard{visa, master}, "1234"))
+	assert.Nil(t, alertCard([]*entity.CreditCard{visa, master}, "9999"))
+	assert.Nil(t, alertCard([]*entity.CreditCard{visa, master, twin}, "5678"), "two cards with the same digits")
+	assert.Equal(t, visa, alertCard([]*entity.CreditCard{visa}, ""), "the only card")
+	assert.Nil(t, alertCard([]*entity.CreditCard{visa, master}, ""))
+	assert.Nil(t, alertCard(nil, ""))
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+)
+
+type CategoryAppearanceUseCase struct {
+	appearanceRepo repository.CategoryAppearanceRepository
+}
+
+func NewCategoryAppearanceUseCase(appearanceRepo repository.CategoryAppearanceRepository) *CategoryAppearanceUseCase {
+	return &CategoryAppearanceUseCase{
+		appearanceRepo: appearanceRepo,
+	}
+}
+
+// ListAppearances returns the customized categories; the others keep their default look
+func (uc *CategoryAppearanceUseCase) ListAppearances(ctx context.Context) ([]*entity.CategoryAppearance, error) {
+	return uc.appearanceRepo.FindAll(ctx)
+}
+
+func (uc *CategoryAppearanceUseCase) SaveAppearance(ctx context.Context, category entity.TransactionCategory, icon, color string) (*entity.CategoryAppearance, error) {
+	appearance, err := entity.NewCategoryAppearance(category, icon, color)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.appearanceRepo.Save(ctx, appearance); err != nil {
+		return nil, fmt.Errorf("failed to save category appearance: %w", err)
+	}
+
+	return appearance, nil
+}
+
+// ResetAppearance drops the customization, bringing back the default icon and color
+func (uc *CategoryAppearanceUseCase) ResetAppearance(ctx context.Context, category entity.TransactionCategory) error {
+	return uc.appearanceRepo.Delete(ctx, category)
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// CategoryRuleUseCase manages the keyword rules that categorize transactions,
+// both the new ones and, on request, the ones already recorded
+type CategoryRuleUseCase struct {
+	ruleRepo           repository.CategoryRuleRepository
+	transactionRepo    repository.TransactionRepository
+	transactionUseCase *TransactionUseCase
+}
+
+func NewCategoryRuleUseCase(ruleRepo repository.CategoryRuleRepository, transactionRepo repository.TransactionRepository, transactionUseCase *TransactionUseCase) *CategoryRuleUseCase {
+	return &CategoryRuleUseCase{
+		ruleRepo:           ruleRepo,
+		transactionRepo:    transactionRepo,
+		transactionUseCase: transactionUseCase,
+	}
+}
+
+func (uc *CategoryRuleUseCase) CreateRule(ctx context.Context, keyword string, category entity.TransactionCategory) (*entity.CategoryRule, error) {
+	rule, err := entity.NewCategoryRule(keyword, category)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *CategoryRuleUseCase) ListRules(ctx context.Context) ([]*entity.CategoryRule, error) {
+	return uc.ruleRepo.FindAll(ctx)
+}
+
+func (uc *CategoryRuleUseCase) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return uc.ruleRepo.Delete(ctx, id)
+}
+
+// Categorize files each transaction under the category of the rule that
+// matches its description. Transfers keep their category, since it is what
+// keeps them out of income and expenses.
+func (uc *CategoryRuleUseCase) Categorize(ctx context.Context, transactions []*entity.Transaction) error {
+	rules, err := uc.ruleRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, txn := range transactions {
+		if txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+		if rule := entity.MatchCategoryRule(rules, txn.Description); rule != nil {
+			txn.SetCategory(rule.Category)
+		}
+	}
+	return nil
+}
+
+// PreviewRule lists the recorded transactions the rule would recategorize:
+// those matching it that are in another category. Transfers and the months
+// whose books are closed are left out.
+func (uc *CategoryRuleUseCase) PreviewRule(ctx context.Context, rule *entity.CategoryRule) ([]*entity.Transaction, error) {
+	transactions, err := uc.transactionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+
+	var matches []*entity.Transaction
+	for _, txn := range transactions {
+		if txn.Category != rule.Category && txn.Category != entity.TransactionCategoryTransfer && rule.Matches(txn.Description) &&
+			uc.transactionUseCase.checkOpen(ctx, txn.Date) == nil {
+			matches = append(matches, txn)
+		}
+	}
+	return matches, nil
+}
+
+// ApplyRule moves the given transactions, usually a batch of the preview, to
+// the category of the rule. Each one goes through the change history, so the
+// back-apply can be reviewed and undone one transaction at a time.
+func (uc *CategoryRuleUseCase) ApplyRule(ctx context.Context, rule *entity.CategoryRule, transactions []*entity.Transaction) error {
+	summary := fmt.Sprintf("Recategorized by the rule %q", rule.Keyword)
+	for _, txn := range transactions {
+		if _, err := uc.transactionUseCase.Recategorize(ctx, txn.ID, rule.Category, summary); err != nil {
+			return fmt.Errorf("failed to recategorize %q: %w", txn.Description, err)
+		}
+	}
+	return nil
+}
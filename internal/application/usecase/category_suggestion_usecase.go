@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+)
+
+// CategorySuggestionUseCase suggests the category of a new transaction from its
+// description, learning from the categories the user gave past transactions
+type CategorySuggestionUseCase struct {
+	classifierRepo  repository.CategoryClassifierRepository
+	transactionRepo repository.TransactionRepository
+
+	// mu serializes the load-update-save of the saved classifier
+	mu sync.Mutex
+}
+
+func NewCategorySuggestionUseCase(classifierRepo repository.CategoryClassifierRepository, transactionRepo repository.TransactionRepository) *CategorySuggestionUseCase {
+	return &CategorySuggestionUseCase{
+		classifierRepo:  classifierRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Classifier returns the saved classifier. The first time, it is trained on
+// every transaction recorded so far.
+func (uc *CategorySuggestionUseCase) Classifier(ctx context.Context) (*entity.CategoryClassifier, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	classifier, _, err := uc.classifier(ctx)
+	return classifier, err
+}
+
+// classifier loads the saved classifier, reporting whether it had to be
+// trained on the history just now
+func (uc *CategorySuggestionUseCase) classifier(ctx context.Context) (*entity.CategoryClassifier, bool, error) {
+	classifier, err := uc.classifierRepo.Get(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if classifier != nil {
+		return classifier, false, nil
+	}
+
+	transactions, err := uc.transactionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load transactions: %w", err)
+	}
+	classifier = entity.NewCategoryClassifier()
+	for _, txn := range transactions {
+		classifier.Learn(txn.Description, txn.Category)
+	}
+
+	if err := uc.classifierRepo.Save(ctx, classifier); err != nil {
+		return nil, false, err
+	}
+	return classifier, true, nil
+}
+
+// SuggestCategory returns the most likely category of description, if the
+// history says anything about it
+func (uc *CategorySuggestionUseCase) SuggestCategory(ctx context.Context, description string) (entity.CategorySuggestion, bool, error) {
+	classifier, err := uc.Classifier(ctx)
+	if err != nil {
+		return entity.CategorySuggestion{}, false, err
+	}
+	suggestion, ok := classifier.Suggest(description)
+	return suggestion, ok, nil
+}
+
+// Learn records the category a saved transaction was given. previous is the
+// transaction as it was before an edit, whose example is taken back, or nil
+// for a new one.
+func (uc *CategorySuggestionUseCase) Learn(ctx context.Context, previous, transaction *entity.Transaction) error {
+	if previous != nil && previous.Description == transaction.Description && previous.Category == transaction.Category {
+		return nil
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	classifier, trained, err := uc.classifier(ctx)
+	if err != nil {
+		return err
+	}
+	// Training read the transaction as it is now saved
+	if trained {
+		return nil
+	}
+	if previous != nil {
+		classifier.Forget(previous.Description, previous.Category)
+	}
+	classifier.Learn(transaction.Description, transaction.Category)
+
+	return uc.classifierRepo.Save(ctx, classifier)
+}
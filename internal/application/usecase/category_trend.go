@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+)
+
+// categoryAverageMonths is how many months before the one reported each
+// category's spending is averaged over
+const categoryAverageMonths = 6
+
+// categoryTrendThreshold is how far, in percent, a month's spending can stray
+// from the average before it counts as going up or down
+const categoryTrendThreshold = 10
+
+// CategoryTrend compares a category's spending in a month with its average
+// over the months before
+type CategoryTrend struct {
+	Category entity.TransactionCategory
+	Current  float64
+	// Average counts the months without spending as zero
+	Average float64
+}
+
+// Change is the change in percent of the month's spending over the average.
+// There is none when nothing was spent in the months before.
+func (t CategoryTrend) Change() (float64, bool) {
+	return percentChange(t.Average, t.Current)
+}
+
+// Direction is 1 when the month's spending is above the average by more than
+// the threshold, -1 when below it by more, and 0 otherwise
+func (t CategoryTrend) Direction() int {
+	change, ok := t.Change()
+	switch {
+	case !ok && t.Current > 0:
+		return 1
+	case change > categoryTrendThreshold:
+		return 1
+	case change < -categoryTrendThreshold:
+		return -1
+	}
+	return 0
+}
+
+// GetCategoryTrends compares each category's spending in the month of month
+// with its average over the 6 months before. It's computed from the per-month
+// totals of the database, so unlike the period reports it doesn't leave out
+// the transactions ignored from budget, nor add the archived ones.
+func (uc *ReportUseCase) GetCategoryTrends(ctx context.Context, month time.Time) (map[entity.TransactionCategory]CategoryTrend, error) {
+	month = entity.PeriodMonth(month)
+	key := fmt.Sprintf("category-trend:%s", month.Format("2006-01"))
+	trends, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeCategoryTrends(ctx, month)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trends.(map[entity.TransactionCategory]CategoryTrend), nil
+}
+
+func (uc *ReportUseCase) computeCategoryTrends(ctx context.Context, month time.Time) (map[entity.TransactionCategory]CategoryTrend, error) {
+	totals, err := uc.transactionRepo.SumByCategoryAndMonth(ctx, entity.TransactionTypeDebit, month.AddDate(0, -categoryAverageMonths, 0), month.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category totals: %w", err)
+	}
+
+	trends := make(map[entity.TransactionCategory]CategoryTrend)
+	for _, total := range totals {
+		trend := trends[total.Category]
+		trend.Category = total.Category
+		if total.Month.Equal(month) {
+			trend.Current += total.Total
+		} else {
+			trend.Average += total.Total / categoryAverageMonths
+		}
+		trends[total.Category] = trend
+	}
+	return trends, nil
+}
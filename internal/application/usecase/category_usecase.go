@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// CategoryUseCase manages the categories the user adds next to the built-in
+// ones
+type CategoryUseCase struct {
+	categoryRepo    repository.CategoryRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewCategoryUseCase(categoryRepo repository.CategoryRepository, transactionRepo repository.TransactionRepository) *CategoryUseCase {
+	return &CategoryUseCase{
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// ListCategories returns the categories added by the user, oldest first
+func (uc *CategoryUseCase) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return uc.categoryRepo.FindAll(ctx)
+}
+
+func (uc *CategoryUseCase) CreateCategory(ctx context.Context, name, icon string, kind entity.CategoryKind) (*entity.Category, error) {
+	category, err := entity.NewCategory(name, icon, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	if category.Key.IsBuiltIn() {
+		return nil, fmt.Errorf("%s is a built-in category", category.Name)
+	}
+	for _, existing := range categories {
+		if existing.Key == category.Key {
+			return nil, fmt.Errorf("a category like %s already exists: %s", category.Name, existing.Name)
+		}
+	}
+
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (uc *CategoryUseCase) UpdateCategory(ctx context.Context, id uuid.UUID, name, icon string, kind entity.CategoryKind) (*entity.Category, error) {
+	category, err := uc.findCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := category.Update(name, icon, kind, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Update(ctx, category); err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return category, nil
+}
+
+// DeleteCategory removes a category no transaction is filed under anymore
+func (uc *CategoryUseCase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	category, err := uc.findCategory(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	transactions, err := uc.transactionRepo.FindByCategory(ctx, category.Key)
+	if err != nil {
+		return fmt.Errorf("failed to get transactions: %w", err)
+	}
+	if len(transactions) > 0 {
+		return fmt.Errorf("%s still has %d transactions: recategorize them before deleting it", category.Name, len(transactions))
+	}
+
+	return uc.categoryRepo.Delete(ctx, id)
+}
+
+func (uc *CategoryUseCase) findCategory(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	categories, err := uc.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	for _, category := range categories {
+		if category.ID == id {
+			return category, nil
+		}
+	}
+	return nil, fmt.Errorf("category not found")
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type ChangeHistoryUseCase struct {
+	changeRepo      repository.ChangeRecordRepository
+	transactionRepo repository.TransactionRepository
+	billRepo        repository.BillRepository
+	periods         *PeriodLockUseCase
+}
+
+func NewChangeHistoryUseCase(
+	changeRepo repository.ChangeRecordRepository,
+	transactionRepo repository.TransactionRepository,
+	billRepo repository.BillRepository,
+) *ChangeHistoryUseCase {
+	return &ChangeHistoryUseCase{
+		changeRepo:      changeRepo,
+		transactionRepo: transactionRepo,
+		billRepo:        billRepo,
+	}
+}
+
+// SetPeriodLocks keeps reverts from changing transactions in months whose
+// books are closed
+func (uc *ChangeHistoryUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// RecordChange stores the fields that differ between two snapshots of an entity.
+// Nothing is stored when the snapshots are equal.
+func (uc *ChangeHistoryUseCase) RecordChange(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID, summary string, before, after entity.Snapshot) error {
+	record := entity.NewChangeRecord(entityType, entityID, summary, before, after)
+	if record == nil {
+		return nil
+	}
+
+	if err := uc.changeRepo.Create(ctx, record); err != nil {
+		return fmt.Errorf("failed to record change: %w", err)
+	}
+	return nil
+}
+
+// GetHistory lists an entity's changes, most recent first
+func (uc *ChangeHistoryUseCase) GetHistory(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID) ([]*entity.ChangeRecord, error) {
+	return uc.changeRepo.FindByEntity(ctx, entityType, entityID)
+}
+
+// RevertChange puts the fields touched by a change back to their previous values.
+// The revert is itself recorded, so it shows up in the history and can be undone.
+func (uc *ChangeHistoryUseCase) RevertChange(ctx context.Context, recordID uuid.UUID) error {
+	record, err := uc.changeRepo.FindByID(ctx, recordID)
+	if err != nil {
+		return err
+	}
+	if record.IsReverted() {
+		return fmt.Errorf("change was already reverted")
+	}
+
+	var before, after entity.Snapshot
+	switch record.EntityType {
+	case entity.ChangeEntityTransaction:
+		transaction, err := uc.transactionRepo.FindByID(ctx, record.EntityID)
+		if err != nil {
+			return fmt.Errorf("transaction not found: %w", err)
+		}
+		before = transaction.Snapshot()
+		date := transaction.Date
+		if err := transaction.Restore(record.RevertSnapshot()); err != nil {
+			return err
+		}
+		if uc.periods != nil {
+			// Both the month it's in and the one it goes back to
+			if err := uc.periods.CheckOpen(ctx, date, transaction.Date); err != nil {
+				return err
+			}
+		}
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+		after = transaction.Snapshot()
+	case entity.ChangeEntityBill:
+		bill, err := uc.billRepo.FindByID(ctx, record.EntityID)
+		if err != nil {
+			return fmt.Errorf("bill not found: %w", err)
+		}
+		before = bill.Snapshot()
+		if err := bill.Restore(record.RevertSnapshot()); err != nil {
+			return err
+		}
+		if err := uc.billRepo.Update(ctx, bill); err != nil {
+			return fmt.Errorf("failed to update bill: %w", err)
+		}
+		after = bill.Snapshot()
+	default:
+		return fmt.Errorf("unsupported entity type: %s", record.EntityType)
+	}
+
+	if err := record.MarkReverted(time.Now()); err != nil {
+		return err
+	}
+	if err := uc.changeRepo.Update(ctx, record); err != nil {
+		return fmt.Errorf("failed to update change record: %w", err)
+	}
+
+	return uc.RecordChange(ctx, record.EntityType, record.EntityID, "Reverted: "+record.Summary, before, after)
+}
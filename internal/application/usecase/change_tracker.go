@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// ChangeTracker records when transactions last changed, so results derived from
+// them, like reports, can tell whether they are stale
+type ChangeTracker struct {
+	mu         sync.RWMutex
+	lastChange time.Time
+}
+
+func NewChangeTracker() *ChangeTracker {
+	return &ChangeTracker{lastChange: time.Now()}
+}
+
+// MarkChanged records a mutation happening now
+func (t *ChangeTracker) MarkChanged() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	// Keep versions strictly increasing even on coarse clocks
+	if !now.After(t.lastChange) {
+		now = t.lastChange.Add(time.Nanosecond)
+	}
+	t.lastChange = now
+}
+
+// LastChange returns the time of the latest mutation
+func (t *ChangeTracker) LastChange() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lastChange
+}
+
+// TrackTransactionChanges wraps a transaction repository so every successful write
+// marks the tracker, whichever use case it comes from
+func TrackTransactionChanges(repo repository.TransactionRepository, tracker *ChangeTracker) repository.TransactionRepository {
+	return &trackedTransactionRepository{TransactionRepository: repo, tracker: tracker}
+}
+
+type trackedTransactionRepository struct {
+	repository.TransactionRepository
+	tracker *ChangeTracker
+}
+
+func (r *trackedTransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	return r.track(r.TransactionRepository.Create(ctx, transaction))
+}
+
+func (r *trackedTransactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	return r.track(r.TransactionRepository.CreateMany(ctx, transactions))
+}
+
+func (r *trackedTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	return r.track(r.TransactionRepository.Update(ctx, transaction))
+}
+
+func (r *trackedTransactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.track(r.TransactionRepository.Delete(ctx, id))
+}
+
+func (r *trackedTransactionRepository) track(err error) error {
+	if err == nil {
+		r.tracker.MarkChanged()
+	}
+	return err
+}
+
+// reportCache keeps computed reports keyed by period, valid only while the
+// transactions haven't changed since they were computed
+type reportCache struct {
+	mu      sync.Mutex
+	entries map[string]reportCacheEntry
+}
+
+type reportCacheEntry struct {
+	version time.Time
+	value   interface{}
+}
+
+func newReportCache() *reportCache {
+	return &reportCache{entries: make(map[string]reportCacheEntry)}
+}
+
+func (c *reportCache) get(key string, version time.Time) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !entry.version.Equal(version) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *reportCache) put(key string, version time.Time, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Entries from older versions can never be hit again
+	for k, entry := range c.entries {
+		if !entry.version.Equal(version) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = reportCacheEntry{version: version, value: value}
+}
@@ -14,6 +14,10 @@ import (
 type CreditCardInvoiceUseCase struct {
 	invoiceRepo    repository.CreditCardInvoiceRepository
 	creditCardRepo repository.CreditCardRepository
+
+	// transactionRepo shares the charges of closed invoices on cards with a
+	// standing split; without it invoices close unsplit
+	transactionRepo repository.TransactionRepository
 }
 
 func NewCreditCardInvoiceUseCase(invoiceRepo repository.CreditCardInvoiceRepository, creditCardRepo repository.CreditCardRepository) *CreditCardInvoiceUseCase {
@@ -23,6 +27,12 @@ func NewCreditCardInvoiceUseCase(invoiceRepo repository.CreditCardInvoiceReposit
 	}
 }
 
+// SetInvoiceSplits enables the standing splits of cards, sharing the charges
+// of each invoice with the card's partner when it closes
+func (uc *CreditCardInvoiceUseCase) SetInvoiceSplits(transactionRepo repository.TransactionRepository) {
+	uc.transactionRepo = transactionRepo
+}
+
 // CreateInvoice creates a new invoice for a credit card
 func (uc *CreditCardInvoiceUseCase) CreateInvoice(ctx context.Context, creditCardID uuid.UUID, referenceMonth string, openingDate, closingDate, dueDate time.Time) (*entity.CreditCardInvoice, error) {
 	// Verify credit card exists
@@ -31,9 +41,15 @@ func (uc *CreditCardInvoiceUseCase) CreateInvoice(ctx context.Context, creditCar
 		return nil, fmt.Errorf("credit card not found: %w", err)
 	}
 
+	// Looked up in the card's invoices rather than by month, where not finding
+	// one can't be told apart from failing to look
+	invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, creditCardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoices: %w", err)
+	}
+
 	// Check if invoice already exists for this month
-	existing, _ := uc.invoiceRepo.FindByMonth(ctx, creditCardID, referenceMonth)
-	if existing != nil {
+	if findInvoice(invoices, referenceMonth) != nil {
 		return nil, fmt.Errorf("invoice already exists for %s", referenceMonth)
 	}
 
@@ -41,14 +57,10 @@ func (uc *CreditCardInvoiceUseCase) CreateInvoice(ctx context.Context, creditCar
 	previousBalance := valueobject.NewMoney(0, card.CreditLimit.Currency())
 
 	// Find the most recent closed invoice
-	invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, creditCardID)
-	if err == nil && len(invoices) > 0 {
-		// Sort to find the most recent closed invoice
-		for i := len(invoices) - 1; i >= 0; i-- {
-			if invoices[i].IsClosed() && invoices[i].ReferenceMonth < referenceMonth {
-				previousBalance = invoices[i].ClosingBalance
-				break
-			}
+	for i := len(invoices) - 1; i >= 0; i-- {
+		if invoices[i].IsClosed() && invoices[i].ReferenceMonth < referenceMonth {
+			previousBalance = invoices[i].ClosingBalance
+			break
 		}
 	}
 
@@ -92,28 +104,34 @@ func (uc *CreditCardInvoiceUseCase) GetCurrentInvoice(ctx context.Context, credi
 	return uc.CreateInvoice(ctx, creditCardID, referenceMonth, openingDate, closingDate, dueDate)
 }
 
-// CloseInvoice closes an invoice and optionally creates the next month's invoice
+// CloseInvoice closes an invoice, computes its minimum payment and optionally creates the next month's invoice
 func (uc *CreditCardInvoiceUseCase) CloseInvoice(ctx context.Context, invoiceID uuid.UUID, createNext bool) error {
 	invoice, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
 	if err != nil {
 		return err
 	}
 
+	card, err := uc.creditCardRepo.FindByID(ctx, invoice.CreditCardID)
+	if err != nil {
+		return fmt.Errorf("credit card not found: %w", err)
+	}
+
 	if err := invoice.Close(); err != nil {
 		return err
 	}
 
+	invoice.CalculateMinimumPayment(card.MinimumPaymentPercentage)
+
 	if err := uc.invoiceRepo.Update(ctx, invoice); err != nil {
 		return fmt.Errorf("failed to close invoice: %w", err)
 	}
 
+	if err := uc.splitInvoice(ctx, card, invoice); err != nil {
+		return fmt.Errorf("failed to split invoice: %w", err)
+	}
+
 	// Create next month's invoice if requested
 	if createNext {
-		card, err := uc.creditCardRepo.FindByID(ctx, invoice.CreditCardID)
-		if err != nil {
-			return fmt.Errorf("credit card not found: %w", err)
-		}
-
 		// Parse current invoice month
 		t, _ := time.Parse("2006-01", invoice.ReferenceMonth)
 		nextMonth := t.AddDate(0, 1, 0)
@@ -133,11 +151,76 @@ func (uc *CreditCardInvoiceUseCase) CloseInvoice(ctx context.Context, invoiceID
 	return nil
 }
 
+// splitInvoice shares each charge of a closed invoice with the card's split
+// partner. Charges already shared with the partner are left alone, and so are
+// the ones whose shares would go over 100%.
+func (uc *CreditCardInvoiceUseCase) splitInvoice(ctx context.Context, card *entity.CreditCard, invoice *entity.CreditCardInvoice) error {
+	split := card.InvoiceSplit
+	if split == nil || uc.transactionRepo == nil {
+		return nil
+	}
+
+	transactions, err := uc.transactionRepo.FindByCreditCardInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get invoice transactions: %w", err)
+	}
+
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.Category == entity.TransactionCategoryTransfer || txn.IsSharedWith(split.PersonID) {
+			continue
+		}
+		if err := txn.AddSharedExpense(split.PersonID, split.Percentage); err != nil {
+			continue
+		}
+		if err := uc.transactionRepo.Update(ctx, txn); err != nil {
+			return fmt.Errorf("failed to share %q: %w", txn.Description, err)
+		}
+	}
+
+	return nil
+}
+
 // ListInvoicesByCard lists all invoices for a credit card
 func (uc *CreditCardInvoiceUseCase) ListInvoicesByCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error) {
 	return uc.invoiceRepo.FindByCreditCard(ctx, creditCardID)
 }
 
+// OpenInvoice is a card's open invoice as it stands. Invoice is nil when the
+// card has none open yet.
+type OpenInvoice struct {
+	Card    *entity.CreditCard
+	Invoice *entity.CreditCardInvoice
+}
+
+// GetOpenInvoices returns the open invoice of every card, without opening
+// the missing ones
+func (uc *CreditCardInvoiceUseCase) GetOpenInvoices(ctx context.Context) ([]OpenInvoice, error) {
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit cards: %w", err)
+	}
+
+	open := make([]OpenInvoice, 0, len(cards))
+	for _, card := range cards {
+		invoices, err := uc.invoiceRepo.FindByStatus(ctx, card.ID, entity.InvoiceStatusOpen)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open invoice of %s: %w", card.Name, err)
+		}
+
+		summary := OpenInvoice{Card: card}
+		if len(invoices) > 0 {
+			summary.Invoice = invoices[0]
+		}
+		open = append(open, summary)
+	}
+	return open, nil
+}
+
+// GetInvoicesByDateRange lists the card's invoices opened between startDate and endDate
+func (uc *CreditCardInvoiceUseCase) GetInvoicesByDateRange(ctx context.Context, creditCardID uuid.UUID, startDate, endDate time.Time) ([]*entity.CreditCardInvoice, error) {
+	return uc.invoiceRepo.FindByDateRange(ctx, creditCardID, startDate, endDate)
+}
+
 // GetInvoiceByID gets a specific invoice
 func (uc *CreditCardInvoiceUseCase) GetInvoiceByID(ctx context.Context, invoiceID uuid.UUID) (*entity.CreditCardInvoice, error) {
 	return uc.invoiceRepo.FindByID(ctx, invoiceID)
@@ -184,7 +267,7 @@ func (uc *CreditCardInvoiceUseCase) ProcessPayment(ctx context.Context, invoiceI
 	transactionID := uuid.New() // This would normally come from the transaction creation
 	money := valueobject.NewMoney(amount, currency)
 
-	if err := invoice.AddTransaction(transactionID, money, true); err != nil {
+	if err := invoice.RegisterPayment(transactionID, money, time.Now()); err != nil {
 		return err
 	}
 
@@ -198,6 +281,24 @@ func (uc *CreditCardInvoiceUseCase) ProcessPayment(ctx context.Context, invoiceI
 	return uc.invoiceRepo.Update(ctx, invoice)
 }
 
+// GetInvoicesWithMissedMinimum lists the card's invoices whose minimum payment wasn't paid by the due date
+func (uc *CreditCardInvoiceUseCase) GetInvoicesWithMissedMinimum(ctx context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error) {
+	invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, creditCardID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var missed []*entity.CreditCardInvoice
+	for _, invoice := range invoices {
+		if invoice.MissedMinimumPayment(now) {
+			missed = append(missed, invoice)
+		}
+	}
+
+	return missed, nil
+}
+
 // GetInvoicesByStatus gets all invoices with a specific status for a credit card
 func (uc *CreditCardInvoiceUseCase) GetInvoicesByStatus(ctx context.Context, creditCardID uuid.UUID, status entity.InvoiceStatus) ([]*entity.CreditCardInvoice, error) {
 	return uc.invoiceRepo.FindByStatus(ctx, creditCardID, status)
@@ -213,7 +314,13 @@ func (uc *CreditCardInvoiceUseCase) UpdateOverdueInvoices(ctx context.Context, c
 
 	now := time.Now()
 	for _, invoice := range closedInvoices {
-		if now.After(invoice.DueDate) && !invoice.ClosingBalance.IsZero() && !invoice.ClosingBalance.IsNegative() {
+		// Paying at least the minimum keeps the invoice current; the remainder rolls over
+		overdue := invoice.MissedMinimumPayment(now)
+		if invoice.MinimumPayment.IsZero() {
+			overdue = now.After(invoice.DueDate) && !invoice.ClosingBalance.IsZero() && !invoice.ClosingBalance.IsNegative()
+		}
+
+		if overdue {
 			invoice.Status = entity.InvoiceStatusOverdue
 			if err := uc.invoiceRepo.Update(ctx, invoice); err != nil {
 				return err
@@ -223,3 +330,73 @@ func (uc *CreditCardInvoiceUseCase) UpdateOverdueInvoices(ctx context.Context, c
 
 	return nil
 }
+
+// CloseDueInvoices closes the open invoices whose closing day is over, opening
+// the next month's invoice of the card when it doesn't exist yet, and flags the
+// closed invoices whose due date went by as overdue. Cards left untouched for a
+// while catch up one month at a time. It returns the invoices it closed.
+func (uc *CreditCardInvoiceUseCase) CloseDueInvoices(ctx context.Context, now time.Time) ([]*entity.CreditCardInvoice, error) {
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit cards: %w", err)
+	}
+
+	var closed []*entity.CreditCardInvoice
+	for _, card := range cards {
+		for {
+			invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+			if err != nil {
+				return closed, fmt.Errorf("failed to get the invoices of %s: %w", card.Name, err)
+			}
+
+			// Cards without an open invoice get one the next time they are used
+			invoice := earliestOpenInvoice(invoices)
+			if invoice == nil || now.Before(invoice.ClosingDate.AddDate(0, 0, 1)) {
+				break
+			}
+
+			t, err := time.Parse("2006-01", invoice.ReferenceMonth)
+			if err != nil {
+				return closed, fmt.Errorf("invalid reference month %q of a %s invoice: %w", invoice.ReferenceMonth, card.Name, err)
+			}
+			next := findInvoice(invoices, t.AddDate(0, 1, 0).Format("2006-01"))
+			if err := uc.CloseInvoice(ctx, invoice.ID, next == nil); err != nil {
+				return closed, fmt.Errorf("failed to close the %s invoice of %s: %w", invoice.ReferenceMonth, card.Name, err)
+			}
+
+			invoice, err = uc.invoiceRepo.FindByID(ctx, invoice.ID)
+			if err != nil {
+				return closed, err
+			}
+			closed = append(closed, invoice)
+		}
+
+		if err := uc.UpdateOverdueInvoices(ctx, card.ID); err != nil {
+			return closed, fmt.Errorf("failed to update overdue invoices of %s: %w", card.Name, err)
+		}
+	}
+
+	return closed, nil
+}
+
+// findInvoice returns the invoice of referenceMonth among invoices, or nil
+func findInvoice(invoices []*entity.CreditCardInvoice, referenceMonth string) *entity.CreditCardInvoice {
+	for _, invoice := range invoices {
+		if invoice.ReferenceMonth == referenceMonth {
+			return invoice
+		}
+	}
+	return nil
+}
+
+// earliestOpenInvoice returns the open invoice of the earliest month among
+// invoices, or nil when none is open
+func earliestOpenInvoice(invoices []*entity.CreditCardInvoice) *entity.CreditCardInvoice {
+	var earliest *entity.CreditCardInvoice
+	for _, invoice := range invoices {
+		if invoice.IsOpen() && (earliest == nil || invoice.ReferenceMonth < earliest.ReferenceMonth) {
+			earliest = invoice
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditCardInvoiceUseCase_CloseDueInvoices(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, time.April, 5, 0, 0, 0, 0, time.UTC)
+
+	newInvoice := func(t *testing.T, cardID uuid.UUID, month time.Month) *entity.CreditCardInvoice {
+		opening := time.Date(2020, month, 1, 0, 0, 0, 0, time.UTC)
+		invoice, err := entity.NewCreditCardInvoice(cardID, opening.Format("2006-01"), opening, opening.AddDate(0, 1, -1), opening.AddDate(0, 1, 9), valueobject.NewMoney(0, "BRL"))
+		require.NoError(t, err)
+		return invoice
+	}
+
+	tests := []struct {
+		name         string
+		months       []time.Month
+		findErr      error
+		wantErr      string
+		wantClosed   []string
+		wantInvoices int
+	}{
+		{
+			name:         "closes the due invoice and opens the next",
+			months:       []time.Month{time.March},
+			wantClosed:   []string{"2020-03"},
+			wantInvoices: 2,
+		},
+		{
+			name:         "keeps the next invoice already there",
+			months:       []time.Month{time.March, time.April},
+			wantClosed:   []string{"2020-03"},
+			wantInvoices: 2,
+		},
+		{
+			name:         "catches up one month at a time",
+			months:       []time.Month{time.February},
+			wantClosed:   []string{"2020-02", "2020-03"},
+			wantInvoices: 3,
+		},
+		{
+			name:         "failing to look invoices up is an error",
+			months:       []time.Month{time.March},
+			findErr:      errors.New("disk full"),
+			wantErr:      "failed to get the invoices of Card: disk full",
+			wantInvoices: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card, err := entity.NewCreditCard(uuid.New(), "Card", "1234", valueobject.NewMoney(1000, "BRL"), 10)
+			require.NoError(t, err)
+			invoices := newFakeInvoiceRepo()
+			for _, month := range tt.months {
+				invoice := newInvoice(t, card.ID, month)
+				invoices.items.put(invoice.ID, invoice)
+			}
+			invoices.findErr = tt.findErr
+
+			uc := NewCreditCardInvoiceUseCase(invoices, newFakeCreditCardRepo(card))
+			closed, err := uc.CloseDueInvoices(ctx, now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var months []string
+			for _, invoice := range closed {
+				months = append(months, invoice.ReferenceMonth)
+			}
+			assert.Equal(t, tt.wantClosed, months)
+			assert.Len(t, invoices.items, tt.wantInvoices)
+		})
+	}
+}
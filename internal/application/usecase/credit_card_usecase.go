@@ -13,16 +13,18 @@ import (
 type CreditCardUseCase struct {
 	creditCardRepo repository.CreditCardRepository
 	accountRepo    repository.AccountRepository
+	invoiceRepo    repository.CreditCardInvoiceRepository
 }
 
-func NewCreditCardUseCase(creditCardRepo repository.CreditCardRepository, accountRepo repository.AccountRepository) *CreditCardUseCase {
+func NewCreditCardUseCase(creditCardRepo repository.CreditCardRepository, accountRepo repository.AccountRepository, invoiceRepo repository.CreditCardInvoiceRepository) *CreditCardUseCase {
 	return &CreditCardUseCase{
 		creditCardRepo: creditCardRepo,
 		accountRepo:    accountRepo,
+		invoiceRepo:    invoiceRepo,
 	}
 }
 
-func (uc *CreditCardUseCase) CreateCreditCard(ctx context.Context, accountID uuid.UUID, name, lastFourDigits string, creditLimit float64, currency string, dueDay int) (*entity.CreditCard, error) {
+func (uc *CreditCardUseCase) CreateCreditCard(ctx context.Context, accountID uuid.UUID, name, lastFourDigits string, creditLimit float64, currency string, dueDay int, minimumPaymentPercentage float64) (*entity.CreditCard, error) {
 	// Verify account exists
 	_, err := uc.accountRepo.FindByID(ctx, accountID)
 	if err != nil {
@@ -35,6 +37,12 @@ func (uc *CreditCardUseCase) CreateCreditCard(ctx context.Context, accountID uui
 		return nil, err
 	}
 
+	if minimumPaymentPercentage > 0 {
+		if err := card.SetMinimumPaymentPercentage(minimumPaymentPercentage); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := uc.creditCardRepo.Create(ctx, card); err != nil {
 		return nil, fmt.Errorf("failed to create credit card: %w", err)
 	}
@@ -42,6 +50,61 @@ func (uc *CreditCardUseCase) CreateCreditCard(ctx context.Context, accountID uui
 	return card, nil
 }
 
+func (uc *CreditCardUseCase) UpdateCreditCard(ctx context.Context, id, accountID uuid.UUID, name, lastFourDigits string, creditLimit float64, dueDay int, minimumPaymentPercentage float64) (*entity.CreditCard, error) {
+	card, err := uc.creditCardRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("credit card not found: %w", err)
+	}
+
+	if _, err := uc.accountRepo.FindByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+
+	limit := valueobject.NewMoney(creditLimit, card.CreditLimit.Currency())
+	if err := card.Update(accountID, name, lastFourDigits, limit, dueDay); err != nil {
+		return nil, err
+	}
+
+	if err := card.SetMinimumPaymentPercentage(minimumPaymentPercentage); err != nil {
+		return nil, err
+	}
+
+	if err := uc.creditCardRepo.Update(ctx, card); err != nil {
+		return nil, fmt.Errorf("failed to update credit card: %w", err)
+	}
+
+	return card, nil
+}
+
+// DeleteCreditCard removes a card that owes nothing: its balance must be zero
+// and every invoice settled. Paid invoices are kept with their transactions.
+func (uc *CreditCardUseCase) DeleteCreditCard(ctx context.Context, id uuid.UUID) error {
+	card, err := uc.creditCardRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("credit card not found: %w", err)
+	}
+
+	if !card.CurrentBalance.IsZero() && !card.CurrentBalance.IsNegative() {
+		return fmt.Errorf("%s still has a balance of %s; pay it off before deleting the card", card.Name, card.CurrentBalance.String())
+	}
+
+	invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get invoices: %w", err)
+	}
+	for _, invoice := range invoices {
+		if !invoice.IsSettled() {
+			return fmt.Errorf("the %s invoice of %s is not settled; pay it before deleting the card", invoice.ReferenceMonth, card.Name)
+		}
+	}
+
+	if err := uc.creditCardRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete credit card: %w", err)
+	}
+
+	return nil
+}
+
 func (uc *CreditCardUseCase) GetCreditCard(ctx context.Context, id uuid.UUID) (*entity.CreditCard, error) {
 	return uc.creditCardRepo.FindByID(ctx, id)
 }
@@ -54,6 +117,59 @@ func (uc *CreditCardUseCase) ListCreditCardsByAccount(ctx context.Context, accou
 	return uc.creditCardRepo.FindByAccountID(ctx, accountID)
 }
 
+func (uc *CreditCardUseCase) SetMinimumPaymentPercentage(ctx context.Context, cardID uuid.UUID, percentage float64) error {
+	card, err := uc.creditCardRepo.FindByID(ctx, cardID)
+	if err != nil {
+		return err
+	}
+
+	if err := card.SetMinimumPaymentPercentage(percentage); err != nil {
+		return err
+	}
+
+	return uc.creditCardRepo.Update(ctx, card)
+}
+
+func (uc *CreditCardUseCase) SetTransactionDefaults(ctx context.Context, cardID uuid.UUID, category entity.TransactionCategory, transactionType entity.TransactionType) error {
+	card, err := uc.creditCardRepo.FindByID(ctx, cardID)
+	if err != nil {
+		return err
+	}
+
+	card.SetTransactionDefaults(category, transactionType)
+
+	return uc.creditCardRepo.Update(ctx, card)
+}
+
+// SetInvoiceReminders picks where the payment reminders of the card's invoices go
+func (uc *CreditCardUseCase) SetInvoiceReminders(ctx context.Context, cardID uuid.UUID, reminders entity.InvoiceReminders) error {
+	card, err := uc.creditCardRepo.FindByID(ctx, cardID)
+	if err != nil {
+		return err
+	}
+
+	card.SetInvoiceReminders(reminders)
+
+	return uc.creditCardRepo.Update(ctx, card)
+}
+
+// SetInvoiceSplit shares percentage of the charges on each of the card's
+// invoices with the person as they close; a nil person stops splitting
+func (uc *CreditCardUseCase) SetInvoiceSplit(ctx context.Context, cardID uuid.UUID, personID *uuid.UUID, percentage float64) error {
+	card, err := uc.creditCardRepo.FindByID(ctx, cardID)
+	if err != nil {
+		return err
+	}
+
+	if personID == nil {
+		card.ClearInvoiceSplit()
+	} else if err := card.SetInvoiceSplit(*personID, percentage); err != nil {
+		return err
+	}
+
+	return uc.creditCardRepo.Update(ctx, card)
+}
+
 func (uc *CreditCardUseCase) ChargeCard(ctx context.Context, cardID uuid.UUID, amount float64, currency string) error {
 	card, err := uc.creditCardRepo.FindByID(ctx, cardID)
 	if err != nil {
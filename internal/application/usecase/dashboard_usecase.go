@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// BalancePoint is the total balance of the accounts at the end of a day
+type BalancePoint struct {
+	Date    time.Time
+	Balance float64
+}
+
+// BalanceTrend is the total balance day by day, oldest day first
+type BalanceTrend struct {
+	Days []BalancePoint
+	// Transactions is how many account transactions the period had. Without
+	// any, the balance is flat and there is no history to chart.
+	Transactions int
+}
+
+// CategorySpending is what one category took of the month's spending
+type CategorySpending struct {
+	Category entity.TransactionCategory
+	Total    float64
+	// Share of the month's spending, in percent
+	Share float64
+}
+
+// DashboardUseCase computes the figures the dashboard charts
+type DashboardUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewDashboardUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *DashboardUseCase {
+	return &DashboardUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// GetBalanceTrend reconstructs the total balance of the accounts at the end of
+// each of the last days days, today included, by taking back from the current
+// balances the transactions made since. Card and cash transactions don't move
+// an account, so they are left out.
+func (uc *DashboardUseCase) GetBalanceTrend(ctx context.Context, days int, now time.Time) (*BalanceTrend, error) {
+	if days < 1 {
+		return nil, fmt.Errorf("balance trend needs at least one day, got %d", days)
+	}
+
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load accounts: %w", err)
+	}
+
+	balance := 0.0
+	accountIDs := make(map[uuid.UUID]bool, len(accounts))
+	for _, account := range accounts {
+		balance += account.Balance.Amount()
+		accountIDs[account.ID] = true
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	start := today.AddDate(0, 0, -(days - 1))
+	// Transactions dated in the future already moved the balances too
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, start, time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+
+	// changes[i] is how much the balance moved on the i-th day; the last slot
+	// collects everything after today
+	changes := make([]float64, days+1)
+	trend := &BalanceTrend{}
+	for _, txn := range transactions {
+		if txn.AccountID == nil || !accountIDs[*txn.AccountID] {
+			continue
+		}
+		amount := txn.Amount.Amount()
+		if txn.Type == entity.TransactionTypeDebit {
+			amount = -amount
+		}
+
+		index := days
+		if date := txn.Date.In(now.Location()); date.Before(today.AddDate(0, 0, 1)) {
+			index = int(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, now.Location()).Sub(start).Hours()+12) / 24
+			trend.Transactions++
+		}
+		if index < 0 {
+			index = 0
+		}
+		changes[index] += amount
+	}
+
+	// Walk back from today's closing balance
+	trend.Days = make([]BalancePoint, days)
+	balance -= changes[days]
+	for i := days - 1; i >= 0; i-- {
+		trend.Days[i] = BalancePoint{Date: start.AddDate(0, 0, i), Balance: balance}
+		balance -= changes[i]
+	}
+
+	return trend, nil
+}
+
+// GetTopSpendingCategories returns the limit categories the most was spent on
+// this month, largest first, summed by the storage rather than read one by one.
+// Transfers between the user's own accounts aren't spending.
+func (uc *DashboardUseCase) GetTopSpendingCategories(ctx context.Context, limit int, now time.Time) ([]CategorySpending, error) {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	totals, err := uc.transactionRepo.SumByCategory(ctx, entity.TransactionTypeDebit, start, start.AddDate(0, 1, 0).Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+
+	var spent float64
+	for _, total := range totals {
+		if total.Category != entity.TransactionCategoryTransfer {
+			spent += total.Total
+		}
+	}
+
+	var top []CategorySpending
+	for _, total := range totals {
+		if total.Category == entity.TransactionCategoryTransfer || total.Total <= 0 {
+			continue
+		}
+		if len(top) == limit {
+			break
+		}
+		top = append(top, CategorySpending{Category: total.Category, Total: total.Total, Share: total.Total / spent * 100})
+	}
+	return top, nil
+}
@@ -0,0 +1,755 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// The files of the plans and settings of a dataset
+const (
+	datasetCategoriesFile         = "categories.csv"
+	datasetCategoryRulesFile      = "category_rules.csv"
+	datasetBudgetsFile            = "budgets.csv"
+	datasetBudgetCarriesFile      = "budget_carries.csv"
+	datasetStandingOrdersFile     = "standing_orders.csv"
+	datasetSinkingFundsFile       = "sinking_funds.csv"
+	datasetEmergencyFundFile      = "emergency_fund.csv"
+	datasetGoalsFile              = "goals.csv"
+	datasetHoldingsFile           = "holdings.csv"
+	datasetWishlistFile           = "wishlist.csv"
+	datasetScheduledTransfersFile = "scheduled_transfers.csv"
+	datasetPeriodsFile            = "accounting_periods.csv"
+	datasetPeriodLogFile          = "period_log.csv"
+)
+
+var (
+	categoryColumns          = []string{"id", "key", "name", "icon", "kind", "created_at", "updated_at"}
+	categoryRuleColumns      = []string{"id", "keyword", "category", "created_at"}
+	budgetColumns            = []string{"id", "category", "monthly_limit", "currency", "rollover", "created_at", "updated_at"}
+	budgetCarryColumns       = []string{"budget_id", "month", "amount"}
+	standingOrderColumns     = []string{"id", "from_account_id", "to_account_id", "amount", "currency", "day_of_month", "description", "last_run_month", "created_at", "updated_at"}
+	sinkingFundColumns       = []string{"id", "name", "annual_amount", "currency", "due_month", "category", "keyword", "created_at", "updated_at"}
+	emergencyFundColumns     = []string{"essential_monthly", "currency", "target_months", "account_ids", "updated_at"}
+	goalColumns              = []string{"id", "name", "target_amount", "currency", "deadline", "account_id", "created_at", "updated_at"}
+	holdingColumns           = []string{"id", "account_id", "ticker", "quantity", "average_price", "last_price", "realized_profit", "currency", "created_at", "updated_at"}
+	wishlistColumns          = []string{"id", "name", "estimated_cost", "currency", "priority", "target_date", "category", "transaction_id", "purchased_at", "created_at", "updated_at"}
+	scheduledTransferColumns = []string{"id", "from_account_id", "to_account_id", "amount", "currency", "scheduled_for", "description", "status", "transferred_at", "created_at", "updated_at"}
+	periodColumns            = []string{"id", "month", "closed", "created_at", "updated_at"}
+	periodLogColumns         = []string{"period_id", "action", "reason", "at"}
+)
+
+// DatasetPlanning holds the repositories of the plans and settings that go
+// into a dataset next to the ledger. A nil repository leaves its records out.
+type DatasetPlanning struct {
+	Categories         repository.CategoryRepository
+	CategoryRules      repository.CategoryRuleRepository
+	Budgets            repository.BudgetRepository
+	StandingOrders     repository.StandingOrderRepository
+	SinkingFunds       repository.SinkingFundRepository
+	EmergencyFund      repository.EmergencyFundRepository
+	Goals              repository.GoalRepository
+	Holdings           repository.HoldingRepository
+	Wishlist           repository.WishlistRepository
+	ScheduledTransfers repository.ScheduledTransferRepository
+	Periods            repository.AccountingPeriodRepository
+}
+
+// SetPlanning makes the dataset cover the budgets, funds, goals and the other
+// plans and settings besides the ledger
+func (uc *DatasetExchangeUseCase) SetPlanning(planning DatasetPlanning) {
+	uc.planning = planning
+}
+
+// exportPlanning writes the files of the plans and settings to dir
+func (uc *DatasetExchangeUseCase) exportPlanning(ctx context.Context, dir string) error {
+	p := uc.planning
+
+	if p.Categories != nil {
+		categories, err := p.Categories.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get categories: %w", err)
+		}
+		rows := make([][]string, 0, len(categories))
+		for _, category := range categories {
+			rows = append(rows, []string{
+				category.ID.String(), string(category.Key), category.Name, category.Icon, string(category.Kind),
+				formatDatasetTime(category.CreatedAt), formatDatasetTime(category.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetCategoriesFile, categoryColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.CategoryRules != nil {
+		rules, err := p.CategoryRules.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get category rules: %w", err)
+		}
+		rows := make([][]string, 0, len(rules))
+		for _, rule := range rules {
+			rows = append(rows, []string{rule.ID.String(), rule.Keyword, string(rule.Category), formatDatasetTime(rule.CreatedAt)})
+		}
+		if err := writeDatasetFile(dir, datasetCategoryRulesFile, categoryRuleColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.Budgets != nil {
+		budgets, err := p.Budgets.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get budgets: %w", err)
+		}
+		rows := make([][]string, 0, len(budgets))
+		var carries [][]string
+		for _, budget := range budgets {
+			rows = append(rows, []string{
+				budget.ID.String(), string(budget.Category),
+				formatDatasetAmount(budget.MonthlyLimit.Amount()), budget.MonthlyLimit.Currency(), string(budget.Rollover),
+				formatDatasetTime(budget.CreatedAt), formatDatasetTime(budget.UpdatedAt),
+			})
+			for month, carry := range budget.Carried {
+				carries = append(carries, []string{budget.ID.String(), month, formatDatasetAmount(carry)})
+			}
+		}
+		if err := writeDatasetFile(dir, datasetBudgetsFile, budgetColumns, rows); err != nil {
+			return err
+		}
+		if err := writeDatasetFile(dir, datasetBudgetCarriesFile, budgetCarryColumns, carries); err != nil {
+			return err
+		}
+	}
+
+	if p.StandingOrders != nil {
+		orders, err := p.StandingOrders.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get standing orders: %w", err)
+		}
+		rows := make([][]string, 0, len(orders))
+		for _, order := range orders {
+			rows = append(rows, []string{
+				order.ID.String(), order.FromAccountID.String(), order.ToAccountID.String(),
+				formatDatasetAmount(order.Amount.Amount()), order.Amount.Currency(),
+				strconv.Itoa(order.DayOfMonth), order.Description, order.LastRunMonth,
+				formatDatasetTime(order.CreatedAt), formatDatasetTime(order.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetStandingOrdersFile, standingOrderColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.SinkingFunds != nil {
+		funds, err := p.SinkingFunds.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get sinking funds: %w", err)
+		}
+		rows := make([][]string, 0, len(funds))
+		for _, fund := range funds {
+			rows = append(rows, []string{
+				fund.ID.String(), fund.Name, formatDatasetAmount(fund.AnnualAmount.Amount()), fund.AnnualAmount.Currency(),
+				strconv.Itoa(int(fund.DueMonth)), string(fund.Category), fund.Keyword,
+				formatDatasetTime(fund.CreatedAt), formatDatasetTime(fund.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetSinkingFundsFile, sinkingFundColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.EmergencyFund != nil {
+		plan, err := p.EmergencyFund.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get emergency fund plan: %w", err)
+		}
+		var rows [][]string
+		if plan != nil {
+			accountIDs := make([]string, len(plan.AccountIDs))
+			for i, id := range plan.AccountIDs {
+				accountIDs[i] = id.String()
+			}
+			rows = append(rows, []string{
+				formatDatasetAmount(plan.EssentialMonthly.Amount()), plan.EssentialMonthly.Currency(),
+				strconv.Itoa(plan.TargetMonths), strings.Join(accountIDs, ","), formatDatasetTime(plan.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetEmergencyFundFile, emergencyFundColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.Goals != nil {
+		goals, err := p.Goals.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get goals: %w", err)
+		}
+		rows := make([][]string, 0, len(goals))
+		for _, goal := range goals {
+			rows = append(rows, []string{
+				goal.ID.String(), goal.Name, formatDatasetAmount(goal.TargetAmount.Amount()), goal.TargetAmount.Currency(),
+				formatDatasetTime(goal.Deadline), goal.AccountID.String(),
+				formatDatasetTime(goal.CreatedAt), formatDatasetTime(goal.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetGoalsFile, goalColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.Holdings != nil {
+		holdings, err := p.Holdings.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get holdings: %w", err)
+		}
+		rows := make([][]string, 0, len(holdings))
+		for _, holding := range holdings {
+			rows = append(rows, []string{
+				holding.ID.String(), holding.AccountID.String(), holding.Ticker,
+				strconv.FormatFloat(holding.Quantity, 'f', -1, 64),
+				formatDatasetAmount(holding.AveragePrice.Amount()), formatDatasetAmount(holding.LastPrice.Amount()),
+				formatDatasetAmount(holding.RealizedProfit.Amount()), holding.AveragePrice.Currency(),
+				formatDatasetTime(holding.CreatedAt), formatDatasetTime(holding.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetHoldingsFile, holdingColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.Wishlist != nil {
+		items, err := p.Wishlist.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get wishlist: %w", err)
+		}
+		rows := make([][]string, 0, len(items))
+		for _, item := range items {
+			rows = append(rows, []string{
+				item.ID.String(), item.Name, formatDatasetAmount(item.EstimatedCost.Amount()), item.EstimatedCost.Currency(),
+				string(item.Priority), formatDatasetTime(item.TargetDate), string(item.Category),
+				formatDatasetID(item.TransactionID), formatDatasetOptionalTime(item.PurchasedAt),
+				formatDatasetTime(item.CreatedAt), formatDatasetTime(item.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetWishlistFile, wishlistColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	// Only the pending transfers, the ones made are already transactions
+	if p.ScheduledTransfers != nil {
+		transfers, err := p.ScheduledTransfers.FindPending(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get scheduled transfers: %w", err)
+		}
+		rows := make([][]string, 0, len(transfers))
+		for _, transfer := range transfers {
+			rows = append(rows, []string{
+				transfer.ID.String(), transfer.FromAccountID.String(), transfer.ToAccountID.String(),
+				formatDatasetAmount(transfer.Amount.Amount()), transfer.Amount.Currency(),
+				formatDatasetTime(transfer.ScheduledFor), transfer.Description, string(transfer.Status),
+				formatDatasetOptionalTime(transfer.TransferredAt),
+				formatDatasetTime(transfer.CreatedAt), formatDatasetTime(transfer.UpdatedAt),
+			})
+		}
+		if err := writeDatasetFile(dir, datasetScheduledTransfersFile, scheduledTransferColumns, rows); err != nil {
+			return err
+		}
+	}
+
+	if p.Periods != nil {
+		periods, err := p.Periods.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get accounting periods: %w", err)
+		}
+		rows := make([][]string, 0, len(periods))
+		var log [][]string
+		for _, period := range periods {
+			rows = append(rows, []string{
+				period.ID.String(), period.Key(), strconv.FormatBool(period.Closed),
+				formatDatasetTime(period.CreatedAt), formatDatasetTime(period.UpdatedAt),
+			})
+			for _, entry := range period.Log {
+				log = append(log, []string{period.ID.String(), string(entry.Action), entry.Reason, formatDatasetTime(entry.At)})
+			}
+		}
+		if err := writeDatasetFile(dir, datasetPeriodsFile, periodColumns, rows); err != nil {
+			return err
+		}
+		if err := writeDatasetFile(dir, datasetPeriodLogFile, periodLogColumns, log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importSettings creates the categories and category rules, which the ledger
+// and the plans file their records under
+func (uc *DatasetExchangeUseCase) importSettings(ctx context.Context, dir string, result *DatasetImportResult) error {
+	p := uc.planning
+
+	if p.Categories != nil {
+		existing, err := p.Categories.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get categories: %w", err)
+		}
+		result.Categories, err = importDatasetRecords(ctx, dir, datasetCategoriesFile, existing, result,
+			func(category *entity.Category) uuid.UUID { return category.ID },
+			func(row datasetRow, id uuid.UUID) (category *entity.Category, err error) {
+				category = &entity.Category{ID: id}
+				category.Key = entity.TransactionCategory(row.get("key"))
+				category.Name = row.get("name")
+				category.Icon = row.get("icon")
+				category.Kind = entity.CategoryKind(row.get("kind"))
+				category.CreatedAt, category.UpdatedAt, err = row.timestamps()
+				return category, err
+			},
+			p.Categories.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.CategoryRules != nil {
+		existing, err := p.CategoryRules.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get category rules: %w", err)
+		}
+		result.CategoryRules, err = importDatasetRecords(ctx, dir, datasetCategoryRulesFile, existing, result,
+			func(rule *entity.CategoryRule) uuid.UUID { return rule.ID },
+			func(row datasetRow, id uuid.UUID) (rule *entity.CategoryRule, err error) {
+				rule = &entity.CategoryRule{ID: id}
+				rule.Keyword = row.get("keyword")
+				rule.Category = entity.TransactionCategory(row.get("category"))
+				rule.CreatedAt, _, err = row.timestamps()
+				return rule, err
+			},
+			p.CategoryRules.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importPlanning creates the plans, once the accounts and transactions they
+// refer to are in
+func (uc *DatasetExchangeUseCase) importPlanning(ctx context.Context, dir string, result *DatasetImportResult) error {
+	p := uc.planning
+
+	if p.Budgets != nil {
+		if err := uc.importBudgets(ctx, dir, result); err != nil {
+			return err
+		}
+	}
+
+	if p.StandingOrders != nil {
+		existing, err := p.StandingOrders.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get standing orders: %w", err)
+		}
+		result.StandingOrders, err = importDatasetRecords(ctx, dir, datasetStandingOrdersFile, existing, result,
+			func(order *entity.StandingOrder) uuid.UUID { return order.ID },
+			func(row datasetRow, id uuid.UUID) (order *entity.StandingOrder, err error) {
+				order = &entity.StandingOrder{ID: id}
+				if order.FromAccountID, err = row.id("from_account_id"); err != nil {
+					return nil, err
+				}
+				if order.ToAccountID, err = row.id("to_account_id"); err != nil {
+					return nil, err
+				}
+				if order.Amount, err = row.money("amount"); err != nil {
+					return nil, err
+				}
+				if order.DayOfMonth, err = strconv.Atoi(row.get("day_of_month")); err != nil {
+					return nil, row.errorf("invalid day_of_month %q", row.get("day_of_month"))
+				}
+				order.Description = row.get("description")
+				order.LastRunMonth = row.get("last_run_month")
+				order.CreatedAt, order.UpdatedAt, err = row.timestamps()
+				return order, err
+			},
+			p.StandingOrders.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.SinkingFunds != nil {
+		existing, err := p.SinkingFunds.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get sinking funds: %w", err)
+		}
+		result.SinkingFunds, err = importDatasetRecords(ctx, dir, datasetSinkingFundsFile, existing, result,
+			func(fund *entity.SinkingFund) uuid.UUID { return fund.ID },
+			func(row datasetRow, id uuid.UUID) (fund *entity.SinkingFund, err error) {
+				fund = &entity.SinkingFund{ID: id}
+				fund.Name = row.get("name")
+				if fund.AnnualAmount, err = row.money("annual_amount"); err != nil {
+					return nil, err
+				}
+				dueMonth, err := strconv.Atoi(row.get("due_month"))
+				if err != nil || dueMonth < 1 || dueMonth > 12 {
+					return nil, row.errorf("invalid due_month %q", row.get("due_month"))
+				}
+				fund.DueMonth = time.Month(dueMonth)
+				fund.Category = entity.TransactionCategory(row.get("category"))
+				fund.Keyword = row.get("keyword")
+				fund.CreatedAt, fund.UpdatedAt, err = row.timestamps()
+				return fund, err
+			},
+			p.SinkingFunds.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.EmergencyFund != nil {
+		if err := uc.importEmergencyFund(ctx, dir, result); err != nil {
+			return err
+		}
+	}
+
+	if p.Goals != nil {
+		existing, err := p.Goals.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get goals: %w", err)
+		}
+		result.Goals, err = importDatasetRecords(ctx, dir, datasetGoalsFile, existing, result,
+			func(goal *entity.Goal) uuid.UUID { return goal.ID },
+			func(row datasetRow, id uuid.UUID) (goal *entity.Goal, err error) {
+				goal = &entity.Goal{ID: id}
+				goal.Name = row.get("name")
+				if goal.TargetAmount, err = row.money("target_amount"); err != nil {
+					return nil, err
+				}
+				if goal.Deadline, err = row.time("deadline"); err != nil {
+					return nil, err
+				}
+				if goal.AccountID, err = row.id("account_id"); err != nil {
+					return nil, err
+				}
+				goal.CreatedAt, goal.UpdatedAt, err = row.timestamps()
+				return goal, err
+			},
+			p.Goals.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.Holdings != nil {
+		existing, err := p.Holdings.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get holdings: %w", err)
+		}
+		result.Holdings, err = importDatasetRecords(ctx, dir, datasetHoldingsFile, existing, result,
+			func(holding *entity.Holding) uuid.UUID { return holding.ID },
+			func(row datasetRow, id uuid.UUID) (holding *entity.Holding, err error) {
+				holding = &entity.Holding{ID: id}
+				if holding.AccountID, err = row.id("account_id"); err != nil {
+					return nil, err
+				}
+				holding.Ticker = row.get("ticker")
+				if holding.Quantity, err = row.float("quantity"); err != nil {
+					return nil, err
+				}
+				if holding.AveragePrice, err = row.money("average_price"); err != nil {
+					return nil, err
+				}
+				if holding.LastPrice, err = row.money("last_price"); err != nil {
+					return nil, err
+				}
+				if holding.RealizedProfit, err = row.money("realized_profit"); err != nil {
+					return nil, err
+				}
+				holding.CreatedAt, holding.UpdatedAt, err = row.timestamps()
+				return holding, err
+			},
+			p.Holdings.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.Wishlist != nil {
+		existing, err := p.Wishlist.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get wishlist: %w", err)
+		}
+		result.WishlistItems, err = importDatasetRecords(ctx, dir, datasetWishlistFile, existing, result,
+			func(item *entity.WishlistItem) uuid.UUID { return item.ID },
+			func(row datasetRow, id uuid.UUID) (item *entity.WishlistItem, err error) {
+				item = &entity.WishlistItem{ID: id}
+				item.Name = row.get("name")
+				if item.EstimatedCost, err = row.money("estimated_cost"); err != nil {
+					return nil, err
+				}
+				item.Priority = entity.WishlistPriority(row.get("priority"))
+				if item.TargetDate, err = row.time("target_date"); err != nil {
+					return nil, err
+				}
+				item.Category = entity.TransactionCategory(row.get("category"))
+				if item.TransactionID, err = row.optionalID("transaction_id"); err != nil {
+					return nil, err
+				}
+				if item.PurchasedAt, err = row.optionalTime("purchased_at"); err != nil {
+					return nil, err
+				}
+				item.CreatedAt, item.UpdatedAt, err = row.timestamps()
+				return item, err
+			},
+			p.Wishlist.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.ScheduledTransfers != nil {
+		existing, err := p.ScheduledTransfers.FindPending(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get scheduled transfers: %w", err)
+		}
+		result.ScheduledTransfers, err = importDatasetRecords(ctx, dir, datasetScheduledTransfersFile, existing, result,
+			func(transfer *entity.ScheduledTransfer) uuid.UUID { return transfer.ID },
+			func(row datasetRow, id uuid.UUID) (transfer *entity.ScheduledTransfer, err error) {
+				transfer = &entity.ScheduledTransfer{ID: id}
+				if transfer.FromAccountID, err = row.id("from_account_id"); err != nil {
+					return nil, err
+				}
+				if transfer.ToAccountID, err = row.id("to_account_id"); err != nil {
+					return nil, err
+				}
+				if transfer.Amount, err = row.money("amount"); err != nil {
+					return nil, err
+				}
+				if transfer.ScheduledFor, err = row.time("scheduled_for"); err != nil {
+					return nil, err
+				}
+				transfer.Description = row.get("description")
+				transfer.Status = entity.ScheduledTransferStatus(row.get("status"))
+				if transfer.TransferredAt, err = row.optionalTime("transferred_at"); err != nil {
+					return nil, err
+				}
+				transfer.CreatedAt, transfer.UpdatedAt, err = row.timestamps()
+				return transfer, err
+			},
+			p.ScheduledTransfers.Create)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importBudgets creates the budgets with what their ended months carried over
+func (uc *DatasetExchangeUseCase) importBudgets(ctx context.Context, dir string, result *DatasetImportResult) error {
+	carries, err := readDatasetFile(dir, datasetBudgetCarriesFile)
+	if err != nil {
+		return err
+	}
+	carried := make(map[uuid.UUID]map[string]float64)
+	for _, row := range carries {
+		budgetID, err := row.id("budget_id")
+		if err != nil {
+			return err
+		}
+		amount, err := row.float("amount")
+		if err != nil {
+			return err
+		}
+		if carried[budgetID] == nil {
+			carried[budgetID] = make(map[string]float64)
+		}
+		carried[budgetID][row.get("month")] = amount
+	}
+
+	existing, err := uc.planning.Budgets.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get budgets: %w", err)
+	}
+	result.Budgets, err = importDatasetRecords(ctx, dir, datasetBudgetsFile, existing, result,
+		func(budget *entity.Budget) uuid.UUID { return budget.ID },
+		func(row datasetRow, id uuid.UUID) (budget *entity.Budget, err error) {
+			budget = &entity.Budget{ID: id}
+			budget.Category = entity.TransactionCategory(row.get("category"))
+			if budget.MonthlyLimit, err = row.money("monthly_limit"); err != nil {
+				return nil, err
+			}
+			budget.Rollover = entity.BudgetRollover(row.get("rollover"))
+			budget.Carried = carried[budget.ID]
+			budget.CreatedAt, budget.UpdatedAt, err = row.timestamps()
+			return budget, err
+		},
+		uc.planning.Budgets.Create)
+	return err
+}
+
+// importEmergencyFund saves the plan of the dataset unless one is set up already
+func (uc *DatasetExchangeUseCase) importEmergencyFund(ctx context.Context, dir string, result *DatasetImportResult) error {
+	rows, err := readDatasetFile(dir, datasetEmergencyFundFile)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+
+	existing, err := uc.planning.EmergencyFund.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get emergency fund plan: %w", err)
+	}
+	if existing != nil {
+		result.Skipped++
+		return nil
+	}
+
+	row := rows[0]
+	plan := &entity.EmergencyFundPlan{}
+	if plan.EssentialMonthly, err = row.money("essential_monthly"); err != nil {
+		return err
+	}
+	if plan.TargetMonths, err = strconv.Atoi(row.get("target_months")); err != nil {
+		return row.errorf("invalid target_months %q", row.get("target_months"))
+	}
+	for _, id := range strings.Split(row.get("account_ids"), ",") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		accountID, err := uuid.Parse(id)
+		if err != nil {
+			return row.errorf("invalid account_ids %q", row.get("account_ids"))
+		}
+		plan.AccountIDs = append(plan.AccountIDs, accountID)
+	}
+	if _, plan.UpdatedAt, err = row.timestamps(); err != nil {
+		return err
+	}
+
+	if err := uc.planning.EmergencyFund.Save(ctx, plan); err != nil {
+		return fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+	result.EmergencyFund++
+	return nil
+}
+
+// importPeriods creates the accounting periods with their history. It runs
+// last, so the months closed in the dataset don't lock out its own transactions.
+func (uc *DatasetExchangeUseCase) importPeriods(ctx context.Context, dir string, result *DatasetImportResult) error {
+	if uc.planning.Periods == nil {
+		return nil
+	}
+
+	logRows, err := readDatasetFile(dir, datasetPeriodLogFile)
+	if err != nil {
+		return err
+	}
+	logs := make(map[uuid.UUID][]entity.PeriodLogEntry)
+	for _, row := range logRows {
+		periodID, err := row.id("period_id")
+		if err != nil {
+			return err
+		}
+		at, err := row.time("at")
+		if err != nil {
+			return err
+		}
+		logs[periodID] = append(logs[periodID], entity.PeriodLogEntry{
+			Action: entity.PeriodAction(row.get("action")),
+			Reason: row.get("reason"),
+			At:     at,
+		})
+	}
+
+	existing, err := uc.planning.Periods.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get accounting periods: %w", err)
+	}
+	// A month closed in the database already keeps its own history
+	months := make(map[string]bool, len(existing))
+	for _, period := range existing {
+		months[period.Key()] = true
+	}
+
+	result.Periods, err = importDatasetRecords(ctx, dir, datasetPeriodsFile, existing, result,
+		func(period *entity.AccountingPeriod) uuid.UUID { return period.ID },
+		func(row datasetRow, id uuid.UUID) (period *entity.AccountingPeriod, err error) {
+			period = &entity.AccountingPeriod{ID: id}
+			month, err := time.Parse("2006-01", row.get("month"))
+			if err != nil {
+				return nil, row.errorf("invalid month %q", row.get("month"))
+			}
+			if months[row.get("month")] {
+				return nil, errDatasetRecordSkipped
+			}
+			period.Month = entity.PeriodMonth(month)
+			period.Closed = row.get("closed") == "true"
+			period.Log = logs[period.ID]
+			period.CreatedAt, period.UpdatedAt, err = row.timestamps()
+			return period, err
+		},
+		uc.planning.Periods.Create)
+	return err
+}
+
+// errDatasetRecordSkipped makes importDatasetRecords skip a record read fine
+// that clashes with one in the database
+var errDatasetRecordSkipped = errors.New("dataset record skipped")
+
+// importDatasetRecords creates the records of a dataset file whose ID isn't
+// among existing, each read from its row, and returns how many it created
+func importDatasetRecords[T any](
+	ctx context.Context,
+	dir, name string,
+	existing []*T,
+	result *DatasetImportResult,
+	idOf func(*T) uuid.UUID,
+	read func(row datasetRow, id uuid.UUID) (*T, error),
+	create func(ctx context.Context, record *T) error,
+) (int, error) {
+	rows, err := readDatasetFile(dir, name)
+	if err != nil {
+		return 0, err
+	}
+
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, record := range existing {
+		known[idOf(record)] = true
+	}
+
+	created := 0
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return created, err
+		}
+		if known[id] {
+			result.Skipped++
+			continue
+		}
+
+		record, err := read(row, id)
+		if err != nil {
+			if err == errDatasetRecordSkipped {
+				result.Skipped++
+				continue
+			}
+			return created, err
+		}
+
+		if err := create(ctx, record); err != nil {
+			return created, fmt.Errorf("failed to create %s record: %w", strings.TrimSuffix(name, ".csv"), err)
+		}
+		known[id] = true
+		created++
+	}
+
+	return created, nil
+}
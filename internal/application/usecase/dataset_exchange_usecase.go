@@ -0,0 +1,1111 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// DatasetFormatVersion is the version of the CSV dataset layout written by
+// ExportDataset. Importers accept any version up to this one; bump it whenever
+// a column changes meaning or a required column is added.
+const DatasetFormatVersion = 1
+
+const datasetFormatName = "financli-dataset"
+
+// The files of a dataset, in the order they are imported
+const (
+	datasetManifestFile     = "manifest.csv"
+	datasetPeopleFile       = "people.csv"
+	datasetAccountsFile     = "accounts.csv"
+	datasetCreditCardsFile  = "credit_cards.csv"
+	datasetBillsFile        = "bills.csv"
+	datasetInvoicesFile     = "invoices.csv"
+	datasetTransactionsFile = "transactions.csv"
+	datasetSplitsFile       = "splits.csv"
+	datasetOverdraftFile    = "overdraft_interest.csv"
+)
+
+var (
+	peopleColumns      = []string{"id", "name", "email", "phone", "notify_owed_amounts", "created_at", "updated_at"}
+	accountColumns     = []string{"id", "name", "type", "balance", "currency", "description", "yield_type", "yield_rate", "last_yield_month", "overdraft_limit", "overdraft_rate", "last_overdraft_day", "minimum_balance", "default_category", "default_transaction_type", "created_at", "updated_at"}
+	creditCardColumns  = []string{"id", "account_id", "name", "last_four_digits", "credit_limit", "current_balance", "currency", "due_day", "minimum_payment_percentage", "default_category", "default_transaction_type", "split_person_id", "split_percentage", "reminders_disabled", "reminders_email", "reminders_webhook", "created_at", "updated_at"}
+	billColumns        = []string{"id", "name", "description", "start_date", "end_date", "due_date", "total_amount", "paid_amount", "currency", "status", "created_at", "updated_at"}
+	invoiceColumns     = []string{"id", "credit_card_id", "reference_month", "opening_date", "closing_date", "due_date", "previous_balance", "total_charges", "total_payments", "closing_balance", "minimum_payment", "amount_paid", "currency", "minimum_paid_at", "status", "created_at", "updated_at"}
+	transactionColumns = []string{"id", "date", "type", "category", "amount", "currency", "description", "account_id", "credit_card_id", "invoice_id", "bill_id", "transfer_id", "ignore_from_budget", "city", "venue", "client", "project", "payment_method", "tags", "created_at", "updated_at"}
+	splitColumns       = []string{"transaction_id", "person_id", "amount", "currency", "percentage"}
+	overdraftColumns   = []string{"account_id", "month", "days", "amount", "currency"}
+)
+
+// DatasetImportResult counts the records created by ImportDataset. Records whose
+// ID is already in the database are skipped, so importing the same dataset
+// twice is harmless.
+type DatasetImportResult struct {
+	People       int `json:"people"`
+	Accounts     int `json:"accounts"`
+	CreditCards  int `json:"credit_cards"`
+	Bills        int `json:"bills"`
+	Invoices     int `json:"invoices"`
+	Transactions int `json:"transactions"`
+
+	Categories         int `json:"categories"`
+	CategoryRules      int `json:"category_rules"`
+	Budgets            int `json:"budgets"`
+	StandingOrders     int `json:"standing_orders"`
+	SinkingFunds       int `json:"sinking_funds"`
+	EmergencyFund      int `json:"emergency_fund"`
+	Goals              int `json:"goals"`
+	Holdings           int `json:"holdings"`
+	WishlistItems      int `json:"wishlist_items"`
+	ScheduledTransfers int `json:"scheduled_transfers"`
+	Periods            int `json:"accounting_periods"`
+
+	Skipped int `json:"skipped"`
+}
+
+func (r *DatasetImportResult) Created() int {
+	return r.People + r.Accounts + r.CreditCards + r.Bills + r.Invoices + r.Transactions + r.Planning()
+}
+
+// Planning counts the plans and settings created, besides the ledger
+func (r *DatasetImportResult) Planning() int {
+	return r.Categories + r.CategoryRules + r.Budgets + r.StandingOrders + r.SinkingFunds + r.EmergencyFund +
+		r.Goals + r.Holdings + r.WishlistItems + r.ScheduledTransfers + r.Periods
+}
+
+// DatasetExchangeUseCase moves the whole dataset in and out of the database as
+// a directory of CSV files, so the data is never locked into Mongo. The layout
+// is described in the "Dataset Export" section of the README.
+type DatasetExchangeUseCase struct {
+	accountRepo     repository.AccountRepository
+	creditCardRepo  repository.CreditCardRepository
+	invoiceRepo     repository.CreditCardInvoiceRepository
+	billRepo        repository.BillRepository
+	personRepo      repository.PersonRepository
+	transactionRepo repository.TransactionRepository
+	archiveRepo     repository.TransactionArchiveRepository
+	periods         *PeriodLockUseCase
+	planning        DatasetPlanning
+	outputDir       string
+}
+
+func NewDatasetExchangeUseCase(
+	accountRepo repository.AccountRepository,
+	creditCardRepo repository.CreditCardRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	billRepo repository.BillRepository,
+	personRepo repository.PersonRepository,
+	transactionRepo repository.TransactionRepository,
+	outputDir string,
+) *DatasetExchangeUseCase {
+	return &DatasetExchangeUseCase{
+		accountRepo:     accountRepo,
+		creditCardRepo:  creditCardRepo,
+		invoiceRepo:     invoiceRepo,
+		billRepo:        billRepo,
+		personRepo:      personRepo,
+		transactionRepo: transactionRepo,
+		outputDir:       outputDir,
+	}
+}
+
+// SetArchive makes the dataset cover the archived transactions too. They are
+// imported back as regular transactions, to be archived again on a later launch.
+func (uc *DatasetExchangeUseCase) SetArchive(archiveRepo repository.TransactionArchiveRepository) {
+	uc.archiveRepo = archiveRepo
+}
+
+// SetPeriodLocks keeps an import from adding transactions to months whose
+// books are closed
+func (uc *DatasetExchangeUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// allTransactions returns every transaction, archived ones included when the archive is set
+func (uc *DatasetExchangeUseCase) allTransactions(ctx context.Context) ([]*entity.Transaction, error) {
+	transactions, err := uc.transactionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	if uc.archiveRepo == nil {
+		return transactions, nil
+	}
+
+	archived, err := uc.archiveRepo.FindByDateRange(ctx, time.Time{}, time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived transactions: %w", err)
+	}
+	return append(transactions, archived...), nil
+}
+
+// ExportDataset writes every person, account, card, bill, invoice and
+// transaction, and the plans and settings when set, to dir, or to a dated
+// directory under the export directory when dir is empty, and returns the
+// directory written
+func (uc *DatasetExchangeUseCase) ExportDataset(ctx context.Context, dir string, now time.Time) (string, error) {
+	if dir == "" {
+		dir = filepath.Join(uc.outputDir, fmt.Sprintf("dataset-%s", now.Format("2006-01-02")))
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create dataset directory: %w", err)
+	}
+
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get people: %w", err)
+	}
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get accounts: %w", err)
+	}
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get credit cards: %w", err)
+	}
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bills: %w", err)
+	}
+	var invoices []*entity.CreditCardInvoice
+	for _, card := range cards {
+		cardInvoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get invoices: %w", err)
+		}
+		invoices = append(invoices, cardInvoices...)
+	}
+	transactions, err := uc.allTransactions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	manifest := [][]string{
+		{"format", datasetFormatName},
+		{"version", strconv.Itoa(DatasetFormatVersion)},
+		{"exported_at", now.Format(time.RFC3339)},
+	}
+	if err := writeDatasetFile(dir, datasetManifestFile, []string{"key", "value"}, manifest); err != nil {
+		return "", err
+	}
+
+	rows := make([][]string, 0, len(people))
+	for _, person := range people {
+		rows = append(rows, []string{
+			person.ID.String(), person.Name, person.Email, person.Phone,
+			strconv.FormatBool(person.NotifyOwedAmounts),
+			formatDatasetTime(person.CreatedAt), formatDatasetTime(person.UpdatedAt),
+		})
+	}
+	if err := writeDatasetFile(dir, datasetPeopleFile, peopleColumns, rows); err != nil {
+		return "", err
+	}
+
+	rows = make([][]string, 0, len(accounts))
+	var overdraftInterest [][]string
+	for _, account := range accounts {
+		minimumBalance := ""
+		if account.MinimumBalance != nil {
+			minimumBalance = formatDatasetAmount(account.MinimumBalance.Amount())
+		}
+		rows = append(rows, []string{
+			account.ID.String(), account.Name, string(account.Type),
+			formatDatasetAmount(account.Balance.Amount()), account.Balance.Currency(),
+			account.Description, string(account.YieldType),
+			strconv.FormatFloat(account.YieldRate, 'f', -1, 64), account.LastYieldMonth,
+			formatDatasetAmount(account.OverdraftLimit.Amount()),
+			strconv.FormatFloat(account.OverdraftRate, 'f', -1, 64), account.LastOverdraftDay,
+			minimumBalance, string(account.DefaultCategory), string(account.DefaultTransactionType),
+			formatDatasetTime(account.CreatedAt), formatDatasetTime(account.UpdatedAt),
+		})
+		for _, interest := range account.OverdraftInterest {
+			overdraftInterest = append(overdraftInterest, []string{
+				account.ID.String(), interest.Month, strconv.Itoa(interest.Days),
+				formatDatasetAmount(interest.Amount.Amount()), interest.Amount.Currency(),
+			})
+		}
+	}
+	if err := writeDatasetFile(dir, datasetAccountsFile, accountColumns, rows); err != nil {
+		return "", err
+	}
+	if err := writeDatasetFile(dir, datasetOverdraftFile, overdraftColumns, overdraftInterest); err != nil {
+		return "", err
+	}
+
+	rows = make([][]string, 0, len(cards))
+	for _, card := range cards {
+		splitPersonID, splitPercentage := "", ""
+		if card.InvoiceSplit != nil {
+			splitPersonID = card.InvoiceSplit.PersonID.String()
+			splitPercentage = strconv.FormatFloat(card.InvoiceSplit.Percentage, 'f', -1, 64)
+		}
+		rows = append(rows, []string{
+			card.ID.String(), card.AccountID.String(), card.Name, card.LastFourDigits,
+			formatDatasetAmount(card.CreditLimit.Amount()), formatDatasetAmount(card.CurrentBalance.Amount()),
+			card.CreditLimit.Currency(), strconv.Itoa(card.DueDay),
+			strconv.FormatFloat(card.MinimumPaymentPercentage, 'f', -1, 64),
+			string(card.DefaultCategory), string(card.DefaultTransactionType),
+			splitPersonID, splitPercentage, strconv.FormatBool(card.InvoiceReminders.Disabled),
+			strconv.FormatBool(card.InvoiceReminders.Email), strconv.FormatBool(card.InvoiceReminders.Webhook),
+			formatDatasetTime(card.CreatedAt), formatDatasetTime(card.UpdatedAt),
+		})
+	}
+	if err := writeDatasetFile(dir, datasetCreditCardsFile, creditCardColumns, rows); err != nil {
+		return "", err
+	}
+
+	rows = make([][]string, 0, len(bills))
+	for _, bill := range bills {
+		rows = append(rows, []string{
+			bill.ID.String(), bill.Name, bill.Description,
+			formatDatasetTime(bill.StartDate), formatDatasetTime(bill.EndDate), formatDatasetTime(bill.DueDate),
+			formatDatasetAmount(bill.TotalAmount.Amount()), formatDatasetAmount(bill.PaidAmount.Amount()),
+			bill.TotalAmount.Currency(), string(bill.Status),
+			formatDatasetTime(bill.CreatedAt), formatDatasetTime(bill.UpdatedAt),
+		})
+	}
+	if err := writeDatasetFile(dir, datasetBillsFile, billColumns, rows); err != nil {
+		return "", err
+	}
+
+	rows = make([][]string, 0, len(invoices))
+	for _, invoice := range invoices {
+		minimumPaidAt := ""
+		if invoice.MinimumPaidAt != nil {
+			minimumPaidAt = formatDatasetTime(*invoice.MinimumPaidAt)
+		}
+		rows = append(rows, []string{
+			invoice.ID.String(), invoice.CreditCardID.String(), invoice.ReferenceMonth,
+			formatDatasetTime(invoice.OpeningDate), formatDatasetTime(invoice.ClosingDate), formatDatasetTime(invoice.DueDate),
+			formatDatasetAmount(invoice.PreviousBalance.Amount()), formatDatasetAmount(invoice.TotalCharges.Amount()),
+			formatDatasetAmount(invoice.TotalPayments.Amount()), formatDatasetAmount(invoice.ClosingBalance.Amount()),
+			formatDatasetAmount(invoice.MinimumPayment.Amount()), formatDatasetAmount(invoice.AmountPaid.Amount()),
+			invoice.ClosingBalance.Currency(), minimumPaidAt, string(invoice.Status),
+			formatDatasetTime(invoice.CreatedAt), formatDatasetTime(invoice.UpdatedAt),
+		})
+	}
+	if err := writeDatasetFile(dir, datasetInvoicesFile, invoiceColumns, rows); err != nil {
+		return "", err
+	}
+
+	rows = make([][]string, 0, len(transactions))
+	var splits [][]string
+	for _, txn := range transactions {
+		rows = append(rows, []string{
+			txn.ID.String(), formatDatasetTime(txn.Date), string(txn.Type), string(txn.Category),
+			formatDatasetAmount(txn.Amount.Amount()), txn.Amount.Currency(), txn.Description,
+			formatDatasetID(txn.AccountID), formatDatasetID(txn.CreditCardID),
+			formatDatasetID(txn.CreditCardInvoiceID), formatDatasetID(txn.BillID), formatDatasetID(txn.TransferID),
+			strconv.FormatBool(txn.IgnoreFromBudget), txn.City, txn.Venue, txn.Client, txn.Project,
+			string(txn.PaymentMethod), strings.Join(txn.Tags, ","), formatDatasetTime(txn.CreatedAt), formatDatasetTime(txn.UpdatedAt),
+		})
+		for _, shared := range txn.SharedWith {
+			splits = append(splits, []string{
+				txn.ID.String(), shared.PersonID.String(),
+				formatDatasetAmount(shared.Amount.Amount()), shared.Amount.Currency(),
+				strconv.FormatFloat(shared.Percentage, 'f', -1, 64),
+			})
+		}
+	}
+	if err := writeDatasetFile(dir, datasetTransactionsFile, transactionColumns, rows); err != nil {
+		return "", err
+	}
+	if err := writeDatasetFile(dir, datasetSplitsFile, splitColumns, splits); err != nil {
+		return "", err
+	}
+
+	if err := uc.exportPlanning(ctx, dir); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// ImportDataset creates the records of a dataset directory written by
+// ExportDataset. Balances are taken from the files as they are: transactions are
+// stored without moving any balance again, since the exported balances already
+// include them. Missing files count as empty, and columns are matched by name so
+// that files edited in a spreadsheet still import.
+func (uc *DatasetExchangeUseCase) ImportDataset(ctx context.Context, dir string) (*DatasetImportResult, error) {
+	manifest, err := readDatasetFile(dir, datasetManifestFile)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%s is not a dataset: %s is missing", dir, datasetManifestFile)
+	}
+	if err := checkDatasetManifest(manifest); err != nil {
+		return nil, err
+	}
+
+	result := &DatasetImportResult{}
+	if err := uc.importSettings(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importPeople(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importAccounts(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importCreditCards(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importBills(ctx, dir, result); err != nil {
+		return result, err
+	}
+	// Invoices list their transactions, so both are read before either is stored
+	if err := uc.importInvoicesAndTransactions(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importPlanning(ctx, dir, result); err != nil {
+		return result, err
+	}
+	if err := uc.importPeriods(ctx, dir, result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func checkDatasetManifest(manifest []datasetRow) error {
+	values := make(map[string]string)
+	for _, row := range manifest {
+		values[row.get("key")] = row.get("value")
+	}
+
+	if values["format"] != datasetFormatName {
+		return fmt.Errorf("unknown dataset format %q", values["format"])
+	}
+	version, err := strconv.Atoi(values["version"])
+	if err != nil {
+		return fmt.Errorf("invalid dataset version %q", values["version"])
+	}
+	if version > DatasetFormatVersion {
+		return fmt.Errorf("dataset version %d is newer than the supported version %d", version, DatasetFormatVersion)
+	}
+	return nil
+}
+
+func (uc *DatasetExchangeUseCase) importPeople(ctx context.Context, dir string, result *DatasetImportResult) error {
+	rows, err := readDatasetFile(dir, datasetPeopleFile)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get people: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, person := range existing {
+		known[person.ID] = true
+	}
+
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return err
+		}
+		if known[id] {
+			result.Skipped++
+			continue
+		}
+
+		person := &entity.Person{
+			ID:                id,
+			Name:              row.get("name"),
+			Email:             row.get("email"),
+			Phone:             row.get("phone"),
+			NotifyOwedAmounts: row.get("notify_owed_amounts") == "true",
+		}
+		if person.CreatedAt, person.UpdatedAt, err = row.timestamps(); err != nil {
+			return err
+		}
+
+		if err := uc.personRepo.Create(ctx, person); err != nil {
+			return fmt.Errorf("failed to create person: %w", err)
+		}
+		known[id] = true
+		result.People++
+	}
+
+	return nil
+}
+
+func (uc *DatasetExchangeUseCase) importAccounts(ctx context.Context, dir string, result *DatasetImportResult) error {
+	rows, err := readDatasetFile(dir, datasetAccountsFile)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, account := range existing {
+		known[account.ID] = true
+	}
+
+	// Accounts are read first so the overdraft history can be attached to them
+	accounts := make([]*entity.Account, 0, len(rows))
+	imported := make(map[uuid.UUID]*entity.Account, len(rows))
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return err
+		}
+		if known[id] || imported[id] != nil {
+			result.Skipped++
+			continue
+		}
+
+		balance, err := row.money("balance")
+		if err != nil {
+			return err
+		}
+		yieldRate, err := row.float("yield_rate")
+		if err != nil {
+			return err
+		}
+		overdraftLimit, err := row.money("overdraft_limit")
+		if err != nil {
+			return err
+		}
+		overdraftRate, err := row.float("overdraft_rate")
+		if err != nil {
+			return err
+		}
+
+		account := &entity.Account{
+			ID:                     id,
+			Name:                   row.get("name"),
+			Type:                   entity.AccountType(row.get("type")),
+			Balance:                balance,
+			Description:            row.get("description"),
+			YieldType:              entity.YieldType(row.get("yield_type")),
+			YieldRate:              yieldRate,
+			LastYieldMonth:         row.get("last_yield_month"),
+			OverdraftLimit:         overdraftLimit,
+			OverdraftRate:          overdraftRate,
+			LastOverdraftDay:       row.get("last_overdraft_day"),
+			DefaultCategory:        entity.TransactionCategory(row.get("default_category")),
+			DefaultTransactionType: entity.TransactionType(row.get("default_transaction_type")),
+		}
+		// A blank minimum balance means no low balance alert
+		if row.get("minimum_balance") != "" {
+			minimumBalance, err := row.money("minimum_balance")
+			if err != nil {
+				return err
+			}
+			account.MinimumBalance = &minimumBalance
+		}
+		if account.CreatedAt, account.UpdatedAt, err = row.timestamps(); err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		imported[id] = account
+	}
+
+	if err := readOverdraftInterest(dir, imported); err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if err := uc.accountRepo.Create(ctx, account); err != nil {
+			return fmt.Errorf("failed to create account: %w", err)
+		}
+		result.Accounts++
+	}
+
+	return nil
+}
+
+// readOverdraftInterest adds the overdraft interest history to the accounts
+// being imported; rows of accounts already in the database are ignored
+func readOverdraftInterest(dir string, accounts map[uuid.UUID]*entity.Account) error {
+	rows, err := readDatasetFile(dir, datasetOverdraftFile)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		accountID, err := row.id("account_id")
+		if err != nil {
+			return err
+		}
+		account := accounts[accountID]
+		if account == nil {
+			continue
+		}
+
+		days, err := strconv.Atoi(row.get("days"))
+		if err != nil {
+			return row.errorf("invalid days %q", row.get("days"))
+		}
+		amount, err := row.money("amount")
+		if err != nil {
+			return err
+		}
+		account.OverdraftInterest = append(account.OverdraftInterest, entity.OverdraftInterest{
+			Month:  row.get("month"),
+			Days:   days,
+			Amount: amount,
+		})
+	}
+	return nil
+}
+
+func (uc *DatasetExchangeUseCase) importCreditCards(ctx context.Context, dir string, result *DatasetImportResult) error {
+	rows, err := readDatasetFile(dir, datasetCreditCardsFile)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credit cards: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, card := range existing {
+		known[card.ID] = true
+	}
+
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return err
+		}
+		if known[id] {
+			result.Skipped++
+			continue
+		}
+
+		accountID, err := row.id("account_id")
+		if err != nil {
+			return err
+		}
+		limit, err := row.money("credit_limit")
+		if err != nil {
+			return err
+		}
+		balance, err := row.money("current_balance")
+		if err != nil {
+			return err
+		}
+		dueDay, err := strconv.Atoi(row.get("due_day"))
+		if err != nil {
+			return row.errorf("invalid due_day %q", row.get("due_day"))
+		}
+		minimumPercentage, err := row.float("minimum_payment_percentage")
+		if err != nil {
+			return err
+		}
+
+		card := &entity.CreditCard{
+			ID:                       id,
+			AccountID:                accountID,
+			Name:                     row.get("name"),
+			LastFourDigits:           row.get("last_four_digits"),
+			CreditLimit:              limit,
+			CurrentBalance:           balance,
+			DueDay:                   dueDay,
+			MinimumPaymentPercentage: minimumPercentage,
+			DefaultCategory:          entity.TransactionCategory(row.get("default_category")),
+			DefaultTransactionType:   entity.TransactionType(row.get("default_transaction_type")),
+			InvoiceReminders: entity.InvoiceReminders{
+				Disabled: row.get("reminders_disabled") == "true",
+				Email:    row.get("reminders_email") == "true",
+				Webhook:  row.get("reminders_webhook") == "true",
+			},
+		}
+		splitPersonID, err := row.optionalID("split_person_id")
+		if err != nil {
+			return err
+		}
+		if splitPersonID != nil {
+			percentage, err := row.float("split_percentage")
+			if err != nil {
+				return err
+			}
+			if err := card.SetInvoiceSplit(*splitPersonID, percentage); err != nil {
+				return row.errorf("%v", err)
+			}
+		}
+		if card.CreatedAt, card.UpdatedAt, err = row.timestamps(); err != nil {
+			return err
+		}
+
+		if err := uc.creditCardRepo.Create(ctx, card); err != nil {
+			return fmt.Errorf("failed to create credit card: %w", err)
+		}
+		known[id] = true
+		result.CreditCards++
+	}
+
+	return nil
+}
+
+func (uc *DatasetExchangeUseCase) importBills(ctx context.Context, dir string, result *DatasetImportResult) error {
+	rows, err := readDatasetFile(dir, datasetBillsFile)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bills: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, bill := range existing {
+		known[bill.ID] = true
+	}
+
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return err
+		}
+		if known[id] {
+			result.Skipped++
+			continue
+		}
+
+		bill := &entity.Bill{
+			ID:          id,
+			Name:        row.get("name"),
+			Description: row.get("description"),
+			Status:      entity.BillStatus(row.get("status")),
+		}
+		if bill.StartDate, err = row.time("start_date"); err != nil {
+			return err
+		}
+		if bill.EndDate, err = row.time("end_date"); err != nil {
+			return err
+		}
+		if bill.DueDate, err = row.time("due_date"); err != nil {
+			return err
+		}
+		if bill.TotalAmount, err = row.money("total_amount"); err != nil {
+			return err
+		}
+		if bill.PaidAmount, err = row.money("paid_amount"); err != nil {
+			return err
+		}
+		if bill.CreatedAt, bill.UpdatedAt, err = row.timestamps(); err != nil {
+			return err
+		}
+
+		if err := uc.billRepo.Create(ctx, bill); err != nil {
+			return fmt.Errorf("failed to create bill: %w", err)
+		}
+		known[id] = true
+		result.Bills++
+	}
+
+	return nil
+}
+
+func (uc *DatasetExchangeUseCase) importInvoicesAndTransactions(ctx context.Context, dir string, result *DatasetImportResult) error {
+	transactions, err := uc.readTransactions(ctx, dir, result)
+	if err != nil {
+		return err
+	}
+	// Checked before the invoices are stored; what came before is skipped when
+	// the import runs again
+	if err := uc.periods.CheckOpen(ctx, transactionDates(transactions)...); err != nil {
+		return err
+	}
+
+	rows, err := readDatasetFile(dir, datasetInvoicesFile)
+	if err != nil {
+		return err
+	}
+
+	knownInvoices := make(map[uuid.UUID]bool)
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credit cards: %w", err)
+	}
+	for _, card := range cards {
+		invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get invoices: %w", err)
+		}
+		for _, invoice := range invoices {
+			knownInvoices[invoice.ID] = true
+		}
+	}
+
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return err
+		}
+		if knownInvoices[id] {
+			result.Skipped++
+			continue
+		}
+
+		invoice := &entity.CreditCardInvoice{
+			ID:             id,
+			ReferenceMonth: row.get("reference_month"),
+			Status:         entity.InvoiceStatus(row.get("status")),
+		}
+		if invoice.CreditCardID, err = row.id("credit_card_id"); err != nil {
+			return err
+		}
+		if invoice.OpeningDate, err = row.time("opening_date"); err != nil {
+			return err
+		}
+		if invoice.ClosingDate, err = row.time("closing_date"); err != nil {
+			return err
+		}
+		if invoice.DueDate, err = row.time("due_date"); err != nil {
+			return err
+		}
+		if invoice.PreviousBalance, err = row.money("previous_balance"); err != nil {
+			return err
+		}
+		if invoice.TotalCharges, err = row.money("total_charges"); err != nil {
+			return err
+		}
+		if invoice.TotalPayments, err = row.money("total_payments"); err != nil {
+			return err
+		}
+		if invoice.ClosingBalance, err = row.money("closing_balance"); err != nil {
+			return err
+		}
+		if invoice.MinimumPayment, err = row.money("minimum_payment"); err != nil {
+			return err
+		}
+		if invoice.AmountPaid, err = row.money("amount_paid"); err != nil {
+			return err
+		}
+		if row.get("minimum_paid_at") != "" {
+			paidAt, err := row.time("minimum_paid_at")
+			if err != nil {
+				return err
+			}
+			invoice.MinimumPaidAt = &paidAt
+		}
+		if invoice.CreatedAt, invoice.UpdatedAt, err = row.timestamps(); err != nil {
+			return err
+		}
+
+		for _, txn := range transactions {
+			if txn.CreditCardInvoiceID != nil && *txn.CreditCardInvoiceID == id {
+				invoice.TransactionIDs = append(invoice.TransactionIDs, txn.ID)
+			}
+		}
+
+		if err := uc.invoiceRepo.Create(ctx, invoice); err != nil {
+			return fmt.Errorf("failed to create invoice: %w", err)
+		}
+		knownInvoices[id] = true
+		result.Invoices++
+	}
+
+	if len(transactions) > 0 {
+		if err := uc.transactionRepo.CreateMany(ctx, transactions); err != nil {
+			return fmt.Errorf("failed to create transactions: %w", err)
+		}
+		result.Transactions += len(transactions)
+	}
+
+	return nil
+}
+
+// readTransactions reads the transactions not in the database yet, with the
+// splits that belong to them
+func (uc *DatasetExchangeUseCase) readTransactions(ctx context.Context, dir string, result *DatasetImportResult) ([]*entity.Transaction, error) {
+	rows, err := readDatasetFile(dir, datasetTransactionsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.allTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, txn := range existing {
+		known[txn.ID] = true
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(rows))
+	byID := make(map[uuid.UUID]*entity.Transaction, len(rows))
+	for _, row := range rows {
+		id, err := row.id("id")
+		if err != nil {
+			return nil, err
+		}
+		if known[id] || byID[id] != nil {
+			result.Skipped++
+			continue
+		}
+
+		txn := &entity.Transaction{
+			ID:               id,
+			Type:             entity.TransactionType(row.get("type")),
+			Category:         entity.TransactionCategory(row.get("category")),
+			Description:      row.get("description"),
+			IgnoreFromBudget: row.get("ignore_from_budget") == "true",
+			City:             row.get("city"),
+			Venue:            row.get("venue"),
+			Client:           row.get("client"),
+			Project:          row.get("project"),
+			PaymentMethod:    entity.PaymentMethod(row.get("payment_method")),
+			Tags:             entity.ParseTags(row.get("tags")),
+		}
+		if txn.Date, err = row.time("date"); err != nil {
+			return nil, err
+		}
+		if txn.Amount, err = row.money("amount"); err != nil {
+			return nil, err
+		}
+		if txn.AccountID, err = row.optionalID("account_id"); err != nil {
+			return nil, err
+		}
+		if txn.CreditCardID, err = row.optionalID("credit_card_id"); err != nil {
+			return nil, err
+		}
+		if txn.CreditCardInvoiceID, err = row.optionalID("invoice_id"); err != nil {
+			return nil, err
+		}
+		if txn.BillID, err = row.optionalID("bill_id"); err != nil {
+			return nil, err
+		}
+		if txn.TransferID, err = row.optionalID("transfer_id"); err != nil {
+			return nil, err
+		}
+		if txn.CreatedAt, txn.UpdatedAt, err = row.timestamps(); err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, txn)
+		byID[id] = txn
+	}
+
+	splits, err := readDatasetFile(dir, datasetSplitsFile)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range splits {
+		transactionID, err := row.id("transaction_id")
+		if err != nil {
+			return nil, err
+		}
+		txn := byID[transactionID]
+		if txn == nil {
+			continue
+		}
+
+		personID, err := row.id("person_id")
+		if err != nil {
+			return nil, err
+		}
+		amount, err := row.money("amount")
+		if err != nil {
+			return nil, err
+		}
+		percentage, err := row.float("percentage")
+		if err != nil {
+			return nil, err
+		}
+		txn.SharedWith = append(txn.SharedWith, entity.SharedExpense{PersonID: personID, Amount: amount, Percentage: percentage})
+	}
+
+	return transactions, nil
+}
+
+func writeDatasetFile(dir, name string, header []string, rows [][]string) error {
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// readDatasetFile returns the rows of a dataset file keyed by column name, or
+// nil when the file doesn't exist
+func readDatasetFile(dir, name string) ([]datasetRow, error) {
+	file, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		// Spreadsheet exports often start with a byte order mark
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+
+	var rows []datasetRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		rows = append(rows, datasetRow{file: name, line: line, columns: columns, record: record})
+	}
+
+	return rows, nil
+}
+
+// datasetRow is one record of a dataset file, read by column name
+type datasetRow struct {
+	file    string
+	line    int
+	columns map[string]int
+	record  []string
+}
+
+func (r datasetRow) get(column string) string {
+	index, ok := r.columns[column]
+	if !ok || index >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[index])
+}
+
+func (r datasetRow) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s line %d: %s", r.file, r.line, fmt.Sprintf(format, args...))
+}
+
+func (r datasetRow) id(column string) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.get(column))
+	if err != nil {
+		return uuid.Nil, r.errorf("invalid %s %q", column, r.get(column))
+	}
+	return id, nil
+}
+
+func (r datasetRow) optionalID(column string) (*uuid.UUID, error) {
+	if r.get(column) == "" {
+		return nil, nil
+	}
+	id, err := r.id(column)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r datasetRow) float(column string) (float64, error) {
+	if r.get(column) == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(r.get(column), 64)
+	if err != nil {
+		return 0, r.errorf("invalid %s %q", column, r.get(column))
+	}
+	return value, nil
+}
+
+// money reads an amount in the row's currency, BRL when the column is blank
+func (r datasetRow) money(column string) (valueobject.Money, error) {
+	amount, err := r.float(column)
+	if err != nil {
+		return valueobject.Money{}, err
+	}
+	currency := r.get("currency")
+	if currency == "" {
+		currency = "BRL"
+	}
+	return valueobject.NewMoney(amount, currency), nil
+}
+
+func (r datasetRow) time(column string) (time.Time, error) {
+	value, err := time.Parse(time.RFC3339, r.get(column))
+	if err != nil {
+		return time.Time{}, r.errorf("invalid %s %q", column, r.get(column))
+	}
+	return value, nil
+}
+
+func (r datasetRow) optionalTime(column string) (*time.Time, error) {
+	if r.get(column) == "" {
+		return nil, nil
+	}
+	value, err := r.time(column)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// timestamps reads created_at and updated_at, defaulting to now when blank
+func (r datasetRow) timestamps() (time.Time, time.Time, error) {
+	createdAt, updatedAt := time.Now(), time.Now()
+	var err error
+	if r.get("created_at") != "" {
+		if createdAt, err = r.time("created_at"); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if r.get("updated_at") != "" {
+		if updatedAt, err = r.time("updated_at"); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	return createdAt, updatedAt, nil
+}
+
+func formatDatasetTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func formatDatasetOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatDatasetTime(*t)
+}
+
+func formatDatasetAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func formatDatasetID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
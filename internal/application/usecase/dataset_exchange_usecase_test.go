@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// datasetFakes is a database of fakes behind a dataset exchange
+type datasetFakes struct {
+	categories         *fakeCategoryRepo
+	categoryRules      *fakeCategoryRuleRepo
+	budgets            *fakeBudgetRepo
+	standingOrders     *fakeStandingOrderRepo
+	sinkingFunds       *fakeSinkingFundRepo
+	emergencyFund      *fakeEmergencyFundRepo
+	goals              *fakeGoalRepo
+	holdings           *fakeHoldingRepo
+	wishlist           *fakeWishlistRepo
+	scheduledTransfers *fakeScheduledTransferRepo
+	periods            *fakePeriodRepo
+}
+
+func newDatasetExchange() (*DatasetExchangeUseCase, *datasetFakes) {
+	fakes := &datasetFakes{
+		categories:         newFakeCategoryRepo(),
+		categoryRules:      newFakeCategoryRuleRepo(),
+		budgets:            newFakeBudgetRepo(),
+		standingOrders:     newFakeStandingOrderRepo(),
+		sinkingFunds:       newFakeSinkingFundRepo(),
+		emergencyFund:      &fakeEmergencyFundRepo{},
+		goals:              newFakeGoalRepo(),
+		holdings:           newFakeHoldingRepo(),
+		wishlist:           newFakeWishlistRepo(),
+		scheduledTransfers: newFakeScheduledTransferRepo(),
+		periods:            newFakePeriodRepo(),
+	}
+
+	uc := NewDatasetExchangeUseCase(newFakeAccountRepo(), newFakeCreditCardRepo(), newFakeInvoiceRepo(), newFakeBillRepo(), newFakePersonRepo(), newFakeTransactionRepo(), "")
+	uc.SetPlanning(DatasetPlanning{
+		Categories:         fakes.categories,
+		CategoryRules:      fakes.categoryRules,
+		Budgets:            fakes.budgets,
+		StandingOrders:     fakes.standingOrders,
+		SinkingFunds:       fakes.sinkingFunds,
+		EmergencyFund:      fakes.emergencyFund,
+		Goals:              fakes.goals,
+		Holdings:           fakes.holdings,
+		Wishlist:           fakes.wishlist,
+		ScheduledTransfers: fakes.scheduledTransfers,
+		Periods:            fakes.periods,
+	})
+	return uc, fakes
+}
+
+func TestDatasetExchangeUseCase_PlanningRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	// Whole seconds in UTC, as the dataset keeps them
+	at := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+	brl := func(amount float64) valueobject.Money { return valueobject.NewMoney(amount, "BRL") }
+	accountID, transactionID := uuid.New(), uuid.New()
+
+	source, fakes := newDatasetExchange()
+	require.NoError(t, fakes.categories.Create(ctx, &entity.Category{ID: uuid.New(), Key: "pets", Name: "Pets", Icon: "🐶", Kind: entity.CategoryKindExpense, CreatedAt: at, UpdatedAt: at}))
+	require.NoError(t, fakes.categoryRules.Create(ctx, &entity.CategoryRule{ID: uuid.New(), Keyword: "petz", Category: "pets", CreatedAt: at}))
+	require.NoError(t, fakes.budgets.Create(ctx, &entity.Budget{
+		ID: uuid.New(), Category: entity.TransactionCategoryFood, MonthlyLimit: brl(800), Rollover: entity.BudgetRolloverUnspent,
+		Carried: map[string]float64{"2026-01": 120.5, "2026-02": 80}, CreatedAt: at, UpdatedAt: at,
+	}))
+	require.NoError(t, fakes.standingOrders.Create(ctx, &entity.StandingOrder{
+		ID: uuid.New(), FromAccountID: accountID, ToAccountID: uuid.New(), Amount: brl(500), DayOfMonth: 5,
+		Description: "Savings", LastRunMonth: "2026-03", CreatedAt: at, UpdatedAt: at,
+	}))
+	require.NoError(t, fakes.sinkingFunds.Create(ctx, &entity.SinkingFund{
+		ID: uuid.New(), Name: "IPVA", AnnualAmount: brl(2400), DueMonth: time.January,
+		Category: entity.TransactionCategoryTransportation, Keyword: "ipva", CreatedAt: at, UpdatedAt: at,
+	}))
+	fakes.emergencyFund.plan = &entity.EmergencyFundPlan{EssentialMonthly: brl(4000), TargetMonths: 6, AccountIDs: []uuid.UUID{accountID}, UpdatedAt: at}
+	require.NoError(t, fakes.goals.Create(ctx, &entity.Goal{ID: uuid.New(), Name: "Trip", TargetAmount: brl(10000), Deadline: at.AddDate(1, 0, 0), AccountID: accountID, CreatedAt: at, UpdatedAt: at}))
+	require.NoError(t, fakes.holdings.Create(ctx, &entity.Holding{
+		ID: uuid.New(), AccountID: accountID, Ticker: "PETR4", Quantity: 12.5,
+		AveragePrice: brl(30), LastPrice: brl(35.2), RealizedProfit: brl(14), CreatedAt: at, UpdatedAt: at,
+	}))
+	require.NoError(t, fakes.wishlist.Create(ctx, &entity.WishlistItem{
+		ID: uuid.New(), Name: "Bike", EstimatedCost: brl(1500), Priority: entity.WishlistPriorityHigh, TargetDate: at.AddDate(0, 2, 0),
+		Category: entity.TransactionCategoryShopping, TransactionID: &transactionID, PurchasedAt: &at, CreatedAt: at, UpdatedAt: at,
+	}))
+	require.NoError(t, fakes.scheduledTransfers.Create(ctx, &entity.ScheduledTransfer{
+		ID: uuid.New(), FromAccountID: accountID, ToAccountID: uuid.New(), Amount: brl(250), ScheduledFor: at.AddDate(0, 0, 10),
+		Description: "Rent share", Status: entity.ScheduledTransferStatusPending, CreatedAt: at, UpdatedAt: at,
+	}))
+	require.NoError(t, fakes.periods.Create(ctx, &entity.AccountingPeriod{
+		ID: uuid.New(), Month: time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), Closed: true,
+		Log:       []entity.PeriodLogEntry{{Action: entity.PeriodActionClosed, At: at}, {Action: entity.PeriodActionReopened, Reason: "late bill", At: at}, {Action: entity.PeriodActionClosed, At: at}},
+		CreatedAt: at, UpdatedAt: at,
+	}))
+
+	dir, err := source.ExportDataset(ctx, t.TempDir(), at)
+	require.NoError(t, err)
+
+	target, imported := newDatasetExchange()
+	result, err := target.ImportDataset(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Planning())
+	assert.Equal(t, 0, result.Skipped)
+
+	assert.Equal(t, fakes.categories.items, imported.categories.items)
+	assert.Equal(t, fakes.categoryRules.items, imported.categoryRules.items)
+	assert.Equal(t, fakes.budgets.items, imported.budgets.items)
+	assert.Equal(t, fakes.standingOrders.items, imported.standingOrders.items)
+	assert.Equal(t, fakes.sinkingFunds.items, imported.sinkingFunds.items)
+	assert.Equal(t, fakes.emergencyFund.plan, imported.emergencyFund.plan)
+	assert.Equal(t, fakes.goals.items, imported.goals.items)
+	assert.Equal(t, fakes.holdings.items, imported.holdings.items)
+	assert.Equal(t, fakes.wishlist.items, imported.wishlist.items)
+	assert.Equal(t, fakes.scheduledTransfers.items, imported.scheduledTransfers.items)
+	assert.Equal(t, fakes.periods.items, imported.periods.items)
+
+	// Importing the same dataset again creates nothing
+	result, err = target.ImportDataset(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Created())
+	assert.Equal(t, 11, result.Skipped)
+}
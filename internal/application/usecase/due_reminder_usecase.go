@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// DesktopNotifier shows a notification on the desktop the app runs on
+type DesktopNotifier interface {
+	Notify(title, body string) error
+}
+
+type DueItemKind string
+
+const (
+	DueItemBill    DueItemKind = "bill"
+	DueItemInvoice DueItemKind = "invoice"
+)
+
+// DueItem is a bill or a closed card invoice still to pay
+type DueItem struct {
+	Kind    DueItemKind
+	ID      uuid.UUID
+	Name    string
+	Amount  float64
+	DueDate time.Time
+	// DaysLeft is negative once the due date has passed
+	DaysLeft int
+}
+
+// When describes the due date relative to today, such as "in 3 days"
+func (i DueItem) When() string {
+	switch {
+	case i.DaysLeft < -1:
+		return fmt.Sprintf("overdue by %d days", -i.DaysLeft)
+	case i.DaysLeft == -1:
+		return "overdue by 1 day"
+	case i.DaysLeft == 0:
+		return "due today"
+	case i.DaysLeft == 1:
+		return "due tomorrow"
+	}
+	return fmt.Sprintf("due in %d days", i.DaysLeft)
+}
+
+// DueReminderUseCase finds the bills and card invoices coming due, to remind
+// of them on the dashboard, on the desktop and from "financli notify"
+type DueReminderUseCase struct {
+	billRepo       repository.BillRepository
+	invoiceRepo    repository.CreditCardInvoiceRepository
+	creditCardRepo repository.CreditCardRepository
+	notifications  *NotificationUseCase
+	daysAhead      int
+
+	notifier DesktopNotifier
+}
+
+// NewDueReminderUseCase reminds of what is due within daysAhead days, and of
+// what is overdue
+func NewDueReminderUseCase(
+	billRepo repository.BillRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardRepo repository.CreditCardRepository,
+	notifications *NotificationUseCase,
+	daysAhead int,
+) *DueReminderUseCase {
+	return &DueReminderUseCase{
+		billRepo:       billRepo,
+		invoiceRepo:    invoiceRepo,
+		creditCardRepo: creditCardRepo,
+		notifications:  notifications,
+		daysAhead:      daysAhead,
+	}
+}
+
+// SetDesktopNotifier lets NotifyDue show its summary on the desktop
+func (uc *DueReminderUseCase) SetDesktopNotifier(notifier DesktopNotifier) {
+	uc.notifier = notifier
+}
+
+func (uc *DueReminderUseCase) DaysAhead() int {
+	return uc.daysAhead
+}
+
+// UpcomingDue returns the unpaid bills and closed invoices due within the days
+// ahead or overdue, soonest first. The invoices of cards with their reminders
+// disabled are left out.
+func (uc *DueReminderUseCase) UpcomingDue(ctx context.Context, now time.Time) ([]DueItem, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	daysLeft := func(dueDate time.Time) int {
+		due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, now.Location())
+		return int(due.Sub(today).Hours() / 24)
+	}
+
+	var items []DueItem
+
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bills: %w", err)
+	}
+	for _, bill := range bills {
+		if bill.Status == entity.BillStatusPaid || bill.Status == entity.BillStatusClosed {
+			continue
+		}
+		remaining, err := bill.GetRemainingAmount()
+		if err != nil || remaining.IsZero() || remaining.IsNegative() {
+			continue
+		}
+		if days := daysLeft(bill.DueDate); days <= uc.daysAhead {
+			items = append(items, DueItem{Kind: DueItemBill, ID: bill.ID, Name: bill.Name, Amount: remaining.Amount(), DueDate: bill.DueDate, DaysLeft: days})
+		}
+	}
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit cards: %w", err)
+	}
+	for _, card := range cards {
+		if card.InvoiceReminders.Disabled {
+			continue
+		}
+
+		invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get invoices of %s: %w", card.Name, err)
+		}
+		for _, invoice := range invoices {
+			if invoice.IsOpen() || invoice.IsSettled() || invoice.ClosingBalance.IsZero() || invoice.ClosingBalance.IsNegative() {
+				continue
+			}
+			if days := daysLeft(invoice.DueDate); days <= uc.daysAhead {
+				name := fmt.Sprintf("%s invoice %s", card.Name, invoice.ReferenceMonth)
+				items = append(items, DueItem{Kind: DueItemInvoice, ID: invoice.ID, Name: name, Amount: invoice.ClosingBalance.Amount(), DueDate: invoice.DueDate, DaysLeft: days})
+			}
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DueDate.Before(items[j].DueDate)
+	})
+	return items, nil
+}
+
+// NotifyDue returns what UpcomingDue does and shows it on the desktop, once a
+// day, so it's safe to run on every launch and from cron. Without a desktop
+// notifier it only returns the items.
+func (uc *DueReminderUseCase) NotifyDue(ctx context.Context, now time.Time) ([]DueItem, error) {
+	items, err := uc.UpcomingDue(ctx, now)
+	if err != nil || len(items) == 0 || uc.notifier == nil {
+		return items, err
+	}
+
+	// The notifications center keeps the summary, and remembers it was shown
+	key := fmt.Sprintf("due-reminder:%s", now.Format("2006-01-02"))
+	notified, err := uc.notifications.Notified(ctx, key)
+	if err != nil || notified {
+		return items, err
+	}
+
+	title, body, severity := dueSummary(items, uc.daysAhead)
+	if err := uc.notifier.Notify(title, body); err != nil {
+		return items, fmt.Errorf("failed to show desktop notification: %w", err)
+	}
+	if _, err := uc.notifications.NotifyWithSeverity(ctx, key, title, body, severity); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// dueSummary sums up the items in a notification, as urgent as the most
+// urgent of them
+func dueSummary(items []DueItem, daysAhead int) (string, string, entity.NotificationSeverity) {
+	title := fmt.Sprintf("%d bills and invoices due within %d days", len(items), daysAhead)
+	if len(items) == 1 {
+		title = fmt.Sprintf("%s is %s", items[0].Name, items[0].When())
+	}
+
+	severity := entity.NotificationSeverityWarning
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.DaysLeft <= 0 {
+			severity = entity.NotificationSeverityCritical
+		}
+		lines = append(lines, fmt.Sprintf("%s: R$ %.2f, %s", item.Name, item.Amount, item.When()))
+	}
+	return title, strings.Join(lines, "\n"), severity
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+// MailSource reads the emails waiting in the mailbox e-receipts are filed under
+type MailSource interface {
+	// FetchNew returns the emails not processed yet
+	FetchNew(ctx context.Context) ([]*ReceiptEmail, error)
+	// MarkProcessed keeps the emails out of the next fetches
+	MarkProcessed(ctx context.Context, ids []string) error
+}
+
+// ReceiptEmail is an email as plain text
+type ReceiptEmail struct {
+	ID      string
+	From    string
+	Subject string
+	Date    time.Time
+	Body    string
+}
+
+// ifoodRestaurantPattern matches the restaurant of an iFood order email
+var ifoodRestaurantPattern = regexp.MustCompile(`(?i)pedido (?:em|no|na|de)\s+([^\n!.,]+?)(?:\s+(?:foi|chegou|está|esta|saiu)\b|[\n!.,]|$)`)
+
+// receiptEmail is what a known e-receipt format says about the purchase
+type receiptEmail struct {
+	kind        string
+	description string
+	category    entity.TransactionCategory
+	amount      float64
+	cardDigits  string
+}
+
+// EmailReceiptUseCase reads e-receipts and card alerts from a mailbox and
+// queues the purchases they describe in the review inbox
+type EmailReceiptUseCase struct {
+	source         MailSource
+	inboxUseCase   *InboxUseCase
+	creditCardRepo repository.CreditCardRepository
+}
+
+func NewEmailReceiptUseCase(source MailSource, inboxUseCase *InboxUseCase, creditCardRepo repository.CreditCardRepository) *EmailReceiptUseCase {
+	return &EmailReceiptUseCase{
+		source:         source,
+		inboxUseCase:   inboxUseCase,
+		creditCardRepo: creditCardRepo,
+	}
+}
+
+// IngestReceipts queues a pending transaction for every new email in a known
+// format, returning how many were queued. Emails that can't be read, or whose
+// card can't be told, are left in the mailbox untouched.
+func (uc *EmailReceiptUseCase) IngestReceipts(ctx context.Context, now time.Time) (int, error) {
+	emails, err := uc.source.FetchNew(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the mailbox: %w", err)
+	}
+	if len(emails) == 0 {
+		return 0, nil
+	}
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load credit cards: %w", err)
+	}
+
+	var processed []string
+	queued := 0
+	for _, email := range emails {
+		receipt, ok := parseReceiptEmail(email)
+		if !ok {
+			continue
+		}
+		card := alertCard(cards, receipt.cardDigits)
+		if card == nil {
+			continue
+		}
+
+		date := email.Date
+		if date.IsZero() || date.After(now) {
+			date = now
+		}
+		cardID := card.ID
+		txn := entity.NewTransaction(
+			nil,
+			&cardID,
+			entity.TransactionTypeDebit,
+			receipt.category,
+			valueobject.NewMoney(receipt.amount, card.CurrentBalance.Currency()),
+			receipt.description,
+			date,
+		)
+		if _, err := uc.inboxUseCase.Submit(ctx, "email: "+receipt.kind, []*entity.Transaction{txn}); err != nil {
+			return queued, err
+		}
+		processed = append(processed, email.ID)
+		queued++
+	}
+
+	if err := uc.source.MarkProcessed(ctx, processed); err != nil {
+		return queued, fmt.Errorf("failed to mark emails as read: %w", err)
+	}
+	return queued, nil
+}
+
+// parseReceiptEmail recognizes the formats of iFood and Uber receipts and of
+// card purchase alerts
+func parseReceiptEmail(email *ReceiptEmail) (*receiptEmail, bool) {
+	sender := strings.ToLower(email.From)
+	text := email.Subject + "\n" + email.Body
+	lower := strings.ToLower(text)
+
+	var receipt *receiptEmail
+	switch {
+	case strings.Contains(sender, "ifood"):
+		receipt = &receiptEmail{kind: "iFood", description: "iFood", category: entity.TransactionCategoryFood}
+		if match := ifoodRestaurantPattern.FindStringSubmatch(text); match != nil {
+			receipt.description = "iFood - " + strings.TrimSpace(match[1])
+		}
+	case strings.Contains(sender, "uber"):
+		receipt = &receiptEmail{kind: "Uber", description: "Uber", category: entity.TransactionCategoryTransportation}
+		if strings.Contains(lower, "uber eats") || strings.Contains(sender, "eats") {
+			receipt.description = "Uber Eats"
+			receipt.category = entity.TransactionCategoryFood
+		}
+	case isCardAlert(lower):
+		alert, ok := parseCardAlert(text)
+		if !ok {
+			return nil, false
+		}
+		receipt = &receiptEmail{kind: "card alert", description: "Card purchase", category: entity.TransactionCategoryOther, amount: alert.amount, cardDigits: alert.cardDigits}
+		if alert.merchant != "" {
+			receipt.description = alert.merchant
+		}
+		return receipt, true
+	default:
+		return nil, false
+	}
+
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	receipt.amount = receiptTotal(lines)
+	if receipt.amount <= 0 {
+		return nil, false
+	}
+
+	if match := cardDigitsPattern.FindStringSubmatch(text); match != nil {
+		receipt.cardDigits = match[1]
+	}
+	return receipt, true
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"testing"
+
+	"financli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReceiptEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		email ReceiptEmail
+		want  receiptEmail
+	}{
+		{
+			name: "iFood order",
+			email: ReceiptEmail{
+				From:    "iFood <pedidos@ifood.com.br>",
+				Subject: "Seu pedido em Pizzaria Bella foi entregue!",
+				Body:    "Subtotal R$ 62,00\nTaxa de entrega R$ 7,90\nTotal R$ 69,90\nPago com cartão final 1234",
+			},
+			want: receiptEmail{kind: "iFood", description: "iFood - Pizzaria Bella", category: entity.TransactionCategoryFood, amount: 69.9, cardDigits: "1234"},
+		},
+		{
+			name: "iFood order without a restaurant",
+			email: ReceiptEmail{
+				From:    "noreply@ifood.com.br",
+				Subject: "Recibo",
+				Body:    "Valor pago: R$ 25,50",
+			},
+			want: receiptEmail{kind: "iFood", description: "iFood", category: entity.TransactionCategoryFood, amount: 25.5},
+		},
+		{
+			name: "Uber trip",
+			email: ReceiptEmail{
+				From:    "Uber Receipts <noreply@uber.com>",
+				Subject: "Sua viagem de terça-feira",
+				Body:    "Total R$ 32,47\nTarifa base R$ 5,00\nVisa ending in 4321",
+			},
+			want: receiptEmail{kind: "Uber", description: "Uber", category: entity.TransactionCategoryTransportation, amount: 32.47, cardDigits: "4321"},
+		},
+		{
+			name: "Uber Eats order",
+			email: ReceiptEmail{
+				From:    "Uber Eats <noreply@uber.com>",
+				Subject: "Seu pedido",
+				Body:    "Subtotal R$ 40,00\nTotal R$ 45,99",
+			},
+			want: receiptEmail{kind: "Uber", description: "Uber Eats", category: entity.TransactionCategoryFood, amount: 45.99},
+		},
+		{
+			name: "card alert from the bank",
+			email: ReceiptEmail{
+				From:    "Banco <alertas@banco.com.br>",
+				Subject: "Compra aprovada",
+				Body:    "Compra aprovada no cartão final 9876: R$ 120,00 em LIVRARIA CULTURA.",
+			},
+			want: receiptEmail{kind: "card alert", description: "LIVRARIA CULTURA", category: entity.TransactionCategoryOther, amount: 120, cardDigits: "9876"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := tt.email
+			receipt, ok := parseReceiptEmail(&email)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, *receipt)
+		})
+	}
+}
+
+func TestParseReceiptEmail_Unknown(t *testing.T) {
+	tests := []struct {
+		name  string
+		email ReceiptEmail
+	}{
+		{"newsletter", ReceiptEmail{From: "news@loja.com.br", Subject: "Ofertas da semana", Body: "Tudo por R$ 9,90"}},
+		{"iFood without an amount", ReceiptEmail{From: "ifood@ifood.com.br", Subject: "Avalie seu pedido", Body: "Conte como foi"}},
+		{"declined card alert", ReceiptEmail{From: "alertas@banco.com.br", Subject: "Compra aprovada?", Body: "Compra recusada: R$ 45,90 em PADARIA"}},
+		{"empty", ReceiptEmail{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := tt.email
+			_, ok := parseReceiptEmail(&email)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestReceiptTotal(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  float64
+	}{
+		{"total line", []string{"Item A 10,00", "Subtotal 30,00", "Desconto 5,00", "Total 25,00"}, 25},
+		{"largest amount without a total", []string{"Item A 10,00", "Item B 1.250,00"}, 1250},
+		{"last amount of the total line", []string{"Total (2 itens) 4,50 12,00"}, 12},
+		{"no amount", []string{"Obrigado pela preferência"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, receiptTotal(tt.lines), 0.0001)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// essentialLookbackMonths is how many closed months are averaged when the
+// essential expenses are inferred
+const essentialLookbackMonths = 3
+
+// EmergencyFundStatus is how far the emergency fund is from its target
+type EmergencyFundStatus struct {
+	Plan             *entity.EmergencyFundPlan
+	Accounts         []*entity.Account // The accounts holding the fund
+	Saved            float64
+	EssentialMonthly float64 // Set by the user, or inferred when Plan.IsInferred
+}
+
+// MonthsCovered is how many months of essential expenses the fund pays for
+func (s *EmergencyFundStatus) MonthsCovered() float64 {
+	if s.EssentialMonthly <= 0 {
+		return 0
+	}
+	return s.Saved / s.EssentialMonthly
+}
+
+func (s *EmergencyFundStatus) TargetAmount() float64 {
+	return s.EssentialMonthly * float64(s.Plan.TargetMonths)
+}
+
+// Progress is the percentage of the target saved, capped at 100
+func (s *EmergencyFundStatus) Progress() float64 {
+	target := s.TargetAmount()
+	if target <= 0 {
+		return 0
+	}
+	progress := s.Saved / target * 100
+	if progress > 100 {
+		return 100
+	}
+	return progress
+}
+
+func (s *EmergencyFundStatus) Missing() float64 {
+	if missing := s.TargetAmount() - s.Saved; missing > 0 {
+		return missing
+	}
+	return 0
+}
+
+type EmergencyFundUseCase struct {
+	planRepo        repository.EmergencyFundRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewEmergencyFundUseCase(
+	planRepo repository.EmergencyFundRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+) *EmergencyFundUseCase {
+	return &EmergencyFundUseCase{
+		planRepo:        planRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// GetPlan returns the saved plan, or the default one when there is none yet
+func (uc *EmergencyFundUseCase) GetPlan(ctx context.Context) (*entity.EmergencyFundPlan, error) {
+	plan, err := uc.planRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		plan = entity.NewEmergencyFundPlan("BRL")
+	}
+	return plan, nil
+}
+
+// SavePlan updates the plan; an essentialMonthly of zero infers the expenses
+// and no accountIDs counts every savings account
+func (uc *EmergencyFundUseCase) SavePlan(ctx context.Context, essentialMonthly float64, targetMonths int, accountIDs []uuid.UUID) (*entity.EmergencyFundPlan, error) {
+	plan, err := uc.GetPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := plan.SetEssentialMonthly(valueobject.NewMoney(essentialMonthly, plan.EssentialMonthly.Currency())); err != nil {
+		return nil, err
+	}
+	if err := plan.SetTargetMonths(targetMonths); err != nil {
+		return nil, err
+	}
+	plan.SetAccounts(accountIDs)
+
+	if err := uc.planRepo.Save(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (uc *EmergencyFundUseCase) GetStatus(ctx context.Context, now time.Time) (*EmergencyFundStatus, error) {
+	plan, err := uc.GetPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	status := &EmergencyFundStatus{Plan: plan, EssentialMonthly: plan.EssentialMonthly.Amount()}
+	for _, account := range accounts {
+		if plan.Holds(account) {
+			status.Accounts = append(status.Accounts, account)
+			status.Saved += account.Balance.Amount()
+		}
+	}
+
+	if plan.IsInferred() {
+		status.EssentialMonthly, err = uc.InferEssentialMonthly(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// InferEssentialMonthly averages the spending in essential categories over the
+// last closed months
+func (uc *EmergencyFundUseCase) InferEssentialMonthly(ctx context.Context, now time.Time) (float64, error) {
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	start := end.AddDate(0, -essentialLookbackMonths, 0)
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, start, end.Add(-time.Nanosecond))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	var total float64
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.IgnoreFromBudget || !entity.IsEssentialCategory(txn.Category) {
+			continue
+		}
+		total += txn.Amount.Amount()
+	}
+
+	return total / essentialLookbackMonths, nil
+}
@@ -0,0 +1,454 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var errFakeNotFound = errors.New("not found")
+
+// memStore keeps copies of entities by ID, so a use case only changes what it
+// saves back
+type memStore[T any] map[uuid.UUID]*T
+
+func (s memStore[T]) put(id uuid.UUID, item *T) {
+	saved := *item
+	s[id] = &saved
+}
+
+func (s memStore[T]) get(id uuid.UUID) (*T, error) {
+	item, ok := s[id]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+	found := *item
+	return &found, nil
+}
+
+func (s memStore[T]) all() []*T {
+	items := make([]*T, 0, len(s))
+	for id := range s {
+		found, _ := s.get(id)
+		items = append(items, found)
+	}
+	return items
+}
+
+// The fakes embed their repository interface, so a method a test doesn't
+// stub panics rather than passing silently
+
+type fakeTransactionRepo struct {
+	repository.TransactionRepository
+	items memStore[entity.Transaction]
+}
+
+func newFakeTransactionRepo(transactions ...*entity.Transaction) *fakeTransactionRepo {
+	r := &fakeTransactionRepo{items: memStore[entity.Transaction]{}}
+	for _, transaction := range transactions {
+		r.items.put(transaction.ID, transaction)
+	}
+	return r
+}
+
+func (r *fakeTransactionRepo) Create(_ context.Context, transaction *entity.Transaction) error {
+	r.items.put(transaction.ID, transaction)
+	return nil
+}
+
+func (r *fakeTransactionRepo) Update(_ context.Context, transaction *entity.Transaction) error {
+	r.items.put(transaction.ID, transaction)
+	return nil
+}
+
+func (r *fakeTransactionRepo) FindByID(_ context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	return r.items.get(id)
+}
+
+func (r *fakeTransactionRepo) FindByDateRange(_ context.Context, start, end time.Time) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	for id, transaction := range r.items {
+		if !transaction.Date.Before(start) && !transaction.Date.After(end) {
+			found, _ := r.items.get(id)
+			transactions = append(transactions, found)
+		}
+	}
+	return transactions, nil
+}
+
+type fakeCreditCardRepo struct {
+	repository.CreditCardRepository
+	items memStore[entity.CreditCard]
+}
+
+func newFakeCreditCardRepo(cards ...*entity.CreditCard) *fakeCreditCardRepo {
+	r := &fakeCreditCardRepo{items: memStore[entity.CreditCard]{}}
+	for _, card := range cards {
+		r.items.put(card.ID, card)
+	}
+	return r
+}
+
+func (r *fakeCreditCardRepo) Create(_ context.Context, card *entity.CreditCard) error {
+	r.items.put(card.ID, card)
+	return nil
+}
+
+func (r *fakeCreditCardRepo) Update(_ context.Context, card *entity.CreditCard) error {
+	r.items.put(card.ID, card)
+	return nil
+}
+
+func (r *fakeCreditCardRepo) FindByID(_ context.Context, id uuid.UUID) (*entity.CreditCard, error) {
+	return r.items.get(id)
+}
+
+func (r *fakeCreditCardRepo) FindAll(_ context.Context) ([]*entity.CreditCard, error) {
+	return r.items.all(), nil
+}
+
+type fakeInvoiceRepo struct {
+	repository.CreditCardInvoiceRepository
+	items memStore[entity.CreditCardInvoice]
+	// findErr, when set, fails the lookups of a card's invoices
+	findErr error
+}
+
+func newFakeInvoiceRepo(invoices ...*entity.CreditCardInvoice) *fakeInvoiceRepo {
+	r := &fakeInvoiceRepo{items: memStore[entity.CreditCardInvoice]{}}
+	for _, invoice := range invoices {
+		r.items.put(invoice.ID, invoice)
+	}
+	return r
+}
+
+func (r *fakeInvoiceRepo) Create(_ context.Context, invoice *entity.CreditCardInvoice) error {
+	r.items.put(invoice.ID, invoice)
+	return nil
+}
+
+func (r *fakeInvoiceRepo) Update(_ context.Context, invoice *entity.CreditCardInvoice) error {
+	r.items.put(invoice.ID, invoice)
+	return nil
+}
+
+func (r *fakeInvoiceRepo) FindByID(_ context.Context, id uuid.UUID) (*entity.CreditCardInvoice, error) {
+	return r.items.get(id)
+}
+
+func (r *fakeInvoiceRepo) FindByCreditCard(_ context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error) {
+	if r.findErr != nil {
+		return nil, r.findErr
+	}
+	var invoices []*entity.CreditCardInvoice
+	for id, invoice := range r.items {
+		if invoice.CreditCardID == creditCardID {
+			found, _ := r.items.get(id)
+			invoices = append(invoices, found)
+		}
+	}
+	return invoices, nil
+}
+
+func (r *fakeInvoiceRepo) FindByStatus(_ context.Context, creditCardID uuid.UUID, status entity.InvoiceStatus) ([]*entity.CreditCardInvoice, error) {
+	var invoices []*entity.CreditCardInvoice
+	for id, invoice := range r.items {
+		if invoice.CreditCardID == creditCardID && invoice.Status == status {
+			found, _ := r.items.get(id)
+			invoices = append(invoices, found)
+		}
+	}
+	return invoices, nil
+}
+
+type fakePeriodRepo struct {
+	repository.AccountingPeriodRepository
+	items memStore[entity.AccountingPeriod]
+}
+
+func newFakePeriodRepo() *fakePeriodRepo {
+	return &fakePeriodRepo{items: memStore[entity.AccountingPeriod]{}}
+}
+
+func (r *fakePeriodRepo) Create(_ context.Context, period *entity.AccountingPeriod) error {
+	r.items.put(period.ID, period)
+	return nil
+}
+
+func (r *fakePeriodRepo) Update(_ context.Context, period *entity.AccountingPeriod) error {
+	r.items.put(period.ID, period)
+	return nil
+}
+
+func (r *fakePeriodRepo) FindAll(_ context.Context) ([]*entity.AccountingPeriod, error) {
+	return r.items.all(), nil
+}
+
+type fakeBudgetRepo struct {
+	repository.BudgetRepository
+	items memStore[entity.Budget]
+}
+
+func newFakeBudgetRepo(budgets ...*entity.Budget) *fakeBudgetRepo {
+	r := &fakeBudgetRepo{items: memStore[entity.Budget]{}}
+	for _, budget := range budgets {
+		r.items.put(budget.ID, budget)
+	}
+	return r
+}
+
+func (r *fakeBudgetRepo) Update(_ context.Context, budget *entity.Budget) error {
+	r.items.put(budget.ID, budget)
+	return nil
+}
+
+func (r *fakeBudgetRepo) FindByID(_ context.Context, id uuid.UUID) (*entity.Budget, error) {
+	return r.items.get(id)
+}
+
+func (r *fakeBudgetRepo) FindAll(_ context.Context) ([]*entity.Budget, error) {
+	return r.items.all(), nil
+}
+
+func (r *fakeTransactionRepo) FindAll(_ context.Context) ([]*entity.Transaction, error) {
+	return r.items.all(), nil
+}
+
+func (r *fakeTransactionRepo) CreateMany(_ context.Context, transactions []*entity.Transaction) error {
+	for _, transaction := range transactions {
+		r.items.put(transaction.ID, transaction)
+	}
+	return nil
+}
+
+func (r *fakeBudgetRepo) Create(_ context.Context, budget *entity.Budget) error {
+	r.items.put(budget.ID, budget)
+	return nil
+}
+
+type fakePersonRepo struct {
+	repository.PersonRepository
+	items memStore[entity.Person]
+}
+
+func newFakePersonRepo() *fakePersonRepo {
+	return &fakePersonRepo{items: memStore[entity.Person]{}}
+}
+
+func (r *fakePersonRepo) Create(_ context.Context, person *entity.Person) error {
+	r.items.put(person.ID, person)
+	return nil
+}
+
+func (r *fakePersonRepo) FindAll(_ context.Context) ([]*entity.Person, error) {
+	return r.items.all(), nil
+}
+
+type fakeAccountRepo struct {
+	repository.AccountRepository
+	items memStore[entity.Account]
+}
+
+func newFakeAccountRepo() *fakeAccountRepo {
+	return &fakeAccountRepo{items: memStore[entity.Account]{}}
+}
+
+func (r *fakeAccountRepo) Create(_ context.Context, account *entity.Account) error {
+	r.items.put(account.ID, account)
+	return nil
+}
+
+func (r *fakeAccountRepo) FindAll(_ context.Context) ([]*entity.Account, error) {
+	return r.items.all(), nil
+}
+
+type fakeBillRepo struct {
+	repository.BillRepository
+	items memStore[entity.Bill]
+}
+
+func newFakeBillRepo() *fakeBillRepo {
+	return &fakeBillRepo{items: memStore[entity.Bill]{}}
+}
+
+func (r *fakeBillRepo) Create(_ context.Context, bill *entity.Bill) error {
+	r.items.put(bill.ID, bill)
+	return nil
+}
+
+func (r *fakeBillRepo) FindByID(_ context.Context, id uuid.UUID) (*entity.Bill, error) {
+	return r.items.get(id)
+}
+
+func (r *fakeBillRepo) FindAll(_ context.Context) ([]*entity.Bill, error) {
+	return r.items.all(), nil
+}
+
+type fakeCategoryRepo struct {
+	repository.CategoryRepository
+	items memStore[entity.Category]
+}
+
+func newFakeCategoryRepo() *fakeCategoryRepo {
+	return &fakeCategoryRepo{items: memStore[entity.Category]{}}
+}
+
+func (r *fakeCategoryRepo) Create(_ context.Context, category *entity.Category) error {
+	r.items.put(category.ID, category)
+	return nil
+}
+
+func (r *fakeCategoryRepo) FindAll(_ context.Context) ([]*entity.Category, error) {
+	return r.items.all(), nil
+}
+
+type fakeCategoryRuleRepo struct {
+	repository.CategoryRuleRepository
+	items memStore[entity.CategoryRule]
+}
+
+func newFakeCategoryRuleRepo() *fakeCategoryRuleRepo {
+	return &fakeCategoryRuleRepo{items: memStore[entity.CategoryRule]{}}
+}
+
+func (r *fakeCategoryRuleRepo) Create(_ context.Context, rule *entity.CategoryRule) error {
+	r.items.put(rule.ID, rule)
+	return nil
+}
+
+func (r *fakeCategoryRuleRepo) FindAll(_ context.Context) ([]*entity.CategoryRule, error) {
+	return r.items.all(), nil
+}
+
+type fakeStandingOrderRepo struct {
+	repository.StandingOrderRepository
+	items memStore[entity.StandingOrder]
+}
+
+func newFakeStandingOrderRepo() *fakeStandingOrderRepo {
+	return &fakeStandingOrderRepo{items: memStore[entity.StandingOrder]{}}
+}
+
+func (r *fakeStandingOrderRepo) Create(_ context.Context, order *entity.StandingOrder) error {
+	r.items.put(order.ID, order)
+	return nil
+}
+
+func (r *fakeStandingOrderRepo) FindAll(_ context.Context) ([]*entity.StandingOrder, error) {
+	return r.items.all(), nil
+}
+
+type fakeSinkingFundRepo struct {
+	repository.SinkingFundRepository
+	items memStore[entity.SinkingFund]
+}
+
+func newFakeSinkingFundRepo() *fakeSinkingFundRepo {
+	return &fakeSinkingFundRepo{items: memStore[entity.SinkingFund]{}}
+}
+
+func (r *fakeSinkingFundRepo) Create(_ context.Context, fund *entity.SinkingFund) error {
+	r.items.put(fund.ID, fund)
+	return nil
+}
+
+func (r *fakeSinkingFundRepo) FindAll(_ context.Context) ([]*entity.SinkingFund, error) {
+	return r.items.all(), nil
+}
+
+type fakeGoalRepo struct {
+	repository.GoalRepository
+	items memStore[entity.Goal]
+}
+
+func newFakeGoalRepo() *fakeGoalRepo {
+	return &fakeGoalRepo{items: memStore[entity.Goal]{}}
+}
+
+func (r *fakeGoalRepo) Create(_ context.Context, goal *entity.Goal) error {
+	r.items.put(goal.ID, goal)
+	return nil
+}
+
+func (r *fakeGoalRepo) FindAll(_ context.Context) ([]*entity.Goal, error) {
+	return r.items.all(), nil
+}
+
+type fakeHoldingRepo struct {
+	repository.HoldingRepository
+	items memStore[entity.Holding]
+}
+
+func newFakeHoldingRepo() *fakeHoldingRepo {
+	return &fakeHoldingRepo{items: memStore[entity.Holding]{}}
+}
+
+func (r *fakeHoldingRepo) Create(_ context.Context, holding *entity.Holding) error {
+	r.items.put(holding.ID, holding)
+	return nil
+}
+
+func (r *fakeHoldingRepo) FindAll(_ context.Context) ([]*entity.Holding, error) {
+	return r.items.all(), nil
+}
+
+type fakeWishlistRepo struct {
+	repository.WishlistRepository
+	items memStore[entity.WishlistItem]
+}
+
+func newFakeWishlistRepo() *fakeWishlistRepo {
+	return &fakeWishlistRepo{items: memStore[entity.WishlistItem]{}}
+}
+
+func (r *fakeWishlistRepo) Create(_ context.Context, item *entity.WishlistItem) error {
+	r.items.put(item.ID, item)
+	return nil
+}
+
+func (r *fakeWishlistRepo) FindAll(_ context.Context) ([]*entity.WishlistItem, error) {
+	return r.items.all(), nil
+}
+
+type fakeScheduledTransferRepo struct {
+	repository.ScheduledTransferRepository
+	items memStore[entity.ScheduledTransfer]
+}
+
+func newFakeScheduledTransferRepo() *fakeScheduledTransferRepo {
+	return &fakeScheduledTransferRepo{items: memStore[entity.ScheduledTransfer]{}}
+}
+
+func (r *fakeScheduledTransferRepo) Create(_ context.Context, transfer *entity.ScheduledTransfer) error {
+	r.items.put(transfer.ID, transfer)
+	return nil
+}
+
+func (r *fakeScheduledTransferRepo) FindPending(_ context.Context) ([]*entity.ScheduledTransfer, error) {
+	var pending []*entity.ScheduledTransfer
+	for _, transfer := range r.items.all() {
+		if transfer.IsPending() {
+			pending = append(pending, transfer)
+		}
+	}
+	return pending, nil
+}
+
+type fakeEmergencyFundRepo struct {
+	repository.EmergencyFundRepository
+	plan *entity.EmergencyFundPlan
+}
+
+func (r *fakeEmergencyFundRepo) Get(_ context.Context) (*entity.EmergencyFundPlan, error) {
+	return r.plan, nil
+}
+
+func (r *fakeEmergencyFundRepo) Save(_ context.Context, plan *entity.EmergencyFundPlan) error {
+	saved := *plan
+	r.plan = &saved
+	return nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type FilterPresetUseCase struct {
+	filterPresetRepo repository.FilterPresetRepository
+}
+
+func NewFilterPresetUseCase(filterPresetRepo repository.FilterPresetRepository) *FilterPresetUseCase {
+	return &FilterPresetUseCase{
+		filterPresetRepo: filterPresetRepo,
+	}
+}
+
+// SavePreset stores the filter under the given name, overwriting the preset
+// that already has that name, if any
+func (uc *FilterPresetUseCase) SavePreset(ctx context.Context, name string, filter entity.TransactionFilter) (*entity.FilterPreset, error) {
+	presets, err := uc.filterPresetRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filter presets: %w", err)
+	}
+
+	for _, preset := range presets {
+		if !preset.HasName(name) {
+			continue
+		}
+
+		if err := preset.SetFilter(filter); err != nil {
+			return nil, err
+		}
+		if err := uc.filterPresetRepo.Update(ctx, preset); err != nil {
+			return nil, fmt.Errorf("failed to save filter preset: %w", err)
+		}
+		return preset, nil
+	}
+
+	preset, err := entity.NewFilterPreset(name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.filterPresetRepo.Create(ctx, preset); err != nil {
+		return nil, fmt.Errorf("failed to save filter preset: %w", err)
+	}
+
+	return preset, nil
+}
+
+func (uc *FilterPresetUseCase) ListPresets(ctx context.Context) ([]*entity.FilterPreset, error) {
+	return uc.filterPresetRepo.FindAll(ctx)
+}
+
+func (uc *FilterPresetUseCase) DeletePreset(ctx context.Context, id uuid.UUID) error {
+	return uc.filterPresetRepo.Delete(ctx, id)
+}
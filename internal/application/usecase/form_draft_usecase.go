@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+)
+
+// FormDraftUseCase keeps the drafts of the new transaction and bill forms in
+// storage, so they outlive the app
+type FormDraftUseCase struct {
+	draftRepo repository.FormDraftRepository
+}
+
+func NewFormDraftUseCase(draftRepo repository.FormDraftRepository) *FormDraftUseCase {
+	return &FormDraftUseCase{
+		draftRepo: draftRepo,
+	}
+}
+
+// GetDraft returns the draft of the form, or nil when there's none
+func (uc *FormDraftUseCase) GetDraft(ctx context.Context, form entity.FormDraftKind) (*entity.FormDraft, error) {
+	return uc.draftRepo.Get(ctx, form)
+}
+
+// SaveDraft replaces the draft of the form with fields
+func (uc *FormDraftUseCase) SaveDraft(ctx context.Context, form entity.FormDraftKind, fields map[string]string) error {
+	draft, err := entity.NewFormDraft(form, fields)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.draftRepo.Save(ctx, draft); err != nil {
+		return fmt.Errorf("failed to save form draft: %w", err)
+	}
+	return nil
+}
+
+// DiscardDraft drops the draft of the form, once its record is saved or the
+// user throws it away
+func (uc *FormDraftUseCase) DiscardDraft(ctx context.Context, form entity.FormDraftKind) error {
+	return uc.draftRepo.Delete(ctx, form)
+}
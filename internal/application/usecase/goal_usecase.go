@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// goalSavingsMonths is how many months back the savings rate of a goal's
+// account is averaged over
+const goalSavingsMonths = 3
+
+// GoalProgress is how far a goal got, with the pace its account has been
+// saving at lately
+type GoalProgress struct {
+	Goal *entity.Goal
+	// Account is nil when the linked account was deleted
+	Account *entity.Account
+	Saved   float64
+	// MonthlySavings is the average net amount that went into the account per
+	// month over the recent months
+	MonthlySavings float64
+}
+
+func (p *GoalProgress) Percentage() float64 {
+	return p.Goal.Percentage(p.Saved)
+}
+
+func (p *GoalProgress) Remaining() float64 {
+	return p.Goal.Remaining(p.Saved)
+}
+
+func (p *GoalProgress) IsReached() bool {
+	return p.Remaining() == 0
+}
+
+// ProjectedCompletion returns when the goal is reached at the recent savings
+// rate, or false when the account hasn't been saving
+func (p *GoalProgress) ProjectedCompletion(now time.Time) (time.Time, bool) {
+	return p.Goal.ProjectedCompletion(p.Saved, p.MonthlySavings, now)
+}
+
+// OnTrack tells whether the recent savings rate reaches the goal by its deadline
+func (p *GoalProgress) OnTrack(now time.Time) bool {
+	date, ok := p.ProjectedCompletion(now)
+	return ok && !date.After(p.Goal.Deadline)
+}
+
+// RequiredMonthly returns how much must be saved each month left to reach the
+// goal by its deadline; all that's missing once the deadline has passed
+func (p *GoalProgress) RequiredMonthly(now time.Time) float64 {
+	months := p.Goal.MonthsLeft(now)
+	if months == 0 {
+		return p.Remaining()
+	}
+	return p.Remaining() / float64(months)
+}
+
+type GoalUseCase struct {
+	goalRepo        repository.GoalRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewGoalUseCase(goalRepo repository.GoalRepository, accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *GoalUseCase {
+	return &GoalUseCase{
+		goalRepo:        goalRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (uc *GoalUseCase) CreateGoal(ctx context.Context, name string, targetAmount float64, currency string, deadline time.Time, accountID uuid.UUID) (*entity.Goal, error) {
+	if _, err := uc.accountRepo.FindByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+
+	goal, err := entity.NewGoal(name, valueobject.NewMoney(targetAmount, currency), deadline, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.goalRepo.Create(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to save goal: %w", err)
+	}
+
+	return goal, nil
+}
+
+func (uc *GoalUseCase) UpdateGoal(ctx context.Context, id uuid.UUID, name string, targetAmount float64, deadline time.Time, accountID uuid.UUID) (*entity.Goal, error) {
+	goal, err := uc.goalRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.accountRepo.FindByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+
+	if err := goal.Revise(name, valueobject.NewMoney(targetAmount, goal.TargetAmount.Currency()), deadline, accountID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	return goal, nil
+}
+
+func (uc *GoalUseCase) DeleteGoal(ctx context.Context, id uuid.UUID) error {
+	return uc.goalRepo.Delete(ctx, id)
+}
+
+func (uc *GoalUseCase) ListGoals(ctx context.Context) ([]*entity.Goal, error) {
+	return uc.goalRepo.FindAll(ctx)
+}
+
+// GetGoalProgress measures each goal against the balance of its account, and
+// works out the account's savings rate from its transactions of the last
+// months so the screens can project when each goal is reached
+func (uc *GoalUseCase) GetGoalProgress(ctx context.Context, now time.Time) ([]*GoalProgress, error) {
+	goals, err := uc.goalRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+	if len(goals) == 0 {
+		return nil, nil
+	}
+
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	byID := make(map[uuid.UUID]*entity.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	// Goals often share an account, so each account's rate is worked out once
+	savings := make(map[uuid.UUID]float64)
+	since := now.AddDate(0, -goalSavingsMonths, 0)
+	progress := make([]*GoalProgress, len(goals))
+	for i, goal := range goals {
+		progress[i] = &GoalProgress{Goal: goal}
+		account, ok := byID[goal.AccountID]
+		if !ok {
+			continue
+		}
+		progress[i].Account = account
+		progress[i].Saved = account.Balance.Amount()
+
+		rate, ok := savings[account.ID]
+		if !ok {
+			rate, err = uc.monthlySavings(ctx, account.ID, since, now)
+			if err != nil {
+				return nil, err
+			}
+			savings[account.ID] = rate
+		}
+		progress[i].MonthlySavings = rate
+	}
+
+	return progress, nil
+}
+
+// monthlySavings averages per month what went into the account minus what
+// came out of it between since and now
+func (uc *GoalUseCase) monthlySavings(ctx context.Context, accountID uuid.UUID, since, now time.Time) (float64, error) {
+	transactions, err := uc.transactionRepo.FindByAccountID(ctx, accountID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get account transactions: %w", err)
+	}
+
+	var net float64
+	for _, txn := range transactions {
+		if txn.Date.Before(since) || txn.Date.After(now) {
+			continue
+		}
+		if txn.Type == entity.TransactionTypeCredit {
+			net += txn.Amount.Amount()
+		} else {
+			net -= txn.Amount.Amount()
+		}
+	}
+	return net / goalSavingsMonths, nil
+}
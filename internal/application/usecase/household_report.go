@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// HouseholdReport sums up a month of the expenses shared with the people of
+// the household: who paid what, each one's share and how the shared bills
+// were split
+type HouseholdReport struct {
+	Month time.Time
+	// Total is what the month's shared expenses add up to
+	Total float64
+	// Members are you first, then the people with a share of the month's
+	// expenses, by name
+	Members []*HouseholdMember
+	// Bills are the bills with shared expenses in the month, by name
+	Bills []*HouseholdBill
+}
+
+// HouseholdMember is what one member of the household paid and took of the
+// month's shared expenses
+type HouseholdMember struct {
+	// Person is nil for you
+	Person *entity.Person
+	// Paid is what the member paid: the shared expenses are the ones you
+	// recorded, so it's all yours until the people settle up
+	Paid float64
+	// Share is the member's part of the shared expenses
+	Share float64
+	// Expenses are the shared expenses the member has a part of, oldest first
+	Expenses []*entity.Transaction
+}
+
+// Balance is what the member is owed, negative when they owe it
+func (m *HouseholdMember) Balance() float64 {
+	return m.Paid - m.Share
+}
+
+// ShareOf is the member's part of the shared expense
+func (m *HouseholdMember) ShareOf(txn *entity.Transaction) float64 {
+	if m.Person == nil {
+		return txn.GetPersonalAmount().Amount()
+	}
+	var share float64
+	for _, shared := range txn.SharedWith {
+		if shared.PersonID == m.Person.ID {
+			share += shared.Amount.Amount()
+		}
+	}
+	return share
+}
+
+// HouseholdBill is how the month's shared expenses of a bill were split
+type HouseholdBill struct {
+	Bill *entity.Bill
+	// Covered is what the bill's shared expenses of the month add up to
+	Covered float64
+	// Shares is each member's part of them, by person ID and uuid.Nil for you
+	Shares map[uuid.UUID]float64
+}
+
+// GetHouseholdReport splits the month's shared expenses among you and the
+// people they are shared with. Transfers between the user's own accounts
+// aren't expenses, and neither are refunds.
+func (uc *ReportUseCase) GetHouseholdReport(ctx context.Context, year int, month time.Month) (*HouseholdReport, error) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	transactions, err := uc.findByDateRange(ctx, start, start.AddDate(0, 1, 0).Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Date.Before(transactions[j].Date)
+	})
+
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get people: %w", err)
+	}
+	byID := make(map[uuid.UUID]*entity.Person, len(people))
+	for _, person := range people {
+		byID[person.ID] = person
+	}
+
+	you := &HouseholdMember{}
+	members := make(map[uuid.UUID]*HouseholdMember)
+	bills := make(map[uuid.UUID]*HouseholdBill)
+	report := &HouseholdReport{Month: start}
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.Category == entity.TransactionCategoryTransfer || len(txn.SharedWith) == 0 {
+			continue
+		}
+
+		amount := txn.Amount.Amount()
+		personal := txn.GetPersonalAmount().Amount()
+		report.Total += amount
+		you.Paid += amount
+		you.Share += personal
+		you.Expenses = append(you.Expenses, txn)
+
+		var bill *HouseholdBill
+		if txn.BillID != nil {
+			if bill = bills[*txn.BillID]; bill == nil {
+				found, err := uc.billRepo.FindByID(ctx, *txn.BillID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get bill: %w", err)
+				}
+				bill = &HouseholdBill{Bill: found, Shares: make(map[uuid.UUID]float64)}
+				bills[*txn.BillID] = bill
+			}
+			bill.Covered += amount
+			bill.Shares[uuid.Nil] += personal
+		}
+
+		for _, shared := range txn.SharedWith {
+			member := members[shared.PersonID]
+			if member == nil {
+				person := byID[shared.PersonID]
+				// Shares of people deleted since still count
+				if person == nil {
+					person = &entity.Person{ID: shared.PersonID, Name: "Unknown person"}
+				}
+				member = &HouseholdMember{Person: person}
+				members[shared.PersonID] = member
+			}
+			member.Share += shared.Amount.Amount()
+			if n := len(member.Expenses); n == 0 || member.Expenses[n-1] != txn {
+				member.Expenses = append(member.Expenses, txn)
+			}
+			if bill != nil {
+				bill.Shares[shared.PersonID] += shared.Amount.Amount()
+			}
+		}
+	}
+
+	report.Members = append(report.Members, you)
+	others := make([]*HouseholdMember, 0, len(members))
+	for _, member := range members {
+		others = append(others, member)
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].Person.Name < others[j].Person.Name
+	})
+	report.Members = append(report.Members, others...)
+
+	for _, bill := range bills {
+		report.Bills = append(report.Bills, bill)
+	}
+	sort.Slice(report.Bills, func(i, j int) bool {
+		return report.Bills[i].Bill.Name < report.Bills[j].Bill.Name
+	})
+
+	return report, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportUseCase_GetHouseholdReport(t *testing.T) {
+	ctx := context.Background()
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 12, 0, 0, 0, time.Local) }
+	brl := func(amount float64) valueobject.Money { return valueobject.NewMoney(amount, "BRL") }
+
+	ana, bruno := entity.NewPerson("Ana", "", ""), entity.NewPerson("Bruno", "", "")
+	gone := uuid.New()
+	rent, err := entity.NewBill("Rent", "", day(time.March, 1), day(time.March, 31), day(time.April, 10), brl(3000))
+	require.NoError(t, err)
+
+	expense := func(category entity.TransactionCategory, amount float64, date time.Time, shares map[uuid.UUID]float64) *entity.Transaction {
+		txn := entity.NewTransaction(nil, nil, entity.TransactionTypeDebit, category, brl(amount), string(category), date)
+		for personID, percentage := range shares {
+			require.NoError(t, txn.AddSharedExpense(personID, percentage))
+		}
+		return txn
+	}
+	rentPaid := expense(entity.TransactionCategoryUtilities, 3000, day(time.March, 10), map[uuid.UUID]float64{ana.ID: 50})
+	rentPaid.AssignToBill(rent.ID)
+	dinner := expense(entity.TransactionCategoryFood, 300, day(time.March, 5), map[uuid.UUID]float64{ana.ID: 25, bruno.ID: 25})
+	movies := expense(entity.TransactionCategoryEntertainment, 100, day(time.March, 20), map[uuid.UUID]float64{gone: 50})
+	refund := entity.NewTransaction(nil, nil, entity.TransactionTypeCredit, entity.TransactionCategoryFood, brl(50), "refund", day(time.March, 21))
+	refund.SharedWith = []entity.SharedExpense{{PersonID: ana.ID, Amount: brl(25), Percentage: 50}}
+	transfer := expense(entity.TransactionCategoryTransfer, 1000, day(time.March, 22), map[uuid.UUID]float64{ana.ID: 100})
+	unshared := expense(entity.TransactionCategoryFood, 80, day(time.March, 23), nil)
+	lastMonth := expense(entity.TransactionCategoryFood, 200, day(time.February, 27), map[uuid.UUID]float64{ana.ID: 50})
+
+	people := newFakePersonRepo()
+	require.NoError(t, people.Create(ctx, ana))
+	require.NoError(t, people.Create(ctx, bruno))
+	bills := newFakeBillRepo()
+	require.NoError(t, bills.Create(ctx, rent))
+	uc := NewReportUseCase(newFakeTransactionRepo(rentPaid, dinner, movies, refund, transfer, unshared, lastMonth), people, bills)
+
+	report, err := uc.GetHouseholdReport(ctx, 2026, time.March)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.Local), report.Month)
+	assert.InDelta(t, 3400, report.Total, 0.001)
+
+	tests := []struct {
+		name         string
+		wantPaid     float64
+		wantShare    float64
+		wantExpenses []*entity.Transaction
+	}{
+		{name: "You", wantPaid: 3400, wantShare: 1700, wantExpenses: []*entity.Transaction{dinner, rentPaid, movies}},
+		{name: "Ana", wantShare: 1575, wantExpenses: []*entity.Transaction{dinner, rentPaid}},
+		{name: "Bruno", wantShare: 75, wantExpenses: []*entity.Transaction{dinner}},
+		{name: "Unknown person", wantShare: 50, wantExpenses: []*entity.Transaction{movies}},
+	}
+	require.Len(t, report.Members, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := report.Members[i]
+			if member.Person == nil {
+				assert.Equal(t, "You", tt.name)
+			} else {
+				assert.Equal(t, tt.name, member.Person.Name)
+			}
+			assert.InDelta(t, tt.wantPaid, member.Paid, 0.001)
+			assert.InDelta(t, tt.wantShare, member.Share, 0.001)
+			assert.InDelta(t, tt.wantPaid-tt.wantShare, member.Balance(), 0.001)
+			assert.Equal(t, tt.wantExpenses, member.Expenses)
+
+			var shares float64
+			for _, txn := range member.Expenses {
+				shares += member.ShareOf(txn)
+			}
+			assert.InDelta(t, tt.wantShare, shares, 0.001)
+		})
+	}
+
+	require.Len(t, report.Bills, 1)
+	assert.Equal(t, rent.ID, report.Bills[0].Bill.ID)
+	assert.InDelta(t, 3000, report.Bills[0].Covered, 0.001)
+	assert.InDelta(t, 1500, report.Bills[0].Shares[uuid.Nil], 0.001)
+	assert.InDelta(t, 1500, report.Bills[0].Shares[ana.ID], 0.001)
+	assert.NotContains(t, report.Bills[0].Shares, bruno.ID)
+}
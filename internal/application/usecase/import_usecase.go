@@ -0,0 +1,391 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// importMatchWindow is how far apart the statement and ledger dates of the same
+// transaction may be, since banks often post a few days after the purchase
+const importMatchWindow = 3 * 24 * time.Hour
+
+// StatementEntry is a single line of a bank statement. Amount is signed:
+// negative values are debits and positive values are credits. Balance is the
+// running balance printed after the entry, nil when the statement has none.
+type StatementEntry struct {
+	Date        time.Time
+	Description string
+	Amount      float64
+	Balance     *float64
+}
+
+type ImportUseCase struct {
+	importSessionRepo  repository.ImportSessionRepository
+	accountRepo        repository.AccountRepository
+	transactionRepo    repository.TransactionRepository
+	transactionUseCase *TransactionUseCase
+	inbox              *InboxUseCase
+	rules              *CategoryRuleUseCase
+}
+
+func NewImportUseCase(
+	importSessionRepo repository.ImportSessionRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	transactionUseCase *TransactionUseCase,
+) *ImportUseCase {
+	return &ImportUseCase{
+		importSessionRepo:  importSessionRepo,
+		accountRepo:        accountRepo,
+		transactionRepo:    transactionRepo,
+		transactionUseCase: transactionUseCase,
+	}
+}
+
+// SetReviewInbox makes imports queue missing entries in the review inbox, so
+// they only affect balances once approved
+func (uc *ImportUseCase) SetReviewInbox(inbox *InboxUseCase) {
+	uc.inbox = inbox
+}
+
+// SetCategoryRules makes imports categorize the entries they create with the
+// user's rules, instead of filing every expense under Other
+func (uc *ImportUseCase) SetCategoryRules(rules *CategoryRuleUseCase) {
+	uc.rules = rules
+}
+
+// ImportStatement reconciles statement entries against the account ledger.
+// Entries that match an existing transaction are left untouched, missing ones
+// are created, and the outcome is stored as an import session. statementBalance
+// is the closing balance reported by the statement, or nil to take it from the
+// entries' running balances, if they have any. Running balances also become
+// balance assertions, checked against the ledger once the import is done.
+func (uc *ImportUseCase) ImportStatement(ctx context.Context, accountID uuid.UUID, source string, entries []StatementEntry, statementBalance *float64) (*entity.ImportSession, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("statement has no entries")
+	}
+
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+	currency := account.Balance.Currency()
+
+	periodStart, periodEnd := statementPeriod(entries)
+	session := entity.NewImportSession(accountID, source, periodStart, periodEnd)
+
+	ledger, err := uc.transactionRepo.FindByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account transactions: %w", err)
+	}
+
+	// Entries already waiting for review match too, so importing the same
+	// statement twice doesn't queue them again
+	awaitingReview := make(map[uuid.UUID]bool)
+	var queued []*entity.Transaction
+	if uc.inbox != nil {
+		pending, err := uc.inbox.ListPending(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range pending {
+			if item.Transaction.AccountID != nil && *item.Transaction.AccountID == accountID {
+				ledger = append(ledger, item.Transaction)
+				queued = append(queued, item.Transaction)
+				awaitingReview[item.Transaction.ID] = true
+			}
+		}
+	}
+
+	// Only ledger transactions around the statement period are candidates for matching
+	var candidates []*entity.Transaction
+	for _, txn := range ledger {
+		if !txn.Date.Before(periodStart.Add(-importMatchWindow)) && !txn.Date.After(periodEnd.Add(importMatchWindow)) {
+			candidates = append(candidates, txn)
+		}
+	}
+
+	used := make(map[uuid.UUID]bool)
+	var missing []StatementEntry
+	var missingIndexes []int
+	// The ledger transaction each entry ended up as, matched or created
+	entryTransactions := make([]*entity.Transaction, len(entries))
+	for i, entry := range entries {
+		match := findStatementMatch(entry, candidates, used)
+		if match == nil {
+			missing = append(missing, entry)
+			missingIndexes = append(missingIndexes, i)
+			continue
+		}
+		used[match.ID] = true
+		entryTransactions[i] = match
+		session.MatchedTransactionIDs = append(session.MatchedTransactionIDs, match.ID)
+	}
+
+	for _, txn := range candidates {
+		if !used[txn.ID] && !awaitingReview[txn.ID] && !txn.Date.Before(periodStart) && !txn.Date.After(periodEnd) {
+			session.LedgerOnlyTransactionIDs = append(session.LedgerOnlyTransactionIDs, txn.ID)
+		}
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(missing))
+	for i, entry := range missing {
+		transactionType, category := entity.TransactionTypeCredit, entity.TransactionCategoryIncome
+		amount := entry.Amount
+		if amount < 0 {
+			transactionType, category = entity.TransactionTypeDebit, entity.TransactionCategoryOther
+			amount = -amount
+		}
+
+		money := valueobject.NewMoney(amount, currency)
+		txn := entity.NewTransaction(&accountID, nil, transactionType, category, money, entry.Description, entry.Date)
+		transactions = append(transactions, txn)
+		entryTransactions[missingIndexes[i]] = txn
+	}
+
+	if uc.rules != nil {
+		if err := uc.rules.Categorize(ctx, transactions); err != nil {
+			fmt.Printf("Warning: failed to apply category rules: %v\n", err)
+		}
+	}
+
+	if uc.inbox != nil {
+		items, err := uc.inbox.Submit(ctx, source, transactions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import statement: %w", err)
+		}
+		for _, item := range items {
+			session.QueuedInboxIDs = append(session.QueuedInboxIDs, item.ID)
+		}
+		queued = append(queued, transactions...)
+	} else {
+		// Imports can run to thousands of rows, so they go through the bulk path
+		if err := uc.transactionUseCase.CreateTransactions(ctx, &accountID, nil, transactions); err != nil {
+			return nil, fmt.Errorf("failed to import statement: %w", err)
+		}
+		for _, txn := range transactions {
+			session.CreatedTransactionIDs = append(session.CreatedTransactionIDs, txn.ID)
+		}
+	}
+
+	// Reload to pick up the balance changes made by the created transactions
+	if account, err = uc.accountRepo.FindByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("failed to reload account: %w", err)
+	}
+	session.LedgerBalance = account.Balance
+	if statementBalance == nil {
+		statementBalance = closingStatementBalance(entries)
+	}
+	if statementBalance != nil {
+		balance := valueobject.NewMoney(*statementBalance, currency)
+		session.StatementBalance = &balance
+	}
+
+	if hasRunningBalances(entries) {
+		ledger, err := uc.transactionRepo.FindByAccountID(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account transactions: %w", err)
+		}
+		// Entries waiting in the inbox are checked as if approved, since the
+		// statement says they happened
+		session.BalanceAssertions = assertStatementBalances(entries, entryTransactions, account.Balance, ledger, queued)
+	}
+
+	if err := uc.importSessionRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save import session: %w", err)
+	}
+
+	return session, nil
+}
+
+// ReadStatementCSV loads a CSV bank statement so its columns can be mapped and
+// previewed before the entries are handed to ImportStatement
+func (uc *ImportUseCase) ReadStatementCSV(path string) (*StatementCSV, error) {
+	return readStatementCSV(path)
+}
+
+// ListImportSessions returns the account's imports, most recent first
+func (uc *ImportUseCase) ListImportSessions(ctx context.Context, accountID uuid.UUID) ([]*entity.ImportSession, error) {
+	return uc.importSessionRepo.FindByAccountID(ctx, accountID)
+}
+
+// GetSessionTransactions loads the transactions created by an import
+func (uc *ImportUseCase) GetSessionTransactions(ctx context.Context, sessionID uuid.UUID) ([]*entity.Transaction, error) {
+	session, err := uc.importSessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import session: %w", err)
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(session.CreatedTransactionIDs))
+	for _, id := range session.CreatedTransactionIDs {
+		txn, err := uc.transactionRepo.FindByID(ctx, id)
+		if err != nil {
+			// The transaction may have been deleted after the import
+			continue
+		}
+		transactions = append(transactions, txn)
+	}
+
+	return transactions, nil
+}
+
+// findStatementMatch returns the unused ledger transaction with the same type and
+// amount whose date is closest to the entry, within the match window
+func findStatementMatch(entry StatementEntry, candidates []*entity.Transaction, used map[uuid.UUID]bool) *entity.Transaction {
+	transactionType := entity.TransactionTypeCredit
+	amount := entry.Amount
+	if amount < 0 {
+		transactionType = entity.TransactionTypeDebit
+		amount = -amount
+	}
+	expected := valueobject.NewMoney(amount, "")
+
+	var best *entity.Transaction
+	var bestDistance time.Duration
+	for _, txn := range candidates {
+		if used[txn.ID] || txn.Type != transactionType || txn.Amount.Amount() != expected.Amount() {
+			continue
+		}
+
+		distance := txn.Date.Sub(entry.Date)
+		if distance < 0 {
+			distance = -distance
+		}
+		if distance > importMatchWindow {
+			continue
+		}
+
+		if best == nil || distance < bestDistance {
+			best = txn
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
+
+func hasRunningBalances(entries []StatementEntry) bool {
+	for _, entry := range entries {
+		if entry.Balance != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// closingStatementBalance is the running balance at the end of the statement,
+// or nil when the entries don't carry one
+func closingStatementBalance(entries []StatementEntry) *float64 {
+	days := statementDayBalances(entries)
+	if len(days) == 0 {
+		return nil
+	}
+	return days[len(days)-1].entry.Balance
+}
+
+type statementDayBalance struct {
+	index int
+	entry StatementEntry
+}
+
+// statementDayBalances picks, for each day with running balances, the entry
+// whose balance closes the day, oldest day first. Statements are listed either
+// oldest or newest first, so the closing entry is the last or the first of the
+// day's rows.
+func statementDayBalances(entries []StatementEntry) []statementDayBalance {
+	newestFirst := entries[0].Date.After(entries[len(entries)-1].Date)
+
+	var days []statementDayBalance
+	byDay := make(map[string]int)
+	for i, entry := range entries {
+		if entry.Balance == nil {
+			continue
+		}
+		day := entry.Date.Format("2006-01-02")
+		at, seen := byDay[day]
+		if !seen {
+			byDay[day] = len(days)
+			days = append(days, statementDayBalance{index: i, entry: entry})
+			continue
+		}
+		if !newestFirst {
+			days[at] = statementDayBalance{index: i, entry: entry}
+		}
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].entry.Date.Before(days[j].entry.Date)
+	})
+	return days
+}
+
+// assertStatementBalances checks each day's closing running balance against the
+// ledger balance at the end of that day, worked back from the current balance
+// by undoing every later transaction. queued holds transactions waiting in the
+// review inbox, which count as if they were already in the ledger.
+func assertStatementBalances(entries []StatementEntry, entryTransactions []*entity.Transaction, balance valueobject.Money, ledger, queued []*entity.Transaction) []entity.BalanceAssertion {
+	current := balance.Amount()
+	all := ledger
+	for _, txn := range queued {
+		current += transactionEffect(txn)
+		all = append(all, txn)
+	}
+
+	assertions := []entity.BalanceAssertion{}
+	for _, day := range statementDayBalances(entries) {
+		d := day.entry.Date
+		endOfDay := time.Date(d.Year(), d.Month(), d.Day(), 23, 59, 59, 0, d.Location())
+
+		actual := current
+		for _, txn := range all {
+			if txn.Date.After(endOfDay) {
+				actual -= transactionEffect(txn)
+			}
+		}
+
+		assertion := entity.BalanceAssertion{
+			Date:        day.entry.Date,
+			Description: day.entry.Description,
+			Expected:    valueobject.NewMoney(*day.entry.Balance, balance.Currency()),
+			Actual:      valueobject.NewMoney(actual, balance.Currency()),
+		}
+		if txn := entryTransactions[day.index]; txn != nil {
+			id := txn.ID
+			assertion.TransactionID = &id
+		}
+		assertions = append(assertions, assertion)
+	}
+	return assertions
+}
+
+// transactionEffect is how much the transaction moved the account balance
+func transactionEffect(txn *entity.Transaction) float64 {
+	if txn.Type == entity.TransactionTypeCredit {
+		return txn.Amount.Amount()
+	}
+	return -txn.Amount.Amount()
+}
+
+func statementPeriod(entries []StatementEntry) (time.Time, time.Time) {
+	start, end := entries[0].Date, entries[0].Date
+	for _, entry := range entries[1:] {
+		if entry.Date.Before(start) {
+			start = entry.Date
+		}
+		if entry.Date.After(end) {
+			end = entry.Date
+		}
+	}
+
+	// Cover whole days, since ledger transactions may carry a time of day
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	end = time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, end.Location())
+	return start, end
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type InboxUseCase struct {
+	inboxRepo          repository.InboxTransactionRepository
+	transactionUseCase *TransactionUseCase
+}
+
+func NewInboxUseCase(inboxRepo repository.InboxTransactionRepository, transactionUseCase *TransactionUseCase) *InboxUseCase {
+	return &InboxUseCase{
+		inboxRepo:          inboxRepo,
+		transactionUseCase: transactionUseCase,
+	}
+}
+
+// Submit queues proposed transactions for review instead of posting them
+func (uc *InboxUseCase) Submit(ctx context.Context, source string, transactions []*entity.Transaction) ([]*entity.InboxTransaction, error) {
+	items := make([]*entity.InboxTransaction, 0, len(transactions))
+	for _, txn := range transactions {
+		item, err := entity.NewInboxTransaction(source, txn)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err := uc.inboxRepo.CreateMany(ctx, items); err != nil {
+		return nil, fmt.Errorf("failed to queue transactions for review: %w", err)
+	}
+
+	return items, nil
+}
+
+// ListPending returns the transactions waiting for review, oldest first
+func (uc *InboxUseCase) ListPending(ctx context.Context) ([]*entity.InboxTransaction, error) {
+	return uc.inboxRepo.FindPending(ctx)
+}
+
+// Approve posts the proposed transaction, which only then affects balances
+func (uc *InboxUseCase) Approve(ctx context.Context, id uuid.UUID) error {
+	item, err := uc.inboxRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get inbox transaction: %w", err)
+	}
+
+	if err := item.Approve(time.Now()); err != nil {
+		return err
+	}
+
+	txn := item.Transaction
+	if err := uc.transactionUseCase.CreateTransactions(ctx, txn.AccountID, txn.CreditCardID, []*entity.Transaction{txn}); err != nil {
+		return fmt.Errorf("failed to post approved transaction: %w", err)
+	}
+
+	if err := uc.inboxRepo.Update(ctx, item); err != nil {
+		return fmt.Errorf("transaction posted but the inbox could not be updated: %w", err)
+	}
+
+	return nil
+}
+
+// Reject discards the proposed transaction without touching any balance
+func (uc *InboxUseCase) Reject(ctx context.Context, id uuid.UUID) error {
+	item, err := uc.inboxRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get inbox transaction: %w", err)
+	}
+
+	if err := item.Reject(time.Now()); err != nil {
+		return err
+	}
+
+	if err := uc.inboxRepo.Update(ctx, item); err != nil {
+		return fmt.Errorf("failed to reject inbox transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Edit corrects a proposed transaction before it is approved
+func (uc *InboxUseCase) Edit(ctx context.Context, id uuid.UUID, description string, category entity.TransactionCategory, amount float64, date time.Time) (*entity.InboxTransaction, error) {
+	item, err := uc.inboxRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inbox transaction: %w", err)
+	}
+
+	money := valueobject.NewMoney(amount, item.Transaction.Amount.Currency())
+	if err := item.Edit(description, category, money, date); err != nil {
+		return nil, err
+	}
+
+	if err := uc.inboxRepo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update inbox transaction: %w", err)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// Position is an open holding with the account it's held in
+type Position struct {
+	Holding *entity.Holding
+	Account *entity.Account
+}
+
+// Portfolio sums up the holdings of every investment account
+type Portfolio struct {
+	Positions []*Position
+	// Cash is what the investment accounts hold outside of their positions
+	Cash       float64
+	Cost       float64
+	Value      float64
+	ProfitLoss float64
+	// Realized adds up the profit of every sale, closed holdings included
+	Realized float64
+}
+
+// ProfitLossPercentage returns the unrealized profit in percent of the cost
+func (p *Portfolio) ProfitLossPercentage() float64 {
+	if p.Cost == 0 {
+		return 0
+	}
+	return p.ProfitLoss / p.Cost * 100
+}
+
+// Total returns the cash plus the market value of the positions
+func (p *Portfolio) Total() float64 {
+	return p.Cash + p.Value
+}
+
+type InvestmentUseCase struct {
+	holdingRepo     repository.HoldingRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewInvestmentUseCase(holdingRepo repository.HoldingRepository, accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *InvestmentUseCase {
+	return &InvestmentUseCase{
+		holdingRepo:     holdingRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Buy pays for quantity of ticker out of the investment account's cash and
+// adds it to the account's holding of it
+func (uc *InvestmentUseCase) Buy(ctx context.Context, accountID uuid.UUID, ticker string, quantity, price float64) (*entity.Holding, error) {
+	account, err := uc.investmentAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	currency := account.Balance.Currency()
+	unitPrice := valueobject.NewMoney(price, currency)
+
+	holding, err := uc.findHolding(ctx, accountID, ticker)
+	if err != nil {
+		return nil, err
+	}
+	isNew := holding == nil
+	if isNew {
+		holding, err = entity.NewHolding(accountID, ticker, quantity, unitPrice)
+	} else {
+		err = holding.Buy(quantity, unitPrice)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cost := valueobject.NewMoney(quantity*price, currency)
+	if err := account.Withdraw(cost); err != nil {
+		return nil, fmt.Errorf("failed to pay for %s: %w", holding.Ticker, err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return nil, fmt.Errorf("failed to update account balance: %w", err)
+	}
+
+	if isNew {
+		err = uc.holdingRepo.Create(ctx, holding)
+	} else {
+		err = uc.holdingRepo.Update(ctx, holding)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save holding: %w", err)
+	}
+
+	description := fmt.Sprintf("Buy %g %s @ %s", quantity, holding.Ticker, formatBRL(unitPrice))
+	if err := uc.recordTrade(ctx, account, entity.TransactionTypeDebit, cost, description); err != nil {
+		return nil, err
+	}
+
+	return holding, nil
+}
+
+// Sell takes quantity out of a holding, crediting the proceeds to its
+// account's cash, and returns the profit the sale made over the average price
+func (uc *InvestmentUseCase) Sell(ctx context.Context, holdingID uuid.UUID, quantity, price float64) (float64, error) {
+	holding, err := uc.holdingRepo.FindByID(ctx, holdingID)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := uc.investmentAccount(ctx, holding.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	unitPrice := valueobject.NewMoney(price, account.Balance.Currency())
+
+	profit, err := holding.Sell(quantity, unitPrice)
+	if err != nil {
+		return 0, err
+	}
+
+	proceeds := valueobject.NewMoney(quantity*price, account.Balance.Currency())
+	if err := account.Deposit(proceeds); err != nil {
+		return 0, fmt.Errorf("failed to credit the sale: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return 0, fmt.Errorf("failed to update account balance: %w", err)
+	}
+
+	if err := uc.holdingRepo.Update(ctx, holding); err != nil {
+		return 0, fmt.Errorf("failed to save holding: %w", err)
+	}
+
+	description := fmt.Sprintf("Sell %g %s @ %s", quantity, holding.Ticker, formatBRL(unitPrice))
+	if err := uc.recordTrade(ctx, account, entity.TransactionTypeCredit, proceeds, description); err != nil {
+		return 0, err
+	}
+
+	return profit, nil
+}
+
+// UpdatePrice records the latest quote of a holding, which its profit is
+// measured against
+func (uc *InvestmentUseCase) UpdatePrice(ctx context.Context, holdingID uuid.UUID, price float64) (*entity.Holding, error) {
+	holding, err := uc.holdingRepo.FindByID(ctx, holdingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := holding.UpdatePrice(valueobject.NewMoney(price, holding.LastPrice.Currency())); err != nil {
+		return nil, err
+	}
+
+	if err := uc.holdingRepo.Update(ctx, holding); err != nil {
+		return nil, fmt.Errorf("failed to save holding: %w", err)
+	}
+
+	return holding, nil
+}
+
+// ListInvestmentAccounts returns the accounts holdings can be bought in
+func (uc *InvestmentUseCase) ListInvestmentAccounts(ctx context.Context) ([]*entity.Account, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	var investments []*entity.Account
+	for _, account := range accounts {
+		if account.Type == entity.AccountTypeInvestment {
+			investments = append(investments, account)
+		}
+	}
+	return investments, nil
+}
+
+// GetPortfolio returns the open positions of every investment account, by
+// ticker, with their totals
+func (uc *InvestmentUseCase) GetPortfolio(ctx context.Context) (*Portfolio, error) {
+	accounts, err := uc.ListInvestmentAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings, err := uc.holdingRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holdings: %w", err)
+	}
+
+	portfolio := &Portfolio{}
+	byID := make(map[uuid.UUID]*entity.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+		portfolio.Cash += account.Balance.Amount()
+	}
+
+	for _, holding := range holdings {
+		portfolio.Realized += holding.RealizedProfit.Amount()
+		if !holding.IsOpen() {
+			continue
+		}
+
+		// Account is nil when the account was deleted or is no longer an
+		// investment account
+		portfolio.Positions = append(portfolio.Positions, &Position{Holding: holding, Account: byID[holding.AccountID]})
+		portfolio.Cost += holding.CostBasis()
+		portfolio.Value += holding.MarketValue()
+	}
+	portfolio.ProfitLoss = portfolio.Value - portfolio.Cost
+
+	return portfolio, nil
+}
+
+func (uc *InvestmentUseCase) investmentAccount(ctx context.Context, accountID uuid.UUID) (*entity.Account, error) {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+	if account.Type != entity.AccountTypeInvestment {
+		return nil, fmt.Errorf("%s is not an investment account", account.Name)
+	}
+	return account, nil
+}
+
+// findHolding returns the account's holding of ticker, or nil when it has none
+func (uc *InvestmentUseCase) findHolding(ctx context.Context, accountID uuid.UUID, ticker string) (*entity.Holding, error) {
+	holdings, err := uc.holdingRepo.FindByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holdings: %w", err)
+	}
+
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	for _, holding := range holdings {
+		if holding.Ticker == ticker {
+			return holding, nil
+		}
+	}
+	return nil, nil
+}
+
+// recordTrade posts the cash a trade moved. It's filed as a transfer because
+// the money stays in the portfolio, so reports don't count it as spending or
+// income.
+func (uc *InvestmentUseCase) recordTrade(ctx context.Context, account *entity.Account, transactionType entity.TransactionType, amount valueobject.Money, description string) error {
+	transaction := entity.NewTransaction(&account.ID, nil, transactionType, entity.TransactionCategoryTransfer, amount, description, time.Now())
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+	return nil
+}
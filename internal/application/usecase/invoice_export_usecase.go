@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+const faturaLineWidth = 64
+
+// installmentPattern matches descriptions ending in an installment marker such
+// as "Notebook (3/10)", "Notebook parcela 03/10" or "Notebook x3/10". A bare
+// "03/10" isn't one, since descriptions often end in a date.
+var installmentPattern = regexp.MustCompile(`(?i)(?:\((\d{1,2})/(\d{1,2})\)|\b(?:parcela|parc\.?|x)\s*(\d{1,2})/(\d{1,2}))\s*$`)
+
+type InvoiceExportUseCase struct {
+	invoiceRepo     repository.CreditCardInvoiceRepository
+	creditCardRepo  repository.CreditCardRepository
+	transactionRepo repository.TransactionRepository
+	outputDir       string
+}
+
+func NewInvoiceExportUseCase(
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardRepo repository.CreditCardRepository,
+	transactionRepo repository.TransactionRepository,
+	outputDir string,
+) *InvoiceExportUseCase {
+	return &InvoiceExportUseCase{
+		invoiceRepo:     invoiceRepo,
+		creditCardRepo:  creditCardRepo,
+		transactionRepo: transactionRepo,
+		outputDir:       outputDir,
+	}
+}
+
+// RenderFatura renders an invoice as plain text following the layout of a
+// Brazilian credit card statement
+func (uc *InvoiceExportUseCase) RenderFatura(ctx context.Context, invoiceID uuid.UUID) (string, error) {
+	invoice, card, transactions, err := uc.loadInvoiceData(ctx, invoiceID)
+	if err != nil {
+		return "", err
+	}
+
+	return renderFatura(card, invoice, transactions), nil
+}
+
+// ExportFatura writes the rendered invoice to the export directory and returns
+// the path of the created file
+func (uc *InvoiceExportUseCase) ExportFatura(ctx context.Context, invoiceID uuid.UUID) (string, error) {
+	invoice, card, transactions, err := uc.loadInvoiceData(ctx, invoiceID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(uc.outputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	fileName := fmt.Sprintf("fatura-%s-%s.txt", card.LastFourDigits, invoice.ReferenceMonth)
+	path := filepath.Join(uc.outputDir, fileName)
+
+	content := renderFatura(card, invoice, transactions)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write invoice export: %w", err)
+	}
+
+	return path, nil
+}
+
+func (uc *InvoiceExportUseCase) loadInvoiceData(ctx context.Context, invoiceID uuid.UUID) (*entity.CreditCardInvoice, *entity.CreditCard, []*entity.Transaction, error) {
+	invoice, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	card, err := uc.creditCardRepo.FindByID(ctx, invoice.CreditCardID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("credit card not found: %w", err)
+	}
+
+	transactions, err := uc.transactionRepo.FindByCreditCardInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get invoice transactions: %w", err)
+	}
+
+	return invoice, card, transactions, nil
+}
+
+func renderFatura(card *entity.CreditCard, invoice *entity.CreditCardInvoice, transactions []*entity.Transaction) string {
+	var charges, payments []*entity.Transaction
+	for _, txn := range transactions {
+		if txn.Type == entity.TransactionTypeCredit {
+			payments = append(payments, txn)
+		} else {
+			charges = append(charges, txn)
+		}
+	}
+
+	sort.SliceStable(charges, func(i, j int) bool { return charges[i].Date.Before(charges[j].Date) })
+	sort.SliceStable(payments, func(i, j int) bool { return payments[i].Date.Before(payments[j].Date) })
+
+	separator := strings.Repeat("=", faturaLineWidth)
+	divider := strings.Repeat("-", faturaLineWidth)
+
+	var b strings.Builder
+
+	b.WriteString(separator + "\n")
+	b.WriteString(centerText("FATURA DO CARTÃO DE CRÉDITO", faturaLineWidth) + "\n")
+	b.WriteString(separator + "\n")
+	fmt.Fprintf(&b, "Cartão: %s (final %s)\n", card.Name, card.LastFourDigits)
+	fmt.Fprintf(&b, "Referência: %s\n", invoice.ReferenceMonth)
+	fmt.Fprintf(&b, "Período: %s a %s\n", invoice.OpeningDate.Format("02/01/2006"), invoice.ClosingDate.Format("02/01/2006"))
+	fmt.Fprintf(&b, "Vencimento: %s\n", invoice.DueDate.Format("02/01/2006"))
+	b.WriteString(divider + "\n")
+
+	b.WriteString("RESUMO DA FATURA\n")
+	b.WriteString(faturaLine("Saldo anterior", invoice.PreviousBalance) + "\n")
+	b.WriteString(faturaLine("(-) Pagamentos/créditos", invoice.TotalPayments) + "\n")
+	b.WriteString(faturaLine("(+) Compras e débitos", invoice.TotalCharges) + "\n")
+	b.WriteString(faturaLine("(=) Total da fatura", invoice.ClosingBalance) + "\n")
+	b.WriteString(divider + "\n")
+
+	b.WriteString("PAGAMENTOS E CRÉDITOS\n")
+	if len(payments) == 0 {
+		b.WriteString("  Nenhum pagamento no período\n")
+	}
+	for _, txn := range payments {
+		b.WriteString(faturaLine(fmt.Sprintf("  %s  %s", txn.Date.Format("02/01"), txn.Description), txn.Amount) + "\n")
+	}
+	b.WriteString(divider + "\n")
+
+	b.WriteString("LANÇAMENTOS\n")
+	if len(charges) == 0 {
+		b.WriteString("  Nenhum lançamento no período\n")
+	}
+	var currentDay time.Time
+	for _, txn := range charges {
+		day := time.Date(txn.Date.Year(), txn.Date.Month(), txn.Date.Day(), 0, 0, 0, 0, txn.Date.Location())
+		if !day.Equal(currentDay) {
+			currentDay = day
+			fmt.Fprintf(&b, "%s\n", day.Format("02/01/2006"))
+		}
+
+		description, installment := splitInstallment(txn.Description)
+		if installment != "" {
+			description = fmt.Sprintf("%s PARC %s", description, installment)
+		}
+		b.WriteString(faturaLine("  "+description, txn.Amount) + "\n")
+	}
+	b.WriteString(divider + "\n")
+
+	// Open invoices have no minimum computed yet, so estimate it from the card setting
+	minimum := invoice.MinimumPayment
+	if invoice.IsOpen() {
+		minimum = minimumPayment(invoice.ClosingBalance, card.MinimumPaymentPercentage)
+	}
+	b.WriteString(faturaLine("TOTAL A PAGAR", invoice.ClosingBalance) + "\n")
+	b.WriteString(faturaLine("PAGAMENTO MÍNIMO", minimum) + "\n")
+	b.WriteString(separator + "\n")
+	fmt.Fprintf(&b, "Gerado em %s\n", time.Now().Format("02/01/2006 15:04"))
+
+	return b.String()
+}
+
+// splitInstallment separates an installment marker from a description,
+// returning the marker normalized as "NN/NN"
+func splitInstallment(description string) (string, string) {
+	match := installmentPattern.FindStringSubmatchIndex(description)
+	if match == nil {
+		return description, ""
+	}
+
+	// The numbers are in the parentheses' groups or in the prefixed ones
+	numbers := match[2:6]
+	if numbers[0] < 0 {
+		numbers = match[6:10]
+	}
+	current, _ := strconv.Atoi(description[numbers[0]:numbers[1]])
+	total, _ := strconv.Atoi(description[numbers[2]:numbers[3]])
+	if current == 0 || total == 0 || current > total {
+		return description, ""
+	}
+
+	base := strings.TrimSpace(description[:match[0]])
+	return base, fmt.Sprintf("%02d/%02d", current, total)
+}
+
+func minimumPayment(balance valueobject.Money, percentage float64) valueobject.Money {
+	if balance.IsNegative() || balance.IsZero() {
+		return valueobject.NewMoney(0, balance.Currency())
+	}
+	return balance.Multiply(percentage / 100)
+}
+
+func faturaLine(label string, amount valueobject.Money) string {
+	value := formatBRL(amount)
+	padding := faturaLineWidth - len([]rune(label)) - len([]rune(value))
+	if padding < 1 {
+		maxLabel := faturaLineWidth - len([]rune(value)) - 4
+		if maxLabel < 0 {
+			maxLabel = 0
+		}
+		label = string([]rune(label)[:maxLabel]) + "..."
+		padding = 1
+	}
+	return label + strings.Repeat(" ", padding) + value
+}
+
+// formatBRL formats money using the Brazilian convention (R$ 1.234,56)
+func formatBRL(m valueobject.Money) string {
+	amount := m.Amount()
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	whole := fmt.Sprintf("%.2f", amount)
+	intPart, decPart := whole[:len(whole)-3], whole[len(whole)-2:]
+
+	var grouped []string
+	for len(intPart) > 3 {
+		grouped = append([]string{intPart[len(intPart)-3:]}, grouped...)
+		intPart = intPart[:len(intPart)-3]
+	}
+	grouped = append([]string{intPart}, grouped...)
+
+	prefix := "R$ "
+	if m.Currency() != "BRL" {
+		prefix = m.Currency() + " "
+	}
+
+	return fmt.Sprintf("%s%s%s,%s", sign, prefix, strings.Join(grouped, "."), decPart)
+}
+
+func centerText(text string, width int) string {
+	padding := (width - len([]rune(text))) / 2
+	if padding <= 0 {
+		return text
+	}
+	return strings.Repeat(" ", padding) + text
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitInstallment(t *testing.T) {
+	tests := []struct {
+		description string
+		base        string
+		installment string
+	}{
+		{"Notebook (3/10)", "Notebook", "03/10"},
+		{"Notebook (03/10) ", "Notebook", "03/10"},
+		{"Notebook parcela 3/10", "Notebook", "03/10"},
+		{"Notebook PARC 03/10", "Notebook", "03/10"},
+		{"Notebook parc.3/10", "Notebook", "03/10"},
+		{"Notebook x3/10", "Notebook", "03/10"},
+		{"Notebook (10/10)", "Notebook", "10/10"},
+		{"Uber 03/10", "Uber 03/10", ""},
+		{"Jantar 25/12", "Jantar 25/12", ""},
+		{"Notebook (11/10)", "Notebook (11/10)", ""},
+		{"Notebook (0/10)", "Notebook (0/10)", ""},
+		{"Notebook (3/10) extra", "Notebook (3/10) extra", ""},
+		{"Notebook", "Notebook", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			base, installment := splitInstallment(tt.description)
+			assert.Equal(t, tt.base, base)
+			assert.Equal(t, tt.installment, installment)
+		})
+	}
+}
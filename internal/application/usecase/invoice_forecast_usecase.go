@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// recurringLookbackMonths is how many past months are scanned when detecting recurring card charges
+const recurringLookbackMonths = 3
+
+type ForecastItemKind string
+
+const (
+	ForecastItemPosted      ForecastItemKind = "posted"
+	ForecastItemInstallment ForecastItemKind = "installment"
+	ForecastItemRecurring   ForecastItemKind = "recurring"
+)
+
+type ForecastItem struct {
+	Description string
+	Amount      valueobject.Money
+	Kind        ForecastItemKind
+}
+
+type InvoiceForecast struct {
+	ReferenceMonth string
+	DueDate        time.Time
+	PostedCharges  valueobject.Money
+	Installments   valueobject.Money
+	Recurring      valueobject.Money
+	Total          valueobject.Money
+	Items          []ForecastItem
+}
+
+type InvoiceForecastUseCase struct {
+	invoiceRepo     repository.CreditCardInvoiceRepository
+	creditCardRepo  repository.CreditCardRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewInvoiceForecastUseCase(
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardRepo repository.CreditCardRepository,
+	transactionRepo repository.TransactionRepository,
+) *InvoiceForecastUseCase {
+	return &InvoiceForecastUseCase{
+		invoiceRepo:     invoiceRepo,
+		creditCardRepo:  creditCardRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// installmentSeries tracks the latest posted installment of a purchase split in N parts
+type installmentSeries struct {
+	description string
+	current     int
+	total       int
+	month       time.Time
+	amount      valueobject.Money
+}
+
+// ForecastInvoices projects the card's next invoices, starting with the current month,
+// from charges already posted, remaining installments and charges that repeat every month
+func (uc *InvoiceForecastUseCase) ForecastInvoices(ctx context.Context, creditCardID uuid.UUID, months int) ([]*InvoiceForecast, error) {
+	card, err := uc.creditCardRepo.FindByID(ctx, creditCardID)
+	if err != nil {
+		return nil, fmt.Errorf("credit card not found: %w", err)
+	}
+
+	transactions, err := uc.transactionRepo.FindByCreditCardID(ctx, creditCardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card transactions: %w", err)
+	}
+
+	currency := card.CreditLimit.Currency()
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	// Charges grouped by the month they were posted in
+	postedByMonth := make(map[string][]*entity.Transaction)
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit {
+			continue
+		}
+		key := txn.Date.Format("2006-01")
+		postedByMonth[key] = append(postedByMonth[key], txn)
+	}
+
+	series := findInstallmentSeries(transactions)
+	recurring := findRecurringCharges(transactions, currentMonth)
+
+	forecasts := make([]*InvoiceForecast, 0, months)
+	for i := 0; i < months; i++ {
+		month := currentMonth.AddDate(0, i, 0)
+		referenceMonth := month.Format("2006-01")
+
+		forecast := &InvoiceForecast{
+			ReferenceMonth: referenceMonth,
+			DueDate:        time.Date(month.Year(), month.Month()+1, card.DueDay, 0, 0, 0, 0, month.Location()),
+			PostedCharges:  valueobject.NewMoney(0, currency),
+			Installments:   valueobject.NewMoney(0, currency),
+			Recurring:      valueobject.NewMoney(0, currency),
+		}
+
+		if invoice, err := uc.invoiceRepo.FindByMonth(ctx, creditCardID, referenceMonth); err == nil && invoice != nil {
+			forecast.DueDate = invoice.DueDate
+		}
+
+		posted := postedByMonth[referenceMonth]
+		postedKeys := make(map[string]bool)
+		for _, txn := range posted {
+			forecast.addItem(ForecastItem{Description: txn.Description, Amount: txn.Amount, Kind: ForecastItemPosted})
+			postedKeys[postedKey(txn.Description)] = true
+		}
+
+		for _, s := range series {
+			monthsAhead := monthsBetween(s.month, month)
+			number := s.current + monthsAhead
+			if monthsAhead <= 0 || number > s.total {
+				continue
+			}
+
+			description := fmt.Sprintf("%s (%02d/%02d)", s.description, number, s.total)
+			if postedKeys[postedKey(description)] {
+				continue
+			}
+			forecast.addItem(ForecastItem{Description: description, Amount: s.amount, Kind: ForecastItemInstallment})
+		}
+
+		for _, charge := range recurring {
+			if postedKeys[postedKey(charge.Description)] {
+				continue
+			}
+			forecast.addItem(ForecastItem{Description: charge.Description, Amount: charge.Amount, Kind: ForecastItemRecurring})
+		}
+
+		forecasts = append(forecasts, forecast)
+	}
+
+	return forecasts, nil
+}
+
+func (f *InvoiceForecast) addItem(item ForecastItem) {
+	f.Items = append(f.Items, item)
+
+	var err error
+	switch item.Kind {
+	case ForecastItemPosted:
+		f.PostedCharges, err = f.PostedCharges.Add(item.Amount)
+	case ForecastItemInstallment:
+		f.Installments, err = f.Installments.Add(item.Amount)
+	case ForecastItemRecurring:
+		f.Recurring, err = f.Recurring.Add(item.Amount)
+	}
+	if err != nil {
+		return
+	}
+
+	total, _ := f.PostedCharges.Add(f.Installments)
+	f.Total, _ = total.Add(f.Recurring)
+}
+
+// findInstallmentSeries returns the latest posted installment of each purchase
+// whose description carries an installment marker
+func findInstallmentSeries(transactions []*entity.Transaction) []*installmentSeries {
+	latest := make(map[string]*installmentSeries)
+
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit {
+			continue
+		}
+
+		base, marker := splitInstallment(txn.Description)
+		if marker == "" {
+			continue
+		}
+
+		var current, total int
+		if _, err := fmt.Sscanf(marker, "%d/%d", &current, &total); err != nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s|%d", normalizeDescription(base), total)
+		if existing, ok := latest[key]; ok && existing.current >= current {
+			continue
+		}
+
+		latest[key] = &installmentSeries{
+			description: base,
+			current:     current,
+			total:       total,
+			month:       time.Date(txn.Date.Year(), txn.Date.Month(), 1, 0, 0, 0, 0, txn.Date.Location()),
+			amount:      txn.Amount,
+		}
+	}
+
+	series := make([]*installmentSeries, 0, len(latest))
+	for _, s := range latest {
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].description < series[j].description })
+
+	return series
+}
+
+// findRecurringCharges detects charges without installment markers that were
+// posted in at least two of the last few months, using the latest amount seen
+func findRecurringCharges(transactions []*entity.Transaction, currentMonth time.Time) []*entity.Transaction {
+	since := currentMonth.AddDate(0, -recurringLookbackMonths, 0)
+
+	monthsSeen := make(map[string]map[string]bool)
+	latest := make(map[string]*entity.Transaction)
+
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.Date.Before(since) || !txn.Date.Before(currentMonth) {
+			continue
+		}
+		if _, marker := splitInstallment(txn.Description); marker != "" {
+			continue
+		}
+
+		key := normalizeDescription(txn.Description)
+		if monthsSeen[key] == nil {
+			monthsSeen[key] = make(map[string]bool)
+		}
+		monthsSeen[key][txn.Date.Format("2006-01")] = true
+
+		if existing, ok := latest[key]; !ok || txn.Date.After(existing.Date) {
+			latest[key] = txn
+		}
+	}
+
+	var recurring []*entity.Transaction
+	for key, seen := range monthsSeen {
+		if len(seen) >= 2 {
+			recurring = append(recurring, latest[key])
+		}
+	}
+	sort.Slice(recurring, func(i, j int) bool { return recurring[i].Description < recurring[j].Description })
+
+	return recurring
+}
+
+// postedKey tells posted charges apart by description, whichever way their
+// installment marker is written
+func postedKey(description string) string {
+	if base, marker := splitInstallment(description); marker != "" {
+		return normalizeDescription(base) + " " + marker
+	}
+	return normalizeDescription(description)
+}
+
+func normalizeDescription(description string) string {
+	return strings.ToLower(strings.Join(strings.Fields(description), " "))
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
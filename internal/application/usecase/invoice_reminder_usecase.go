@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+)
+
+// InvoiceReminderStage is how close an unpaid invoice is to its due date.
+// Reminders escalate from one stage to the next.
+type InvoiceReminderStage string
+
+const (
+	InvoiceReminderWeek    InvoiceReminderStage = "7-days"
+	InvoiceReminderDays    InvoiceReminderStage = "3-days"
+	InvoiceReminderDueDay  InvoiceReminderStage = "due-day"
+	InvoiceReminderOverdue InvoiceReminderStage = "overdue"
+)
+
+// invoiceReminderStage returns the stage an invoice due on dueDate is at on
+// now's day, or false when it's still more than a week away
+func invoiceReminderStage(dueDate, now time.Time) (InvoiceReminderStage, entity.NotificationSeverity, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, now.Location())
+	days := int(due.Sub(today).Hours() / 24)
+
+	switch {
+	case days < 0:
+		return InvoiceReminderOverdue, entity.NotificationSeverityCritical, true
+	case days == 0:
+		return InvoiceReminderDueDay, entity.NotificationSeverityCritical, true
+	case days <= 3:
+		return InvoiceReminderDays, entity.NotificationSeverityWarning, true
+	case days <= 7:
+		return InvoiceReminderWeek, entity.NotificationSeverityInfo, true
+	}
+	return "", "", false
+}
+
+// InvoiceReminderUseCase reminds of the closed card invoices still unpaid as
+// their due date nears and once it has passed
+type InvoiceReminderUseCase struct {
+	invoiceRepo    repository.CreditCardInvoiceRepository
+	creditCardRepo repository.CreditCardRepository
+	notifications  *NotificationUseCase
+
+	mailer    Mailer
+	recipient string
+	webhook   Webhook
+}
+
+func NewInvoiceReminderUseCase(
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardRepo repository.CreditCardRepository,
+	notifications *NotificationUseCase,
+) *InvoiceReminderUseCase {
+	return &InvoiceReminderUseCase{
+		invoiceRepo:    invoiceRepo,
+		creditCardRepo: creditCardRepo,
+		notifications:  notifications,
+	}
+}
+
+// SetMailer lets cards send their reminders by email to the recipient
+func (uc *InvoiceReminderUseCase) SetMailer(mailer Mailer, recipient string) {
+	uc.mailer = mailer
+	uc.recipient = recipient
+}
+
+// SetWebhook lets cards send their reminders to a webhook
+func (uc *InvoiceReminderUseCase) SetWebhook(webhook Webhook) {
+	uc.webhook = webhook
+}
+
+// SendDueReminders sends the reminder of the stage each unpaid invoice is at,
+// once per invoice and stage, so this is safe to run on every launch. Stages
+// skipped between two launches aren't sent late. Every reminder goes to the
+// notifications center, and by email or webhook when the card asks for it;
+// failing to deliver to those doesn't hold the others back, and is reported
+// once every reminder is sent. It returns how many reminders were sent.
+func (uc *InvoiceReminderUseCase) SendDueReminders(ctx context.Context, now time.Time) (int, error) {
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get credit cards: %w", err)
+	}
+
+	sent := 0
+	var deliveryErr error
+	for _, card := range cards {
+		if card.InvoiceReminders.Disabled {
+			continue
+		}
+
+		invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return sent, fmt.Errorf("failed to get invoices of %s: %w", card.Name, err)
+		}
+
+		for _, invoice := range invoices {
+			if invoice.IsOpen() || invoice.IsSettled() || invoice.ClosingBalance.IsZero() || invoice.ClosingBalance.IsNegative() {
+				continue
+			}
+			stage, severity, ok := invoiceReminderStage(invoice.DueDate, now)
+			if !ok {
+				continue
+			}
+
+			reminded, err := uc.remind(ctx, card, invoice, stage, severity)
+			if reminded {
+				sent++
+			}
+			if err != nil {
+				if !reminded {
+					return sent, err
+				}
+				if deliveryErr == nil {
+					deliveryErr = err
+				}
+			}
+		}
+	}
+
+	return sent, deliveryErr
+}
+
+// remind sends the reminder of an invoice stage unless it was sent already.
+// An error with the reminder sent is a failed email or webhook delivery.
+func (uc *InvoiceReminderUseCase) remind(ctx context.Context, card *entity.CreditCard, invoice *entity.CreditCardInvoice, stage InvoiceReminderStage, severity entity.NotificationSeverity) (bool, error) {
+	key := fmt.Sprintf("invoice-reminder:%s:%s", invoice.ID, stage)
+	notified, err := uc.notifications.Notified(ctx, key)
+	if err != nil || notified {
+		return false, err
+	}
+
+	due := invoice.DueDate.Format("02/01/2006")
+	var title string
+	switch stage {
+	case InvoiceReminderOverdue:
+		title = fmt.Sprintf("%s invoice is overdue since %s", card.Name, due)
+	case InvoiceReminderDueDay:
+		title = fmt.Sprintf("%s invoice is due today", card.Name)
+	default:
+		title = fmt.Sprintf("%s invoice is due on %s", card.Name, due)
+	}
+
+	body := fmt.Sprintf("Invoice %s of %s (•••• %s)\nOutstanding: %s\nDue date: %s",
+		invoice.ReferenceMonth, card.Name, card.LastFourDigits, formatBRL(invoice.ClosingBalance), due)
+	if remaining := invoice.GetMinimumPaymentRemaining(); !remaining.IsZero() {
+		body += fmt.Sprintf("\nMinimum payment still due: %s", formatBRL(remaining))
+	}
+
+	// The other channels are best effort: the reminder stays in the
+	// notifications center either way
+	var deliveryErr error
+	if card.InvoiceReminders.Email {
+		if uc.mailer == nil || uc.recipient == "" {
+			deliveryErr = fmt.Errorf("invoice reminder email needs SMTP and a recipient configured")
+		} else if err := uc.mailer.Send(uc.recipient, title, body); err != nil {
+			deliveryErr = fmt.Errorf("failed to email invoice reminder: %w", err)
+		}
+	}
+	if card.InvoiceReminders.Webhook {
+		payload := map[string]interface{}{
+			"event":           "invoice_reminder",
+			"stage":           string(stage),
+			"severity":        string(severity),
+			"title":           title,
+			"text":            body,
+			"card":            card.Name,
+			"reference_month": invoice.ReferenceMonth,
+			"due_date":        invoice.DueDate.Format("2006-01-02"),
+			"outstanding":     invoice.ClosingBalance.Amount(),
+		}
+		if uc.webhook == nil {
+			deliveryErr = fmt.Errorf("invoice reminder webhook needs a URL configured")
+		} else if err := uc.webhook.Post(payload); err != nil {
+			deliveryErr = fmt.Errorf("failed to post invoice reminder: %w", err)
+		}
+	}
+
+	if _, err := uc.notifications.NotifyWithSeverity(ctx, key, title, body, severity); err != nil {
+		return false, err
+	}
+	return true, deliveryErr
+}
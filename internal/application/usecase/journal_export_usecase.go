@@ -0,0 +1,395 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// JournalFormat is the plaintext accounting dialect a journal is written in
+type JournalFormat string
+
+const (
+	JournalFormatBeancount JournalFormat = "beancount"
+	JournalFormatLedger    JournalFormat = "ledger"
+)
+
+const (
+	journalOpeningAccount  = "Equity:Opening-Balances"
+	journalTransferAccount = "Equity:Transfers"
+)
+
+// journalPosting is one leg of a journal entry. Amounts follow the plaintext
+// accounting convention: money flowing into an account is positive.
+type journalPosting struct {
+	account  string
+	amount   float64
+	currency string
+}
+
+type journalEntry struct {
+	date      time.Time
+	payee     string
+	narration string
+	postings  []journalPosting
+}
+
+// JournalExportUseCase writes accounts and transactions as a Beancount or
+// ledger-cli journal
+type JournalExportUseCase struct {
+	accountRepo     repository.AccountRepository
+	creditCardRepo  repository.CreditCardRepository
+	personRepo      repository.PersonRepository
+	transactionRepo repository.TransactionRepository
+	outputDir       string
+}
+
+func NewJournalExportUseCase(
+	accountRepo repository.AccountRepository,
+	creditCardRepo repository.CreditCardRepository,
+	personRepo repository.PersonRepository,
+	transactionRepo repository.TransactionRepository,
+	outputDir string,
+) *JournalExportUseCase {
+	return &JournalExportUseCase{
+		accountRepo:     accountRepo,
+		creditCardRepo:  creditCardRepo,
+		personRepo:      personRepo,
+		transactionRepo: transactionRepo,
+		outputDir:       outputDir,
+	}
+}
+
+// ParseJournalFormat accepts the format names used on the command line
+func ParseJournalFormat(name string) (JournalFormat, error) {
+	switch JournalFormat(strings.ToLower(name)) {
+	case JournalFormatBeancount:
+		return JournalFormatBeancount, nil
+	case JournalFormatLedger:
+		return JournalFormatLedger, nil
+	}
+	return "", fmt.Errorf("unknown journal format %q (use beancount or ledger)", name)
+}
+
+// ExportJournal writes the journal to path, or to a dated file in the export
+// directory when path is empty, and returns the path of the created file
+func (uc *JournalExportUseCase) ExportJournal(ctx context.Context, format JournalFormat, path string, now time.Time) (string, error) {
+	journal, err := uc.RenderJournal(ctx, format)
+	if err != nil {
+		return "", err
+	}
+
+	if path == "" {
+		path = filepath.Join(uc.outputDir, fmt.Sprintf("financli-%s.%s", now.Format("2006-01-02"), format))
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(journal), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write journal: %w", err)
+	}
+	return path, nil
+}
+
+// RenderJournal renders every account and transaction as a journal. Each
+// account gets an opening balance entry sized so that, after all of its
+// transactions, the journal ends at the balance financli shows today.
+func (uc *JournalExportUseCase) RenderJournal(ctx context.Context, format JournalFormat) (string, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load accounts: %w", err)
+	}
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load credit cards: %w", err)
+	}
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load people: %w", err)
+	}
+	transactions, err := uc.transactionRepo.FindAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load transactions: %w", err)
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if !transactions[i].Date.Equal(transactions[j].Date) {
+			return transactions[i].Date.Before(transactions[j].Date)
+		}
+		return transactions[i].CreatedAt.Before(transactions[j].CreatedAt)
+	})
+
+	sources := make(map[uuid.UUID]string)
+	for _, account := range accounts {
+		sources[account.ID] = journalAccountName("Assets", journalAccountTypeName(account.Type), account.Name)
+	}
+	for _, card := range cards {
+		sources[card.ID] = journalAccountName("Liabilities", "CreditCard", card.Name)
+	}
+	personNames := make(map[uuid.UUID]string)
+	for _, person := range people {
+		personNames[person.ID] = person.Name
+	}
+
+	entries := make([]journalEntry, 0, len(transactions))
+	movements := make(map[string]float64)
+	for _, transaction := range transactions {
+		entry := buildJournalEntry(transaction, sources, personNames)
+		movements[entry.postings[0].account] += entry.postings[0].amount
+		entries = append(entries, entry)
+	}
+
+	// Opening balances come first, dated when the account was created or on the
+	// day of its first transaction, whichever is earlier
+	var openings []journalEntry
+	firstSeen := journalFirstSeen(entries)
+	addOpening := func(account string, balance float64, currency string, createdAt time.Time) {
+		date := createdAt
+		if first, ok := firstSeen[account]; ok && first.Before(date) {
+			date = first
+		}
+		firstSeen[account] = date
+		amount := roundCents(balance - movements[account])
+		if amount == 0 {
+			return
+		}
+		openings = append(openings, journalEntry{
+			date:      date,
+			narration: "Opening balance",
+			postings: []journalPosting{
+				{account: account, amount: amount, currency: currency},
+				{account: journalOpeningAccount, amount: -amount, currency: currency},
+			},
+		})
+	}
+	for _, account := range accounts {
+		addOpening(sources[account.ID], account.Balance.Amount(), account.Balance.Currency(), account.CreatedAt)
+	}
+	for _, card := range cards {
+		// Card balances are debt, which a journal records as a negative liability
+		addOpening(sources[card.ID], -card.CurrentBalance.Amount(), card.CurrentBalance.Currency(), card.CreatedAt)
+	}
+	for _, opening := range openings {
+		if first, ok := firstSeen[journalOpeningAccount]; !ok || opening.date.Before(first) {
+			firstSeen[journalOpeningAccount] = opening.date
+		}
+	}
+
+	entries = append(openings, entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].date.Before(entries[j].date)
+	})
+
+	return renderJournal(format, firstSeen, journalCurrencies(entries), entries), nil
+}
+
+func buildJournalEntry(transaction *entity.Transaction, sources map[uuid.UUID]string, personNames map[uuid.UUID]string) journalEntry {
+	currency := transaction.Amount.Currency()
+	amount := transaction.Amount.Amount()
+
+	source := "Assets:Unknown"
+	switch {
+	case transaction.AccountID != nil && sources[*transaction.AccountID] != "":
+		source = sources[*transaction.AccountID]
+	case transaction.CreditCardID != nil && sources[*transaction.CreditCardID] != "":
+		source = sources[*transaction.CreditCardID]
+	}
+
+	entry := journalEntry{
+		date:      transaction.Date,
+		payee:     transaction.Venue,
+		narration: transaction.Description,
+	}
+
+	if transaction.Type == entity.TransactionTypeCredit {
+		counter := journalTransferAccount
+		if transaction.Category != entity.TransactionCategoryTransfer {
+			counter = journalAccountName("Income", journalCategoryName(transaction.Category))
+		}
+		entry.postings = []journalPosting{
+			{account: source, amount: amount, currency: currency},
+			{account: counter, amount: -amount, currency: currency},
+		}
+		return entry
+	}
+
+	entry.postings = []journalPosting{{account: source, amount: -amount, currency: currency}}
+
+	// What others owe for a shared expense is a receivable, not an expense
+	personal := amount
+	for _, shared := range transaction.SharedWith {
+		name, ok := personNames[shared.PersonID]
+		if !ok {
+			name = shared.PersonID.String()[:8]
+		}
+		share := roundCents(shared.Amount.Amount())
+		personal -= share
+		entry.postings = append(entry.postings, journalPosting{
+			account:  journalAccountName("Assets", "Receivables", name),
+			amount:   share,
+			currency: currency,
+		})
+	}
+
+	counter := journalTransferAccount
+	if transaction.Category != entity.TransactionCategoryTransfer {
+		counter = journalAccountName("Expenses", journalCategoryName(transaction.Category))
+	}
+	entry.postings = append(entry.postings, journalPosting{account: counter, amount: roundCents(personal), currency: currency})
+	return entry
+}
+
+func renderJournal(format JournalFormat, opened map[string]time.Time, currencies []string, entries []journalEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "; Exported by financli\n\n")
+
+	names := make([]string, 0, len(opened))
+	for name := range opened {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if format == JournalFormatBeancount {
+		fmt.Fprintf(&b, "option \"title\" \"financli\"\n")
+		for _, currency := range currencies {
+			fmt.Fprintf(&b, "option \"operating_currency\" \"%s\"\n", currency)
+		}
+		b.WriteString("\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "%s open %s\n", opened[name].Format("2006-01-02"), name)
+		}
+	} else {
+		for _, currency := range currencies {
+			fmt.Fprintf(&b, "commodity %s\n", currency)
+		}
+		for _, name := range names {
+			fmt.Fprintf(&b, "account %s\n", name)
+		}
+	}
+
+	for _, entry := range entries {
+		b.WriteString("\n")
+		if format == JournalFormatBeancount {
+			fmt.Fprintf(&b, "%s *", entry.date.Format("2006-01-02"))
+			if entry.payee != "" {
+				fmt.Fprintf(&b, " %s", beancountString(entry.payee))
+			}
+			fmt.Fprintf(&b, " %s\n", beancountString(entry.narration))
+		} else {
+			payee := entry.narration
+			if entry.payee != "" {
+				payee = entry.payee
+			}
+			fmt.Fprintf(&b, "%s * %s\n", entry.date.Format("2006/01/02"), strings.TrimSpace(payee))
+			if entry.payee != "" && entry.narration != "" {
+				fmt.Fprintf(&b, "  ; %s\n", entry.narration)
+			}
+		}
+		for _, posting := range entry.postings {
+			fmt.Fprintf(&b, "  %-48s %12s %s\n", posting.account,
+				strconv.FormatFloat(posting.amount, 'f', 2, 64), posting.currency)
+		}
+	}
+	return b.String()
+}
+
+// journalFirstSeen returns the date each account is first used, which is
+// when the journal opens it
+func journalFirstSeen(entries []journalEntry) map[string]time.Time {
+	firstSeen := make(map[string]time.Time)
+	for _, entry := range entries {
+		for _, posting := range entry.postings {
+			if first, ok := firstSeen[posting.account]; !ok || entry.date.Before(first) {
+				firstSeen[posting.account] = entry.date
+			}
+		}
+	}
+	return firstSeen
+}
+
+func journalCurrencies(entries []journalEntry) []string {
+	seen := make(map[string]bool)
+	var currencies []string
+	for _, entry := range entries {
+		for _, posting := range entry.postings {
+			if !seen[posting.currency] {
+				seen[posting.currency] = true
+				currencies = append(currencies, posting.currency)
+			}
+		}
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+func journalAccountTypeName(accountType entity.AccountType) string {
+	switch accountType {
+	case entity.AccountTypeSavings:
+		return "Savings"
+	case entity.AccountTypeInvestment:
+		return "Investment"
+	default:
+		return "Checking"
+	}
+}
+
+func journalCategoryName(category entity.TransactionCategory) string {
+	if category == entity.TransactionCategoryIncome {
+		return "General"
+	}
+	return string(category)
+}
+
+// journalAccountName joins the parts into an account name both Beancount and
+// ledger-cli accept: each component starts with a capital letter or digit and holds
+// only ASCII letters, digits and dashes
+func journalAccountName(parts ...string) string {
+	components := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if component := journalComponent(part); component != "" {
+			components = append(components, component)
+		}
+	}
+	return strings.Join(components, ":")
+}
+
+func journalComponent(name string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		stripped = name
+	}
+
+	var words []string
+	for _, word := range strings.FieldsFunc(stripped, func(r rune) bool {
+		return !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+	}) {
+		words = append(words, strings.ToUpper(word[:1])+word[1:])
+	}
+	component := strings.Join(words, "-")
+	if component == "" {
+		return "Unnamed"
+	}
+	return component
+}
+
+func beancountString(s string) string {
+	return "\"" + strings.ReplaceAll(strings.TrimSpace(s), "\"", "'") + "\""
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
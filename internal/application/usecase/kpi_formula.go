@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KPIVariables are the monthly aggregates a KPI formula can use, with what
+// each one holds
+var KPIVariables = map[string]string{
+	"income":       "income received this month",
+	"expenses":     "expenses this month, card purchases included",
+	"net":          "income minus expenses",
+	"balance":      "sum of every account balance",
+	"card_balance": "sum of every credit card balance",
+	"invoices_due": "what is still owed on credit card invoices due by the end of the month",
+	"bills_due":    "what is still owed on open bills",
+	"transactions": "number of transactions this month",
+	"day":          "day of the month",
+	"days_left":    "days left in the month, today included",
+}
+
+// KPIFormula is a named arithmetic expression over the KPIVariables, such as
+// "Free cash=income - expenses - invoices_due". Expressions support numbers,
+// variables, + - * /, unary minus and parentheses.
+type KPIFormula struct {
+	Name       string
+	Expression string
+	root       kpiNode
+}
+
+// ParseKPIFormula reads a "name=expression" definition; without a name the
+// expression itself names the KPI
+func ParseKPIFormula(definition string) (*KPIFormula, error) {
+	name, expression, found := strings.Cut(definition, "=")
+	if !found {
+		expression = name
+	}
+	name, expression = strings.TrimSpace(name), strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, fmt.Errorf("KPI %q has no formula", name)
+	}
+
+	parser := &kpiParser{tokens: tokenizeKPI(expression)}
+	root, err := parser.parseSum()
+	if err == nil && parser.pos < len(parser.tokens) {
+		err = fmt.Errorf("unexpected %q", parser.tokens[parser.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid KPI formula %q: %w", expression, err)
+	}
+
+	return &KPIFormula{Name: name, Expression: expression, root: root}, nil
+}
+
+// Evaluate computes the formula with the given variable values
+func (f *KPIFormula) Evaluate(variables map[string]float64) (float64, error) {
+	return f.root.eval(variables)
+}
+
+type kpiNode interface {
+	eval(variables map[string]float64) (float64, error)
+}
+
+type kpiNumber float64
+
+func (n kpiNumber) eval(map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+type kpiVariable string
+
+func (v kpiVariable) eval(variables map[string]float64) (float64, error) {
+	value, ok := variables[string(v)]
+	if !ok {
+		return 0, fmt.Errorf("%s is not available", v)
+	}
+	return value, nil
+}
+
+type kpiNegation struct {
+	operand kpiNode
+}
+
+func (n kpiNegation) eval(variables map[string]float64) (float64, error) {
+	value, err := n.operand.eval(variables)
+	return -value, err
+}
+
+type kpiBinary struct {
+	op          byte
+	left, right kpiNode
+}
+
+func (b kpiBinary) eval(variables map[string]float64) (float64, error) {
+	left, err := b.left.eval(variables)
+	if err != nil {
+		return 0, err
+	}
+	right, err := b.right.eval(variables)
+	if err != nil {
+		return 0, err
+	}
+
+	switch b.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	default:
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
+	}
+}
+
+// tokenizeKPI splits an expression into numbers, identifiers and single-rune
+// operators; anything else becomes its own token and fails to parse
+func tokenizeKPI(expression string) []string {
+	var tokens []string
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, strings.ToLower(string(runes[start:i])))
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+// kpiParser is a recursive descent parser: sums of products of factors
+type kpiParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *kpiParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *kpiParser) parseSum() (kpiNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "+" || op == "-"; op = p.peek() {
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = kpiBinary{op: op[0], left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *kpiParser) parseProduct() (kpiNode, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "*" || op == "/"; op = p.peek() {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = kpiBinary{op: op[0], left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *kpiParser) parseFactor() (kpiNode, error) {
+	token := p.peek()
+	if token == "" {
+		return nil, fmt.Errorf("unexpected end of formula")
+	}
+	p.pos++
+
+	first, _ := utf8.DecodeRuneInString(token)
+	switch {
+	case token == "-":
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return kpiNegation{operand: operand}, nil
+	case token == "(":
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	case unicode.IsDigit(first) || first == '.':
+		value, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", token)
+		}
+		return kpiNumber(value), nil
+	case unicode.IsLetter(first) || first == '_':
+		if _, ok := KPIVariables[token]; !ok {
+			return nil, fmt.Errorf("unknown variable %q", token)
+		}
+		return kpiVariable(token), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKPIFormula(t *testing.T) {
+	variables := map[string]float64{"income": 5000, "expenses": 3000, "invoices_due": 500, "days_left": 10, "day": 0}
+
+	tests := []struct {
+		name       string
+		definition string
+		kpiName    string
+		want       float64
+	}{
+		{"named", "Free cash=income - expenses - invoices_due", "Free cash", 1500},
+		{"unnamed", "income - expenses", "income - expenses", 2000},
+		{"product before sum", "income - expenses * 2", "", -1000},
+		{"division before sum", "income + expenses / 3", "", 6000},
+		{"left associative", "income - expenses - 1000", "", 1000},
+		{"parentheses", "(income - expenses) / days_left", "", 200},
+		{"nested parentheses", "((income - (expenses + invoices_due)) * 2)", "", 3000},
+		{"unary minus", "-expenses + income", "", 2000},
+		{"negated group", "-(income - expenses)", "", -2000},
+		{"decimals", "income * .5 + 0.25", "", 2500.25},
+		{"case insensitive", "INCOME - Expenses", "", 2000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formula, err := ParseKPIFormula(tt.definition)
+			require.NoError(t, err)
+			if tt.kpiName != "" {
+				assert.Equal(t, tt.kpiName, formula.Name)
+			}
+
+			value, err := formula.Evaluate(variables)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, value, 0.0001)
+		})
+	}
+}
+
+func TestParseKPIFormula_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantErr    string
+	}{
+		{"empty formula", "Cash=", "has no formula"},
+		{"unknown identifier", "income - rent", `unknown variable "rent"`},
+		{"non-ASCII identifier", "income - éxito", `unknown variable "éxito"`},
+		{"unclosed parenthesis", "(income - expenses", "missing closing parenthesis"},
+		{"stray closing parenthesis", "income - expenses)", `unexpected ")"`},
+		{"dangling operator", "income -", "unexpected end of formula"},
+		{"two operators", "income * / expenses", `unexpected "/"`},
+		{"two operands", "income expenses", `unexpected "expenses"`},
+		{"invalid number", "income * 1.2.3", `invalid number "1.2.3"`},
+		{"unknown symbol", "income % 2", `unexpected "%"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseKPIFormula(tt.definition)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestKPIFormula_Evaluate_Errors(t *testing.T) {
+	formula, err := ParseKPIFormula("income / day")
+	require.NoError(t, err)
+
+	_, err = formula.Evaluate(map[string]float64{"income": 100, "day": 0})
+	assert.EqualError(t, err, "division by zero")
+
+	_, err = formula.Evaluate(map[string]float64{"income": 100})
+	assert.EqualError(t, err, "day is not available")
+
+	formula, err = ParseKPIFormula("income / (day - day)")
+	require.NoError(t, err)
+	_, err = formula.Evaluate(map[string]float64{"income": 100, "day": 3})
+	assert.EqualError(t, err, "division by zero")
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+)
+
+// KPIResult is the value of one KPI formula, or why it couldn't be computed
+type KPIResult struct {
+	Name  string
+	Value float64
+	Err   error
+}
+
+// KPIUseCase evaluates the user's KPI formulas against the month's aggregates
+type KPIUseCase struct {
+	accountRepo     repository.AccountRepository
+	creditCardRepo  repository.CreditCardRepository
+	invoiceRepo     repository.CreditCardInvoiceRepository
+	billRepo        repository.BillRepository
+	transactionRepo repository.TransactionRepository
+
+	formulas []*KPIFormula
+	// invalid keeps the definitions that failed to parse, so they show up as
+	// errors instead of silently disappearing
+	invalid []KPIResult
+}
+
+func NewKPIUseCase(
+	accountRepo repository.AccountRepository,
+	creditCardRepo repository.CreditCardRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	billRepo repository.BillRepository,
+	transactionRepo repository.TransactionRepository,
+) *KPIUseCase {
+	return &KPIUseCase{
+		accountRepo:     accountRepo,
+		creditCardRepo:  creditCardRepo,
+		invoiceRepo:     invoiceRepo,
+		billRepo:        billRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetFormulas replaces the KPI definitions, each a "name=expression"
+func (uc *KPIUseCase) SetFormulas(definitions []string) {
+	uc.formulas, uc.invalid = nil, nil
+	for _, definition := range definitions {
+		formula, err := ParseKPIFormula(definition)
+		if err != nil {
+			name, _, _ := strings.Cut(definition, "=")
+			uc.invalid = append(uc.invalid, KPIResult{Name: strings.TrimSpace(name), Err: err})
+			continue
+		}
+		uc.formulas = append(uc.formulas, formula)
+	}
+}
+
+// HasFormulas reports whether any KPI is defined, valid or not
+func (uc *KPIUseCase) HasFormulas() bool {
+	return len(uc.formulas) > 0 || len(uc.invalid) > 0
+}
+
+// EvaluateKPIs computes every formula for the month of now, in the order they
+// were defined, followed by the definitions that failed to parse
+func (uc *KPIUseCase) EvaluateKPIs(ctx context.Context, now time.Time) ([]KPIResult, error) {
+	if !uc.HasFormulas() {
+		return nil, nil
+	}
+
+	variables, err := uc.MonthlyAggregates(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]KPIResult, 0, len(uc.formulas)+len(uc.invalid))
+	for _, formula := range uc.formulas {
+		value, err := formula.Evaluate(variables)
+		results = append(results, KPIResult{Name: formula.Name, Value: value, Err: err})
+	}
+	return append(results, uc.invalid...), nil
+}
+
+// MonthlyAggregates computes the values of the KPIVariables for the month of now
+func (uc *KPIUseCase) MonthlyAggregates(ctx context.Context, now time.Time) (map[string]float64, error) {
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	startOfNextMonth := startOfMonth.AddDate(0, 1, 0)
+
+	variables := map[string]float64{
+		"day":       float64(now.Day()),
+		"days_left": float64(startOfNextMonth.AddDate(0, 0, -1).Day() - now.Day() + 1),
+	}
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, startOfMonth, startOfNextMonth.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+	var income, expenses float64
+	for _, txn := range transactions {
+		if txn.Type == entity.TransactionTypeCredit {
+			income += txn.Amount.Amount()
+		} else {
+			expenses += txn.Amount.Amount()
+		}
+	}
+	variables["income"] = income
+	variables["expenses"] = expenses
+	variables["net"] = income - expenses
+	variables["transactions"] = float64(len(transactions))
+
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load accounts: %w", err)
+	}
+	var balance float64
+	for _, account := range accounts {
+		balance += account.Balance.Amount()
+	}
+	variables["balance"] = balance
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credit cards: %w", err)
+	}
+	var cardBalance, invoicesDue float64
+	for _, card := range cards {
+		cardBalance += card.CurrentBalance.Amount()
+
+		invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load invoices: %w", err)
+		}
+		for _, invoice := range invoices {
+			if invoice.Status == entity.InvoiceStatusPaid || !invoice.DueDate.Before(startOfNextMonth) {
+				continue
+			}
+			// The closing balance already has the payments made so far taken off
+			if owed := invoice.ClosingBalance.Amount(); owed > 0 {
+				invoicesDue += owed
+			}
+		}
+	}
+	variables["card_balance"] = cardBalance
+	variables["invoices_due"] = invoicesDue
+
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bills: %w", err)
+	}
+	var billsDue float64
+	for _, bill := range bills {
+		if bill.Status != entity.BillStatusOpen && bill.Status != entity.BillStatusOverdue {
+			continue
+		}
+		if remaining, err := bill.GetRemainingAmount(); err == nil && remaining.Amount() > 0 {
+			billsDue += remaining.Amount()
+		}
+	}
+	variables["bills_due"] = billsDue
+
+	return variables, nil
+}
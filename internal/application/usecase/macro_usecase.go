@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type MacroUseCase struct {
+	macroRepo repository.MacroRepository
+}
+
+func NewMacroUseCase(macroRepo repository.MacroRepository) *MacroUseCase {
+	return &MacroUseCase{
+		macroRepo: macroRepo,
+	}
+}
+
+// SaveMacro binds the keystrokes to the slot's hotkey, replacing the macro that
+// was bound to it, if any
+func (uc *MacroUseCase) SaveMacro(ctx context.Context, name string, slot int, keys []entity.MacroKey) (*entity.Macro, error) {
+	macros, err := uc.macroRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get macros: %w", err)
+	}
+
+	for _, macro := range macros {
+		if macro.Slot != slot {
+			continue
+		}
+
+		if err := macro.Record(name, keys); err != nil {
+			return nil, err
+		}
+		if err := uc.macroRepo.Update(ctx, macro); err != nil {
+			return nil, fmt.Errorf("failed to save macro: %w", err)
+		}
+		return macro, nil
+	}
+
+	macro, err := entity.NewMacro(name, slot, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.macroRepo.Create(ctx, macro); err != nil {
+		return nil, fmt.Errorf("failed to save macro: %w", err)
+	}
+
+	return macro, nil
+}
+
+func (uc *MacroUseCase) ListMacros(ctx context.Context) ([]*entity.Macro, error) {
+	return uc.macroRepo.FindAll(ctx)
+}
+
+func (uc *MacroUseCase) DeleteMacro(ctx context.Context, id uuid.UUID) error {
+	return uc.macroRepo.Delete(ctx, id)
+}
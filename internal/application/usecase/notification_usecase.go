@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type NotificationUseCase struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewNotificationUseCase(notificationRepo repository.NotificationRepository) *NotificationUseCase {
+	return &NotificationUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Notified tells whether a notification with the key was already added
+func (uc *NotificationUseCase) Notified(ctx context.Context, key string) (bool, error) {
+	return uc.notificationRepo.ExistsByKey(ctx, key)
+}
+
+// Notify adds a notification unless one with the same key was already added,
+// in which case it returns nil
+func (uc *NotificationUseCase) Notify(ctx context.Context, key, title, body string) (*entity.Notification, error) {
+	return uc.NotifyWithSeverity(ctx, key, title, body, entity.NotificationSeverityInfo)
+}
+
+// NotifyWithSeverity is Notify for notifications more urgent than the default
+func (uc *NotificationUseCase) NotifyWithSeverity(ctx context.Context, key, title, body string, severity entity.NotificationSeverity) (*entity.Notification, error) {
+	exists, err := uc.notificationRepo.ExistsByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, nil
+	}
+
+	notification, err := entity.NewNotification(key, title, body)
+	if err != nil {
+		return nil, err
+	}
+	notification.Severity = severity
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return nil, fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	return notification, nil
+}
+
+func (uc *NotificationUseCase) ListNotifications(ctx context.Context) ([]*entity.Notification, error) {
+	return uc.notificationRepo.FindAll(ctx)
+}
+
+func (uc *NotificationUseCase) MarkRead(ctx context.Context, notification *entity.Notification) error {
+	if notification.IsRead() {
+		return nil
+	}
+
+	notification.MarkRead()
+	if err := uc.notificationRepo.Update(ctx, notification); err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	return nil
+}
+
+func (uc *NotificationUseCase) DeleteNotification(ctx context.Context, id uuid.UUID) error {
+	return uc.notificationRepo.Delete(ctx, id)
+}
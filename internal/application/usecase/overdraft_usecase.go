@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type OverdraftUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	periods         *PeriodLockUseCase
+}
+
+func NewOverdraftUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository) *OverdraftUseCase {
+	return &OverdraftUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetPeriodLocks keeps overdraft interest from being charged in months whose
+// books are closed
+func (uc *OverdraftUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// SetAccountOverdraft configures the overdraft limit and its monthly interest
+// rate; a zero limit removes the overdraft
+func (uc *OverdraftUseCase) SetAccountOverdraft(ctx context.Context, accountID uuid.UUID, limit, monthlyRate float64) error {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	if err := account.SetOverdraft(valueobject.NewMoney(limit, account.Balance.Currency()), monthlyRate, time.Now()); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return nil
+}
+
+// AccrueDailyInterest posts an interest debit for every closed day an account
+// with an overdraft ended below zero. Days missed while the app wasn't running
+// are caught up in order, each on the balance the account closed it with,
+// rebuilt from the transactions made since.
+func (uc *OverdraftUseCase) AccrueDailyInterest(ctx context.Context, now time.Time) ([]*entity.Transaction, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var posted []*entity.Transaction
+	for _, account := range accounts {
+		if !account.HasOverdraft() || account.LastOverdraftDay == "" {
+			continue
+		}
+
+		transactions, err := uc.accrueAccount(ctx, account, today)
+		posted = append(posted, transactions...)
+		if err != nil {
+			return posted, fmt.Errorf("failed to accrue overdraft interest for %s: %w", account.Name, err)
+		}
+	}
+
+	return posted, nil
+}
+
+func (uc *OverdraftUseCase) accrueAccount(ctx context.Context, account *entity.Account, today time.Time) ([]*entity.Transaction, error) {
+	last, err := time.ParseInLocation("2006-01-02", account.LastOverdraftDay, today.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid last overdraft day: %w", err)
+	}
+	day := last.AddDate(0, 0, 1)
+	if !day.Before(today) {
+		return nil, nil
+	}
+
+	history, err := uc.transactionRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account transactions: %w", err)
+	}
+
+	var posted []*entity.Transaction
+	for ; day.Before(today); day = day.AddDate(0, 0, 1) {
+		// The balance the day closed with is the current one before what came after it
+		nextDay := day.AddDate(0, 0, 1)
+		closing := account.Balance.Amount()
+		for _, txn := range history {
+			if txn.Date.Before(nextDay) {
+				continue
+			}
+			if txn.Type == entity.TransactionTypeDebit {
+				closing += txn.Amount.Amount()
+			} else {
+				closing -= txn.Amount.Amount()
+			}
+		}
+
+		interest, err := account.ChargeOverdraftInterest(day, closing)
+		if err != nil {
+			return posted, err
+		}
+
+		var transaction *entity.Transaction
+		if !interest.IsZero() {
+			if err := uc.periods.CheckOpen(ctx, day); err != nil {
+				return posted, err
+			}
+			transaction = entity.NewTransaction(&account.ID, nil, entity.TransactionTypeDebit, entity.TransactionCategoryOther,
+				interest, fmt.Sprintf("Overdraft interest %s (%g%% a.m.)", day.Format("02/01/2006"), account.OverdraftRate), day)
+		}
+
+		// Persist the account first so a failure never leaves a debit without its balance
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return posted, fmt.Errorf("failed to update account: %w", err)
+		}
+
+		if transaction != nil {
+			if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+				return posted, fmt.Errorf("failed to create overdraft interest transaction: %w", err)
+			}
+			history = append(history, transaction)
+			posted = append(posted, transaction)
+		}
+	}
+
+	return posted, nil
+}
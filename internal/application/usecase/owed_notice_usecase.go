@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/repository"
+)
+
+// Mailer sends a plain text email
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+type OwedNoticeUseCase struct {
+	personRepo    repository.PersonRepository
+	reportUseCase *ReportUseCase
+	mailer        Mailer
+}
+
+func NewOwedNoticeUseCase(personRepo repository.PersonRepository, reportUseCase *ReportUseCase, mailer Mailer) *OwedNoticeUseCase {
+	return &OwedNoticeUseCase{
+		personRepo:    personRepo,
+		reportUseCase: reportUseCase,
+		mailer:        mailer,
+	}
+}
+
+// SendMonthlyNotices emails every person who opted in a summary of the shared
+// expenses of the last closed month. Each month is sent at most once, so this
+// is safe to run on every launch. People who owe nothing get no email.
+func (uc *OwedNoticeUseCase) SendMonthlyNotices(ctx context.Context, now time.Time) (int, error) {
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	month := monthStart.Format("2006-01")
+
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get people: %w", err)
+	}
+
+	sent := 0
+	for _, person := range people {
+		if !person.NeedsOwedNotice(month) {
+			continue
+		}
+
+		// The report excludes transactions at exactly its start time
+		report, err := uc.reportUseCase.GetSharedExpenseReport(ctx, person.ID, monthStart.Add(-time.Nanosecond), monthEnd)
+		if err != nil {
+			return sent, err
+		}
+
+		if !report.Balance.IsZero() {
+			subject := fmt.Sprintf("Shared expenses for %s", monthStart.Format("January 2006"))
+			if err := uc.mailer.Send(person.Email, subject, renderOwedNotice(report, monthStart)); err != nil {
+				return sent, err
+			}
+			sent++
+		}
+
+		person.MarkOwedNoticeSent(month)
+		if err := uc.personRepo.Update(ctx, person); err != nil {
+			return sent, fmt.Errorf("failed to update person: %w", err)
+		}
+	}
+
+	return sent, nil
+}
+
+func renderOwedNotice(report *SharedExpenseReport, month time.Time) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Hi %s,\n\n", report.Person.Name)
+	fmt.Fprintf(&b, "Here is your share of the expenses we split in %s:\n\n", month.Format("January 2006"))
+
+	for _, txn := range report.Expenses {
+		for _, shared := range txn.SharedWith {
+			if shared.PersonID != report.Person.ID {
+				continue
+			}
+			fmt.Fprintf(&b, "  %s  %-30s %s (%.0f%%)\n", txn.Date.Format("02/01"), txn.Description, formatBRL(shared.Amount), shared.Percentage)
+		}
+	}
+
+	b.WriteString("\n")
+	if report.Balance.IsNegative() {
+		fmt.Fprintf(&b, "You are owed %s.\n", formatBRL(report.Balance.Multiply(-1)))
+	} else {
+		fmt.Fprintf(&b, "Total you owe: %s\n", formatBRL(report.Balance))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type PendingPaymentUseCase struct {
+	pendingPaymentRepo repository.PendingPaymentRepository
+	accountRepo        repository.AccountRepository
+	creditCardRepo     repository.CreditCardRepository
+	invoiceRepo        repository.CreditCardInvoiceRepository
+	creditCardUseCase  *CreditCardUseCase
+	invoiceUseCase     *CreditCardInvoiceUseCase
+}
+
+func NewPendingPaymentUseCase(
+	pendingPaymentRepo repository.PendingPaymentRepository,
+	accountRepo repository.AccountRepository,
+	creditCardRepo repository.CreditCardRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardUseCase *CreditCardUseCase,
+	invoiceUseCase *CreditCardInvoiceUseCase,
+) *PendingPaymentUseCase {
+	return &PendingPaymentUseCase{
+		pendingPaymentRepo: pendingPaymentRepo,
+		accountRepo:        accountRepo,
+		creditCardRepo:     creditCardRepo,
+		invoiceRepo:        invoiceRepo,
+		creditCardUseCase:  creditCardUseCase,
+		invoiceUseCase:     invoiceUseCase,
+	}
+}
+
+// SchedulePayment schedules a card payment from the card's linked account. The
+// amount is reserved on the account until the payment date, when it clears
+// against the card and its oldest unpaid closed invoice.
+func (uc *PendingPaymentUseCase) SchedulePayment(ctx context.Context, creditCardID uuid.UUID, amount float64, currency string, scheduledFor time.Time) (*entity.PendingPayment, error) {
+	card, err := uc.creditCardRepo.FindByID(ctx, creditCardID)
+	if err != nil {
+		return nil, fmt.Errorf("credit card not found: %w", err)
+	}
+
+	account, err := uc.accountRepo.FindByID(ctx, card.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("linked account not found: %w", err)
+	}
+
+	money := valueobject.NewMoney(amount, currency)
+
+	if account.Type != entity.AccountTypeChecking {
+		available, err := uc.availableToSpend(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		if available.Amount() < money.Amount() {
+			return nil, fmt.Errorf("insufficient funds: only %s available to spend", available.String())
+		}
+	}
+
+	payment, err := entity.NewPendingPayment(account.ID, card.ID, uc.findInvoiceToPay(ctx, card.ID), money, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.pendingPaymentRepo.Create(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to schedule payment: %w", err)
+	}
+
+	// Payments scheduled for today or earlier clear right away
+	if payment.IsDue(time.Now()) {
+		if err := uc.clearPayment(ctx, payment, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return payment, nil
+}
+
+// CancelPayment drops a scheduled payment, releasing the reserved amount
+func (uc *PendingPaymentUseCase) CancelPayment(ctx context.Context, paymentID uuid.UUID) error {
+	payment, err := uc.pendingPaymentRepo.FindByID(ctx, paymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get pending payment: %w", err)
+	}
+
+	if err := payment.Cancel(); err != nil {
+		return err
+	}
+
+	if err := uc.pendingPaymentRepo.Update(ctx, payment); err != nil {
+		return fmt.Errorf("failed to cancel payment: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *PendingPaymentUseCase) ListPendingByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return uc.pendingPaymentRepo.FindPendingByCreditCardID(ctx, creditCardID)
+}
+
+// GetAvailableToSpend returns the account balance minus the payments still pending on it
+func (uc *PendingPaymentUseCase) GetAvailableToSpend(ctx context.Context, accountID uuid.UUID) (valueobject.Money, error) {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return valueobject.Money{}, fmt.Errorf("account not found: %w", err)
+	}
+
+	return uc.availableToSpend(ctx, account)
+}
+
+// ResolveDuePayments clears every pending payment whose date has arrived
+func (uc *PendingPaymentUseCase) ResolveDuePayments(ctx context.Context, now time.Time) ([]*entity.PendingPayment, error) {
+	payments, err := uc.pendingPaymentRepo.FindPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending payments: %w", err)
+	}
+
+	var cleared []*entity.PendingPayment
+	for _, payment := range payments {
+		if !payment.IsDue(now) {
+			continue
+		}
+
+		if err := uc.clearPayment(ctx, payment, now); err != nil {
+			return cleared, err
+		}
+		cleared = append(cleared, payment)
+	}
+
+	return cleared, nil
+}
+
+func (uc *PendingPaymentUseCase) clearPayment(ctx context.Context, payment *entity.PendingPayment, now time.Time) error {
+	amount, currency := payment.Amount.Amount(), payment.Amount.Currency()
+
+	if err := uc.creditCardUseCase.MakePayment(ctx, payment.CreditCardID, amount, currency); err != nil {
+		return fmt.Errorf("failed to clear scheduled payment: %w", err)
+	}
+
+	if payment.InvoiceID != nil {
+		if err := uc.invoiceUseCase.ProcessPayment(ctx, *payment.InvoiceID, amount, currency); err != nil {
+			// The card is already paid, so don't fail over the invoice bookkeeping
+			fmt.Printf("Warning: failed to apply scheduled payment to invoice: %v\n", err)
+		}
+	}
+
+	if err := payment.MarkCleared(now); err != nil {
+		return err
+	}
+
+	if err := uc.pendingPaymentRepo.Update(ctx, payment); err != nil {
+		return fmt.Errorf("failed to update pending payment: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *PendingPaymentUseCase) availableToSpend(ctx context.Context, account *entity.Account) (valueobject.Money, error) {
+	payments, err := uc.pendingPaymentRepo.FindPendingByAccountID(ctx, account.ID)
+	if err != nil {
+		return valueobject.Money{}, fmt.Errorf("failed to get pending payments: %w", err)
+	}
+
+	available := account.GetAvailableBalance()
+	for _, payment := range payments {
+		available, err = available.Subtract(payment.Amount)
+		if err != nil {
+			return valueobject.Money{}, err
+		}
+	}
+
+	return available, nil
+}
+
+// findInvoiceToPay returns the oldest closed or overdue invoice of the card, if any
+func (uc *PendingPaymentUseCase) findInvoiceToPay(ctx context.Context, creditCardID uuid.UUID) *uuid.UUID {
+	invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, creditCardID)
+	if err != nil {
+		return nil
+	}
+
+	var oldest *entity.CreditCardInvoice
+	for _, invoice := range invoices {
+		if invoice.Status != entity.InvoiceStatusClosed && invoice.Status != entity.InvoiceStatusOverdue {
+			continue
+		}
+		if oldest == nil || invoice.DueDate.Before(oldest.DueDate) {
+			oldest = invoice
+		}
+	}
+
+	if oldest == nil {
+		return nil
+	}
+	return &oldest.ID
+}
@@ -0,0 +1,439 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+// PeopleExport is the JSON document written by ExportPeopleJSON. It carries
+// each contact with the balance they owe and the shared expenses behind it, so
+// the file can be handed to the group as a settlement ledger.
+type PeopleExport struct {
+	ExportedAt time.Time      `json:"exported_at"`
+	People     []PersonExport `json:"people"`
+}
+
+type PersonExport struct {
+	Name     string            `json:"name"`
+	Email    string            `json:"email,omitempty"`
+	Phone    string            `json:"phone,omitempty"`
+	Balance  float64           `json:"balance"`
+	Currency string            `json:"currency"`
+	Ledger   []SettlementEntry `json:"ledger,omitempty"`
+}
+
+// SettlementEntry is a person's share of one shared expense
+type SettlementEntry struct {
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"`
+	Amount      float64   `json:"amount"`
+	Percentage  float64   `json:"percentage"`
+}
+
+// phoneMatchDigits is how many trailing digits must agree for two phones to be the same number
+const phoneMatchDigits = 8
+
+type ContactImportResult struct {
+	Created int
+	Skipped int // Rows without a name or matching someone already registered
+}
+
+type PeopleExchangeUseCase struct {
+	personRepo      repository.PersonRepository
+	transactionRepo repository.TransactionRepository
+	outputDir       string
+}
+
+func NewPeopleExchangeUseCase(
+	personRepo repository.PersonRepository,
+	transactionRepo repository.TransactionRepository,
+	outputDir string,
+) *PeopleExchangeUseCase {
+	return &PeopleExchangeUseCase{
+		personRepo:      personRepo,
+		transactionRepo: transactionRepo,
+		outputDir:       outputDir,
+	}
+}
+
+// ExportPeopleCSV writes the contacts and their balances as CSV to the export
+// directory and returns the path of the created file
+func (uc *PeopleExchangeUseCase) ExportPeopleCSV(ctx context.Context) (string, error) {
+	people, err := uc.buildExport(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return uc.writeExport("csv", func(w io.Writer) error {
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"name", "email", "phone", "balance", "currency"}); err != nil {
+			return err
+		}
+		for _, person := range people {
+			balance := strconv.FormatFloat(person.Balance, 'f', 2, 64)
+			if err := writer.Write([]string{person.Name, person.Email, person.Phone, balance, person.Currency}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	})
+}
+
+// ExportPeopleJSON writes the contacts with their balances and settlement
+// ledgers as JSON to the export directory and returns the path of the created file
+func (uc *PeopleExchangeUseCase) ExportPeopleJSON(ctx context.Context) (string, error) {
+	people, err := uc.buildExport(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return uc.writeExport("json", func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(PeopleExport{ExportedAt: time.Now(), People: people})
+	})
+}
+
+// ImportPeople registers the contacts found in a CSV, JSON or vCard (.vcf) file.
+// CSV files may be our own export or a phone contacts export; JSON files must be
+// our own export. Balances and ledgers are informational and are not imported,
+// since they are derived from the shared transactions.
+func (uc *PeopleExchangeUseCase) ImportPeople(ctx context.Context, path string) (*ContactImportResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	var contacts []*entity.Person
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		contacts, err = parsePeopleJSON(file)
+	case ".vcf", ".vcard":
+		contacts, err = parseVCards(file)
+	default:
+		contacts, err = parseContactsCSV(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get people: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, person := range existing {
+		for _, key := range contactKeys(person) {
+			known[key] = true
+		}
+	}
+
+	result := &ContactImportResult{}
+	for _, contact := range contacts {
+		keys := contactKeys(contact)
+		if contact.Name == "" || isKnownContact(known, keys) {
+			result.Skipped++
+			continue
+		}
+
+		if err := uc.personRepo.Create(ctx, contact); err != nil {
+			return result, fmt.Errorf("failed to create person: %w", err)
+		}
+		for _, key := range keys {
+			known[key] = true
+		}
+		result.Created++
+	}
+
+	return result, nil
+}
+
+func (uc *PeopleExchangeUseCase) buildExport(ctx context.Context) ([]PersonExport, error) {
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get people: %w", err)
+	}
+
+	exports := make([]PersonExport, 0, len(people))
+	for _, person := range people {
+		transactions, err := uc.transactionRepo.FindSharedWithPerson(ctx, person.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shared transactions: %w", err)
+		}
+
+		export := PersonExport{Name: person.Name, Email: person.Email, Phone: person.Phone, Currency: "BRL"}
+		for _, txn := range transactions {
+			for _, shared := range txn.SharedWith {
+				if shared.PersonID != person.ID {
+					continue
+				}
+				export.Ledger = append(export.Ledger, SettlementEntry{
+					Date:        txn.Date,
+					Description: txn.Description,
+					Amount:      shared.Amount.Amount(),
+					Percentage:  shared.Percentage,
+				})
+				export.Balance += shared.Amount.Amount()
+				export.Currency = shared.Amount.Currency()
+			}
+		}
+
+		sort.Slice(export.Ledger, func(i, j int) bool { return export.Ledger[i].Date.Before(export.Ledger[j].Date) })
+		export.Balance = valueobject.NewMoney(export.Balance, export.Currency).Amount()
+		exports = append(exports, export)
+	}
+
+	sort.Slice(exports, func(i, j int) bool { return strings.ToLower(exports[i].Name) < strings.ToLower(exports[j].Name) })
+	return exports, nil
+}
+
+func (uc *PeopleExchangeUseCase) writeExport(extension string, write func(io.Writer) error) (string, error) {
+	if err := os.MkdirAll(uc.outputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	fileName := fmt.Sprintf("people-%s.%s", time.Now().Format("2006-01-02"), extension)
+	path := filepath.Join(uc.outputDir, fileName)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create people export: %w", err)
+	}
+	defer file.Close()
+
+	if err := write(file); err != nil {
+		return "", fmt.Errorf("failed to write people export: %w", err)
+	}
+
+	return path, nil
+}
+
+func parsePeopleJSON(r io.Reader) ([]*entity.Person, error) {
+	var export PeopleExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("invalid people file: %w", err)
+	}
+
+	people := make([]*entity.Person, 0, len(export.People))
+	for _, person := range export.People {
+		people = append(people, entity.NewPerson(strings.TrimSpace(person.Name), strings.TrimSpace(person.Email), strings.TrimSpace(person.Phone)))
+	}
+	return people, nil
+}
+
+// parseContactsCSV reads contacts from a CSV with a header row. Besides our own
+// "name,email,phone" layout it understands the column names used by phone and
+// webmail contact exports, such as "First Name", "E-mail 1 - Value" and
+// "Phone 1 - Value".
+func parseContactsCSV(r io.Reader) ([]*entity.Person, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid contacts file: %w", err)
+	}
+
+	columns := findContactColumns(header)
+	if columns.name < 0 && columns.firstName < 0 {
+		return nil, fmt.Errorf("contacts file has no name column")
+	}
+
+	var people []*entity.Person
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid contacts file: %w", err)
+		}
+
+		name := csvField(record, columns.name)
+		if name == "" {
+			name = strings.TrimSpace(csvField(record, columns.firstName) + " " + csvField(record, columns.lastName))
+		}
+		people = append(people, entity.NewPerson(name, csvField(record, columns.email), csvField(record, columns.phone)))
+	}
+
+	return people, nil
+}
+
+type contactColumns struct {
+	name, firstName, lastName, email, phone int
+}
+
+func findContactColumns(header []string) contactColumns {
+	columns := contactColumns{name: -1, firstName: -1, lastName: -1, email: -1, phone: -1}
+
+	for i, column := range header {
+		// Spreadsheet exports often start with a byte order mark
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+
+		switch {
+		case column == "name" || column == "full name" || column == "display name":
+			columns.name = firstColumn(columns.name, i)
+		case column == "first name" || column == "given name":
+			columns.firstName = firstColumn(columns.firstName, i)
+		case column == "last name" || column == "family name":
+			columns.lastName = firstColumn(columns.lastName, i)
+		case strings.Contains(column, "mail") && !strings.Contains(column, "type") && !strings.Contains(column, "label"):
+			columns.email = firstColumn(columns.email, i)
+		case (strings.Contains(column, "phone") || strings.Contains(column, "mobile")) && !strings.Contains(column, "type") && !strings.Contains(column, "label"):
+			columns.phone = firstColumn(columns.phone, i)
+		}
+	}
+
+	return columns
+}
+
+func firstColumn(current, index int) int {
+	if current >= 0 {
+		return current
+	}
+	return index
+}
+
+// csvField returns the trimmed value of a column, keeping only the first entry
+// when the exporter joined several values with " ::: "
+func csvField(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	value, _, _ := strings.Cut(record[index], ":::")
+	return strings.TrimSpace(value)
+}
+
+// contactKeys identifies a contact by email and phone, falling back to the name
+// when there's no email. Phones are compared by their last digits so that the
+// same number matches with or without country and area codes.
+func contactKeys(person *entity.Person) []string {
+	var keys []string
+	if person.Email != "" {
+		keys = append(keys, "email:"+strings.ToLower(person.Email))
+	} else if person.Name != "" {
+		keys = append(keys, "name:"+strings.ToLower(person.Name))
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, person.Phone)
+	if len(digits) >= phoneMatchDigits {
+		keys = append(keys, "phone:"+digits[len(digits)-phoneMatchDigits:])
+	}
+
+	return keys
+}
+
+func isKnownContact(known map[string]bool, keys []string) bool {
+	for _, key := range keys {
+		if known[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVCards reads the FN, N, EMAIL and TEL properties of every card in a
+// vCard file, keeping the first email and phone of each card
+func parseVCards(r io.Reader) ([]*entity.Person, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vCard file: %w", err)
+	}
+
+	// Long lines are folded by breaking them and starting the continuation with a space or tab
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\n ", "")
+	text = strings.ReplaceAll(text, "\n\t", "")
+
+	var people []*entity.Person
+	var card *vCard
+	for _, line := range strings.Split(text, "\n") {
+		property, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+
+		// Parameters such as TYPE=CELL and group prefixes such as "item1." don't matter here
+		name, _, _ := strings.Cut(strings.ToUpper(property), ";")
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+
+		switch name {
+		case "BEGIN":
+			card = &vCard{}
+		case "END":
+			if card != nil {
+				people = append(people, card.person())
+			}
+			card = nil
+		case "FN":
+			if card != nil && card.fullName == "" {
+				card.fullName = unescapeVCard(value)
+			}
+		case "N":
+			if card != nil && card.structuredName == "" {
+				card.structuredName = value
+			}
+		case "EMAIL":
+			if card != nil && card.email == "" {
+				card.email = unescapeVCard(value)
+			}
+		case "TEL":
+			if card != nil && card.phone == "" {
+				card.phone = strings.TrimPrefix(unescapeVCard(value), "tel:")
+			}
+		}
+	}
+
+	if len(people) == 0 {
+		return nil, fmt.Errorf("no contacts found in vCard file")
+	}
+	return people, nil
+}
+
+type vCard struct {
+	fullName       string
+	structuredName string // Family;Given;Additional;Prefix;Suffix
+	email          string
+	phone          string
+}
+
+func (c *vCard) person() *entity.Person {
+	name := strings.TrimSpace(c.fullName)
+	if name == "" {
+		parts := strings.Split(c.structuredName, ";")
+		if len(parts) > 1 {
+			name = strings.TrimSpace(unescapeVCard(parts[1]) + " " + unescapeVCard(parts[0]))
+		} else {
+			name = strings.TrimSpace(unescapeVCard(c.structuredName))
+		}
+	}
+
+	return entity.NewPerson(name, strings.TrimSpace(c.email), strings.TrimSpace(c.phone))
+}
+
+func unescapeVCard(value string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\\`, `\`).Replace(value)
+}
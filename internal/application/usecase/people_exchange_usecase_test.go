@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"financli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVCards(t *testing.T) {
+	type contact struct{ name, email, phone string }
+
+	tests := []struct {
+		name string
+		data string
+		want []contact
+	}{
+		{
+			name: "vCard 3.0",
+			data: "BEGIN:VCARD\nVERSION:3.0\nFN:Ana Souza\nN:Souza;Ana;;;\nEMAIL;TYPE=INTERNET:ana@example.com\nTEL;TYPE=CELL:+55 11 98765-4321\nEND:VCARD\n",
+			want: []contact{{"Ana Souza", "ana@example.com", "+55 11 98765-4321"}},
+		},
+		{
+			name: "several cards with CRLF line endings",
+			data: "BEGIN:VCARD\r\nFN:Ana\r\nEND:VCARD\r\nBEGIN:VCARD\r\nFN:Bruno\r\nEND:VCARD\r\n",
+			want: []contact{{"Ana", "", ""}, {"Bruno", "", ""}},
+		},
+		{
+			name: "structured name without FN",
+			data: "BEGIN:VCARD\nN:Lima;Carla;;;\nEND:VCARD\n",
+			want: []contact{{"Carla Lima", "", ""}},
+		},
+		{
+			name: "folded lines",
+			data: "BEGIN:VCARD\nFN:Maria da Con\n ceição\nEMAIL:maria@exa\n\tmple.com\nEND:VCARD\n",
+			want: []contact{{"Maria da Conceição", "maria@example.com", ""}},
+		},
+		{
+			name: "first email and phone kept, groups and parameters ignored",
+			data: "BEGIN:VCARD\nFN:Davi\nitem1.EMAIL;type=pref:davi@work.com\nEMAIL:davi@home.com\nTEL;VALUE=uri:tel:+5511912345678\nTEL:1111\nEND:VCARD\n",
+			want: []contact{{"Davi", "davi@work.com", "+5511912345678"}},
+		},
+		{
+			name: "escaped characters",
+			data: "BEGIN:VCARD\nFN:Souza\\, Ana\\; Jr.\nEND:VCARD\n",
+			want: []contact{{"Souza, Ana; Jr.", "", ""}},
+		},
+		{
+			name: "lowercase property names",
+			data: "begin:vcard\nfn:Elis\nemail:elis@example.com\nend:vcard\n",
+			want: []contact{{"Elis", "elis@example.com", ""}},
+		},
+		{
+			name: "properties outside a card ignored",
+			data: "FN:Nobody\nBEGIN:VCARD\nFN:Fabio\nEND:VCARD\nEMAIL:stray@example.com\n",
+			want: []contact{{"Fabio", "", ""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			people, err := parseVCards(strings.NewReader(tt.data))
+			require.NoError(t, err)
+
+			got := make([]contact, len(people))
+			for i, person := range people {
+				got[i] = contact{person.Name, person.Email, person.Phone}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseVCards_NoContacts(t *testing.T) {
+	for _, data := range []string{"", "not a vcard\n", "BEGIN:VCARD\nFN:Unfinished\n"} {
+		_, err := parseVCards(strings.NewReader(data))
+		assert.EqualError(t, err, "no contacts found in vCard file", "data %q", data)
+	}
+}
+
+func TestContactKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		person *entity.Person
+		want   []string
+	}{
+		{"email over name", entity.NewPerson("Ana", "Ana@Example.com", ""), []string{"email:ana@example.com"}},
+		{"name without email", entity.NewPerson("Ana Souza", "", ""), []string{"name:ana souza"}},
+		{"phone by last digits", entity.NewPerson("Ana", "", "+55 (11) 98765-4321"), []string{"name:ana", "phone:87654321"}},
+		{"short phone ignored", entity.NewPerson("Ana", "", "123"), []string{"name:ana"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, contactKeys(tt.person))
+		})
+	}
+
+	// The same number with and without country and area codes matches
+	local := contactKeys(entity.NewPerson("Ana", "", "98765-4321"))
+	international := contactKeys(entity.NewPerson("Ana Souza", "", "+55 11 98765 4321"))
+	assert.True(t, isKnownContact(map[string]bool{local[len(local)-1]: true}, international))
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// PeriodLockUseCase closes the books of past months, so the transactions in
+// them stay as they were reported
+type PeriodLockUseCase struct {
+	periodRepo repository.AccountingPeriodRepository
+	// snapshots, when set, keeps the report of each month as its books close
+	snapshots *ReportSnapshotUseCase
+	// creditCardRepo and invoiceRepo, when set, keep a month open while a card
+	// invoice with charges in it is, since closing the invoice updates them
+	creditCardRepo repository.CreditCardRepository
+	invoiceRepo    repository.CreditCardInvoiceRepository
+}
+
+func NewPeriodLockUseCase(periodRepo repository.AccountingPeriodRepository) *PeriodLockUseCase {
+	return &PeriodLockUseCase{periodRepo: periodRepo}
+}
+
+// SetReportSnapshots makes closing the books also snapshot the month's report
+func (uc *PeriodLockUseCase) SetReportSnapshots(snapshots *ReportSnapshotUseCase) {
+	uc.snapshots = snapshots
+}
+
+// SetCardInvoices makes closing the books wait for the card invoices of the
+// month to close
+func (uc *PeriodLockUseCase) SetCardInvoices(creditCardRepo repository.CreditCardRepository, invoiceRepo repository.CreditCardInvoiceRepository) {
+	uc.creditCardRepo = creditCardRepo
+	uc.invoiceRepo = invoiceRepo
+}
+
+// ListPeriods returns the months that were ever closed, latest first
+func (uc *PeriodLockUseCase) ListPeriods(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	return uc.periodRepo.FindAll(ctx)
+}
+
+// GetPeriod returns the period of the month date falls in, open when its books
+// were never closed
+func (uc *PeriodLockUseCase) GetPeriod(ctx context.Context, date time.Time) (*entity.AccountingPeriod, error) {
+	period, err := uc.findPeriod(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	if period == nil {
+		return entity.NewAccountingPeriod(date), nil
+	}
+	return period, nil
+}
+
+// CloseMonth locks the transactions of the month date falls in, snapshotting
+// its report. The current month can't be closed while it isn't over.
+func (uc *PeriodLockUseCase) CloseMonth(ctx context.Context, date time.Time) (*entity.AccountingPeriod, error) {
+	now := time.Now()
+	if !entity.PeriodMonth(date).Before(entity.PeriodMonth(now)) {
+		return nil, fmt.Errorf("only past months can be closed")
+	}
+	if err := uc.checkInvoicesClosed(ctx, date); err != nil {
+		return nil, err
+	}
+
+	period, err := uc.findPeriod(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	isNew := period == nil
+	if isNew {
+		period = entity.NewAccountingPeriod(date)
+	}
+
+	if err := period.Close(now); err != nil {
+		return nil, err
+	}
+
+	// Taken again even when the month already has one, since the books are
+	// closed once its late transactions are in
+	if uc.snapshots != nil {
+		if _, err := uc.snapshots.TakeSnapshot(ctx, period.Month, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if isNew {
+		err = uc.periodRepo.Create(ctx, period)
+	} else {
+		err = uc.periodRepo.Update(ctx, period)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save accounting period: %w", err)
+	}
+
+	return period, nil
+}
+
+// checkInvoicesClosed fails when a card invoice opened by the end of the
+// month date falls in is still open: closing it splits its charges, which
+// can't change once the month's books are closed
+func (uc *PeriodLockUseCase) checkInvoicesClosed(ctx context.Context, date time.Time) error {
+	if uc.creditCardRepo == nil || uc.invoiceRepo == nil {
+		return nil
+	}
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credit cards: %w", err)
+	}
+
+	month := entity.PeriodMonth(date)
+	for _, card := range cards {
+		invoices, err := uc.invoiceRepo.FindByStatus(ctx, card.ID, entity.InvoiceStatusOpen)
+		if err != nil {
+			return fmt.Errorf("failed to get the open invoices of %s: %w", card.Name, err)
+		}
+		for _, invoice := range invoices {
+			if !month.Before(entity.PeriodMonth(invoice.OpeningDate)) {
+				return fmt.Errorf("the %s invoice of %s is still open: close it before the books of %s",
+					invoice.ReferenceMonth, card.Name, month.Format("01/2006"))
+			}
+		}
+	}
+	return nil
+}
+
+// ReopenMonth unlocks the transactions of a closed month, logging the reason
+func (uc *PeriodLockUseCase) ReopenMonth(ctx context.Context, date time.Time, reason string) (*entity.AccountingPeriod, error) {
+	period, err := uc.findPeriod(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	if period == nil {
+		return nil, fmt.Errorf("the books of %s are not closed", entity.PeriodMonth(date).Format("01/2006"))
+	}
+
+	if err := period.Reopen(reason, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.periodRepo.Update(ctx, period); err != nil {
+		return nil, fmt.Errorf("failed to save accounting period: %w", err)
+	}
+
+	return period, nil
+}
+
+// CheckOpen fails when any of the dates falls in a month whose books are
+// closed. A nil use case closes no months, for the use cases not given one.
+func (uc *PeriodLockUseCase) CheckOpen(ctx context.Context, dates ...time.Time) error {
+	if uc == nil || len(dates) == 0 {
+		return nil
+	}
+
+	periods, err := uc.periodRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get accounting periods: %w", err)
+	}
+
+	for _, period := range periods {
+		if !period.Closed {
+			continue
+		}
+		for _, date := range dates {
+			if period.Contains(date) {
+				return fmt.Errorf("the books of %s are closed: reopen the month on the Reports screen to change its transactions", period.Month.Format("01/2006"))
+			}
+		}
+	}
+	return nil
+}
+
+// findPeriod returns the stored period of the month date falls in, or nil
+func (uc *PeriodLockUseCase) findPeriod(ctx context.Context, date time.Time) (*entity.AccountingPeriod, error) {
+	periods, err := uc.periodRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounting periods: %w", err)
+	}
+
+	for _, period := range periods {
+		if period.Contains(date) {
+			return period, nil
+		}
+	}
+	return nil, nil
+}
+
+// LockClosedPeriods wraps a transaction repository so no write reaches the
+// transactions of a closed month, whichever use case it comes from. The use
+// cases check first too, before touching balances the write goes with.
+func LockClosedPeriods(repo repository.TransactionRepository, periods *PeriodLockUseCase) repository.TransactionRepository {
+	return &lockedTransactionRepository{TransactionRepository: repo, periods: periods}
+}
+
+type lockedTransactionRepository struct {
+	repository.TransactionRepository
+	periods *PeriodLockUseCase
+}
+
+func (r *lockedTransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	if err := r.periods.CheckOpen(ctx, transaction.Date); err != nil {
+		return err
+	}
+	return r.TransactionRepository.Create(ctx, transaction)
+}
+
+func (r *lockedTransactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	if err := r.periods.CheckOpen(ctx, transactionDates(transactions)...); err != nil {
+		return err
+	}
+	return r.TransactionRepository.CreateMany(ctx, transactions)
+}
+
+// Update checks the month the transaction is stored in as well, so it can't
+// be moved out of a closed one
+func (r *lockedTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	dates := []time.Time{transaction.Date}
+	if stored, err := r.TransactionRepository.FindByID(ctx, transaction.ID); err == nil && stored != nil {
+		dates = append(dates, stored.Date)
+	}
+	if err := r.periods.CheckOpen(ctx, dates...); err != nil {
+		return err
+	}
+	return r.TransactionRepository.Update(ctx, transaction)
+}
+
+func (r *lockedTransactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if stored, err := r.TransactionRepository.FindByID(ctx, id); err == nil && stored != nil {
+		if err := r.periods.CheckOpen(ctx, stored.Date); err != nil {
+			return err
+		}
+	}
+	return r.TransactionRepository.Delete(ctx, id)
+}
+
+func transactionDates(transactions []*entity.Transaction) []time.Time {
+	dates := make([]time.Time, len(transactions))
+	for i, transaction := range transactions {
+		dates[i] = transaction.Date
+	}
+	return dates
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodLockUseCase_CloseMonth_OpenInvoices(t *testing.T) {
+	ctx := context.Background()
+	march := time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		opening   time.Time
+		closed    bool
+		wantErr   string
+		wantSaved bool
+	}{
+		{
+			name:    "open invoice of the month keeps it open",
+			opening: time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: "the 2020-03 invoice of Card is still open: close it before the books of 03/2020",
+		},
+		{
+			name:    "open invoice of an earlier month keeps it open",
+			opening: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: "the 2020-03 invoice of Card is still open: close it before the books of 03/2020",
+		},
+		{
+			name:      "closed invoice of the month lets it close",
+			opening:   time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+			closed:    true,
+			wantSaved: true,
+		},
+		{
+			name:      "open invoice of a later month lets it close",
+			opening:   time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantSaved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card, err := entity.NewCreditCard(uuid.New(), "Card", "1234", valueobject.NewMoney(1000, "BRL"), 10)
+			require.NoError(t, err)
+			invoice, err := entity.NewCreditCardInvoice(card.ID, "2020-03", tt.opening, tt.opening.AddDate(0, 1, -1), tt.opening.AddDate(0, 1, 9), valueobject.NewMoney(0, "BRL"))
+			require.NoError(t, err)
+			if tt.closed {
+				require.NoError(t, invoice.Close())
+			}
+
+			periods := newFakePeriodRepo()
+			uc := NewPeriodLockUseCase(periods)
+			uc.SetCardInvoices(newFakeCreditCardRepo(card), newFakeInvoiceRepo(invoice))
+
+			_, err = uc.CloseMonth(ctx, march)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			saved, err := periods.FindAll(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSaved, len(saved) == 1)
+		})
+	}
+}
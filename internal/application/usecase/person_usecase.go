@@ -55,3 +55,17 @@ func (uc *PersonUseCase) DeletePerson(ctx context.Context, id uuid.UUID) error {
 func (uc *PersonUseCase) FindByEmail(ctx context.Context, email string) (*entity.Person, error) {
 	return uc.personRepo.FindByEmail(ctx, email)
 }
+
+// SetOwedNotice opts a person in or out of the monthly email with what they owe
+func (uc *PersonUseCase) SetOwedNotice(ctx context.Context, id uuid.UUID, enabled bool) error {
+	person, err := uc.personRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := person.SetOwedNotice(enabled); err != nil {
+		return err
+	}
+
+	return uc.personRepo.Update(ctx, person)
+}
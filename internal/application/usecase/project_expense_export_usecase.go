@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ProjectExpenseExportUseCase writes the business expenses of a client's
+// project as a CSV expense report, to be attached to the invoice sent to the client
+type ProjectExpenseExportUseCase struct {
+	reportUseCase *ReportUseCase
+	outputDir     string
+}
+
+func NewProjectExpenseExportUseCase(reportUseCase *ReportUseCase, outputDir string) *ProjectExpenseExportUseCase {
+	return &ProjectExpenseExportUseCase{
+		reportUseCase: reportUseCase,
+		outputDir:     outputDir,
+	}
+}
+
+// ExportProjectExpenses writes the expenses tagged with client and project
+// between startDate and endDate to the export directory, one row per expense
+// and a closing total, and returns the path of the created file
+func (uc *ProjectExpenseExportUseCase) ExportProjectExpenses(ctx context.Context, client, project string, startDate, endDate time.Time) (string, error) {
+	reports, err := uc.reportUseCase.GetProjectReport(ctx, startDate, endDate)
+	if err != nil {
+		return "", err
+	}
+
+	var report *ProjectReport
+	for _, candidate := range reports {
+		if strings.EqualFold(candidate.Client, client) && strings.EqualFold(candidate.Project, project) {
+			report = candidate
+		}
+	}
+	if report == nil {
+		return "", fmt.Errorf("no expenses for client %q and project %q in the period", client, project)
+	}
+
+	if err := os.MkdirAll(uc.outputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	fileName := fmt.Sprintf("expenses-%s-%s-%s.csv", fileNamePart(report.Label()),
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	path := filepath.Join(uc.outputDir, fileName)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create expense report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	rows := [][]string{{"date", "client", "project", "description", "category", "amount", "currency"}}
+	for _, txn := range report.Transactions {
+		rows = append(rows, []string{
+			txn.Date.Format("2006-01-02"), txn.Client, txn.Project, txn.Description, string(txn.Category),
+			strconv.FormatFloat(txn.Amount.Amount(), 'f', 2, 64), txn.Amount.Currency(),
+		})
+	}
+	rows = append(rows, []string{"", "", "", "Total", "",
+		strconv.FormatFloat(report.Total.Amount(), 'f', 2, 64), report.Total.Currency()})
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("failed to write expense report: %w", err)
+	}
+
+	return path, nil
+}
+
+// fileNamePart lowercases label and turns anything but letters and digits into
+// dashes, so it can go into a file name
+func fileNamePart(label string) string {
+	part := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, label)
+	for strings.Contains(part, "--") {
+		part = strings.ReplaceAll(part, "--", "-")
+	}
+	return strings.Trim(part, "-")
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// OCR reads the text of a receipt image
+type OCR interface {
+	Recognize(ctx context.Context, imagePath string) (string, error)
+}
+
+var (
+	// receiptAmountPattern matches amounts such as 1.234,56, 1234.56 or 45,90
+	receiptAmountPattern = regexp.MustCompile(`(\d{1,3}(?:[.\s]\d{3})+|\d+)[,.](\d{2})\b`)
+	// receiptDatePattern matches day-first dates such as 12/03/2026 or 12-03-26
+	receiptDatePattern = regexp.MustCompile(`\b(\d{1,2})[/.-](\d{1,2})[/.-](\d{4}|\d{2})\b`)
+	// receiptISODatePattern matches dates such as 2026-03-12
+	receiptISODatePattern = regexp.MustCompile(`\b(\d{4})-(\d{2})-(\d{2})\b`)
+)
+
+// receiptHeaderWords mark receipt lines that are not the merchant's name
+var receiptHeaderWords = []string{"cupom", "fiscal", "nfc-e", "nf-e", "cnpj", "cpf", "documento", "extrato", "danfe", "comprovante", "via "}
+
+// ReceiptScan is what could be read from a receipt: the fields not found are
+// left zero, for the user to fill in
+type ReceiptScan struct {
+	Amount   float64
+	Date     time.Time
+	Merchant string
+	// Text is everything the OCR read
+	Text string
+}
+
+// ReceiptUseCase turns receipt images into transaction drafts through a
+// pluggable OCR backend
+type ReceiptUseCase struct {
+	ocr OCR
+}
+
+func NewReceiptUseCase() *ReceiptUseCase {
+	return &ReceiptUseCase{}
+}
+
+// SetOCR enables scanning receipts with backend
+func (uc *ReceiptUseCase) SetOCR(backend OCR) {
+	uc.ocr = backend
+}
+
+// ScanReceipt reads the image at imagePath and extracts the total, the date
+// and the merchant of the receipt. Nothing is recorded: the scan pre-fills a
+// transaction the user confirms.
+func (uc *ReceiptUseCase) ScanReceipt(ctx context.Context, imagePath string, now time.Time) (*ReceiptScan, error) {
+	if uc.ocr == nil {
+		return nil, fmt.Errorf("no OCR backend configured (set FINANCLI_OCR_COMMAND or FINANCLI_OCR_URL)")
+	}
+	if _, err := os.Stat(imagePath); err != nil {
+		return nil, fmt.Errorf("failed to read receipt image: %w", err)
+	}
+
+	text, err := uc.ocr.Recognize(ctx, imagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the receipt: %w", err)
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("no text found on the receipt")
+	}
+
+	return parseReceiptText(text, now), nil
+}
+
+func parseReceiptText(text string, now time.Time) *ReceiptScan {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	return &ReceiptScan{
+		Amount:   receiptTotal(lines),
+		Date:     receiptDate(lines, now),
+		Merchant: receiptMerchant(lines),
+		Text:     text,
+	}
+}
+
+// receiptTotal takes the amount of the last line naming the total, which comes
+// after any subtotal, falling back to the largest amount on the receipt
+func receiptTotal(lines []string) float64 {
+	var total, largest float64
+	for _, line := range lines {
+		amounts := receiptAmountPattern.FindAllStringSubmatch(line, -1)
+		if len(amounts) == 0 {
+			continue
+		}
+
+		last := parseReceiptAmount(amounts[len(amounts)-1])
+		lower := strings.ToLower(line)
+		if strings.Contains(lower, "total") && !strings.Contains(lower, "subtotal") && !strings.Contains(lower, "sub-total") {
+			total = last
+		}
+		for _, match := range amounts {
+			if amount := parseReceiptAmount(match); amount > largest {
+				largest = amount
+			}
+		}
+	}
+
+	if total > 0 {
+		return total
+	}
+	return largest
+}
+
+func parseReceiptAmount(match []string) float64 {
+	whole := strings.NewReplacer(".", "", " ", "").Replace(match[1])
+	amount, _ := strconv.ParseFloat(whole+"."+match[2], 64)
+	return amount
+}
+
+// receiptDate takes the first valid date up to now, reading dates day first
+// as Brazilian receipts write them
+func receiptDate(lines []string, now time.Time) time.Time {
+	for _, line := range lines {
+		if match := receiptISODatePattern.FindStringSubmatch(line); match != nil {
+			if date, ok := receiptDateOf(match[1], match[2], match[3], now); ok {
+				return date
+			}
+		}
+		for _, match := range receiptDatePattern.FindAllStringSubmatch(line, -1) {
+			year := match[3]
+			if len(year) == 2 {
+				year = "20" + year
+			}
+			if date, ok := receiptDateOf(year, match[2], match[1], now); ok {
+				return date
+			}
+		}
+	}
+	return time.Time{}
+}
+
+func receiptDateOf(year, month, day string, now time.Time) (time.Time, bool) {
+	y, _ := strconv.Atoi(year)
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	date := time.Date(y, time.Month(m), d, 0, 0, 0, 0, now.Location())
+	// time.Date normalizes impossible dates such as 31/02 into another month
+	if date.Year() != y || date.Month() != time.Month(m) || date.Day() != d {
+		return time.Time{}, false
+	}
+	if date.After(now) {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// receiptMerchant takes the first line that reads like a name: receipts open
+// with the store's name, before the tax document headers and the items
+func receiptMerchant(lines []string) string {
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		lower := strings.ToLower(line)
+
+		letters, digits := 0, 0
+		for _, r := range line {
+			switch {
+			case unicode.IsLetter(r):
+				letters++
+			case unicode.IsDigit(r):
+				digits++
+			}
+		}
+		if letters < 3 || digits > letters {
+			continue
+		}
+
+		header := false
+		for _, word := range receiptHeaderWords {
+			if strings.Contains(lower, word) {
+				header = true
+				break
+			}
+		}
+		if !header {
+			return line
+		}
+	}
+	return ""
+}
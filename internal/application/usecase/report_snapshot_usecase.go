@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+// maxSnapshotCatchUp bounds how many ended months one run snapshots, so the
+// first run after a long break doesn't compute years of reports
+const maxSnapshotCatchUp = 12
+
+// ReportSnapshotUseCase keeps the monthly reports as they were when each month
+// ended, so they can be compared with what the transactions add up to now
+type ReportSnapshotUseCase struct {
+	snapshotRepo  repository.ReportSnapshotRepository
+	reportUseCase *ReportUseCase
+}
+
+func NewReportSnapshotUseCase(snapshotRepo repository.ReportSnapshotRepository, reportUseCase *ReportUseCase) *ReportSnapshotUseCase {
+	return &ReportSnapshotUseCase{
+		snapshotRepo:  snapshotRepo,
+		reportUseCase: reportUseCase,
+	}
+}
+
+// GetSnapshot returns the snapshot of the month date falls in, or nil when it
+// was never taken
+func (uc *ReportSnapshotUseCase) GetSnapshot(ctx context.Context, date time.Time) (*entity.ReportSnapshot, error) {
+	snapshots, err := uc.snapshotRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report snapshots: %w", err)
+	}
+
+	month := entity.PeriodMonth(date)
+	for _, snapshot := range snapshots {
+		if snapshot.Month.Equal(month) {
+			return snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+// TakeSnapshot computes the report of the month date falls in and keeps it,
+// replacing any earlier snapshot of that month
+func (uc *ReportSnapshotUseCase) TakeSnapshot(ctx context.Context, date time.Time, now time.Time) (*entity.ReportSnapshot, error) {
+	month := entity.PeriodMonth(date)
+	report, err := uc.reportUseCase.GetPeriodReport(ctx, month, month.AddDate(0, 1, 0).Add(-time.Nanosecond), month.Format("January 2006"))
+	if err != nil {
+		return nil, err
+	}
+	income, expenses, count, categories := reportFigures(report)
+
+	snapshot, err := uc.GetSnapshot(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		snapshot = entity.NewReportSnapshot(month, income, expenses, count, categories, now)
+		err = uc.snapshotRepo.Create(ctx, snapshot)
+	} else {
+		snapshot.Retake(income, expenses, count, categories, now)
+		err = uc.snapshotRepo.Update(ctx, snapshot)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save report snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// SnapshotEndedMonths snapshots the months that ended since the latest
+// snapshot, or just the last month on the first run. Months already
+// snapshotted are left as they were. It returns how many were taken.
+func (uc *ReportSnapshotUseCase) SnapshotEndedMonths(ctx context.Context, now time.Time) (int, error) {
+	snapshots, err := uc.snapshotRepo.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get report snapshots: %w", err)
+	}
+
+	current := entity.PeriodMonth(now)
+	month := current.AddDate(0, -1, 0)
+	if len(snapshots) > 0 {
+		month = snapshots[0].Month.AddDate(0, 1, 0)
+	}
+	if earliest := current.AddDate(0, -maxSnapshotCatchUp, 0); month.Before(earliest) {
+		month = earliest
+	}
+
+	taken := 0
+	for ; month.Before(current); month = month.AddDate(0, 1, 0) {
+		if _, err := uc.TakeSnapshot(ctx, month, now); err != nil {
+			return taken, err
+		}
+		taken++
+	}
+	return taken, nil
+}
+
+// SnapshotReport lays a snapshot out like the period reports, so it can be
+// shown in their place
+func SnapshotReport(snapshot *entity.ReportSnapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"period":            snapshot.Month.Format("January 2006"),
+		"totalIncome":       snapshot.Income,
+		"totalExpenses":     snapshot.Expenses,
+		"netSavings":        snapshot.Net(),
+		"categoryBreakdown": snapshot.Categories,
+		"transactionCount":  snapshot.TransactionCount,
+	}
+}
+
+// SnapshotMatchesReport tells whether a period report recomputed now still
+// agrees with the snapshot of its month
+func SnapshotMatchesReport(snapshot *entity.ReportSnapshot, report map[string]interface{}) bool {
+	income, expenses, count, categories := reportFigures(report)
+	return snapshot.Matches(income, expenses, count, categories)
+}
+
+func reportFigures(report map[string]interface{}) (valueobject.Money, valueobject.Money, int, map[entity.TransactionCategory]valueobject.Money) {
+	income, _ := report["totalIncome"].(valueobject.Money)
+	expenses, _ := report["totalExpenses"].(valueobject.Money)
+	count, _ := report["transactionCount"].(int)
+	categories, _ := report["categoryBreakdown"].(map[entity.TransactionCategory]valueobject.Money)
+	return income, expenses, count, categories
+}
@@ -0,0 +1,377 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// reportTemplateExt is the extension of the files in the templates directory
+const reportTemplateExt = ".tmpl"
+
+// ReportTemplateData is what a report template renders. Its fields are the
+// documented data model templates rely on, so renaming one breaks users'
+// templates: add fields rather than change them.
+type ReportTemplateData struct {
+	GeneratedAt time.Time            `json:"generated_at"`
+	Period      TemplatePeriod       `json:"period"`
+	Totals      TemplateTotals       `json:"totals"`
+	Accounts    []TemplateAccount    `json:"accounts"`
+	CreditCards []TemplateCreditCard `json:"credit_cards"`
+	// Transactions of the period, oldest first
+	Transactions []TemplateTransaction `json:"transactions"`
+	// Categories with transactions in the period, largest expense first
+	Categories []TemplateCategory `json:"categories"`
+	// People who shared expenses in the period, largest amount owed first
+	People []TemplatePerson `json:"people"`
+}
+
+type TemplatePeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Label string    `json:"label"`
+}
+
+type TemplateTotals struct {
+	Income   float64 `json:"income"`
+	Expenses float64 `json:"expenses"`
+	Net      float64 `json:"net"`
+	Count    int     `json:"count"`
+}
+
+type TemplateAccount struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Balance  float64 `json:"balance"`
+	Currency string  `json:"currency"`
+}
+
+type TemplateCreditCard struct {
+	Name           string  `json:"name"`
+	LastFourDigits string  `json:"last_four_digits"`
+	Balance        float64 `json:"balance"`
+	Limit          float64 `json:"limit"`
+	Available      float64 `json:"available"`
+	Currency       string  `json:"currency"`
+}
+
+type TemplateTransaction struct {
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	Type        string    `json:"type"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	// Name of the account or credit card the transaction belongs to
+	Source           string   `json:"source"`
+	City             string   `json:"city"`
+	Venue            string   `json:"venue"`
+	Client           string   `json:"client"`
+	Project          string   `json:"project"`
+	PaymentMethod    string   `json:"payment_method"`
+	Tags             []string `json:"tags"`
+	Shared           bool     `json:"shared"`
+	PersonalAmount   float64  `json:"personal_amount"`
+	IgnoreFromBudget bool     `json:"ignore_from_budget"`
+}
+
+type TemplateCategory struct {
+	Name     string  `json:"name"`
+	Income   float64 `json:"income"`
+	Expenses float64 `json:"expenses"`
+	Count    int     `json:"count"`
+}
+
+type TemplatePerson struct {
+	Name string  `json:"name"`
+	Owed float64 `json:"owed"`
+}
+
+// ReportTemplateUseCase renders user-written text/template files over the
+// ReportTemplateData of a period
+type ReportTemplateUseCase struct {
+	accountRepo     repository.AccountRepository
+	creditCardRepo  repository.CreditCardRepository
+	personRepo      repository.PersonRepository
+	transactionRepo repository.TransactionRepository
+	templatesDir    string
+	// archiveRepo, when set, adds the archived transactions to the period's
+	archiveRepo repository.TransactionArchiveRepository
+}
+
+func NewReportTemplateUseCase(
+	accountRepo repository.AccountRepository,
+	creditCardRepo repository.CreditCardRepository,
+	personRepo repository.PersonRepository,
+	transactionRepo repository.TransactionRepository,
+	templatesDir string,
+) *ReportTemplateUseCase {
+	return &ReportTemplateUseCase{
+		accountRepo:     accountRepo,
+		creditCardRepo:  creditCardRepo,
+		personRepo:      personRepo,
+		transactionRepo: transactionRepo,
+		templatesDir:    templatesDir,
+	}
+}
+
+// SetIncludeArchive makes templates also see the transactions moved to the archive
+func (uc *ReportTemplateUseCase) SetIncludeArchive(archiveRepo repository.TransactionArchiveRepository) {
+	uc.archiveRepo = archiveRepo
+}
+
+// ListTemplates returns the names of the templates in the templates directory,
+// which is fine not to exist yet
+func (uc *ReportTemplateUseCase) ListTemplates() ([]string, error) {
+	files, err := os.ReadDir(uc.templatesDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read templates directory: %w", err)
+	}
+
+	var names []string
+	for _, file := range files {
+		if !file.IsDir() && filepath.Ext(file.Name()) == reportTemplateExt {
+			names = append(names, strings.TrimSuffix(file.Name(), reportTemplateExt))
+		}
+	}
+	return names, nil
+}
+
+// RenderTemplate renders the named template over the transactions between
+// start and end. name is either a template in the templates directory or the
+// path of a template file.
+func (uc *ReportTemplateUseCase) RenderTemplate(ctx context.Context, name string, start, end, now time.Time) (string, error) {
+	path := name
+	if _, err := os.Stat(path); err != nil {
+		path = filepath.Join(uc.templatesDir, name+reportTemplateExt)
+	}
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("template %q not found in %s", name, uc.templatesDir)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(reportTemplateFuncs).Option("missingkey=error").Parse(string(source))
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	data, err := uc.BuildTemplateData(ctx, start, end, now)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// BuildTemplateData gathers the data model for the period between start and end
+func (uc *ReportTemplateUseCase) BuildTemplateData(ctx context.Context, start, end, now time.Time) (*ReportTemplateData, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load accounts: %w", err)
+	}
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credit cards: %w", err)
+	}
+	people, err := uc.personRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load people: %w", err)
+	}
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+	if uc.archiveRepo != nil {
+		archived, err := uc.archiveRepo.FindByDateRange(ctx, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load archived transactions: %w", err)
+		}
+		transactions = append(transactions, archived...)
+	}
+
+	data := &ReportTemplateData{
+		GeneratedAt: now,
+		Period:      TemplatePeriod{Start: start, End: end, Label: templatePeriodLabel(start, end)},
+		// Empty rather than nil, so that JSON output has [] for an empty list
+		Accounts:     []TemplateAccount{},
+		CreditCards:  []TemplateCreditCard{},
+		Transactions: []TemplateTransaction{},
+		Categories:   []TemplateCategory{},
+		People:       []TemplatePerson{},
+	}
+
+	sources := make(map[uuid.UUID]string)
+	for _, account := range accounts {
+		sources[account.ID] = account.Name
+		data.Accounts = append(data.Accounts, TemplateAccount{
+			Name:     account.Name,
+			Type:     string(account.Type),
+			Balance:  account.Balance.Amount(),
+			Currency: account.Balance.Currency(),
+		})
+	}
+	for _, card := range cards {
+		sources[card.ID] = card.Name
+		data.CreditCards = append(data.CreditCards, TemplateCreditCard{
+			Name:           card.Name,
+			LastFourDigits: card.LastFourDigits,
+			Balance:        card.CurrentBalance.Amount(),
+			Limit:          card.CreditLimit.Amount(),
+			Available:      card.CreditLimit.Amount() - card.CurrentBalance.Amount(),
+			Currency:       card.CurrentBalance.Currency(),
+		})
+	}
+	personNames := make(map[uuid.UUID]string)
+	for _, person := range people {
+		personNames[person.ID] = person.Name
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Date.Before(transactions[j].Date)
+	})
+
+	categories := make(map[entity.TransactionCategory]*TemplateCategory)
+	owed := make(map[string]float64)
+	for _, txn := range transactions {
+		source := ""
+		if txn.AccountID != nil {
+			source = sources[*txn.AccountID]
+		} else if txn.CreditCardID != nil {
+			source = sources[*txn.CreditCardID]
+		}
+
+		amount := txn.Amount.Amount()
+		data.Transactions = append(data.Transactions, TemplateTransaction{
+			Date:             txn.Date,
+			Description:      txn.Description,
+			Category:         string(txn.Category),
+			Type:             string(txn.Type),
+			Amount:           amount,
+			Currency:         txn.Amount.Currency(),
+			Source:           source,
+			City:             txn.City,
+			Venue:            txn.Venue,
+			Client:           txn.Client,
+			Project:          txn.Project,
+			PaymentMethod:    string(txn.PaymentMethod),
+			Tags:             txn.Tags,
+			Shared:           len(txn.SharedWith) > 0,
+			PersonalAmount:   txn.GetPersonalAmount().Amount(),
+			IgnoreFromBudget: txn.IgnoreFromBudget,
+		})
+
+		category, ok := categories[txn.Category]
+		if !ok {
+			category = &TemplateCategory{Name: string(txn.Category)}
+			categories[txn.Category] = category
+		}
+		category.Count++
+		data.Totals.Count++
+		if txn.Type == entity.TransactionTypeCredit {
+			category.Income += amount
+			data.Totals.Income += amount
+		} else {
+			category.Expenses += amount
+			data.Totals.Expenses += amount
+		}
+
+		for _, shared := range txn.SharedWith {
+			if name, ok := personNames[shared.PersonID]; ok {
+				owed[name] += shared.Amount.Amount()
+			}
+		}
+	}
+	data.Totals.Net = data.Totals.Income - data.Totals.Expenses
+
+	for _, category := range categories {
+		data.Categories = append(data.Categories, *category)
+	}
+	sort.Slice(data.Categories, func(i, j int) bool {
+		if data.Categories[i].Expenses != data.Categories[j].Expenses {
+			return data.Categories[i].Expenses > data.Categories[j].Expenses
+		}
+		return data.Categories[i].Name < data.Categories[j].Name
+	})
+
+	for name, amount := range owed {
+		data.People = append(data.People, TemplatePerson{Name: name, Owed: amount})
+	}
+	sort.Slice(data.People, func(i, j int) bool {
+		if data.People[i].Owed != data.People[j].Owed {
+			return data.People[i].Owed > data.People[j].Owed
+		}
+		return data.People[i].Name < data.People[j].Name
+	})
+
+	return data, nil
+}
+
+// templatePeriodLabel names whole months as "March 2024" and other periods by
+// their dates
+func templatePeriodLabel(start, end time.Time) string {
+	if start.Day() == 1 && end.Equal(start.AddDate(0, 1, 0).Add(-time.Nanosecond)) {
+		return fmt.Sprintf("%s %d", start.Month(), start.Year())
+	}
+	return fmt.Sprintf("%s - %s", start.Format("2006-01-02"), end.Format("2006-01-02"))
+}
+
+// reportTemplateFuncs are the helpers templates can call besides the text/template builtins
+var reportTemplateFuncs = template.FuncMap{
+	"money": func(amount float64) string {
+		return fmt.Sprintf("R$ %.2f", amount)
+	},
+	"date": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+	"pct": func(part, whole float64) string {
+		if whole == 0 {
+			return "0%"
+		}
+		return fmt.Sprintf("%.0f%%", part/whole*100)
+	},
+	"pad": func(width int, s string) string {
+		return fmt.Sprintf("%-*s", width, s)
+	},
+	"padLeft": func(width int, s string) string {
+		return fmt.Sprintf("%*s", width, s)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"title": func(s string) string {
+		if s == "" {
+			return s
+		}
+		return strings.ToUpper(s[:1]) + s[1:]
+	},
+	"repeat": func(count int, s string) string {
+		if count < 0 {
+			count = 0
+		}
+		return strings.Repeat(s, count)
+	},
+	"add": func(a, b float64) float64 { return a + b },
+	"sub": func(a, b float64) float64 { return a - b },
+	"mul": func(a, b float64) float64 { return a * b },
+	"div": func(a, b float64) float64 {
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	},
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"financli/internal/domain/entity"
@@ -15,6 +17,15 @@ type ReportUseCase struct {
 	transactionRepo repository.TransactionRepository
 	personRepo      repository.PersonRepository
 	billRepo        repository.BillRepository
+
+	// excludeIgnored drops transactions flagged as ignored from budget out of monthly reports
+	excludeIgnored bool
+	// archiveRepo, when set, adds the archived transactions to the reports
+	archiveRepo repository.TransactionArchiveRepository
+
+	// changes and cache are set together; without a tracker reports are always recomputed
+	changes *ChangeTracker
+	cache   *reportCache
 }
 
 type SharedExpenseReport struct {
@@ -33,6 +44,69 @@ type BillReport struct {
 	Participants     []string
 }
 
+// BillCoverageReport reconciles a bill's total with the transactions linked to it
+type BillCoverageReport struct {
+	Bill *entity.Bill
+	// Linked transactions, oldest first
+	Linked []*entity.Transaction
+	// Covered is what the linked expenses add up to, refunds taken off
+	Covered valueobject.Money
+	// Gap is what the linked transactions miss to reach the bill's total,
+	// negative when they go over it
+	Gap valueobject.Money
+	// Candidates are the expenses of the bill's period linked to no bill, oldest first
+	Candidates      []*entity.Transaction
+	CandidatesTotal valueobject.Money
+}
+
+// LocationReport totals the spending in one city, broken down by venue
+type LocationReport struct {
+	City             string
+	Total            valueobject.Money
+	TransactionCount int
+	FirstDate        time.Time
+	LastDate         time.Time
+	Venues           []*VenueSpend
+}
+
+type VenueSpend struct {
+	Venue            string
+	Total            valueobject.Money
+	TransactionCount int
+}
+
+// ProjectReport totals the business expenses billed to one client and project
+type ProjectReport struct {
+	Client  string
+	Project string
+	Total   valueobject.Money
+	// Transactions are the project's expenses, oldest first
+	Transactions []*entity.Transaction
+}
+
+// TagReport totals the transactions with one tag. A transaction with several
+// tags counts in each of them.
+type TagReport struct {
+	Tag              string
+	Income           valueobject.Money
+	Expenses         valueobject.Money
+	TransactionCount int
+	// AllTimeExpenses is what the tag's expenses add up to outside the period too
+	AllTimeExpenses valueobject.Money
+}
+
+// Label names the project as "Client / Project", omitting missing parts
+func (r *ProjectReport) Label() string {
+	switch {
+	case r.Client != "" && r.Project != "":
+		return r.Client + " / " + r.Project
+	case r.Client != "":
+		return r.Client
+	default:
+		return r.Project
+	}
+}
+
 func NewReportUseCase(
 	transactionRepo repository.TransactionRepository,
 	personRepo repository.PersonRepository,
@@ -45,6 +119,61 @@ func NewReportUseCase(
 	}
 }
 
+// SetExcludeIgnored controls whether monthly reports honor the ignore-from-budget flag
+func (uc *ReportUseCase) SetExcludeIgnored(exclude bool) {
+	uc.excludeIgnored = exclude
+}
+
+// SetIncludeArchive makes reports also read the transactions moved to the archive
+func (uc *ReportUseCase) SetIncludeArchive(archiveRepo repository.TransactionArchiveRepository) {
+	uc.archiveRepo = archiveRepo
+}
+
+// SetChangeTracker enables caching of period reports, invalidated whenever the
+// tracker sees a transaction change
+func (uc *ReportUseCase) SetChangeTracker(tracker *ChangeTracker) {
+	uc.changes = tracker
+	uc.cache = newReportCache()
+}
+
+// cached returns the report stored under key if no transaction changed since it
+// was computed, otherwise computes and stores it
+func (uc *ReportUseCase) cached(key string, compute func() (interface{}, error)) (interface{}, error) {
+	if uc.changes == nil {
+		return compute()
+	}
+
+	version := uc.changes.LastChange()
+	if value, ok := uc.cache.get(key, version); ok {
+		return value, nil
+	}
+
+	value, err := compute()
+	if err != nil {
+		return nil, err
+	}
+	uc.cache.put(key, version, value)
+	return value, nil
+}
+
+// findByDateRange returns the period's transactions, archived ones included
+// when the archive is on
+func (uc *ReportUseCase) findByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	if uc.archiveRepo == nil {
+		return transactions, nil
+	}
+
+	archived, err := uc.archiveRepo.FindByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived transactions: %w", err)
+	}
+	return append(transactions, archived...), nil
+}
+
 func (uc *ReportUseCase) GetSharedExpenseReport(ctx context.Context, personID uuid.UUID, startDate, endDate time.Time) (*SharedExpenseReport, error) {
 	person, err := uc.personRepo.FindByID(ctx, personID)
 	if err != nil {
@@ -55,6 +184,13 @@ func (uc *ReportUseCase) GetSharedExpenseReport(ctx context.Context, personID uu
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shared transactions: %w", err)
 	}
+	if uc.archiveRepo != nil {
+		archived, err := uc.archiveRepo.FindSharedWithPerson(ctx, personID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get archived shared transactions: %w", err)
+		}
+		transactions = append(transactions, archived...)
+	}
 
 	var filteredTransactions []*entity.Transaction
 	totalOwed := valueobject.NewMoney(0, "BRL")
@@ -143,20 +279,107 @@ func (uc *ReportUseCase) GetBillReport(ctx context.Context, billID uuid.UUID) (*
 	}, nil
 }
 
+// GetBillCoverageReport shows whether the transactions linked to a bill make up
+// its total, along with the unlinked expenses of its period that may be missing
+func (uc *ReportUseCase) GetBillCoverageReport(ctx context.Context, billID uuid.UUID) (*BillCoverageReport, error) {
+	bill, err := uc.billRepo.FindByID(ctx, billID)
+	if err != nil {
+		return nil, fmt.Errorf("bill not found: %w", err)
+	}
+
+	linked, err := uc.transactionRepo.FindByBillID(ctx, billID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bill transactions: %w", err)
+	}
+	unassigned, err := uc.transactionRepo.FindUnassignedToBill(ctx, bill.StartDate, bill.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unlinked transactions: %w", err)
+	}
+
+	var covered float64
+	for _, txn := range linked {
+		if txn.Type == entity.TransactionTypeCredit {
+			covered -= txn.Amount.Amount()
+		} else {
+			covered += txn.Amount.Amount()
+		}
+	}
+
+	var candidates []*entity.Transaction
+	var candidatesTotal float64
+	for _, txn := range unassigned {
+		if txn.Type != entity.TransactionTypeDebit || txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+		candidates = append(candidates, txn)
+		candidatesTotal += txn.Amount.Amount()
+	}
+
+	byDate := func(transactions []*entity.Transaction) {
+		sort.SliceStable(transactions, func(i, j int) bool {
+			return transactions[i].Date.Before(transactions[j].Date)
+		})
+	}
+	byDate(linked)
+	byDate(candidates)
+
+	currency := bill.TotalAmount.Currency()
+	return &BillCoverageReport{
+		Bill:            bill,
+		Linked:          linked,
+		Covered:         valueobject.NewMoney(covered, currency),
+		Gap:             valueobject.NewMoney(bill.TotalAmount.Amount()-covered, currency),
+		Candidates:      candidates,
+		CandidatesTotal: valueobject.NewMoney(candidatesTotal, currency),
+	}, nil
+}
+
 func (uc *ReportUseCase) GetMonthlyReport(ctx context.Context, year int, month time.Month) (map[string]interface{}, error) {
+	key := fmt.Sprintf("monthly:%04d-%02d", year, month)
+	report, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeMonthlyReport(ctx, year, month)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report.(map[string]interface{}), nil
+}
+
+func (uc *ReportUseCase) computeMonthlyReport(ctx context.Context, year int, month time.Month) (map[string]interface{}, error) {
 	startDate := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0)
+	return uc.computePeriodReport(ctx, startDate, startDate.AddDate(0, 1, 0), fmt.Sprintf("%s %d", month.String(), year))
+}
 
-	transactions, err := uc.transactionRepo.FindByDateRange(ctx, startDate, endDate)
+// GetPeriodReport is the monthly report's totals and category breakdown for
+// any period between startDate and endDate, labeled period
+func (uc *ReportUseCase) GetPeriodReport(ctx context.Context, startDate, endDate time.Time, period string) (map[string]interface{}, error) {
+	key := fmt.Sprintf("period:%d-%d:%s", startDate.Unix(), endDate.Unix(), period)
+	report, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computePeriodReport(ctx, startDate, endDate, period)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transactions: %w", err)
+		return nil, err
+	}
+	return report.(map[string]interface{}), nil
+}
+
+func (uc *ReportUseCase) computePeriodReport(ctx context.Context, startDate, endDate time.Time, period string) (map[string]interface{}, error) {
+	transactions, err := uc.findByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
 	}
 
 	totalIncome := valueobject.NewMoney(0, "BRL")
 	totalExpenses := valueobject.NewMoney(0, "BRL")
 	categoryBreakdown := make(map[entity.TransactionCategory]valueobject.Money)
+	transactionCount := 0
 
 	for _, txn := range transactions {
+		if uc.excludeIgnored && txn.IgnoreFromBudget {
+			continue
+		}
+		transactionCount++
+
 		if txn.Type == entity.TransactionTypeCredit {
 			income, err := totalIncome.Add(txn.Amount)
 			if err == nil {
@@ -180,11 +403,242 @@ func (uc *ReportUseCase) GetMonthlyReport(ctx context.Context, year int, month t
 	}
 
 	return map[string]interface{}{
-		"period":            fmt.Sprintf("%s %d", month.String(), year),
+		"period":            period,
 		"totalIncome":       totalIncome,
 		"totalExpenses":     totalExpenses,
 		"netSavings":        func() valueobject.Money { net, _ := totalIncome.Subtract(totalExpenses); return net }(),
 		"categoryBreakdown": categoryBreakdown,
-		"transactionCount":  len(transactions),
+		"transactionCount":  transactionCount,
 	}, nil
 }
+
+// GetLocationReport groups the expenses between startDate and endDate by city,
+// largest total first. Transactions without a city are left out, so the report
+// reflects only what was tagged while traveling or out and about.
+func (uc *ReportUseCase) GetLocationReport(ctx context.Context, startDate, endDate time.Time) ([]*LocationReport, error) {
+	key := fmt.Sprintf("location:%d-%d", startDate.Unix(), endDate.Unix())
+	reports, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeLocationReport(ctx, startDate, endDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reports.([]*LocationReport), nil
+}
+
+func (uc *ReportUseCase) computeLocationReport(ctx context.Context, startDate, endDate time.Time) ([]*LocationReport, error) {
+	transactions, err := uc.findByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	byCity := make(map[string]*LocationReport)
+	venues := make(map[string]map[string]*VenueSpend)
+
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.City == "" {
+			continue
+		}
+		if uc.excludeIgnored && txn.IgnoreFromBudget {
+			continue
+		}
+
+		// Group case-insensitively so "Lisboa" and "lisboa" land together
+		cityKey := strings.ToLower(txn.City)
+		report, exists := byCity[cityKey]
+		if !exists {
+			report = &LocationReport{
+				City:      txn.City,
+				Total:     valueobject.NewMoney(0, txn.Amount.Currency()),
+				FirstDate: txn.Date,
+				LastDate:  txn.Date,
+			}
+			byCity[cityKey] = report
+			venues[cityKey] = make(map[string]*VenueSpend)
+		}
+
+		if total, err := report.Total.Add(txn.Amount); err == nil {
+			report.Total = total
+		}
+		report.TransactionCount++
+		if txn.Date.Before(report.FirstDate) {
+			report.FirstDate = txn.Date
+		}
+		if txn.Date.After(report.LastDate) {
+			report.LastDate = txn.Date
+		}
+
+		venueName := txn.Venue
+		if venueName == "" {
+			venueName = "Other"
+		}
+		venue, exists := venues[cityKey][strings.ToLower(venueName)]
+		if !exists {
+			venue = &VenueSpend{Venue: venueName, Total: valueobject.NewMoney(0, txn.Amount.Currency())}
+			venues[cityKey][strings.ToLower(venueName)] = venue
+			report.Venues = append(report.Venues, venue)
+		}
+		if total, err := venue.Total.Add(txn.Amount); err == nil {
+			venue.Total = total
+		}
+		venue.TransactionCount++
+	}
+
+	reports := make([]*LocationReport, 0, len(byCity))
+	for _, report := range byCity {
+		sort.Slice(report.Venues, func(i, j int) bool {
+			return report.Venues[i].Total.Amount() > report.Venues[j].Total.Amount()
+		})
+		reports = append(reports, report)
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Total.Amount() != reports[j].Total.Amount() {
+			return reports[i].Total.Amount() > reports[j].Total.Amount()
+		}
+		return reports[i].City < reports[j].City
+	})
+
+	return reports, nil
+}
+
+// GetProjectReport groups the period's business expenses by client and
+// project, largest first. Only expenses tagged with a client or project count.
+func (uc *ReportUseCase) GetProjectReport(ctx context.Context, startDate, endDate time.Time) ([]*ProjectReport, error) {
+	key := fmt.Sprintf("project:%d-%d", startDate.Unix(), endDate.Unix())
+	reports, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeProjectReport(ctx, startDate, endDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reports.([]*ProjectReport), nil
+}
+
+func (uc *ReportUseCase) computeProjectReport(ctx context.Context, startDate, endDate time.Time) ([]*ProjectReport, error) {
+	transactions, err := uc.findByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	byProject := make(map[string]*ProjectReport)
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit || txn.Category == entity.TransactionCategoryTransfer || !txn.IsBusiness() {
+			continue
+		}
+
+		// Group case-insensitively so "Acme" and "acme" land together
+		key := strings.ToLower(txn.Client) + "\x00" + strings.ToLower(txn.Project)
+		report, exists := byProject[key]
+		if !exists {
+			report = &ProjectReport{
+				Client:  txn.Client,
+				Project: txn.Project,
+				Total:   valueobject.NewMoney(0, txn.Amount.Currency()),
+			}
+			byProject[key] = report
+		}
+
+		if total, err := report.Total.Add(txn.Amount); err == nil {
+			report.Total = total
+		}
+		report.Transactions = append(report.Transactions, txn)
+	}
+
+	reports := make([]*ProjectReport, 0, len(byProject))
+	for _, report := range byProject {
+		sort.SliceStable(report.Transactions, func(i, j int) bool {
+			return report.Transactions[i].Date.Before(report.Transactions[j].Date)
+		})
+		reports = append(reports, report)
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Total.Amount() != reports[j].Total.Amount() {
+			return reports[i].Total.Amount() > reports[j].Total.Amount()
+		}
+		if reports[i].Client != reports[j].Client {
+			return reports[i].Client < reports[j].Client
+		}
+		return reports[i].Project < reports[j].Project
+	})
+
+	return reports, nil
+}
+
+// GetTagReport totals the period's transactions by tag, largest expenses
+// first. Transfers between the user's own accounts are left out.
+func (uc *ReportUseCase) GetTagReport(ctx context.Context, startDate, endDate time.Time) ([]*TagReport, error) {
+	key := fmt.Sprintf("tag:%d-%d", startDate.Unix(), endDate.Unix())
+	reports, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeTagReport(ctx, startDate, endDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reports.([]*TagReport), nil
+}
+
+func (uc *ReportUseCase) computeTagReport(ctx context.Context, startDate, endDate time.Time) ([]*TagReport, error) {
+	transactions, err := uc.findByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	byTag := make(map[string]*TagReport)
+	for _, txn := range transactions {
+		if txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+		for _, tag := range txn.Tags {
+			report, exists := byTag[tag]
+			if !exists {
+				report = &TagReport{
+					Tag:      tag,
+					Income:   valueobject.NewMoney(0, txn.Amount.Currency()),
+					Expenses: valueobject.NewMoney(0, txn.Amount.Currency()),
+				}
+				byTag[tag] = report
+			}
+			report.TransactionCount++
+			addTransactionAmount(txn, &report.Income, &report.Expenses)
+		}
+	}
+
+	reports := make([]*TagReport, 0, len(byTag))
+	for tag, report := range byTag {
+		tagged, err := uc.transactionRepo.FindByTag(ctx, tag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transactions tagged %s: %w", tag, err)
+		}
+		allTimeIncome := valueobject.NewMoney(0, report.Expenses.Currency())
+		report.AllTimeExpenses = valueobject.NewMoney(0, report.Expenses.Currency())
+		for _, txn := range tagged {
+			if txn.Category != entity.TransactionCategoryTransfer {
+				addTransactionAmount(txn, &allTimeIncome, &report.AllTimeExpenses)
+			}
+		}
+		reports = append(reports, report)
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Expenses.Amount() != reports[j].Expenses.Amount() {
+			return reports[i].Expenses.Amount() > reports[j].Expenses.Amount()
+		}
+		return reports[i].Tag < reports[j].Tag
+	})
+
+	return reports, nil
+}
+
+// addTransactionAmount adds the transaction's amount to income or expenses,
+// depending on its type
+func addTransactionAmount(txn *entity.Transaction, income, expenses *valueobject.Money) {
+	total := expenses
+	if txn.Type == entity.TransactionTypeCredit {
+		total = income
+	}
+	if sum, err := total.Add(txn.Amount); err == nil {
+		*total = sum
+	}
+}
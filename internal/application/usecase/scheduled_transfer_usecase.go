@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type ScheduledTransferUseCase struct {
+	transferRepo    repository.ScheduledTransferRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	periods         *PeriodLockUseCase
+}
+
+func NewScheduledTransferUseCase(
+	transferRepo repository.ScheduledTransferRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+) *ScheduledTransferUseCase {
+	return &ScheduledTransferUseCase{
+		transferRepo:    transferRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetPeriodLocks keeps scheduled transfers from being made in months whose
+// books are closed
+func (uc *ScheduledTransferUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// ScheduleTransfer books a transfer between two accounts for date. One due
+// already is made right away rather than waiting for the next run.
+func (uc *ScheduledTransferUseCase) ScheduleTransfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount float64, date time.Time, description string) (*entity.ScheduledTransfer, error) {
+	fromAccount, err := uc.accountRepo.FindByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account not found: %w", err)
+	}
+
+	toAccount, err := uc.accountRepo.FindByID(ctx, toAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("destination account not found: %w", err)
+	}
+
+	currency := fromAccount.Balance.Currency()
+	if toAccount.Balance.Currency() != currency {
+		return nil, fmt.Errorf("accounts must have the same currency")
+	}
+
+	transfer, err := entity.NewScheduledTransfer(fromAccountID, toAccountID, valueobject.NewMoney(amount, currency), date, description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to save scheduled transfer: %w", err)
+	}
+
+	now := time.Now()
+	if transfer.IsDue(now) {
+		if _, err := uc.makeTransfer(ctx, transfer, now); err != nil {
+			return transfer, fmt.Errorf("transfer scheduled but not made yet: %w", err)
+		}
+	}
+
+	return transfer, nil
+}
+
+func (uc *ScheduledTransferUseCase) ListPendingTransfers(ctx context.Context) ([]*entity.ScheduledTransfer, error) {
+	return uc.transferRepo.FindPending(ctx)
+}
+
+func (uc *ScheduledTransferUseCase) CancelTransfer(ctx context.Context, id uuid.UUID) error {
+	transfer, err := uc.transferRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := transfer.Cancel(); err != nil {
+		return err
+	}
+
+	if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+
+	return nil
+}
+
+// RunDueTransfers makes every scheduled transfer whose date has arrived. A
+// transfer the source can't cover stays pending and is retried on the next run;
+// the others still run.
+func (uc *ScheduledTransferUseCase) RunDueTransfers(ctx context.Context, now time.Time) ([]*entity.Transaction, error) {
+	transfers, err := uc.transferRepo.FindPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled transfers: %w", err)
+	}
+
+	var posted []*entity.Transaction
+	var firstErr error
+	for _, transfer := range transfers {
+		if !transfer.IsDue(now) {
+			continue
+		}
+
+		transactions, err := uc.makeTransfer(ctx, transfer, now)
+		posted = append(posted, transactions...)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("scheduled transfer of %s: %w", transfer.ScheduledFor.Format("2006-01-02"), err)
+		}
+	}
+
+	return posted, firstErr
+}
+
+func (uc *ScheduledTransferUseCase) makeTransfer(ctx context.Context, transfer *entity.ScheduledTransfer, now time.Time) ([]*entity.Transaction, error) {
+	date := transfer.ScheduledFor
+	if date.After(now) {
+		date = now
+	}
+	if err := uc.periods.CheckOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := uc.accountRepo.FindByID(ctx, transfer.FromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account not found: %w", err)
+	}
+
+	toAccount, err := uc.accountRepo.FindByID(ctx, transfer.ToAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("destination account not found: %w", err)
+	}
+
+	if err := fromAccount.Withdraw(transfer.Amount); err != nil {
+		return nil, fmt.Errorf("failed to withdraw from source account: %w", err)
+	}
+
+	if err := toAccount.Deposit(transfer.Amount); err != nil {
+		return nil, fmt.Errorf("failed to deposit to destination account: %w", err)
+	}
+
+	// Mark the transfer first so a failure never makes it twice
+	if err := transfer.MarkDone(now); err != nil {
+		return nil, err
+	}
+	if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, fromAccount); err != nil {
+		return nil, fmt.Errorf("failed to update source account: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, toAccount); err != nil {
+		return nil, fmt.Errorf("failed to update destination account: %w", err)
+	}
+
+	description := transfer.Description
+	if description == "" {
+		description = "Scheduled transfer"
+	}
+
+	debit, credit := entity.NewTransfer(fromAccount.ID, toAccount.ID, transfer.Amount,
+		fmt.Sprintf("%s → %s", description, toAccount.Name), fmt.Sprintf("%s ← %s", description, fromAccount.Name), date)
+
+	var posted []*entity.Transaction
+	for _, transaction := range []*entity.Transaction{debit, credit} {
+		if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+			return posted, fmt.Errorf("failed to create transfer transaction: %w", err)
+		}
+		posted = append(posted, transaction)
+	}
+
+	return posted, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// SinkingFundStatus compares what should already be set aside for a fund with
+// what was spent from it during the current cycle
+type SinkingFundStatus struct {
+	Fund             *entity.SinkingFund
+	MonthlyAccrual   valueobject.Money
+	ExpectedSetAside valueobject.Money
+	SpentThisCycle   valueobject.Money
+	Available        valueobject.Money
+	MonthsAccrued    int
+	NextDueDate      time.Time
+}
+
+// Progress returns the share of the annual amount that should be saved by now, in percent
+func (s *SinkingFundStatus) Progress() float64 {
+	return float64(s.MonthsAccrued) / 12 * 100
+}
+
+type SinkingFundUseCase struct {
+	sinkingFundRepo repository.SinkingFundRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewSinkingFundUseCase(
+	sinkingFundRepo repository.SinkingFundRepository,
+	transactionRepo repository.TransactionRepository,
+) *SinkingFundUseCase {
+	return &SinkingFundUseCase{
+		sinkingFundRepo: sinkingFundRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (uc *SinkingFundUseCase) CreateSinkingFund(ctx context.Context, name string, annualAmount float64, currency string, dueMonth time.Month, category entity.TransactionCategory, keyword string) (*entity.SinkingFund, error) {
+	money := valueobject.NewMoney(annualAmount, currency)
+
+	fund, err := entity.NewSinkingFund(name, money, dueMonth, category, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.sinkingFundRepo.Create(ctx, fund); err != nil {
+		return nil, fmt.Errorf("failed to create sinking fund: %w", err)
+	}
+
+	return fund, nil
+}
+
+func (uc *SinkingFundUseCase) ListSinkingFunds(ctx context.Context) ([]*entity.SinkingFund, error) {
+	return uc.sinkingFundRepo.FindAll(ctx)
+}
+
+func (uc *SinkingFundUseCase) DeleteSinkingFund(ctx context.Context, id uuid.UUID) error {
+	return uc.sinkingFundRepo.Delete(ctx, id)
+}
+
+// GetSinkingFundStatuses returns the accrual status of every fund as of now.
+// Spending is counted from the transactions matching each fund since its cycle began.
+func (uc *SinkingFundUseCase) GetSinkingFundStatuses(ctx context.Context, now time.Time) ([]*SinkingFundStatus, error) {
+	funds, err := uc.sinkingFundRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sinking funds: %w", err)
+	}
+
+	if len(funds) == 0 {
+		return nil, nil
+	}
+
+	// Load the widest cycle once instead of querying per fund
+	earliest := now
+	for _, fund := range funds {
+		if start := fund.CycleStart(now); start.Before(earliest) {
+			earliest = start
+		}
+	}
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, earliest, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	statuses := make([]*SinkingFundStatus, 0, len(funds))
+	for _, fund := range funds {
+		currency := fund.AnnualAmount.Currency()
+		cycleStart := fund.CycleStart(now)
+
+		spent := valueobject.NewMoney(0, currency)
+		for _, txn := range transactions {
+			if txn.Date.Before(cycleStart) || !fund.Matches(txn) {
+				continue
+			}
+			if total, err := spent.Add(txn.Amount); err == nil {
+				spent = total
+			}
+		}
+
+		expected := fund.ExpectedSetAside(now)
+		available, err := expected.Subtract(spent)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, &SinkingFundStatus{
+			Fund:             fund,
+			MonthlyAccrual:   fund.MonthlyAccrual(),
+			ExpectedSetAside: expected,
+			SpentThisCycle:   spent,
+			Available:        available,
+			MonthsAccrued:    fund.MonthsAccrued(now),
+			NextDueDate:      fund.NextDueDate(now),
+		})
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"time"
+
+	"financli/internal/domain/entity"
+)
+
+// SpendPace compares what was spent today with what can still be spent per day
+// for the rest of the month without outspending the month's income
+type SpendPace struct {
+	SpentToday float64
+	// DailyAllowance is the income left at the start of today spread over the
+	// remaining days of the month, today included
+	DailyAllowance float64
+	DaysLeft       int
+}
+
+// RemainingToday returns how much more can be spent today before going over pace
+func (p SpendPace) RemainingToday() float64 {
+	return p.DailyAllowance - p.SpentToday
+}
+
+// IsOverPace reports whether today's spending already exceeds the daily allowance
+func (p SpendPace) IsOverPace() bool {
+	return p.SpentToday > p.DailyAllowance
+}
+
+// CalculateSpendPace computes the spend pace for the month of now from the
+// month's transactions. Transactions ignored from the budget don't count.
+func CalculateSpendPace(transactions []*entity.Transaction, now time.Time) SpendPace {
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	startOfNextMonth := startOfMonth.AddDate(0, 1, 0)
+
+	var income, spentBeforeToday, spentToday float64
+	for _, txn := range transactions {
+		if txn.IgnoreFromBudget || txn.Date.Before(startOfMonth) || !txn.Date.Before(startOfNextMonth) {
+			continue
+		}
+
+		switch {
+		case txn.Type == entity.TransactionTypeCredit:
+			income += txn.Amount.Amount()
+		case txn.Date.Before(startOfToday):
+			spentBeforeToday += txn.Amount.Amount()
+		default:
+			spentToday += txn.Amount.Amount()
+		}
+	}
+
+	daysLeft := startOfNextMonth.AddDate(0, 0, -1).Day() - now.Day() + 1
+
+	allowance := (income - spentBeforeToday) / float64(daysLeft)
+	if allowance < 0 {
+		allowance = 0
+	}
+
+	return SpendPace{
+		SpentToday:     spentToday,
+		DailyAllowance: allowance,
+		DaysLeft:       daysLeft,
+	}
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type StandingOrderUseCase struct {
+	orderRepo       repository.StandingOrderRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	periods         *PeriodLockUseCase
+}
+
+func NewStandingOrderUseCase(
+	orderRepo repository.StandingOrderRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+) *StandingOrderUseCase {
+	return &StandingOrderUseCase{
+		orderRepo:       orderRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// SetPeriodLocks keeps standing orders from transferring in months whose
+// books are closed
+func (uc *StandingOrderUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+func (uc *StandingOrderUseCase) CreateStandingOrder(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount float64, dayOfMonth int, description string) (*entity.StandingOrder, error) {
+	fromAccount, err := uc.accountRepo.FindByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account not found: %w", err)
+	}
+
+	toAccount, err := uc.accountRepo.FindByID(ctx, toAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("destination account not found: %w", err)
+	}
+
+	currency := fromAccount.Balance.Currency()
+	if toAccount.Balance.Currency() != currency {
+		return nil, fmt.Errorf("accounts must have the same currency")
+	}
+
+	order, err := entity.NewStandingOrder(fromAccountID, toAccountID, valueobject.NewMoney(amount, currency), dayOfMonth, description, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.orderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to save standing order: %w", err)
+	}
+
+	return order, nil
+}
+
+func (uc *StandingOrderUseCase) ListStandingOrders(ctx context.Context) ([]*entity.StandingOrder, error) {
+	return uc.orderRepo.FindAll(ctx)
+}
+
+func (uc *StandingOrderUseCase) DeleteStandingOrder(ctx context.Context, id uuid.UUID) error {
+	return uc.orderRepo.Delete(ctx, id)
+}
+
+// RunDueOrders makes every transfer that came due since the last run, recording
+// each as a debit on the source account paired with a credit on the destination.
+// An order whose source can't cover the transfer stops there and is retried on
+// the next run; the other orders still run.
+func (uc *StandingOrderUseCase) RunDueOrders(ctx context.Context, now time.Time) ([]*entity.Transaction, error) {
+	orders, err := uc.orderRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get standing orders: %w", err)
+	}
+
+	var posted []*entity.Transaction
+	var firstErr error
+	for _, order := range orders {
+		dates, err := order.DueDates(now)
+		if err != nil {
+			return posted, err
+		}
+
+		for _, date := range dates {
+			transactions, err := uc.runTransfer(ctx, order, date)
+			posted = append(posted, transactions...)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("standing order %q of %s: %w", order.Description, date.Format("2006-01-02"), err)
+				}
+				break
+			}
+		}
+	}
+
+	return posted, firstErr
+}
+
+func (uc *StandingOrderUseCase) runTransfer(ctx context.Context, order *entity.StandingOrder, date time.Time) ([]*entity.Transaction, error) {
+	if err := uc.periods.CheckOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := uc.accountRepo.FindByID(ctx, order.FromAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account not found: %w", err)
+	}
+
+	toAccount, err := uc.accountRepo.FindByID(ctx, order.ToAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("destination account not found: %w", err)
+	}
+
+	if err := fromAccount.Withdraw(order.Amount); err != nil {
+		return nil, fmt.Errorf("failed to withdraw from source account: %w", err)
+	}
+
+	if err := toAccount.Deposit(order.Amount); err != nil {
+		return nil, fmt.Errorf("failed to deposit to destination account: %w", err)
+	}
+
+	// Mark the month first so a failure never transfers it twice
+	order.MarkRun(date)
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to update standing order: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, fromAccount); err != nil {
+		return nil, fmt.Errorf("failed to update source account: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, toAccount); err != nil {
+		return nil, fmt.Errorf("failed to update destination account: %w", err)
+	}
+
+	description := order.Description
+	if description == "" {
+		description = "Standing order"
+	}
+
+	debit, credit := entity.NewTransfer(fromAccount.ID, toAccount.ID, order.Amount,
+		fmt.Sprintf("%s → %s", description, toAccount.Name), fmt.Sprintf("%s ← %s", description, fromAccount.Name), date)
+
+	var posted []*entity.Transaction
+	for _, transaction := range []*entity.Transaction{debit, credit} {
+		if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+			return posted, fmt.Errorf("failed to create transfer transaction: %w", err)
+		}
+		posted = append(posted, transaction)
+	}
+
+	return posted, nil
+}
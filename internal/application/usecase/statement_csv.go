@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// statementDateLayouts are tried in order; day-first layouts come before
+// month-first ones since that is how Brazilian banks write dates
+var statementDateLayouts = []string{
+	"2006-01-02",
+	"02/01/2006",
+	"02/01/06",
+	"02-01-2006",
+	"02.01.2006",
+	"2006/01/02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+	"02/01/2006 15:04",
+	"02/01/2006 15:04:05",
+}
+
+// StatementCSV is a bank statement read from a CSV file, before its columns
+// are mapped to statement fields
+type StatementCSV struct {
+	Source string
+	Header []string
+	Rows   [][]string
+}
+
+// StatementColumns tells which column holds each field, -1 meaning none.
+// Statements that split debits and credits in two columns use Amount for
+// credits and Debit for debits; otherwise Amount holds signed values. Balance
+// is the running balance some banks print after each entry.
+type StatementColumns struct {
+	Date        int
+	Description int
+	Amount      int
+	Debit       int
+	Balance     int
+}
+
+func (c StatementColumns) Validate() error {
+	if c.Date < 0 {
+		return fmt.Errorf("date column is required")
+	}
+	if c.Description < 0 {
+		return fmt.Errorf("description column is required")
+	}
+	if c.Amount < 0 && c.Debit < 0 {
+		return fmt.Errorf("amount column is required")
+	}
+	return nil
+}
+
+// readStatementCSV reads a statement, working out whether it is separated by
+// commas, semicolons or tabs and whether the first row is a header
+func readStatementCSV(path string) (*StatementCSV, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open statement file: %w", err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid statement file: %w", err)
+	}
+
+	statement := &StatementCSV{Source: filepath.Base(path)}
+	for _, record := range records {
+		if !isBlankRecord(record) {
+			statement.Rows = append(statement.Rows, record)
+		}
+	}
+	if len(statement.Rows) == 0 {
+		return nil, fmt.Errorf("statement file is empty")
+	}
+
+	if looksLikeHeader(statement.Rows[0]) {
+		statement.Header = statement.Rows[0]
+		statement.Rows = statement.Rows[1:]
+	} else {
+		for i := range statement.Rows[0] {
+			statement.Header = append(statement.Header, fmt.Sprintf("Column %d", i+1))
+		}
+	}
+
+	return statement, nil
+}
+
+// detectDelimiter picks the separator the first line has the most of. Ties go
+// to semicolons and tabs, since commas also turn up as decimal separators and
+// in descriptions.
+func detectDelimiter(data []byte) rune {
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+
+	delimiter, best := ',', bytes.Count(firstLine, []byte(","))
+	for _, candidate := range []rune{';', '\t'} {
+		if count := bytes.Count(firstLine, []byte(string(candidate))); count > 0 && count >= best {
+			delimiter, best = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// looksLikeHeader treats the first row as a header unless it already holds a
+// date or an amount
+func looksLikeHeader(record []string) bool {
+	for _, field := range record {
+		if _, ok := parseStatementDate(field); ok {
+			return false
+		}
+		if _, ok := parseStatementAmount(field); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// GuessColumns maps the header names used by common bank exports, in English
+// and Portuguese, to statement fields
+func (s *StatementCSV) GuessColumns() StatementColumns {
+	columns := StatementColumns{Date: -1, Description: -1, Amount: -1, Debit: -1, Balance: -1}
+
+	for i, name := range s.Header {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch {
+		case strings.Contains(name, "balance") || strings.Contains(name, "saldo"):
+			columns.Balance = firstColumn(columns.Balance, i)
+		case strings.Contains(name, "date") || strings.Contains(name, "data"):
+			columns.Date = firstColumn(columns.Date, i)
+		case strings.Contains(name, "desc") || strings.Contains(name, "hist") || strings.Contains(name, "memo") ||
+			strings.Contains(name, "lançamento") || strings.Contains(name, "payee") || strings.Contains(name, "title") || strings.Contains(name, "título"):
+			columns.Description = firstColumn(columns.Description, i)
+		case strings.Contains(name, "debit") || strings.Contains(name, "débito") || strings.Contains(name, "saída"):
+			columns.Debit = firstColumn(columns.Debit, i)
+		case strings.Contains(name, "amount") || strings.Contains(name, "valor") || strings.Contains(name, "value") ||
+			strings.Contains(name, "credit") || strings.Contains(name, "crédito") || strings.Contains(name, "entrada"):
+			columns.Amount = firstColumn(columns.Amount, i)
+		}
+	}
+
+	// Headerless statements usually come as date, description, amount
+	if columns.Date < 0 && columns.Description < 0 && columns.Amount < 0 && len(s.Header) >= 3 {
+		columns.Date, columns.Description, columns.Amount = 0, 1, 2
+	}
+
+	return columns
+}
+
+// Entries converts the rows into statement entries. Rows without a valid date
+// or a non-zero amount, such as balance lines and footers, are skipped and
+// counted.
+func (s *StatementCSV) Entries(columns StatementColumns) ([]StatementEntry, int, error) {
+	if err := columns.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	var entries []StatementEntry
+	skipped := 0
+	for _, row := range s.Rows {
+		date, ok := parseStatementDate(csvField(row, columns.Date))
+		if !ok {
+			skipped++
+			continue
+		}
+
+		amount, _ := parseStatementAmount(csvField(row, columns.Amount))
+		if debit, ok := parseStatementAmount(csvField(row, columns.Debit)); ok && debit != 0 {
+			// Debit columns hold either positive or already negative values
+			if debit > 0 {
+				debit = -debit
+			}
+			amount += debit
+		}
+		if amount == 0 {
+			skipped++
+			continue
+		}
+
+		entry := StatementEntry{
+			Date:        date,
+			Description: csvField(row, columns.Description),
+			Amount:      amount,
+		}
+		if balance, ok := parseStatementAmount(csvField(row, columns.Balance)); ok {
+			entry.Balance = &balance
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil, skipped, fmt.Errorf("no rows could be read with the selected columns")
+	}
+	return entries, skipped, nil
+}
+
+func parseStatementDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range statementDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseStatementAmount reads amounts written either as 1,234.56 or 1.234,56,
+// with optional currency symbols, and negatives marked by a minus sign,
+// parentheses or a trailing D
+func parseStatementAmount(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.Trim(value, "()")
+	}
+	upper := strings.ToUpper(value)
+	if strings.HasSuffix(upper, " D") || strings.HasSuffix(upper, " C") {
+		negative = negative || strings.HasSuffix(upper, " D")
+		value = value[:len(value)-2]
+	}
+
+	value = strings.NewReplacer("R$", "", "US$", "", "$", "", " ", "", "\u00a0", "").Replace(value)
+	if strings.HasPrefix(value, "-") {
+		negative = !negative
+		value = value[1:]
+	} else if strings.HasSuffix(value, "-") {
+		negative = !negative
+		value = value[:len(value)-1]
+	}
+	value = strings.TrimPrefix(value, "+")
+
+	lastComma, lastDot := strings.LastIndex(value, ","), strings.LastIndex(value, ".")
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		// Whichever separator comes last is the decimal one
+		if lastComma > lastDot {
+			value = strings.ReplaceAll(value, ".", "")
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case lastComma >= 0:
+		if strings.Count(value, ",") == 1 && len(value)-lastComma-1 <= 2 {
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case strings.Count(value, ".") > 1:
+		value = strings.ReplaceAll(value, ".", "")
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, true
+}
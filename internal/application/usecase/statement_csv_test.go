@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		value string
+		want  float64
+		ok    bool
+	}{
+		{"1234.56", 1234.56, true},
+		{"1,234.56", 1234.56, true},
+		{"1.234,56", 1234.56, true},
+		{"R$ 1.234,56", 1234.56, true},
+		{"US$ 12.50", 12.5, true},
+		{"-45,90", -45.9, true},
+		{"-R$ 10,00", -10, true},
+		{"+10,00", 10, true},
+		{"100,00-", -100, true},
+		{"(50,00)", -50, true},
+		{"123,45 D", -123.45, true},
+		{"123,45 C", 123.45, true},
+		{"1.234.567", 1234567, true},
+		{"1,234", 1234, true},
+		{"0,5", 0.5, true},
+		{"", 0, false},
+		{"   ", 0, false},
+		{"abc", 0, false},
+		{"12/03/2026", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseStatementAmount(tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.want, got, 0.0001)
+		})
+	}
+}
+
+func TestParseStatementDate(t *testing.T) {
+	march10 := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+		ok    bool
+	}{
+		{"2026-03-10", march10, true},
+		{"10/03/2026", march10, true},
+		{"10/03/26", march10, true},
+		{"10-03-2026", march10, true},
+		{"10.03.2026", march10, true},
+		{"2026/03/10", march10, true},
+		{" 10/03/2026 ", march10, true},
+		{"10/03/2026 14:30", time.Date(2026, time.March, 10, 14, 30, 0, 0, time.UTC), true},
+		{"2026-03-10T14:30:00Z", time.Date(2026, time.March, 10, 14, 30, 0, 0, time.UTC), true},
+		{"31/02/2026", time.Time{}, false},
+		{"03/31/2026", time.Time{}, false},
+		{"Saldo anterior", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseStatementDate(tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestDetectDelimiter(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want rune
+	}{
+		{"commas", "date,description,amount\n", ','},
+		{"semicolons", "Data;Histórico;Valor\n10/03/2026;Padaria;-12,50\n", ';'},
+		{"tabs", "Data\tHistórico\tValor\n", '\t'},
+		{"decimal commas in a semicolon file", "10/03/2026;Padaria, pão e leite;-1.212,50\n", ';'},
+		{"single column", "amount\n", ','},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectDelimiter([]byte(tt.data)))
+		})
+	}
+}
+
+func TestStatementCSV_GuessColumns(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []string
+		want   StatementColumns
+	}{
+		{
+			name:   "english",
+			header: []string{"Date", "Description", "Amount", "Balance"},
+			want:   StatementColumns{Date: 0, Description: 1, Amount: 2, Debit: -1, Balance: 3},
+		},
+		{
+			name:   "portuguese with split columns",
+			header: []string{"Data Lançamento", "Histórico", "Crédito (R$)", "Débito (R$)", "Saldo (R$)"},
+			want:   StatementColumns{Date: 0, Description: 1, Amount: 2, Debit: 3, Balance: 4},
+		},
+		{
+			name:   "first matching column wins",
+			header: []string{"Data", "Data Valor", "Título", "Valor"},
+			want:   StatementColumns{Date: 0, Description: 2, Amount: 3, Debit: -1, Balance: -1},
+		},
+		{
+			name:   "headerless",
+			header: []string{"Column 1", "Column 2", "Column 3"},
+			want:   StatementColumns{Date: 0, Description: 1, Amount: 2, Debit: -1, Balance: -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := &StatementCSV{Header: tt.header}
+			assert.Equal(t, tt.want, statement.GuessColumns())
+		})
+	}
+}
+
+func TestReadStatementCSV(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantHeader []string
+		wantRows   int
+		wantErr    bool
+	}{
+		{
+			name:       "header and byte order mark",
+			data:       "\ufeffData;Histórico;Valor\n10/03/2026;Padaria;-12,50\n11/03/2026;Salário;5.000,00\n",
+			wantHeader: []string{"Data", "Histórico", "Valor"},
+			wantRows:   2,
+		},
+		{
+			name:       "headerless",
+			data:       "2026-03-10,Bakery,-12.50\n",
+			wantHeader: []string{"Column 1", "Column 2", "Column 3"},
+			wantRows:   1,
+		},
+		{
+			name:       "blank lines skipped",
+			data:       "date,description,amount\n\n,,\n2026-03-10,Bakery,-12.50\n",
+			wantHeader: []string{"date", "description", "amount"},
+			wantRows:   1,
+		},
+		{
+			name:    "empty",
+			data:    "\n\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "statement.csv")
+			require.NoError(t, os.WriteFile(path, []byte(tt.data), 0o644))
+
+			statement, err := readStatementCSV(path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHeader, statement.Header)
+			assert.Len(t, statement.Rows, tt.wantRows)
+		})
+	}
+}
+
+func TestStatementCSV_Entries(t *testing.T) {
+	statement := &StatementCSV{
+		Header: []string{"Data", "Histórico", "Crédito", "Débito", "Saldo"},
+		Rows: [][]string{
+			{"", "Saldo anterior", "", "", "1.000,00"},
+			{"10/03/2026", "Padaria", "", "12,50", "987,50"},
+			{"11/03/2026", "Salário", "5.000,00", "", "5.987,50"},
+			{"12/03/2026", "Estorno", "", "-30,00", "5.957,50"},
+			{"13/03/2026", "Tarifa zerada", "", "0,00", "5.957,50"},
+		},
+	}
+
+	entries, skipped, err := statement.Entries(statement.GuessColumns())
+	require.NoError(t, err)
+	assert.Equal(t, 2, skipped)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "Padaria", entries[0].Description)
+	assert.Equal(t, -12.5, entries[0].Amount)
+	require.NotNil(t, entries[0].Balance)
+	assert.Equal(t, 987.5, *entries[0].Balance)
+	assert.Equal(t, 5000.0, entries[1].Amount)
+	assert.Equal(t, -30.0, entries[2].Amount)
+
+	_, _, err = statement.Entries(StatementColumns{Date: -1, Description: 1, Amount: 2, Debit: -1, Balance: -1})
+	assert.EqualError(t, err, "date column is required")
+
+	_, _, err = statement.Entries(StatementColumns{Date: 1, Description: 1, Amount: 2, Debit: -1, Balance: -1})
+	assert.EqualError(t, err, "no rows could be read with the selected columns")
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// StatementWriter lays out a statement as a document, such as a PDF
+type StatementWriter interface {
+	WriteStatement(path string, statement *Statement) error
+}
+
+// Statement is a monthly statement ready to be laid out: every value is
+// already formatted, so writers only arrange them
+type Statement struct {
+	Title  string
+	Holder string
+	// Details go under the title, such as the period and the due date
+	Details []StatementField
+	Lines   []StatementLine
+	// ShowBalance adds the running balance column to the table
+	ShowBalance bool
+	Totals      []StatementField
+	GeneratedAt time.Time
+}
+
+type StatementField struct {
+	Label string
+	Value string
+}
+
+// StatementLine is one transaction of the statement table
+type StatementLine struct {
+	Date        string
+	Description string
+	Category    string
+	Amount      string
+	Balance     string
+}
+
+// StatementExportUseCase writes monthly statements of accounts and card
+// invoices as documents
+type StatementExportUseCase struct {
+	accountRepo     repository.AccountRepository
+	invoiceRepo     repository.CreditCardInvoiceRepository
+	creditCardRepo  repository.CreditCardRepository
+	transactionRepo repository.TransactionRepository
+	writer          StatementWriter
+	outputDir       string
+}
+
+func NewStatementExportUseCase(
+	accountRepo repository.AccountRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	creditCardRepo repository.CreditCardRepository,
+	transactionRepo repository.TransactionRepository,
+	writer StatementWriter,
+	outputDir string,
+) *StatementExportUseCase {
+	return &StatementExportUseCase{
+		accountRepo:     accountRepo,
+		invoiceRepo:     invoiceRepo,
+		creditCardRepo:  creditCardRepo,
+		transactionRepo: transactionRepo,
+		writer:          writer,
+		outputDir:       outputDir,
+	}
+}
+
+// ExportAccountStatements writes the statement of the period between startDate
+// and endDate for every account and returns the paths of the created files
+func (uc *StatementExportUseCase) ExportAccountStatements(ctx context.Context, startDate, endDate time.Time) ([]string, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("no accounts to export")
+	}
+
+	paths := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		path, err := uc.exportAccountStatement(ctx, account, startDate, endDate)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// ExportAccountStatement writes the statement of the account for the period
+// between startDate and endDate and returns the path of the created file
+func (uc *StatementExportUseCase) ExportAccountStatement(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) (string, error) {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return "", fmt.Errorf("account not found: %w", err)
+	}
+	return uc.exportAccountStatement(ctx, account, startDate, endDate)
+}
+
+func (uc *StatementExportUseCase) exportAccountStatement(ctx context.Context, account *entity.Account, startDate, endDate time.Time) (string, error) {
+	transactions, err := uc.transactionRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get account transactions: %w", err)
+	}
+
+	// The opening balance is the current one without everything recorded since
+	// the period began
+	currency := account.Balance.Currency()
+	opening := account.Balance.Amount()
+	var monthTransactions []*entity.Transaction
+	for _, txn := range transactions {
+		if txn.Date.Before(startDate) {
+			continue
+		}
+		opening -= signedAmount(txn)
+		if !txn.Date.After(endDate) {
+			monthTransactions = append(monthTransactions, txn)
+		}
+	}
+	sort.SliceStable(monthTransactions, func(i, j int) bool { return monthTransactions[i].Date.Before(monthTransactions[j].Date) })
+
+	statement := &Statement{
+		Title:  "Account Statement",
+		Holder: account.Name,
+		Details: []StatementField{
+			{Label: "Account type", Value: string(account.Type)},
+			{Label: "Period", Value: fmt.Sprintf("%s to %s", startDate.Format("02/01/2006"), endDate.Format("02/01/2006"))},
+		},
+		ShowBalance: true,
+		GeneratedAt: time.Now(),
+	}
+
+	balance := opening
+	var moneyIn, moneyOut float64
+	for _, txn := range monthTransactions {
+		amount := signedAmount(txn)
+		balance += amount
+		if amount > 0 {
+			moneyIn += amount
+		} else {
+			moneyOut -= amount
+		}
+		statement.Lines = append(statement.Lines, StatementLine{
+			Date:        txn.Date.Format("02/01/2006"),
+			Description: txn.Description,
+			Category:    string(txn.Category),
+			Amount:      formatBRL(valueobject.NewMoney(amount, currency)),
+			Balance:     formatBRL(valueobject.NewMoney(balance, currency)),
+		})
+	}
+	statement.Totals = []StatementField{
+		{Label: "Opening balance", Value: formatBRL(valueobject.NewMoney(opening, currency))},
+		{Label: "(+) Money in", Value: formatBRL(valueobject.NewMoney(moneyIn, currency))},
+		{Label: "(-) Money out", Value: formatBRL(valueobject.NewMoney(moneyOut, currency))},
+		{Label: "(=) Closing balance", Value: formatBRL(valueobject.NewMoney(balance, currency))},
+	}
+
+	// A whole month is named after it, other periods after their dates
+	period := startDate.Format("2006-01-02") + "-" + endDate.Format("2006-01-02")
+	if startDate.Day() == 1 && startDate.AddDate(0, 1, 0).Add(-time.Nanosecond).Equal(endDate) {
+		period = startDate.Format("2006-01")
+	}
+	fileName := fmt.Sprintf("statement-%s-%s.pdf", fileNamePart(account.Name), period)
+	return uc.write(fileName, statement)
+}
+
+// ExportInvoiceStatement writes the statement of a card invoice and returns
+// the path of the created file
+func (uc *StatementExportUseCase) ExportInvoiceStatement(ctx context.Context, invoiceID uuid.UUID) (string, error) {
+	invoice, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get invoice: %w", err)
+	}
+	card, err := uc.creditCardRepo.FindByID(ctx, invoice.CreditCardID)
+	if err != nil {
+		return "", fmt.Errorf("credit card not found: %w", err)
+	}
+	transactions, err := uc.transactionRepo.FindByCreditCardInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get invoice transactions: %w", err)
+	}
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].Date.Before(transactions[j].Date) })
+
+	statement := &Statement{
+		Title:  "Credit Card Invoice",
+		Holder: fmt.Sprintf("%s (final %s)", card.Name, card.LastFourDigits),
+		Details: []StatementField{
+			{Label: "Reference", Value: invoice.ReferenceMonth},
+			{Label: "Period", Value: fmt.Sprintf("%s to %s", invoice.OpeningDate.Format("02/01/2006"), invoice.ClosingDate.Format("02/01/2006"))},
+			{Label: "Due date", Value: invoice.DueDate.Format("02/01/2006")},
+			{Label: "Status", Value: string(invoice.Status)},
+		},
+		GeneratedAt: time.Now(),
+	}
+
+	// Charges are what the invoice adds up, so payments go in negative
+	for _, txn := range transactions {
+		amount := txn.Amount
+		if txn.Type == entity.TransactionTypeCredit {
+			amount = amount.Multiply(-1)
+		}
+		description, installment := splitInstallment(txn.Description)
+		if installment != "" {
+			description = fmt.Sprintf("%s (%s)", description, installment)
+		}
+		statement.Lines = append(statement.Lines, StatementLine{
+			Date:        txn.Date.Format("02/01/2006"),
+			Description: description,
+			Category:    string(txn.Category),
+			Amount:      formatBRL(amount),
+		})
+	}
+
+	// Open invoices have no minimum computed yet, so estimate it from the card setting
+	minimum := invoice.MinimumPayment
+	if invoice.IsOpen() {
+		minimum = minimumPayment(invoice.ClosingBalance, card.MinimumPaymentPercentage)
+	}
+	statement.Totals = []StatementField{
+		{Label: "Previous balance", Value: formatBRL(invoice.PreviousBalance)},
+		{Label: "(-) Payments and credits", Value: formatBRL(invoice.TotalPayments)},
+		{Label: "(+) Purchases and charges", Value: formatBRL(invoice.TotalCharges)},
+		{Label: "(=) Invoice total", Value: formatBRL(invoice.ClosingBalance)},
+		{Label: "Minimum payment", Value: formatBRL(minimum)},
+	}
+
+	fileName := fmt.Sprintf("fatura-%s-%s.pdf", card.LastFourDigits, invoice.ReferenceMonth)
+	return uc.write(fileName, statement)
+}
+
+func (uc *StatementExportUseCase) write(fileName string, statement *Statement) (string, error) {
+	if err := os.MkdirAll(uc.outputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	path := filepath.Join(uc.outputDir, fileName)
+	if err := uc.writer.WriteStatement(path, statement); err != nil {
+		return "", fmt.Errorf("failed to write statement: %w", err)
+	}
+	return path, nil
+}
+
+// signedAmount is what the transaction adds to its account's balance
+func signedAmount(txn *entity.Transaction) float64 {
+	if txn.Type == entity.TransactionTypeDebit {
+		return -txn.Amount.Amount()
+	}
+	return txn.Amount.Amount()
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+// subscriptionLookbackMonths is how far back charges are scanned when detecting subscriptions
+const subscriptionLookbackMonths = 6
+
+// DetectedSubscription is a charge that repeats monthly under the same description
+type DetectedSubscription struct {
+	Key           string
+	Description   string
+	Amount        valueobject.Money // Amount of the latest charge
+	LastChargedAt time.Time
+	Charges       []*entity.Transaction // Oldest first
+}
+
+// PriceChangeAlert flags a subscription whose latest charge differs from the
+// price it used to have
+type PriceChangeAlert struct {
+	Subscription   *DetectedSubscription
+	PreviousAmount valueobject.Money
+	NewAmount      valueobject.Money
+	Delta          valueobject.Money
+}
+
+func (a *PriceChangeAlert) DeltaPercentage() float64 {
+	if a.PreviousAmount.IsZero() {
+		return 0
+	}
+	return a.Delta.Amount() / a.PreviousAmount.Amount() * 100
+}
+
+type SubscriptionUseCase struct {
+	transactionRepo       repository.TransactionRepository
+	subscriptionPriceRepo repository.SubscriptionPriceRepository
+}
+
+func NewSubscriptionUseCase(
+	transactionRepo repository.TransactionRepository,
+	subscriptionPriceRepo repository.SubscriptionPriceRepository,
+) *SubscriptionUseCase {
+	return &SubscriptionUseCase{
+		transactionRepo:       transactionRepo,
+		subscriptionPriceRepo: subscriptionPriceRepo,
+	}
+}
+
+// DetectSubscriptions finds charges without installment markers that were posted
+// in at least two different months and are still active, having been charged
+// this month or the previous one
+func (uc *SubscriptionUseCase) DetectSubscriptions(ctx context.Context, now time.Time) ([]*DetectedSubscription, error) {
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	since := currentMonth.AddDate(0, -subscriptionLookbackMonths, 0)
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, since, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	grouped := make(map[string][]*entity.Transaction)
+	for _, txn := range transactions {
+		if txn.Type != entity.TransactionTypeDebit {
+			continue
+		}
+		if _, marker := splitInstallment(txn.Description); marker != "" {
+			continue
+		}
+
+		key := entity.SubscriptionKey(txn.Description)
+		grouped[key] = append(grouped[key], txn)
+	}
+
+	activeSince := currentMonth.AddDate(0, -1, 0)
+
+	var subscriptions []*DetectedSubscription
+	for key, charges := range grouped {
+		months := make(map[string]bool)
+		for _, txn := range charges {
+			months[txn.Date.Format("2006-01")] = true
+		}
+		if len(months) < 2 {
+			continue
+		}
+
+		sort.SliceStable(charges, func(i, j int) bool { return charges[i].Date.Before(charges[j].Date) })
+		latest := charges[len(charges)-1]
+		if latest.Date.Before(activeSince) {
+			continue
+		}
+
+		subscriptions = append(subscriptions, &DetectedSubscription{
+			Key:           key,
+			Description:   latest.Description,
+			Amount:        latest.Amount,
+			LastChargedAt: latest.Date,
+			Charges:       charges,
+		})
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool { return subscriptions[i].Description < subscriptions[j].Description })
+
+	return subscriptions, nil
+}
+
+// GetPriceChangeAlerts compares the latest charge of each subscription with its
+// acknowledged price or, if the user never acknowledged one, with the amount it
+// was charged most often before
+func (uc *SubscriptionUseCase) GetPriceChangeAlerts(ctx context.Context, now time.Time) ([]*PriceChangeAlert, error) {
+	subscriptions, err := uc.DetectSubscriptions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := uc.subscriptionPriceRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription prices: %w", err)
+	}
+
+	acknowledged := make(map[string]*entity.SubscriptionPrice)
+	for _, price := range prices {
+		acknowledged[price.Key] = price
+	}
+
+	var alerts []*PriceChangeAlert
+	for _, subscription := range subscriptions {
+		var previous valueobject.Money
+		if price, ok := acknowledged[subscription.Key]; ok {
+			previous = price.Amount
+		} else {
+			previous = mostFrequentAmount(subscription.Charges[:len(subscription.Charges)-1])
+		}
+
+		if previous.Amount() == subscription.Amount.Amount() {
+			continue
+		}
+
+		delta, err := subscription.Amount.Subtract(previous)
+		if err != nil {
+			continue
+		}
+
+		alerts = append(alerts, &PriceChangeAlert{
+			Subscription:   subscription,
+			PreviousAmount: previous,
+			NewAmount:      subscription.Amount,
+			Delta:          delta,
+		})
+	}
+
+	return alerts, nil
+}
+
+// AcknowledgePrice accepts the new price of a subscription so it stops raising alerts
+func (uc *SubscriptionUseCase) AcknowledgePrice(ctx context.Context, alert *PriceChangeAlert) error {
+	price, err := uc.subscriptionPriceRepo.FindByKey(ctx, alert.Subscription.Key)
+	if err != nil {
+		price = entity.NewSubscriptionPrice(alert.Subscription.Description, alert.NewAmount)
+		if err := uc.subscriptionPriceRepo.Create(ctx, price); err != nil {
+			return fmt.Errorf("failed to acknowledge price: %w", err)
+		}
+		return nil
+	}
+
+	price.Acknowledge(alert.NewAmount)
+	if err := uc.subscriptionPriceRepo.Update(ctx, price); err != nil {
+		return fmt.Errorf("failed to acknowledge price: %w", err)
+	}
+
+	return nil
+}
+
+// mostFrequentAmount returns the amount charged most often, preferring the most
+// recent one on ties. charges must be sorted oldest first and not empty.
+func mostFrequentAmount(charges []*entity.Transaction) valueobject.Money {
+	counts := make(map[float64]int)
+	best := charges[len(charges)-1].Amount
+	for _, txn := range charges {
+		counts[txn.Amount.Amount()]++
+	}
+	for i := len(charges) - 1; i >= 0; i-- {
+		if counts[charges[i].Amount.Amount()] > counts[best.Amount()] {
+			best = charges[i].Amount
+		}
+	}
+	return best
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/repository"
+)
+
+// TransactionArchiveUseCase moves old years of transactions to the archive
+type TransactionArchiveUseCase struct {
+	archiveRepo repository.TransactionArchiveRepository
+}
+
+func NewTransactionArchiveUseCase(archiveRepo repository.TransactionArchiveRepository) *TransactionArchiveUseCase {
+	return &TransactionArchiveUseCase{archiveRepo: archiveRepo}
+}
+
+// ArchiveOlderThan archives whole calendar years, keeping the given number of
+// past years besides the current one: with years set to 2 in 2024, everything
+// before January 1st 2022 moves. It returns how many transactions
+// were moved and does nothing when years isn't positive.
+func (uc *TransactionArchiveUseCase) ArchiveOlderThan(ctx context.Context, years int, now time.Time) (int, error) {
+	if years <= 0 {
+		return 0, nil
+	}
+
+	cutoff := time.Date(now.Year()-years, time.January, 1, 0, 0, 0, 0, now.Location())
+	moved, err := uc.archiveRepo.ArchiveBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive transactions before %s: %w", cutoff.Format("2006-01-02"), err)
+	}
+	return moved, nil
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"financli/internal/domain/entity"
@@ -17,6 +19,11 @@ type TransactionUseCase struct {
 	creditCardRepo        repository.CreditCardRepository
 	creditCardInvoiceRepo repository.CreditCardInvoiceRepository
 	billRepo              repository.BillRepository
+	history               *ChangeHistoryUseCase
+	suggestions           *CategorySuggestionUseCase
+	notifications         *NotificationUseCase
+	periods               *PeriodLockUseCase
+	unitOfWork            repository.UnitOfWork
 }
 
 func NewTransactionUseCase(
@@ -50,6 +57,102 @@ func NewTransactionUseCaseWithInvoice(
 	}
 }
 
+// SetChangeHistory enables recording of transaction edits
+func (uc *TransactionUseCase) SetChangeHistory(history *ChangeHistoryUseCase) {
+	uc.history = history
+}
+
+// SetCategorySuggestions makes the categories given to transactions train the
+// category suggestions
+func (uc *TransactionUseCase) SetCategorySuggestions(suggestions *CategorySuggestionUseCase) {
+	uc.suggestions = suggestions
+}
+
+// SetNotifications raises a notification when a transaction drops an account
+// below its minimum balance
+func (uc *TransactionUseCase) SetNotifications(notifications *NotificationUseCase) {
+	uc.notifications = notifications
+}
+
+// SetPeriodLocks keeps the transactions of months whose books are closed
+// from being added, edited or deleted
+func (uc *TransactionUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// SetUnitOfWork makes the balance, invoice and transaction writes of each
+// change commit or roll back together
+func (uc *TransactionUseCase) SetUnitOfWork(unitOfWork repository.UnitOfWork) {
+	uc.unitOfWork = unitOfWork
+}
+
+// atomically runs fn in the unit of work when there is one. fn may run again
+// on a retry, so it must load what it changes instead of reusing what an
+// earlier run loaded.
+func (uc *TransactionUseCase) atomically(ctx context.Context, fn func(ctx context.Context) error) error {
+	return inUnitOfWork(ctx, uc.unitOfWork, fn)
+}
+
+// inUnitOfWork runs fn in unitOfWork, or right away when it's nil
+func inUnitOfWork(ctx context.Context, unitOfWork repository.UnitOfWork, fn func(ctx context.Context) error) error {
+	if unitOfWork == nil {
+		return fn(ctx)
+	}
+	return unitOfWork.Do(ctx, fn)
+}
+
+// checkOpen fails when any of the dates is in a month whose books are closed
+func (uc *TransactionUseCase) checkOpen(ctx context.Context, dates ...time.Time) error {
+	if uc.periods == nil {
+		return nil
+	}
+	return uc.periods.CheckOpen(ctx, dates...)
+}
+
+// alertLowBalance notifies that the transaction dropped the account below its
+// minimum balance. Only crossing the threshold alerts, so further spending
+// while already below it doesn't pile up notifications.
+func (uc *TransactionUseCase) alertLowBalance(ctx context.Context, account *entity.Account, wasBelow bool, transaction *entity.Transaction) {
+	if uc.notifications == nil || wasBelow || !account.IsBelowMinimumBalance() {
+		return
+	}
+
+	severity := entity.NotificationSeverityWarning
+	if account.Balance.IsNegative() {
+		severity = entity.NotificationSeverityCritical
+	}
+	key := fmt.Sprintf("low-balance:%s:%s", account.ID, transaction.ID)
+	title := fmt.Sprintf("%s is below its minimum balance", account.Name)
+	body := fmt.Sprintf("Balance: %s\nMinimum: %s\nAfter: %s (%s)",
+		formatBRL(account.Balance), formatBRL(*account.MinimumBalance), transaction.Description, formatBRL(transaction.Amount))
+	if _, err := uc.notifications.NotifyWithSeverity(ctx, key, title, body, severity); err != nil {
+		// The alert is a convenience, the transaction itself was saved
+		fmt.Printf("Warning: failed to raise low balance alert: %v\n", err)
+	}
+}
+
+// learnCategory trains the suggestions on a saved transaction; previous is the
+// transaction before an edit, or nil for a new one
+func (uc *TransactionUseCase) learnCategory(ctx context.Context, previous, transaction *entity.Transaction) {
+	if uc.suggestions == nil {
+		return
+	}
+	if err := uc.suggestions.Learn(ctx, previous, transaction); err != nil {
+		// Suggestions are a convenience, the transaction itself was saved
+		fmt.Printf("Warning: failed to learn category: %v\n", err)
+	}
+}
+
+func (uc *TransactionUseCase) recordChange(ctx context.Context, transaction *entity.Transaction, summary string, before entity.Snapshot) {
+	if uc.history == nil {
+		return
+	}
+	if err := uc.history.RecordChange(ctx, entity.ChangeEntityTransaction, transaction.ID, summary, before, transaction.Snapshot()); err != nil {
+		// The edit itself succeeded, so only warn about the history
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
+
 func (uc *TransactionUseCase) CreateTransaction(
 	ctx context.Context,
 	accountID *uuid.UUID,
@@ -61,113 +164,852 @@ func (uc *TransactionUseCase) CreateTransaction(
 	description string,
 	date time.Time,
 ) (*entity.Transaction, error) {
+	// Cash transactions have neither and leave every balance alone
+	if accountID != nil && creditCardID != nil {
+		return nil, fmt.Errorf("transaction can't belong to both an account and a credit card")
+	}
+
+	if err := uc.checkOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
 	money := valueobject.NewMoney(amount, currency)
 	transaction := entity.NewTransaction(accountID, creditCardID, transactionType, category, money, description, date)
 
-	// Update account or credit card balance
-	if accountID != nil {
-		account, err := uc.accountRepo.FindByID(ctx, *accountID)
+	err := uc.atomically(ctx, func(ctx context.Context) error {
+		if err := uc.applyBalance(ctx, transaction); err != nil {
+			return err
+		}
+
+		// Auto-assign to bills if applicable
+		if err := uc.autoAssignToBills(ctx, transaction); err != nil {
+			// Log warning but don't fail the transaction
+			fmt.Printf("Warning: failed to auto-assign to bills: %v\n", err)
+		}
+
+		if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+			return fmt.Errorf("failed to create transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.learnCategory(ctx, nil, transaction)
+
+	return transaction, nil
+}
+
+// CreateTransactions records a batch of transactions built with entity.NewTransaction,
+// all belonging to the given account or card. Balances, bills and invoices are worked
+// out in memory and saved with a handful of bulk writes instead of several round trips
+// per transaction, which keeps large imports fast.
+func (uc *TransactionUseCase) CreateTransactions(ctx context.Context, accountID, creditCardID *uuid.UUID, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	dates := make([]time.Time, len(transactions))
+	for i, txn := range transactions {
+		dates[i] = txn.Date
+	}
+	if err := uc.checkOpen(ctx, dates...); err != nil {
+		return err
+	}
+
+	start, end := transactions[0].Date, transactions[0].Date
+	for _, txn := range transactions[1:] {
+		if txn.Date.Before(start) {
+			start = txn.Date
+		}
+		if txn.Date.After(end) {
+			end = txn.Date
+		}
+	}
+
+	bills, err := uc.billRepo.FindByDateRange(ctx, start, end)
+	if err != nil {
+		// Bills are a convenience, so don't fail the import over them
+		fmt.Printf("Warning: failed to load bills for auto-assignment: %v\n", err)
+	}
+	for _, txn := range transactions {
+		if bill := selectBill(bills, txn.Date); bill != nil {
+			txn.AssignToBill(bill.ID)
+		}
+	}
+
+	return uc.atomically(ctx, func(ctx context.Context) error {
+		if accountID != nil {
+			account, err := uc.accountRepo.FindByID(ctx, *accountID)
+			if err != nil {
+				return fmt.Errorf("account not found: %w", err)
+			}
+
+			wasBelow := account.IsBelowMinimumBalance()
+			for _, txn := range transactions {
+				if txn.Type == entity.TransactionTypeDebit {
+					err = account.Withdraw(txn.Amount)
+				} else {
+					err = account.Deposit(txn.Amount)
+				}
+				if err != nil {
+					return fmt.Errorf("failed to apply %q to account: %w", txn.Description, err)
+				}
+			}
+
+			if err := uc.accountRepo.Update(ctx, account); err != nil {
+				return fmt.Errorf("failed to update account: %w", err)
+			}
+			// One alert for the whole batch, naming its last transaction
+			uc.alertLowBalance(ctx, account, wasBelow, transactions[len(transactions)-1])
+		}
+
+		if creditCardID != nil {
+			card, err := uc.creditCardRepo.FindByID(ctx, *creditCardID)
+			if err != nil {
+				return fmt.Errorf("credit card not found: %w", err)
+			}
+
+			for _, txn := range transactions {
+				if txn.Type == entity.TransactionTypeDebit {
+					err = card.Charge(txn.Amount)
+				} else {
+					err = card.Payment(txn.Amount)
+				}
+				if err != nil {
+					return fmt.Errorf("failed to apply %q to credit card: %w", txn.Description, err)
+				}
+			}
+
+			if err := uc.creditCardRepo.Update(ctx, card); err != nil {
+				return fmt.Errorf("failed to update credit card: %w", err)
+			}
+
+			if uc.creditCardInvoiceRepo != nil {
+				if err := uc.assignToInvoices(ctx, card, transactions); err != nil {
+					// Log warning but don't fail the transactions
+					fmt.Printf("Warning: failed to assign to invoices: %v\n", err)
+				}
+			}
+		}
+
+		if err := uc.transactionRepo.CreateMany(ctx, transactions); err != nil {
+			return fmt.Errorf("failed to create transactions: %w", err)
+		}
+		return nil
+	})
+}
+
+func (uc *TransactionUseCase) GetTransaction(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	return uc.transactionRepo.FindByID(ctx, id)
+}
+
+func (uc *TransactionUseCase) GetTransactionsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return uc.transactionRepo.FindByDateRange(ctx, startDate, endDate)
+}
+
+// TransactionPage is one page of the transactions of a date range, with the
+// count and totals of the whole range
+type TransactionPage struct {
+	Transactions []*entity.Transaction
+	Total        int
+	Income       float64
+	Expense      float64
+}
+
+// GetTransactionPage loads limit transactions of the date range, newest
+// first, from offset on. The count and totals are summed in the database,
+// so a long ledger is never loaded whole.
+func (uc *TransactionUseCase) GetTransactionPage(ctx context.Context, startDate, endDate time.Time, offset, limit int) (*TransactionPage, error) {
+	transactions, err := uc.transactionRepo.FindPageByDateRange(ctx, startDate, endDate, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	page := &TransactionPage{Transactions: transactions}
+	for _, transactionType := range []entity.TransactionType{entity.TransactionTypeCredit, entity.TransactionTypeDebit} {
+		totals, err := uc.transactionRepo.SumByCategory(ctx, transactionType, startDate, endDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sum transactions: %w", err)
+		}
+		for _, total := range totals {
+			page.Total += total.Count
+			if transactionType == entity.TransactionTypeCredit {
+				page.Income += total.Total
+			} else {
+				page.Expense += total.Total
+			}
+		}
+	}
+	return page, nil
+}
+
+// GetLatestTransactions returns the most recent transactions, newest first
+func (uc *TransactionUseCase) GetLatestTransactions(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	return uc.transactionRepo.FindLatest(ctx, limit)
+}
+
+func (uc *TransactionUseCase) GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID) ([]*entity.Transaction, error) {
+	return uc.transactionRepo.FindByAccountID(ctx, accountID)
+}
+
+func (uc *TransactionUseCase) GetTransactionsByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.Transaction, error) {
+	return uc.transactionRepo.FindByCreditCardID(ctx, creditCardID)
+}
+
+func (uc *TransactionUseCase) SplitTransactionEqually(ctx context.Context, transactionID uuid.UUID, personIDs []uuid.UUID) error {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := transaction.SplitEqually(personIDs); err != nil {
+		return err
+	}
+
+	return uc.transactionRepo.Update(ctx, transaction)
+}
+
+func (uc *TransactionUseCase) AddSharedExpense(ctx context.Context, transactionID uuid.UUID, personID uuid.UUID, percentage float64) error {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := transaction.AddSharedExpense(personID, percentage); err != nil {
+		return err
+	}
+
+	return uc.transactionRepo.Update(ctx, transaction)
+}
+
+// GetRecentCategories returns the categories used by an account or card since the given
+// date, most frequently used first (ties go to the most recently used)
+func (uc *TransactionUseCase) GetRecentCategories(ctx context.Context, accountID, creditCardID *uuid.UUID, since time.Time) ([]entity.TransactionCategory, error) {
+	usage, err := uc.transactionRepo.FindCategoryUsage(ctx, accountID, creditCardID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	counts := make(map[entity.TransactionCategory]int)
+	lastUsed := make(map[entity.TransactionCategory]time.Time)
+	for _, use := range usage {
+		counts[use.Category]++
+		if use.Date.After(lastUsed[use.Category]) {
+			lastUsed[use.Category] = use.Date
+		}
+	}
+
+	categories := make([]entity.TransactionCategory, 0, len(counts))
+	for category := range counts {
+		categories = append(categories, category)
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		if counts[categories[i]] != counts[categories[j]] {
+			return counts[categories[i]] > counts[categories[j]]
+		}
+		return lastUsed[categories[i]].After(lastUsed[categories[j]])
+	})
+
+	return categories, nil
+}
+
+// GetFrequentDescriptions returns the descriptions used since the given date, most
+// used first and ties broken by the most recent. Descriptions differing only in
+// case count as one, spelled as they were last used.
+func (uc *TransactionUseCase) GetFrequentDescriptions(ctx context.Context, since time.Time) ([]string, error) {
+	usage, err := uc.transactionRepo.FindDescriptionUsage(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	counts := make(map[string]int)
+	lastUsed := make(map[string]time.Time)
+	spelling := make(map[string]string)
+	for _, use := range usage {
+		description := strings.TrimSpace(use.Description)
+		if description == "" {
+			continue
+		}
+		key := strings.ToLower(description)
+		counts[key]++
+		if use.Date.After(lastUsed[key]) || spelling[key] == "" {
+			lastUsed[key] = use.Date
+			spelling[key] = description
+		}
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return lastUsed[keys[i]].After(lastUsed[keys[j]])
+	})
+
+	descriptions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		descriptions = append(descriptions, spelling[key])
+	}
+	return descriptions, nil
+}
+
+// SetIgnoreFromBudget flags a transaction so budget tracking skips it
+func (uc *TransactionUseCase) SetIgnoreFromBudget(ctx context.Context, transactionID uuid.UUID, ignore bool) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	transaction.SetIgnoreFromBudget(ignore)
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, "Ignore from budget toggled", before)
+
+	return transaction, nil
+}
+
+// SetLocation tags a transaction with the city and venue where it happened
+func (uc *TransactionUseCase) SetLocation(ctx context.Context, transactionID uuid.UUID, city, venue string) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	transaction.SetLocation(city, venue)
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, "Location changed", before)
+
+	return transaction, nil
+}
+
+// SetPaymentMethod records how a transaction was paid, such as by Pix or boleto
+func (uc *TransactionUseCase) SetPaymentMethod(ctx context.Context, transactionID uuid.UUID, method entity.PaymentMethod) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	if err := transaction.SetPaymentMethod(method); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, "Payment method changed", before)
+
+	return transaction, nil
+}
+
+// SetTags replaces the free-form tags of a transaction
+func (uc *TransactionUseCase) SetTags(ctx context.Context, transactionID uuid.UUID, tags []string) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	transaction.SetTags(tags)
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, "Tags changed", before)
+
+	return transaction, nil
+}
+
+// ListTags returns every tag in use, in alphabetical order, to offer as completions
+func (uc *TransactionUseCase) ListTags(ctx context.Context) ([]string, error) {
+	tags, err := uc.transactionRepo.FindTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
+}
+
+// SetBusinessTags tags a business expense with the client and project it is billed to
+func (uc *TransactionUseCase) SetBusinessTags(ctx context.Context, transactionID uuid.UUID, client, project string) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	transaction.SetBusinessTags(client, project)
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, "Client and project changed", before)
+
+	return transaction, nil
+}
+
+// UpdateTransaction saves the edited details of a transaction. When the
+// account or card, type, amount or date change, the old effect on balances and
+// invoices is reversed before the new one is applied. Transactions on a closed
+// invoice keep the amounts it was closed with, so only their description and
+// category can change.
+func (uc *TransactionUseCase) UpdateTransaction(
+	ctx context.Context,
+	id uuid.UUID,
+	accountID *uuid.UUID,
+	creditCardID *uuid.UUID,
+	transactionType entity.TransactionType,
+	category entity.TransactionCategory,
+	amount float64,
+	description string,
+	date time.Time,
+) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	// Moving it into a closed month changes that month too
+	if err := uc.checkOpen(ctx, transaction.Date, date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	previous := *transaction
+
+	moved, err := transaction.Revise(accountID, creditCardID, transactionType, category,
+		valueobject.NewMoney(amount, previous.Amount.Currency()), description, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if moved && previous.CreditCardInvoiceID != nil && uc.creditCardInvoiceRepo != nil {
+		invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, *previous.CreditCardInvoiceID)
+		if err == nil && !invoice.IsOpen() {
+			return nil, fmt.Errorf("transaction is on a closed invoice: only its description and category can change")
+		}
+	}
+
+	err = uc.atomically(ctx, func(ctx context.Context) error {
+		if moved {
+			if err := uc.reverseBalance(ctx, &previous); err != nil {
+				return err
+			}
+			if err := uc.applyBalance(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		if !transaction.Date.Equal(previous.Date) {
+			if err := uc.autoAssignToBills(ctx, transaction); err != nil {
+				fmt.Printf("Warning: failed to auto-assign to bills: %v\n", err)
+			}
+		}
+
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.recordChange(ctx, transaction, "Transaction edited", before)
+	uc.learnCategory(ctx, &previous, transaction)
+
+	return transaction, nil
+}
+
+// UpdateAmountAndCategory changes the amount and category of a transaction. A new
+// amount moves the difference to the account or card balance and to the open invoice
+// the transaction belongs to; the amount of a charge on a closed invoice can't change.
+func (uc *TransactionUseCase) UpdateAmountAndCategory(ctx context.Context, transactionID uuid.UUID, amount float64, category entity.TransactionCategory) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	previous := *transaction
+	previousAmount := transaction.Amount
+	if err := transaction.SetAmount(valueobject.NewMoney(amount, previousAmount.Currency())); err != nil {
+		return nil, err
+	}
+	transaction.SetCategory(category)
+
+	amountChanged := !transaction.Amount.Equals(previousAmount)
+	if amountChanged && previous.CreditCardInvoiceID != nil && uc.creditCardInvoiceRepo != nil {
+		invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, *previous.CreditCardInvoiceID)
+		if err == nil && !invoice.IsOpen() {
+			return nil, fmt.Errorf("transaction is on a closed invoice: only its description and category can change")
+		}
+	}
+
+	err = uc.atomically(ctx, func(ctx context.Context) error {
+		if amountChanged {
+			if err := uc.rebalance(ctx, transaction, previousAmount); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.recordChange(ctx, transaction, "Amount and category changed", before)
+	uc.learnCategory(ctx, &previous, transaction)
+
+	return transaction, nil
+}
+
+// PayInvoice pays a closed invoice from the card's linked account, recorded as
+// a debit on the account and a credit on the card. Amounts above what is left
+// to pay are capped to it, and the invoice is marked paid once nothing is left.
+func (uc *TransactionUseCase) PayInvoice(ctx context.Context, invoiceID uuid.UUID, amount float64, date time.Time) (*entity.CreditCardInvoice, error) {
+	if uc.creditCardInvoiceRepo == nil {
+		return nil, fmt.Errorf("invoices are not available")
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("payment amount must be positive")
+	}
+	if err := uc.checkOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
+	var paid *entity.CreditCardInvoice
+	err := uc.atomically(ctx, func(ctx context.Context) error {
+		invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, invoiceID)
+		if err != nil {
+			return fmt.Errorf("invoice not found: %w", err)
+		}
+		if invoice.IsOpen() {
+			return fmt.Errorf("invoice is still open: only closed invoices can be paid")
+		}
+		if invoice.Status == entity.InvoiceStatusPaid {
+			return fmt.Errorf("invoice is already paid")
+		}
+
+		outstanding := invoice.ClosingBalance.Amount()
+		if outstanding <= 0 {
+			return fmt.Errorf("nothing is left to pay on this invoice")
+		}
+		payment := amount
+		if payment > outstanding {
+			payment = outstanding
+		}
+
+		card, err := uc.creditCardRepo.FindByID(ctx, invoice.CreditCardID)
+		if err != nil {
+			return fmt.Errorf("credit card not found: %w", err)
+		}
+		account, err := uc.accountRepo.FindByID(ctx, card.AccountID)
+		if err != nil {
+			return fmt.Errorf("linked account not found: %w", err)
+		}
+
+		money := valueobject.NewMoney(payment, invoice.ClosingBalance.Currency())
+		debit, credit := entity.NewInvoicePayment(account.ID, card.ID, invoice.ID, money,
+			fmt.Sprintf("%s invoice %s", card.Name, invoice.ReferenceMonth), date)
+
+		if err := invoice.RegisterPayment(credit.ID, money, date); err != nil {
+			return err
+		}
+		if invoice.ClosingBalance.IsZero() || invoice.ClosingBalance.IsNegative() {
+			if err := invoice.MarkAsPaid(); err != nil {
+				return err
+			}
+		}
+
+		if err := account.Withdraw(money); err != nil {
+			return fmt.Errorf("failed to withdraw from account: %w", err)
+		}
+		if err := card.Payment(money); err != nil {
+			return fmt.Errorf("failed to apply payment to card: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+		if err := uc.creditCardRepo.Update(ctx, card); err != nil {
+			return fmt.Errorf("failed to update credit card: %w", err)
+		}
+		if err := uc.creditCardInvoiceRepo.Update(ctx, invoice); err != nil {
+			return fmt.Errorf("failed to update invoice: %w", err)
+		}
+		if err := uc.transactionRepo.CreateMany(ctx, []*entity.Transaction{debit, credit}); err != nil {
+			return fmt.Errorf("failed to create payment transactions: %w", err)
+		}
+		paid = invoice
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+// Recategorize moves a transaction to another category, leaving its amount and
+// balances alone. summary describes the change in the history.
+func (uc *TransactionUseCase) Recategorize(ctx context.Context, transactionID uuid.UUID, category entity.TransactionCategory, summary string) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return nil, err
+	}
+
+	before := transaction.Snapshot()
+	previous := *transaction
+	transaction.SetCategory(category)
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	uc.recordChange(ctx, transaction, summary, before)
+	uc.learnCategory(ctx, &previous, transaction)
+
+	return transaction, nil
+}
+
+// rebalance reverses the previous amount of the transaction on its account or card
+// and applies the current one
+func (uc *TransactionUseCase) rebalance(ctx context.Context, transaction *entity.Transaction, previousAmount valueobject.Money) error {
+	isDebit := transaction.Type == entity.TransactionTypeDebit
+
+	if transaction.AccountID != nil {
+		account, err := uc.accountRepo.FindByID(ctx, *transaction.AccountID)
+		if err != nil {
+			return fmt.Errorf("account not found: %w", err)
+		}
+
+		wasBelow := account.IsBelowMinimumBalance()
+		if isDebit {
+			if err := account.Deposit(previousAmount); err != nil {
+				return fmt.Errorf("failed to reverse withdrawal from account: %w", err)
+			}
+			if err := account.Withdraw(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to withdraw from account: %w", err)
+			}
+		} else {
+			if err := account.Withdraw(previousAmount); err != nil {
+				return fmt.Errorf("failed to reverse deposit to account: %w", err)
+			}
+			if err := account.Deposit(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to deposit to account: %w", err)
+			}
+		}
+
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+		uc.alertLowBalance(ctx, account, wasBelow, transaction)
+	}
+
+	if transaction.CreditCardID != nil {
+		card, err := uc.creditCardRepo.FindByID(ctx, *transaction.CreditCardID)
+		if err != nil {
+			return fmt.Errorf("credit card not found: %w", err)
+		}
+
+		if isDebit {
+			if err := card.Payment(previousAmount); err != nil {
+				return fmt.Errorf("failed to reverse charge on credit card: %w", err)
+			}
+			if err := card.Charge(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to charge credit card: %w", err)
+			}
+		} else {
+			if err := card.Charge(previousAmount); err != nil {
+				return fmt.Errorf("failed to reverse payment on credit card: %w", err)
+			}
+			if err := card.Payment(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to apply payment to card: %w", err)
+			}
+		}
+
+		if err := uc.creditCardRepo.Update(ctx, card); err != nil {
+			return fmt.Errorf("failed to update credit card: %w", err)
+		}
+
+		// Closed invoices keep the amounts they were closed with
+		if transaction.CreditCardInvoiceID != nil && uc.creditCardInvoiceRepo != nil {
+			invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, *transaction.CreditCardInvoiceID)
+			if err == nil && invoice.IsOpen() {
+				if err := invoice.RemoveTransaction(transaction.ID, previousAmount, !isDebit); err != nil {
+					return fmt.Errorf("failed to update invoice: %w", err)
+				}
+				if err := invoice.AddTransaction(transaction.ID, transaction.Amount, !isDebit); err != nil {
+					return fmt.Errorf("failed to update invoice: %w", err)
+				}
+				if err := uc.creditCardInvoiceRepo.Update(ctx, invoice); err != nil {
+					return fmt.Errorf("failed to update invoice: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyBalance withdraws or deposits the transaction on its account, or charges
+// or pays its card and puts it on the invoice of its date
+func (uc *TransactionUseCase) applyBalance(ctx context.Context, transaction *entity.Transaction) error {
+	if transaction.AccountID != nil {
+		account, err := uc.accountRepo.FindByID(ctx, *transaction.AccountID)
 		if err != nil {
-			return nil, fmt.Errorf("account not found: %w", err)
+			return fmt.Errorf("account not found: %w", err)
 		}
 
-		if transactionType == entity.TransactionTypeDebit {
-			if err := account.Withdraw(money); err != nil {
-				return nil, fmt.Errorf("failed to withdraw from account: %w", err)
+		wasBelow := account.IsBelowMinimumBalance()
+		if transaction.Type == entity.TransactionTypeDebit {
+			if err := account.Withdraw(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to withdraw from account: %w", err)
 			}
 		} else {
-			if err := account.Deposit(money); err != nil {
-				return nil, fmt.Errorf("failed to deposit to account: %w", err)
+			if err := account.Deposit(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to deposit to account: %w", err)
 			}
 		}
 
 		if err := uc.accountRepo.Update(ctx, account); err != nil {
-			return nil, fmt.Errorf("failed to update account: %w", err)
+			return fmt.Errorf("failed to update account: %w", err)
 		}
+		uc.alertLowBalance(ctx, account, wasBelow, transaction)
 	}
 
-	if creditCardID != nil {
-		card, err := uc.creditCardRepo.FindByID(ctx, *creditCardID)
+	if transaction.CreditCardID != nil {
+		card, err := uc.creditCardRepo.FindByID(ctx, *transaction.CreditCardID)
 		if err != nil {
-			return nil, fmt.Errorf("credit card not found: %w", err)
+			return fmt.Errorf("credit card not found: %w", err)
 		}
 
-		if transactionType == entity.TransactionTypeDebit {
-			if err := card.Charge(money); err != nil {
-				return nil, fmt.Errorf("failed to charge credit card: %w", err)
+		if transaction.Type == entity.TransactionTypeDebit {
+			if err := card.Charge(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to charge credit card: %w", err)
 			}
 		} else {
-			if err := card.Payment(money); err != nil {
-				return nil, fmt.Errorf("failed to apply payment to card: %w", err)
+			if err := card.Payment(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to apply payment to card: %w", err)
 			}
 		}
 
 		if err := uc.creditCardRepo.Update(ctx, card); err != nil {
-			return nil, fmt.Errorf("failed to update credit card: %w", err)
+			return fmt.Errorf("failed to update credit card: %w", err)
 		}
 
 		// Handle invoice assignment if invoice repository is available
 		if uc.creditCardInvoiceRepo != nil {
-			if err := uc.assignToInvoice(ctx, transaction, *creditCardID, transactionType == entity.TransactionTypeCredit); err != nil {
+			if err := uc.assignToInvoice(ctx, transaction, *transaction.CreditCardID, transaction.Type == entity.TransactionTypeCredit); err != nil {
 				// Log warning but don't fail the transaction
 				fmt.Printf("Warning: failed to assign to invoice: %v\n", err)
 			}
 		}
 	}
 
-	// Auto-assign to bills if applicable
-	if err := uc.autoAssignToBills(ctx, transaction); err != nil {
-		// Log warning but don't fail the transaction
-		fmt.Printf("Warning: failed to auto-assign to bills: %v\n", err)
-	}
-
-	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
-		return nil, fmt.Errorf("failed to create transaction: %w", err)
-	}
-
-	return transaction, nil
-}
-
-func (uc *TransactionUseCase) GetTransaction(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
-	return uc.transactionRepo.FindByID(ctx, id)
-}
-
-func (uc *TransactionUseCase) GetTransactionsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
-	return uc.transactionRepo.FindByDateRange(ctx, startDate, endDate)
+	return nil
 }
 
-func (uc *TransactionUseCase) GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID) ([]*entity.Transaction, error) {
-	return uc.transactionRepo.FindByAccountID(ctx, accountID)
-}
+// reverseBalance undoes the effect of the transaction on its account or card,
+// and takes it off its invoice while the invoice is open
+func (uc *TransactionUseCase) reverseBalance(ctx context.Context, transaction *entity.Transaction) error {
+	// Reverse the effects on account balance
+	if transaction.AccountID != nil {
+		account, err := uc.accountRepo.FindByID(ctx, *transaction.AccountID)
+		if err != nil {
+			return fmt.Errorf("account not found: %w", err)
+		}
 
-func (uc *TransactionUseCase) GetTransactionsByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.Transaction, error) {
-	return uc.transactionRepo.FindByCreditCardID(ctx, creditCardID)
-}
+		// Reverse the transaction effect
+		if transaction.Type == entity.TransactionTypeDebit {
+			// Original transaction was a withdrawal, so we deposit back
+			if err := account.Deposit(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to reverse withdrawal from account: %w", err)
+			}
+		} else {
+			// Original transaction was a deposit, so we withdraw back
+			if err := account.Withdraw(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to reverse deposit to account: %w", err)
+			}
+		}
 
-func (uc *TransactionUseCase) SplitTransactionEqually(ctx context.Context, transactionID uuid.UUID, personIDs []uuid.UUID) error {
-	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
-	if err != nil {
-		return err
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
 	}
 
-	if err := transaction.SplitEqually(personIDs); err != nil {
-		return err
-	}
+	// Reverse the effects on credit card balance
+	if transaction.CreditCardID != nil {
+		card, err := uc.creditCardRepo.FindByID(ctx, *transaction.CreditCardID)
+		if err != nil {
+			return fmt.Errorf("credit card not found: %w", err)
+		}
 
-	return uc.transactionRepo.Update(ctx, transaction)
-}
+		// Reverse the transaction effect
+		if transaction.Type == entity.TransactionTypeDebit {
+			// Original transaction was a charge, so we apply a payment back
+			if err := card.Payment(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to reverse charge on credit card: %w", err)
+			}
+		} else {
+			// Original transaction was a payment, so we charge back
+			if err := card.Charge(transaction.Amount); err != nil {
+				return fmt.Errorf("failed to reverse payment on credit card: %w", err)
+			}
+		}
 
-func (uc *TransactionUseCase) AddSharedExpense(ctx context.Context, transactionID uuid.UUID, personID uuid.UUID, percentage float64) error {
-	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
-	if err != nil {
-		return err
-	}
+		if err := uc.creditCardRepo.Update(ctx, card); err != nil {
+			return fmt.Errorf("failed to update credit card: %w", err)
+		}
 
-	if err := transaction.AddSharedExpense(personID, percentage); err != nil {
-		return err
+		// Remove from invoice if assigned
+		if transaction.CreditCardInvoiceID != nil && uc.creditCardInvoiceRepo != nil {
+			invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, *transaction.CreditCardInvoiceID)
+			if err == nil && invoice.IsOpen() {
+				// Remove transaction from invoice
+				if err := invoice.RemoveTransaction(transaction.ID, transaction.Amount, transaction.Type == entity.TransactionTypeCredit); err == nil {
+					uc.creditCardInvoiceRepo.Update(ctx, invoice)
+				}
+			}
+		}
 	}
 
-	return uc.transactionRepo.Update(ctx, transaction)
+	return nil
 }
 
 func (uc *TransactionUseCase) autoAssignToBills(ctx context.Context, transaction *entity.Transaction) error {
@@ -177,23 +1019,27 @@ func (uc *TransactionUseCase) autoAssignToBills(ctx context.Context, transaction
 		return err
 	}
 
-	// Find the most appropriate bill (e.g., shortest date range)
-	var selectedBill *entity.Bill
-	for _, bill := range bills {
-		if bill.Status == entity.BillStatusOpen {
-			if selectedBill == nil || bill.EndDate.Sub(bill.StartDate) < selectedBill.EndDate.Sub(selectedBill.StartDate) {
-				selectedBill = bill
-			}
-		}
-	}
-
-	if selectedBill != nil {
+	if selectedBill := selectBill(bills, transaction.Date); selectedBill != nil {
 		transaction.AssignToBill(selectedBill.ID)
 	}
 
 	return nil
 }
 
+// selectBill picks the most appropriate open bill covering the date (e.g., shortest date range)
+func selectBill(bills []*entity.Bill, date time.Time) *entity.Bill {
+	var selectedBill *entity.Bill
+	for _, bill := range bills {
+		if bill.Status != entity.BillStatusOpen || date.Before(bill.StartDate) || date.After(bill.EndDate) {
+			continue
+		}
+		if selectedBill == nil || bill.EndDate.Sub(bill.StartDate) < selectedBill.EndDate.Sub(selectedBill.StartDate) {
+			selectedBill = bill
+		}
+	}
+	return selectedBill
+}
+
 func (uc *TransactionUseCase) assignToInvoice(ctx context.Context, transaction *entity.Transaction, creditCardID uuid.UUID, isPayment bool) error {
 	// Find or create the current invoice for the transaction date
 	var invoice *entity.CreditCardInvoice
@@ -278,84 +1124,107 @@ func (uc *TransactionUseCase) assignToInvoice(ctx context.Context, transaction *
 	return nil
 }
 
-// GetTransactionsByCreditCardInvoice returns all transactions for a specific invoice
-func (uc *TransactionUseCase) GetTransactionsByCreditCardInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*entity.Transaction, error) {
-	return uc.transactionRepo.FindByCreditCardInvoiceID(ctx, invoiceID)
-}
-
-// DeleteTransaction deletes a transaction and reverses its effects on accounts/credit cards
-func (uc *TransactionUseCase) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
-	// First, get the transaction to understand its effects
-	transaction, err := uc.transactionRepo.FindByID(ctx, id)
+// assignToInvoices links a batch of card transactions to their invoices, creating
+// missing invoices on the way, and saves the touched invoices in one bulk write
+func (uc *TransactionUseCase) assignToInvoices(ctx context.Context, card *entity.CreditCard, transactions []*entity.Transaction) error {
+	invoices, err := uc.creditCardInvoiceRepo.FindByCreditCard(ctx, card.ID)
 	if err != nil {
-		return fmt.Errorf("transaction not found: %w", err)
+		return err
 	}
 
-	// Reverse the effects on account balance
-	if transaction.AccountID != nil {
-		account, err := uc.accountRepo.FindByID(ctx, *transaction.AccountID)
-		if err != nil {
-			return fmt.Errorf("account not found: %w", err)
+	var openInvoice *entity.CreditCardInvoice
+	for _, inv := range invoices {
+		if inv.IsOpen() {
+			openInvoice = inv
+			break
 		}
+	}
 
-		// Reverse the transaction effect
-		if transaction.Type == entity.TransactionTypeDebit {
-			// Original transaction was a withdrawal, so we deposit back
-			if err := account.Deposit(transaction.Amount); err != nil {
-				return fmt.Errorf("failed to reverse withdrawal from account: %w", err)
-			}
-		} else {
-			// Original transaction was a deposit, so we withdraw back
-			if err := account.Withdraw(transaction.Amount); err != nil {
-				return fmt.Errorf("failed to reverse deposit to account: %w", err)
+	touched := make(map[uuid.UUID]*entity.CreditCardInvoice)
+	for _, txn := range transactions {
+		var invoice *entity.CreditCardInvoice
+		for _, inv := range invoices {
+			if inv.ContainsDate(txn.Date) {
+				invoice = inv
+				break
 			}
 		}
-
-		if err := uc.accountRepo.Update(ctx, account); err != nil {
-			return fmt.Errorf("failed to update account: %w", err)
+		if invoice == nil {
+			invoice = openInvoice
 		}
-	}
 
-	// Reverse the effects on credit card balance
-	if transaction.CreditCardID != nil {
-		card, err := uc.creditCardRepo.FindByID(ctx, *transaction.CreditCardID)
-		if err != nil {
-			return fmt.Errorf("credit card not found: %w", err)
-		}
+		if invoice == nil {
+			year, month := txn.Date.Year(), txn.Date.Month()
+			referenceMonth := fmt.Sprintf("%04d-%02d", year, month)
 
-		// Reverse the transaction effect
-		if transaction.Type == entity.TransactionTypeDebit {
-			// Original transaction was a charge, so we apply a payment back
-			if err := card.Payment(transaction.Amount); err != nil {
-				return fmt.Errorf("failed to reverse charge on credit card: %w", err)
+			openingDate := time.Date(year, month, 1, 0, 0, 0, 0, txn.Date.Location())
+			closingDate := time.Date(year, month+1, 1, 0, 0, 0, 0, txn.Date.Location()).AddDate(0, 0, -1)
+			dueDate := time.Date(year, month+1, card.DueDay, 0, 0, 0, 0, txn.Date.Location())
+
+			previousBalance := valueobject.NewMoney(0, card.CreditLimit.Currency())
+			for _, inv := range invoices {
+				if inv.IsClosed() && inv.ReferenceMonth < referenceMonth {
+					previousBalance = inv.ClosingBalance
+					break
+				}
 			}
-		} else {
-			// Original transaction was a payment, so we charge back
-			if err := card.Charge(transaction.Amount); err != nil {
-				return fmt.Errorf("failed to reverse payment on credit card: %w", err)
+
+			invoice, err = entity.NewCreditCardInvoice(card.ID, referenceMonth, openingDate, closingDate, dueDate, previousBalance)
+			if err != nil {
+				return fmt.Errorf("failed to create invoice: %w", err)
 			}
+			if err := uc.creditCardInvoiceRepo.Create(ctx, invoice); err != nil {
+				return fmt.Errorf("failed to save invoice: %w", err)
+			}
+			invoices = append(invoices, invoice)
 		}
 
-		if err := uc.creditCardRepo.Update(ctx, card); err != nil {
-			return fmt.Errorf("failed to update credit card: %w", err)
+		if !invoice.IsOpen() {
+			continue
 		}
-
-		// Remove from invoice if assigned
-		if transaction.CreditCardInvoiceID != nil && uc.creditCardInvoiceRepo != nil {
-			invoice, err := uc.creditCardInvoiceRepo.FindByID(ctx, *transaction.CreditCardInvoiceID)
-			if err == nil && invoice.IsOpen() {
-				// Remove transaction from invoice
-				if err := invoice.RemoveTransaction(transaction.ID, transaction.Amount, transaction.Type == entity.TransactionTypeCredit); err == nil {
-					uc.creditCardInvoiceRepo.Update(ctx, invoice)
-				}
-			}
+		if err := invoice.AddTransaction(txn.ID, txn.Amount, txn.Type == entity.TransactionTypeCredit); err != nil {
+			return err
 		}
+		txn.AssignToCreditCardInvoice(invoice.ID)
+		touched[invoice.ID] = invoice
 	}
 
-	// Finally, delete the transaction
-	if err := uc.transactionRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete transaction: %w", err)
+	updated := make([]*entity.CreditCardInvoice, 0, len(touched))
+	for _, invoice := range touched {
+		updated = append(updated, invoice)
+	}
+	if err := uc.creditCardInvoiceRepo.UpdateMany(ctx, updated); err != nil {
+		return fmt.Errorf("failed to update invoices: %w", err)
 	}
 
 	return nil
 }
+
+// GetTransactionsByCreditCardInvoice returns all transactions for a specific invoice
+func (uc *TransactionUseCase) GetTransactionsByCreditCardInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*entity.Transaction, error) {
+	return uc.transactionRepo.FindByCreditCardInvoiceID(ctx, invoiceID)
+}
+
+// DeleteTransaction deletes a transaction and reverses its effects on accounts/credit cards
+func (uc *TransactionUseCase) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
+	// First, get the transaction to understand its effects
+	transaction, err := uc.transactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("transaction not found: %w", err)
+	}
+	if err := uc.checkOpen(ctx, transaction.Date); err != nil {
+		return err
+	}
+
+	return uc.atomically(ctx, func(ctx context.Context) error {
+		if err := uc.reverseBalance(ctx, transaction); err != nil {
+			return err
+		}
+
+		// Finally, delete the transaction
+		if err := uc.transactionRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete transaction: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionUseCase_UpdateAmountAndCategory_Invoice(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		closed      bool
+		amount      float64
+		category    entity.TransactionCategory
+		wantErr     string
+		wantBalance float64
+		wantCharges float64
+	}{
+		{
+			name:        "open invoice follows the new amount",
+			amount:      150,
+			category:    entity.TransactionCategoryFood,
+			wantBalance: 150,
+			wantCharges: 150,
+		},
+		{
+			name:        "closed invoice refuses a new amount",
+			closed:      true,
+			amount:      150,
+			category:    entity.TransactionCategoryFood,
+			wantErr:     "transaction is on a closed invoice: only its description and category can change",
+			wantBalance: 100,
+			wantCharges: 100,
+		},
+		{
+			name:        "closed invoice takes a new category",
+			closed:      true,
+			amount:      100,
+			category:    entity.TransactionCategoryTransportation,
+			wantBalance: 100,
+			wantCharges: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card, err := entity.NewCreditCard(uuid.New(), "Card", "1234", valueobject.NewMoney(1000, "BRL"), 10)
+			require.NoError(t, err)
+			invoice, err := entity.NewCreditCardInvoice(card.ID, "2026-03", date.AddDate(0, 0, -9), date.AddDate(0, 0, 20), date.AddDate(0, 0, 30), valueobject.NewMoney(0, "BRL"))
+			require.NoError(t, err)
+
+			charge := entity.NewTransaction(nil, &card.ID, entity.TransactionTypeDebit, entity.TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Lunch", date)
+			charge.AssignToCreditCardInvoice(invoice.ID)
+			require.NoError(t, card.Charge(charge.Amount))
+			require.NoError(t, invoice.AddTransaction(charge.ID, charge.Amount, false))
+			if tt.closed {
+				require.NoError(t, invoice.Close())
+			}
+
+			cards, invoices := newFakeCreditCardRepo(card), newFakeInvoiceRepo(invoice)
+			uc := NewTransactionUseCaseWithInvoice(newFakeTransactionRepo(charge), nil, cards, invoices, nil)
+
+			updated, err := uc.UpdateAmountAndCategory(ctx, charge.ID, tt.amount, tt.category)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.category, updated.Category)
+			}
+
+			card, err = cards.FindByID(ctx, card.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, card.CurrentBalance.Amount())
+			invoice, err = invoices.FindByID(ctx, invoice.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCharges, invoice.TotalCharges.Amount())
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// transferSuggestionDays is how far ahead the balances are projected
+const transferSuggestionDays = 30
+
+// ProjectedMovement is a payment or transfer expected to move an account
+type ProjectedMovement struct {
+	Date        time.Time
+	Description string
+	// Amount is negative for what leaves the account
+	Amount float64
+}
+
+// AccountProjection follows an account's balance through the movements
+// expected in the coming days
+type AccountProjection struct {
+	Account *entity.Account
+	// Movements are in date order, what comes in before what goes out on a day
+	Movements []ProjectedMovement
+	// Floor is the balance the account shouldn't go below: its minimum
+	// balance, or zero
+	Floor float64
+	// Lowest is the lowest balance the account reaches
+	Lowest float64
+	// Uncovered is the first payment that leaves the balance below the floor,
+	// nil when all of them are covered
+	Uncovered *ProjectedMovement
+}
+
+// Shortfall is how much the account misses to cover its payments
+func (p *AccountProjection) Shortfall() float64 {
+	if p.Uncovered == nil {
+		return 0
+	}
+	return p.Floor - p.Lowest
+}
+
+// Spare is how much could leave the account without it missing any payment
+func (p *AccountProjection) Spare() float64 {
+	return math.Max(p.Lowest-p.Floor, 0)
+}
+
+// TransferSuggestion is a transfer that would let an account cover its payments
+type TransferSuggestion struct {
+	From *entity.Account // nil when no account can spare the amount
+	To   *entity.Account
+	// Amount is the shortfall rounded up to a whole unit
+	Amount valueobject.Money
+	// Date is the day before the first uncovered payment, or today
+	Date      time.Time
+	Uncovered ProjectedMovement
+}
+
+// TransferSuggestionUseCase projects the account balances through the payments
+// coming due and suggests the transfers that would cover them
+type TransferSuggestionUseCase struct {
+	accountRepo        repository.AccountRepository
+	transactionRepo    repository.TransactionRepository
+	billRepo           repository.BillRepository
+	creditCardRepo     repository.CreditCardRepository
+	invoiceRepo        repository.CreditCardInvoiceRepository
+	pendingPaymentRepo repository.PendingPaymentRepository
+	orderRepo          repository.StandingOrderRepository
+	transferUseCase    *ScheduledTransferUseCase
+}
+
+func NewTransferSuggestionUseCase(
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	billRepo repository.BillRepository,
+	creditCardRepo repository.CreditCardRepository,
+	invoiceRepo repository.CreditCardInvoiceRepository,
+	pendingPaymentRepo repository.PendingPaymentRepository,
+	orderRepo repository.StandingOrderRepository,
+	transferUseCase *ScheduledTransferUseCase,
+) *TransferSuggestionUseCase {
+	return &TransferSuggestionUseCase{
+		accountRepo:        accountRepo,
+		transactionRepo:    transactionRepo,
+		billRepo:           billRepo,
+		creditCardRepo:     creditCardRepo,
+		invoiceRepo:        invoiceRepo,
+		pendingPaymentRepo: pendingPaymentRepo,
+		orderRepo:          orderRepo,
+		transferUseCase:    transferUseCase,
+	}
+}
+
+// SuggestTransfers lists a transfer for each account that can't cover what
+// comes due in the next days, taken from the account that can best spare it
+func (uc *TransferSuggestionUseCase) SuggestTransfers(ctx context.Context, now time.Time) ([]*TransferSuggestion, error) {
+	projections, err := uc.ProjectBalances(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	spare := make(map[uuid.UUID]float64, len(projections))
+	for _, projection := range projections {
+		spare[projection.Account.ID] = projection.Spare()
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	var suggestions []*TransferSuggestion
+	for _, projection := range projections {
+		if projection.Uncovered == nil {
+			continue
+		}
+
+		account := projection.Account
+		currency := account.Balance.Currency()
+		amount := math.Ceil(projection.Shortfall())
+		date := projection.Uncovered.Date.AddDate(0, 0, -1)
+		if date.Before(today) {
+			date = today
+		}
+
+		suggestion := &TransferSuggestion{
+			To:        account,
+			Amount:    valueobject.NewMoney(amount, currency),
+			Date:      date,
+			Uncovered: *projection.Uncovered,
+		}
+		for _, source := range projections {
+			if source.Account.ID == account.ID || source.Account.Balance.Currency() != currency || spare[source.Account.ID] < amount {
+				continue
+			}
+			if suggestion.From == nil || spare[source.Account.ID] > spare[suggestion.From.ID] {
+				suggestion.From = source.Account
+			}
+		}
+		if suggestion.From != nil {
+			spare[suggestion.From.ID] -= amount
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Date.Before(suggestions[j].Date)
+	})
+	return suggestions, nil
+}
+
+// AcceptSuggestion schedules the suggested transfer
+func (uc *TransferSuggestionUseCase) AcceptSuggestion(ctx context.Context, suggestion *TransferSuggestion) (*entity.ScheduledTransfer, error) {
+	if suggestion.From == nil {
+		return nil, fmt.Errorf("no account can spare %s", formatBRL(suggestion.Amount))
+	}
+	return uc.transferUseCase.ScheduleTransfer(ctx, suggestion.From.ID, suggestion.To.ID, suggestion.Amount.Amount(),
+		suggestion.Date, fmt.Sprintf("Cover %s", suggestion.Uncovered.Description))
+}
+
+// ProjectBalances follows every account through the movements expected in the
+// next days: scheduled card payments, the card invoices coming due that aren't
+// scheduled yet, the bills still open, standing orders and scheduled
+// transfers. Bills aren't tied to an account, so each is expected from the
+// account the last payment linked to it came from; bills never paid from an
+// account are left out. What came due already and is still unpaid is expected
+// today.
+func (uc *TransferSuggestionUseCase) ProjectBalances(ctx context.Context, now time.Time) ([]*AccountProjection, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	until := today.AddDate(0, 0, transferSuggestionDays)
+
+	movements := make(map[uuid.UUID][]ProjectedMovement, len(accounts))
+	expect := func(accountID uuid.UUID, date time.Time, description string, amount float64) {
+		if date.After(until) || amount == 0 {
+			return
+		}
+		date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, today.Location())
+		if date.Before(today) {
+			date = today
+		}
+		movements[accountID] = append(movements[accountID], ProjectedMovement{Date: date, Description: description, Amount: amount})
+	}
+
+	if err := uc.expectCardPayments(ctx, until, expect); err != nil {
+		return nil, err
+	}
+	if err := uc.expectBills(ctx, until, expect); err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string, len(accounts))
+	for _, account := range accounts {
+		names[account.ID] = account.Name
+	}
+
+	orders, err := uc.orderRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get standing orders: %w", err)
+	}
+	for _, order := range orders {
+		for _, date := range order.UpcomingDates(now, until) {
+			amount := order.Amount.Amount()
+			expect(order.FromAccountID, date, fmt.Sprintf("Standing order to %s", names[order.ToAccountID]), -amount)
+			expect(order.ToAccountID, date, fmt.Sprintf("Standing order from %s", names[order.FromAccountID]), amount)
+		}
+	}
+
+	transfers, err := uc.transferUseCase.ListPendingTransfers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled transfers: %w", err)
+	}
+	for _, transfer := range transfers {
+		amount := transfer.Amount.Amount()
+		expect(transfer.FromAccountID, transfer.ScheduledFor, fmt.Sprintf("Transfer to %s", names[transfer.ToAccountID]), -amount)
+		expect(transfer.ToAccountID, transfer.ScheduledFor, fmt.Sprintf("Transfer from %s", names[transfer.FromAccountID]), amount)
+	}
+
+	projections := make([]*AccountProjection, 0, len(accounts))
+	for _, account := range accounts {
+		projections = append(projections, projectAccount(account, movements[account.ID]))
+	}
+	return projections, nil
+}
+
+// expectCardPayments expects the scheduled card payments from their account,
+// and what remains due of each card's invoices from the card's account
+func (uc *TransferSuggestionUseCase) expectCardPayments(ctx context.Context, until time.Time, expect func(uuid.UUID, time.Time, string, float64)) error {
+	payments, err := uc.pendingPaymentRepo.FindPending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pending payments: %w", err)
+	}
+	scheduled := make(map[uuid.UUID]float64)
+	for _, payment := range payments {
+		scheduled[payment.CreditCardID] += payment.Amount.Amount()
+		if !payment.ScheduledFor.After(until) {
+			expect(payment.AccountID, payment.ScheduledFor, "Scheduled card payment", -payment.Amount.Amount())
+		}
+	}
+
+	cards, err := uc.creditCardRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credit cards: %w", err)
+	}
+	for _, card := range cards {
+		invoices, err := uc.invoiceRepo.FindByCreditCard(ctx, card.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get invoices: %w", err)
+		}
+		sort.SliceStable(invoices, func(i, j int) bool {
+			return invoices[i].DueDate.Before(invoices[j].DueDate)
+		})
+
+		// An invoice carries over what the earlier one left unpaid, so only
+		// the first counts it; the payments already scheduled settle the
+		// earliest invoices first
+		covered := scheduled[card.ID]
+		counted := false
+		for _, invoice := range invoices {
+			if invoice.Status == entity.InvoiceStatusPaid || invoice.DueDate.After(until) {
+				continue
+			}
+			due := invoice.ClosingBalance.Amount()
+			if counted {
+				due -= invoice.PreviousBalance.Amount()
+			}
+			counted = true
+
+			paid := math.Min(covered, math.Max(due, 0))
+			covered -= paid
+			if due -= paid; due > 0 {
+				expect(card.AccountID, invoice.DueDate, fmt.Sprintf("%s invoice %s", card.Name, invoice.ReferenceMonth), -due)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (uc *TransferSuggestionUseCase) expectBills(ctx context.Context, until time.Time, expect func(uuid.UUID, time.Time, string, float64)) error {
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bills: %w", err)
+	}
+
+	for _, bill := range bills {
+		if bill.Status == entity.BillStatusPaid || bill.Status == entity.BillStatusClosed || bill.DueDate.After(until) {
+			continue
+		}
+		remaining, err := bill.GetRemainingAmount()
+		if err != nil || remaining.Amount() <= 0 {
+			continue
+		}
+
+		linked, err := uc.transactionRepo.FindByBillID(ctx, bill.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get bill transactions: %w", err)
+		}
+		var last *entity.Transaction
+		for _, txn := range linked {
+			if txn.AccountID != nil && (last == nil || txn.Date.After(last.Date)) {
+				last = txn
+			}
+		}
+		if last != nil {
+			expect(*last.AccountID, bill.DueDate, bill.Name, -remaining.Amount())
+		}
+	}
+
+	return nil
+}
+
+func projectAccount(account *entity.Account, movements []ProjectedMovement) *AccountProjection {
+	sort.SliceStable(movements, func(i, j int) bool {
+		if !movements[i].Date.Equal(movements[j].Date) {
+			return movements[i].Date.Before(movements[j].Date)
+		}
+		return movements[i].Amount > movements[j].Amount
+	})
+
+	projection := &AccountProjection{Account: account, Movements: movements}
+	if account.MinimumBalance != nil {
+		projection.Floor = account.MinimumBalance.Amount()
+	}
+
+	balance := account.Balance.Amount()
+	projection.Lowest = balance
+	for i, movement := range movements {
+		balance += movement.Amount
+		if balance >= projection.Lowest {
+			continue
+		}
+		projection.Lowest = balance
+		if movement.Amount < 0 && balance < projection.Floor && projection.Uncovered == nil {
+			projection.Uncovered = &movements[i]
+		}
+	}
+	return projection
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+)
+
+// trendMonths is how many months the trend report covers
+const trendMonths = 12
+
+// TrendMonth is the income and expenses of one month of the trend report,
+// next to the same month a year before
+type TrendMonth struct {
+	Month            time.Time
+	Income           float64
+	Expenses         float64
+	LastYearIncome   float64
+	LastYearExpenses float64
+}
+
+// TrendReport follows income and expenses month by month, oldest month first
+type TrendReport struct {
+	Months []TrendMonth
+}
+
+// Latest is the last month of the report
+func (r *TrendReport) Latest() TrendMonth {
+	return r.Months[len(r.Months)-1]
+}
+
+// MonthOverMonth is the change in percent of the latest month's expenses over
+// the month before. There is none when that month had no expenses.
+func (r *TrendReport) MonthOverMonth() (float64, bool) {
+	if len(r.Months) < 2 {
+		return 0, false
+	}
+	return percentChange(r.Months[len(r.Months)-2].Expenses, r.Latest().Expenses)
+}
+
+// YearOverYear is the change in percent of the latest month's expenses over
+// the same month a year before. There is none when that month had no expenses.
+func (r *TrendReport) YearOverYear() (float64, bool) {
+	latest := r.Latest()
+	return percentChange(latest.LastYearExpenses, latest.Expenses)
+}
+
+// ExpenseSlope is how much expenses grow per month, on average, along a
+// least-squares line through the months; negative when spending is going down
+func (r *TrendReport) ExpenseSlope() float64 {
+	n := float64(len(r.Months))
+	if n < 2 {
+		return 0
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for i, month := range r.Months {
+		x := float64(i)
+		sumX += x
+		sumY += month.Expenses
+		sumXY += x * month.Expenses
+		sumXX += x * x
+	}
+	return (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+}
+
+func percentChange(from, to float64) (float64, bool) {
+	if from == 0 {
+		return 0, false
+	}
+	return (to - from) / from * 100, true
+}
+
+// GetTrendReport totals income and expenses for each of the 12 months ending
+// with lastMonth, and for the 12 before them for the year-over-year
+// comparison. Transfers between the user's own accounts count as neither.
+func (uc *ReportUseCase) GetTrendReport(ctx context.Context, lastMonth time.Time) (*TrendReport, error) {
+	end := time.Date(lastMonth.Year(), lastMonth.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	key := fmt.Sprintf("trend:%s", end.Format("2006-01"))
+	report, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeTrendReport(ctx, end)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report.(*TrendReport), nil
+}
+
+func (uc *ReportUseCase) computeTrendReport(ctx context.Context, end time.Time) (*TrendReport, error) {
+	start := end.AddDate(0, -2*trendMonths, 0)
+	transactions, err := uc.findByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Months since start, the first year being the one before the report
+	income := make([]float64, 2*trendMonths)
+	expenses := make([]float64, 2*trendMonths)
+	for _, txn := range transactions {
+		if txn.Category == entity.TransactionCategoryTransfer || (uc.excludeIgnored && txn.IgnoreFromBudget) {
+			continue
+		}
+		index := (txn.Date.Year()-start.Year())*12 + int(txn.Date.Month()-start.Month())
+		if index < 0 || index >= len(income) {
+			continue
+		}
+
+		if txn.Type == entity.TransactionTypeCredit {
+			income[index] += txn.Amount.Amount()
+		} else {
+			expenses[index] += txn.Amount.Amount()
+		}
+	}
+
+	report := &TrendReport{}
+	for i := trendMonths; i < 2*trendMonths; i++ {
+		report.Months = append(report.Months, TrendMonth{
+			Month:            start.AddDate(0, i, 0),
+			Income:           income[i],
+			Expenses:         expenses[i],
+			LastYearIncome:   income[i-trendMonths],
+			LastYearExpenses: expenses[i-trendMonths],
+		})
+	}
+
+	return report, nil
+}
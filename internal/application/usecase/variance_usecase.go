@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+const (
+	// varianceDriftThreshold is how far, in percent, actuals must stray from
+	// the plan to count as drifting
+	varianceDriftThreshold = 10.0
+	// varianceRecentPeriods is how many of the latest periods must all drift the
+	// same way for an item to be flagged
+	varianceRecentPeriods = 3
+)
+
+// RecurringKind tells where a recurring item's plan comes from
+type RecurringKind string
+
+const (
+	// RecurringKindBill plans with the bill's total
+	RecurringKindBill RecurringKind = "bill"
+	// RecurringKindSubscription plans with the subscription's acknowledged
+	// price, or the amount it is charged most often
+	RecurringKindSubscription RecurringKind = "subscription"
+)
+
+// VariancePeriod is one occurrence of a recurring item: what was planned for it
+// and what was actually posted
+type VariancePeriod struct {
+	Month   time.Time
+	Planned float64
+	Actual  float64
+}
+
+// Drift is how far the actual strayed from the plan, in percent of the plan
+func (p VariancePeriod) Drift() float64 {
+	if p.Planned == 0 {
+		return 0
+	}
+	return (p.Actual - p.Planned) / p.Planned * 100
+}
+
+// RecurringVariance compares the plan of a recurring bill or subscription with
+// what was posted, period by period
+type RecurringVariance struct {
+	Kind RecurringKind
+	Name string
+	// Periods are oldest first
+	Periods []VariancePeriod
+}
+
+// AverageDrift is the mean drift of the periods, in percent
+func (v *RecurringVariance) AverageDrift() float64 {
+	if len(v.Periods) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, period := range v.Periods {
+		sum += period.Drift()
+	}
+	return sum / float64(len(v.Periods))
+}
+
+// ConsistentDrift tells whether each of the latest periods, and at least two,
+// strayed from the plan by the threshold or more in the same direction: 1 when
+// above plan, -1 when below, 0 otherwise
+func (v *RecurringVariance) ConsistentDrift() int {
+	recent := v.Periods
+	if len(recent) > varianceRecentPeriods {
+		recent = recent[len(recent)-varianceRecentPeriods:]
+	}
+	if len(recent) < 2 {
+		return 0
+	}
+
+	direction := 0
+	for _, period := range recent {
+		drift := period.Drift()
+		if math.Abs(drift) < varianceDriftThreshold {
+			return 0
+		}
+		sign := 1
+		if drift < 0 {
+			sign = -1
+		}
+		if direction != 0 && sign != direction {
+			return 0
+		}
+		direction = sign
+	}
+	return direction
+}
+
+// VarianceUseCase compares realized amounts with the planned ones for the
+// recurring bills and subscriptions
+type VarianceUseCase struct {
+	billRepo              repository.BillRepository
+	transactionRepo       repository.TransactionRepository
+	subscriptionPriceRepo repository.SubscriptionPriceRepository
+	subscriptionUseCase   *SubscriptionUseCase
+}
+
+func NewVarianceUseCase(
+	billRepo repository.BillRepository,
+	transactionRepo repository.TransactionRepository,
+	subscriptionPriceRepo repository.SubscriptionPriceRepository,
+	subscriptionUseCase *SubscriptionUseCase,
+) *VarianceUseCase {
+	return &VarianceUseCase{
+		billRepo:              billRepo,
+		transactionRepo:       transactionRepo,
+		subscriptionPriceRepo: subscriptionPriceRepo,
+		subscriptionUseCase:   subscriptionUseCase,
+	}
+}
+
+// GetVarianceReport lists the recurring items with their planned and realized
+// amounts, the ones drifting consistently first, then by how far they drift on
+// average
+func (uc *VarianceUseCase) GetVarianceReport(ctx context.Context, now time.Time) ([]*RecurringVariance, error) {
+	bills, err := uc.billVariances(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := uc.subscriptionVariances(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	variances := append(bills, subscriptions...)
+	sort.SliceStable(variances, func(i, j int) bool {
+		flaggedI, flaggedJ := variances[i].ConsistentDrift() != 0, variances[j].ConsistentDrift() != 0
+		if flaggedI != flaggedJ {
+			return flaggedI
+		}
+		driftI, driftJ := math.Abs(variances[i].AverageDrift()), math.Abs(variances[j].AverageDrift())
+		if driftI != driftJ {
+			return driftI > driftJ
+		}
+		return variances[i].Name < variances[j].Name
+	})
+	return variances, nil
+}
+
+// billVariances groups the bills whose period is over by name, each bill being
+// one period planned at its total. Bills without linked transactions are left
+// out, having no actuals to compare.
+func (uc *VarianceUseCase) billVariances(ctx context.Context, now time.Time) ([]*RecurringVariance, error) {
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bills: %w", err)
+	}
+
+	byName := make(map[string]*RecurringVariance)
+	var variances []*RecurringVariance
+	for _, bill := range bills {
+		if !bill.EndDate.Before(now) {
+			continue
+		}
+		linked, err := uc.transactionRepo.FindByBillID(ctx, bill.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bill transactions: %w", err)
+		}
+		if len(linked) == 0 {
+			continue
+		}
+
+		var actual float64
+		for _, txn := range linked {
+			if txn.Type == entity.TransactionTypeCredit {
+				actual -= txn.Amount.Amount()
+			} else {
+				actual += txn.Amount.Amount()
+			}
+		}
+
+		key := normalizeDescription(bill.Name)
+		variance, ok := byName[key]
+		if !ok {
+			variance = &RecurringVariance{Kind: RecurringKindBill, Name: bill.Name}
+			byName[key] = variance
+			variances = append(variances, variance)
+		}
+		variance.Periods = append(variance.Periods, VariancePeriod{
+			Month:   time.Date(bill.StartDate.Year(), bill.StartDate.Month(), 1, 0, 0, 0, 0, bill.StartDate.Location()),
+			Planned: bill.TotalAmount.Amount(),
+			Actual:  actual,
+		})
+	}
+
+	for _, variance := range variances {
+		sort.SliceStable(variance.Periods, func(i, j int) bool {
+			return variance.Periods[i].Month.Before(variance.Periods[j].Month)
+		})
+	}
+	return variances, nil
+}
+
+// subscriptionVariances compares each month's charges of the detected
+// subscriptions with their acknowledged price or, without one, the amount
+// charged most often
+func (uc *VarianceUseCase) subscriptionVariances(ctx context.Context, now time.Time) ([]*RecurringVariance, error) {
+	subscriptions, err := uc.subscriptionUseCase.DetectSubscriptions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := uc.subscriptionPriceRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription prices: %w", err)
+	}
+	acknowledged := make(map[string]valueobject.Money)
+	for _, price := range prices {
+		acknowledged[price.Key] = price.Amount
+	}
+
+	var variances []*RecurringVariance
+	for _, subscription := range subscriptions {
+		planned, ok := acknowledged[subscription.Key]
+		if !ok {
+			planned = mostFrequentAmount(subscription.Charges)
+		}
+
+		variance := &RecurringVariance{Kind: RecurringKindSubscription, Name: subscription.Description}
+		// Charges are oldest first, so the months come out in order
+		for _, charge := range subscription.Charges {
+			month := time.Date(charge.Date.Year(), charge.Date.Month(), 1, 0, 0, 0, 0, charge.Date.Location())
+			if last := len(variance.Periods) - 1; last >= 0 && variance.Periods[last].Month.Equal(month) {
+				variance.Periods[last].Actual += charge.Amount.Amount()
+				continue
+			}
+			variance.Periods = append(variance.Periods, VariancePeriod{Month: month, Planned: planned.Amount(), Actual: charge.Amount.Amount()})
+		}
+		variances = append(variances, variance)
+	}
+	return variances, nil
+}
@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+)
+
+// Channels the weekly digest can be delivered to
+const (
+	DigestChannelNotifications = "notifications"
+	DigestChannelEmail         = "email"
+	DigestChannelWebhook       = "webhook"
+)
+
+const (
+	digestTopExpenses = 5
+	// How far ahead the digest looks for bills to pay
+	digestUpcomingDays = 7
+)
+
+// Webhook delivers a JSON payload to an external service
+type Webhook interface {
+	Post(payload interface{}) error
+}
+
+// WeeklyDigest sums up the week that ended on Sunday
+type WeeklyDigest struct {
+	WeekStart time.Time
+	WeekEnd   time.Time // Exclusive, the Monday after
+
+	TopExpenses []*entity.Transaction
+
+	// Budget status of the month the week ends in, up to the end of the week
+	MonthIncome float64
+	MonthSpent  float64
+
+	UpcomingBills []*entity.Bill
+	Balances      []AccountBalanceChange
+}
+
+// AccountBalanceChange is an account's current balance and how much the
+// week's transactions moved it
+type AccountBalanceChange struct {
+	Account *entity.Account
+	Change  float64
+}
+
+type WeeklyDigestUseCase struct {
+	transactionRepo repository.TransactionRepository
+	accountRepo     repository.AccountRepository
+	billRepo        repository.BillRepository
+	notifications   *NotificationUseCase
+	channels        []string
+
+	mailer    Mailer
+	recipient string
+	webhook   Webhook
+}
+
+func NewWeeklyDigestUseCase(
+	transactionRepo repository.TransactionRepository,
+	accountRepo repository.AccountRepository,
+	billRepo repository.BillRepository,
+	notifications *NotificationUseCase,
+	channels []string,
+) *WeeklyDigestUseCase {
+	return &WeeklyDigestUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		billRepo:        billRepo,
+		notifications:   notifications,
+		channels:        channels,
+	}
+}
+
+// SetMailer enables the email channel, sending the digest to the recipient
+func (uc *WeeklyDigestUseCase) SetMailer(mailer Mailer, recipient string) {
+	uc.mailer = mailer
+	uc.recipient = recipient
+}
+
+// SetWebhook enables the webhook channel
+func (uc *WeeklyDigestUseCase) SetWebhook(webhook Webhook) {
+	uc.webhook = webhook
+}
+
+func (uc *WeeklyDigestUseCase) deliversTo(channel string) bool {
+	for _, c := range uc.channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// SendWeeklyDigest delivers the digest of last week, from Monday to Sunday, to
+// the configured channels. Each week is sent at most once, so this is safe to
+// run on every launch: the digest goes out on Monday or on the first launch
+// after it. The digest is always kept in the notifications center as a record
+// of what was sent, already read when that channel isn't chosen.
+func (uc *WeeklyDigestUseCase) SendWeeklyDigest(ctx context.Context, now time.Time) (bool, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weekStart := today.AddDate(0, 0, -(int(today.Weekday())+6)%7-7)
+	key := "weekly-digest:" + weekStart.Format("2006-01-02")
+
+	sent, err := uc.notifications.Notified(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if sent {
+		return false, nil
+	}
+
+	digest, err := uc.BuildDigest(ctx, weekStart, now)
+	if err != nil {
+		return false, err
+	}
+	title := fmt.Sprintf("Weekly digest: %s – %s", weekStart.Format("02 Jan"), digest.WeekEnd.AddDate(0, 0, -1).Format("02 Jan 2006"))
+	body := renderWeeklyDigest(digest)
+
+	if uc.deliversTo(DigestChannelEmail) {
+		if uc.mailer == nil || uc.recipient == "" {
+			return false, fmt.Errorf("weekly digest email needs SMTP and a recipient configured")
+		}
+		if err := uc.mailer.Send(uc.recipient, title, body); err != nil {
+			return false, err
+		}
+	}
+
+	if uc.deliversTo(DigestChannelWebhook) {
+		if uc.webhook == nil {
+			return false, fmt.Errorf("weekly digest webhook needs a URL configured")
+		}
+		if err := uc.webhook.Post(weeklyDigestPayload(digest, title, body)); err != nil {
+			return false, err
+		}
+	}
+
+	notification, err := uc.notifications.Notify(ctx, key, title, body)
+	if err != nil {
+		return false, err
+	}
+	if notification != nil && !uc.deliversTo(DigestChannelNotifications) {
+		if err := uc.notifications.MarkRead(ctx, notification); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// BuildDigest gathers the digest of the week starting on weekStart. Upcoming
+// bills are the unpaid ones due within a week of now.
+func (uc *WeeklyDigestUseCase) BuildDigest(ctx context.Context, weekStart, now time.Time) (*WeeklyDigest, error) {
+	digest := &WeeklyDigest{
+		WeekStart: weekStart,
+		WeekEnd:   weekStart.AddDate(0, 0, 7),
+	}
+
+	lastDay := digest.WeekEnd.AddDate(0, 0, -1)
+	monthStart := time.Date(lastDay.Year(), lastDay.Month(), 1, 0, 0, 0, 0, lastDay.Location())
+	from := monthStart
+	if weekStart.Before(from) {
+		from = weekStart
+	}
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, from, digest.WeekEnd.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	changes := make(map[string]float64)
+	var expenses []*entity.Transaction
+	for _, txn := range transactions {
+		amount := txn.Amount.Amount()
+		inWeek := !txn.Date.Before(weekStart)
+
+		if inWeek && txn.AccountID != nil {
+			if txn.Type == entity.TransactionTypeCredit {
+				changes[txn.AccountID.String()] += amount
+			} else {
+				changes[txn.AccountID.String()] -= amount
+			}
+		}
+
+		if txn.IgnoreFromBudget || txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+		if !txn.Date.Before(monthStart) {
+			if txn.Type == entity.TransactionTypeCredit {
+				digest.MonthIncome += amount
+			} else {
+				digest.MonthSpent += amount
+			}
+		}
+		if inWeek && txn.Type == entity.TransactionTypeDebit {
+			expenses = append(expenses, txn)
+		}
+	}
+
+	sort.SliceStable(expenses, func(i, j int) bool {
+		return expenses[i].Amount.Amount() > expenses[j].Amount.Amount()
+	})
+	if len(expenses) > digestTopExpenses {
+		expenses = expenses[:digestTopExpenses]
+	}
+	digest.TopExpenses = expenses
+
+	bills, err := uc.billRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bills: %w", err)
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	horizon := today.AddDate(0, 0, digestUpcomingDays+1)
+	for _, bill := range bills {
+		if bill.Status == entity.BillStatusPaid || bill.DueDate.Before(today) || !bill.DueDate.Before(horizon) {
+			continue
+		}
+		digest.UpcomingBills = append(digest.UpcomingBills, bill)
+	}
+	sort.Slice(digest.UpcomingBills, func(i, j int) bool {
+		return digest.UpcomingBills[i].DueDate.Before(digest.UpcomingBills[j].DueDate)
+	})
+
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	for _, account := range accounts {
+		digest.Balances = append(digest.Balances, AccountBalanceChange{
+			Account: account,
+			Change:  changes[account.ID.String()],
+		})
+	}
+
+	return digest, nil
+}
+
+func renderWeeklyDigest(digest *WeeklyDigest) string {
+	var b strings.Builder
+
+	b.WriteString("Top expenses\n")
+	if len(digest.TopExpenses) == 0 {
+		b.WriteString("  No expenses this week.\n")
+	}
+	for _, txn := range digest.TopExpenses {
+		fmt.Fprintf(&b, "  %s  %-30s %s\n", txn.Date.Format("02/01"), txn.Description, formatBRL(txn.Amount))
+	}
+
+	fmt.Fprintf(&b, "\nBudget for %s\n", digest.WeekEnd.AddDate(0, 0, -1).Format("January 2006"))
+	fmt.Fprintf(&b, "  Income: %s\n", formatBRL(valueobject.NewMoney(digest.MonthIncome, "BRL")))
+	fmt.Fprintf(&b, "  Spent:  %s", formatBRL(valueobject.NewMoney(digest.MonthSpent, "BRL")))
+	if digest.MonthIncome > 0 {
+		fmt.Fprintf(&b, " (%.0f%% of income)", digest.MonthSpent/digest.MonthIncome*100)
+	}
+	b.WriteString("\n")
+
+	b.WriteString("\nDue in the next 7 days\n")
+	if len(digest.UpcomingBills) == 0 {
+		b.WriteString("  Nothing due.\n")
+	}
+	for _, bill := range digest.UpcomingBills {
+		remaining, err := bill.GetRemainingAmount()
+		if err != nil {
+			remaining = bill.TotalAmount
+		}
+		fmt.Fprintf(&b, "  %s  %-30s %s\n", bill.DueDate.Format("02/01"), bill.Name, formatBRL(remaining))
+	}
+
+	b.WriteString("\nBalances\n")
+	for _, balance := range digest.Balances {
+		change := formatBRL(valueobject.NewMoney(balance.Change, "BRL"))
+		if balance.Change >= 0 {
+			change = "+" + change
+		}
+		fmt.Fprintf(&b, "  %-30s %s (%s this week)\n", balance.Account.Name, formatBRL(balance.Account.Balance), change)
+	}
+
+	return b.String()
+}
+
+// weeklyDigestPayload is the JSON posted to the webhook. Text holds the
+// rendered digest for services that only display messages.
+func weeklyDigestPayload(digest *WeeklyDigest, title, body string) map[string]interface{} {
+	expenses := make([]map[string]interface{}, len(digest.TopExpenses))
+	for i, txn := range digest.TopExpenses {
+		expenses[i] = map[string]interface{}{
+			"date":        txn.Date.Format("2006-01-02"),
+			"description": txn.Description,
+			"category":    string(txn.Category),
+			"amount":      txn.Amount.Amount(),
+		}
+	}
+
+	bills := make([]map[string]interface{}, len(digest.UpcomingBills))
+	for i, bill := range digest.UpcomingBills {
+		remaining, err := bill.GetRemainingAmount()
+		if err != nil {
+			remaining = bill.TotalAmount
+		}
+		bills[i] = map[string]interface{}{
+			"name":      bill.Name,
+			"due_date":  bill.DueDate.Format("2006-01-02"),
+			"remaining": remaining.Amount(),
+		}
+	}
+
+	balances := make([]map[string]interface{}, len(digest.Balances))
+	for i, balance := range digest.Balances {
+		balances[i] = map[string]interface{}{
+			"account": balance.Account.Name,
+			"balance": balance.Account.Balance.Amount(),
+			"change":  balance.Change,
+		}
+	}
+
+	return map[string]interface{}{
+		"event":          "weekly_digest",
+		"title":          title,
+		"text":           body,
+		"week_start":     digest.WeekStart.Format("2006-01-02"),
+		"week_end":       digest.WeekEnd.AddDate(0, 0, -1).Format("2006-01-02"),
+		"top_expenses":   expenses,
+		"month_income":   digest.MonthIncome,
+		"month_spent":    digest.MonthSpent,
+		"upcoming_bills": bills,
+		"balances":       balances,
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+)
+
+// WhatIfMonth is one replayed month of a what-if simulation
+type WhatIfMonth struct {
+	Month           time.Time
+	Actual          float64 // What was spent in the category
+	Simulated       float64 // What would have been spent under the cap
+	CumulativeSaved float64 // Savings from the first replayed month up to this one
+}
+
+func (m WhatIfMonth) Saved() float64 {
+	return m.Actual - m.Simulated
+}
+
+// WhatIfSimulation replays past months as if a category's spending had been
+// capped, oldest month first
+type WhatIfSimulation struct {
+	Category   entity.TransactionCategory
+	MonthlyCap float64
+	Months     []WhatIfMonth
+}
+
+func (s *WhatIfSimulation) TotalSaved() float64 {
+	if len(s.Months) == 0 {
+		return 0
+	}
+	return s.Months[len(s.Months)-1].CumulativeSaved
+}
+
+// MonthsOverCap counts the months the cap would have cut spending
+func (s *WhatIfSimulation) MonthsOverCap() int {
+	count := 0
+	for _, month := range s.Months {
+		if month.Actual > s.MonthlyCap {
+			count++
+		}
+	}
+	return count
+}
+
+// SimulateCategoryCap replays the last closed months from the monthly reports,
+// answering "what if I had capped this category at monthlyCap?". The current
+// month is left out since it isn't over yet.
+func (uc *ReportUseCase) SimulateCategoryCap(ctx context.Context, category entity.TransactionCategory, monthlyCap float64, months int, now time.Time) (*WhatIfSimulation, error) {
+	if monthlyCap < 0 {
+		return nil, fmt.Errorf("monthly cap cannot be negative")
+	}
+	if months < 1 {
+		return nil, fmt.Errorf("simulate at least one month")
+	}
+
+	simulation := &WhatIfSimulation{Category: category, MonthlyCap: monthlyCap}
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -months, 0)
+
+	var cumulative float64
+	for i := 0; i < months; i++ {
+		month := first.AddDate(0, i, 0)
+		report, err := uc.GetMonthlyReport(ctx, month.Year(), month.Month())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the %s report: %w", month.Format("2006-01"), err)
+		}
+
+		var actual float64
+		if breakdown, ok := report["categoryBreakdown"].(map[entity.TransactionCategory]valueobject.Money); ok {
+			actual = breakdown[category].Amount()
+		}
+
+		simulated := actual
+		if simulated > monthlyCap {
+			simulated = monthlyCap
+		}
+		cumulative += actual - simulated
+
+		simulation.Months = append(simulation.Months, WhatIfMonth{
+			Month:           month,
+			Actual:          actual,
+			Simulated:       simulated,
+			CumulativeSaved: cumulative,
+		})
+	}
+
+	return simulation, nil
+}
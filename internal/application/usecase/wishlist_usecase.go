@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// cashFlowLookbackMonths is how many closed months are averaged to project the monthly cash flow
+const cashFlowLookbackMonths = 3
+
+// CashFlowProjection is the cash on hand today and the average monthly net flow
+// of the checking and savings accounts
+type CashFlowProjection struct {
+	CurrentCash valueobject.Money
+	MonthlyNet  valueobject.Money
+}
+
+// WishlistAffordability tells whether a planned purchase fits the projected cash
+// by its target date, once every higher priority item has been paid for
+type WishlistAffordability struct {
+	Item          *entity.WishlistItem
+	ProjectedCash valueobject.Money
+	Shortfall     valueobject.Money
+	Affordable    bool
+	AffordableOn  *time.Time // Earliest month the item fits, nil if the cash flow never covers it
+}
+
+type WishlistUseCase struct {
+	wishlistRepo       repository.WishlistRepository
+	accountRepo        repository.AccountRepository
+	transactionRepo    repository.TransactionRepository
+	transactionUseCase *TransactionUseCase
+}
+
+func NewWishlistUseCase(
+	wishlistRepo repository.WishlistRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	transactionUseCase *TransactionUseCase,
+) *WishlistUseCase {
+	return &WishlistUseCase{
+		wishlistRepo:       wishlistRepo,
+		accountRepo:        accountRepo,
+		transactionRepo:    transactionRepo,
+		transactionUseCase: transactionUseCase,
+	}
+}
+
+func (uc *WishlistUseCase) AddItem(ctx context.Context, name string, estimatedCost float64, currency string, priority entity.WishlistPriority, targetDate time.Time, category entity.TransactionCategory) (*entity.WishlistItem, error) {
+	money := valueobject.NewMoney(estimatedCost, currency)
+
+	item, err := entity.NewWishlistItem(name, money, priority, targetDate, category)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.wishlistRepo.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to create wishlist item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (uc *WishlistUseCase) ListItems(ctx context.Context) ([]*entity.WishlistItem, error) {
+	return uc.wishlistRepo.FindAll(ctx)
+}
+
+func (uc *WishlistUseCase) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	return uc.wishlistRepo.Delete(ctx, id)
+}
+
+// PurchaseItem records the purchase of a planned item as a real expense on the
+// given account or credit card and links the transaction back to the item
+func (uc *WishlistUseCase) PurchaseItem(ctx context.Context, itemID uuid.UUID, accountID, creditCardID *uuid.UUID, amount float64, date time.Time) (*entity.Transaction, error) {
+	item, err := uc.wishlistRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist item: %w", err)
+	}
+
+	if item.IsPurchased() {
+		return nil, fmt.Errorf("item was already purchased")
+	}
+
+	txn, err := uc.transactionUseCase.CreateTransaction(ctx, accountID, creditCardID, entity.TransactionTypeDebit, item.Category, amount, item.EstimatedCost.Currency(), item.Name, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := item.MarkPurchased(txn.ID, date); err != nil {
+		return nil, err
+	}
+
+	if err := uc.wishlistRepo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update wishlist item: %w", err)
+	}
+
+	return txn, nil
+}
+
+// ProjectCashFlow sums the cash held in checking and savings accounts and
+// averages their net flow over the last closed months
+func (uc *WishlistUseCase) ProjectCashFlow(ctx context.Context, now time.Time) (*CashFlowProjection, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	currency := "BRL"
+	cash := 0.0
+	cashAccounts := make(map[uuid.UUID]bool)
+	for _, account := range accounts {
+		if account.Type == entity.AccountTypeInvestment {
+			continue
+		}
+		cashAccounts[account.ID] = true
+		cash += account.GetAvailableBalance().Amount()
+		currency = account.Balance.Currency()
+	}
+
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	since := currentMonth.AddDate(0, -cashFlowLookbackMonths, 0)
+
+	transactions, err := uc.transactionRepo.FindByDateRange(ctx, since, currentMonth.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	net := 0.0
+	for _, txn := range transactions {
+		// Transfers only move money between the user's own accounts
+		if txn.AccountID == nil || !cashAccounts[*txn.AccountID] || txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+
+		if txn.Type == entity.TransactionTypeCredit {
+			net += txn.Amount.Amount()
+		} else {
+			net -= txn.Amount.Amount()
+		}
+	}
+
+	return &CashFlowProjection{
+		CurrentCash: valueobject.NewMoney(cash, currency),
+		MonthlyNet:  valueobject.NewMoney(net/cashFlowLookbackMonths, currency),
+	}, nil
+}
+
+// AnalyzeAffordability checks every planned item against the projected cash flow.
+// Items are funded in priority order, then by target date, so a lower priority
+// item only counts on the cash left after the ones ahead of it.
+func (uc *WishlistUseCase) AnalyzeAffordability(ctx context.Context, now time.Time) (*CashFlowProjection, []*WishlistAffordability, error) {
+	items, err := uc.wishlistRepo.FindAll(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	projection, err := uc.ProjectCashFlow(ctx, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var planned []*entity.WishlistItem
+	for _, item := range items {
+		if !item.IsPurchased() {
+			planned = append(planned, item)
+		}
+	}
+
+	sort.SliceStable(planned, func(i, j int) bool {
+		if planned[i].Priority.Rank() != planned[j].Priority.Rank() {
+			return planned[i].Priority.Rank() < planned[j].Priority.Rank()
+		}
+		return planned[i].TargetDate.Before(planned[j].TargetDate)
+	})
+
+	currency := projection.CurrentCash.Currency()
+	cash := projection.CurrentCash.Amount()
+	monthlyNet := projection.MonthlyNet.Amount()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	committed := 0.0
+	results := make([]*WishlistAffordability, 0, len(planned))
+	for _, item := range planned {
+		cost := item.EstimatedCost.Amount()
+
+		monthsAhead := monthsBetween(currentMonth, item.TargetDate)
+		if monthsAhead < 0 {
+			monthsAhead = 0
+		}
+
+		projected := cash + monthlyNet*float64(monthsAhead) - committed
+		result := &WishlistAffordability{
+			Item:          item,
+			ProjectedCash: valueobject.NewMoney(projected, currency),
+			Shortfall:     valueobject.NewMoney(0, currency),
+			Affordable:    projected >= cost,
+		}
+
+		if result.Affordable {
+			target := item.TargetDate
+			result.AffordableOn = &target
+		} else {
+			result.Shortfall = valueobject.NewMoney(cost-projected, currency)
+			if monthlyNet > 0 {
+				months := int(math.Ceil((committed + cost - cash) / monthlyNet))
+				date := currentMonth.AddDate(0, months, 0)
+				result.AffordableOn = &date
+			}
+		}
+
+		committed += cost
+		results = append(results, result)
+	}
+
+	return projection, results, nil
+}
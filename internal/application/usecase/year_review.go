@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+)
+
+// yearReviewTopCategories is how many categories the year review ranks
+const yearReviewTopCategories = 5
+
+// YearReview sums up a year of spending, "wrapped" style
+type YearReview struct {
+	Year     int
+	Income   float64
+	Expenses float64
+	// Count of the year's expenses
+	Purchases int
+	// TopCategories are the largest expense categories, largest first
+	TopCategories []YearReviewCategory
+	// BiggestPurchase is the year's largest expense, nil without any
+	BiggestPurchase *entity.Transaction
+	// TopMerchant is where the most purchases were made
+	TopMerchant *YearReviewMerchant
+	// Months are January to December of the year
+	Months []YearReviewMonth
+}
+
+type YearReviewCategory struct {
+	Category entity.TransactionCategory
+	Total    float64
+	// Share of the year's expenses, in percent
+	Share float64
+}
+
+type YearReviewMerchant struct {
+	Name      string
+	Purchases int
+	Total     float64
+}
+
+// YearReviewMonth is one month of the year review
+type YearReviewMonth struct {
+	Month    time.Time
+	Income   float64
+	Expenses float64
+	// SavingsRate is the share of the month's income left after its expenses,
+	// in percent, and YearToDateRate that of the year so far. Both are zero
+	// without income.
+	SavingsRate    float64
+	YearToDateRate float64
+}
+
+// Net is what the month saved, negative when it spent more than it earned
+func (m YearReviewMonth) Net() float64 {
+	return m.Income - m.Expenses
+}
+
+// Active tells whether the month had any income or expenses
+func (m YearReviewMonth) Active() bool {
+	return m.Income != 0 || m.Expenses != 0
+}
+
+// Savings is what the year saved
+func (r *YearReview) Savings() float64 {
+	return r.Income - r.Expenses
+}
+
+// SavingsRate is the share of the year's income it saved, in percent
+func (r *YearReview) SavingsRate() float64 {
+	return savingsRate(r.Income, r.Expenses)
+}
+
+// BestMonth and WorstMonth are the active months that saved the most and the
+// least. There are none when no month was active.
+func (r *YearReview) BestMonth() (YearReviewMonth, bool) {
+	return r.extremeMonth(func(a, b YearReviewMonth) bool { return a.Net() > b.Net() })
+}
+
+func (r *YearReview) WorstMonth() (YearReviewMonth, bool) {
+	return r.extremeMonth(func(a, b YearReviewMonth) bool { return a.Net() < b.Net() })
+}
+
+func (r *YearReview) extremeMonth(better func(a, b YearReviewMonth) bool) (YearReviewMonth, bool) {
+	var extreme YearReviewMonth
+	found := false
+	for _, month := range r.Months {
+		if month.Active() && (!found || better(month, extreme)) {
+			extreme = month
+			found = true
+		}
+	}
+	return extreme, found
+}
+
+func savingsRate(income, expenses float64) float64 {
+	if income == 0 {
+		return 0
+	}
+	return (income - expenses) / income * 100
+}
+
+// GetYearReview sums up the year's income and spending: the largest categories,
+// the biggest purchase, the most frequent merchant and how the savings rate
+// went month by month. Transfers between the user's own accounts count as neither.
+func (uc *ReportUseCase) GetYearReview(ctx context.Context, year int) (*YearReview, error) {
+	key := fmt.Sprintf("year-review:%d", year)
+	review, err := uc.cached(key, func() (interface{}, error) {
+		return uc.computeYearReview(ctx, year)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review.(*YearReview), nil
+}
+
+func (uc *ReportUseCase) computeYearReview(ctx context.Context, year int) (*YearReview, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	transactions, err := uc.findByDateRange(ctx, start, start.AddDate(1, 0, 0).Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+
+	review := &YearReview{Year: year}
+	for i := 0; i < 12; i++ {
+		review.Months = append(review.Months, YearReviewMonth{Month: start.AddDate(0, i, 0)})
+	}
+
+	categories := make(map[entity.TransactionCategory]float64)
+	merchants := make(map[string]*YearReviewMerchant)
+	for _, txn := range transactions {
+		if txn.Category == entity.TransactionCategoryTransfer || (uc.excludeIgnored && txn.IgnoreFromBudget) {
+			continue
+		}
+		month := &review.Months[txn.Date.In(start.Location()).Month()-1]
+		amount := txn.Amount.Amount()
+
+		if txn.Type == entity.TransactionTypeCredit {
+			review.Income += amount
+			month.Income += amount
+			continue
+		}
+		review.Expenses += amount
+		review.Purchases++
+		month.Expenses += amount
+		categories[txn.Category] += amount
+
+		if review.BiggestPurchase == nil || amount > review.BiggestPurchase.Amount.Amount() {
+			review.BiggestPurchase = txn
+		}
+
+		// The venue names the merchant best; the description, without any
+		// installment suffix, stands in for it
+		name := txn.Venue
+		if name == "" {
+			name, _ = splitInstallment(txn.Description)
+		}
+		key := normalizeDescription(name)
+		if key == "" {
+			continue
+		}
+		merchant, ok := merchants[key]
+		if !ok {
+			merchant = &YearReviewMerchant{Name: strings.TrimSpace(name)}
+			merchants[key] = merchant
+		}
+		merchant.Purchases++
+		merchant.Total += amount
+	}
+
+	var income, expenses float64
+	for i := range review.Months {
+		month := &review.Months[i]
+		income += month.Income
+		expenses += month.Expenses
+		month.SavingsRate = savingsRate(month.Income, month.Expenses)
+		month.YearToDateRate = savingsRate(income, expenses)
+	}
+
+	for category, total := range categories {
+		review.TopCategories = append(review.TopCategories, YearReviewCategory{
+			Category: category,
+			Total:    total,
+			Share:    total / review.Expenses * 100,
+		})
+	}
+	sort.Slice(review.TopCategories, func(i, j int) bool {
+		if review.TopCategories[i].Total != review.TopCategories[j].Total {
+			return review.TopCategories[i].Total > review.TopCategories[j].Total
+		}
+		return review.TopCategories[i].Category < review.TopCategories[j].Category
+	})
+	if len(review.TopCategories) > yearReviewTopCategories {
+		review.TopCategories = review.TopCategories[:yearReviewTopCategories]
+	}
+
+	for _, merchant := range merchants {
+		top := review.TopMerchant
+		if top == nil || merchant.Purchases > top.Purchases ||
+			(merchant.Purchases == top.Purchases && (merchant.Total > top.Total || (merchant.Total == top.Total && merchant.Name < top.Name))) {
+			review.TopMerchant = merchant
+		}
+	}
+
+	return review, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"financli/internal/domain/valueobject"
+)
+
+// YearReviewExportUseCase writes the year review as a Markdown document, to
+// keep or share outside the TUI
+type YearReviewExportUseCase struct {
+	reportUseCase *ReportUseCase
+	outputDir     string
+}
+
+func NewYearReviewExportUseCase(reportUseCase *ReportUseCase, outputDir string) *YearReviewExportUseCase {
+	return &YearReviewExportUseCase{
+		reportUseCase: reportUseCase,
+		outputDir:     outputDir,
+	}
+}
+
+// ExportYearReview writes the review of year to the export directory and
+// returns the path of the created file
+func (uc *YearReviewExportUseCase) ExportYearReview(ctx context.Context, year int) (string, error) {
+	review, err := uc.reportUseCase.GetYearReview(ctx, year)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(uc.outputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+	path := filepath.Join(uc.outputDir, fmt.Sprintf("year-review-%d.md", year))
+	if err := os.WriteFile(path, []byte(renderYearReviewMarkdown(review)), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write year review: %w", err)
+	}
+	return path, nil
+}
+
+func renderYearReviewMarkdown(review *YearReview) string {
+	brl := func(amount float64) string { return formatBRL(valueobject.NewMoney(amount, "BRL")) }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %d in Review\n\n", review.Year)
+
+	b.WriteString("## The Year in Numbers\n\n")
+	fmt.Fprintf(&b, "- **Total spent:** %s over %d purchases\n", brl(review.Expenses), review.Purchases)
+	fmt.Fprintf(&b, "- **Income:** %s\n", brl(review.Income))
+	fmt.Fprintf(&b, "- **Saved:** %s (%.1f%% of income)\n\n", brl(review.Savings()), review.SavingsRate())
+
+	b.WriteString("## Top Categories\n\n")
+	if len(review.TopCategories) == 0 {
+		b.WriteString("No spending recorded.\n\n")
+	} else {
+		b.WriteString("| # | Category | Spent | Share |\n| ---: | --- | ---: | ---: |\n")
+		for i, category := range review.TopCategories {
+			fmt.Fprintf(&b, "| %d | %s | %s | %.1f%% |\n", i+1, category.Category, brl(category.Total), category.Share)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Highlights\n\n")
+	if purchase := review.BiggestPurchase; purchase != nil {
+		fmt.Fprintf(&b, "- **Biggest purchase:** %s, %s on %s\n", purchase.Description, brl(purchase.Amount.Amount()), purchase.Date.Format("02/01/2006"))
+	}
+	if merchant := review.TopMerchant; merchant != nil {
+		fmt.Fprintf(&b, "- **Most frequent merchant:** %s, %d purchases totaling %s\n", merchant.Name, merchant.Purchases, brl(merchant.Total))
+	}
+	if best, ok := review.BestMonth(); ok {
+		fmt.Fprintf(&b, "- **Best month:** %s, saving %s\n", best.Month.Format("January"), brl(best.Net()))
+	}
+	if worst, ok := review.WorstMonth(); ok {
+		fmt.Fprintf(&b, "- **Worst month:** %s, saving %s\n", worst.Month.Format("January"), brl(worst.Net()))
+	}
+	b.WriteString("\n")
+
+	b.WriteString("## Savings Rate\n\n")
+	b.WriteString("| Month | Income | Expenses | Savings Rate | Year to Date |\n| --- | ---: | ---: | ---: | ---: |\n")
+	for _, month := range review.Months {
+		if !month.Active() {
+			continue
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %.1f%% | %.1f%% |\n", month.Month.Format("January"),
+			brl(month.Income), brl(month.Expenses), month.SavingsRate, month.YearToDateRate)
+	}
+
+	return b.String()
+}
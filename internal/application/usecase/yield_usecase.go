@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type YieldUseCase struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	cdiAnnualRate   float64
+	periods         *PeriodLockUseCase
+}
+
+func NewYieldUseCase(accountRepo repository.AccountRepository, transactionRepo repository.TransactionRepository, cdiAnnualRate float64) *YieldUseCase {
+	return &YieldUseCase{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		cdiAnnualRate:   cdiAnnualRate,
+	}
+}
+
+// SetPeriodLocks keeps interest from being credited to months whose books
+// are closed; accruing stops at the first one until it's reopened
+func (uc *YieldUseCase) SetPeriodLocks(periods *PeriodLockUseCase) {
+	uc.periods = periods
+}
+
+// SetAccountYield configures how much interest an account earns each month
+func (uc *YieldUseCase) SetAccountYield(ctx context.Context, accountID uuid.UUID, yieldType entity.YieldType, rate float64) error {
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	if err := account.SetYield(yieldType, rate); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return nil
+}
+
+// EstimateMonthlyYield returns what the account would earn this month at its current balance
+func (uc *YieldUseCase) EstimateMonthlyYield(account *entity.Account) float64 {
+	return account.CalculateMonthlyYield(uc.cdiAnnualRate).Amount()
+}
+
+// AccrueMonthlyYield posts an interest credit for every closed month that hasn't
+// been credited yet on each yielding account. Months missed while the app wasn't
+// running are caught up in order, compounding on the previous credit.
+func (uc *YieldUseCase) AccrueMonthlyYield(ctx context.Context, now time.Time) ([]*entity.Transaction, error) {
+	accounts, err := uc.accountRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	var posted []*entity.Transaction
+	for _, account := range accounts {
+		if !account.HasYield() {
+			continue
+		}
+
+		transactions, err := uc.accrueAccount(ctx, account, currentMonth)
+		posted = append(posted, transactions...)
+		if err != nil {
+			return posted, fmt.Errorf("failed to accrue yield for %s: %w", account.Name, err)
+		}
+	}
+
+	return posted, nil
+}
+
+func (uc *YieldUseCase) accrueAccount(ctx context.Context, account *entity.Account, currentMonth time.Time) ([]*entity.Transaction, error) {
+	month := currentMonth.AddDate(0, -1, 0)
+	if account.LastYieldMonth != "" {
+		last, err := time.ParseInLocation("2006-01", account.LastYieldMonth, currentMonth.Location())
+		if err != nil {
+			return nil, fmt.Errorf("invalid last yield month: %w", err)
+		}
+		month = last.AddDate(0, 1, 0)
+	}
+
+	var posted []*entity.Transaction
+	for ; month.Before(currentMonth); month = month.AddDate(0, 1, 0) {
+		referenceMonth := month.Format("2006-01")
+		amount := account.CalculateMonthlyYield(uc.cdiAnnualRate)
+		closingDay := month.AddDate(0, 1, -1)
+		if !amount.IsZero() {
+			if err := uc.periods.CheckOpen(ctx, closingDay); err != nil {
+				return posted, err
+			}
+		}
+
+		if err := account.CreditYield(referenceMonth, amount); err != nil {
+			return posted, err
+		}
+
+		var transaction *entity.Transaction
+		if !amount.IsZero() {
+			transaction = entity.NewTransaction(&account.ID, nil, entity.TransactionTypeCredit, entity.TransactionCategoryIncome,
+				amount, fmt.Sprintf("Interest %s (%s)", referenceMonth, yieldDescription(account)), closingDay)
+		}
+
+		// Persist the account first so a failure never leaves a credit without its balance
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return posted, fmt.Errorf("failed to update account: %w", err)
+		}
+
+		if transaction != nil {
+			if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+				return posted, fmt.Errorf("failed to create interest transaction: %w", err)
+			}
+			posted = append(posted, transaction)
+		}
+	}
+
+	return posted, nil
+}
+
+func yieldDescription(account *entity.Account) string {
+	switch account.YieldType {
+	case entity.YieldTypeCDI:
+		return fmt.Sprintf("%g%% CDI", account.YieldRate)
+	case entity.YieldTypeFixed:
+		return fmt.Sprintf("%g%% a.m.", account.YieldRate)
+	default:
+		return "no yield"
+	}
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"financli/internal/domain/valueobject"
@@ -16,14 +17,46 @@ const (
 	AccountTypeInvestment AccountType = "investment"
 )
 
+// YieldType defines how an account's monthly interest is calculated
+type YieldType string
+
+const (
+	YieldTypeNone  YieldType = ""
+	YieldTypeCDI   YieldType = "cdi"   // YieldRate is a percentage of the CDI rate (e.g. 100)
+	YieldTypeFixed YieldType = "fixed" // YieldRate is a fixed monthly percentage (e.g. 0.8)
+)
+
 type Account struct {
 	ID          uuid.UUID
 	Name        string
 	Type        AccountType
 	Balance     valueobject.Money
 	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+
+	// Pre-filled in the transaction form when this account is the source
+	DefaultCategory        TransactionCategory
+	DefaultTransactionType TransactionType
+
+	// Monthly interest credited at month close
+	YieldType      YieldType
+	YieldRate      float64
+	LastYieldMonth string // Reference month (YYYY-MM) of the last interest credit
+
+	// Monthly bank fees and the charges they posted
+	Fees       []AccountFee
+	FeeCharges []FeeCharge
+
+	// Low balance alert threshold; nil when the account has none
+	MinimumBalance *valueobject.Money
+
+	// Overdraft (cheque especial) of a checking account and the interest it charged
+	OverdraftLimit    valueobject.Money
+	OverdraftRate     float64 // Monthly interest percentage (e.g. 8 for 8% a.m.)
+	LastOverdraftDay  string  // Day (YYYY-MM-DD) up to which overdraft interest was charged
+	OverdraftInterest []OverdraftInterest
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func NewAccount(name string, accountType AccountType, initialBalance valueobject.Money, description string) *Account {
@@ -57,11 +90,120 @@ func (a *Account) Withdraw(amount valueobject.Money) error {
 	if newBalance.IsNegative() && a.Type != AccountTypeChecking {
 		return fmt.Errorf("insufficient funds: balance would be %s", newBalance.String())
 	}
+	if a.HasOverdraft() && newBalance.Amount() < -a.OverdraftLimit.Amount() {
+		return fmt.Errorf("exceeds the overdraft limit of %s: balance would be %s", a.OverdraftLimit.String(), newBalance.String())
+	}
 	a.Balance = newBalance
 	a.UpdatedAt = time.Now()
 	return nil
 }
 
+// SetTransactionDefaults sets the category and type suggested for new transactions;
+// empty values clear the default
+func (a *Account) SetTransactionDefaults(category TransactionCategory, transactionType TransactionType) {
+	a.DefaultCategory = category
+	a.DefaultTransactionType = transactionType
+	a.UpdatedAt = time.Now()
+}
+
+// SetYield configures the monthly interest of a savings or investment account;
+// YieldTypeNone disables it
+func (a *Account) SetYield(yieldType YieldType, rate float64) error {
+	switch yieldType {
+	case YieldTypeNone:
+		// Forget the last credited month so re-enabling doesn't back-credit the disabled period
+		rate = 0
+		a.LastYieldMonth = ""
+	case YieldTypeCDI, YieldTypeFixed:
+		if a.Type == AccountTypeChecking {
+			return fmt.Errorf("yield is only available for savings and investment accounts")
+		}
+		if rate <= 0 {
+			return fmt.Errorf("yield rate must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid yield type: %s", yieldType)
+	}
+
+	// Interest starts accruing in the current month, so earlier months are never credited
+	if a.LastYieldMonth == "" && yieldType != YieldTypeNone {
+		now := time.Now()
+		a.LastYieldMonth = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, now.Location()).Format("2006-01")
+	}
+
+	a.YieldType = yieldType
+	a.YieldRate = rate
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+func (a *Account) HasYield() bool {
+	return a.YieldType != YieldTypeNone && a.YieldRate > 0
+}
+
+// MonthlyYieldRate returns the fraction of the balance credited per month. CDI
+// yields convert the annual CDI rate to its monthly equivalent before applying
+// the configured percentage.
+func (a *Account) MonthlyYieldRate(cdiAnnualRate float64) float64 {
+	switch a.YieldType {
+	case YieldTypeCDI:
+		monthlyCDI := math.Pow(1+cdiAnnualRate/100, 1.0/12) - 1
+		return monthlyCDI * a.YieldRate / 100
+	case YieldTypeFixed:
+		return a.YieldRate / 100
+	default:
+		return 0
+	}
+}
+
+// CalculateMonthlyYield returns the interest earned by the current balance over one month
+func (a *Account) CalculateMonthlyYield(cdiAnnualRate float64) valueobject.Money {
+	if !a.HasYield() || a.Balance.IsNegative() || a.Balance.IsZero() {
+		return valueobject.NewMoney(0, a.Balance.Currency())
+	}
+	return a.Balance.Multiply(a.MonthlyYieldRate(cdiAnnualRate))
+}
+
+// CreditYield deposits the interest for the given reference month and records
+// it so the same month is never credited twice
+func (a *Account) CreditYield(referenceMonth string, amount valueobject.Money) error {
+	if a.LastYieldMonth != "" && referenceMonth <= a.LastYieldMonth {
+		return fmt.Errorf("yield for %s already credited", referenceMonth)
+	}
+
+	if !amount.IsZero() {
+		if err := a.Deposit(amount); err != nil {
+			return err
+		}
+	}
+
+	a.LastYieldMonth = referenceMonth
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetMinimumBalance sets the balance below which the account raises a low
+// balance alert; nil removes the alert
+func (a *Account) SetMinimumBalance(threshold *valueobject.Money) error {
+	if threshold != nil && threshold.Currency() != a.Balance.Currency() {
+		return fmt.Errorf("minimum balance must be in %s", a.Balance.Currency())
+	}
+	a.MinimumBalance = threshold
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsBelowMinimumBalance tells whether the balance dropped below the alert threshold
+func (a *Account) IsBelowMinimumBalance() bool {
+	return a.MinimumBalance != nil && a.Balance.Amount() < a.MinimumBalance.Amount()
+}
+
+// WouldDropBelowMinimumBalance tells whether withdrawing amount would leave the
+// balance below the alert threshold
+func (a *Account) WouldDropBelowMinimumBalance(amount valueobject.Money) bool {
+	return a.MinimumBalance != nil && a.Balance.Amount()-amount.Amount() < a.MinimumBalance.Amount()
+}
+
 func (a *Account) GetAvailableBalance() valueobject.Money {
 	return a.Balance
 }
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// AccountFee is a recurring bank fee, such as account maintenance or a service
+// package, charged every month on DayOfMonth
+type AccountFee struct {
+	ID               uuid.UUID
+	Name             string
+	Amount           valueobject.Money
+	DayOfMonth       int    // 1-31; shorter months are charged on their last day
+	LastChargedMonth string // Reference month (YYYY-MM) of the last charge
+}
+
+// FeeCharge is a fee posted to an account. Charges are kept on the account,
+// even after the fee is removed, for the yearly fees report.
+type FeeCharge struct {
+	FeeID   uuid.UUID
+	FeeName string
+	Month   string // Reference month (YYYY-MM)
+	Date    time.Time
+	Amount  valueobject.Money
+}
+
+// AddFee schedules a monthly fee. Charging starts at the next due date, so
+// months before the fee was added are never charged.
+func (a *Account) AddFee(name string, amount valueobject.Money, dayOfMonth int, now time.Time) (*AccountFee, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("fee name is required")
+	}
+	if amount.IsNegative() || amount.IsZero() {
+		return nil, fmt.Errorf("fee amount must be positive")
+	}
+	if amount.Currency() != a.Balance.Currency() {
+		return nil, fmt.Errorf("fee currency must match the account's")
+	}
+	if dayOfMonth < 1 || dayOfMonth > 31 {
+		return nil, fmt.Errorf("fee day must be between 1 and 31")
+	}
+
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if now.Before(feeChargeDate(month, dayOfMonth)) {
+		month = month.AddDate(0, -1, 0)
+	}
+
+	fee := AccountFee{
+		ID:               uuid.New(),
+		Name:             name,
+		Amount:           amount,
+		DayOfMonth:       dayOfMonth,
+		LastChargedMonth: month.Format("2006-01"),
+	}
+	a.Fees = append(a.Fees, fee)
+	a.UpdatedAt = time.Now()
+	return &a.Fees[len(a.Fees)-1], nil
+}
+
+func (a *Account) RemoveFee(id uuid.UUID) error {
+	for i, fee := range a.Fees {
+		if fee.ID == id {
+			a.Fees = append(a.Fees[:i], a.Fees[i+1:]...)
+			a.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("fee not found")
+}
+
+// ChargeFees debits every fee charge due up to now that wasn't charged yet.
+// Months missed while the app wasn't running are caught up in order. Banks
+// charge fees whatever the balance, so they may leave it negative.
+func (a *Account) ChargeFees(now time.Time) ([]FeeCharge, error) {
+	var charges []FeeCharge
+	for i := range a.Fees {
+		fee := &a.Fees[i]
+
+		last, err := time.ParseInLocation("2006-01", fee.LastChargedMonth, now.Location())
+		if err != nil {
+			return charges, fmt.Errorf("invalid last charged month of %s: %w", fee.Name, err)
+		}
+
+		for month := last.AddDate(0, 1, 0); ; month = month.AddDate(0, 1, 0) {
+			date := feeChargeDate(month, fee.DayOfMonth)
+			if date.After(now) {
+				break
+			}
+
+			balance, err := a.Balance.Subtract(fee.Amount)
+			if err != nil {
+				return charges, err
+			}
+			a.Balance = balance
+
+			charge := FeeCharge{
+				FeeID:   fee.ID,
+				FeeName: fee.Name,
+				Month:   month.Format("2006-01"),
+				Date:    date,
+				Amount:  fee.Amount,
+			}
+			fee.LastChargedMonth = charge.Month
+			a.FeeCharges = append(a.FeeCharges, charge)
+			charges = append(charges, charge)
+		}
+	}
+
+	if len(charges) > 0 {
+		a.UpdatedAt = time.Now()
+	}
+	return charges, nil
+}
+
+// FeesPaidIn returns the fee charges of the year
+func (a *Account) FeesPaidIn(year int) []FeeCharge {
+	prefix := fmt.Sprintf("%04d-", year)
+	var charges []FeeCharge
+	for _, charge := range a.FeeCharges {
+		if strings.HasPrefix(charge.Month, prefix) {
+			charges = append(charges, charge)
+		}
+	}
+	return charges
+}
+
+// feeChargeDate is the day a fee is due in the month, the last day for months
+// shorter than dayOfMonth
+func feeChargeDate(month time.Time, dayOfMonth int) time.Time {
+	lastDay := time.Date(month.Year(), month.Month()+1, 0, 0, 0, 0, 0, month.Location()).Day()
+	if dayOfMonth > lastDay {
+		dayOfMonth = lastDay
+	}
+	return time.Date(month.Year(), month.Month(), dayOfMonth, 0, 0, 0, 0, month.Location())
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount_AddFee(t *testing.T) {
+	account := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(100, "BRL"), "")
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	_, err := account.AddFee("", valueobject.NewMoney(30, "BRL"), 5, now)
+	assert.Error(t, err)
+	_, err = account.AddFee("Maintenance", valueobject.NewMoney(0, "BRL"), 5, now)
+	assert.Error(t, err)
+	_, err = account.AddFee("Maintenance", valueobject.NewMoney(30, "BRL"), 32, now)
+	assert.Error(t, err)
+
+	// Already past this month's due date: the first charge is next month
+	fee, err := account.AddFee("Maintenance", valueobject.NewMoney(30, "BRL"), 5, now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03", fee.LastChargedMonth)
+
+	// Still due this month
+	fee, err = account.AddFee("Package", valueobject.NewMoney(20, "BRL"), 15, now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-02", fee.LastChargedMonth)
+}
+
+func TestAccount_ChargeFees(t *testing.T) {
+	account := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(50, "BRL"), "")
+	_, err := account.AddFee("Package", valueobject.NewMoney(30, "BRL"), 31, time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// February is charged on its last day, March not before the 31st
+	charges, err := account.ChargeFees(time.Date(2026, time.March, 30, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, charges, 1)
+	assert.Equal(t, "2026-02", charges[0].Month)
+	assert.Equal(t, 28, charges[0].Date.Day())
+
+	charges, err = account.ChargeFees(time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, charges, 1)
+	assert.Equal(t, "2026-03", charges[0].Month)
+
+	// Fees are charged even when they overdraw the account
+	assert.Equal(t, -10.0, account.Balance.Amount())
+
+	// Nothing is charged twice
+	charges, err = account.ChargeFees(time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, charges)
+
+	assert.Len(t, account.FeesPaidIn(2026), 2)
+	assert.Empty(t, account.FeesPaidIn(2025))
+}
+
+func TestAccount_RemoveFee(t *testing.T) {
+	account := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(50, "BRL"), "")
+	fee, err := account.AddFee("Package", valueobject.NewMoney(30, "BRL"), 1, time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, account.RemoveFee(fee.ID))
+	assert.Empty(t, account.Fees)
+	assert.Error(t, account.RemoveFee(fee.ID))
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+)
+
+// OverdraftInterest is the interest an overdraft charged in a month, kept on
+// the account for the cost it shows
+type OverdraftInterest struct {
+	Month  string // Reference month (YYYY-MM)
+	Days   int    // Days of the month the balance was negative
+	Amount valueobject.Money
+}
+
+// SetOverdraft lets a checking account go negative down to limit, charging
+// the monthly rate on the negative balance day by day. A zero limit removes
+// the overdraft. Interest starts with today, so earlier days are never charged.
+func (a *Account) SetOverdraft(limit valueobject.Money, monthlyRate float64, now time.Time) error {
+	if limit.IsZero() {
+		a.OverdraftLimit = valueobject.NewMoney(0, a.Balance.Currency())
+		a.OverdraftRate = 0
+		a.LastOverdraftDay = ""
+		a.UpdatedAt = time.Now()
+		return nil
+	}
+
+	if a.Type != AccountTypeChecking {
+		return fmt.Errorf("overdraft is only available for checking accounts")
+	}
+	if limit.IsNegative() {
+		return fmt.Errorf("overdraft limit must be positive")
+	}
+	if limit.Currency() != a.Balance.Currency() {
+		return fmt.Errorf("overdraft limit currency must match the account's")
+	}
+	if monthlyRate < 0 {
+		return fmt.Errorf("overdraft rate can't be negative")
+	}
+
+	if a.LastOverdraftDay == "" {
+		a.LastOverdraftDay = now.AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	a.OverdraftLimit = limit
+	a.OverdraftRate = monthlyRate
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasOverdraft tells whether the account may go negative
+func (a *Account) HasOverdraft() bool {
+	return a.OverdraftLimit.Amount() > 0
+}
+
+// IsInOverdraft tells whether the account is using its overdraft
+func (a *Account) IsInOverdraft() bool {
+	return a.HasOverdraft() && a.Balance.IsNegative()
+}
+
+// OverdraftUsed returns how much of the overdraft the balance is using
+func (a *Account) OverdraftUsed() float64 {
+	return math.Max(-a.Balance.Amount(), 0)
+}
+
+// DailyOverdraftRate converts the monthly rate to its daily equivalent, over
+// 30-day months as banks quote it
+func (a *Account) DailyOverdraftRate() float64 {
+	return math.Pow(1+a.OverdraftRate/100, 1.0/30) - 1
+}
+
+// DailyOverdraftCost returns what a day at the current balance costs in interest
+func (a *Account) DailyOverdraftCost() float64 {
+	if !a.HasOverdraft() {
+		return 0
+	}
+	return a.OverdraftUsed() * a.DailyOverdraftRate()
+}
+
+// ChargeOverdraftInterest debits the interest of day on the balance the account
+// closed that day with, nothing when it wasn't negative, and records the day so
+// it's never charged twice. The interest may take the balance past the limit,
+// as banks charge it regardless.
+func (a *Account) ChargeOverdraftInterest(day time.Time, closingBalance float64) (valueobject.Money, error) {
+	key := day.Format("2006-01-02")
+	if a.LastOverdraftDay != "" && key <= a.LastOverdraftDay {
+		return valueobject.Money{}, fmt.Errorf("overdraft interest for %s already charged", key)
+	}
+	a.LastOverdraftDay = key
+	a.UpdatedAt = time.Now()
+
+	if closingBalance >= 0 || a.OverdraftRate == 0 {
+		return valueobject.NewMoney(0, a.Balance.Currency()), nil
+	}
+
+	interest := valueobject.NewMoney(math.Round(-closingBalance*a.DailyOverdraftRate()*100)/100, a.Balance.Currency())
+	if interest.IsZero() {
+		return interest, nil
+	}
+	balance, err := a.Balance.Subtract(interest)
+	if err != nil {
+		return interest, err
+	}
+	a.Balance = balance
+
+	month := day.Format("2006-01")
+	if last := len(a.OverdraftInterest) - 1; last >= 0 && a.OverdraftInterest[last].Month == month {
+		total, err := a.OverdraftInterest[last].Amount.Add(interest)
+		if err != nil {
+			return interest, err
+		}
+		a.OverdraftInterest[last].Amount = total
+		a.OverdraftInterest[last].Days++
+	} else {
+		a.OverdraftInterest = append(a.OverdraftInterest, OverdraftInterest{Month: month, Days: 1, Amount: interest})
+	}
+	return interest, nil
+}
+
+// OverdraftInterestIn returns the overdraft interest charged in the months
+// starting with prefix, a month (YYYY-MM) or a year (YYYY)
+func (a *Account) OverdraftInterestIn(prefix string) float64 {
+	var total float64
+	for _, interest := range a.OverdraftInterest {
+		if strings.HasPrefix(interest.Month, prefix) {
+			total += interest.Amount.Amount()
+		}
+	}
+	return total
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount_SetOverdraft(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	savings := NewAccount("Savings", AccountTypeSavings, valueobject.NewMoney(100, "BRL"), "")
+	assert.Error(t, savings.SetOverdraft(valueobject.NewMoney(500, "BRL"), 8, now))
+
+	account := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(100, "BRL"), "")
+	assert.Error(t, account.SetOverdraft(valueobject.NewMoney(-500, "BRL"), 8, now))
+	assert.Error(t, account.SetOverdraft(valueobject.NewMoney(500, "USD"), 8, now))
+	assert.Error(t, account.SetOverdraft(valueobject.NewMoney(500, "BRL"), -1, now))
+
+	require.NoError(t, account.SetOverdraft(valueobject.NewMoney(500, "BRL"), 8, now))
+	assert.True(t, account.HasOverdraft())
+	assert.Equal(t, "2024-03-09", account.LastOverdraftDay)
+
+	assert.Error(t, account.Withdraw(valueobject.NewMoney(601, "BRL")))
+	require.NoError(t, account.Withdraw(valueobject.NewMoney(600, "BRL")))
+	assert.True(t, account.IsInOverdraft())
+	assert.Equal(t, 500.0, account.OverdraftUsed())
+
+	require.NoError(t, account.SetOverdraft(valueobject.NewMoney(0, "BRL"), 0, now))
+	assert.False(t, account.HasOverdraft())
+	assert.Empty(t, account.LastOverdraftDay)
+}
+
+func TestAccount_ChargeOverdraftInterest(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	account := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(-1000, "BRL"), "")
+	require.NoError(t, account.SetOverdraft(valueobject.NewMoney(2000, "BRL"), 8, day))
+
+	interest, err := account.ChargeOverdraftInterest(day, -1000)
+	require.NoError(t, err)
+	assert.Equal(t, 2.57, interest.Amount())
+	assert.InDelta(t, -1002.57, account.Balance.Amount(), 0.001)
+
+	_, err = account.ChargeOverdraftInterest(day, -1000)
+	assert.Error(t, err)
+
+	// A day closed above zero costs nothing
+	interest, err = account.ChargeOverdraftInterest(day.AddDate(0, 0, 1), 50)
+	require.NoError(t, err)
+	assert.True(t, interest.IsZero())
+
+	_, err = account.ChargeOverdraftInterest(day.AddDate(0, 0, 2), -1000)
+	require.NoError(t, err)
+	_, err = account.ChargeOverdraftInterest(day.AddDate(0, 1, 0), -1000)
+	require.NoError(t, err)
+
+	require.Len(t, account.OverdraftInterest, 2)
+	assert.Equal(t, "2024-03", account.OverdraftInterest[0].Month)
+	assert.Equal(t, 2, account.OverdraftInterest[0].Days)
+	assert.InDelta(t, 5.14, account.OverdraftInterestIn("2024-03"), 0.001)
+	assert.InDelta(t, 7.71, account.OverdraftInterestIn("2024"), 0.001)
+}
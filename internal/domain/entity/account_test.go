@@ -63,3 +63,48 @@ func TestAccount_CheckingAllowsNegativeBalance(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, -500.0, account.Balance.Amount())
 }
+
+func TestAccount_CalculateMonthlyYield(t *testing.T) {
+	account := NewAccount("Reserve", AccountTypeSavings, valueobject.NewMoney(10000.0, "BRL"), "")
+
+	require.NoError(t, account.SetYield(YieldTypeFixed, 1.0))
+	assert.Equal(t, 100.0, account.CalculateMonthlyYield(0).Amount())
+
+	// 12.68% a year compounds to roughly 1% a month
+	require.NoError(t, account.SetYield(YieldTypeCDI, 100))
+	assert.InDelta(t, 100.0, account.CalculateMonthlyYield(12.68).Amount(), 0.1)
+
+	checking := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(1000.0, "BRL"), "")
+	assert.Error(t, checking.SetYield(YieldTypeFixed, 1.0))
+}
+
+func TestAccount_CreditYield(t *testing.T) {
+	account := NewAccount("Reserve", AccountTypeSavings, valueobject.NewMoney(1000.0, "BRL"), "")
+
+	require.NoError(t, account.CreditYield("2024-05", valueobject.NewMoney(10.0, "BRL")))
+	assert.Equal(t, 1010.0, account.Balance.Amount())
+	assert.Equal(t, "2024-05", account.LastYieldMonth)
+
+	err := account.CreditYield("2024-05", valueobject.NewMoney(10.0, "BRL"))
+	assert.Error(t, err)
+	assert.Equal(t, 1010.0, account.Balance.Amount())
+}
+
+func TestAccount_MinimumBalance(t *testing.T) {
+	account := NewAccount("Test Account", AccountTypeChecking, valueobject.NewMoney(1000.0, "BRL"), "Test")
+	assert.False(t, account.WouldDropBelowMinimumBalance(valueobject.NewMoney(5000.0, "BRL")))
+
+	minimum := valueobject.NewMoney(500.0, "BRL")
+	require.NoError(t, account.SetMinimumBalance(&minimum))
+	assert.False(t, account.WouldDropBelowMinimumBalance(valueobject.NewMoney(500.0, "BRL")))
+	assert.True(t, account.WouldDropBelowMinimumBalance(valueobject.NewMoney(500.01, "BRL")))
+
+	require.NoError(t, account.Withdraw(valueobject.NewMoney(600.0, "BRL")))
+	assert.True(t, account.IsBelowMinimumBalance())
+
+	dollars := valueobject.NewMoney(100.0, "USD")
+	assert.Error(t, account.SetMinimumBalance(&dollars))
+
+	require.NoError(t, account.SetMinimumBalance(nil))
+	assert.False(t, account.IsBelowMinimumBalance())
+}
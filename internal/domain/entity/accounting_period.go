@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PeriodAction string
+
+const (
+	PeriodActionClosed   PeriodAction = "closed"
+	PeriodActionReopened PeriodAction = "reopened"
+)
+
+// PeriodLogEntry records one closing or reopening of a month's books
+type PeriodLogEntry struct {
+	Action PeriodAction
+	// Reason is why the books were reopened; closing doesn't need one
+	Reason string
+	At     time.Time
+}
+
+// AccountingPeriod is a month whose books can be closed. The transactions of a
+// closed month can't be added, edited or deleted, so its reports stay as they
+// were; reopening it takes a reason, kept in the log with every closing.
+type AccountingPeriod struct {
+	ID uuid.UUID
+	// Month is the first day of the month
+	Month     time.Time
+	Closed    bool
+	Log       []PeriodLogEntry
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewAccountingPeriod returns the open period of the month date falls in
+func NewAccountingPeriod(date time.Time) *AccountingPeriod {
+	now := time.Now()
+	return &AccountingPeriod{
+		ID:        uuid.New(),
+		Month:     PeriodMonth(date),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// PeriodMonth returns the first day of the month date falls in
+func PeriodMonth(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
+
+// Key identifies the month as YYYY-MM
+func (p *AccountingPeriod) Key() string {
+	return p.Month.Format("2006-01")
+}
+
+// Contains tells whether date falls in the period's month
+func (p *AccountingPeriod) Contains(date time.Time) bool {
+	date = date.In(p.Month.Location())
+	return date.Year() == p.Month.Year() && date.Month() == p.Month.Month()
+}
+
+// Close locks the month's transactions
+func (p *AccountingPeriod) Close(now time.Time) error {
+	if p.Closed {
+		return fmt.Errorf("the books of %s are already closed", p.Month.Format("01/2006"))
+	}
+
+	p.Closed = true
+	p.Log = append(p.Log, PeriodLogEntry{Action: PeriodActionClosed, At: now})
+	p.UpdatedAt = now
+	return nil
+}
+
+// Reopen unlocks the month's transactions, logging why
+func (p *AccountingPeriod) Reopen(reason string, now time.Time) error {
+	if !p.Closed {
+		return fmt.Errorf("the books of %s are not closed", p.Month.Format("01/2006"))
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return fmt.Errorf("a reason is required to reopen closed books")
+	}
+
+	p.Closed = false
+	p.Log = append(p.Log, PeriodLogEntry{Action: PeriodActionReopened, Reason: reason, At: now})
+	p.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountingPeriod(t *testing.T) {
+	period := NewAccountingPeriod(time.Date(2026, 3, 17, 15, 30, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), period.Month)
+	assert.Equal(t, "2026-03", period.Key())
+	assert.False(t, period.Closed)
+	assert.True(t, period.Contains(time.Date(2026, 3, 31, 23, 59, 0, 0, time.UTC)))
+	assert.False(t, period.Contains(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestAccountingPeriod_CloseAndReopen(t *testing.T) {
+	period := NewAccountingPeriod(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	now := time.Date(2026, 4, 5, 10, 0, 0, 0, time.UTC)
+
+	assert.Error(t, period.Reopen("fix", now))
+
+	require.NoError(t, period.Close(now))
+	assert.True(t, period.Closed)
+	assert.Error(t, period.Close(now))
+
+	assert.Error(t, period.Reopen("  ", now))
+	require.NoError(t, period.Reopen("late invoice", now.Add(time.Hour)))
+	assert.False(t, period.Closed)
+
+	require.Len(t, period.Log, 2)
+	assert.Equal(t, PeriodActionClosed, period.Log[0].Action)
+	assert.Equal(t, PeriodActionReopened, period.Log[1].Action)
+	assert.Equal(t, "late invoice", period.Log[1].Reason)
+}
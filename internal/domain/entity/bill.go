@@ -56,6 +56,44 @@ func NewBill(name, description string, startDate, endDate, dueDate time.Time, to
 	}, nil
 }
 
+// Update changes the bill details. The total can't drop below what was already
+// paid, and the status follows the new total unless the bill was closed.
+func (b *Bill) Update(name, description string, startDate, endDate, dueDate time.Time, totalAmount valueobject.Money) error {
+	if endDate.Before(startDate) {
+		return fmt.Errorf("end date cannot be before start date")
+	}
+
+	if dueDate.Before(endDate) {
+		return fmt.Errorf("due date cannot be before end date")
+	}
+
+	if totalAmount.IsNegative() {
+		return fmt.Errorf("total amount cannot be negative")
+	}
+
+	if totalAmount.Currency() != b.PaidAmount.Currency() {
+		return fmt.Errorf("total amount currency must match the bill's")
+	}
+
+	if b.PaidAmount.Amount() > totalAmount.Amount() {
+		return fmt.Errorf("total amount cannot be less than the %s already paid", b.PaidAmount.String())
+	}
+
+	b.Name = name
+	b.Description = description
+	b.StartDate = startDate
+	b.EndDate = endDate
+	b.DueDate = dueDate
+	b.TotalAmount = totalAmount
+	b.UpdatedAt = time.Now()
+
+	if b.Status != BillStatusClosed {
+		b.Status = BillStatusOpen
+		b.updateStatus()
+	}
+	return nil
+}
+
 func (b *Bill) AddPayment(amount valueobject.Money) error {
 	newPaidAmount, err := b.PaidAmount.Add(amount)
 	if err != nil {
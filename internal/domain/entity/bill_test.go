@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBill_Update(t *testing.T) {
+	start := time.Now().AddDate(0, 0, -20)
+	end := start.AddDate(0, 0, 30)
+	due := end.AddDate(0, 0, 10)
+	bill, err := NewBill("Rent", "", start, end, due, valueobject.NewMoney(1000, "BRL"))
+	require.NoError(t, err)
+	require.NoError(t, bill.AddPayment(valueobject.NewMoney(600, "BRL")))
+
+	assert.Error(t, bill.Update("Rent", "", end, start, due, valueobject.NewMoney(1000, "BRL")))
+	assert.Error(t, bill.Update("Rent", "", start, end, start, valueobject.NewMoney(1000, "BRL")))
+
+	// The total can't drop below what was paid
+	assert.Error(t, bill.Update("Rent", "", start, end, due, valueobject.NewMoney(500, "BRL")))
+	assert.Equal(t, 1000.0, bill.TotalAmount.Amount())
+
+	// Lowering the total to the paid amount settles the bill
+	require.NoError(t, bill.Update("Rent + Condo", "March", start, end, due, valueobject.NewMoney(600, "BRL")))
+	assert.Equal(t, "Rent + Condo", bill.Name)
+	assert.Equal(t, BillStatusPaid, bill.Status)
+
+	// Raising it again reopens it
+	require.NoError(t, bill.Update("Rent + Condo", "March", start, end, due, valueobject.NewMoney(1200, "BRL")))
+	assert.Equal(t, BillStatusOpen, bill.Status)
+}
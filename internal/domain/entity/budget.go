@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// BudgetRollover decides what a month's leftover does to the next month's budget
+type BudgetRollover string
+
+const (
+	BudgetRolloverReset     BudgetRollover = "reset"     // Every month starts again from the limit
+	BudgetRolloverUnspent   BudgetRollover = "unspent"   // What's left unspent adds to the next month
+	BudgetRolloverOverspend BudgetRollover = "overspend" // Overspending is taken from the next month
+)
+
+// Budget caps how much can be spent on a category each month. Expenses of the
+// category count against the budget of the month they happen in.
+type Budget struct {
+	ID           uuid.UUID
+	Category     TransactionCategory
+	MonthlyLimit valueobject.Money
+	Rollover     BudgetRollover // Empty on budgets saved before rollovers, which reset
+	// Carried is what each month that ended passed on to the next, by the
+	// month's YYYY-MM, kept as it was worked out once the month was over
+	Carried   map[string]float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewBudget(category TransactionCategory, monthlyLimit valueobject.Money) (*Budget, error) {
+	switch category {
+	case "":
+		return nil, fmt.Errorf("budget category is required")
+	case TransactionCategoryIncome, TransactionCategoryTransfer:
+		return nil, fmt.Errorf("budgets are only for expense categories")
+	}
+
+	now := time.Now()
+	budget := &Budget{
+		ID:        uuid.New(),
+		Category:  category,
+		Rollover:  BudgetRolloverReset,
+		CreatedAt: now,
+	}
+	if err := budget.SetLimit(monthlyLimit); err != nil {
+		return nil, err
+	}
+	budget.UpdatedAt = now
+	return budget, nil
+}
+
+func (b *Budget) SetLimit(monthlyLimit valueobject.Money) error {
+	if monthlyLimit.IsNegative() || monthlyLimit.IsZero() {
+		return fmt.Errorf("monthly limit must be positive")
+	}
+
+	b.MonthlyLimit = monthlyLimit
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+func (b *Budget) SetRollover(rollover BudgetRollover) error {
+	switch rollover {
+	case BudgetRolloverReset, BudgetRolloverUnspent, BudgetRolloverOverspend:
+	default:
+		return fmt.Errorf("unknown rollover %q", rollover)
+	}
+
+	// What the months carried under the old rollover doesn't hold anymore
+	if rollover != b.Rollover {
+		b.Carried = nil
+	}
+	b.Rollover = rollover
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// RollsOver tells whether the budget's months pass anything on to the next
+func (b *Budget) RollsOver() bool {
+	return b.Rollover == BudgetRolloverUnspent || b.Rollover == BudgetRolloverOverspend
+}
+
+// Carry is what a month that ended with remaining left (negative when it was
+// overspent) passes on to the next month's limit
+func (b *Budget) Carry(remaining float64) float64 {
+	switch {
+	case b.Rollover == BudgetRolloverUnspent && remaining > 0:
+		return remaining
+	case b.Rollover == BudgetRolloverOverspend && remaining < 0:
+		return remaining
+	}
+	return 0
+}
+
+// CarriedFrom returns what the month passed on to the next, when it was
+// recorded
+func (b *Budget) CarriedFrom(month string) (float64, bool) {
+	carry, ok := b.Carried[month]
+	return carry, ok
+}
+
+// RecordCarry keeps what a month that ended passed on to the next
+func (b *Budget) RecordCarry(month string, carry float64) {
+	if b.Carried == nil {
+		b.Carried = make(map[string]float64)
+	}
+	b.Carried[month] = carry
+}
+
+// Counts tells whether the transaction is spending against the budget
+func (b *Budget) Counts(transaction *Transaction) bool {
+	return transaction.Type == TransactionTypeDebit &&
+		transaction.Category == b.Category &&
+		!transaction.IgnoreFromBudget
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBudget(t *testing.T) {
+	_, err := NewBudget(TransactionCategoryIncome, valueobject.NewMoney(100, "BRL"))
+	assert.Error(t, err)
+
+	_, err = NewBudget(TransactionCategoryFood, valueobject.NewMoney(0, "BRL"))
+	assert.Error(t, err)
+
+	budget, err := NewBudget(TransactionCategoryFood, valueobject.NewMoney(800, "BRL"))
+	require.NoError(t, err)
+	assert.Equal(t, 800.0, budget.MonthlyLimit.Amount())
+}
+
+func TestBudget_Carry(t *testing.T) {
+	budget, err := NewBudget(TransactionCategoryFood, valueobject.NewMoney(800, "BRL"))
+	require.NoError(t, err)
+	assert.Equal(t, BudgetRolloverReset, budget.Rollover)
+	assert.Equal(t, 0.0, budget.Carry(120))
+	assert.Equal(t, 0.0, budget.Carry(-50))
+
+	require.NoError(t, budget.SetRollover(BudgetRolloverUnspent))
+	assert.Equal(t, 120.0, budget.Carry(120))
+	assert.Equal(t, 0.0, budget.Carry(-50))
+
+	require.NoError(t, budget.SetRollover(BudgetRolloverOverspend))
+	assert.Equal(t, 0.0, budget.Carry(120))
+	assert.Equal(t, -50.0, budget.Carry(-50))
+
+	assert.Error(t, budget.SetRollover("everything"))
+	assert.Equal(t, BudgetRolloverOverspend, budget.Rollover)
+}
+
+func TestBudget_Counts(t *testing.T) {
+	budget, err := NewBudget(TransactionCategoryFood, valueobject.NewMoney(800, "BRL"))
+	require.NoError(t, err)
+
+	amount := valueobject.NewMoney(50, "BRL")
+	expense := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryFood, amount, "Lunch", time.Now())
+	assert.True(t, budget.Counts(expense))
+
+	refund := NewTransaction(nil, nil, TransactionTypeCredit, TransactionCategoryFood, amount, "Refund", time.Now())
+	assert.False(t, budget.Counts(refund))
+
+	other := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryShopping, amount, "Shirt", time.Now())
+	assert.False(t, budget.Counts(other))
+
+	expense.SetIgnoreFromBudget(true)
+	assert.False(t, budget.Counts(expense))
+}
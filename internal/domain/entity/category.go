@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// CategoryKind tells whether a category files income or spending
+type CategoryKind string
+
+const (
+	CategoryKindExpense CategoryKind = "expense"
+	CategoryKindIncome  CategoryKind = "income"
+)
+
+// BuiltInCategories lists the categories every user starts with, in the order
+// they are offered
+func BuiltInCategories() []TransactionCategory {
+	return []TransactionCategory{
+		TransactionCategoryFood,
+		TransactionCategoryTransportation,
+		TransactionCategoryUtilities,
+		TransactionCategoryEntertainment,
+		TransactionCategoryShopping,
+		TransactionCategoryHealthcare,
+		TransactionCategoryEducation,
+		TransactionCategoryIncome,
+		TransactionCategoryTransfer,
+		TransactionCategoryOther,
+	}
+}
+
+func (c TransactionCategory) IsBuiltIn() bool {
+	for _, category := range BuiltInCategories() {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Category is a category the user added next to the built-in ones
+type Category struct {
+	ID uuid.UUID
+	// Key is what transactions, budgets and rules store as their category. It
+	// is derived from the name once, so renaming keeps them in the category.
+	Key       TransactionCategory
+	Name      string
+	Icon      string
+	Kind      CategoryKind
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewCategory(name, icon string, kind CategoryKind) (*Category, error) {
+	now := time.Now()
+	category := &Category{
+		ID:        uuid.New(),
+		Key:       categoryKey(name),
+		CreatedAt: now,
+	}
+	if category.Key == "" {
+		return nil, fmt.Errorf("category name must contain letters or digits")
+	}
+	if err := category.Update(name, icon, kind, now); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Update renames the category and changes its icon and kind, keeping its key
+func (c *Category) Update(name, icon string, kind CategoryKind, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("category name is required")
+	}
+
+	icon = strings.TrimSpace(icon)
+	if utf8.RuneCountInString(icon) > maxCategoryIconRunes {
+		return fmt.Errorf("icon must be a single symbol")
+	}
+
+	if kind != CategoryKindExpense && kind != CategoryKindIncome {
+		return fmt.Errorf("category must be for income or expenses")
+	}
+
+	c.Name = name
+	c.Icon = icon
+	c.Kind = kind
+	c.UpdatedAt = now
+	return nil
+}
+
+// categoryKey turns a name such as "Pet Care" into "pet_care"
+func categoryKey(name string) TransactionCategory {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return TransactionCategory(strings.Join(words, "_"))
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxCategoryIconRunes leaves room for emoji written as several code points, such as "🛍️"
+const maxCategoryIconRunes = 4
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// CategoryAppearance is the icon and color a category is shown with
+type CategoryAppearance struct {
+	Category  TransactionCategory
+	Icon      string
+	Color     string // Hex color such as "#10B981"
+	UpdatedAt time.Time
+}
+
+func NewCategoryAppearance(category TransactionCategory, icon, color string) (*CategoryAppearance, error) {
+	if category == "" {
+		return nil, fmt.Errorf("category is required")
+	}
+
+	icon = strings.TrimSpace(icon)
+	if icon == "" {
+		return nil, fmt.Errorf("icon is required")
+	}
+	if utf8.RuneCountInString(icon) > maxCategoryIconRunes {
+		return nil, fmt.Errorf("icon must be a single symbol")
+	}
+
+	color = strings.TrimSpace(color)
+	if !hexColorPattern.MatchString(color) {
+		return nil, fmt.Errorf("invalid color, use #RRGGBB")
+	}
+
+	return &CategoryAppearance{
+		Category:  category,
+		Icon:      icon,
+		Color:     strings.ToUpper(color),
+		UpdatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryAppearance(t *testing.T) {
+	appearance, err := NewCategoryAppearance(TransactionCategoryShopping, " 🛍️ ", "#f97316")
+	require.NoError(t, err)
+	assert.Equal(t, "🛍️", appearance.Icon)
+	assert.Equal(t, "#F97316", appearance.Color)
+
+	_, err = NewCategoryAppearance(TransactionCategoryShopping, "", "#F97316")
+	assert.Error(t, err)
+
+	_, err = NewCategoryAppearance(TransactionCategoryShopping, "Shopping", "#F97316")
+	assert.Error(t, err)
+
+	_, err = NewCategoryAppearance(TransactionCategoryShopping, "🛍️", "orange")
+	assert.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// minClassifierWordRunes skips words too short to say anything about a category
+const minClassifierWordRunes = 3
+
+// CategoryClassifier learns which category a description tends to get from the
+// user's own history. It is a naive Bayes model over the words of the
+// descriptions, kept as plain counts so it can be saved and updated one
+// transaction at a time.
+type CategoryClassifier struct {
+	// WordCounts counts, per word, the learned descriptions of each category that have it
+	WordCounts map[string]map[TransactionCategory]int
+	// CategoryCounts counts the learned descriptions per category
+	CategoryCounts map[TransactionCategory]int
+	UpdatedAt      time.Time
+}
+
+// CategorySuggestion is the most likely category of a description
+type CategorySuggestion struct {
+	Category TransactionCategory
+	// Confidence is the probability the model gives the category, from 0 to 1
+	Confidence float64
+}
+
+func NewCategoryClassifier() *CategoryClassifier {
+	return &CategoryClassifier{
+		WordCounts:     make(map[string]map[TransactionCategory]int),
+		CategoryCounts: make(map[TransactionCategory]int),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// Learn counts description as an example of category
+func (c *CategoryClassifier) Learn(description string, category TransactionCategory) {
+	words := classifierWords(description)
+	if len(words) == 0 || category == "" {
+		return
+	}
+
+	c.CategoryCounts[category]++
+	for _, word := range words {
+		if c.WordCounts[word] == nil {
+			c.WordCounts[word] = make(map[TransactionCategory]int)
+		}
+		c.WordCounts[word][category]++
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// Forget takes back an example learned before, such as when the transaction
+// it came from is recategorized
+func (c *CategoryClassifier) Forget(description string, category TransactionCategory) {
+	words := classifierWords(description)
+	if len(words) == 0 || c.CategoryCounts[category] == 0 {
+		return
+	}
+
+	if c.CategoryCounts[category]--; c.CategoryCounts[category] == 0 {
+		delete(c.CategoryCounts, category)
+	}
+	for _, word := range words {
+		counts := c.WordCounts[word]
+		if counts[category] == 0 {
+			continue
+		}
+		if counts[category]--; counts[category] == 0 {
+			delete(counts, category)
+		}
+		if len(counts) == 0 {
+			delete(c.WordCounts, word)
+		}
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// Suggest returns the most likely category of description. There is no
+// suggestion when none of its words has been learned yet.
+func (c *CategoryClassifier) Suggest(description string) (CategorySuggestion, bool) {
+	var known []string
+	for _, word := range classifierWords(description) {
+		if len(c.WordCounts[word]) > 0 {
+			known = append(known, word)
+		}
+	}
+	if len(known) == 0 {
+		return CategorySuggestion{}, false
+	}
+
+	total := 0
+	for _, count := range c.CategoryCounts {
+		total += count
+	}
+
+	// Log-probabilities with add-one smoothing, so a word never seen with a
+	// category only makes it unlikely instead of impossible
+	scores := make(map[TransactionCategory]float64, len(c.CategoryCounts))
+	best, bestScore := TransactionCategory(""), math.Inf(-1)
+	for category, count := range c.CategoryCounts {
+		score := math.Log(float64(count) / float64(total))
+		for _, word := range known {
+			score += math.Log(float64(c.WordCounts[word][category]+1) / float64(count+2))
+		}
+		scores[category] = score
+		if score > bestScore || (score == bestScore && category < best) {
+			best, bestScore = category, score
+		}
+	}
+
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - bestScore)
+	}
+	return CategorySuggestion{Category: best, Confidence: 1 / sum}, true
+}
+
+// Examples is how many descriptions the classifier has learned
+func (c *CategoryClassifier) Examples() int {
+	total := 0
+	for _, count := range c.CategoryCounts {
+		total += count
+	}
+	return total
+}
+
+// classifierWords splits a description into its distinct lowercase words,
+// leaving out numbers and short words such as installment counters and
+// prepositions
+func classifierWords(description string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	seen := make(map[string]bool, len(fields))
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if len([]rune(field)) < minClassifierWordRunes || seen[field] {
+			continue
+		}
+		seen[field] = true
+		words = append(words, field)
+	}
+	return words
+}
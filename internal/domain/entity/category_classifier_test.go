@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryClassifier_Suggest(t *testing.T) {
+	classifier := NewCategoryClassifier()
+	classifier.Learn("Uber trip home", TransactionCategoryTransportation)
+	classifier.Learn("Uber to the airport", TransactionCategoryTransportation)
+	classifier.Learn("Uber Eats pizza", TransactionCategoryFood)
+	classifier.Learn("Pizza Hut", TransactionCategoryFood)
+	classifier.Learn("Supermarket 12/03", TransactionCategoryFood)
+	assert.Equal(t, 5, classifier.Examples())
+
+	suggestion, ok := classifier.Suggest("UBER 1234")
+	require.True(t, ok)
+	assert.Equal(t, TransactionCategoryTransportation, suggestion.Category)
+	assert.Greater(t, suggestion.Confidence, 0.5)
+	assert.Less(t, suggestion.Confidence, 1.0)
+
+	suggestion, ok = classifier.Suggest("uber eats - pizza")
+	require.True(t, ok)
+	assert.Equal(t, TransactionCategoryFood, suggestion.Category)
+
+	// Unknown words and numbers alone say nothing
+	_, ok = classifier.Suggest("Pharmacy 42")
+	assert.False(t, ok)
+}
+
+func TestCategoryClassifier_Forget(t *testing.T) {
+	classifier := NewCategoryClassifier()
+	classifier.Learn("Netflix", TransactionCategoryShopping)
+
+	// A recategorized transaction moves its example to the new category
+	classifier.Forget("Netflix", TransactionCategoryShopping)
+	classifier.Learn("Netflix", TransactionCategoryEntertainment)
+
+	suggestion, ok := classifier.Suggest("netflix.com")
+	require.True(t, ok)
+	assert.Equal(t, TransactionCategoryEntertainment, suggestion.Category)
+	assert.Equal(t, 1.0, suggestion.Confidence)
+	assert.NotContains(t, classifier.CategoryCounts, TransactionCategoryShopping)
+
+	// Forgetting what was never learned changes nothing
+	classifier.Forget("Netflix", TransactionCategoryFood)
+	assert.Equal(t, 1, classifier.Examples())
+}
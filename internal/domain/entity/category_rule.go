@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CategoryRule files the transactions whose description contains Keyword
+// under Category
+type CategoryRule struct {
+	ID        uuid.UUID
+	Keyword   string
+	Category  TransactionCategory
+	CreatedAt time.Time
+}
+
+func NewCategoryRule(keyword string, category TransactionCategory) (*CategoryRule, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, fmt.Errorf("rule keyword is required")
+	}
+	if category == "" {
+		return nil, fmt.Errorf("rule category is required")
+	}
+
+	return &CategoryRule{
+		ID:        uuid.New(),
+		Keyword:   keyword,
+		Category:  category,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Matches tells whether description contains the keyword, ignoring case
+func (r *CategoryRule) Matches(description string) bool {
+	return strings.Contains(strings.ToLower(description), strings.ToLower(r.Keyword))
+}
+
+// MatchCategoryRule returns the rule that applies to description, or nil when
+// none does. When several match, the longest keyword wins, so "uber eats" takes
+// precedence over "uber".
+func MatchCategoryRule(rules []*CategoryRule, description string) *CategoryRule {
+	var best *CategoryRule
+	for _, rule := range rules {
+		if rule.Matches(description) && (best == nil || len(rule.Keyword) > len(best.Keyword)) {
+			best = rule
+		}
+	}
+	return best
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryRule(t *testing.T) {
+	rule, err := NewCategoryRule("  Netflix ", TransactionCategoryEntertainment)
+	require.NoError(t, err)
+	assert.Equal(t, "Netflix", rule.Keyword)
+	assert.Equal(t, TransactionCategoryEntertainment, rule.Category)
+
+	_, err = NewCategoryRule("   ", TransactionCategoryFood)
+	assert.Error(t, err)
+
+	_, err = NewCategoryRule("Uber", "")
+	assert.Error(t, err)
+}
+
+func TestMatchCategoryRule(t *testing.T) {
+	uber, err := NewCategoryRule("uber", TransactionCategoryTransportation)
+	require.NoError(t, err)
+	uberEats, err := NewCategoryRule("Uber Eats", TransactionCategoryFood)
+	require.NoError(t, err)
+	rules := []*CategoryRule{uber, uberEats}
+
+	assert.Equal(t, uber, MatchCategoryRule(rules, "UBER *TRIP 1234"))
+	assert.Equal(t, uberEats, MatchCategoryRule(rules, "uber eats - pizza"))
+	assert.Nil(t, MatchCategoryRule(rules, "Pharmacy"))
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategory(t *testing.T) {
+	category, err := NewCategory("  Pet Care ", "🐶", CategoryKindExpense)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionCategory("pet_care"), category.Key)
+	assert.Equal(t, "Pet Care", category.Name)
+	assert.Equal(t, "🐶", category.Icon)
+	assert.Equal(t, CategoryKindExpense, category.Kind)
+
+	_, err = NewCategory("🐶 🐱", "", CategoryKindExpense)
+	assert.Error(t, err)
+
+	_, err = NewCategory("Freelance", "💵", "")
+	assert.Error(t, err)
+
+	_, err = NewCategory("Freelance", "not an icon", CategoryKindIncome)
+	assert.Error(t, err)
+}
+
+func TestCategory_Update(t *testing.T) {
+	category, err := NewCategory("Pets", "🐶", CategoryKindExpense)
+	require.NoError(t, err)
+
+	require.NoError(t, category.Update("Pet Care", "", CategoryKindExpense, time.Now()))
+	assert.Equal(t, "Pet Care", category.Name)
+	assert.Equal(t, TransactionCategory("pets"), category.Key, "renaming keeps the key")
+
+	assert.Error(t, category.Update(" ", "🐶", CategoryKindExpense, time.Now()))
+}
+
+func TestTransactionCategory_IsBuiltIn(t *testing.T) {
+	assert.True(t, TransactionCategoryFood.IsBuiltIn())
+	assert.False(t, TransactionCategory("pet_care").IsBuiltIn())
+}
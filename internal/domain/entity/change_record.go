@@ -0,0 +1,223 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type ChangeEntityType string
+
+const (
+	ChangeEntityTransaction ChangeEntityType = "transaction"
+	ChangeEntityBill        ChangeEntityType = "bill"
+)
+
+// Snapshot holds the editable fields of an entity as text, keyed by field name
+type Snapshot map[string]string
+
+// FieldChange is the before/after value of one field touched by a change
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// ChangeRecord is a persisted entry in an entity's history. Reverting it puts
+// the changed fields back to their previous values.
+type ChangeRecord struct {
+	ID         uuid.UUID
+	EntityType ChangeEntityType
+	EntityID   uuid.UUID
+	Summary    string
+	Changes    []FieldChange
+	RevertedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// NewChangeRecord diffs two snapshots of an entity, returning nil when nothing changed
+func NewChangeRecord(entityType ChangeEntityType, entityID uuid.UUID, summary string, before, after Snapshot) *ChangeRecord {
+	changes := DiffSnapshots(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &ChangeRecord{
+		ID:         uuid.New(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Summary:    summary,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// DiffSnapshots lists the fields whose values differ, sorted by field name
+func DiffSnapshots(before, after Snapshot) []FieldChange {
+	fields := make(map[string]bool)
+	for field := range before {
+		fields[field] = true
+	}
+	for field := range after {
+		fields[field] = true
+	}
+
+	var changes []FieldChange
+	for field := range fields {
+		if before[field] != after[field] {
+			changes = append(changes, FieldChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+
+	return changes
+}
+
+func (r *ChangeRecord) IsReverted() bool {
+	return r.RevertedAt != nil
+}
+
+// RevertSnapshot returns the previous values of the changed fields
+func (r *ChangeRecord) RevertSnapshot() Snapshot {
+	snapshot := make(Snapshot, len(r.Changes))
+	for _, change := range r.Changes {
+		snapshot[change.Field] = change.Before
+	}
+	return snapshot
+}
+
+func (r *ChangeRecord) MarkReverted(now time.Time) error {
+	if r.IsReverted() {
+		return fmt.Errorf("change was already reverted")
+	}
+	r.RevertedAt = &now
+	return nil
+}
+
+// Snapshot captures the transaction fields that can be changed and reverted
+// without touching account or card balances
+func (t *Transaction) Snapshot() Snapshot {
+	return Snapshot{
+		"description":        t.Description,
+		"category":           string(t.Category),
+		"date":               t.Date.Format(time.RFC3339),
+		"ignore_from_budget": strconv.FormatBool(t.IgnoreFromBudget),
+		"city":               t.City,
+		"venue":              t.Venue,
+		"client":             t.Client,
+		"project":            t.Project,
+		"payment_method":     string(t.PaymentMethod),
+		"tags":               strings.Join(t.Tags, ","),
+	}
+}
+
+// Restore applies snapshot values to the transaction
+func (t *Transaction) Restore(snapshot Snapshot) error {
+	restored := *t
+	for field, value := range snapshot {
+		switch field {
+		case "description":
+			restored.Description = value
+		case "category":
+			restored.Category = TransactionCategory(value)
+		case "date":
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return fmt.Errorf("invalid date %q: %w", value, err)
+			}
+			restored.Date = date
+		case "ignore_from_budget":
+			ignore, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid flag %q: %w", value, err)
+			}
+			restored.IgnoreFromBudget = ignore
+		case "city":
+			restored.City = value
+		case "venue":
+			restored.Venue = value
+		case "client":
+			restored.Client = value
+		case "project":
+			restored.Project = value
+		case "payment_method":
+			restored.PaymentMethod = PaymentMethod(value)
+		case "tags":
+			restored.Tags = ParseTags(value)
+		default:
+			return fmt.Errorf("field %q cannot be restored", field)
+		}
+	}
+
+	restored.UpdatedAt = time.Now()
+	*t = restored
+	return nil
+}
+
+// Snapshot captures the bill fields tracked in its history
+func (b *Bill) Snapshot() Snapshot {
+	return Snapshot{
+		"name":         b.Name,
+		"description":  b.Description,
+		"start_date":   b.StartDate.Format(time.RFC3339),
+		"end_date":     b.EndDate.Format(time.RFC3339),
+		"due_date":     b.DueDate.Format(time.RFC3339),
+		"total_amount": strconv.FormatFloat(b.TotalAmount.Amount(), 'f', 2, 64),
+		"paid_amount":  strconv.FormatFloat(b.PaidAmount.Amount(), 'f', 2, 64),
+		"status":       string(b.Status),
+	}
+}
+
+// Restore applies snapshot values to the bill
+func (b *Bill) Restore(snapshot Snapshot) error {
+	restored := *b
+	for field, value := range snapshot {
+		switch field {
+		case "name":
+			restored.Name = value
+		case "description":
+			restored.Description = value
+		case "start_date", "end_date", "due_date":
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q: %w", field, value, err)
+			}
+			switch field {
+			case "start_date":
+				restored.StartDate = date
+			case "end_date":
+				restored.EndDate = date
+			default:
+				restored.DueDate = date
+			}
+		case "total_amount", "paid_amount":
+			amount, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q: %w", field, value, err)
+			}
+			money := valueobject.NewMoney(amount, b.TotalAmount.Currency())
+			if field == "total_amount" {
+				restored.TotalAmount = money
+			} else {
+				restored.PaidAmount = money
+			}
+		case "status":
+			restored.Status = BillStatus(value)
+		default:
+			return fmt.Errorf("field %q cannot be restored", field)
+		}
+	}
+
+	if restored.EndDate.Before(restored.StartDate) {
+		return fmt.Errorf("end date cannot be before start date")
+	}
+
+	restored.UpdatedAt = time.Now()
+	*b = restored
+	return nil
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChangeRecord_DiffsSnapshots(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(42, "BRL"), "Lunch", time.Now())
+	before := txn.Snapshot()
+
+	txn.SetLocation("Lisboa", "Time Out Market")
+	record := NewChangeRecord(ChangeEntityTransaction, txn.ID, "Set location", before, txn.Snapshot())
+
+	require.NotNil(t, record)
+	assert.Equal(t, []FieldChange{
+		{Field: "city", Before: "", After: "Lisboa"},
+		{Field: "venue", Before: "", After: "Time Out Market"},
+	}, record.Changes)
+
+	assert.Nil(t, NewChangeRecord(ChangeEntityTransaction, txn.ID, "No-op", txn.Snapshot(), txn.Snapshot()))
+}
+
+func TestChangeRecord_Revert(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(42, "BRL"), "Lunch", time.Now())
+	before := txn.Snapshot()
+
+	txn.SetIgnoreFromBudget(true)
+	txn.SetLocation("Porto", "")
+	record := NewChangeRecord(ChangeEntityTransaction, txn.ID, "Edit", before, txn.Snapshot())
+	require.NotNil(t, record)
+
+	require.NoError(t, txn.Restore(record.RevertSnapshot()))
+	assert.False(t, txn.IgnoreFromBudget)
+	assert.Empty(t, txn.City)
+	assert.Equal(t, "Lunch", txn.Description)
+
+	require.NoError(t, record.MarkReverted(time.Now()))
+	assert.Error(t, record.MarkReverted(time.Now()))
+}
+
+func TestBill_RestoreRejectsUnknownFields(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	bill, err := NewBill("Rent", "", start, start.AddDate(0, 1, -1), start.AddDate(0, 1, 5), valueobject.NewMoney(1500, "BRL"))
+	require.NoError(t, err)
+
+	err = bill.Restore(Snapshot{"name": "Mortgage", "owner": "me"})
+	assert.Error(t, err)
+	assert.Equal(t, "Rent", bill.Name, "a failed restore leaves the bill untouched")
+}
@@ -8,16 +8,40 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultMinimumPaymentPercentage is the share of the invoice balance required
+// as minimum payment when the card doesn't configure its own
+const DefaultMinimumPaymentPercentage = 15.0
+
+// InvoiceReminders picks where the payment reminders of a card's closed
+// invoices go. The zero value sends them to the notifications center only.
+type InvoiceReminders struct {
+	Disabled bool
+	Email    bool
+	Webhook  bool
+}
+
+// InvoiceSplit shares a part of every charge on a card's invoices with one
+// person, such as a family card paid together
+type InvoiceSplit struct {
+	PersonID   uuid.UUID
+	Percentage float64
+}
+
 type CreditCard struct {
-	ID             uuid.UUID
-	AccountID      uuid.UUID
-	Name           string
-	LastFourDigits string
-	CreditLimit    valueobject.Money
-	CurrentBalance valueobject.Money
-	DueDay         int
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                       uuid.UUID
+	AccountID                uuid.UUID
+	Name                     string
+	LastFourDigits           string
+	CreditLimit              valueobject.Money
+	CurrentBalance           valueobject.Money
+	DueDay                   int
+	MinimumPaymentPercentage float64
+	DefaultCategory          TransactionCategory
+	DefaultTransactionType   TransactionType
+	InvoiceReminders         InvoiceReminders
+	InvoiceSplit             *InvoiceSplit // Optional, shared when each invoice closes
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
 }
 
 func NewCreditCard(accountID uuid.UUID, name string, lastFourDigits string, creditLimit valueobject.Money, dueDay int) (*CreditCard, error) {
@@ -31,18 +55,87 @@ func NewCreditCard(accountID uuid.UUID, name string, lastFourDigits string, cred
 
 	now := time.Now()
 	return &CreditCard{
-		ID:             uuid.New(),
-		AccountID:      accountID,
-		Name:           name,
-		LastFourDigits: lastFourDigits,
-		CreditLimit:    creditLimit,
-		CurrentBalance: valueobject.NewMoney(0, creditLimit.Currency()),
-		DueDay:         dueDay,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		ID:                       uuid.New(),
+		AccountID:                accountID,
+		Name:                     name,
+		LastFourDigits:           lastFourDigits,
+		CreditLimit:              creditLimit,
+		CurrentBalance:           valueobject.NewMoney(0, creditLimit.Currency()),
+		DueDay:                   dueDay,
+		MinimumPaymentPercentage: DefaultMinimumPaymentPercentage,
+		CreatedAt:                now,
+		UpdatedAt:                now,
 	}, nil
 }
 
+// Update changes the card details. The balance is kept, even when the new
+// limit is below it.
+func (c *CreditCard) Update(accountID uuid.UUID, name string, lastFourDigits string, creditLimit valueobject.Money, dueDay int) error {
+	if dueDay < 1 || dueDay > 31 {
+		return fmt.Errorf("due day must be between 1 and 31")
+	}
+
+	if len(lastFourDigits) != 4 {
+		return fmt.Errorf("last four digits must be exactly 4 characters")
+	}
+
+	if creditLimit.IsNegative() || creditLimit.IsZero() {
+		return fmt.Errorf("credit limit must be positive")
+	}
+
+	if creditLimit.Currency() != c.CurrentBalance.Currency() {
+		return fmt.Errorf("credit limit currency must match the card's")
+	}
+
+	c.AccountID = accountID
+	c.Name = name
+	c.LastFourDigits = lastFourDigits
+	c.CreditLimit = creditLimit
+	c.DueDay = dueDay
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func (c *CreditCard) SetMinimumPaymentPercentage(percentage float64) error {
+	if percentage <= 0 || percentage > 100 {
+		return fmt.Errorf("minimum payment percentage must be between 0 and 100")
+	}
+
+	c.MinimumPaymentPercentage = percentage
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetTransactionDefaults sets the category and type suggested for new charges on
+// this card; empty values clear the default
+func (c *CreditCard) SetTransactionDefaults(category TransactionCategory, transactionType TransactionType) {
+	c.DefaultCategory = category
+	c.DefaultTransactionType = transactionType
+	c.UpdatedAt = time.Now()
+}
+
+func (c *CreditCard) SetInvoiceReminders(reminders InvoiceReminders) {
+	c.InvoiceReminders = reminders
+	c.UpdatedAt = time.Now()
+}
+
+// SetInvoiceSplit shares percentage of every charge on the card's invoices with
+// the person once each invoice closes
+func (c *CreditCard) SetInvoiceSplit(personID uuid.UUID, percentage float64) error {
+	if percentage <= 0 || percentage > 100 {
+		return fmt.Errorf("split percentage must be between 0 and 100")
+	}
+
+	c.InvoiceSplit = &InvoiceSplit{PersonID: personID, Percentage: percentage}
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func (c *CreditCard) ClearInvoiceSplit() {
+	c.InvoiceSplit = nil
+	c.UpdatedAt = time.Now()
+}
+
 func (c *CreditCard) Charge(amount valueobject.Money) error {
 	newBalance, err := c.CurrentBalance.Add(amount)
 	if err != nil {
@@ -28,6 +28,9 @@ type CreditCardInvoice struct {
 	TotalCharges    valueobject.Money
 	TotalPayments   valueobject.Money
 	ClosingBalance  valueobject.Money
+	MinimumPayment  valueobject.Money
+	AmountPaid      valueobject.Money // payments received after the invoice closed
+	MinimumPaidAt   *time.Time
 	Status          InvoiceStatus
 	TransactionIDs  []uuid.UUID
 	CreatedAt       time.Time
@@ -61,6 +64,8 @@ func NewCreditCardInvoice(creditCardID uuid.UUID, referenceMonth string, opening
 		TotalCharges:    valueobject.NewMoney(0, previousBalance.Currency()),
 		TotalPayments:   valueobject.NewMoney(0, previousBalance.Currency()),
 		ClosingBalance:  previousBalance,
+		MinimumPayment:  valueobject.NewMoney(0, previousBalance.Currency()),
+		AmountPaid:      valueobject.NewMoney(0, previousBalance.Currency()),
 		Status:          InvoiceStatusOpen,
 		TransactionIDs:  []uuid.UUID{},
 		CreatedAt:       now,
@@ -176,6 +181,85 @@ func (i *CreditCardInvoice) Close() error {
 	return nil
 }
 
+// CalculateMinimumPayment sets the minimum due as a percentage of the closing balance
+func (i *CreditCardInvoice) CalculateMinimumPayment(percentage float64) {
+	if i.ClosingBalance.IsZero() || i.ClosingBalance.IsNegative() {
+		i.MinimumPayment = valueobject.NewMoney(0, i.ClosingBalance.Currency())
+	} else {
+		i.MinimumPayment = i.ClosingBalance.Multiply(percentage / 100)
+	}
+	i.UpdatedAt = time.Now()
+}
+
+// RegisterPayment applies a payment made against a closed invoice, recording
+// when the minimum payment was reached
+func (i *CreditCardInvoice) RegisterPayment(transactionID uuid.UUID, amount valueobject.Money, paidAt time.Time) error {
+	if i.Status == InvoiceStatusOpen {
+		return i.AddTransaction(transactionID, amount, true)
+	}
+
+	if i.Status == InvoiceStatusPaid {
+		return fmt.Errorf("invoice is already paid")
+	}
+
+	newPayments, err := i.TotalPayments.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	newAmountPaid, err := i.AmountPaid.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	i.TransactionIDs = append(i.TransactionIDs, transactionID)
+	i.TotalPayments = newPayments
+	i.AmountPaid = newAmountPaid
+
+	if err := i.recalculateBalance(); err != nil {
+		return err
+	}
+
+	if i.MinimumPaidAt == nil && i.AmountPaid.Amount() >= i.MinimumPayment.Amount() {
+		i.MinimumPaidAt = &paidAt
+	}
+
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+// GetMinimumPaymentRemaining returns how much is still needed to cover the minimum payment
+func (i *CreditCardInvoice) GetMinimumPaymentRemaining() valueobject.Money {
+	remaining, err := i.MinimumPayment.Subtract(i.AmountPaid)
+	if err != nil || remaining.IsNegative() {
+		return valueobject.NewMoney(0, i.MinimumPayment.Currency())
+	}
+	return remaining
+}
+
+// IsMinimumPaymentMet reports whether at least the minimum was paid by the due date
+func (i *CreditCardInvoice) IsMinimumPaymentMet() bool {
+	if i.Status == InvoiceStatusPaid {
+		return true
+	}
+	if i.MinimumPaidAt == nil {
+		return false
+	}
+	return !i.MinimumPaidAt.After(endOfDay(i.DueDate))
+}
+
+// MissedMinimumPayment reports whether the due date passed without the minimum being paid
+func (i *CreditCardInvoice) MissedMinimumPayment(now time.Time) bool {
+	if !i.IsClosed() || i.Status == InvoiceStatusPaid || i.MinimumPayment.IsZero() {
+		return false
+	}
+	return now.After(endOfDay(i.DueDate)) && !i.IsMinimumPaymentMet()
+}
+
+func endOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, t.Location())
+}
+
 func (i *CreditCardInvoice) MarkAsPaid() error {
 	if i.Status == InvoiceStatusPaid {
 		return fmt.Errorf("invoice is already paid")
@@ -196,6 +280,15 @@ func (i *CreditCardInvoice) updateStatusIfOverdue() {
 	}
 }
 
+// IsSettled reports whether nothing is owed on the invoice: it was paid, or it
+// has no charges and no balance carried over
+func (i *CreditCardInvoice) IsSettled() bool {
+	if i.Status == InvoiceStatusPaid {
+		return true
+	}
+	return len(i.TransactionIDs) == 0 && (i.ClosingBalance.IsZero() || i.ClosingBalance.IsNegative())
+}
+
 func (i *CreditCardInvoice) IsOpen() bool {
 	return i.Status == InvoiceStatusOpen
 }
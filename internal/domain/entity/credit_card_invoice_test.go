@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClosedInvoice(t *testing.T, charges float64, dueDate time.Time) *CreditCardInvoice {
+	t.Helper()
+
+	opening := dueDate.AddDate(0, -1, -10)
+	closing := dueDate.AddDate(0, 0, -10)
+	invoice, err := NewCreditCardInvoice(uuid.New(), opening.Format("2006-01"), opening, closing, dueDate, valueobject.NewMoney(0, "BRL"))
+	require.NoError(t, err)
+
+	require.NoError(t, invoice.AddTransaction(uuid.New(), valueobject.NewMoney(charges, "BRL"), false))
+	require.NoError(t, invoice.Close())
+	invoice.CalculateMinimumPayment(15)
+
+	return invoice
+}
+
+func TestCreditCardInvoice_CalculateMinimumPayment(t *testing.T) {
+	invoice := newClosedInvoice(t, 1000.0, time.Now().AddDate(0, 0, 10))
+
+	assert.Equal(t, 150.0, invoice.MinimumPayment.Amount())
+	assert.Equal(t, 150.0, invoice.GetMinimumPaymentRemaining().Amount())
+	assert.False(t, invoice.IsMinimumPaymentMet())
+}
+
+func TestCreditCardInvoice_RegisterPayment(t *testing.T) {
+	dueDate := time.Now().AddDate(0, 0, 10)
+	invoice := newClosedInvoice(t, 1000.0, dueDate)
+
+	err := invoice.RegisterPayment(uuid.New(), valueobject.NewMoney(100.0, "BRL"), time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, invoice.MinimumPaidAt)
+	assert.Equal(t, 50.0, invoice.GetMinimumPaymentRemaining().Amount())
+
+	err = invoice.RegisterPayment(uuid.New(), valueobject.NewMoney(60.0, "BRL"), time.Now())
+	require.NoError(t, err)
+	assert.NotNil(t, invoice.MinimumPaidAt)
+	assert.True(t, invoice.IsMinimumPaymentMet())
+	assert.Equal(t, 840.0, invoice.ClosingBalance.Amount())
+	assert.False(t, invoice.MissedMinimumPayment(dueDate.AddDate(0, 0, 1)))
+}
+
+func TestCreditCardInvoice_MissedMinimumPayment(t *testing.T) {
+	dueDate := time.Now().AddDate(0, 0, 5)
+	invoice := newClosedInvoice(t, 500.0, dueDate)
+
+	assert.False(t, invoice.MissedMinimumPayment(dueDate))
+	assert.True(t, invoice.MissedMinimumPayment(dueDate.AddDate(0, 0, 1)))
+
+	// Paying the minimum after the due date doesn't count as on time
+	late := dueDate.AddDate(0, 0, 2)
+	require.NoError(t, invoice.RegisterPayment(uuid.New(), valueobject.NewMoney(75.0, "BRL"), late))
+	assert.False(t, invoice.IsMinimumPaymentMet())
+	assert.True(t, invoice.MissedMinimumPayment(late))
+}
+
+func TestCreditCardInvoice_IsSettled(t *testing.T) {
+	dueDate := time.Now().AddDate(0, 0, 10)
+	opening := dueDate.AddDate(0, -1, -10)
+	empty, err := NewCreditCardInvoice(uuid.New(), opening.Format("2006-01"), opening, dueDate.AddDate(0, 0, -10), dueDate, valueobject.NewMoney(0, "BRL"))
+	require.NoError(t, err)
+	assert.True(t, empty.IsSettled())
+
+	invoice := newClosedInvoice(t, 300.0, dueDate)
+	assert.False(t, invoice.IsSettled())
+
+	require.NoError(t, invoice.RegisterPayment(uuid.New(), valueobject.NewMoney(300.0, "BRL"), time.Now()))
+	require.NoError(t, invoice.MarkAsPaid())
+	assert.True(t, invoice.IsSettled())
+}
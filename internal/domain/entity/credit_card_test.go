@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"testing"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditCard_Update(t *testing.T) {
+	card, err := NewCreditCard(uuid.New(), "Nubank", "1234", valueobject.NewMoney(5000, "BRL"), 10)
+	require.NoError(t, err)
+	require.NoError(t, card.Charge(valueobject.NewMoney(3000, "BRL")))
+
+	accountID := uuid.New()
+	assert.Error(t, card.Update(accountID, "Nubank", "1234", valueobject.NewMoney(5000, "BRL"), 32))
+	assert.Error(t, card.Update(accountID, "Nubank", "12345", valueobject.NewMoney(5000, "BRL"), 10))
+	assert.Error(t, card.Update(accountID, "Nubank", "1234", valueobject.NewMoney(0, "BRL"), 10))
+	assert.Error(t, card.Update(accountID, "Nubank", "1234", valueobject.NewMoney(5000, "USD"), 10))
+
+	// A limit below the balance is accepted, the balance is kept
+	require.NoError(t, card.Update(accountID, "Nubank Ultravioleta", "9876", valueobject.NewMoney(2000, "BRL"), 5))
+	assert.Equal(t, accountID, card.AccountID)
+	assert.Equal(t, "Nubank Ultravioleta", card.Name)
+	assert.Equal(t, "9876", card.LastFourDigits)
+	assert.Equal(t, 5, card.DueDay)
+	assert.Equal(t, 3000.0, card.CurrentBalance.Amount())
+}
+
+func TestCreditCard_SetInvoiceSplit(t *testing.T) {
+	card, err := NewCreditCard(uuid.New(), "Family", "4321", valueobject.NewMoney(5000, "BRL"), 10)
+	require.NoError(t, err)
+
+	personID := uuid.New()
+	assert.Error(t, card.SetInvoiceSplit(personID, 0))
+	assert.Error(t, card.SetInvoiceSplit(personID, 101))
+	assert.Nil(t, card.InvoiceSplit)
+
+	require.NoError(t, card.SetInvoiceSplit(personID, 50))
+	require.NotNil(t, card.InvoiceSplit)
+	assert.Equal(t, personID, card.InvoiceSplit.PersonID)
+	assert.Equal(t, 50.0, card.InvoiceSplit.Percentage)
+
+	card.ClearInvoiceSplit()
+	assert.Nil(t, card.InvoiceSplit)
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// DefaultEmergencyFundMonths is the coverage targeted until the user sets one
+const DefaultEmergencyFundMonths = 6
+
+// EmergencyFundPlan is how many months of essential expenses the emergency
+// fund should cover and which accounts hold it
+type EmergencyFundPlan struct {
+	EssentialMonthly valueobject.Money // Zero infers it from the spending in essential categories
+	TargetMonths     int
+	AccountIDs       []uuid.UUID // Empty counts every savings account
+	UpdatedAt        time.Time
+}
+
+func NewEmergencyFundPlan(currency string) *EmergencyFundPlan {
+	return &EmergencyFundPlan{
+		EssentialMonthly: valueobject.NewMoney(0, currency),
+		TargetMonths:     DefaultEmergencyFundMonths,
+		AccountIDs:       []uuid.UUID{},
+		UpdatedAt:        time.Now(),
+	}
+}
+
+// IsEssentialCategory reports whether spending in the category keeps going
+// after an income loss, and so has to be covered by the emergency fund
+func IsEssentialCategory(category TransactionCategory) bool {
+	switch category {
+	case TransactionCategoryFood, TransactionCategoryTransportation, TransactionCategoryUtilities, TransactionCategoryHealthcare:
+		return true
+	}
+	return false
+}
+
+func (p *EmergencyFundPlan) SetTargetMonths(months int) error {
+	if months < 1 || months > 60 {
+		return fmt.Errorf("target must be between 1 and 60 months")
+	}
+	p.TargetMonths = months
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetEssentialMonthly sets the essential expenses of a month; zero goes back
+// to inferring them
+func (p *EmergencyFundPlan) SetEssentialMonthly(amount valueobject.Money) error {
+	if amount.IsNegative() {
+		return fmt.Errorf("essential expenses can't be negative")
+	}
+	p.EssentialMonthly = amount
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+func (p *EmergencyFundPlan) IsInferred() bool {
+	return p.EssentialMonthly.IsZero()
+}
+
+func (p *EmergencyFundPlan) SetAccounts(accountIDs []uuid.UUID) {
+	p.AccountIDs = append([]uuid.UUID{}, accountIDs...)
+	p.UpdatedAt = time.Now()
+}
+
+// Holds reports whether the account's balance counts toward the fund
+func (p *EmergencyFundPlan) Holds(account *Account) bool {
+	if len(p.AccountIDs) == 0 {
+		return account.Type == AccountTypeSavings
+	}
+	for _, id := range p.AccountIDs {
+		if id == account.ID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmergencyFundPlan_SetTargetMonths(t *testing.T) {
+	plan := NewEmergencyFundPlan("BRL")
+	assert.Equal(t, DefaultEmergencyFundMonths, plan.TargetMonths)
+
+	assert.Error(t, plan.SetTargetMonths(0))
+	assert.Error(t, plan.SetTargetMonths(61))
+	require.NoError(t, plan.SetTargetMonths(12))
+	assert.Equal(t, 12, plan.TargetMonths)
+}
+
+func TestEmergencyFundPlan_SetEssentialMonthly(t *testing.T) {
+	plan := NewEmergencyFundPlan("BRL")
+	assert.True(t, plan.IsInferred())
+
+	assert.Error(t, plan.SetEssentialMonthly(valueobject.NewMoney(-1, "BRL")))
+	require.NoError(t, plan.SetEssentialMonthly(valueobject.NewMoney(4000, "BRL")))
+	assert.False(t, plan.IsInferred())
+
+	// Zero goes back to inferring
+	require.NoError(t, plan.SetEssentialMonthly(valueobject.NewMoney(0, "BRL")))
+	assert.True(t, plan.IsInferred())
+}
+
+func TestEmergencyFundPlan_Holds(t *testing.T) {
+	plan := NewEmergencyFundPlan("BRL")
+	savings := NewAccount("Savings", AccountTypeSavings, valueobject.NewMoney(1000, "BRL"), "")
+	checking := NewAccount("Checking", AccountTypeChecking, valueobject.NewMoney(1000, "BRL"), "")
+
+	// Savings accounts by default
+	assert.True(t, plan.Holds(savings))
+	assert.False(t, plan.Holds(checking))
+
+	plan.SetAccounts([]uuid.UUID{checking.ID})
+	assert.False(t, plan.Holds(savings))
+	assert.True(t, plan.Holds(checking))
+}
+
+func TestIsEssentialCategory(t *testing.T) {
+	assert.True(t, IsEssentialCategory(TransactionCategoryFood))
+	assert.True(t, IsEssentialCategory(TransactionCategoryUtilities))
+	assert.False(t, IsEssentialCategory(TransactionCategoryEntertainment))
+	assert.False(t, IsEssentialCategory(TransactionCategoryTransfer))
+}
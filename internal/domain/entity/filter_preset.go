@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FilterDateRange string
+
+// The to-date ranges (month, quarter and year) run to the end of the current
+// period, so what is scheduled later in it is included
+const (
+	FilterDateRangeAll          FilterDateRange = "all"
+	FilterDateRangeToday        FilterDateRange = "today"
+	FilterDateRangeWeek         FilterDateRange = "week"
+	FilterDateRangeMonth        FilterDateRange = "month"
+	FilterDateRangeLastMonth    FilterDateRange = "last_month"
+	FilterDateRangeQuarter      FilterDateRange = "quarter"
+	FilterDateRangeYear         FilterDateRange = "year"
+	FilterDateRangeLast12Months FilterDateRange = "last_12_months"
+	FilterDateRangeCustom       FilterDateRange = "custom"
+)
+
+type FilterSource string
+
+const (
+	FilterSourceAll      FilterSource = "all"
+	FilterSourceAccounts FilterSource = "accounts"
+	FilterSourceCards    FilterSource = "cards"
+)
+
+type FilterType string
+
+const (
+	FilterTypeAll     FilterType = "all"
+	FilterTypeIncome  FilterType = "income"
+	FilterTypeExpense FilterType = "expense"
+)
+
+// TransactionFilter is a combination of transaction list filters. Relative date
+// ranges such as "this month" are stored as such, so a preset keeps following
+// the calendar instead of freezing the dates it was saved with.
+type TransactionFilter struct {
+	DateRange    FilterDateRange
+	StartDate    string // YYYY-MM-DD, custom ranges only
+	EndDate      string // YYYY-MM-DD, custom ranges only
+	Categories   []TransactionCategory
+	Source       FilterSource
+	AccountID    *uuid.UUID
+	CreditCardID *uuid.UUID
+	Type         FilterType
+	// PaymentMethod narrows the list to one payment method; empty matches any
+	PaymentMethod PaymentMethod
+	// Tag narrows the list to transactions carrying it; empty matches any
+	Tag string
+	// Payee narrows the list to transactions with the same description; empty
+	// matches any
+	Payee string
+	// InvoiceID narrows the list to the transactions of one credit card invoice
+	InvoiceID *uuid.UUID
+}
+
+func (f TransactionFilter) Validate() error {
+	if f.DateRange != FilterDateRangeCustom {
+		return nil
+	}
+
+	start, err := time.Parse("2006-01-02", f.StartDate)
+	if err != nil {
+		return fmt.Errorf("invalid start date, use YYYY-MM-DD")
+	}
+	end, err := time.Parse("2006-01-02", f.EndDate)
+	if err != nil {
+		return fmt.Errorf("invalid end date, use YYYY-MM-DD")
+	}
+	if end.Before(start) {
+		return fmt.Errorf("end date must not be before start date")
+	}
+
+	return nil
+}
+
+// MatchesPayee tells whether the description is the filter's payee, ignoring
+// case and surrounding spaces
+func (f TransactionFilter) MatchesPayee(description string) bool {
+	return f.Payee == "" || strings.EqualFold(strings.TrimSpace(description), strings.TrimSpace(f.Payee))
+}
+
+// Period resolves the date range at now into the half-open interval
+// [start, end). All time has no bounds, which bounded reports as false.
+func (f TransactionFilter) Period(now time.Time) (start, end time.Time, bounded bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	switch f.DateRange {
+	case FilterDateRangeToday:
+		return today, today.AddDate(0, 0, 1), true
+	case FilterDateRangeWeek:
+		weekStart := today.AddDate(0, 0, -int(today.Weekday()))
+		return weekStart, weekStart.AddDate(0, 0, 7), true
+	case FilterDateRangeMonth:
+		return month, month.AddDate(0, 1, 0), true
+	case FilterDateRangeLastMonth:
+		return month.AddDate(0, -1, 0), month, true
+	case FilterDateRangeQuarter:
+		quarter := time.Date(now.Year(), (now.Month()-1)/3*3+1, 1, 0, 0, 0, 0, now.Location())
+		return quarter, quarter.AddDate(0, 3, 0), true
+	case FilterDateRangeYear:
+		year := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+		return year, year.AddDate(1, 0, 0), true
+	case FilterDateRangeLast12Months:
+		return month.AddDate(0, -11, 0), month.AddDate(0, 1, 0), true
+	case FilterDateRangeCustom:
+		start, err := time.ParseInLocation("2006-01-02", f.StartDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		end, err := time.ParseInLocation("2006-01-02", f.EndDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		return start, end.AddDate(0, 0, 1), true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// FilterPreset is a transaction filter saved under a name for later recall
+type FilterPreset struct {
+	ID        uuid.UUID
+	Name      string
+	Filter    TransactionFilter
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewFilterPreset(name string, filter TransactionFilter) (*FilterPreset, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("preset name is required")
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &FilterPreset{
+		ID:        uuid.New(),
+		Name:      name,
+		Filter:    filter,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// HasName compares names ignoring case and surrounding spaces
+func (p *FilterPreset) HasName(name string) bool {
+	return strings.EqualFold(p.Name, strings.TrimSpace(name))
+}
+
+// SetFilter replaces the saved filter, used when a preset is saved again under the same name
+func (p *FilterPreset) SetFilter(filter TransactionFilter) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	p.Filter = filter
+	p.UpdatedAt = time.Now()
+	return nil
+}
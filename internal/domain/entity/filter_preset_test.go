@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFilterPreset(t *testing.T) {
+	filter := TransactionFilter{
+		DateRange:  FilterDateRangeMonth,
+		Categories: []TransactionCategory{TransactionCategoryFood},
+		Source:     FilterSourceCards,
+		Type:       FilterTypeExpense,
+	}
+
+	preset, err := NewFilterPreset("  Card-only food this month ", filter)
+	require.NoError(t, err)
+	assert.Equal(t, "Card-only food this month", preset.Name)
+	assert.True(t, preset.HasName("card-only FOOD this month"))
+
+	_, err = NewFilterPreset(" ", filter)
+	assert.Error(t, err)
+}
+
+func TestTransactionFilter_ValidateCustomRange(t *testing.T) {
+	filter := TransactionFilter{DateRange: FilterDateRangeCustom, StartDate: "2024-03-01", EndDate: "2024-03-31"}
+	assert.NoError(t, filter.Validate())
+
+	filter.EndDate = "2024-02-28"
+	assert.Error(t, filter.Validate())
+
+	filter.EndDate = "31/03/2024"
+	assert.Error(t, filter.Validate())
+
+	preset, err := NewFilterPreset("March", TransactionFilter{DateRange: FilterDateRangeAll})
+	require.NoError(t, err)
+	assert.Error(t, preset.SetFilter(filter))
+	assert.Equal(t, FilterDateRangeAll, preset.Filter.DateRange)
+}
+
+func TestTransactionFilter_Period(t *testing.T) {
+	now := time.Date(2026, time.May, 14, 15, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		filter     TransactionFilter
+		start, end time.Time
+	}{
+		{TransactionFilter{DateRange: FilterDateRangeToday}, date(2026, time.May, 14), date(2026, time.May, 15)},
+		{TransactionFilter{DateRange: FilterDateRangeWeek}, date(2026, time.May, 10), date(2026, time.May, 17)},
+		{TransactionFilter{DateRange: FilterDateRangeMonth}, date(2026, time.May, 1), date(2026, time.June, 1)},
+		{TransactionFilter{DateRange: FilterDateRangeLastMonth}, date(2026, time.April, 1), date(2026, time.May, 1)},
+		{TransactionFilter{DateRange: FilterDateRangeQuarter}, date(2026, time.April, 1), date(2026, time.July, 1)},
+		{TransactionFilter{DateRange: FilterDateRangeYear}, date(2026, time.January, 1), date(2027, time.January, 1)},
+		{TransactionFilter{DateRange: FilterDateRangeLast12Months}, date(2025, time.June, 1), date(2026, time.June, 1)},
+		{TransactionFilter{DateRange: FilterDateRangeCustom, StartDate: "2026-02-03", EndDate: "2026-02-10"}, date(2026, time.February, 3), date(2026, time.February, 11)},
+	}
+	for _, c := range cases {
+		start, end, bounded := c.filter.Period(now)
+		require.True(t, bounded, c.filter.DateRange)
+		assert.Equal(t, c.start, start, c.filter.DateRange)
+		assert.Equal(t, c.end, end, c.filter.DateRange)
+	}
+
+	_, _, bounded := TransactionFilter{DateRange: FilterDateRangeAll}.Period(now)
+	assert.False(t, bounded)
+}
+
+func TestTransactionFilter_MatchesPayee(t *testing.T) {
+	assert.True(t, TransactionFilter{}.MatchesPayee("Anything"))
+
+	filter := TransactionFilter{Payee: "Padaria Real"}
+	assert.True(t, filter.MatchesPayee("  padaria real "))
+	assert.False(t, filter.MatchesPayee("Padaria Real Centro"))
+}
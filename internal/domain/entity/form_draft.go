@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormDraftKind names the form a draft was typed into
+type FormDraftKind string
+
+const (
+	FormDraftTransaction FormDraftKind = "transaction"
+	FormDraftBill        FormDraftKind = "bill"
+)
+
+// FormDraft is what was typed into the form of a new record before it was
+// saved, kept so quitting or a crash doesn't lose it. There's one per form.
+type FormDraft struct {
+	Form      FormDraftKind
+	Fields    map[string]string
+	UpdatedAt time.Time
+}
+
+func NewFormDraft(form FormDraftKind, fields map[string]string) (*FormDraft, error) {
+	if form == "" {
+		return nil, fmt.Errorf("draft form is required")
+	}
+
+	copied := make(map[string]string, len(fields))
+	for name, value := range fields {
+		copied[name] = value
+	}
+
+	return &FormDraft{
+		Form:      form,
+		Fields:    copied,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// Field returns the value of the named field, or "" when the draft has none
+func (d *FormDraft) Field(name string) string {
+	return d.Fields[name]
+}
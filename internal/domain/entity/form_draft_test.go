@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFormDraft(t *testing.T) {
+	fields := map[string]string{"description": "Mercado", "amount": "12.5"}
+	draft, err := NewFormDraft(FormDraftTransaction, fields)
+	require.NoError(t, err)
+	assert.Equal(t, "Mercado", draft.Field("description"))
+	assert.Equal(t, "", draft.Field("tags"))
+	assert.False(t, draft.UpdatedAt.IsZero())
+
+	// Later edits to the form's fields don't reach the draft
+	fields["description"] = "Padaria"
+	assert.Equal(t, "Mercado", draft.Field("description"))
+
+	_, err = NewFormDraft("", fields)
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// Goal is an amount to save by a deadline. The balance of the linked account
+// is what has been saved towards it.
+type Goal struct {
+	ID           uuid.UUID
+	Name         string
+	TargetAmount valueobject.Money
+	Deadline     time.Time
+	AccountID    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewGoal(name string, targetAmount valueobject.Money, deadline time.Time, accountID uuid.UUID) (*Goal, error) {
+	now := time.Now()
+	goal := &Goal{
+		ID:        uuid.New(),
+		CreatedAt: now,
+	}
+	if err := goal.Revise(name, targetAmount, deadline, accountID); err != nil {
+		return nil, err
+	}
+	return goal, nil
+}
+
+// Revise changes what the goal is, validated as on creation
+func (g *Goal) Revise(name string, targetAmount valueobject.Money, deadline time.Time, accountID uuid.UUID) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("goal name is required")
+	}
+	if targetAmount.IsNegative() || targetAmount.IsZero() {
+		return fmt.Errorf("target amount must be positive")
+	}
+	if deadline.IsZero() {
+		return fmt.Errorf("goal deadline is required")
+	}
+	if accountID == uuid.Nil {
+		return fmt.Errorf("goal needs a linked account")
+	}
+
+	g.Name = strings.TrimSpace(name)
+	g.TargetAmount = targetAmount
+	g.Deadline = deadline
+	g.AccountID = accountID
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// Remaining returns how much is still missing once saved is put aside
+func (g *Goal) Remaining(saved float64) float64 {
+	return math.Max(g.TargetAmount.Amount()-saved, 0)
+}
+
+// Percentage returns how much of the target saved makes up, capped at 100
+func (g *Goal) Percentage(saved float64) float64 {
+	return math.Min(math.Max(saved, 0)/g.TargetAmount.Amount()*100, 100)
+}
+
+// MonthsLeft counts the monthly savings that still fit before the deadline,
+// at least one while it hasn't passed and zero once it has
+func (g *Goal) MonthsLeft(now time.Time) int {
+	if g.Deadline.Before(now) {
+		return 0
+	}
+	months := (g.Deadline.Year()-now.Year())*12 + int(g.Deadline.Month()) - int(now.Month())
+	if now.AddDate(0, months, 0).After(g.Deadline) {
+		months--
+	}
+	if months < 1 {
+		return 1
+	}
+	return months
+}
+
+// ProjectedCompletion returns when the target is reached if monthlySavings
+// keep being put aside every month from now on. It's now once the target is
+// reached, and false when nothing is being saved.
+func (g *Goal) ProjectedCompletion(saved, monthlySavings float64, now time.Time) (time.Time, bool) {
+	remaining := g.Remaining(saved)
+	if remaining == 0 {
+		return now, true
+	}
+	if monthlySavings <= 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, int(math.Ceil(remaining/monthlySavings)), 0), true
+}
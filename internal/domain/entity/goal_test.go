@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGoal(t *testing.T) {
+	deadline := time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC)
+	accountID := uuid.New()
+
+	_, err := NewGoal("", valueobject.NewMoney(5000, "BRL"), deadline, accountID)
+	assert.Error(t, err)
+
+	_, err = NewGoal("Trip", valueobject.NewMoney(0, "BRL"), deadline, accountID)
+	assert.Error(t, err)
+
+	_, err = NewGoal("Trip", valueobject.NewMoney(5000, "BRL"), time.Time{}, accountID)
+	assert.Error(t, err)
+
+	_, err = NewGoal("Trip", valueobject.NewMoney(5000, "BRL"), deadline, uuid.Nil)
+	assert.Error(t, err)
+
+	goal, err := NewGoal(" Trip ", valueobject.NewMoney(5000, "BRL"), deadline, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, "Trip", goal.Name)
+	assert.Equal(t, accountID, goal.AccountID)
+}
+
+func TestGoal_ProjectedCompletion(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	goal, err := NewGoal("Trip", valueobject.NewMoney(6000, "BRL"), time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC), uuid.New())
+	require.NoError(t, err)
+
+	assert.Equal(t, 50.0, goal.Percentage(3000))
+	assert.Equal(t, 11, goal.MonthsLeft(now))
+
+	date, ok := goal.ProjectedCompletion(3000, 1000, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), date)
+
+	_, ok = goal.ProjectedCompletion(3000, 0, now)
+	assert.False(t, ok)
+
+	date, ok = goal.ProjectedCompletion(7000, 0, now)
+	require.True(t, ok)
+	assert.Equal(t, now, date)
+	assert.Equal(t, 100.0, goal.Percentage(7000))
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// holdingEpsilon absorbs the float error of fractional quantities, so selling
+// everything closes the position
+const holdingEpsilon = 1e-9
+
+// Holding is a position in one asset of an investment account, kept at the
+// average price paid for it
+type Holding struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	Ticker       string
+	Quantity     float64
+	AveragePrice valueobject.Money
+	// LastPrice is the latest quote entered or traded at
+	LastPrice valueobject.Money
+	// RealizedProfit adds up what the sales made over the average price
+	RealizedProfit valueobject.Money
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewHolding(accountID uuid.UUID, ticker string, quantity float64, price valueobject.Money) (*Holding, error) {
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	if ticker == "" {
+		return nil, fmt.Errorf("ticker is required")
+	}
+	if err := validateTrade(quantity, price); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Holding{
+		ID:             uuid.New(),
+		AccountID:      accountID,
+		Ticker:         ticker,
+		Quantity:       quantity,
+		AveragePrice:   price,
+		LastPrice:      price,
+		RealizedProfit: valueobject.NewMoney(0, price.Currency()),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+func validateTrade(quantity float64, price valueobject.Money) error {
+	if quantity <= 0 {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if price.IsNegative() || price.IsZero() {
+		return fmt.Errorf("price must be positive")
+	}
+	return nil
+}
+
+// Buy adds quantity bought at price, averaging the price paid
+func (h *Holding) Buy(quantity float64, price valueobject.Money) error {
+	if err := validateTrade(quantity, price); err != nil {
+		return err
+	}
+	if price.Currency() != h.AveragePrice.Currency() {
+		return fmt.Errorf("price currency must match the holding's")
+	}
+
+	total := h.Quantity + quantity
+	average := (h.Quantity*h.AveragePrice.Amount() + quantity*price.Amount()) / total
+	h.Quantity = total
+	h.AveragePrice = valueobject.NewMoney(average, price.Currency())
+	h.LastPrice = price
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// Sell takes quantity out at price and returns the profit it made over the
+// average price, negative for a loss. The average price doesn't change.
+func (h *Holding) Sell(quantity float64, price valueobject.Money) (float64, error) {
+	if err := validateTrade(quantity, price); err != nil {
+		return 0, err
+	}
+	if price.Currency() != h.AveragePrice.Currency() {
+		return 0, fmt.Errorf("price currency must match the holding's")
+	}
+	if quantity > h.Quantity+holdingEpsilon {
+		return 0, fmt.Errorf("can't sell %g %s, only %g held", quantity, h.Ticker, h.Quantity)
+	}
+
+	profit := (price.Amount() - h.AveragePrice.Amount()) * quantity
+	h.Quantity -= quantity
+	if h.Quantity < holdingEpsilon {
+		h.Quantity = 0
+	}
+	h.RealizedProfit = valueobject.NewMoney(h.RealizedProfit.Amount()+profit, price.Currency())
+	h.LastPrice = price
+	h.UpdatedAt = time.Now()
+	return profit, nil
+}
+
+// UpdatePrice records the asset's latest quote
+func (h *Holding) UpdatePrice(price valueobject.Money) error {
+	if price.IsNegative() || price.IsZero() {
+		return fmt.Errorf("price must be positive")
+	}
+	if price.Currency() != h.AveragePrice.Currency() {
+		return fmt.Errorf("price currency must match the holding's")
+	}
+
+	h.LastPrice = price
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsOpen tells whether anything is still held; closed holdings are kept for
+// the profit their sales realized
+func (h *Holding) IsOpen() bool {
+	return h.Quantity > 0
+}
+
+// CostBasis returns what the quantity held cost at the average price
+func (h *Holding) CostBasis() float64 {
+	return h.Quantity * h.AveragePrice.Amount()
+}
+
+// MarketValue returns what the quantity held is worth at the last price
+func (h *Holding) MarketValue() float64 {
+	return h.Quantity * h.LastPrice.Amount()
+}
+
+// ProfitLoss returns the unrealized profit of the quantity held, negative
+// for a loss
+func (h *Holding) ProfitLoss() float64 {
+	return h.MarketValue() - h.CostBasis()
+}
+
+// ProfitLossPercentage returns the unrealized profit in percent of the cost
+func (h *Holding) ProfitLossPercentage() float64 {
+	cost := h.CostBasis()
+	if cost == 0 {
+		return 0
+	}
+	return math.Round(h.ProfitLoss()/cost*10000) / 100
+}
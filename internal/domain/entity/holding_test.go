@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"testing"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHolding(t *testing.T) {
+	_, err := NewHolding(uuid.New(), " ", 10, valueobject.NewMoney(30, "BRL"))
+	assert.Error(t, err)
+	_, err = NewHolding(uuid.New(), "PETR4", 0, valueobject.NewMoney(30, "BRL"))
+	assert.Error(t, err)
+	_, err = NewHolding(uuid.New(), "PETR4", 10, valueobject.NewMoney(0, "BRL"))
+	assert.Error(t, err)
+
+	holding, err := NewHolding(uuid.New(), " petr4 ", 10, valueobject.NewMoney(30, "BRL"))
+	require.NoError(t, err)
+	assert.Equal(t, "PETR4", holding.Ticker)
+	assert.Equal(t, 300.0, holding.CostBasis())
+	assert.True(t, holding.IsOpen())
+}
+
+func TestHolding_BuyAverages(t *testing.T) {
+	holding, err := NewHolding(uuid.New(), "PETR4", 10, valueobject.NewMoney(30, "BRL"))
+	require.NoError(t, err)
+
+	require.NoError(t, holding.Buy(30, valueobject.NewMoney(40, "BRL")))
+	assert.Equal(t, 40.0, holding.Quantity)
+	assert.Equal(t, 37.5, holding.AveragePrice.Amount())
+	assert.Equal(t, 40.0, holding.LastPrice.Amount())
+
+	assert.Error(t, holding.Buy(1, valueobject.NewMoney(40, "USD")))
+}
+
+func TestHolding_Sell(t *testing.T) {
+	holding, err := NewHolding(uuid.New(), "PETR4", 10, valueobject.NewMoney(30, "BRL"))
+	require.NoError(t, err)
+
+	_, err = holding.Sell(11, valueobject.NewMoney(35, "BRL"))
+	assert.Error(t, err)
+
+	profit, err := holding.Sell(4, valueobject.NewMoney(35, "BRL"))
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, profit)
+	assert.Equal(t, 6.0, holding.Quantity)
+	assert.Equal(t, 30.0, holding.AveragePrice.Amount())
+
+	profit, err = holding.Sell(6, valueobject.NewMoney(25, "BRL"))
+	require.NoError(t, err)
+	assert.Equal(t, -30.0, profit)
+	assert.Equal(t, -10.0, holding.RealizedProfit.Amount())
+	assert.False(t, holding.IsOpen())
+}
+
+func TestHolding_ProfitLoss(t *testing.T) {
+	holding, err := NewHolding(uuid.New(), "IVVB11", 10, valueobject.NewMoney(200, "BRL"))
+	require.NoError(t, err)
+
+	require.NoError(t, holding.UpdatePrice(valueobject.NewMoney(250, "BRL")))
+	assert.Equal(t, 2500.0, holding.MarketValue())
+	assert.Equal(t, 500.0, holding.ProfitLoss())
+	assert.Equal(t, 25.0, holding.ProfitLossPercentage())
+
+	assert.Error(t, holding.UpdatePrice(valueobject.NewMoney(0, "BRL")))
+}
@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// ImportSession records the outcome of importing a bank statement into an
+// account, so the user can review what each import changed
+type ImportSession struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Source    string
+
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Statement entries that already existed in the ledger
+	MatchedTransactionIDs []uuid.UUID
+	// Statement entries that were missing and got created by the import
+	CreatedTransactionIDs []uuid.UUID
+	// Ledger transactions within the statement period that the statement doesn't list
+	LedgerOnlyTransactionIDs []uuid.UUID
+	// Missing statement entries queued in the review inbox instead of being created
+	QueuedInboxIDs []uuid.UUID
+
+	// StatementBalance is nil when the statement didn't report a closing balance
+	StatementBalance *valueobject.Money
+	LedgerBalance    valueobject.Money
+
+	// One per statement day with a running balance, oldest first
+	BalanceAssertions []BalanceAssertion
+
+	CreatedAt time.Time
+}
+
+// BalanceAssertion compares the running balance a statement printed at the end
+// of a day with the ledger balance at the end of that day
+type BalanceAssertion struct {
+	Date        time.Time
+	Description string
+	// Ledger transaction of the statement entry that carried the balance, if any
+	TransactionID *uuid.UUID
+	Expected      valueobject.Money
+	Actual        valueobject.Money
+}
+
+// Holds reports whether the ledger agrees with the statement to the cent
+func (a BalanceAssertion) Holds() bool {
+	return math.Abs(a.Expected.Amount()-a.Actual.Amount()) < 0.005
+}
+
+func NewImportSession(accountID uuid.UUID, source string, periodStart, periodEnd time.Time) *ImportSession {
+	return &ImportSession{
+		ID:                       uuid.New(),
+		AccountID:                accountID,
+		Source:                   source,
+		PeriodStart:              periodStart,
+		PeriodEnd:                periodEnd,
+		MatchedTransactionIDs:    []uuid.UUID{},
+		CreatedTransactionIDs:    []uuid.UUID{},
+		LedgerOnlyTransactionIDs: []uuid.UUID{},
+		QueuedInboxIDs:           []uuid.UUID{},
+		BalanceAssertions:        []BalanceAssertion{},
+		CreatedAt:                time.Now(),
+	}
+}
+
+func (s *ImportSession) TotalEntries() int {
+	return len(s.MatchedTransactionIDs) + len(s.CreatedTransactionIDs) + len(s.QueuedInboxIDs)
+}
+
+// BalanceDelta is the difference between the statement closing balance and the
+// account balance after the import; zero when the statement had no balance
+func (s *ImportSession) BalanceDelta() valueobject.Money {
+	if s.StatementBalance == nil {
+		return valueobject.NewMoney(0, s.LedgerBalance.Currency())
+	}
+
+	delta, err := s.StatementBalance.Subtract(s.LedgerBalance)
+	if err != nil {
+		return valueobject.NewMoney(0, s.LedgerBalance.Currency())
+	}
+	return delta
+}
+
+func (s *ImportSession) IsBalanced() bool {
+	return s.BalanceDelta().IsZero()
+}
+
+// FirstFailedAssertion returns the earliest day the ledger stopped matching the
+// statement's running balance, or nil when every assertion holds. The divergent
+// transaction is on that day or after the last assertion that held.
+func (s *ImportSession) FirstFailedAssertion() *BalanceAssertion {
+	for i := range s.BalanceAssertions {
+		if !s.BalanceAssertions[i].Holds() {
+			return &s.BalanceAssertions[i]
+		}
+	}
+	return nil
+}
+
+// Score rates from 0 to 100 how well the ledger agreed with the statement before
+// the import. Every missing or ledger-only transaction lowers it, and a balance
+// that still doesn't match after the import halves it.
+func (s *ImportSession) Score() float64 {
+	compared := s.TotalEntries() + len(s.LedgerOnlyTransactionIDs)
+	if compared == 0 {
+		if s.IsBalanced() {
+			return 100
+		}
+		return 0
+	}
+
+	score := float64(len(s.MatchedTransactionIDs)) / float64(compared) * 100
+	if !s.IsBalanced() {
+		score /= 2
+	}
+	return score
+}
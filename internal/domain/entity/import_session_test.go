@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportSession_Score(t *testing.T) {
+	session := NewImportSession(uuid.New(), "extrato.csv", time.Now().AddDate(0, -1, 0), time.Now())
+	session.MatchedTransactionIDs = []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	session.CreatedTransactionIDs = []uuid.UUID{uuid.New()}
+	session.LedgerBalance = valueobject.NewMoney(500.0, "BRL")
+
+	assert.Equal(t, 4, session.TotalEntries())
+	assert.Equal(t, 75.0, session.Score())
+
+	statement := valueobject.NewMoney(450.0, "BRL")
+	session.StatementBalance = &statement
+	assert.Equal(t, -50.0, session.BalanceDelta().Amount())
+	assert.Equal(t, 37.5, session.Score())
+}
+
+func TestImportSession_FirstFailedAssertion(t *testing.T) {
+	session := NewImportSession(uuid.New(), "extrato.csv", time.Now().AddDate(0, -1, 0), time.Now())
+	assert.Nil(t, session.FirstFailedAssertion())
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	session.BalanceAssertions = []BalanceAssertion{
+		{Date: day, Expected: valueobject.NewMoney(100.0, "BRL"), Actual: valueobject.NewMoney(100.001, "BRL")},
+		{Date: day.AddDate(0, 0, 1), Expected: valueobject.NewMoney(80.0, "BRL"), Actual: valueobject.NewMoney(90.0, "BRL")},
+		{Date: day.AddDate(0, 0, 2), Expected: valueobject.NewMoney(70.0, "BRL"), Actual: valueobject.NewMoney(60.0, "BRL")},
+	}
+
+	assert.True(t, session.BalanceAssertions[0].Holds())
+	failed := session.FirstFailedAssertion()
+	assert.NotNil(t, failed)
+	assert.Equal(t, day.AddDate(0, 0, 1), failed.Date)
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type InboxStatus string
+
+const (
+	InboxStatusPending  InboxStatus = "pending"
+	InboxStatusApproved InboxStatus = "approved"
+	InboxStatusRejected InboxStatus = "rejected"
+)
+
+// InboxTransaction is a transaction proposed by an import that waits in the
+// review inbox. It only affects balances once approved, when the proposed
+// transaction is posted with its original ID.
+type InboxTransaction struct {
+	ID          uuid.UUID
+	Source      string
+	Transaction *Transaction
+	Status      InboxStatus
+	ResolvedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewInboxTransaction(source string, transaction *Transaction) (*InboxTransaction, error) {
+	if transaction == nil {
+		return nil, fmt.Errorf("inbox transaction needs a proposed transaction")
+	}
+	if transaction.AccountID == nil && transaction.CreditCardID == nil {
+		return nil, fmt.Errorf("proposed transaction must belong to an account or credit card")
+	}
+
+	now := time.Now()
+	return &InboxTransaction{
+		ID:          uuid.New(),
+		Source:      source,
+		Transaction: transaction,
+		Status:      InboxStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (i *InboxTransaction) IsPending() bool {
+	return i.Status == InboxStatusPending
+}
+
+// Edit corrects the proposed transaction before it is approved
+func (i *InboxTransaction) Edit(description string, category TransactionCategory, amount valueobject.Money, date time.Time) error {
+	if !i.IsPending() {
+		return fmt.Errorf("only pending inbox transactions can be edited")
+	}
+	if strings.TrimSpace(description) == "" {
+		return fmt.Errorf("description is required")
+	}
+	if amount.IsNegative() || amount.IsZero() {
+		return fmt.Errorf("amount must be positive")
+	}
+
+	now := time.Now()
+	i.Transaction.Description = description
+	i.Transaction.Category = category
+	i.Transaction.Amount = amount
+	i.Transaction.Date = date
+	i.Transaction.UpdatedAt = now
+	i.UpdatedAt = now
+	return nil
+}
+
+func (i *InboxTransaction) Approve(resolvedAt time.Time) error {
+	return i.resolve(InboxStatusApproved, resolvedAt)
+}
+
+func (i *InboxTransaction) Reject(resolvedAt time.Time) error {
+	return i.resolve(InboxStatusRejected, resolvedAt)
+}
+
+func (i *InboxTransaction) resolve(status InboxStatus, resolvedAt time.Time) error {
+	if !i.IsPending() {
+		return fmt.Errorf("inbox transaction was already %s", i.Status)
+	}
+
+	i.Status = status
+	i.ResolvedAt = &resolvedAt
+	i.UpdatedAt = time.Now()
+	return nil
+}
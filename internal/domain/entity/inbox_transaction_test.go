@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxTransaction_Review(t *testing.T) {
+	accountID := uuid.New()
+	date := time.Date(2024, 5, 10, 0, 0, 0, 0, time.Local)
+	txn := NewTransaction(&accountID, nil, TransactionTypeDebit, TransactionCategoryOther, valueobject.NewMoney(42.9, "BRL"), "PIX MERCADO", date)
+
+	item, err := NewInboxTransaction("extrato.csv", txn)
+	require.NoError(t, err)
+	assert.True(t, item.IsPending())
+
+	assert.Error(t, item.Edit(" ", TransactionCategoryFood, valueobject.NewMoney(42.9, "BRL"), date))
+	assert.Error(t, item.Edit("Mercado", TransactionCategoryFood, valueobject.NewMoney(0, "BRL"), date))
+
+	require.NoError(t, item.Edit("Mercado", TransactionCategoryFood, valueobject.NewMoney(45.0, "BRL"), date.AddDate(0, 0, 1)))
+	assert.Equal(t, "Mercado", item.Transaction.Description)
+	assert.Equal(t, TransactionCategoryFood, item.Transaction.Category)
+	assert.Equal(t, 45.0, item.Transaction.Amount.Amount())
+
+	require.NoError(t, item.Approve(time.Now()))
+	assert.NotNil(t, item.ResolvedAt)
+	assert.Error(t, item.Reject(time.Now()))
+	assert.Error(t, item.Edit("Other", TransactionCategoryFood, valueobject.NewMoney(1, "BRL"), date))
+}
+
+func TestNewInboxTransaction_RequiresSource(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryOther, valueobject.NewMoney(10, "BRL"), "Orphan", time.Now())
+
+	_, err := NewInboxTransaction("extrato.csv", txn)
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxMacroSlot is how many hotkeys macros can be bound to, Alt+1 to Alt+9
+const MaxMacroSlot = 9
+
+// MacroKey is a recorded keystroke. Type holds the terminal key code, so the
+// domain doesn't depend on the TUI library, and Runes the typed characters.
+type MacroKey struct {
+	Type  int
+	Runes string
+	Alt   bool
+}
+
+// Macro is a named sequence of keystrokes replayed with the hotkey of its slot
+type Macro struct {
+	ID        uuid.UUID
+	Name      string
+	Slot      int
+	Keys      []MacroKey
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewMacro(name string, slot int, keys []MacroKey) (*Macro, error) {
+	if slot < 1 || slot > MaxMacroSlot {
+		return nil, fmt.Errorf("macro slot must be between 1 and %d", MaxMacroSlot)
+	}
+
+	now := time.Now()
+	macro := &Macro{
+		ID:        uuid.New(),
+		Slot:      slot,
+		CreatedAt: now,
+	}
+	if err := macro.Record(name, keys); err != nil {
+		return nil, err
+	}
+	macro.UpdatedAt = now
+	return macro, nil
+}
+
+// Record replaces the name and keystrokes, used when a new macro is saved to a
+// slot that is already taken
+func (m *Macro) Record(name string, keys []MacroKey) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("macro name is required")
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("macro has no keystrokes")
+	}
+
+	m.Name = name
+	m.Keys = keys
+	m.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMacro(t *testing.T) {
+	keys := []MacroKey{{Type: -1, Runes: "n"}, {Type: 13}}
+
+	macro, err := NewMacro(" Groceries with Alice ", 3, keys)
+	require.NoError(t, err)
+	assert.Equal(t, "Groceries with Alice", macro.Name)
+	assert.Equal(t, 3, macro.Slot)
+	assert.Len(t, macro.Keys, 2)
+
+	_, err = NewMacro("Groceries", 0, keys)
+	assert.Error(t, err)
+	_, err = NewMacro("Groceries", MaxMacroSlot+1, keys)
+	assert.Error(t, err)
+	_, err = NewMacro("", 1, keys)
+	assert.Error(t, err)
+	_, err = NewMacro("Groceries", 1, nil)
+	assert.Error(t, err)
+}
+
+func TestMacro_Record(t *testing.T) {
+	macro, err := NewMacro("Groceries", 1, []MacroKey{{Type: -1, Runes: "n"}})
+	require.NoError(t, err)
+
+	require.NoError(t, macro.Record("Rent", []MacroKey{{Type: -1, Runes: "5"}, {Type: -1, Runes: "n"}}))
+	assert.Equal(t, "Rent", macro.Name)
+	assert.Len(t, macro.Keys, 2)
+
+	assert.Error(t, macro.Record("Rent", nil))
+	assert.Equal(t, "Rent", macro.Name)
+	assert.Len(t, macro.Keys, 2)
+}
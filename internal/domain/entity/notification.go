@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationSeverity tells how urgent a notification is
+type NotificationSeverity string
+
+const (
+	NotificationSeverityInfo     NotificationSeverity = "info"
+	NotificationSeverityWarning  NotificationSeverity = "warning"
+	NotificationSeverityCritical NotificationSeverity = "critical"
+)
+
+// Notification is a message kept in the notifications center. Key identifies
+// what it is about, so the same event is never notified twice.
+type Notification struct {
+	ID        uuid.UUID
+	Key       string
+	Title     string
+	Body      string
+	Severity  NotificationSeverity
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
+
+func NewNotification(key, title, body string) (*Notification, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("notification title is required")
+	}
+
+	return &Notification{
+		ID:        uuid.New(),
+		Key:       key,
+		Title:     title,
+		Body:      body,
+		Severity:  NotificationSeverityInfo,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func (n *Notification) MarkRead() {
+	if n.ReadAt != nil {
+		return
+	}
+	now := time.Now()
+	n.ReadAt = &now
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotification(t *testing.T) {
+	_, err := NewNotification("weekly-digest:2026-10-05", "  ", "body")
+	assert.Error(t, err)
+
+	notification, err := NewNotification("weekly-digest:2026-10-05", " Weekly digest ", "body")
+	require.NoError(t, err)
+	assert.Equal(t, "Weekly digest", notification.Title)
+	assert.False(t, notification.IsRead())
+}
+
+func TestNotification_MarkRead(t *testing.T) {
+	notification, err := NewNotification("key", "Title", "")
+	require.NoError(t, err)
+
+	notification.MarkRead()
+	require.True(t, notification.IsRead())
+	readAt := *notification.ReadAt
+
+	// Reading it again keeps the first read time
+	notification.MarkRead()
+	assert.Equal(t, readAt, *notification.ReadAt)
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type PendingPaymentStatus string
+
+const (
+	PendingPaymentStatusPending   PendingPaymentStatus = "pending"
+	PendingPaymentStatusCleared   PendingPaymentStatus = "cleared"
+	PendingPaymentStatusCancelled PendingPaymentStatus = "cancelled"
+)
+
+// PendingPayment is a card payment scheduled from the linked account that
+// hasn't cleared yet. While pending it reserves the amount on the account
+// without changing the card balance.
+type PendingPayment struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	CreditCardID uuid.UUID
+	InvoiceID    *uuid.UUID
+	Amount       valueobject.Money
+	ScheduledFor time.Time
+	Status       PendingPaymentStatus
+	ClearedAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewPendingPayment(accountID, creditCardID uuid.UUID, invoiceID *uuid.UUID, amount valueobject.Money, scheduledFor time.Time) (*PendingPayment, error) {
+	if amount.IsNegative() || amount.IsZero() {
+		return nil, fmt.Errorf("payment amount must be positive")
+	}
+
+	now := time.Now()
+	return &PendingPayment{
+		ID:           uuid.New(),
+		AccountID:    accountID,
+		CreditCardID: creditCardID,
+		InvoiceID:    invoiceID,
+		Amount:       amount,
+		ScheduledFor: scheduledFor,
+		Status:       PendingPaymentStatusPending,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func (p *PendingPayment) IsPending() bool {
+	return p.Status == PendingPaymentStatusPending
+}
+
+// IsDue reports whether the payment should clear, which happens from the start
+// of its scheduled day
+func (p *PendingPayment) IsDue(now time.Time) bool {
+	day := time.Date(p.ScheduledFor.Year(), p.ScheduledFor.Month(), p.ScheduledFor.Day(), 0, 0, 0, 0, p.ScheduledFor.Location())
+	return p.IsPending() && !now.Before(day)
+}
+
+func (p *PendingPayment) MarkCleared(clearedAt time.Time) error {
+	if !p.IsPending() {
+		return fmt.Errorf("payment is not pending")
+	}
+
+	p.Status = PendingPaymentStatusCleared
+	p.ClearedAt = &clearedAt
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+func (p *PendingPayment) Cancel() error {
+	if !p.IsPending() {
+		return fmt.Errorf("payment is not pending")
+	}
+
+	p.Status = PendingPaymentStatusCancelled
+	p.UpdatedAt = time.Now()
+	return nil
+}
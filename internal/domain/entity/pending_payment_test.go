@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingPayment_IsDue(t *testing.T) {
+	scheduledFor := time.Date(2024, 5, 10, 15, 0, 0, 0, time.Local)
+	payment, err := NewPendingPayment(uuid.New(), uuid.New(), nil, valueobject.NewMoney(300.0, "BRL"), scheduledFor)
+	require.NoError(t, err)
+
+	assert.False(t, payment.IsDue(scheduledFor.AddDate(0, 0, -1)))
+	assert.True(t, payment.IsDue(time.Date(2024, 5, 10, 8, 0, 0, 0, time.Local)))
+
+	require.NoError(t, payment.MarkCleared(scheduledFor))
+	assert.False(t, payment.IsDue(scheduledFor))
+	assert.Error(t, payment.Cancel())
+}
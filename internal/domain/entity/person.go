@@ -1,18 +1,21 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Person struct {
-	ID        uuid.UUID
-	Name      string
-	Email     string
-	Phone     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                uuid.UUID
+	Name              string
+	Email             string
+	Phone             string
+	NotifyOwedAmounts bool   // Opted into the monthly email with what they owe
+	LastOwedNotice    string // Month (YYYY-MM) of the last owed-amount email sent
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 func NewPerson(name, email, phone string) *Person {
@@ -33,3 +36,23 @@ func (p *Person) Update(name, email, phone string) {
 	p.Phone = phone
 	p.UpdatedAt = time.Now()
 }
+
+func (p *Person) SetOwedNotice(enabled bool) error {
+	if enabled && p.Email == "" {
+		return fmt.Errorf("an email is required for the monthly summary")
+	}
+
+	p.NotifyOwedAmounts = enabled
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// NeedsOwedNotice tells whether the summary for month (YYYY-MM) is still to be sent
+func (p *Person) NeedsOwedNotice(month string) bool {
+	return p.NotifyOwedAmounts && p.Email != "" && p.LastOwedNotice < month
+}
+
+func (p *Person) MarkOwedNoticeSent(month string) {
+	p.LastOwedNotice = month
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerson_OwedNotice(t *testing.T) {
+	person := NewPerson("Ana", "", "")
+	assert.Error(t, person.SetOwedNotice(true))
+	assert.False(t, person.NeedsOwedNotice("2024-03"))
+
+	person.Update("Ana", "ana@example.com", "")
+	require.NoError(t, person.SetOwedNotice(true))
+	assert.True(t, person.NeedsOwedNotice("2024-03"))
+
+	person.MarkOwedNoticeSent("2024-03")
+	assert.False(t, person.NeedsOwedNotice("2024-03"))
+	assert.True(t, person.NeedsOwedNotice("2024-04"))
+}
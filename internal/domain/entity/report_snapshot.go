@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// ReportSnapshot is a month's report as computed once the month was over, kept
+// so that later recategorizations and rule changes don't silently rewrite what
+// the month looked like when it closed
+type ReportSnapshot struct {
+	ID uuid.UUID
+	// Month is the first day of the month
+	Month            time.Time
+	Income           valueobject.Money
+	Expenses         valueobject.Money
+	TransactionCount int
+	Categories       map[TransactionCategory]valueobject.Money
+	// TakenAt is when the figures were computed
+	TakenAt   time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewReportSnapshot(month time.Time, income, expenses valueobject.Money, transactionCount int, categories map[TransactionCategory]valueobject.Money, takenAt time.Time) *ReportSnapshot {
+	return &ReportSnapshot{
+		ID:               uuid.New(),
+		Month:            PeriodMonth(month),
+		Income:           income,
+		Expenses:         expenses,
+		TransactionCount: transactionCount,
+		Categories:       categories,
+		TakenAt:          takenAt,
+		CreatedAt:        takenAt,
+		UpdatedAt:        takenAt,
+	}
+}
+
+// Key identifies the month as YYYY-MM
+func (s *ReportSnapshot) Key() string {
+	return s.Month.Format("2006-01")
+}
+
+func (s *ReportSnapshot) Net() valueobject.Money {
+	net, _ := s.Income.Subtract(s.Expenses)
+	return net
+}
+
+// Retake replaces the figures with freshly computed ones, as when the books of
+// the month are closed
+func (s *ReportSnapshot) Retake(income, expenses valueobject.Money, transactionCount int, categories map[TransactionCategory]valueobject.Money, takenAt time.Time) {
+	s.Income = income
+	s.Expenses = expenses
+	s.TransactionCount = transactionCount
+	s.Categories = categories
+	s.TakenAt = takenAt
+	s.UpdatedAt = takenAt
+}
+
+// Matches tells whether recomputed figures still agree with the snapshot, to
+// the cent
+func (s *ReportSnapshot) Matches(income, expenses valueobject.Money, transactionCount int, categories map[TransactionCategory]valueobject.Money) bool {
+	if !sameCents(s.Income, income) || !sameCents(s.Expenses, expenses) || s.TransactionCount != transactionCount {
+		return false
+	}
+
+	for category, amount := range categories {
+		if amount.IsZero() {
+			continue
+		}
+		if snapshot, ok := s.Categories[category]; !ok || !sameCents(snapshot, amount) {
+			return false
+		}
+	}
+	for category, amount := range s.Categories {
+		if _, ok := categories[category]; !ok && !amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+func sameCents(a, b valueobject.Money) bool {
+	return a.Currency() == b.Currency() && math.Round(a.Amount()*100) == math.Round(b.Amount()*100)
+}
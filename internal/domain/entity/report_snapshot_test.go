@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReportSnapshot(t *testing.T) {
+	categories := map[TransactionCategory]valueobject.Money{TransactionCategoryFood: valueobject.NewMoney(300, "BRL")}
+	snapshot := NewReportSnapshot(time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC), valueobject.NewMoney(5000, "BRL"), valueobject.NewMoney(300, "BRL"), 4, categories, time.Now())
+
+	assert.Equal(t, "2026-03", snapshot.Key())
+	assert.Equal(t, 4700.0, snapshot.Net().Amount())
+}
+
+func TestReportSnapshot_Matches(t *testing.T) {
+	income, expenses := valueobject.NewMoney(5000, "BRL"), valueobject.NewMoney(300, "BRL")
+	categories := map[TransactionCategory]valueobject.Money{
+		TransactionCategoryFood:   valueobject.NewMoney(300, "BRL"),
+		TransactionCategoryIncome: valueobject.NewMoney(5000, "BRL"),
+	}
+	snapshot := NewReportSnapshot(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), income, expenses, 2, categories, time.Now())
+
+	assert.True(t, snapshot.Matches(valueobject.NewMoney(5000.001, "BRL"), expenses, 2, categories))
+	assert.False(t, snapshot.Matches(income, expenses, 3, categories))
+
+	recategorized := map[TransactionCategory]valueobject.Money{
+		TransactionCategoryShopping: valueobject.NewMoney(300, "BRL"),
+		TransactionCategoryIncome:   valueobject.NewMoney(5000, "BRL"),
+	}
+	assert.False(t, snapshot.Matches(income, expenses, 2, recategorized))
+
+	snapshot.Retake(income, expenses, 2, recategorized, time.Now())
+	assert.True(t, snapshot.Matches(income, expenses, 2, recategorized))
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type ScheduledTransferStatus string
+
+const (
+	ScheduledTransferStatusPending   ScheduledTransferStatus = "pending"
+	ScheduledTransferStatusDone      ScheduledTransferStatus = "done"
+	ScheduledTransferStatusCancelled ScheduledTransferStatus = "cancelled"
+)
+
+// ScheduledTransfer is a one-off transfer between two of the user's accounts
+// made on a future date, like moving money to cover a payment coming due
+type ScheduledTransfer struct {
+	ID            uuid.UUID
+	FromAccountID uuid.UUID
+	ToAccountID   uuid.UUID
+	Amount        valueobject.Money
+	ScheduledFor  time.Time
+	Description   string
+	Status        ScheduledTransferStatus
+	TransferredAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewScheduledTransfer(fromAccountID, toAccountID uuid.UUID, amount valueobject.Money, scheduledFor time.Time, description string) (*ScheduledTransfer, error) {
+	if fromAccountID == toAccountID {
+		return nil, fmt.Errorf("source and destination accounts must be different")
+	}
+	if amount.IsNegative() || amount.IsZero() {
+		return nil, fmt.Errorf("transfer amount must be positive")
+	}
+
+	now := time.Now()
+	return &ScheduledTransfer{
+		ID:            uuid.New(),
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		ScheduledFor:  scheduledFor,
+		Description:   strings.TrimSpace(description),
+		Status:        ScheduledTransferStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (t *ScheduledTransfer) IsPending() bool {
+	return t.Status == ScheduledTransferStatusPending
+}
+
+// IsDue reports whether the transfer should be made, which happens from the
+// start of its scheduled day
+func (t *ScheduledTransfer) IsDue(now time.Time) bool {
+	day := time.Date(t.ScheduledFor.Year(), t.ScheduledFor.Month(), t.ScheduledFor.Day(), 0, 0, 0, 0, t.ScheduledFor.Location())
+	return t.IsPending() && !now.Before(day)
+}
+
+func (t *ScheduledTransfer) MarkDone(transferredAt time.Time) error {
+	if !t.IsPending() {
+		return fmt.Errorf("transfer is not pending")
+	}
+
+	t.Status = ScheduledTransferStatusDone
+	t.TransferredAt = &transferredAt
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func (t *ScheduledTransfer) Cancel() error {
+	if !t.IsPending() {
+		return fmt.Errorf("transfer is not pending")
+	}
+
+	t.Status = ScheduledTransferStatusCancelled
+	t.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledTransfer(t *testing.T) {
+	from, to := uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewScheduledTransfer(from, from, valueobject.NewMoney(100, "BRL"), date, "")
+	assert.Error(t, err)
+	_, err = NewScheduledTransfer(from, to, valueobject.NewMoney(0, "BRL"), date, "")
+	assert.Error(t, err)
+
+	transfer, err := NewScheduledTransfer(from, to, valueobject.NewMoney(100, "BRL"), date, "  Cover rent ")
+	require.NoError(t, err)
+	assert.Equal(t, "Cover rent", transfer.Description)
+	assert.True(t, transfer.IsPending())
+}
+
+func TestScheduledTransfer_IsDue(t *testing.T) {
+	transfer, err := NewScheduledTransfer(uuid.New(), uuid.New(), valueobject.NewMoney(100, "BRL"), time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC), "")
+	require.NoError(t, err)
+
+	assert.False(t, transfer.IsDue(time.Date(2026, time.March, 9, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, transfer.IsDue(time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, transfer.MarkDone(time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)))
+	assert.False(t, transfer.IsDue(time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)))
+	assert.Error(t, transfer.Cancel())
+}
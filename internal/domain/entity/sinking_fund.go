@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// SinkingFund is a yearly budget for an infrequent expense (IPVA, insurance,
+// gifts) that is set aside in equal monthly parts until its due month
+type SinkingFund struct {
+	ID           uuid.UUID
+	Name         string
+	AnnualAmount valueobject.Money
+	DueMonth     time.Month
+
+	// Transactions count against the fund when they match the category and,
+	// if set, contain the keyword in their description
+	Category TransactionCategory
+	Keyword  string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewSinkingFund(name string, annualAmount valueobject.Money, dueMonth time.Month, category TransactionCategory, keyword string) (*SinkingFund, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("sinking fund name is required")
+	}
+
+	if annualAmount.IsNegative() || annualAmount.IsZero() {
+		return nil, fmt.Errorf("annual amount must be positive")
+	}
+
+	if dueMonth < time.January || dueMonth > time.December {
+		return nil, fmt.Errorf("due month must be between 1 and 12")
+	}
+
+	now := time.Now()
+	return &SinkingFund{
+		ID:           uuid.New(),
+		Name:         name,
+		AnnualAmount: annualAmount,
+		DueMonth:     dueMonth,
+		Category:     category,
+		Keyword:      strings.TrimSpace(keyword),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func (f *SinkingFund) MonthlyAccrual() valueobject.Money {
+	return f.AnnualAmount.Multiply(1.0 / 12)
+}
+
+// NextDueDate returns the first day of the next due month, which is the current
+// month while it is the due month
+func (f *SinkingFund) NextDueDate(now time.Time) time.Time {
+	due := time.Date(now.Year(), f.DueMonth, 1, 0, 0, 0, 0, now.Location())
+	if due.Before(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())) {
+		due = due.AddDate(1, 0, 0)
+	}
+	return due
+}
+
+// CycleStart returns the first day of the current saving cycle: the month right
+// after the previous due month
+func (f *SinkingFund) CycleStart(now time.Time) time.Time {
+	return f.NextDueDate(now).AddDate(-1, 1, 0)
+}
+
+// MonthsAccrued counts the months of the current cycle up to and including now,
+// so the full amount is expected by the due month
+func (f *SinkingFund) MonthsAccrued(now time.Time) int {
+	start := f.CycleStart(now)
+	months := (now.Year()-start.Year())*12 + int(now.Month()) - int(start.Month()) + 1
+	if months > 12 {
+		months = 12
+	}
+	return months
+}
+
+// ExpectedSetAside returns how much should already be saved for this cycle
+func (f *SinkingFund) ExpectedSetAside(now time.Time) valueobject.Money {
+	return f.AnnualAmount.Multiply(float64(f.MonthsAccrued(now)) / 12)
+}
+
+// Matches reports whether a transaction is spending from this fund
+func (f *SinkingFund) Matches(transaction *Transaction) bool {
+	if transaction.Type != TransactionTypeDebit || transaction.IgnoreFromBudget {
+		return false
+	}
+
+	if f.Category != "" && transaction.Category != f.Category {
+		return false
+	}
+
+	if f.Keyword != "" && !strings.Contains(strings.ToLower(transaction.Description), strings.ToLower(f.Keyword)) {
+		return false
+	}
+
+	return f.Category != "" || f.Keyword != ""
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkingFund_ExpectedSetAside(t *testing.T) {
+	fund, err := NewSinkingFund("IPVA", valueobject.NewMoney(2400.0, "BRL"), time.March, TransactionCategoryTransportation, "ipva")
+	require.NoError(t, err)
+
+	assert.Equal(t, 200.0, fund.MonthlyAccrual().Amount())
+
+	// The cycle for a March expense runs from April through the next March
+	now := time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), fund.NextDueDate(now))
+	assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), fund.CycleStart(now))
+	assert.Equal(t, 3, fund.MonthsAccrued(now))
+	assert.Equal(t, 600.0, fund.ExpectedSetAside(now).Amount())
+
+	dueMonth := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 2400.0, fund.ExpectedSetAside(dueMonth).Amount())
+}
+
+func TestSinkingFund_Matches(t *testing.T) {
+	fund, err := NewSinkingFund("IPVA", valueobject.NewMoney(2400.0, "BRL"), time.March, TransactionCategoryTransportation, "ipva")
+	require.NoError(t, err)
+
+	amount := valueobject.NewMoney(800.0, "BRL")
+	ipva := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryTransportation, amount, "IPVA 2024 parcela 1", time.Now())
+	fuel := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryTransportation, amount, "Posto Shell", time.Now())
+
+	assert.True(t, fund.Matches(ipva))
+	assert.False(t, fund.Matches(fuel))
+
+	ipva.SetIgnoreFromBudget(true)
+	assert.False(t, fund.Matches(ipva))
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// StandingOrder is an automatic transfer between two of the user's accounts
+// repeated every month on DayOfMonth, like moving part of the salary to savings
+type StandingOrder struct {
+	ID            uuid.UUID
+	FromAccountID uuid.UUID
+	ToAccountID   uuid.UUID
+	Amount        valueobject.Money
+	DayOfMonth    int // 1-31; shorter months transfer on their last day
+	Description   string
+	LastRunMonth  string // Reference month (YYYY-MM) of the last transfer
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewStandingOrder schedules a monthly transfer. The first transfer runs on the
+// next due date, so months before the order was created are never transferred.
+func NewStandingOrder(fromAccountID, toAccountID uuid.UUID, amount valueobject.Money, dayOfMonth int, description string, now time.Time) (*StandingOrder, error) {
+	if fromAccountID == toAccountID {
+		return nil, fmt.Errorf("source and destination accounts must be different")
+	}
+	if amount.IsNegative() || amount.IsZero() {
+		return nil, fmt.Errorf("transfer amount must be positive")
+	}
+	if dayOfMonth < 1 || dayOfMonth > 31 {
+		return nil, fmt.Errorf("transfer day must be between 1 and 31")
+	}
+
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if now.Before(feeChargeDate(month, dayOfMonth)) {
+		month = month.AddDate(0, -1, 0)
+	}
+
+	return &StandingOrder{
+		ID:            uuid.New(),
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		DayOfMonth:    dayOfMonth,
+		Description:   strings.TrimSpace(description),
+		LastRunMonth:  month.Format("2006-01"),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// DueDates returns the transfer dates up to now that haven't run yet, oldest
+// first, so months missed while the app wasn't running are caught up
+func (o *StandingOrder) DueDates(now time.Time) ([]time.Time, error) {
+	last, err := time.ParseInLocation("2006-01", o.LastRunMonth, now.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid last run month: %w", err)
+	}
+
+	var dates []time.Time
+	for month := last.AddDate(0, 1, 0); ; month = month.AddDate(0, 1, 0) {
+		date := feeChargeDate(month, o.DayOfMonth)
+		if date.After(now) {
+			break
+		}
+		dates = append(dates, date)
+	}
+	return dates, nil
+}
+
+// NextRunDate returns the date of the next transfer still to run
+func (o *StandingOrder) NextRunDate(now time.Time) time.Time {
+	last, err := time.ParseInLocation("2006-01", o.LastRunMonth, now.Location())
+	if err != nil {
+		last = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, now.Location())
+	}
+	return feeChargeDate(last.AddDate(0, 1, 0), o.DayOfMonth)
+}
+
+// UpcomingDates returns the dates of the transfers still to run up to until,
+// the next one first
+func (o *StandingOrder) UpcomingDates(now, until time.Time) []time.Time {
+	var dates []time.Time
+	for date := o.NextRunDate(now); !date.After(until); {
+		dates = append(dates, date)
+		next := time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, date.Location())
+		date = feeChargeDate(next, o.DayOfMonth)
+	}
+	return dates
+}
+
+// MarkRun records the transfer of the date's month as done
+func (o *StandingOrder) MarkRun(date time.Time) {
+	o.LastRunMonth = date.Format("2006-01")
+	o.UpdatedAt = time.Now()
+}
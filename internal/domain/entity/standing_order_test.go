@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStandingOrder(t *testing.T) {
+	from, to := uuid.New(), uuid.New()
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	_, err := NewStandingOrder(from, from, valueobject.NewMoney(500, "BRL"), 6, "", now)
+	assert.Error(t, err)
+	_, err = NewStandingOrder(from, to, valueobject.NewMoney(0, "BRL"), 6, "", now)
+	assert.Error(t, err)
+	_, err = NewStandingOrder(from, to, valueobject.NewMoney(500, "BRL"), 0, "", now)
+	assert.Error(t, err)
+
+	// Already past this month's day: the first transfer is next month
+	order, err := NewStandingOrder(from, to, valueobject.NewMoney(500, "BRL"), 6, " Savings ", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03", order.LastRunMonth)
+	assert.Equal(t, "Savings", order.Description)
+	assert.Equal(t, time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC), order.NextRunDate(now))
+
+	order, err = NewStandingOrder(from, to, valueobject.NewMoney(500, "BRL"), 15, "", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-02", order.LastRunMonth)
+}
+
+func TestStandingOrder_DueDates(t *testing.T) {
+	order, err := NewStandingOrder(uuid.New(), uuid.New(), valueobject.NewMoney(500, "BRL"), 31, "", time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// February transfers on its last day, March not before the 31st
+	dates, err := order.DueDates(time.Date(2026, time.March, 30, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+	assert.Equal(t, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC), dates[0])
+
+	// Missed months are caught up in order
+	dates, err = order.DueDates(time.Date(2026, time.May, 31, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, dates, 4)
+	assert.Equal(t, time.May, dates[3].Month())
+
+	for _, date := range dates {
+		order.MarkRun(date)
+	}
+	dates, err = order.DueDates(time.Date(2026, time.May, 31, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, dates)
+}
+
+func TestStandingOrder_UpcomingDates(t *testing.T) {
+	order, err := NewStandingOrder(uuid.New(), uuid.New(), valueobject.NewMoney(500, "BRL"), 31, "", time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	now := time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC)
+	dates := order.UpcomingDates(now, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC))
+	require.Len(t, dates, 2)
+	assert.Equal(t, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC), dates[0])
+	assert.Equal(t, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), dates[1])
+
+	assert.Empty(t, order.UpcomingDates(now, time.Date(2026, time.February, 27, 0, 0, 0, 0, time.UTC)))
+}
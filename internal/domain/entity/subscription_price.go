@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// SubscriptionPrice is the price the user has acknowledged for a detected
+// subscription. Charges that differ from it raise a price change alert.
+type SubscriptionPrice struct {
+	ID             uuid.UUID
+	Key            string // Normalized description identifying the subscription
+	Description    string
+	Amount         valueobject.Money
+	AcknowledgedAt time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewSubscriptionPrice(description string, amount valueobject.Money) *SubscriptionPrice {
+	now := time.Now()
+	return &SubscriptionPrice{
+		ID:             uuid.New(),
+		Key:            SubscriptionKey(description),
+		Description:    description,
+		Amount:         amount,
+		AcknowledgedAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Acknowledge accepts a new price for the subscription
+func (s *SubscriptionPrice) Acknowledge(amount valueobject.Money) {
+	now := time.Now()
+	s.Amount = amount
+	s.AcknowledgedAt = now
+	s.UpdatedAt = now
+}
+
+// SubscriptionKey normalizes a charge description so that the monthly charges
+// of the same subscription share a key
+func SubscriptionKey(description string) string {
+	return strings.ToLower(strings.Join(strings.Fields(description), " "))
+}
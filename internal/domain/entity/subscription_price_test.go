@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"testing"
+
+	"financli/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionKey(t *testing.T) {
+	assert.Equal(t, "netflix.com sao paulo", SubscriptionKey("  NETFLIX.COM   Sao Paulo "))
+}
+
+func TestSubscriptionPrice_Acknowledge(t *testing.T) {
+	price := NewSubscriptionPrice("Spotify", valueobject.NewMoney(21.90, "BRL"))
+	assert.Equal(t, "spotify", price.Key)
+
+	price.Acknowledge(valueobject.NewMoney(23.90, "BRL"))
+	assert.Equal(t, 23.90, price.Amount.Amount())
+}
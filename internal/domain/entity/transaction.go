@@ -2,7 +2,9 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"financli/internal/domain/valueobject"
 	"github.com/google/uuid"
@@ -30,18 +32,52 @@ const (
 	TransactionCategoryOther          TransactionCategory = "other"
 )
 
+// PaymentMethod tells how a transaction was paid, which bank statements list
+// separately; empty when it wasn't recorded
+type PaymentMethod string
+
+const (
+	PaymentMethodPix       PaymentMethod = "pix"
+	PaymentMethodBoleto    PaymentMethod = "boleto"
+	PaymentMethodDebitCard PaymentMethod = "debit_card"
+	PaymentMethodCash      PaymentMethod = "cash"
+	PaymentMethodTransfer  PaymentMethod = "transfer"
+)
+
+// PaymentMethods lists the payment methods a transaction can be tagged with
+func PaymentMethods() []PaymentMethod {
+	return []PaymentMethod{PaymentMethodPix, PaymentMethodBoleto, PaymentMethodDebitCard, PaymentMethodCash, PaymentMethodTransfer}
+}
+
+func (p PaymentMethod) IsValid() bool {
+	for _, method := range PaymentMethods() {
+		if p == method {
+			return true
+		}
+	}
+	return false
+}
+
 type Transaction struct {
 	ID                  uuid.UUID
 	AccountID           *uuid.UUID
 	CreditCardID        *uuid.UUID
 	CreditCardInvoiceID *uuid.UUID
 	BillID              *uuid.UUID
+	TransferID          *uuid.UUID // Shared by both sides of a transfer between accounts
 	Type                TransactionType
 	Category            TransactionCategory
 	Amount              valueobject.Money
 	Description         string
 	Date                time.Time
 	SharedWith          []SharedExpense
+	IgnoreFromBudget    bool
+	City                string // Optional, where the money was spent
+	Venue               string
+	Client              string // Optional, who a business expense is billed to
+	Project             string
+	PaymentMethod       PaymentMethod // Optional, empty when not recorded
+	Tags                []string      // Free-form labels beyond the category, such as "trip-2026"
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
@@ -77,11 +113,46 @@ func NewTransaction(
 	}
 }
 
+// NewTransfer records money moved between two of the user's accounts as a debit
+// on the source paired with a credit on the destination, both in the Transfer
+// category so they count as neither income nor expense
+func NewTransfer(fromAccountID, toAccountID uuid.UUID, amount valueobject.Money, fromDescription, toDescription string, date time.Time) (*Transaction, *Transaction) {
+	transferID := uuid.New()
+
+	debit := NewTransaction(&fromAccountID, nil, TransactionTypeDebit, TransactionCategoryTransfer, amount, fromDescription, date)
+	debit.TransferID = &transferID
+	credit := NewTransaction(&toAccountID, nil, TransactionTypeCredit, TransactionCategoryTransfer, amount, toDescription, date)
+	credit.TransferID = &transferID
+
+	return debit, credit
+}
+
+// NewInvoicePayment records paying a card invoice from the card's linked
+// account: a debit on the account paired with a credit on the card, kept on
+// the paid invoice. Both are in the Transfer category, since the charges on the
+// invoice already counted as expenses.
+func NewInvoicePayment(accountID, creditCardID, invoiceID uuid.UUID, amount valueobject.Money, description string, date time.Time) (*Transaction, *Transaction) {
+	transferID := uuid.New()
+
+	debit := NewTransaction(&accountID, nil, TransactionTypeDebit, TransactionCategoryTransfer, amount, description, date)
+	debit.TransferID = &transferID
+	credit := NewTransaction(nil, &creditCardID, TransactionTypeCredit, TransactionCategoryTransfer, amount, description, date)
+	credit.TransferID = &transferID
+	credit.AssignToCreditCardInvoice(invoiceID)
+
+	return debit, credit
+}
+
 func (t *Transaction) AssignToBill(billID uuid.UUID) {
 	t.BillID = &billID
 	t.UpdatedAt = time.Now()
 }
 
+func (t *Transaction) UnassignFromBill() {
+	t.BillID = nil
+	t.UpdatedAt = time.Now()
+}
+
 func (t *Transaction) AssignToCreditCardInvoice(invoiceID uuid.UUID) {
 	t.CreditCardInvoiceID = &invoiceID
 	t.UpdatedAt = time.Now()
@@ -113,6 +184,16 @@ func (t *Transaction) AddSharedExpense(personID uuid.UUID, percentage float64) e
 	return nil
 }
 
+// IsSharedWith tells whether the person already has a share of the transaction
+func (t *Transaction) IsSharedWith(personID uuid.UUID) bool {
+	for _, shared := range t.SharedWith {
+		if shared.PersonID == personID {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *Transaction) SplitEqually(personIDs []uuid.UUID) error {
 	if len(personIDs) == 0 {
 		return fmt.Errorf("must provide at least one person to split with")
@@ -146,6 +227,184 @@ func (t *Transaction) GetPersonalAmount() valueobject.Money {
 	return t.Amount.Multiply(personalPercentage / 100)
 }
 
+// SetAmount changes the amount of the transaction, rescaling what each person owes
+// so the shared percentages stay the same
+func (t *Transaction) SetAmount(amount valueobject.Money) error {
+	if amount.IsNegative() || amount.IsZero() {
+		return fmt.Errorf("amount must be positive")
+	}
+	if amount.Currency() != t.Amount.Currency() {
+		return fmt.Errorf("currency mismatch: %s vs %s", amount.Currency(), t.Amount.Currency())
+	}
+
+	t.Amount = amount
+	for i := range t.SharedWith {
+		t.SharedWith[i].Amount = amount.Multiply(t.SharedWith[i].Percentage / 100)
+	}
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func (t *Transaction) SetCategory(category TransactionCategory) {
+	t.Category = category
+	t.UpdatedAt = time.Now()
+}
+
+// Revise replaces the editable details of the transaction, reporting whether
+// its effect on balances changed: another account or card, type, amount or
+// date. When it did, the transaction leaves its invoice so it can be assigned
+// again, and a new date also drops the bill it was on. Without an account or
+// card the transaction becomes a cash one.
+func (t *Transaction) Revise(accountID, creditCardID *uuid.UUID, transactionType TransactionType, category TransactionCategory, amount valueobject.Money, description string, date time.Time) (bool, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return false, fmt.Errorf("description is required")
+	}
+	if accountID != nil && creditCardID != nil {
+		return false, fmt.Errorf("transaction can't belong to both an account and a credit card")
+	}
+
+	moved := !sameID(accountID, t.AccountID) || !sameID(creditCardID, t.CreditCardID) ||
+		transactionType != t.Type || !amount.Equals(t.Amount) || !date.Equal(t.Date)
+
+	if err := t.SetAmount(amount); err != nil {
+		return false, err
+	}
+	if moved {
+		t.CreditCardInvoiceID = nil
+	}
+	if !date.Equal(t.Date) {
+		t.BillID = nil
+	}
+
+	t.AccountID = accountID
+	t.CreditCardID = creditCardID
+	t.Type = transactionType
+	t.Category = category
+	t.Description = description
+	t.Date = date
+	t.UpdatedAt = time.Now()
+	return moved, nil
+}
+
+func sameID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SetIgnoreFromBudget excludes (or re-includes) the transaction from budget tracking
+func (t *Transaction) SetIgnoreFromBudget(ignore bool) {
+	t.IgnoreFromBudget = ignore
+	t.UpdatedAt = time.Now()
+}
+
+// SetLocation records the city and venue of the transaction; empty values clear them
+func (t *Transaction) SetLocation(city, venue string) {
+	t.City = strings.TrimSpace(city)
+	t.Venue = strings.TrimSpace(venue)
+	t.UpdatedAt = time.Now()
+}
+
+// SetBusinessTags records the client and project a business expense is billed
+// to; empty values clear them
+func (t *Transaction) SetBusinessTags(client, project string) {
+	t.Client = strings.TrimSpace(client)
+	t.Project = strings.TrimSpace(project)
+	t.UpdatedAt = time.Now()
+}
+
+// SetPaymentMethod records how the transaction was paid; an empty method clears it
+func (t *Transaction) SetPaymentMethod(method PaymentMethod) error {
+	if method != "" && !method.IsValid() {
+		return fmt.Errorf("invalid payment method: %s", method)
+	}
+	t.PaymentMethod = method
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetTags replaces the tags of the transaction, normalized by NormalizeTags
+func (t *Transaction) SetTags(tags []string) {
+	t.Tags = NormalizeTags(tags)
+	t.UpdatedAt = time.Now()
+}
+
+// HasTag reports whether the transaction is tagged with tag, in any case
+func (t *Transaction) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, own := range t.Tags {
+		if own == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeTags lowercases the tags and drops the empty and repeated ones,
+// keeping their order. Tags can't hold commas or spaces, so a tag with them is
+// split into several.
+func NormalizeTags(tags []string) []string {
+	var normalized []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		for _, part := range ParseTags(tag) {
+			if !seen[part] {
+				seen[part] = true
+				normalized = append(normalized, part)
+			}
+		}
+	}
+	return normalized
+}
+
+// ParseTags splits tags typed as "trip, work" or "trip work" into lowercase tags
+func ParseTags(input string) []string {
+	return strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
+
+// IsBusiness reports whether the transaction is tagged with a client or project
+func (t *Transaction) IsBusiness() bool {
+	return t.Client != "" || t.Project != ""
+}
+
+// BusinessLabel formats the tags as "Client / Project", omitting missing parts
+func (t *Transaction) BusinessLabel() string {
+	switch {
+	case t.Client != "" && t.Project != "":
+		return t.Client + " / " + t.Project
+	case t.Client != "":
+		return t.Client
+	default:
+		return t.Project
+	}
+}
+
+// IsCash reports whether the transaction was paid in cash, outside any account
+// or card, so it doesn't affect any balance
+func (t *Transaction) IsCash() bool {
+	return t.AccountID == nil && t.CreditCardID == nil
+}
+
+func (t *Transaction) HasLocation() bool {
+	return t.City != "" || t.Venue != ""
+}
+
+// LocationLabel formats the location as "Venue, City", omitting missing parts
+func (t *Transaction) LocationLabel() string {
+	switch {
+	case t.Venue != "" && t.City != "":
+		return t.Venue + ", " + t.City
+	case t.Venue != "":
+		return t.Venue
+	default:
+		return t.City
+	}
+}
+
 func (t *Transaction) ClearSharedExpenses() {
 	t.SharedWith = []SharedExpense{}
 	t.UpdatedAt = time.Now()
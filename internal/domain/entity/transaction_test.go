@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_SetAmount(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Dinner", time.Now())
+	require.NoError(t, txn.AddSharedExpense(uuid.New(), 25))
+
+	require.NoError(t, txn.SetAmount(valueobject.NewMoney(80, "BRL")))
+	assert.Equal(t, 80.0, txn.Amount.Amount())
+	assert.Equal(t, 20.0, txn.SharedWith[0].Amount.Amount())
+	assert.Equal(t, 25.0, txn.SharedWith[0].Percentage)
+
+	assert.Error(t, txn.SetAmount(valueobject.NewMoney(0, "BRL")))
+	assert.Error(t, txn.SetAmount(valueobject.NewMoney(50, "USD")))
+	assert.Equal(t, 80.0, txn.Amount.Amount())
+}
+
+func TestTransaction_Revise(t *testing.T) {
+	accountID, cardID, invoiceID, billID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	newCardTransaction := func() *Transaction {
+		txn := NewTransaction(nil, &cardID, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Dinner", date)
+		txn.AssignToCreditCardInvoice(invoiceID)
+		txn.AssignToBill(billID)
+		return txn
+	}
+
+	// Description and category changes leave balances, invoice and bill alone
+	txn := newCardTransaction()
+	moved, err := txn.Revise(nil, &cardID, TransactionTypeDebit, TransactionCategoryEntertainment, valueobject.NewMoney(100, "BRL"), " Birthday dinner ", date)
+	require.NoError(t, err)
+	assert.False(t, moved)
+	assert.Equal(t, "Birthday dinner", txn.Description)
+	assert.Equal(t, TransactionCategoryEntertainment, txn.Category)
+	assert.Equal(t, invoiceID, *txn.CreditCardInvoiceID)
+
+	// A new amount moves balances and leaves the invoice, but keeps the bill
+	txn = newCardTransaction()
+	moved, err = txn.Revise(nil, &cardID, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(120, "BRL"), "Dinner", date)
+	require.NoError(t, err)
+	assert.True(t, moved)
+	assert.Nil(t, txn.CreditCardInvoiceID)
+	assert.Equal(t, billID, *txn.BillID)
+
+	// Moving to an account on another date drops both
+	txn = newCardTransaction()
+	moved, err = txn.Revise(&accountID, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Dinner", date.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.True(t, moved)
+	assert.Nil(t, txn.CreditCardID)
+	assert.Equal(t, accountID, *txn.AccountID)
+	assert.Nil(t, txn.BillID)
+
+	// Without an account or card it becomes a cash transaction
+	txn = newCardTransaction()
+	moved, err = txn.Revise(nil, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Dinner", date)
+	require.NoError(t, err)
+	assert.True(t, moved)
+	assert.True(t, txn.IsCash())
+	assert.Nil(t, txn.CreditCardInvoiceID)
+
+	_, err = newCardTransaction().Revise(&accountID, &cardID, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), "Dinner", date)
+	assert.Error(t, err)
+	_, err = newCardTransaction().Revise(nil, &cardID, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(100, "BRL"), " ", date)
+	assert.Error(t, err)
+}
+
+func TestNewTransfer(t *testing.T) {
+	fromID, toID := uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	debit, credit := NewTransfer(fromID, toID, valueobject.NewMoney(250, "BRL"), "Savings → Nubank", "Savings ← Itaú", date)
+	assert.Equal(t, fromID, *debit.AccountID)
+	assert.Equal(t, TransactionTypeDebit, debit.Type)
+	assert.Equal(t, toID, *credit.AccountID)
+	assert.Equal(t, TransactionTypeCredit, credit.Type)
+	assert.Equal(t, TransactionCategoryTransfer, debit.Category)
+	assert.Equal(t, TransactionCategoryTransfer, credit.Category)
+	require.NotNil(t, debit.TransferID)
+	assert.Equal(t, *debit.TransferID, *credit.TransferID)
+	assert.NotEqual(t, debit.ID, credit.ID)
+}
+
+func TestNewInvoicePayment(t *testing.T) {
+	accountID, cardID, invoiceID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	debit, credit := NewInvoicePayment(accountID, cardID, invoiceID, valueobject.NewMoney(900, "BRL"), "Nubank invoice 2026-02", date)
+	assert.Equal(t, accountID, *debit.AccountID)
+	assert.Nil(t, debit.CreditCardID)
+	assert.Equal(t, TransactionTypeDebit, debit.Type)
+	assert.Equal(t, cardID, *credit.CreditCardID)
+	assert.Nil(t, credit.AccountID)
+	assert.Equal(t, TransactionTypeCredit, credit.Type)
+	assert.Equal(t, invoiceID, *credit.CreditCardInvoiceID)
+	assert.Nil(t, debit.CreditCardInvoiceID)
+	assert.Equal(t, TransactionCategoryTransfer, debit.Category)
+	assert.Equal(t, TransactionCategoryTransfer, credit.Category)
+	require.NotNil(t, debit.TransferID)
+	assert.Equal(t, *debit.TransferID, *credit.TransferID)
+}
+
+func TestTransaction_SetBusinessTags(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryTransportation, valueobject.NewMoney(42, "BRL"), "Uber to the client", time.Now())
+	assert.False(t, txn.IsBusiness())
+
+	txn.SetBusinessTags("  Acme ", "Website")
+	assert.True(t, txn.IsBusiness())
+	assert.Equal(t, "Acme", txn.Client)
+	assert.Equal(t, "Acme / Website", txn.BusinessLabel())
+
+	txn.SetBusinessTags("", "Website")
+	assert.Equal(t, "Website", txn.BusinessLabel())
+
+	txn.SetBusinessTags("", " ")
+	assert.False(t, txn.IsBusiness())
+}
+
+func TestTransaction_SetPaymentMethod(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryUtilities, valueobject.NewMoney(180, "BRL"), "Electricity bill", time.Now())
+	assert.Empty(t, txn.PaymentMethod)
+
+	require.NoError(t, txn.SetPaymentMethod(PaymentMethodBoleto))
+	assert.Equal(t, PaymentMethodBoleto, txn.PaymentMethod)
+	assert.Equal(t, "boleto", txn.Snapshot()["payment_method"])
+
+	assert.Error(t, txn.SetPaymentMethod("cheque"))
+	assert.Equal(t, PaymentMethodBoleto, txn.PaymentMethod)
+
+	require.NoError(t, txn.SetPaymentMethod(""))
+	assert.Empty(t, txn.PaymentMethod)
+}
+
+func TestTransaction_SetTags(t *testing.T) {
+	txn := NewTransaction(nil, nil, TransactionTypeDebit, TransactionCategoryFood, valueobject.NewMoney(90, "BRL"), "Dinner in Lisbon", time.Now())
+
+	txn.SetTags([]string{" Trip-2026 ", "work, trip-2026", ""})
+	assert.Equal(t, []string{"trip-2026", "work"}, txn.Tags)
+	assert.True(t, txn.HasTag("TRIP-2026"))
+	assert.False(t, txn.HasTag("trip"))
+
+	before := txn.Snapshot()
+	txn.SetTags(nil)
+	assert.Empty(t, txn.Tags)
+	require.NoError(t, txn.Restore(before))
+	assert.Equal(t, []string{"trip-2026", "work"}, txn.Tags)
+}
+
+func TestParseTags(t *testing.T) {
+	assert.Equal(t, []string{"trip", "work", "lisbon"}, ParseTags("Trip, work  lisbon,"))
+	assert.Empty(t, ParseTags(" , "))
+}
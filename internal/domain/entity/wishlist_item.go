@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type WishlistPriority string
+
+const (
+	WishlistPriorityHigh   WishlistPriority = "high"
+	WishlistPriorityMedium WishlistPriority = "medium"
+	WishlistPriorityLow    WishlistPriority = "low"
+)
+
+// Rank orders priorities so that the most wanted items come first
+func (p WishlistPriority) Rank() int {
+	switch p {
+	case WishlistPriorityHigh:
+		return 0
+	case WishlistPriorityMedium:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// WishlistItem is a planned purchase. Once bought it is linked to the
+// transaction that recorded the purchase.
+type WishlistItem struct {
+	ID            uuid.UUID
+	Name          string
+	EstimatedCost valueobject.Money
+	Priority      WishlistPriority
+	TargetDate    time.Time
+	Category      TransactionCategory
+	TransactionID *uuid.UUID
+	PurchasedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewWishlistItem(name string, estimatedCost valueobject.Money, priority WishlistPriority, targetDate time.Time, category TransactionCategory) (*WishlistItem, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("item name is required")
+	}
+
+	if estimatedCost.IsNegative() || estimatedCost.IsZero() {
+		return nil, fmt.Errorf("estimated cost must be positive")
+	}
+
+	if category == "" {
+		category = TransactionCategoryShopping
+	}
+
+	now := time.Now()
+	return &WishlistItem{
+		ID:            uuid.New(),
+		Name:          name,
+		EstimatedCost: estimatedCost,
+		Priority:      priority,
+		TargetDate:    targetDate,
+		Category:      category,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (w *WishlistItem) IsPurchased() bool {
+	return w.TransactionID != nil
+}
+
+func (w *WishlistItem) MarkPurchased(transactionID uuid.UUID, purchasedAt time.Time) error {
+	if w.IsPurchased() {
+		return fmt.Errorf("item was already purchased")
+	}
+
+	w.TransactionID = &transactionID
+	w.PurchasedAt = &purchasedAt
+	w.UpdatedAt = time.Now()
+	return nil
+}
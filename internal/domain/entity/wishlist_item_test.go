@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"financli/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWishlistItem_MarkPurchased(t *testing.T) {
+	item, err := NewWishlistItem("Notebook", valueobject.NewMoney(4500.0, "BRL"), WishlistPriorityHigh, time.Now().AddDate(0, 2, 0), "")
+	require.NoError(t, err)
+	assert.Equal(t, TransactionCategoryShopping, item.Category)
+	assert.False(t, item.IsPurchased())
+
+	require.NoError(t, item.MarkPurchased(uuid.New(), time.Now()))
+	assert.True(t, item.IsPurchased())
+	assert.NotNil(t, item.PurchasedAt)
+
+	assert.Error(t, item.MarkPurchased(uuid.New(), time.Now()))
+}
+
+func TestNewWishlistItem_RequiresPositiveCost(t *testing.T) {
+	_, err := NewWishlistItem("Notebook", valueobject.NewMoney(0, "BRL"), WishlistPriorityLow, time.Now(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type AccountingPeriodRepository interface {
+	Create(ctx context.Context, period *entity.AccountingPeriod) error
+	Update(ctx context.Context, period *entity.AccountingPeriod) error
+	FindAll(ctx context.Context) ([]*entity.AccountingPeriod, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type BudgetRepository interface {
+	Create(ctx context.Context, budget *entity.Budget) error
+	Update(ctx context.Context, budget *entity.Budget) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error)
+	FindAll(ctx context.Context) ([]*entity.Budget, error)
+}
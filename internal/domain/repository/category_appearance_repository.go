@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type CategoryAppearanceRepository interface {
+	// Save creates or replaces the appearance of the category
+	Save(ctx context.Context, appearance *entity.CategoryAppearance) error
+	Delete(ctx context.Context, category entity.TransactionCategory) error
+	FindAll(ctx context.Context) ([]*entity.CategoryAppearance, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type CategoryClassifierRepository interface {
+	// Get returns the saved classifier, or nil when it hasn't been trained yet
+	Get(ctx context.Context) (*entity.CategoryClassifier, error)
+	// Save creates or replaces the classifier
+	Save(ctx context.Context, classifier *entity.CategoryClassifier) error
+}
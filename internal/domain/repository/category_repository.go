@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CategoryRepository stores the categories added by the user; the built-in
+// ones aren't stored
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]*entity.Category, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type CategoryRuleRepository interface {
+	Create(ctx context.Context, rule *entity.CategoryRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]*entity.CategoryRule, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ChangeRecordRepository interface {
+	Create(ctx context.Context, record *entity.ChangeRecord) error
+	Update(ctx context.Context, record *entity.ChangeRecord) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.ChangeRecord, error)
+	FindByEntity(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID) ([]*entity.ChangeRecord, error)
+}
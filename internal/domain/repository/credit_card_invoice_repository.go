@@ -11,6 +11,7 @@ import (
 type CreditCardInvoiceRepository interface {
 	Create(ctx context.Context, invoice *entity.CreditCardInvoice) error
 	Update(ctx context.Context, invoice *entity.CreditCardInvoice) error
+	UpdateMany(ctx context.Context, invoices []*entity.CreditCardInvoice) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.CreditCardInvoice, error)
 	FindByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error)
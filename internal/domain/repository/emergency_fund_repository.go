@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type EmergencyFundRepository interface {
+	// Get returns the saved plan, or nil when the user hasn't set one up
+	Get(ctx context.Context) (*entity.EmergencyFundPlan, error)
+	// Save creates or replaces the plan
+	Save(ctx context.Context, plan *entity.EmergencyFundPlan) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type FilterPresetRepository interface {
+	Create(ctx context.Context, preset *entity.FilterPreset) error
+	Update(ctx context.Context, preset *entity.FilterPreset) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]*entity.FilterPreset, error)
+}
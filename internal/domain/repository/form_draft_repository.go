@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type FormDraftRepository interface {
+	// Get returns the draft of the form, or nil when there's none
+	Get(ctx context.Context, form entity.FormDraftKind) (*entity.FormDraft, error)
+	// Save creates or replaces the draft of its form
+	Save(ctx context.Context, draft *entity.FormDraft) error
+	Delete(ctx context.Context, form entity.FormDraftKind) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type GoalRepository interface {
+	Create(ctx context.Context, goal *entity.Goal) error
+	Update(ctx context.Context, goal *entity.Goal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error)
+	FindAll(ctx context.Context) ([]*entity.Goal, error)
+}
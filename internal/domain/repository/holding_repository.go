@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type HoldingRepository interface {
+	Create(ctx context.Context, holding *entity.Holding) error
+	Update(ctx context.Context, holding *entity.Holding) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Holding, error)
+	FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Holding, error)
+	FindAll(ctx context.Context) ([]*entity.Holding, error)
+}
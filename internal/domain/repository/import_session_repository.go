@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ImportSessionRepository interface {
+	Create(ctx context.Context, session *entity.ImportSession) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportSession, error)
+	FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.ImportSession, error)
+}
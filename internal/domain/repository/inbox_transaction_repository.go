@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type InboxTransactionRepository interface {
+	CreateMany(ctx context.Context, items []*entity.InboxTransaction) error
+	Update(ctx context.Context, item *entity.InboxTransaction) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.InboxTransaction, error)
+	FindPending(ctx context.Context) ([]*entity.InboxTransaction, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type MacroRepository interface {
+	Create(ctx context.Context, macro *entity.Macro) error
+	Update(ctx context.Context, macro *entity.Macro) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]*entity.Macro, error)
+}
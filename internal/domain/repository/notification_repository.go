@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entity.Notification) error
+	Update(ctx context.Context, notification *entity.Notification) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindAll returns the newest notifications first
+	FindAll(ctx context.Context) ([]*entity.Notification, error)
+	ExistsByKey(ctx context.Context, key string) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type PendingPaymentRepository interface {
+	Create(ctx context.Context, payment *entity.PendingPayment) error
+	Update(ctx context.Context, payment *entity.PendingPayment) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.PendingPayment, error)
+	FindPending(ctx context.Context) ([]*entity.PendingPayment, error)
+	FindPendingByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.PendingPayment, error)
+	FindPendingByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.PendingPayment, error)
+}
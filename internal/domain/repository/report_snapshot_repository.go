@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type ReportSnapshotRepository interface {
+	Create(ctx context.Context, snapshot *entity.ReportSnapshot) error
+	Update(ctx context.Context, snapshot *entity.ReportSnapshot) error
+	FindAll(ctx context.Context) ([]*entity.ReportSnapshot, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ScheduledTransferRepository interface {
+	Create(ctx context.Context, transfer *entity.ScheduledTransfer) error
+	Update(ctx context.Context, transfer *entity.ScheduledTransfer) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.ScheduledTransfer, error)
+	FindPending(ctx context.Context) ([]*entity.ScheduledTransfer, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SinkingFundRepository interface {
+	Create(ctx context.Context, fund *entity.SinkingFund) error
+	Update(ctx context.Context, fund *entity.SinkingFund) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.SinkingFund, error)
+	FindAll(ctx context.Context) ([]*entity.SinkingFund, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type StandingOrderRepository interface {
+	Create(ctx context.Context, order *entity.StandingOrder) error
+	Update(ctx context.Context, order *entity.StandingOrder) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]*entity.StandingOrder, error)
+}
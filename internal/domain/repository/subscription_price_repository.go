@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+)
+
+type SubscriptionPriceRepository interface {
+	Create(ctx context.Context, price *entity.SubscriptionPrice) error
+	Update(ctx context.Context, price *entity.SubscriptionPrice) error
+	FindByKey(ctx context.Context, key string) (*entity.SubscriptionPrice, error)
+	FindAll(ctx context.Context) ([]*entity.SubscriptionPrice, error)
+}
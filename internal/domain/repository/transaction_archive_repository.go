@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TransactionArchiveRepository holds the transactions moved out of the
+// transactions collection, so everyday queries only go through recent years.
+// Archived transactions are read-only and only reports look them up.
+type TransactionArchiveRepository interface {
+	// ArchiveBefore moves every transaction dated before cutoff into the
+	// archive and returns how many were moved
+	ArchiveBefore(ctx context.Context, cutoff time.Time) (int, error)
+	FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error)
+	FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error)
+}
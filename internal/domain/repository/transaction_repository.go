@@ -8,8 +8,37 @@ import (
 	"github.com/google/uuid"
 )
 
+// CategoryUsage is the part of a transaction needed to rank categories by use
+type CategoryUsage struct {
+	Category entity.TransactionCategory
+	Date     time.Time
+}
+
+// CategoryMonthTotal sums the transactions of one category in one month
+type CategoryMonthTotal struct {
+	Category entity.TransactionCategory
+	// Month is the first day of the month, in local time
+	Month time.Time
+	Total float64
+	Count int
+}
+
+// DescriptionUsage is the part of a transaction needed to rank descriptions by use
+type DescriptionUsage struct {
+	Description string
+	Date        time.Time
+}
+
+// CategoryTotal sums the transactions of one category
+type CategoryTotal struct {
+	Category entity.TransactionCategory
+	Total    float64
+	Count    int
+}
+
 type TransactionRepository interface {
 	Create(ctx context.Context, transaction *entity.Transaction) error
+	CreateMany(ctx context.Context, transactions []*entity.Transaction) error
 	Update(ctx context.Context, transaction *entity.Transaction) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
@@ -19,7 +48,23 @@ type TransactionRepository interface {
 	FindByCreditCardInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*entity.Transaction, error)
 	FindByBillID(ctx context.Context, billID uuid.UUID) ([]*entity.Transaction, error)
 	FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error)
+	// FindPageByDateRange returns limit transactions between startDate and
+	// endDate, newest first, skipping the first offset of them
+	FindPageByDateRange(ctx context.Context, startDate, endDate time.Time, offset, limit int) ([]*entity.Transaction, error)
 	FindByCategory(ctx context.Context, category entity.TransactionCategory) ([]*entity.Transaction, error)
+	FindByTag(ctx context.Context, tag string) ([]*entity.Transaction, error)
+	// FindTags lists every tag in use, in alphabetical order
+	FindTags(ctx context.Context) ([]string, error)
 	FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error)
 	FindUnassignedToBill(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error)
+	FindLatest(ctx context.Context, limit int) ([]*entity.Transaction, error)
+	FindCategoryUsage(ctx context.Context, accountID, creditCardID *uuid.UUID, since time.Time) ([]CategoryUsage, error)
+	FindDescriptionUsage(ctx context.Context, since time.Time) ([]DescriptionUsage, error)
+	// SumByCategory totals the transactions of transactionType between
+	// startDate and endDate per category, largest total first
+	SumByCategory(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]CategoryTotal, error)
+	// SumByCategoryAndMonth totals the transactions of transactionType per
+	// category and calendar month, for the months from the one of startDate up
+	// to, and not including, the one of endDate
+	SumByCategoryAndMonth(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]CategoryMonthTotal, error)
 }
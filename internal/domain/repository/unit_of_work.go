@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// UnitOfWork makes the writes of several repositories commit or roll back
+// together
+type UnitOfWork interface {
+	// Do runs fn in a transaction: the repository calls fn makes with the
+	// context it's given are kept only if fn returns nil. fn may run more
+	// than once when the transaction is retried.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
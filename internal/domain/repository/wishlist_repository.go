@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type WishlistRepository interface {
+	Create(ctx context.Context, item *entity.WishlistItem) error
+	Update(ctx context.Context, item *entity.WishlistItem) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WishlistItem, error)
+	FindAll(ctx context.Context) ([]*entity.WishlistItem, error)
+}
@@ -1,13 +1,54 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	MongoDB MongoDBConfig
+	Storage   StorageConfig
+	Workspace WorkspaceConfig
+	MongoDB   MongoDBConfig
+	Export    ExportConfig
+	Reports   ReportsConfig
+	Import    ImportConfig
+	Yield     YieldConfig
+	Security  SecurityConfig
+	Refresh   RefreshConfig
+	List      ListConfig
+	Forms     FormsConfig
+	SMTP      SMTPConfig
+	Digest    DigestConfig
+	Reminders RemindersConfig
+	Dashboard DashboardConfig
+	Archive   ArchiveConfig
+	Business  BusinessConfig
+	OCR       OCRConfig
+	IMAP      IMAPConfig
+	Server    ServerConfig
+}
+
+type StorageConfig struct {
+	// Where the data lives: "mongodb", "sqlite" or "bolt"
+	Backend string
+	// Database file used by the sqlite backend
+	SQLitePath string
+	// Database file used by the bolt backend
+	BoltPath string
+}
+
+type WorkspaceConfig struct {
+	// Names of the workspaces the TUI can switch between, each with its own
+	// database. The first uses the configured database; empty means just that one
+	Names []string
+	// Workspace whose database the config points to
+	Current string
 }
 
 type MongoDBConfig struct {
@@ -15,9 +56,146 @@ type MongoDBConfig struct {
 	Database string
 }
 
+type ExportConfig struct {
+	Dir string
+}
+
+type ReportsConfig struct {
+	ExcludeIgnored bool
+	// Directory holding the user's report templates (*.tmpl)
+	TemplatesDir string
+	// Read archived transactions too, so reports reach back past the archive cutoff
+	IncludeArchive bool
+}
+
+type ArchiveConfig struct {
+	// Past years kept out of the archive besides the current one; older
+	// transactions are archived on launch. 0 disables archiving
+	AfterYears int
+}
+
+type BusinessConfig struct {
+	// Tag expenses with the client and project they are billed to, and group them by project in the reports
+	Enabled bool
+}
+
+type OCRConfig struct {
+	// Program that reads receipt images, {image} standing for the image path
+	Command string
+	// OCR service the images are POSTed to when no command is set
+	URL string
+}
+
+type IMAPConfig struct {
+	// Host of the mail server e-receipts are read from; empty disables reading them
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// Mailbox, or Gmail label, the e-receipts and card alerts are filed under
+	Mailbox string
+}
+
+type ServerConfig struct {
+	// Address "financli serve" listens on for forwarded bank notifications,
+	// only this machine by default
+	Addr string
+	// Token the requests must carry; empty accepts any request, and is only
+	// allowed on a loopback address
+	Token string
+}
+
+type ImportConfig struct {
+	// Queue imported transactions in the review inbox instead of posting them
+	ReviewInbox bool
+}
+
+type YieldConfig struct {
+	// Annual CDI rate in percent used to approximate CDI-indexed yields
+	CDIAnnualRate float64
+}
+
+type SecurityConfig struct {
+	// Bcrypt hash of the passcode asked for on launch; empty disables the lock
+	PasscodeHash string
+	// Read the passcode hash from the OS keychain instead of PasscodeHash
+	PasscodeKeychain bool
+	// Minutes of inactivity before the TUI locks again; 0 disables the auto-lock
+	AutoLockMinutes int
+}
+
+type RefreshConfig struct {
+	// Seconds between automatic reloads of the dashboard and transaction list; 0 disables them
+	IntervalSeconds int
+}
+
+type ListConfig struct {
+	// Transactions per page of the transaction list on launch, from 5 to 100;
+	// + and - change it for the session only
+	ItemsPerPage int
+}
+
+type FormsConfig struct {
+	// Keep new records left half filled as drafts instead of asking to discard them
+	Drafts bool
+}
+
+type SMTPConfig struct {
+	// Host of the mail server used for the monthly owed-amount emails; empty disables them
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type DigestConfig struct {
+	// Where the weekly digest goes: any of notifications, email and webhook
+	Channels []string
+	// Recipient of the email channel, sent through the SMTP settings
+	Email      string
+	WebhookURL string
+}
+
+type RemindersConfig struct {
+	// How many days ahead bills and invoices count as coming due
+	DaysAhead int
+	// Show the bills and invoices coming due as a desktop notification, once a day
+	Desktop bool
+	// Program that shows desktop notifications, {title} and {body} standing for
+	// their text; empty uses notify-send on Linux and osascript on macOS
+	DesktopCommand string
+}
+
+type DashboardConfig struct {
+	// Extra dashboard cards, each a "name=expression" over the monthly aggregates
+	KPIs []string
+}
+
 func Load() (*Config, error) {
 	godotenv.Load()
 
+	storage := strings.ToLower(strings.TrimSpace(os.Getenv("FINANCLI_STORAGE")))
+	if storage == "" {
+		storage = "mongodb"
+	}
+	if storage != "mongodb" && storage != "sqlite" && storage != "bolt" {
+		return nil, fmt.Errorf("unknown storage %q: use mongodb, sqlite or bolt", storage)
+	}
+
+	sqlitePath := os.Getenv("FINANCLI_SQLITE_PATH")
+	if sqlitePath == "" {
+		sqlitePath = "financli.db"
+	}
+
+	boltPath := os.Getenv("FINANCLI_BOLT_PATH")
+	if boltPath == "" {
+		boltPath = "financli.bolt"
+		if home, err := os.UserHomeDir(); err == nil {
+			boltPath = filepath.Join(home, ".financli", "financli.bolt")
+		}
+	}
+
 	mongoURI := os.Getenv("MONGODB_URI")
 	if mongoURI == "" {
 		mongoURI = "mongodb://localhost:27017"
@@ -28,10 +206,244 @@ func Load() (*Config, error) {
 		mongoDatabase = "financli"
 	}
 
+	exportDir := os.Getenv("FINANCLI_EXPORT_DIR")
+	if exportDir == "" {
+		exportDir = "exports"
+	}
+
+	excludeIgnored, _ := strconv.ParseBool(os.Getenv("FINANCLI_REPORTS_EXCLUDE_IGNORED"))
+
+	templatesDir := os.Getenv("FINANCLI_REPORT_TEMPLATES_DIR")
+	if templatesDir == "" {
+		templatesDir = "report-templates"
+	}
+	includeArchive, _ := strconv.ParseBool(os.Getenv("FINANCLI_REPORTS_INCLUDE_ARCHIVE"))
+
+	archiveAfterYears, err := strconv.Atoi(os.Getenv("FINANCLI_ARCHIVE_AFTER_YEARS"))
+	if err != nil || archiveAfterYears < 0 {
+		archiveAfterYears = 0
+	}
+
+	reviewInbox, _ := strconv.ParseBool(os.Getenv("FINANCLI_IMPORT_REVIEW_INBOX"))
+
+	businessMode, _ := strconv.ParseBool(os.Getenv("FINANCLI_BUSINESS_MODE"))
+
+	cdiAnnualRate, err := strconv.ParseFloat(os.Getenv("FINANCLI_CDI_ANNUAL_RATE"), 64)
+	if err != nil || cdiAnnualRate < 0 {
+		cdiAnnualRate = 10.65
+	}
+
+	passcodeHash := strings.TrimSpace(os.Getenv("FINANCLI_PASSCODE_HASH"))
+	passcodeKeychain, _ := strconv.ParseBool(os.Getenv("FINANCLI_PASSCODE_KEYCHAIN"))
+
+	autoLockMinutes, err := strconv.Atoi(os.Getenv("FINANCLI_AUTO_LOCK_MINUTES"))
+	if err != nil || autoLockMinutes < 0 {
+		autoLockMinutes = 5
+	}
+
+	refreshSeconds, err := strconv.Atoi(os.Getenv("FINANCLI_REFRESH_SECONDS"))
+	if err != nil || refreshSeconds < 0 {
+		refreshSeconds = 0
+	}
+
+	itemsPerPage, err := strconv.Atoi(os.Getenv("FINANCLI_ITEMS_PER_PAGE"))
+	if err != nil || itemsPerPage <= 0 {
+		itemsPerPage = 10
+	}
+	if itemsPerPage < 5 {
+		itemsPerPage = 5
+	}
+	if itemsPerPage > 100 {
+		itemsPerPage = 100
+	}
+
+	formDrafts := true
+	if value, err := strconv.ParseBool(os.Getenv("FINANCLI_FORM_DRAFTS")); err == nil {
+		formDrafts = value
+	}
+
+	smtpPort, err := strconv.Atoi(os.Getenv("FINANCLI_SMTP_PORT"))
+	if err != nil || smtpPort <= 0 {
+		smtpPort = 587
+	}
+
+	smtpUsername := os.Getenv("FINANCLI_SMTP_USERNAME")
+	smtpFrom := os.Getenv("FINANCLI_SMTP_FROM")
+	if smtpFrom == "" {
+		smtpFrom = smtpUsername
+	}
+
+	imapPort, err := strconv.Atoi(os.Getenv("FINANCLI_IMAP_PORT"))
+	if err != nil || imapPort <= 0 {
+		imapPort = 993
+	}
+
+	imapMailbox := os.Getenv("FINANCLI_IMAP_MAILBOX")
+	if imapMailbox == "" {
+		imapMailbox = "Receipts"
+	}
+
+	serverAddr := os.Getenv("FINANCLI_SERVER_ADDR")
+	if serverAddr == "" {
+		serverAddr = "127.0.0.1:8080"
+	}
+
+	var digestChannels []string
+	for _, channel := range strings.Split(os.Getenv("FINANCLI_DIGEST_CHANNELS"), ",") {
+		if channel = strings.ToLower(strings.TrimSpace(channel)); channel != "" {
+			digestChannels = append(digestChannels, channel)
+		}
+	}
+	if len(digestChannels) == 0 {
+		digestChannels = []string{"notifications"}
+	}
+
+	reminderDays, err := strconv.Atoi(os.Getenv("FINANCLI_REMINDER_DAYS"))
+	if err != nil || reminderDays < 0 {
+		reminderDays = 3
+	}
+
+	desktopNotifications, _ := strconv.ParseBool(os.Getenv("FINANCLI_DESKTOP_NOTIFICATIONS"))
+
+	var workspaces []string
+	for _, name := range strings.Split(os.Getenv("FINANCLI_WORKSPACES"), ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		// The name goes into file and database names
+		if strings.ContainsFunc(name, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+		}) {
+			return nil, fmt.Errorf("invalid workspace %q: use letters, digits, - and _", name)
+		}
+		workspaces = append(workspaces, name)
+	}
+	var currentWorkspace string
+	if len(workspaces) > 0 {
+		currentWorkspace = workspaces[0]
+	}
+
+	var kpis []string
+	for _, kpi := range strings.Split(os.Getenv("FINANCLI_DASHBOARD_KPIS"), ";") {
+		if kpi = strings.TrimSpace(kpi); kpi != "" {
+			kpis = append(kpis, kpi)
+		}
+	}
+
 	return &Config{
+		Storage: StorageConfig{
+			Backend:    storage,
+			SQLitePath: sqlitePath,
+			BoltPath:   boltPath,
+		},
+		Workspace: WorkspaceConfig{
+			Names:   workspaces,
+			Current: currentWorkspace,
+		},
 		MongoDB: MongoDBConfig{
 			URI:      mongoURI,
 			Database: mongoDatabase,
 		},
+		Export: ExportConfig{
+			Dir: exportDir,
+		},
+		Reports: ReportsConfig{
+			ExcludeIgnored: excludeIgnored,
+			TemplatesDir:   templatesDir,
+			IncludeArchive: includeArchive,
+		},
+		Import: ImportConfig{
+			ReviewInbox: reviewInbox,
+		},
+		Yield: YieldConfig{
+			CDIAnnualRate: cdiAnnualRate,
+		},
+		Security: SecurityConfig{
+			PasscodeHash:     passcodeHash,
+			PasscodeKeychain: passcodeKeychain,
+			AutoLockMinutes:  autoLockMinutes,
+		},
+		Refresh: RefreshConfig{
+			IntervalSeconds: refreshSeconds,
+		},
+		List: ListConfig{
+			ItemsPerPage: itemsPerPage,
+		},
+		Forms: FormsConfig{
+			Drafts: formDrafts,
+		},
+		SMTP: SMTPConfig{
+			Host:     os.Getenv("FINANCLI_SMTP_HOST"),
+			Port:     smtpPort,
+			Username: smtpUsername,
+			Password: os.Getenv("FINANCLI_SMTP_PASSWORD"),
+			From:     smtpFrom,
+		},
+		Digest: DigestConfig{
+			Channels:   digestChannels,
+			Email:      os.Getenv("FINANCLI_DIGEST_EMAIL"),
+			WebhookURL: os.Getenv("FINANCLI_DIGEST_WEBHOOK_URL"),
+		},
+		Reminders: RemindersConfig{
+			DaysAhead:      reminderDays,
+			Desktop:        desktopNotifications,
+			DesktopCommand: strings.TrimSpace(os.Getenv("FINANCLI_NOTIFY_COMMAND")),
+		},
+		Dashboard: DashboardConfig{
+			KPIs: kpis,
+		},
+		Archive: ArchiveConfig{
+			AfterYears: archiveAfterYears,
+		},
+		Business: BusinessConfig{
+			Enabled: businessMode,
+		},
+		OCR: OCRConfig{
+			Command: strings.TrimSpace(os.Getenv("FINANCLI_OCR_COMMAND")),
+			URL:     os.Getenv("FINANCLI_OCR_URL"),
+		},
+		IMAP: IMAPConfig{
+			Host:     os.Getenv("FINANCLI_IMAP_HOST"),
+			Port:     imapPort,
+			Username: os.Getenv("FINANCLI_IMAP_USERNAME"),
+			Password: os.Getenv("FINANCLI_IMAP_PASSWORD"),
+			Mailbox:  imapMailbox,
+		},
+		Server: ServerConfig{
+			Addr:  serverAddr,
+			Token: os.Getenv("FINANCLI_SERVER_TOKEN"),
+		},
 	}, nil
 }
+
+// ForWorkspace returns a copy of the config pointing to the named workspace's
+// database. The first workspace keeps the configured one, and every other lives
+// next to it with the name as a suffix: financli-side-business.db for
+// "side-business" on SQLite, and likewise for the Bolt file and the MongoDB
+// database.
+func (c *Config) ForWorkspace(name string) (*Config, error) {
+	index := -1
+	for i, workspace := range c.Workspace.Names {
+		if workspace == name {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("unknown workspace %q", name)
+	}
+
+	workspace := *c
+	workspace.Workspace.Current = name
+	if index > 0 {
+		workspace.Storage.SQLitePath = workspacePath(c.Storage.SQLitePath, name)
+		workspace.Storage.BoltPath = workspacePath(c.Storage.BoltPath, name)
+		workspace.MongoDB.Database = c.MongoDB.Database + "-" + name
+	}
+	return &workspace, nil
+}
+
+// workspacePath adds the workspace name to a database file name, before its extension
+func workspacePath(path, name string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "-" + name + ext
+}
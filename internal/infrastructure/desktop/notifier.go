@@ -0,0 +1,80 @@
+package desktop
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const (
+	// titlePlaceholder and bodyPlaceholder are replaced by the notification's
+	// title and body in the command's arguments
+	titlePlaceholder = "{title}"
+	bodyPlaceholder  = "{body}"
+)
+
+// Notifier shows desktop notifications by running a program such as
+// notify-send
+type Notifier struct {
+	args []string
+}
+
+// NewNotifier runs commandLine for each notification, with {title} and {body}
+// standing for its text; without them, the title and body go last. An empty
+// commandLine uses notify-send on Linux and osascript on macOS. Arguments are
+// split on spaces, so they can't hold spaces of their own.
+func NewNotifier(commandLine string) *Notifier {
+	return &Notifier{args: strings.Fields(commandLine)}
+}
+
+func (n *Notifier) Notify(title, body string) error {
+	name, args, err := n.command(title, body)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("notification command failed: %w: %s", err, message)
+		}
+		return fmt.Errorf("notification command failed: %w", err)
+	}
+	return nil
+}
+
+func (n *Notifier) command(title, body string) (string, []string, error) {
+	if len(n.args) == 0 {
+		switch runtime.GOOS {
+		case "linux", "freebsd", "openbsd":
+			return "notify-send", []string{"--app-name=financli", title, body}, nil
+		case "darwin":
+			script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+			return "osascript", []string{"-e", script}, nil
+		}
+		return "", nil, fmt.Errorf("no desktop notification command for %s: set FINANCLI_NOTIFY_COMMAND", runtime.GOOS)
+	}
+
+	args := make([]string, 0, len(n.args)+1)
+	placed := false
+	for _, arg := range n.args[1:] {
+		if strings.Contains(arg, titlePlaceholder) || strings.Contains(arg, bodyPlaceholder) {
+			arg = strings.NewReplacer(titlePlaceholder, title, bodyPlaceholder, body).Replace(arg)
+			placed = true
+		}
+		args = append(args, arg)
+	}
+	if !placed {
+		args = append(args, title, body)
+	}
+	return n.args[0], args, nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
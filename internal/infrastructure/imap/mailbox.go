@@ -0,0 +1,184 @@
+package imap
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+)
+
+// sessionTimeout bounds a whole IMAP session when the context has no deadline
+const sessionTimeout = 2 * time.Minute
+
+// literalPattern matches the {size} ending a response line that is followed
+// by size bytes of raw data, such as a fetched message
+var literalPattern = regexp.MustCompile(`\{(\d+)\}$`)
+
+// Mailbox reads the unseen emails of one mailbox (a Gmail label is a mailbox)
+// over IMAP with TLS. It speaks just enough of the protocol to search, fetch
+// and flag messages.
+type Mailbox struct {
+	host     string
+	port     int
+	username string
+	password string
+	mailbox  string
+}
+
+func NewMailbox(host string, port int, username, password, mailbox string) *Mailbox {
+	return &Mailbox{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		mailbox:  mailbox,
+	}
+}
+
+// FetchNew returns the unseen emails of the mailbox, leaving them unseen
+func (m *Mailbox) FetchNew(ctx context.Context) ([]*usecase.ReceiptEmail, error) {
+	var emails []*usecase.ReceiptEmail
+	err := m.session(ctx, func(c *conn) error {
+		resp, err := c.command("UID SEARCH UNSEEN")
+		if err != nil {
+			return err
+		}
+
+		var uids []string
+		for _, line := range resp.lines {
+			if strings.HasPrefix(line, "* SEARCH") {
+				uids = append(uids, strings.Fields(strings.TrimPrefix(line, "* SEARCH"))...)
+			}
+		}
+
+		for _, uid := range uids {
+			resp, err := c.command("UID FETCH %s BODY.PEEK[]", uid)
+			if err != nil {
+				return err
+			}
+			if len(resp.literals) == 0 {
+				continue
+			}
+
+			email, err := parseMessage(resp.literals[0])
+			if err != nil {
+				// An email that can't be read is not a receipt we know
+				continue
+			}
+			email.ID = uid
+			emails = append(emails, email)
+		}
+		return nil
+	})
+	return emails, err
+}
+
+// MarkProcessed flags the emails as seen, so they aren't fetched again
+func (m *Mailbox) MarkProcessed(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return m.session(ctx, func(c *conn) error {
+		_, err := c.command(`UID STORE %s +FLAGS.SILENT (\Seen)`, strings.Join(ids, ","))
+		return err
+	})
+}
+
+// session logs in and selects the mailbox, runs fn and logs out
+func (m *Mailbox) session(ctx context.Context, fn func(c *conn) error) error {
+	addr := net.JoinHostPort(m.host, strconv.Itoa(m.port))
+	dialer := &tls.Dialer{Config: &tls.Config{ServerName: m.host}}
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer netConn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(sessionTimeout)
+	}
+	netConn.SetDeadline(deadline)
+
+	c := &conn{r: bufio.NewReader(netConn), w: netConn}
+	if _, err := c.r.ReadString('\n'); err != nil {
+		return fmt.Errorf("failed to read IMAP greeting: %w", err)
+	}
+	if _, err := c.command("LOGIN %s %s", quote(m.username), quote(m.password)); err != nil {
+		return fmt.Errorf("IMAP login failed: %w", err)
+	}
+	if _, err := c.command("SELECT %s", quote(m.mailbox)); err != nil {
+		return fmt.Errorf("failed to open mailbox %q: %w", m.mailbox, err)
+	}
+
+	if err := fn(c); err != nil {
+		return err
+	}
+
+	c.command("LOGOUT")
+	return nil
+}
+
+// conn runs tagged IMAP commands one at a time
+type conn struct {
+	r   *bufio.Reader
+	w   io.Writer
+	tag int
+}
+
+type response struct {
+	// Untagged response lines, without their CRLF
+	lines []string
+	// Raw data sent as literals, in order
+	literals [][]byte
+}
+
+func (c *conn) command(format string, args ...interface{}) (*response, error) {
+	c.tag++
+	tag := fmt.Sprintf("F%04d", c.tag)
+	if _, err := fmt.Fprintf(c.w, "%s %s\r\n", tag, fmt.Sprintf(format, args...)); err != nil {
+		return nil, fmt.Errorf("failed to send IMAP command: %w", err)
+	}
+
+	resp := &response{}
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("failed to read IMAP response: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if match := literalPattern.FindStringSubmatch(line); match != nil {
+			size, _ := strconv.Atoi(match[1])
+			literal := make([]byte, size)
+			if _, err := io.ReadFull(c.r, literal); err != nil {
+				return nil, fmt.Errorf("failed to read IMAP literal: %w", err)
+			}
+			resp.literals = append(resp.literals, literal)
+			resp.lines = append(resp.lines, line)
+			continue
+		}
+
+		if strings.HasPrefix(line, tag+" ") {
+			status := strings.TrimPrefix(line, tag+" ")
+			if !strings.HasPrefix(status, "OK") {
+				return nil, fmt.Errorf("server answered %s", status)
+			}
+			return resp, nil
+		}
+		resp.lines = append(resp.lines, line)
+	}
+}
+
+// quote writes s as an IMAP quoted string
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,146 @@
+package imap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"financli/internal/application/usecase"
+)
+
+var (
+	// htmlBreakPattern matches the tags that end a line of text
+	htmlBreakPattern = regexp.MustCompile(`(?i)<(br|/p|/div|/tr|/h\d|/li)[^>]*>`)
+	htmlTagPattern   = regexp.MustCompile(`(?s)<[^>]*>`)
+	// htmlHiddenPattern matches the blocks whose content isn't text
+	htmlHiddenPattern = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+)
+
+var wordDecoder = new(mime.WordDecoder)
+
+// parseMessage reads a raw email into its sender, subject, date and plain text body
+func parseMessage(raw []byte) (*usecase.ReceiptEmail, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := wordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+	from, err := wordDecoder.DecodeHeader(msg.Header.Get("From"))
+	if err != nil {
+		from = msg.Header.Get("From")
+	}
+	date, _ := msg.Header.Date()
+
+	body, err := readBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.ReceiptEmail{
+		From:    from,
+		Subject: subject,
+		Date:    date,
+		Body:    body,
+	}, nil
+}
+
+// readBody returns the text of a message part, preferring the plain text
+// alternative of multipart messages over the HTML one
+func readBody(contentType, encoding string, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		var plain, htmlText string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+
+			text, err := readBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				continue
+			}
+			partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+			switch {
+			case partType == "text/html" && htmlText == "":
+				htmlText = text
+			case plain == "":
+				plain = text
+			}
+		}
+		if strings.TrimSpace(plain) != "" {
+			return plain, nil
+		}
+		return htmlText, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: body})
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+
+	if mediaType == "text/html" {
+		return htmlToText(string(content)), nil
+	}
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "", nil
+	}
+	return string(content), nil
+}
+
+// htmlToText keeps the text of an HTML email, one line per block
+func htmlToText(s string) string {
+	s = htmlHiddenPattern.ReplaceAllString(s, "")
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = html.UnescapeString(htmlTagPattern.ReplaceAllString(s, " "))
+
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// newlineStripper drops the line breaks of base64 bodies, which the decoder rejects
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (s *newlineStripper) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	kept := 0
+	for _, b := range p[:n] {
+		if b != '\r' && b != '\n' {
+			p[kept] = b
+			kept++
+		}
+	}
+	return kept, err
+}
@@ -0,0 +1,76 @@
+package keychain
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// service is the name financli's secrets are filed under in the keychain
+const service = "financli"
+
+// Keychain keeps secrets in the operating system's keychain: the login
+// keychain through security on macOS and the Secret Service (GNOME Keyring,
+// KWallet) through secret-tool on Linux
+type Keychain struct{}
+
+func New() *Keychain {
+	return &Keychain{}
+}
+
+// Get reads the secret stored under account, failing when there is none
+func (k *Keychain) Get(account string) (string, error) {
+	var name string
+	var args []string
+	switch runtime.GOOS {
+	case "darwin":
+		name, args = "security", []string{"find-generic-password", "-s", service, "-a", account, "-w"}
+	case "linux", "freebsd", "openbsd":
+		name, args = "secret-tool", []string{"lookup", "service", service, "account", account}
+	default:
+		return "", fmt.Errorf("no keychain support for %s", runtime.GOOS)
+	}
+
+	output, err := run(exec.Command(name, args...))
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimSpace(output)
+	if secret == "" {
+		return "", fmt.Errorf("no %s secret in the keychain", account)
+	}
+	return secret, nil
+}
+
+// Set stores secret under account, replacing what was there
+func (k *Keychain) Set(account, secret string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "add-generic-password", "-U", "-s", service, "-a", account, "-w", secret)
+	case "linux", "freebsd", "openbsd":
+		// secret-tool reads the secret from stdin, keeping it off the command line
+		cmd = exec.Command("secret-tool", "store", "--label", service+" "+account, "service", service, "account", account)
+		cmd.Stdin = strings.NewReader(secret)
+	default:
+		return fmt.Errorf("no keychain support for %s", runtime.GOOS)
+	}
+
+	_, err := run(cmd)
+	return err
+}
+
+func run(cmd *exec.Cmd) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("keychain command failed: %w: %s", err, message)
+		}
+		return "", fmt.Errorf("keychain command failed: %w", err)
+	}
+	return stdout.String(), nil
+}
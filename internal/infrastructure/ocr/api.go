@@ -0,0 +1,66 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// API reads receipts with an OCR service: the image is POSTed as the request
+// body, and the service answers with the text, either plain or as {"text": "..."}
+type API struct {
+	url    string
+	client *http.Client
+}
+
+func NewAPI(url string) *API {
+	return &API{
+		url:    url,
+		client: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (a *API) Recognize(ctx context.Context, imagePath string) (string, error) {
+	image, err := os.ReadFile(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(image))
+	if err != nil {
+		return "", fmt.Errorf("failed to build OCR request: %w", err)
+	}
+	req.Header.Set("Content-Type", http.DetectContentType(image))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to call OCR service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OCR response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("OCR service returned %s", resp.Status)
+	}
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		var result struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return "", fmt.Errorf("invalid OCR response: %w", err)
+		}
+		return result.Text, nil
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,57 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// imagePlaceholder is replaced by the image path in the command's arguments
+const imagePlaceholder = "{image}"
+
+// Command reads receipts with an external OCR program such as tesseract,
+// taking the text it prints
+type Command struct {
+	args []string
+}
+
+// NewCommand runs commandLine for each receipt, with {image} standing for the
+// image path; without it, the path goes last. For example:
+// "tesseract {image} - -l por"
+func NewCommand(commandLine string) *Command {
+	return &Command{args: strings.Fields(commandLine)}
+}
+
+func (c *Command) Recognize(ctx context.Context, imagePath string) (string, error) {
+	if len(c.args) == 0 {
+		return "", fmt.Errorf("empty OCR command")
+	}
+
+	args := make([]string, 0, len(c.args)+1)
+	placed := false
+	for _, arg := range c.args[1:] {
+		if strings.Contains(arg, imagePlaceholder) {
+			arg = strings.ReplaceAll(arg, imagePlaceholder, imagePath)
+			placed = true
+		}
+		args = append(args, arg)
+	}
+	if !placed {
+		args = append(args, imagePath)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.args[0], args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("OCR command failed: %w: %s", err, message)
+		}
+		return "", fmt.Errorf("OCR command failed: %w", err)
+	}
+
+	return stdout.String(), nil
+}
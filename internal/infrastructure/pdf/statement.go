@@ -0,0 +1,138 @@
+package pdf
+
+import (
+	"fmt"
+
+	"financli/internal/application/usecase"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pageMargin = 15.0
+	rowHeight  = 6.0
+	dateWidth  = 24.0
+	catWidth   = 30.0
+	moneyWidth = 32.0
+)
+
+// StatementWriter lays out statements as A4 PDF files: a header with the
+// holder and period, the transaction table and a box with the totals
+type StatementWriter struct{}
+
+func NewStatementWriter() *StatementWriter {
+	return &StatementWriter{}
+}
+
+// WriteStatement writes statement as a PDF file at path
+func (w *StatementWriter) WriteStatement(path string, statement *usecase.Statement) error {
+	doc := fpdf.New("P", "mm", "A4", "")
+	doc.SetMargins(pageMargin, pageMargin, pageMargin)
+	doc.SetAutoPageBreak(true, pageMargin+5)
+	doc.AliasNbPages("")
+	// The core fonts only know Latin-1, which covers the Portuguese accents
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+
+	doc.SetFooterFunc(func() {
+		doc.SetY(-pageMargin)
+		doc.SetFont("Helvetica", "I", 8)
+		doc.SetTextColor(128, 128, 128)
+		doc.CellFormat(0, 5, tr(fmt.Sprintf("Generated on %s", statement.GeneratedAt.Format("02/01/2006 15:04"))), "", 0, "L", false, 0, "")
+		doc.CellFormat(0, 5, fmt.Sprintf("Page %d of {nb}", doc.PageNo()), "", 0, "R", false, 0, "")
+	})
+	doc.AddPage()
+
+	pageWidth, _ := doc.GetPageSize()
+	contentWidth := pageWidth - 2*pageMargin
+
+	// Header
+	doc.SetFont("Helvetica", "B", 18)
+	doc.CellFormat(contentWidth, 10, tr(statement.Title), "", 1, "L", false, 0, "")
+	doc.SetFont("Helvetica", "B", 12)
+	doc.CellFormat(contentWidth, 7, tr(statement.Holder), "", 1, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 10)
+	for _, detail := range statement.Details {
+		doc.CellFormat(contentWidth, 5, tr(fmt.Sprintf("%s: %s", detail.Label, detail.Value)), "", 1, "L", false, 0, "")
+	}
+	doc.Ln(4)
+
+	// Transaction table
+	descWidth := contentWidth - dateWidth - catWidth - moneyWidth
+	if statement.ShowBalance {
+		descWidth -= moneyWidth
+	}
+	tableHeader := func() {
+		doc.SetFont("Helvetica", "B", 9)
+		doc.SetFillColor(52, 73, 94)
+		doc.SetTextColor(255, 255, 255)
+		doc.CellFormat(dateWidth, rowHeight+1, "Date", "", 0, "L", true, 0, "")
+		doc.CellFormat(descWidth, rowHeight+1, "Description", "", 0, "L", true, 0, "")
+		doc.CellFormat(catWidth, rowHeight+1, "Category", "", 0, "L", true, 0, "")
+		ln := 1
+		if statement.ShowBalance {
+			ln = 0
+		}
+		doc.CellFormat(moneyWidth, rowHeight+1, "Amount", "", ln, "R", true, 0, "")
+		if statement.ShowBalance {
+			doc.CellFormat(moneyWidth, rowHeight+1, "Balance", "", 1, "R", true, 0, "")
+		}
+		doc.SetTextColor(0, 0, 0)
+		doc.SetFont("Helvetica", "", 9)
+	}
+	tableHeader()
+
+	if len(statement.Lines) == 0 {
+		doc.SetFont("Helvetica", "I", 9)
+		doc.CellFormat(contentWidth, rowHeight, "No transactions in the period", "", 1, "L", false, 0, "")
+	}
+	_, pageHeight := doc.GetPageSize()
+	for i, line := range statement.Lines {
+		// Start every page with the column names
+		if doc.GetY()+rowHeight > pageHeight-pageMargin-5 {
+			doc.AddPage()
+			tableHeader()
+		}
+
+		fill := i%2 == 1
+		doc.SetFillColor(240, 243, 246)
+		doc.CellFormat(dateWidth, rowHeight, line.Date, "", 0, "L", fill, 0, "")
+		doc.CellFormat(descWidth, rowHeight, fitText(doc, tr, line.Description, descWidth-2), "", 0, "L", fill, 0, "")
+		doc.CellFormat(catWidth, rowHeight, fitText(doc, tr, line.Category, catWidth-2), "", 0, "L", fill, 0, "")
+		if statement.ShowBalance {
+			doc.CellFormat(moneyWidth, rowHeight, tr(line.Amount), "", 0, "R", fill, 0, "")
+			doc.CellFormat(moneyWidth, rowHeight, tr(line.Balance), "", 1, "R", fill, 0, "")
+		} else {
+			doc.CellFormat(moneyWidth, rowHeight, tr(line.Amount), "", 1, "R", fill, 0, "")
+		}
+	}
+
+	// Totals, right-aligned under the table
+	doc.Ln(6)
+	totalsWidth := 90.0
+	left := pageMargin + contentWidth - totalsWidth
+	for i, total := range statement.Totals {
+		doc.SetX(left)
+		border := ""
+		if i == 0 {
+			border = "T"
+		}
+		doc.SetFont("Helvetica", "", 10)
+		doc.CellFormat(totalsWidth-moneyWidth, rowHeight, tr(total.Label), border, 0, "L", false, 0, "")
+		doc.SetFont("Helvetica", "B", 10)
+		doc.CellFormat(moneyWidth, rowHeight, tr(total.Value), border, 1, "R", false, 0, "")
+	}
+
+	return doc.OutputFileAndClose(path)
+}
+
+// fitText translates s for the font, cut short with an ellipsis so it fits width
+func fitText(doc *fpdf.Fpdf, tr func(string) string, s string, width float64) string {
+	if doc.GetStringWidth(tr(s)) <= width {
+		return tr(s)
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && doc.GetStringWidth(tr(string(runes)+"...")) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return tr(string(runes) + "...")
+}
@@ -0,0 +1,101 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type accountRepository struct {
+	bucket *documentBucket
+}
+
+func NewAccountRepository(db *bbolt.DB) repository.AccountRepository {
+	return &accountRepository{bucket: newDocumentBucket(db, "accounts")}
+}
+
+func (r *accountRepository) Create(ctx context.Context, account *entity.Account) error {
+	model := mongodb.AccountToModel(account)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create account: %w", err)
+	}
+	return nil
+}
+
+func (r *accountRepository) Update(ctx context.Context, account *entity.Account) error {
+	model := mongodb.AccountToModel(account)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("account not found")
+	}
+	return nil
+}
+
+func (r *accountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("account not found")
+	}
+	return nil
+}
+
+func (r *accountRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
+	var model mongodb.AccountModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("account not found")
+	}
+	return mongodb.AccountFromModel(model)
+}
+
+func (r *accountRepository) FindAll(ctx context.Context) ([]*entity.Account, error) {
+	return r.findAccounts(nil)
+}
+
+func (r *accountRepository) FindByType(ctx context.Context, accountType entity.AccountType) ([]*entity.Account, error) {
+	return r.findAccounts(func(account *entity.Account) bool {
+		return account.Type == accountType
+	})
+}
+
+// findAccounts returns the accounts match accepts, or all of them when it's nil
+func (r *accountRepository) findAccounts(match func(account *entity.Account) bool) ([]*entity.Account, error) {
+	var accounts []*entity.Account
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.AccountModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		account, err := mongodb.AccountFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(account) {
+			accounts = append(accounts, account)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounts: %w", err)
+	}
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].CreatedAt.Before(accounts[j].CreatedAt)
+	})
+	return accounts, nil
+}
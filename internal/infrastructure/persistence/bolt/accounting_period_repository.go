@@ -0,0 +1,65 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type accountingPeriodRepository struct {
+	bucket *documentBucket
+}
+
+func NewAccountingPeriodRepository(db *bbolt.DB) repository.AccountingPeriodRepository {
+	return &accountingPeriodRepository{bucket: newDocumentBucket(db, "accounting_periods")}
+}
+
+func (r *accountingPeriodRepository) Create(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := mongodb.AccountingPeriodToModel(period)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create accounting period: %w", err)
+	}
+	return nil
+}
+
+func (r *accountingPeriodRepository) Update(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := mongodb.AccountingPeriodToModel(period)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update accounting period: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("accounting period not found")
+	}
+	return nil
+}
+
+// FindAll returns every period, latest month first
+func (r *accountingPeriodRepository) FindAll(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	var periods []*entity.AccountingPeriod
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.AccountingPeriodModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		period, err := mongodb.AccountingPeriodFromModel(model)
+		if err != nil {
+			return err
+		}
+		periods = append(periods, period)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounting periods: %w", err)
+	}
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].Month.After(periods[j].Month)
+	})
+	return periods, nil
+}
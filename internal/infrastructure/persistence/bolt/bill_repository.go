@@ -0,0 +1,108 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type billRepository struct {
+	bucket *documentBucket
+}
+
+func NewBillRepository(db *bbolt.DB) repository.BillRepository {
+	return &billRepository{bucket: newDocumentBucket(db, "bills")}
+}
+
+func (r *billRepository) Create(ctx context.Context, bill *entity.Bill) error {
+	model := mongodb.BillToModel(bill)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) Update(ctx context.Context, bill *entity.Bill) error {
+	model := mongodb.BillToModel(bill)
+	if _, err := r.bucket.replace(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to update bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.bucket.remove(id.String()); err != nil {
+		return fmt.Errorf("failed to delete bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Bill, error) {
+	var model mongodb.BillModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bill: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("bill not found")
+	}
+	return mongodb.BillFromModel(model)
+}
+
+func (r *billRepository) FindAll(ctx context.Context) ([]*entity.Bill, error) {
+	return r.findBills(nil)
+}
+
+func (r *billRepository) FindByStatus(ctx context.Context, status entity.BillStatus) ([]*entity.Bill, error) {
+	return r.findBills(func(bill *entity.Bill) bool {
+		return bill.Status == status
+	})
+}
+
+// FindByDateRange returns the bills whose period overlaps the range
+func (r *billRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Bill, error) {
+	return r.findBills(func(bill *entity.Bill) bool {
+		return !bill.StartDate.After(endDate) && !bill.EndDate.Before(startDate)
+	})
+}
+
+func (r *billRepository) FindOverdue(ctx context.Context) ([]*entity.Bill, error) {
+	now := time.Now()
+	return r.findBills(func(bill *entity.Bill) bool {
+		return bill.Status != entity.BillStatusPaid && bill.DueDate.Before(now)
+	})
+}
+
+// findBills returns the bills match accepts, or all of them when it's nil
+func (r *billRepository) findBills(match func(bill *entity.Bill) bool) ([]*entity.Bill, error) {
+	var bills []*entity.Bill
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.BillModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		bill, err := mongodb.BillFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(bill) {
+			bills = append(bills, bill)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bills: %w", err)
+	}
+	sort.SliceStable(bills, func(i, j int) bool {
+		return bills[i].CreatedAt.Before(bills[j].CreatedAt)
+	})
+	return bills, nil
+}
@@ -0,0 +1,95 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type budgetRepository struct {
+	bucket *documentBucket
+}
+
+func NewBudgetRepository(db *bbolt.DB) repository.BudgetRepository {
+	return &budgetRepository{bucket: newDocumentBucket(db, "budgets")}
+}
+
+func (r *budgetRepository) Create(ctx context.Context, budget *entity.Budget) error {
+	model := mongodb.BudgetToModel(budget)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create budget: %w", err)
+	}
+	return nil
+}
+
+func (r *budgetRepository) Update(ctx context.Context, budget *entity.Budget) error {
+	model := mongodb.BudgetToModel(budget)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update budget: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("budget not found")
+	}
+	return nil
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("budget not found")
+	}
+	return nil
+}
+
+func (r *budgetRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error) {
+	var model mongodb.BudgetModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budget: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("budget not found")
+	}
+	return mongodb.BudgetFromModel(model)
+}
+
+func (r *budgetRepository) FindAll(ctx context.Context) ([]*entity.Budget, error) {
+	return r.findBudgets(nil)
+}
+
+// findBudgets returns the budgets match accepts, or all of them when it's nil
+func (r *budgetRepository) findBudgets(match func(budget *entity.Budget) bool) ([]*entity.Budget, error) {
+	var budgets []*entity.Budget
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.BudgetModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		budget, err := mongodb.BudgetFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(budget) {
+			budgets = append(budgets, budget)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budgets: %w", err)
+	}
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].Category < budgets[j].Category
+	})
+	return budgets, nil
+}
@@ -0,0 +1,53 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// categoryAppearanceRepository keys its records by category rather than uuid,
+// which also keeps them in category order
+type categoryAppearanceRepository struct {
+	bucket *documentBucket
+}
+
+func NewCategoryAppearanceRepository(db *bbolt.DB) repository.CategoryAppearanceRepository {
+	return &categoryAppearanceRepository{bucket: newDocumentBucket(db, "category_appearances")}
+}
+
+func (r *categoryAppearanceRepository) Save(ctx context.Context, appearance *entity.CategoryAppearance) error {
+	model := mongodb.CategoryAppearanceToModel(appearance)
+	if err := r.bucket.put(model.Category, model); err != nil {
+		return fmt.Errorf("failed to save category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) Delete(ctx context.Context, category entity.TransactionCategory) error {
+	if _, err := r.bucket.remove(string(category)); err != nil {
+		return fmt.Errorf("failed to delete category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) FindAll(ctx context.Context) ([]*entity.CategoryAppearance, error) {
+	var appearances []*entity.CategoryAppearance
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.CategoryAppearanceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		appearances = append(appearances, mongodb.CategoryAppearanceFromModel(model))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category appearances: %w", err)
+	}
+	return appearances, nil
+}
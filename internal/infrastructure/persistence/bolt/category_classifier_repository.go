@@ -0,0 +1,41 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+)
+
+// categoryClassifierKey is the key of the bucket's only record
+const categoryClassifierKey = "classifier"
+
+type categoryClassifierRepository struct {
+	bucket *documentBucket
+}
+
+func NewCategoryClassifierRepository(db *bbolt.DB) repository.CategoryClassifierRepository {
+	return &categoryClassifierRepository{bucket: newDocumentBucket(db, "category_classifier")}
+}
+
+func (r *categoryClassifierRepository) Get(ctx context.Context) (*entity.CategoryClassifier, error) {
+	var model mongodb.CategoryClassifierModel
+	found, err := r.bucket.get(categoryClassifierKey, &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category classifier: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return mongodb.CategoryClassifierFromModel(model), nil
+}
+
+func (r *categoryClassifierRepository) Save(ctx context.Context, classifier *entity.CategoryClassifier) error {
+	if err := r.bucket.put(categoryClassifierKey, mongodb.CategoryClassifierToModel(classifier)); err != nil {
+		return fmt.Errorf("failed to save category classifier: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type categoryRepository struct {
+	bucket *documentBucket
+}
+
+func NewCategoryRepository(db *bbolt.DB) repository.CategoryRepository {
+	return &categoryRepository{bucket: newDocumentBucket(db, "categories")}
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	model := mongodb.CategoryToModel(category)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	model := mongodb.CategoryToModel(category)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category not found")
+	}
+	return nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category not found")
+	}
+	return nil
+}
+
+// FindAll returns every category, oldest first
+func (r *categoryRepository) FindAll(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.CategoryModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		category, err := mongodb.CategoryFromModel(model)
+		if err != nil {
+			return err
+		}
+		categories = append(categories, category)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].CreatedAt.Before(categories[j].CreatedAt)
+	})
+	return categories, nil
+}
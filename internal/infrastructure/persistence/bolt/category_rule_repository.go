@@ -0,0 +1,64 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type categoryRuleRepository struct {
+	bucket *documentBucket
+}
+
+func NewCategoryRuleRepository(db *bbolt.DB) repository.CategoryRuleRepository {
+	return &categoryRuleRepository{bucket: newDocumentBucket(db, "category_rules")}
+}
+
+func (r *categoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	model := mongodb.CategoryRuleToModel(rule)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create category rule: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete category rule: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category rule not found")
+	}
+	return nil
+}
+
+func (r *categoryRuleRepository) FindAll(ctx context.Context) ([]*entity.CategoryRule, error) {
+	var rules []*entity.CategoryRule
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.CategoryRuleModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		rule, err := mongodb.CategoryRuleFromModel(model)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category rules: %w", err)
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+	})
+	return rules, nil
+}
@@ -0,0 +1,86 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type changeRecordRepository struct {
+	bucket *documentBucket
+}
+
+func NewChangeRecordRepository(db *bbolt.DB) repository.ChangeRecordRepository {
+	return &changeRecordRepository{bucket: newDocumentBucket(db, "change_records")}
+}
+
+func (r *changeRecordRepository) Create(ctx context.Context, record *entity.ChangeRecord) error {
+	model := mongodb.ChangeRecordToModel(record)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create change record: %w", err)
+	}
+	return nil
+}
+
+func (r *changeRecordRepository) Update(ctx context.Context, record *entity.ChangeRecord) error {
+	model := mongodb.ChangeRecordToModel(record)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update change record: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("change record not found")
+	}
+	return nil
+}
+
+func (r *changeRecordRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ChangeRecord, error) {
+	var model mongodb.ChangeRecordModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change record: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("change record not found")
+	}
+	return mongodb.ChangeRecordFromModel(model)
+}
+
+func (r *changeRecordRepository) FindByEntity(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID) ([]*entity.ChangeRecord, error) {
+	return r.findRecords(func(record *entity.ChangeRecord) bool {
+		return record.EntityType == entityType && record.EntityID == entityID
+	})
+}
+
+// findRecords returns the change records match accepts, or all of them when it's nil
+func (r *changeRecordRepository) findRecords(match func(record *entity.ChangeRecord) bool) ([]*entity.ChangeRecord, error) {
+	var records []*entity.ChangeRecord
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.ChangeRecordModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		record, err := mongodb.ChangeRecordFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(record) {
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change records: %w", err)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].CreatedAt.After(records[j].CreatedAt)
+	})
+	return records, nil
+}
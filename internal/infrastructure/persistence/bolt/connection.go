@@ -0,0 +1,65 @@
+// Package bolt implements the repositories on a single embedded bbolt file, so
+// the app works offline with nothing else installed.
+//
+// Like the sqlite package, every record is stored as the BSON document the
+// MongoDB backend keeps, built by the mongodb package's models and mappers, in
+// a bucket keyed by its uuid. There are no indexes: repositories read the whole
+// bucket and filter and sort in memory, which is plenty for one person's data.
+package bolt
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// buckets lists every bucket the repositories use, created on first run
+var buckets = []string{
+	"accounts", "credit_cards", "credit_card_invoices", "people", "bills", "transactions",
+	"import_sessions", "pending_payments", "sinking_funds", "wishlist_items", "subscription_prices",
+	"change_records", "transaction_inbox", "filter_presets", "category_appearances", "macros",
+	"notifications", "budgets", "standing_orders", "emergency_fund", "transactions_archive",
+	"category_classifier", "category_rules", "goals", "scheduled_transfers",
+	"holdings", "accounting_periods", "report_snapshots", "categories", "form_drafts",
+}
+
+type Config struct {
+	Path string
+}
+
+// NewConnection opens the database file, creating it and its buckets on first run
+func NewConnection(cfg Config) (*bbolt.DB, error) {
+	if dir := filepath.Dir(cfg.Path); dir != "." {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create bolt directory: %w", err)
+		}
+	}
+
+	// bbolt locks the file while it's open, so a second copy of the app waits
+	// for the lock; give up quickly instead of hanging
+	db, err := bbolt.Open(cfg.Path, 0o600, &bbolt.Options{Timeout: 2 * time.Second})
+	if err != nil {
+		if err == bbolt.ErrTimeout {
+			return nil, fmt.Errorf("failed to open bolt database: %s is in use by another financli", cfg.Path)
+		}
+		return nil, fmt.Errorf("failed to open bolt database: %w", err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range buckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create bolt buckets: %w", err)
+	}
+
+	return db, nil
+}
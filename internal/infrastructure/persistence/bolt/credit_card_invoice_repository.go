@@ -0,0 +1,180 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type creditCardInvoiceRepository struct {
+	bucket *documentBucket
+}
+
+func NewCreditCardInvoiceRepository(db *bbolt.DB) repository.CreditCardInvoiceRepository {
+	return &creditCardInvoiceRepository{bucket: newDocumentBucket(db, "credit_card_invoices")}
+}
+
+func (r *creditCardInvoiceRepository) Create(ctx context.Context, invoice *entity.CreditCardInvoice) error {
+	model := mongodb.CreditCardInvoiceToModel(invoice)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create credit card invoice: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) Update(ctx context.Context, invoice *entity.CreditCardInvoice) error {
+	return r.UpdateMany(ctx, []*entity.CreditCardInvoice{invoice})
+}
+
+// UpdateMany saves the invoices in a single transaction, saving none if any of
+// them doesn't exist
+func (r *creditCardInvoiceRepository) UpdateMany(ctx context.Context, invoices []*entity.CreditCardInvoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	var missing bool
+	err := r.bucket.write(func(bucket *bbolt.Bucket) error {
+		for _, invoice := range invoices {
+			model := mongodb.CreditCardInvoiceToModel(invoice)
+			if bucket.Get([]byte(model.UUID)) == nil {
+				missing = true
+				return fmt.Errorf("credit card invoice not found")
+			}
+			if err := putDocument(bucket, model.UUID, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if missing {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update credit card invoices: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete credit card invoice: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("credit card invoice not found")
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CreditCardInvoice, error) {
+	var model mongodb.CreditCardInvoiceModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card invoice: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("credit card invoice not found")
+	}
+	return mongodb.CreditCardInvoiceFromModel(model)
+}
+
+// FindByCreditCard returns the card's invoices, latest month first
+func (r *creditCardInvoiceRepository) FindByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error) {
+	invoices, err := r.findInvoices(func(invoice *entity.CreditCardInvoice) bool {
+		return invoice.CreditCardID == creditCardID
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return invoices[i].ReferenceMonth > invoices[j].ReferenceMonth
+	})
+	return invoices, nil
+}
+
+func (r *creditCardInvoiceRepository) FindByMonth(ctx context.Context, creditCardID uuid.UUID, referenceMonth string) (*entity.CreditCardInvoice, error) {
+	invoices, err := r.findInvoices(func(invoice *entity.CreditCardInvoice) bool {
+		return invoice.CreditCardID == creditCardID && invoice.ReferenceMonth == referenceMonth
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, fmt.Errorf("credit card invoice not found for month %s", referenceMonth)
+	}
+	return invoices[0], nil
+}
+
+func (r *creditCardInvoiceRepository) FindOpenInvoice(ctx context.Context, creditCardID uuid.UUID) (*entity.CreditCardInvoice, error) {
+	invoices, err := r.findInvoices(func(invoice *entity.CreditCardInvoice) bool {
+		return invoice.CreditCardID == creditCardID && invoice.Status == entity.InvoiceStatusOpen
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, fmt.Errorf("no open credit card invoice found")
+	}
+	return invoices[0], nil
+}
+
+// FindByDateRange returns the invoices opened within the range, latest first
+func (r *creditCardInvoiceRepository) FindByDateRange(ctx context.Context, creditCardID uuid.UUID, startDate, endDate time.Time) ([]*entity.CreditCardInvoice, error) {
+	invoices, err := r.findInvoices(func(invoice *entity.CreditCardInvoice) bool {
+		return invoice.CreditCardID == creditCardID &&
+			!invoice.OpeningDate.Before(startDate) && !invoice.OpeningDate.After(endDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return invoices[i].OpeningDate.After(invoices[j].OpeningDate)
+	})
+	return invoices, nil
+}
+
+// FindByStatus returns the card's invoices in the status, earliest due first
+func (r *creditCardInvoiceRepository) FindByStatus(ctx context.Context, creditCardID uuid.UUID, status entity.InvoiceStatus) ([]*entity.CreditCardInvoice, error) {
+	invoices, err := r.findInvoices(func(invoice *entity.CreditCardInvoice) bool {
+		return invoice.CreditCardID == creditCardID && invoice.Status == status
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return invoices[i].DueDate.Before(invoices[j].DueDate)
+	})
+	return invoices, nil
+}
+
+// findInvoices returns the invoices match accepts, in no particular order
+func (r *creditCardInvoiceRepository) findInvoices(match func(invoice *entity.CreditCardInvoice) bool) ([]*entity.CreditCardInvoice, error) {
+	var invoices []*entity.CreditCardInvoice
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.CreditCardInvoiceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		invoice, err := mongodb.CreditCardInvoiceFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match(invoice) {
+			invoices = append(invoices, invoice)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card invoices: %w", err)
+	}
+	return invoices, nil
+}
@@ -0,0 +1,93 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type creditCardRepository struct {
+	bucket *documentBucket
+}
+
+func NewCreditCardRepository(db *bbolt.DB) repository.CreditCardRepository {
+	return &creditCardRepository{bucket: newDocumentBucket(db, "credit_cards")}
+}
+
+func (r *creditCardRepository) Create(ctx context.Context, card *entity.CreditCard) error {
+	model := mongodb.CreditCardToModel(card)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) Update(ctx context.Context, card *entity.CreditCard) error {
+	model := mongodb.CreditCardToModel(card)
+	if _, err := r.bucket.replace(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to update credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.bucket.remove(id.String()); err != nil {
+		return fmt.Errorf("failed to delete credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CreditCard, error) {
+	var model mongodb.CreditCardModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("credit card not found")
+	}
+	return mongodb.CreditCardFromModel(model)
+}
+
+func (r *creditCardRepository) FindAll(ctx context.Context) ([]*entity.CreditCard, error) {
+	return r.findCards(nil)
+}
+
+func (r *creditCardRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.CreditCard, error) {
+	return r.findCards(func(card *entity.CreditCard) bool {
+		return card.AccountID == accountID
+	})
+}
+
+// findCards returns the credit cards match accepts, or all of them when it's nil
+func (r *creditCardRepository) findCards(match func(card *entity.CreditCard) bool) ([]*entity.CreditCard, error) {
+	var cards []*entity.CreditCard
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.CreditCardModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		card, err := mongodb.CreditCardFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(card) {
+			cards = append(cards, card)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit cards: %w", err)
+	}
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].CreatedAt.Before(cards[j].CreatedAt)
+	})
+	return cards, nil
+}
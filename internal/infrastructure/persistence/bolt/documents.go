@@ -0,0 +1,88 @@
+package bolt
+
+import (
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// documentBucket reads and writes a bucket that holds each record as a BSON
+// document keyed by its uuid
+type documentBucket struct {
+	db   *bbolt.DB
+	name []byte
+}
+
+func newDocumentBucket(db *bbolt.DB, name string) *documentBucket {
+	return &documentBucket{db: db, name: []byte(name)}
+}
+
+// write runs fn in a read-write transaction, committing only if it succeeds
+func (b *documentBucket) write(fn func(bucket *bbolt.Bucket) error) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return fn(tx.Bucket(b.name))
+	})
+}
+
+// put creates or replaces the record
+func (b *documentBucket) put(id string, model interface{}) error {
+	return b.write(func(bucket *bbolt.Bucket) error {
+		return putDocument(bucket, id, model)
+	})
+}
+
+// replace saves the record and reports whether it existed, saving nothing if not
+func (b *documentBucket) replace(id string, model interface{}) (bool, error) {
+	found := false
+	err := b.write(func(bucket *bbolt.Bucket) error {
+		if bucket.Get([]byte(id)) == nil {
+			return nil
+		}
+		found = true
+		return putDocument(bucket, id, model)
+	})
+	return found, err
+}
+
+// remove deletes the record and reports whether it existed
+func (b *documentBucket) remove(id string) (bool, error) {
+	found := false
+	err := b.write(func(bucket *bbolt.Bucket) error {
+		if bucket.Get([]byte(id)) == nil {
+			return nil
+		}
+		found = true
+		return bucket.Delete([]byte(id))
+	})
+	return found, err
+}
+
+// get decodes the record into model and reports whether there was one
+func (b *documentBucket) get(id string, model interface{}) (bool, error) {
+	var found bool
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		document := tx.Bucket(b.name).Get([]byte(id))
+		if document == nil {
+			return nil
+		}
+		found = true
+		return bson.Unmarshal(document, model)
+	})
+	return found, err
+}
+
+// each calls decode with every document in the bucket, in uuid order
+func (b *documentBucket) each(decode func(document []byte) error) error {
+	return b.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(b.name).ForEach(func(_, document []byte) error {
+			return decode(document)
+		})
+	})
+}
+
+func putDocument(bucket *bbolt.Bucket, id string, model interface{}) error {
+	document, err := bson.Marshal(model)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(id), document)
+}
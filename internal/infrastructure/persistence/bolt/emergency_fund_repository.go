@@ -0,0 +1,41 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+)
+
+// emergencyFundPlanKey is the key of the bucket's only record
+const emergencyFundPlanKey = "plan"
+
+type emergencyFundRepository struct {
+	bucket *documentBucket
+}
+
+func NewEmergencyFundRepository(db *bbolt.DB) repository.EmergencyFundRepository {
+	return &emergencyFundRepository{bucket: newDocumentBucket(db, "emergency_fund")}
+}
+
+func (r *emergencyFundRepository) Get(ctx context.Context) (*entity.EmergencyFundPlan, error) {
+	var model mongodb.EmergencyFundPlanModel
+	found, err := r.bucket.get(emergencyFundPlanKey, &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find emergency fund plan: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return mongodb.EmergencyFundPlanFromModel(model)
+}
+
+func (r *emergencyFundRepository) Save(ctx context.Context, plan *entity.EmergencyFundPlan) error {
+	if err := r.bucket.put(emergencyFundPlanKey, mongodb.EmergencyFundPlanToModel(plan)); err != nil {
+		return fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+	return nil
+}
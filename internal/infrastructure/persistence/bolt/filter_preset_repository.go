@@ -0,0 +1,83 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type filterPresetRepository struct {
+	bucket *documentBucket
+}
+
+func NewFilterPresetRepository(db *bbolt.DB) repository.FilterPresetRepository {
+	return &filterPresetRepository{bucket: newDocumentBucket(db, "filter_presets")}
+}
+
+func (r *filterPresetRepository) Create(ctx context.Context, preset *entity.FilterPreset) error {
+	model := mongodb.FilterPresetToModel(preset)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create filter preset: %w", err)
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) Update(ctx context.Context, preset *entity.FilterPreset) error {
+	model := mongodb.FilterPresetToModel(preset)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update filter preset: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("filter preset not found")
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete filter preset: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("filter preset not found")
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) FindAll(ctx context.Context) ([]*entity.FilterPreset, error) {
+	return r.findPresets(nil)
+}
+
+// findPresets returns the filter presets match accepts, or all of them when it's nil
+func (r *filterPresetRepository) findPresets(match func(preset *entity.FilterPreset) bool) ([]*entity.FilterPreset, error) {
+	var presets []*entity.FilterPreset
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.FilterPresetModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		preset, err := mongodb.FilterPresetFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(preset) {
+			presets = append(presets, preset)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find filter presets: %w", err)
+	}
+	sort.SliceStable(presets, func(i, j int) bool {
+		return presets[i].Name < presets[j].Name
+	})
+	return presets, nil
+}
@@ -0,0 +1,47 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+)
+
+// formDraftRepository keys its records by form rather than uuid
+type formDraftRepository struct {
+	bucket *documentBucket
+}
+
+func NewFormDraftRepository(db *bbolt.DB) repository.FormDraftRepository {
+	return &formDraftRepository{bucket: newDocumentBucket(db, "form_drafts")}
+}
+
+func (r *formDraftRepository) Get(ctx context.Context, form entity.FormDraftKind) (*entity.FormDraft, error) {
+	var model mongodb.FormDraftModel
+	found, err := r.bucket.get(string(form), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find form draft: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return mongodb.FormDraftFromModel(model), nil
+}
+
+func (r *formDraftRepository) Save(ctx context.Context, draft *entity.FormDraft) error {
+	model := mongodb.FormDraftToModel(draft)
+	if err := r.bucket.put(model.Form, model); err != nil {
+		return fmt.Errorf("failed to save form draft: %w", err)
+	}
+	return nil
+}
+
+func (r *formDraftRepository) Delete(ctx context.Context, form entity.FormDraftKind) error {
+	if _, err := r.bucket.remove(string(form)); err != nil {
+		return fmt.Errorf("failed to delete form draft: %w", err)
+	}
+	return nil
+}
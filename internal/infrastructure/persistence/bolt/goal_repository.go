@@ -0,0 +1,95 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type goalRepository struct {
+	bucket *documentBucket
+}
+
+func NewGoalRepository(db *bbolt.DB) repository.GoalRepository {
+	return &goalRepository{bucket: newDocumentBucket(db, "goals")}
+}
+
+func (r *goalRepository) Create(ctx context.Context, goal *entity.Goal) error {
+	model := mongodb.GoalToModel(goal)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create goal: %w", err)
+	}
+	return nil
+}
+
+func (r *goalRepository) Update(ctx context.Context, goal *entity.Goal) error {
+	model := mongodb.GoalToModel(goal)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+func (r *goalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+func (r *goalRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
+	var model mongodb.GoalModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goal: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("goal not found")
+	}
+	return mongodb.GoalFromModel(model)
+}
+
+func (r *goalRepository) FindAll(ctx context.Context) ([]*entity.Goal, error) {
+	return r.findGoals(nil)
+}
+
+// findGoals returns the goals match accepts, or all of them when it's nil
+func (r *goalRepository) findGoals(match func(goal *entity.Goal) bool) ([]*entity.Goal, error) {
+	var goals []*entity.Goal
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.GoalModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		goal, err := mongodb.GoalFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(goal) {
+			goals = append(goals, goal)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goals: %w", err)
+	}
+	sort.SliceStable(goals, func(i, j int) bool {
+		return goals[i].Deadline.Before(goals[j].Deadline)
+	})
+	return goals, nil
+}
@@ -0,0 +1,88 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type holdingRepository struct {
+	bucket *documentBucket
+}
+
+func NewHoldingRepository(db *bbolt.DB) repository.HoldingRepository {
+	return &holdingRepository{bucket: newDocumentBucket(db, "holdings")}
+}
+
+func (r *holdingRepository) Create(ctx context.Context, holding *entity.Holding) error {
+	model := mongodb.HoldingToModel(holding)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create holding: %w", err)
+	}
+	return nil
+}
+
+func (r *holdingRepository) Update(ctx context.Context, holding *entity.Holding) error {
+	model := mongodb.HoldingToModel(holding)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update holding: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("holding not found")
+	}
+	return nil
+}
+
+func (r *holdingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Holding, error) {
+	var model mongodb.HoldingModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find holding: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("holding not found")
+	}
+	return mongodb.HoldingFromModel(model)
+}
+
+func (r *holdingRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Holding, error) {
+	return r.find(func(holding *entity.Holding) bool { return holding.AccountID == accountID })
+}
+
+func (r *holdingRepository) FindAll(ctx context.Context) ([]*entity.Holding, error) {
+	return r.find(func(*entity.Holding) bool { return true })
+}
+
+// find returns the holdings keep accepts, by ticker
+func (r *holdingRepository) find(keep func(*entity.Holding) bool) ([]*entity.Holding, error) {
+	var holdings []*entity.Holding
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.HoldingModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		holding, err := mongodb.HoldingFromModel(model)
+		if err != nil {
+			return err
+		}
+		if keep(holding) {
+			holdings = append(holdings, holding)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find holdings: %w", err)
+	}
+	sort.SliceStable(holdings, func(i, j int) bool {
+		return holdings[i].Ticker < holdings[j].Ticker
+	})
+	return holdings, nil
+}
@@ -0,0 +1,75 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type importSessionRepository struct {
+	bucket *documentBucket
+}
+
+func NewImportSessionRepository(db *bbolt.DB) repository.ImportSessionRepository {
+	return &importSessionRepository{bucket: newDocumentBucket(db, "import_sessions")}
+}
+
+func (r *importSessionRepository) Create(ctx context.Context, session *entity.ImportSession) error {
+	model := mongodb.ImportSessionToModel(session)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create import session: %w", err)
+	}
+	return nil
+}
+
+func (r *importSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportSession, error) {
+	var model mongodb.ImportSessionModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import session: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("import session not found")
+	}
+	return mongodb.ImportSessionFromModel(model)
+}
+
+// FindByAccountID returns the account's imports, most recent first
+func (r *importSessionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.ImportSession, error) {
+	return r.findSessions(func(session *entity.ImportSession) bool {
+		return session.AccountID == accountID
+	})
+}
+
+// findSessions returns the import sessions match accepts, or all of them when it's nil
+func (r *importSessionRepository) findSessions(match func(session *entity.ImportSession) bool) ([]*entity.ImportSession, error) {
+	var sessions []*entity.ImportSession
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.ImportSessionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		session, err := mongodb.ImportSessionFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(session) {
+			sessions = append(sessions, session)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import sessions: %w", err)
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+	return sessions, nil
+}
@@ -0,0 +1,93 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type inboxTransactionRepository struct {
+	bucket *documentBucket
+}
+
+func NewInboxTransactionRepository(db *bbolt.DB) repository.InboxTransactionRepository {
+	return &inboxTransactionRepository{bucket: newDocumentBucket(db, "transaction_inbox")}
+}
+
+func (r *inboxTransactionRepository) CreateMany(ctx context.Context, items []*entity.InboxTransaction) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := r.bucket.write(func(bucket *bbolt.Bucket) error {
+		for _, item := range items {
+			model := mongodb.InboxTransactionToModel(item)
+			if err := putDocument(bucket, model.UUID, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create inbox transactions: %w", err)
+	}
+	return nil
+}
+
+func (r *inboxTransactionRepository) Update(ctx context.Context, item *entity.InboxTransaction) error {
+	model := mongodb.InboxTransactionToModel(item)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update inbox transaction: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("inbox transaction not found")
+	}
+	return nil
+}
+
+func (r *inboxTransactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InboxTransaction, error) {
+	var model mongodb.InboxTransactionModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbox transaction: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("inbox transaction not found")
+	}
+	return mongodb.InboxTransactionFromModel(model)
+}
+
+// FindPending returns the items waiting for review, oldest first
+func (r *inboxTransactionRepository) FindPending(ctx context.Context) ([]*entity.InboxTransaction, error) {
+	var items []*entity.InboxTransaction
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.InboxTransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		if model.Status != string(entity.InboxStatusPending) {
+			return nil
+		}
+		item, err := mongodb.InboxTransactionFromModel(model)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbox transactions: %w", err)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Transaction.Date.Before(items[j].Transaction.Date)
+	})
+	return items, nil
+}
@@ -0,0 +1,83 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type macroRepository struct {
+	bucket *documentBucket
+}
+
+func NewMacroRepository(db *bbolt.DB) repository.MacroRepository {
+	return &macroRepository{bucket: newDocumentBucket(db, "macros")}
+}
+
+func (r *macroRepository) Create(ctx context.Context, macro *entity.Macro) error {
+	model := mongodb.MacroToModel(macro)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create macro: %w", err)
+	}
+	return nil
+}
+
+func (r *macroRepository) Update(ctx context.Context, macro *entity.Macro) error {
+	model := mongodb.MacroToModel(macro)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update macro: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("macro not found")
+	}
+	return nil
+}
+
+func (r *macroRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete macro: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("macro not found")
+	}
+	return nil
+}
+
+func (r *macroRepository) FindAll(ctx context.Context) ([]*entity.Macro, error) {
+	return r.findMacros(nil)
+}
+
+// findMacros returns the macros match accepts, or all of them when it's nil
+func (r *macroRepository) findMacros(match func(macro *entity.Macro) bool) ([]*entity.Macro, error) {
+	var macros []*entity.Macro
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.MacroModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		macro, err := mongodb.MacroFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(macro) {
+			macros = append(macros, macro)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find macros: %w", err)
+	}
+	sort.SliceStable(macros, func(i, j int) bool {
+		return macros[i].Slot < macros[j].Slot
+	})
+	return macros, nil
+}
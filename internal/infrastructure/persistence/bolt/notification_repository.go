@@ -0,0 +1,93 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type notificationRepository struct {
+	bucket *documentBucket
+}
+
+func NewNotificationRepository(db *bbolt.DB) repository.NotificationRepository {
+	return &notificationRepository{bucket: newDocumentBucket(db, "notifications")}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *entity.Notification) error {
+	model := mongodb.NotificationToModel(notification)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) Update(ctx context.Context, notification *entity.Notification) error {
+	model := mongodb.NotificationToModel(notification)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+func (r *notificationRepository) FindAll(ctx context.Context) ([]*entity.Notification, error) {
+	return r.findNotifications(nil)
+}
+
+func (r *notificationRepository) ExistsByKey(ctx context.Context, key string) (bool, error) {
+	notifications, err := r.findNotifications(func(notification *entity.Notification) bool {
+		return notification.Key == key
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(notifications) > 0, nil
+}
+
+// findNotifications returns the notifications match accepts, or all of them when it's nil
+func (r *notificationRepository) findNotifications(match func(notification *entity.Notification) bool) ([]*entity.Notification, error) {
+	var notifications []*entity.Notification
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.NotificationModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		notification, err := mongodb.NotificationFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(notification) {
+			notifications = append(notifications, notification)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.After(notifications[j].CreatedAt)
+	})
+	return notifications, nil
+}
@@ -0,0 +1,98 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type pendingPaymentRepository struct {
+	bucket *documentBucket
+}
+
+func NewPendingPaymentRepository(db *bbolt.DB) repository.PendingPaymentRepository {
+	return &pendingPaymentRepository{bucket: newDocumentBucket(db, "pending_payments")}
+}
+
+func (r *pendingPaymentRepository) Create(ctx context.Context, payment *entity.PendingPayment) error {
+	model := mongodb.PendingPaymentToModel(payment)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create pending payment: %w", err)
+	}
+	return nil
+}
+
+func (r *pendingPaymentRepository) Update(ctx context.Context, payment *entity.PendingPayment) error {
+	model := mongodb.PendingPaymentToModel(payment)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update pending payment: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("pending payment not found")
+	}
+	return nil
+}
+
+func (r *pendingPaymentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PendingPayment, error) {
+	var model mongodb.PendingPaymentModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending payment: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pending payment not found")
+	}
+	return mongodb.PendingPaymentFromModel(model)
+}
+
+func (r *pendingPaymentRepository) FindPending(ctx context.Context) ([]*entity.PendingPayment, error) {
+	return r.findPayments(func(payment *entity.PendingPayment) bool {
+		return payment.Status == entity.PendingPaymentStatusPending
+	})
+}
+
+func (r *pendingPaymentRepository) FindPendingByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findPayments(func(payment *entity.PendingPayment) bool {
+		return payment.AccountID == accountID && payment.Status == entity.PendingPaymentStatusPending
+	})
+}
+
+func (r *pendingPaymentRepository) FindPendingByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findPayments(func(payment *entity.PendingPayment) bool {
+		return payment.CreditCardID == creditCardID && payment.Status == entity.PendingPaymentStatusPending
+	})
+}
+
+// findPayments returns the pending payments match accepts, earliest first
+func (r *pendingPaymentRepository) findPayments(match func(payment *entity.PendingPayment) bool) ([]*entity.PendingPayment, error) {
+	var payments []*entity.PendingPayment
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.PendingPaymentModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		payment, err := mongodb.PendingPaymentFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(payment) {
+			payments = append(payments, payment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending payments: %w", err)
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].ScheduledFor.Before(payments[j].ScheduledFor)
+	})
+	return payments, nil
+}
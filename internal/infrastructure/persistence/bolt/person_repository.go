@@ -0,0 +1,100 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type personRepository struct {
+	bucket *documentBucket
+}
+
+func NewPersonRepository(db *bbolt.DB) repository.PersonRepository {
+	return &personRepository{bucket: newDocumentBucket(db, "people")}
+}
+
+func (r *personRepository) Create(ctx context.Context, person *entity.Person) error {
+	model := mongodb.PersonToModel(person)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) Update(ctx context.Context, person *entity.Person) error {
+	model := mongodb.PersonToModel(person)
+	if _, err := r.bucket.replace(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to update person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.bucket.remove(id.String()); err != nil {
+		return fmt.Errorf("failed to delete person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Person, error) {
+	var model mongodb.PersonModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find person: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("person not found")
+	}
+	return mongodb.PersonFromModel(model)
+}
+
+func (r *personRepository) FindAll(ctx context.Context) ([]*entity.Person, error) {
+	return r.findPeople(nil)
+}
+
+func (r *personRepository) FindByEmail(ctx context.Context, email string) (*entity.Person, error) {
+	people, err := r.findPeople(func(person *entity.Person) bool {
+		return person.Email == email
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(people) == 0 {
+		return nil, fmt.Errorf("person not found")
+	}
+	return people[0], nil
+}
+
+// findPeople returns the people match accepts, or all of them when it's nil
+func (r *personRepository) findPeople(match func(person *entity.Person) bool) ([]*entity.Person, error) {
+	var people []*entity.Person
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.PersonModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		person, err := mongodb.PersonFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(person) {
+			people = append(people, person)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find people: %w", err)
+	}
+	sort.SliceStable(people, func(i, j int) bool {
+		return people[i].CreatedAt.Before(people[j].CreatedAt)
+	})
+	return people, nil
+}
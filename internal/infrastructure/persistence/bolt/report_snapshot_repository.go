@@ -0,0 +1,65 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type reportSnapshotRepository struct {
+	bucket *documentBucket
+}
+
+func NewReportSnapshotRepository(db *bbolt.DB) repository.ReportSnapshotRepository {
+	return &reportSnapshotRepository{bucket: newDocumentBucket(db, "report_snapshots")}
+}
+
+func (r *reportSnapshotRepository) Create(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := mongodb.ReportSnapshotToModel(snapshot)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create report snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *reportSnapshotRepository) Update(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := mongodb.ReportSnapshotToModel(snapshot)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update report snapshot: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("report snapshot not found")
+	}
+	return nil
+}
+
+// FindAll returns every snapshot, latest month first
+func (r *reportSnapshotRepository) FindAll(ctx context.Context) ([]*entity.ReportSnapshot, error) {
+	var snapshots []*entity.ReportSnapshot
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.ReportSnapshotModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		snapshot, err := mongodb.ReportSnapshotFromModel(model)
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report snapshots: %w", err)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Month.After(snapshots[j].Month)
+	})
+	return snapshots, nil
+}
@@ -0,0 +1,80 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type scheduledTransferRepository struct {
+	bucket *documentBucket
+}
+
+func NewScheduledTransferRepository(db *bbolt.DB) repository.ScheduledTransferRepository {
+	return &scheduledTransferRepository{bucket: newDocumentBucket(db, "scheduled_transfers")}
+}
+
+func (r *scheduledTransferRepository) Create(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := mongodb.ScheduledTransferToModel(transfer)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepository) Update(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := mongodb.ScheduledTransferToModel(transfer)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("scheduled transfer not found")
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ScheduledTransfer, error) {
+	var model mongodb.ScheduledTransferModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled transfer: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("scheduled transfer not found")
+	}
+	return mongodb.ScheduledTransferFromModel(model)
+}
+
+// FindPending returns the transfers still to be made, earliest first
+func (r *scheduledTransferRepository) FindPending(ctx context.Context) ([]*entity.ScheduledTransfer, error) {
+	var transfers []*entity.ScheduledTransfer
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.ScheduledTransferModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		transfer, err := mongodb.ScheduledTransferFromModel(model)
+		if err != nil {
+			return err
+		}
+		if transfer.IsPending() {
+			transfers = append(transfers, transfer)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled transfers: %w", err)
+	}
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].ScheduledFor.Before(transfers[j].ScheduledFor)
+	})
+	return transfers, nil
+}
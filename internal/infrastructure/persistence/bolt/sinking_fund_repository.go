@@ -0,0 +1,98 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type sinkingFundRepository struct {
+	bucket *documentBucket
+}
+
+func NewSinkingFundRepository(db *bbolt.DB) repository.SinkingFundRepository {
+	return &sinkingFundRepository{bucket: newDocumentBucket(db, "sinking_funds")}
+}
+
+func (r *sinkingFundRepository) Create(ctx context.Context, fund *entity.SinkingFund) error {
+	model := mongodb.SinkingFundToModel(fund)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create sinking fund: %w", err)
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) Update(ctx context.Context, fund *entity.SinkingFund) error {
+	model := mongodb.SinkingFundToModel(fund)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update sinking fund: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("sinking fund not found")
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete sinking fund: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("sinking fund not found")
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SinkingFund, error) {
+	var model mongodb.SinkingFundModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sinking fund: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("sinking fund not found")
+	}
+	return mongodb.SinkingFundFromModel(model)
+}
+
+func (r *sinkingFundRepository) FindAll(ctx context.Context) ([]*entity.SinkingFund, error) {
+	return r.findFunds(nil)
+}
+
+// findFunds returns the sinking funds match accepts, or all of them when it's nil
+func (r *sinkingFundRepository) findFunds(match func(fund *entity.SinkingFund) bool) ([]*entity.SinkingFund, error) {
+	var funds []*entity.SinkingFund
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.SinkingFundModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		fund, err := mongodb.SinkingFundFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(fund) {
+			funds = append(funds, fund)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sinking funds: %w", err)
+	}
+	sort.SliceStable(funds, func(i, j int) bool {
+		if funds[i].DueMonth != funds[j].DueMonth {
+			return funds[i].DueMonth < funds[j].DueMonth
+		}
+		return funds[i].Name < funds[j].Name
+	})
+	return funds, nil
+}
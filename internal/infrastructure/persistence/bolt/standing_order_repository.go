@@ -0,0 +1,83 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type standingOrderRepository struct {
+	bucket *documentBucket
+}
+
+func NewStandingOrderRepository(db *bbolt.DB) repository.StandingOrderRepository {
+	return &standingOrderRepository{bucket: newDocumentBucket(db, "standing_orders")}
+}
+
+func (r *standingOrderRepository) Create(ctx context.Context, order *entity.StandingOrder) error {
+	model := mongodb.StandingOrderToModel(order)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create standing order: %w", err)
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) Update(ctx context.Context, order *entity.StandingOrder) error {
+	model := mongodb.StandingOrderToModel(order)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update standing order: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("standing order not found")
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete standing order: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("standing order not found")
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) FindAll(ctx context.Context) ([]*entity.StandingOrder, error) {
+	return r.findOrders(nil)
+}
+
+// findOrders returns the standing orders match accepts, or all of them when it's nil
+func (r *standingOrderRepository) findOrders(match func(order *entity.StandingOrder) bool) ([]*entity.StandingOrder, error) {
+	var orders []*entity.StandingOrder
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.StandingOrderModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		order, err := mongodb.StandingOrderFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(order) {
+			orders = append(orders, order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find standing orders: %w", err)
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].DayOfMonth < orders[j].DayOfMonth
+	})
+	return orders, nil
+}
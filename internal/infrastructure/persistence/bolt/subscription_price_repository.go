@@ -0,0 +1,84 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type subscriptionPriceRepository struct {
+	bucket *documentBucket
+}
+
+func NewSubscriptionPriceRepository(db *bbolt.DB) repository.SubscriptionPriceRepository {
+	return &subscriptionPriceRepository{bucket: newDocumentBucket(db, "subscription_prices")}
+}
+
+func (r *subscriptionPriceRepository) Create(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := mongodb.SubscriptionPriceToModel(price)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create subscription price: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionPriceRepository) Update(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := mongodb.SubscriptionPriceToModel(price)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription price: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("subscription price not found")
+	}
+	return nil
+}
+
+func (r *subscriptionPriceRepository) FindAll(ctx context.Context) ([]*entity.SubscriptionPrice, error) {
+	return r.findPrices(nil)
+}
+
+func (r *subscriptionPriceRepository) FindByKey(ctx context.Context, key string) (*entity.SubscriptionPrice, error) {
+	prices, err := r.findPrices(func(price *entity.SubscriptionPrice) bool {
+		return price.Key == key
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("subscription price not found")
+	}
+	return prices[0], nil
+}
+
+// findPrices returns the subscription prices match accepts, or all of them when it's nil
+func (r *subscriptionPriceRepository) findPrices(match func(price *entity.SubscriptionPrice) bool) ([]*entity.SubscriptionPrice, error) {
+	var prices []*entity.SubscriptionPrice
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.SubscriptionPriceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		price, err := mongodb.SubscriptionPriceFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(price) {
+			prices = append(prices, price)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription prices: %w", err)
+	}
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].CreatedAt.Before(prices[j].CreatedAt)
+	})
+	return prices, nil
+}
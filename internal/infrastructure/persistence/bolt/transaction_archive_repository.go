@@ -0,0 +1,76 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type transactionArchiveRepository struct {
+	db *bbolt.DB
+	// archive reads through a transactionRepository so it filters and sorts the same way
+	archive *transactionRepository
+}
+
+func NewTransactionArchiveRepository(db *bbolt.DB) repository.TransactionArchiveRepository {
+	return &transactionArchiveRepository{
+		db:      db,
+		archive: &transactionRepository{bucket: newDocumentBucket(db, "transactions_archive")},
+	}
+}
+
+// ArchiveBefore moves the documents between buckets in a single bbolt transaction
+func (r *transactionArchiveRepository) ArchiveBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	moved := 0
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		transactions, archive := tx.Bucket([]byte("transactions")), tx.Bucket([]byte("transactions_archive"))
+
+		// Deleting while iterating skips keys, so collect them first
+		var keys [][]byte
+		err := transactions.ForEach(func(key, document []byte) error {
+			var model mongodb.TransactionModel
+			if err := bson.Unmarshal(document, &model); err != nil {
+				return err
+			}
+			if model.Date.Before(cutoff) {
+				keys = append(keys, append([]byte(nil), key...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			// Values only live as long as the transaction's pages, so copy before moving
+			document := append([]byte(nil), transactions.Get(key)...)
+			if err := archive.Put(key, document); err != nil {
+				return err
+			}
+			if err := transactions.Delete(key); err != nil {
+				return err
+			}
+		}
+		moved = len(keys)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive transactions: %w", err)
+	}
+	return moved, nil
+}
+
+func (r *transactionArchiveRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.archive.FindByDateRange(ctx, startDate, endDate)
+}
+
+func (r *transactionArchiveRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.archive.FindSharedWithPerson(ctx, personID)
+}
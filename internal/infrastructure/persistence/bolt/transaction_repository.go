@@ -0,0 +1,350 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type transactionRepository struct {
+	bucket *documentBucket
+}
+
+func NewTransactionRepository(db *bbolt.DB) repository.TransactionRepository {
+	return &transactionRepository{bucket: newDocumentBucket(db, "transactions")}
+}
+
+func (r *transactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	return r.CreateMany(ctx, []*entity.Transaction{transaction})
+}
+
+// CreateMany saves the transactions in a single bbolt transaction
+func (r *transactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	err := r.bucket.write(func(bucket *bbolt.Bucket) error {
+		for _, transaction := range transactions {
+			model := mongodb.TransactionToModel(transaction)
+			if err := putDocument(bucket, model.UUID, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	model := mongodb.TransactionToModel(transaction)
+	if _, err := r.bucket.replace(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to update transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.bucket.remove(id.String()); err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	var model mongodb.TransactionModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("transaction not found")
+	}
+	return mongodb.TransactionFromModel(model)
+}
+
+func (r *transactionRepository) FindAll(ctx context.Context) ([]*entity.Transaction, error) {
+	return r.findTransactions(nil)
+}
+
+func (r *transactionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.AccountID != nil && *transaction.AccountID == accountID
+	})
+}
+
+func (r *transactionRepository) FindByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.CreditCardID != nil && *transaction.CreditCardID == creditCardID
+	})
+}
+
+func (r *transactionRepository) FindByCreditCardInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.CreditCardInvoiceID != nil && *transaction.CreditCardInvoiceID == invoiceID
+	})
+}
+
+func (r *transactionRepository) FindByBillID(ctx context.Context, billID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.BillID != nil && *transaction.BillID == billID
+	})
+}
+
+func (r *transactionRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return inRange(transaction.Date, startDate, endDate)
+	})
+}
+
+// FindPageByDateRange decodes the whole bucket like every bolt query, but
+// hands back only the page
+func (r *transactionRepository) FindPageByDateRange(ctx context.Context, startDate, endDate time.Time, offset, limit int) ([]*entity.Transaction, error) {
+	transactions, err := r.FindByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if offset >= len(transactions) {
+		return nil, nil
+	}
+	transactions = transactions[offset:]
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+	}
+	return transactions, nil
+}
+
+func (r *transactionRepository) FindByCategory(ctx context.Context, category entity.TransactionCategory) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.Category == category
+	})
+}
+
+func (r *transactionRepository) FindByTag(ctx context.Context, tag string) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.HasTag(tag)
+	})
+}
+
+func (r *transactionRepository) FindTags(ctx context.Context) ([]string, error) {
+	transactions, err := r.findTransactions(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var tags []string
+	for _, transaction := range transactions {
+		for _, tag := range transaction.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+func (r *transactionRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		for _, shared := range transaction.SharedWith {
+			if shared.PersonID == personID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (r *transactionRepository) FindUnassignedToBill(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.findTransactions(func(transaction *entity.Transaction) bool {
+		return transaction.BillID == nil && inRange(transaction.Date, startDate, endDate)
+	})
+}
+
+// FindLatest returns the most recent transactions, newest first
+func (r *transactionRepository) FindLatest(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	transactions, err := r.findTransactions(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+	}
+	return transactions, nil
+}
+
+func (r *transactionRepository) FindCategoryUsage(ctx context.Context, accountID, creditCardID *uuid.UUID, since time.Time) ([]repository.CategoryUsage, error) {
+	if accountID == nil && creditCardID == nil {
+		return nil, nil
+	}
+
+	transactions, err := r.findTransactions(func(transaction *entity.Transaction) bool {
+		if transaction.Date.Before(since) {
+			return false
+		}
+		if accountID != nil {
+			return transaction.AccountID != nil && *transaction.AccountID == *accountID
+		}
+		return transaction.CreditCardID != nil && *transaction.CreditCardID == *creditCardID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category usage: %w", err)
+	}
+
+	usage := make([]repository.CategoryUsage, 0, len(transactions))
+	for _, transaction := range transactions {
+		usage = append(usage, repository.CategoryUsage{Category: transaction.Category, Date: transaction.Date})
+	}
+	return usage, nil
+}
+
+func (r *transactionRepository) FindDescriptionUsage(ctx context.Context, since time.Time) ([]repository.DescriptionUsage, error) {
+	transactions, err := r.findTransactions(func(transaction *entity.Transaction) bool {
+		return !transaction.Date.Before(since)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find description usage: %w", err)
+	}
+
+	usage := make([]repository.DescriptionUsage, 0, len(transactions))
+	for _, transaction := range transactions {
+		usage = append(usage, repository.DescriptionUsage{Description: transaction.Description, Date: transaction.Date})
+	}
+	return usage, nil
+}
+
+// SumByCategory totals the matching transactions while reading them, without
+// keeping them around
+func (r *transactionRepository) SumByCategory(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryTotal, error) {
+	byCategory := make(map[entity.TransactionCategory]*repository.CategoryTotal)
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.TransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		if model.Type != string(transactionType) || !inRange(model.Date, startDate, endDate) {
+			return nil
+		}
+
+		category := entity.TransactionCategory(model.Category)
+		total, ok := byCategory[category]
+		if !ok {
+			total = &repository.CategoryTotal{Category: category}
+			byCategory[category] = total
+		}
+		total.Total += model.Amount.Amount
+		total.Count++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum transactions by category: %w", err)
+	}
+
+	totals := make([]repository.CategoryTotal, 0, len(byCategory))
+	for _, total := range byCategory {
+		totals = append(totals, *total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Total != totals[j].Total {
+			return totals[i].Total > totals[j].Total
+		}
+		return totals[i].Category < totals[j].Category
+	})
+	return totals, nil
+}
+
+// SumByCategoryAndMonth totals the matching transactions per category and
+// local calendar month while reading them
+func (r *transactionRepository) SumByCategoryAndMonth(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryMonthTotal, error) {
+	type categoryMonth struct {
+		category entity.TransactionCategory
+		month    time.Time
+	}
+
+	from, to := entity.PeriodMonth(startDate), entity.PeriodMonth(endDate)
+	byCategoryMonth := make(map[categoryMonth]*repository.CategoryMonthTotal)
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.TransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		if model.Type != string(transactionType) || model.Date.Before(from) || !model.Date.Before(to) {
+			return nil
+		}
+
+		key := categoryMonth{category: entity.TransactionCategory(model.Category), month: entity.PeriodMonth(model.Date.Local())}
+		total, ok := byCategoryMonth[key]
+		if !ok {
+			total = &repository.CategoryMonthTotal{Category: key.category, Month: key.month}
+			byCategoryMonth[key] = total
+		}
+		total.Total += model.Amount.Amount
+		total.Count++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum transactions by category and month: %w", err)
+	}
+
+	totals := make([]repository.CategoryMonthTotal, 0, len(byCategoryMonth))
+	for _, total := range byCategoryMonth {
+		totals = append(totals, *total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if !totals[i].Month.Equal(totals[j].Month) {
+			return totals[i].Month.Before(totals[j].Month)
+		}
+		return totals[i].Category < totals[j].Category
+	})
+	return totals, nil
+}
+
+// findTransactions returns the transactions match accepts, or all of them when
+// it's nil, newest first
+func (r *transactionRepository) findTransactions(match func(transaction *entity.Transaction) bool) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.TransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		transaction, err := mongodb.TransactionFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(transaction) {
+			transactions = append(transactions, transaction)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	// The bucket is already in id order, so a stable sort keeps it as the tiebreaker
+	sort.SliceStable(transactions, func(i, j int) bool {
+		a, b := transactions[i], transactions[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.After(b.Date)
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	return transactions, nil
+}
+
+func inRange(t, start, end time.Time) bool {
+	return !t.Before(start) && !t.After(end)
+}
@@ -0,0 +1,95 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type wishlistRepository struct {
+	bucket *documentBucket
+}
+
+func NewWishlistRepository(db *bbolt.DB) repository.WishlistRepository {
+	return &wishlistRepository{bucket: newDocumentBucket(db, "wishlist_items")}
+}
+
+func (r *wishlistRepository) Create(ctx context.Context, item *entity.WishlistItem) error {
+	model := mongodb.WishlistItemToModel(item)
+	if err := r.bucket.put(model.UUID, model); err != nil {
+		return fmt.Errorf("failed to create wishlist item: %w", err)
+	}
+	return nil
+}
+
+func (r *wishlistRepository) Update(ctx context.Context, item *entity.WishlistItem) error {
+	model := mongodb.WishlistItemToModel(item)
+	found, err := r.bucket.replace(model.UUID, model)
+	if err != nil {
+		return fmt.Errorf("failed to update wishlist item: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("wishlist item not found")
+	}
+	return nil
+}
+
+func (r *wishlistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.bucket.remove(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist item: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("wishlist item not found")
+	}
+	return nil
+}
+
+func (r *wishlistRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WishlistItem, error) {
+	var model mongodb.WishlistItemModel
+	found, err := r.bucket.get(id.String(), &model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist item: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("wishlist item not found")
+	}
+	return mongodb.WishlistItemFromModel(model)
+}
+
+func (r *wishlistRepository) FindAll(ctx context.Context) ([]*entity.WishlistItem, error) {
+	return r.findItems(nil)
+}
+
+// findItems returns the wishlist items match accepts, or all of them when it's nil
+func (r *wishlistRepository) findItems(match func(item *entity.WishlistItem) bool) ([]*entity.WishlistItem, error) {
+	var items []*entity.WishlistItem
+	err := r.bucket.each(func(document []byte) error {
+		var model mongodb.WishlistItemModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		item, err := mongodb.WishlistItemFromModel(model)
+		if err != nil {
+			return err
+		}
+		if match == nil || match(item) {
+			items = append(items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist items: %w", err)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].TargetDate.Before(items[j].TargetDate)
+	})
+	return items, nil
+}
@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type accountingPeriodRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAccountingPeriodRepository(db *mongo.Database) repository.AccountingPeriodRepository {
+	return &accountingPeriodRepository{
+		collection: db.Collection("accounting_periods"),
+	}
+}
+
+func (r *accountingPeriodRepository) Create(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := AccountingPeriodToModel(period)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create accounting period: %w", err)
+	}
+	return nil
+}
+
+func (r *accountingPeriodRepository) Update(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := AccountingPeriodToModel(period)
+	filter := bson.M{"uuid": period.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update accounting period: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("accounting period not found")
+	}
+
+	return nil
+}
+
+func (r *accountingPeriodRepository) FindAll(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "month", Value: -1}}) // Latest month first
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounting periods: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var periods []*entity.AccountingPeriod
+	for cursor.Next(ctx) {
+		var model AccountingPeriodModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode accounting period: %w", err)
+		}
+
+		period, err := AccountingPeriodFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type budgetRepository struct {
+	collection *mongo.Collection
+}
+
+func NewBudgetRepository(db *mongo.Database) repository.BudgetRepository {
+	return &budgetRepository{
+		collection: db.Collection("budgets"),
+	}
+}
+
+func (r *budgetRepository) Create(ctx context.Context, budget *entity.Budget) error {
+	model := BudgetToModel(budget)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create budget: %w", err)
+	}
+	return nil
+}
+
+func (r *budgetRepository) Update(ctx context.Context, budget *entity.Budget) error {
+	model := BudgetToModel(budget)
+	filter := bson.M{"uuid": budget.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("budget not found")
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("budget not found")
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error) {
+	var model BudgetModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("budget not found")
+		}
+		return nil, fmt.Errorf("failed to find budget: %w", err)
+	}
+
+	return BudgetFromModel(model)
+}
+
+func (r *budgetRepository) FindAll(ctx context.Context) ([]*entity.Budget, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "category", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budgets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var budgets []*entity.Budget
+	for cursor.Next(ctx) {
+		var model BudgetModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode budget: %w", err)
+		}
+
+		budget, err := BudgetFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, budget)
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type categoryAppearanceRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCategoryAppearanceRepository(db *mongo.Database) repository.CategoryAppearanceRepository {
+	return &categoryAppearanceRepository{
+		collection: db.Collection("category_appearances"),
+	}
+}
+
+func (r *categoryAppearanceRepository) Save(ctx context.Context, appearance *entity.CategoryAppearance) error {
+	model := CategoryAppearanceToModel(appearance)
+	filter := bson.M{"category": model.Category}
+	update := bson.M{"$set": model}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) Delete(ctx context.Context, category entity.TransactionCategory) error {
+	filter := bson.M{"category": string(category)}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed to delete category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) FindAll(ctx context.Context) ([]*entity.CategoryAppearance, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category appearances: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var appearances []*entity.CategoryAppearance
+	for cursor.Next(ctx) {
+		var model CategoryAppearanceModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode category appearance: %w", err)
+		}
+		appearances = append(appearances, CategoryAppearanceFromModel(model))
+	}
+
+	return appearances, nil
+}
@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// categoryClassifierRepository keeps the classifier as the collection's only document
+type categoryClassifierRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCategoryClassifierRepository(db *mongo.Database) repository.CategoryClassifierRepository {
+	return &categoryClassifierRepository{
+		collection: db.Collection("category_classifier"),
+	}
+}
+
+func (r *categoryClassifierRepository) Get(ctx context.Context) (*entity.CategoryClassifier, error) {
+	var model CategoryClassifierModel
+	err := r.collection.FindOne(ctx, bson.M{}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find category classifier: %w", err)
+	}
+
+	return CategoryClassifierFromModel(model), nil
+}
+
+func (r *categoryClassifierRepository) Save(ctx context.Context, classifier *entity.CategoryClassifier) error {
+	model := CategoryClassifierToModel(classifier)
+	update := bson.M{"$set": model}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save category classifier: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type categoryRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCategoryRepository(db *mongo.Database) repository.CategoryRepository {
+	return &categoryRepository{
+		collection: db.Collection("categories"),
+	}
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	model := CategoryToModel(category)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	model := CategoryToModel(category)
+	filter := bson.M{"uuid": category.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("category not found")
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("category not found")
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) FindAll(ctx context.Context) ([]*entity.Category, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var categories []*entity.Category
+	for cursor.Next(ctx) {
+		var model CategoryModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode category: %w", err)
+		}
+
+		category, err := CategoryFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type categoryRuleRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCategoryRuleRepository(db *mongo.Database) repository.CategoryRuleRepository {
+	return &categoryRuleRepository{
+		collection: db.Collection("category_rules"),
+	}
+}
+
+func (r *categoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	model := CategoryRuleToModel(rule)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create category rule: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete category rule: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("category rule not found")
+	}
+
+	return nil
+}
+
+func (r *categoryRuleRepository) FindAll(ctx context.Context) ([]*entity.CategoryRule, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category rules: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rules []*entity.CategoryRule
+	for cursor.Next(ctx) {
+		var model CategoryRuleModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode category rule: %w", err)
+		}
+
+		rule, err := CategoryRuleFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type changeRecordRepository struct {
+	collection *mongo.Collection
+}
+
+func NewChangeRecordRepository(db *mongo.Database) repository.ChangeRecordRepository {
+	return &changeRecordRepository{
+		collection: db.Collection("change_history"),
+	}
+}
+
+func (r *changeRecordRepository) Create(ctx context.Context, record *entity.ChangeRecord) error {
+	model := ChangeRecordToModel(record)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create change record: %w", err)
+	}
+	return nil
+}
+
+func (r *changeRecordRepository) Update(ctx context.Context, record *entity.ChangeRecord) error {
+	model := ChangeRecordToModel(record)
+	filter := bson.M{"uuid": record.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update change record: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("change record not found")
+	}
+
+	return nil
+}
+
+func (r *changeRecordRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ChangeRecord, error) {
+	var model ChangeRecordModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("change record not found")
+		}
+		return nil, fmt.Errorf("failed to find change record: %w", err)
+	}
+
+	return ChangeRecordFromModel(model)
+}
+
+func (r *changeRecordRepository) FindByEntity(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID) ([]*entity.ChangeRecord, error) {
+	filter := bson.M{"entity_type": string(entityType), "entity_uuid": entityID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change records: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []*entity.ChangeRecord
+	for cursor.Next(ctx) {
+		var model ChangeRecordModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode change record: %w", err)
+		}
+
+		record, err := ChangeRecordFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
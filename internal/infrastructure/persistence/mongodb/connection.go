@@ -12,18 +12,25 @@ import (
 type Config struct {
 	URI      string
 	Database string
+	// Lazy skips the ping, so connecting doesn't wait on the network and the
+	// first query finds out whether the server is there instead
+	Lazy bool
 }
 
 func NewConnection(cfg Config) (*mongo.Database, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	clientOptions := options.Client().ApplyURI(cfg.URI)
+	clientOptions := options.Client().ApplyURI(cfg.URI).SetServerSelectionTimeout(10 * time.Second)
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
 	}
 
+	if cfg.Lazy {
+		return client.Database(cfg.Database), nil
+	}
+
 	if err := client.Ping(ctx, nil); err != nil {
 		return nil, fmt.Errorf("failed to ping MongoDB: %w", err)
 	}
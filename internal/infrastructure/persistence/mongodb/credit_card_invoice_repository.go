@@ -49,6 +49,31 @@ func (r *creditCardInvoiceRepository) Update(ctx context.Context, invoice *entit
 	return nil
 }
 
+// UpdateMany saves several invoices in a single bulk write
+func (r *creditCardInvoiceRepository) UpdateMany(ctx context.Context, invoices []*entity.CreditCardInvoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(invoices))
+	for _, invoice := range invoices {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"uuid": invoice.ID.String()}).
+			SetUpdate(bson.M{"$set": CreditCardInvoiceToModel(invoice)}))
+	}
+
+	result, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return fmt.Errorf("failed to update credit card invoices: %w", err)
+	}
+
+	if result.MatchedCount != int64(len(invoices)) {
+		return fmt.Errorf("credit card invoice not found")
+	}
+
+	return nil
+}
+
 func (r *creditCardInvoiceRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	filter := bson.M{"uuid": id.String()}
 	result, err := r.collection.DeleteOne(ctx, filter)
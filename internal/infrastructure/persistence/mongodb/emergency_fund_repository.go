@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// emergencyFundRepository keeps the plan as the collection's only document
+type emergencyFundRepository struct {
+	collection *mongo.Collection
+}
+
+func NewEmergencyFundRepository(db *mongo.Database) repository.EmergencyFundRepository {
+	return &emergencyFundRepository{
+		collection: db.Collection("emergency_fund"),
+	}
+}
+
+func (r *emergencyFundRepository) Get(ctx context.Context) (*entity.EmergencyFundPlan, error) {
+	var model EmergencyFundPlanModel
+	err := r.collection.FindOne(ctx, bson.M{}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find emergency fund plan: %w", err)
+	}
+
+	return EmergencyFundPlanFromModel(model)
+}
+
+func (r *emergencyFundRepository) Save(ctx context.Context, plan *entity.EmergencyFundPlan) error {
+	model := EmergencyFundPlanToModel(plan)
+	update := bson.M{"$set": model}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type filterPresetRepository struct {
+	collection *mongo.Collection
+}
+
+func NewFilterPresetRepository(db *mongo.Database) repository.FilterPresetRepository {
+	return &filterPresetRepository{
+		collection: db.Collection("filter_presets"),
+	}
+}
+
+func (r *filterPresetRepository) Create(ctx context.Context, preset *entity.FilterPreset) error {
+	model := FilterPresetToModel(preset)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create filter preset: %w", err)
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) Update(ctx context.Context, preset *entity.FilterPreset) error {
+	model := FilterPresetToModel(preset)
+	filter := bson.M{"uuid": preset.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update filter preset: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("filter preset not found")
+	}
+
+	return nil
+}
+
+func (r *filterPresetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete filter preset: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("filter preset not found")
+	}
+
+	return nil
+}
+
+func (r *filterPresetRepository) FindAll(ctx context.Context) ([]*entity.FilterPreset, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find filter presets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var presets []*entity.FilterPreset
+	for cursor.Next(ctx) {
+		var model FilterPresetModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode filter preset: %w", err)
+		}
+
+		preset, err := FilterPresetFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		presets = append(presets, preset)
+	}
+
+	return presets, nil
+}
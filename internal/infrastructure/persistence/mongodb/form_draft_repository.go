@@ -0,0 +1,54 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type formDraftRepository struct {
+	collection *mongo.Collection
+}
+
+func NewFormDraftRepository(db *mongo.Database) repository.FormDraftRepository {
+	return &formDraftRepository{
+		collection: db.Collection("form_drafts"),
+	}
+}
+
+func (r *formDraftRepository) Get(ctx context.Context, form entity.FormDraftKind) (*entity.FormDraft, error) {
+	var model FormDraftModel
+	err := r.collection.FindOne(ctx, bson.M{"form": string(form)}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find form draft: %w", err)
+	}
+
+	return FormDraftFromModel(model), nil
+}
+
+func (r *formDraftRepository) Save(ctx context.Context, draft *entity.FormDraft) error {
+	model := FormDraftToModel(draft)
+	filter := bson.M{"form": model.Form}
+	update := bson.M{"$set": model}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save form draft: %w", err)
+	}
+	return nil
+}
+
+func (r *formDraftRepository) Delete(ctx context.Context, form entity.FormDraftKind) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"form": string(form)}); err != nil {
+		return fmt.Errorf("failed to delete form draft: %w", err)
+	}
+	return nil
+}
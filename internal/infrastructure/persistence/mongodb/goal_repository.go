@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type goalRepository struct {
+	collection *mongo.Collection
+}
+
+func NewGoalRepository(db *mongo.Database) repository.GoalRepository {
+	return &goalRepository{
+		collection: db.Collection("goals"),
+	}
+}
+
+func (r *goalRepository) Create(ctx context.Context, goal *entity.Goal) error {
+	model := GoalToModel(goal)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create goal: %w", err)
+	}
+	return nil
+}
+
+func (r *goalRepository) Update(ctx context.Context, goal *entity.Goal) error {
+	model := GoalToModel(goal)
+	filter := bson.M{"uuid": goal.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("goal not found")
+	}
+
+	return nil
+}
+
+func (r *goalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("goal not found")
+	}
+
+	return nil
+}
+
+func (r *goalRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
+	var model GoalModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("goal not found")
+		}
+		return nil, fmt.Errorf("failed to find goal: %w", err)
+	}
+
+	return GoalFromModel(model)
+}
+
+func (r *goalRepository) FindAll(ctx context.Context) ([]*entity.Goal, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "deadline", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goals: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var goals []*entity.Goal
+	for cursor.Next(ctx) {
+		var model GoalModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode goal: %w", err)
+		}
+
+		goal, err := GoalFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, nil
+}
@@ -0,0 +1,98 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type holdingRepository struct {
+	collection *mongo.Collection
+}
+
+func NewHoldingRepository(db *mongo.Database) repository.HoldingRepository {
+	return &holdingRepository{
+		collection: db.Collection("holdings"),
+	}
+}
+
+func (r *holdingRepository) Create(ctx context.Context, holding *entity.Holding) error {
+	model := HoldingToModel(holding)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create holding: %w", err)
+	}
+	return nil
+}
+
+func (r *holdingRepository) Update(ctx context.Context, holding *entity.Holding) error {
+	model := HoldingToModel(holding)
+	filter := bson.M{"uuid": holding.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update holding: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("holding not found")
+	}
+
+	return nil
+}
+
+func (r *holdingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Holding, error) {
+	var model HoldingModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("holding not found")
+		}
+		return nil, fmt.Errorf("failed to find holding: %w", err)
+	}
+
+	return HoldingFromModel(model)
+}
+
+func (r *holdingRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Holding, error) {
+	return r.find(ctx, bson.M{"account_uuid": accountID.String()})
+}
+
+func (r *holdingRepository) FindAll(ctx context.Context) ([]*entity.Holding, error) {
+	return r.find(ctx, bson.M{})
+}
+
+func (r *holdingRepository) find(ctx context.Context, filter bson.M) ([]*entity.Holding, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "ticker", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find holdings: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var holdings []*entity.Holding
+	for cursor.Next(ctx) {
+		var model HoldingModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode holding: %w", err)
+		}
+
+		holding, err := HoldingFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, holding)
+	}
+
+	return holdings, nil
+}
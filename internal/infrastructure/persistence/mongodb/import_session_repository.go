@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type importSessionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewImportSessionRepository(db *mongo.Database) repository.ImportSessionRepository {
+	return &importSessionRepository{
+		collection: db.Collection("import_sessions"),
+	}
+}
+
+func (r *importSessionRepository) Create(ctx context.Context, session *entity.ImportSession) error {
+	model := ImportSessionToModel(session)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create import session: %w", err)
+	}
+	return nil
+}
+
+func (r *importSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportSession, error) {
+	var model ImportSessionModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("import session not found")
+		}
+		return nil, fmt.Errorf("failed to find import session: %w", err)
+	}
+
+	return ImportSessionFromModel(model)
+}
+
+func (r *importSessionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.ImportSession, error) {
+	filter := bson.M{"account_uuid": accountID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}) // Most recent import first
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import sessions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []*entity.ImportSession
+	for cursor.Next(ctx) {
+		var model ImportSessionModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode import session: %w", err)
+		}
+
+		session, err := ImportSessionFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,98 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type inboxTransactionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewInboxTransactionRepository(db *mongo.Database) repository.InboxTransactionRepository {
+	return &inboxTransactionRepository{
+		collection: db.Collection("transaction_inbox"),
+	}
+}
+
+func (r *inboxTransactionRepository) CreateMany(ctx context.Context, items []*entity.InboxTransaction) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(items))
+	for i, item := range items {
+		documents[i] = InboxTransactionToModel(item)
+	}
+
+	if _, err := r.collection.InsertMany(ctx, documents); err != nil {
+		return fmt.Errorf("failed to create inbox transactions: %w", err)
+	}
+	return nil
+}
+
+func (r *inboxTransactionRepository) Update(ctx context.Context, item *entity.InboxTransaction) error {
+	model := InboxTransactionToModel(item)
+	filter := bson.M{"uuid": item.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update inbox transaction: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("inbox transaction not found")
+	}
+
+	return nil
+}
+
+func (r *inboxTransactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InboxTransaction, error) {
+	var model InboxTransactionModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("inbox transaction not found")
+		}
+		return nil, fmt.Errorf("failed to find inbox transaction: %w", err)
+	}
+
+	return InboxTransactionFromModel(model)
+}
+
+func (r *inboxTransactionRepository) FindPending(ctx context.Context) ([]*entity.InboxTransaction, error) {
+	filter := bson.M{"status": string(entity.InboxStatusPending)}
+	opts := options.Find().SetSort(bson.D{{Key: "transaction.date", Value: 1}, {Key: "uuid", Value: 1}}) // Oldest first
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbox transactions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []*entity.InboxTransaction
+	for cursor.Next(ctx) {
+		var model InboxTransactionModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode inbox transaction: %w", err)
+		}
+
+		item, err := InboxTransactionFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, cursor.Err()
+}
@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// indexes lists the indexes behind the frequent queries, by collection. They
+// mirror the ones the sqlite schema creates.
+var indexes = map[string][]bson.D{
+	"transactions": {
+		{{Key: "date", Value: -1}, {Key: "created_at", Value: -1}, {Key: "uuid", Value: 1}},
+		{{Key: "account_uuid", Value: 1}, {Key: "date", Value: 1}},
+		{{Key: "credit_card_uuid", Value: 1}, {Key: "date", Value: 1}},
+		{{Key: "credit_card_invoice_uuid", Value: 1}},
+		{{Key: "bill_uuid", Value: 1}},
+		{{Key: "category", Value: 1}},
+		{{Key: "shared_with.person_uuid", Value: 1}},
+		{{Key: "tags", Value: 1}},
+	},
+	"transactions_archive": {
+		{{Key: "date", Value: -1}, {Key: "created_at", Value: -1}, {Key: "uuid", Value: 1}},
+		{{Key: "shared_with.person_uuid", Value: 1}},
+	},
+	"credit_card_invoices": {
+		{{Key: "credit_card_uuid", Value: 1}, {Key: "reference_month", Value: 1}},
+	},
+}
+
+// EnsureIndexes creates the indexes that don't exist yet; existing ones are left alone
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	for collection, keys := range indexes {
+		models := make([]mongo.IndexModel, 0, len(keys))
+		for _, key := range keys {
+			models = append(models, mongo.IndexModel{Keys: key})
+		}
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, models); err != nil {
+			return fmt.Errorf("failed to create %s indexes: %w", collection, err)
+		}
+	}
+	return nil
+}
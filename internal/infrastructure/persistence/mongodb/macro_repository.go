@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type macroRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMacroRepository(db *mongo.Database) repository.MacroRepository {
+	return &macroRepository{
+		collection: db.Collection("macros"),
+	}
+}
+
+func (r *macroRepository) Create(ctx context.Context, macro *entity.Macro) error {
+	model := MacroToModel(macro)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create macro: %w", err)
+	}
+	return nil
+}
+
+func (r *macroRepository) Update(ctx context.Context, macro *entity.Macro) error {
+	model := MacroToModel(macro)
+	filter := bson.M{"uuid": macro.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update macro: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("macro not found")
+	}
+
+	return nil
+}
+
+func (r *macroRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete macro: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("macro not found")
+	}
+
+	return nil
+}
+
+func (r *macroRepository) FindAll(ctx context.Context) ([]*entity.Macro, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "slot", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find macros: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var macros []*entity.Macro
+	for cursor.Next(ctx) {
+		var model MacroModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode macro: %w", err)
+		}
+
+		macro, err := MacroFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		macros = append(macros, macro)
+	}
+
+	return macros, nil
+}
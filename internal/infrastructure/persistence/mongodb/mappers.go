@@ -1,6 +1,8 @@
 package mongodb
 
 import (
+	"time"
+
 	"financli/internal/domain/entity"
 	"financli/internal/domain/valueobject"
 	"github.com/google/uuid"
@@ -18,15 +20,62 @@ func MoneyFromModel(model MoneyModel) valueobject.Money {
 }
 
 func AccountToModel(account *entity.Account) AccountModel {
-	return AccountModel{
-		UUID:        account.ID.String(),
-		Name:        account.Name,
-		Type:        string(account.Type),
-		Balance:     MoneyToModel(account.Balance),
-		Description: account.Description,
-		CreatedAt:   account.CreatedAt,
-		UpdatedAt:   account.UpdatedAt,
+	var fees []AccountFeeModel
+	for _, fee := range account.Fees {
+		fees = append(fees, AccountFeeModel{
+			UUID:             fee.ID.String(),
+			Name:             fee.Name,
+			Amount:           MoneyToModel(fee.Amount),
+			DayOfMonth:       fee.DayOfMonth,
+			LastChargedMonth: fee.LastChargedMonth,
+		})
+	}
+
+	var charges []FeeChargeModel
+	for _, charge := range account.FeeCharges {
+		charges = append(charges, FeeChargeModel{
+			FeeUUID: charge.FeeID.String(),
+			FeeName: charge.FeeName,
+			Month:   charge.Month,
+			Date:    charge.Date,
+			Amount:  MoneyToModel(charge.Amount),
+		})
+	}
+
+	var interest []OverdraftModel
+	for _, charged := range account.OverdraftInterest {
+		interest = append(interest, OverdraftModel{
+			Month:  charged.Month,
+			Days:   charged.Days,
+			Amount: MoneyToModel(charged.Amount),
+		})
+	}
+
+	model := AccountModel{
+		UUID:                   account.ID.String(),
+		Name:                   account.Name,
+		Type:                   string(account.Type),
+		Balance:                MoneyToModel(account.Balance),
+		Description:            account.Description,
+		DefaultCategory:        string(account.DefaultCategory),
+		DefaultTransactionType: string(account.DefaultTransactionType),
+		YieldType:              string(account.YieldType),
+		YieldRate:              account.YieldRate,
+		LastYieldMonth:         account.LastYieldMonth,
+		Fees:                   fees,
+		FeeCharges:             charges,
+		OverdraftLimit:         MoneyToModel(account.OverdraftLimit),
+		OverdraftRate:          account.OverdraftRate,
+		LastOverdraftDay:       account.LastOverdraftDay,
+		OverdraftInterest:      interest,
+		CreatedAt:              account.CreatedAt,
+		UpdatedAt:              account.UpdatedAt,
+	}
+	if account.MinimumBalance != nil {
+		minimum := MoneyToModel(*account.MinimumBalance)
+		model.MinimumBalance = &minimum
 	}
+	return model
 }
 
 func AccountFromModel(model AccountModel) (*entity.Account, error) {
@@ -35,29 +84,99 @@ func AccountFromModel(model AccountModel) (*entity.Account, error) {
 		return nil, err
 	}
 
-	return &entity.Account{
-		ID:          id,
-		Name:        model.Name,
-		Type:        entity.AccountType(model.Type),
-		Balance:     MoneyFromModel(model.Balance),
-		Description: model.Description,
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
-	}, nil
+	var fees []entity.AccountFee
+	for _, fee := range model.Fees {
+		feeID, err := uuid.Parse(fee.UUID)
+		if err != nil {
+			return nil, err
+		}
+		fees = append(fees, entity.AccountFee{
+			ID:               feeID,
+			Name:             fee.Name,
+			Amount:           MoneyFromModel(fee.Amount),
+			DayOfMonth:       fee.DayOfMonth,
+			LastChargedMonth: fee.LastChargedMonth,
+		})
+	}
+
+	var charges []entity.FeeCharge
+	for _, charge := range model.FeeCharges {
+		feeID, err := uuid.Parse(charge.FeeUUID)
+		if err != nil {
+			return nil, err
+		}
+		charges = append(charges, entity.FeeCharge{
+			FeeID:   feeID,
+			FeeName: charge.FeeName,
+			Month:   charge.Month,
+			Date:    charge.Date,
+			Amount:  MoneyFromModel(charge.Amount),
+		})
+	}
+
+	var interest []entity.OverdraftInterest
+	for _, charged := range model.OverdraftInterest {
+		interest = append(interest, entity.OverdraftInterest{
+			Month:  charged.Month,
+			Days:   charged.Days,
+			Amount: MoneyFromModel(charged.Amount),
+		})
+	}
+
+	account := &entity.Account{
+		ID:                     id,
+		Name:                   model.Name,
+		Type:                   entity.AccountType(model.Type),
+		Balance:                MoneyFromModel(model.Balance),
+		Description:            model.Description,
+		DefaultCategory:        entity.TransactionCategory(model.DefaultCategory),
+		DefaultTransactionType: entity.TransactionType(model.DefaultTransactionType),
+		YieldType:              entity.YieldType(model.YieldType),
+		YieldRate:              model.YieldRate,
+		LastYieldMonth:         model.LastYieldMonth,
+		Fees:                   fees,
+		FeeCharges:             charges,
+		OverdraftLimit:         MoneyFromModel(model.OverdraftLimit),
+		OverdraftRate:          model.OverdraftRate,
+		LastOverdraftDay:       model.LastOverdraftDay,
+		OverdraftInterest:      interest,
+		CreatedAt:              model.CreatedAt,
+		UpdatedAt:              model.UpdatedAt,
+	}
+	if model.MinimumBalance != nil {
+		minimum := MoneyFromModel(*model.MinimumBalance)
+		account.MinimumBalance = &minimum
+	}
+	return account, nil
 }
 
 func CreditCardToModel(card *entity.CreditCard) CreditCardModel {
-	return CreditCardModel{
-		UUID:           card.ID.String(),
-		AccountUUID:    card.AccountID.String(),
-		Name:           card.Name,
-		LastFourDigits: card.LastFourDigits,
-		CreditLimit:    MoneyToModel(card.CreditLimit),
-		CurrentBalance: MoneyToModel(card.CurrentBalance),
-		DueDay:         card.DueDay,
-		CreatedAt:      card.CreatedAt,
-		UpdatedAt:      card.UpdatedAt,
+	model := CreditCardModel{
+		UUID:                     card.ID.String(),
+		AccountUUID:              card.AccountID.String(),
+		Name:                     card.Name,
+		LastFourDigits:           card.LastFourDigits,
+		CreditLimit:              MoneyToModel(card.CreditLimit),
+		CurrentBalance:           MoneyToModel(card.CurrentBalance),
+		DueDay:                   card.DueDay,
+		MinimumPaymentPercentage: card.MinimumPaymentPercentage,
+		DefaultCategory:          string(card.DefaultCategory),
+		DefaultTransactionType:   string(card.DefaultTransactionType),
+		RemindersDisabled:        card.InvoiceReminders.Disabled,
+		ReminderEmail:            card.InvoiceReminders.Email,
+		ReminderWebhook:          card.InvoiceReminders.Webhook,
+		CreatedAt:                card.CreatedAt,
+		UpdatedAt:                card.UpdatedAt,
 	}
+
+	if card.InvoiceSplit != nil {
+		model.InvoiceSplit = &InvoiceSplitModel{
+			PersonUUID: card.InvoiceSplit.PersonID.String(),
+			Percentage: card.InvoiceSplit.Percentage,
+		}
+	}
+
+	return model
 }
 
 func CreditCardFromModel(model CreditCardModel) (*entity.CreditCard, error) {
@@ -71,27 +190,54 @@ func CreditCardFromModel(model CreditCardModel) (*entity.CreditCard, error) {
 		return nil, err
 	}
 
-	return &entity.CreditCard{
-		ID:             id,
-		AccountID:      accountID,
-		Name:           model.Name,
-		LastFourDigits: model.LastFourDigits,
-		CreditLimit:    MoneyFromModel(model.CreditLimit),
-		CurrentBalance: MoneyFromModel(model.CurrentBalance),
-		DueDay:         model.DueDay,
-		CreatedAt:      model.CreatedAt,
-		UpdatedAt:      model.UpdatedAt,
-	}, nil
+	minimumPercentage := model.MinimumPaymentPercentage
+	if minimumPercentage == 0 {
+		minimumPercentage = entity.DefaultMinimumPaymentPercentage
+	}
+
+	reminders := entity.InvoiceReminders{
+		Disabled: model.RemindersDisabled,
+		Email:    model.ReminderEmail,
+		Webhook:  model.ReminderWebhook,
+	}
+
+	card := &entity.CreditCard{
+		ID:                       id,
+		AccountID:                accountID,
+		Name:                     model.Name,
+		LastFourDigits:           model.LastFourDigits,
+		CreditLimit:              MoneyFromModel(model.CreditLimit),
+		CurrentBalance:           MoneyFromModel(model.CurrentBalance),
+		DueDay:                   model.DueDay,
+		MinimumPaymentPercentage: minimumPercentage,
+		DefaultCategory:          entity.TransactionCategory(model.DefaultCategory),
+		DefaultTransactionType:   entity.TransactionType(model.DefaultTransactionType),
+		InvoiceReminders:         reminders,
+		CreatedAt:                model.CreatedAt,
+		UpdatedAt:                model.UpdatedAt,
+	}
+
+	if model.InvoiceSplit != nil {
+		personID, err := uuid.Parse(model.InvoiceSplit.PersonUUID)
+		if err != nil {
+			return nil, err
+		}
+		card.InvoiceSplit = &entity.InvoiceSplit{PersonID: personID, Percentage: model.InvoiceSplit.Percentage}
+	}
+
+	return card, nil
 }
 
 func PersonToModel(person *entity.Person) PersonModel {
 	return PersonModel{
-		UUID:      person.ID.String(),
-		Name:      person.Name,
-		Email:     person.Email,
-		Phone:     person.Phone,
-		CreatedAt: person.CreatedAt,
-		UpdatedAt: person.UpdatedAt,
+		UUID:              person.ID.String(),
+		Name:              person.Name,
+		Email:             person.Email,
+		Phone:             person.Phone,
+		NotifyOwedAmounts: person.NotifyOwedAmounts,
+		LastOwedNotice:    person.LastOwedNotice,
+		CreatedAt:         person.CreatedAt,
+		UpdatedAt:         person.UpdatedAt,
 	}
 }
 
@@ -102,12 +248,14 @@ func PersonFromModel(model PersonModel) (*entity.Person, error) {
 	}
 
 	return &entity.Person{
-		ID:        id,
-		Name:      model.Name,
-		Email:     model.Email,
-		Phone:     model.Phone,
-		CreatedAt: model.CreatedAt,
-		UpdatedAt: model.UpdatedAt,
+		ID:                id,
+		Name:              model.Name,
+		Email:             model.Email,
+		Phone:             model.Phone,
+		NotifyOwedAmounts: model.NotifyOwedAmounts,
+		LastOwedNotice:    model.LastOwedNotice,
+		CreatedAt:         model.CreatedAt,
+		UpdatedAt:         model.UpdatedAt,
 	}, nil
 }
 
@@ -150,15 +298,22 @@ func BillFromModel(model BillModel) (*entity.Bill, error) {
 
 func TransactionToModel(transaction *entity.Transaction) TransactionModel {
 	model := TransactionModel{
-		UUID:        transaction.ID.String(),
-		Type:        string(transaction.Type),
-		Category:    string(transaction.Category),
-		Amount:      MoneyToModel(transaction.Amount),
-		Description: transaction.Description,
-		Date:        transaction.Date,
-		SharedWith:  make([]SharedExpenseModel, len(transaction.SharedWith)),
-		CreatedAt:   transaction.CreatedAt,
-		UpdatedAt:   transaction.UpdatedAt,
+		UUID:             transaction.ID.String(),
+		Type:             string(transaction.Type),
+		Category:         string(transaction.Category),
+		Amount:           MoneyToModel(transaction.Amount),
+		Description:      transaction.Description,
+		Date:             transaction.Date,
+		SharedWith:       make([]SharedExpenseModel, len(transaction.SharedWith)),
+		IgnoreFromBudget: transaction.IgnoreFromBudget,
+		City:             transaction.City,
+		Venue:            transaction.Venue,
+		Client:           transaction.Client,
+		Project:          transaction.Project,
+		PaymentMethod:    string(transaction.PaymentMethod),
+		Tags:             transaction.Tags,
+		CreatedAt:        transaction.CreatedAt,
+		UpdatedAt:        transaction.UpdatedAt,
 	}
 
 	if transaction.AccountID != nil {
@@ -181,6 +336,11 @@ func TransactionToModel(transaction *entity.Transaction) TransactionModel {
 		model.CreditCardInvoiceUUID = &invoiceUUID
 	}
 
+	if transaction.TransferID != nil {
+		transferUUID := transaction.TransferID.String()
+		model.TransferUUID = &transferUUID
+	}
+
 	for i, shared := range transaction.SharedWith {
 		model.SharedWith[i] = SharedExpenseModel{
 			PersonUUID: shared.PersonID.String(),
@@ -199,15 +359,22 @@ func TransactionFromModel(model TransactionModel) (*entity.Transaction, error) {
 	}
 
 	transaction := &entity.Transaction{
-		ID:          id,
-		Type:        entity.TransactionType(model.Type),
-		Category:    entity.TransactionCategory(model.Category),
-		Amount:      MoneyFromModel(model.Amount),
-		Description: model.Description,
-		Date:        model.Date,
-		SharedWith:  make([]entity.SharedExpense, len(model.SharedWith)),
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
+		ID:               id,
+		Type:             entity.TransactionType(model.Type),
+		Category:         entity.TransactionCategory(model.Category),
+		Amount:           MoneyFromModel(model.Amount),
+		Description:      model.Description,
+		Date:             model.Date,
+		SharedWith:       make([]entity.SharedExpense, len(model.SharedWith)),
+		IgnoreFromBudget: model.IgnoreFromBudget,
+		City:             model.City,
+		Venue:            model.Venue,
+		Client:           model.Client,
+		Project:          model.Project,
+		PaymentMethod:    entity.PaymentMethod(model.PaymentMethod),
+		Tags:             model.Tags,
+		CreatedAt:        model.CreatedAt,
+		UpdatedAt:        model.UpdatedAt,
 	}
 
 	if model.AccountUUID != nil {
@@ -242,6 +409,14 @@ func TransactionFromModel(model TransactionModel) (*entity.Transaction, error) {
 		transaction.CreditCardInvoiceID = &invoiceID
 	}
 
+	if model.TransferUUID != nil {
+		transferID, err := uuid.Parse(*model.TransferUUID)
+		if err != nil {
+			return nil, err
+		}
+		transaction.TransferID = &transferID
+	}
+
 	for i, shared := range model.SharedWith {
 		personID, err := uuid.Parse(shared.PersonUUID)
 		if err != nil {
@@ -274,6 +449,9 @@ func CreditCardInvoiceToModel(invoice *entity.CreditCardInvoice) CreditCardInvoi
 		TotalCharges:     MoneyToModel(invoice.TotalCharges),
 		TotalPayments:    MoneyToModel(invoice.TotalPayments),
 		ClosingBalance:   MoneyToModel(invoice.ClosingBalance),
+		MinimumPayment:   MoneyToModel(invoice.MinimumPayment),
+		AmountPaid:       MoneyToModel(invoice.AmountPaid),
+		MinimumPaidAt:    invoice.MinimumPaidAt,
 		Status:           string(invoice.Status),
 		TransactionUUIDs: transactionUUIDs,
 		CreatedAt:        invoice.CreatedAt,
@@ -301,6 +479,15 @@ func CreditCardInvoiceFromModel(model CreditCardInvoiceModel) (*entity.CreditCar
 		transactionIDs[i] = txnID
 	}
 
+	// Invoices stored before minimum payment tracking have no currency set
+	currency := model.ClosingBalance.Currency
+	if model.MinimumPayment.Currency == "" {
+		model.MinimumPayment.Currency = currency
+	}
+	if model.AmountPaid.Currency == "" {
+		model.AmountPaid.Currency = currency
+	}
+
 	return &entity.CreditCardInvoice{
 		ID:              id,
 		CreditCardID:    creditCardID,
@@ -312,9 +499,978 @@ func CreditCardInvoiceFromModel(model CreditCardInvoiceModel) (*entity.CreditCar
 		TotalCharges:    MoneyFromModel(model.TotalCharges),
 		TotalPayments:   MoneyFromModel(model.TotalPayments),
 		ClosingBalance:  MoneyFromModel(model.ClosingBalance),
+		MinimumPayment:  MoneyFromModel(model.MinimumPayment),
+		AmountPaid:      MoneyFromModel(model.AmountPaid),
+		MinimumPaidAt:   model.MinimumPaidAt,
 		Status:          entity.InvoiceStatus(model.Status),
 		TransactionIDs:  transactionIDs,
 		CreatedAt:       model.CreatedAt,
 		UpdatedAt:       model.UpdatedAt,
 	}, nil
 }
+
+func ImportSessionToModel(session *entity.ImportSession) ImportSessionModel {
+	model := ImportSessionModel{
+		UUID:                       session.ID.String(),
+		AccountUUID:                session.AccountID.String(),
+		Source:                     session.Source,
+		PeriodStart:                session.PeriodStart,
+		PeriodEnd:                  session.PeriodEnd,
+		MatchedTransactionUUIDs:    uuidsToStrings(session.MatchedTransactionIDs),
+		CreatedTransactionUUIDs:    uuidsToStrings(session.CreatedTransactionIDs),
+		LedgerOnlyTransactionUUIDs: uuidsToStrings(session.LedgerOnlyTransactionIDs),
+		QueuedInboxUUIDs:           uuidsToStrings(session.QueuedInboxIDs),
+		LedgerBalance:              MoneyToModel(session.LedgerBalance),
+		CreatedAt:                  session.CreatedAt,
+	}
+
+	if session.StatementBalance != nil {
+		balance := MoneyToModel(*session.StatementBalance)
+		model.StatementBalance = &balance
+	}
+
+	for _, assertion := range session.BalanceAssertions {
+		assertionModel := BalanceAssertionModel{
+			Date:        assertion.Date,
+			Description: assertion.Description,
+			Expected:    MoneyToModel(assertion.Expected),
+			Actual:      MoneyToModel(assertion.Actual),
+		}
+		if assertion.TransactionID != nil {
+			id := assertion.TransactionID.String()
+			assertionModel.TransactionUUID = &id
+		}
+		model.BalanceAssertions = append(model.BalanceAssertions, assertionModel)
+	}
+
+	return model
+}
+
+func ImportSessionFromModel(model ImportSessionModel) (*entity.ImportSession, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := uuid.Parse(model.AccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	matched, err := stringsToUUIDs(model.MatchedTransactionUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := stringsToUUIDs(model.CreatedTransactionUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerOnly, err := stringsToUUIDs(model.LedgerOnlyTransactionUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := stringsToUUIDs(model.QueuedInboxUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &entity.ImportSession{
+		ID:                       id,
+		AccountID:                accountID,
+		Source:                   model.Source,
+		PeriodStart:              model.PeriodStart,
+		PeriodEnd:                model.PeriodEnd,
+		MatchedTransactionIDs:    matched,
+		CreatedTransactionIDs:    created,
+		LedgerOnlyTransactionIDs: ledgerOnly,
+		QueuedInboxIDs:           queued,
+		LedgerBalance:            MoneyFromModel(model.LedgerBalance),
+		BalanceAssertions:        []entity.BalanceAssertion{},
+		CreatedAt:                model.CreatedAt,
+	}
+
+	if model.StatementBalance != nil {
+		balance := MoneyFromModel(*model.StatementBalance)
+		session.StatementBalance = &balance
+	}
+
+	for _, assertionModel := range model.BalanceAssertions {
+		assertion := entity.BalanceAssertion{
+			Date:        assertionModel.Date,
+			Description: assertionModel.Description,
+			Expected:    MoneyFromModel(assertionModel.Expected),
+			Actual:      MoneyFromModel(assertionModel.Actual),
+		}
+		if assertionModel.TransactionUUID != nil {
+			id, err := uuid.Parse(*assertionModel.TransactionUUID)
+			if err != nil {
+				return nil, err
+			}
+			assertion.TransactionID = &id
+		}
+		session.BalanceAssertions = append(session.BalanceAssertions, assertion)
+	}
+
+	return session, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+func stringsToUUIDs(values []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = id
+	}
+	return result, nil
+}
+
+func PendingPaymentToModel(payment *entity.PendingPayment) PendingPaymentModel {
+	var invoiceUUID *string
+	if payment.InvoiceID != nil {
+		id := payment.InvoiceID.String()
+		invoiceUUID = &id
+	}
+
+	return PendingPaymentModel{
+		UUID:           payment.ID.String(),
+		AccountUUID:    payment.AccountID.String(),
+		CreditCardUUID: payment.CreditCardID.String(),
+		InvoiceUUID:    invoiceUUID,
+		Amount:         MoneyToModel(payment.Amount),
+		ScheduledFor:   payment.ScheduledFor,
+		Status:         string(payment.Status),
+		ClearedAt:      payment.ClearedAt,
+		CreatedAt:      payment.CreatedAt,
+		UpdatedAt:      payment.UpdatedAt,
+	}
+}
+
+func PendingPaymentFromModel(model PendingPaymentModel) (*entity.PendingPayment, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := uuid.Parse(model.AccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	creditCardID, err := uuid.Parse(model.CreditCardUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoiceID *uuid.UUID
+	if model.InvoiceUUID != nil {
+		parsed, err := uuid.Parse(*model.InvoiceUUID)
+		if err != nil {
+			return nil, err
+		}
+		invoiceID = &parsed
+	}
+
+	return &entity.PendingPayment{
+		ID:           id,
+		AccountID:    accountID,
+		CreditCardID: creditCardID,
+		InvoiceID:    invoiceID,
+		Amount:       MoneyFromModel(model.Amount),
+		ScheduledFor: model.ScheduledFor,
+		Status:       entity.PendingPaymentStatus(model.Status),
+		ClearedAt:    model.ClearedAt,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
+
+func SinkingFundToModel(fund *entity.SinkingFund) SinkingFundModel {
+	return SinkingFundModel{
+		UUID:         fund.ID.String(),
+		Name:         fund.Name,
+		AnnualAmount: MoneyToModel(fund.AnnualAmount),
+		DueMonth:     int(fund.DueMonth),
+		Category:     string(fund.Category),
+		Keyword:      fund.Keyword,
+		CreatedAt:    fund.CreatedAt,
+		UpdatedAt:    fund.UpdatedAt,
+	}
+}
+
+func SinkingFundFromModel(model SinkingFundModel) (*entity.SinkingFund, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SinkingFund{
+		ID:           id,
+		Name:         model.Name,
+		AnnualAmount: MoneyFromModel(model.AnnualAmount),
+		DueMonth:     time.Month(model.DueMonth),
+		Category:     entity.TransactionCategory(model.Category),
+		Keyword:      model.Keyword,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
+
+func WishlistItemToModel(item *entity.WishlistItem) WishlistItemModel {
+	var transactionUUID *string
+	if item.TransactionID != nil {
+		id := item.TransactionID.String()
+		transactionUUID = &id
+	}
+
+	return WishlistItemModel{
+		UUID:            item.ID.String(),
+		Name:            item.Name,
+		EstimatedCost:   MoneyToModel(item.EstimatedCost),
+		Priority:        string(item.Priority),
+		TargetDate:      item.TargetDate,
+		Category:        string(item.Category),
+		TransactionUUID: transactionUUID,
+		PurchasedAt:     item.PurchasedAt,
+		CreatedAt:       item.CreatedAt,
+		UpdatedAt:       item.UpdatedAt,
+	}
+}
+
+func WishlistItemFromModel(model WishlistItemModel) (*entity.WishlistItem, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactionID *uuid.UUID
+	if model.TransactionUUID != nil {
+		parsed, err := uuid.Parse(*model.TransactionUUID)
+		if err != nil {
+			return nil, err
+		}
+		transactionID = &parsed
+	}
+
+	return &entity.WishlistItem{
+		ID:            id,
+		Name:          model.Name,
+		EstimatedCost: MoneyFromModel(model.EstimatedCost),
+		Priority:      entity.WishlistPriority(model.Priority),
+		TargetDate:    model.TargetDate,
+		Category:      entity.TransactionCategory(model.Category),
+		TransactionID: transactionID,
+		PurchasedAt:   model.PurchasedAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
+
+func SubscriptionPriceToModel(price *entity.SubscriptionPrice) SubscriptionPriceModel {
+	return SubscriptionPriceModel{
+		UUID:           price.ID.String(),
+		Key:            price.Key,
+		Description:    price.Description,
+		Amount:         MoneyToModel(price.Amount),
+		AcknowledgedAt: price.AcknowledgedAt,
+		CreatedAt:      price.CreatedAt,
+		UpdatedAt:      price.UpdatedAt,
+	}
+}
+
+func SubscriptionPriceFromModel(model SubscriptionPriceModel) (*entity.SubscriptionPrice, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SubscriptionPrice{
+		ID:             id,
+		Key:            model.Key,
+		Description:    model.Description,
+		Amount:         MoneyFromModel(model.Amount),
+		AcknowledgedAt: model.AcknowledgedAt,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}, nil
+}
+
+func ChangeRecordToModel(record *entity.ChangeRecord) ChangeRecordModel {
+	changes := make([]FieldChangeModel, len(record.Changes))
+	for i, change := range record.Changes {
+		changes[i] = FieldChangeModel{Field: change.Field, Before: change.Before, After: change.After}
+	}
+
+	return ChangeRecordModel{
+		UUID:       record.ID.String(),
+		EntityType: string(record.EntityType),
+		EntityUUID: record.EntityID.String(),
+		Summary:    record.Summary,
+		Changes:    changes,
+		RevertedAt: record.RevertedAt,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func ChangeRecordFromModel(model ChangeRecordModel) (*entity.ChangeRecord, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	entityID, err := uuid.Parse(model.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]entity.FieldChange, len(model.Changes))
+	for i, change := range model.Changes {
+		changes[i] = entity.FieldChange{Field: change.Field, Before: change.Before, After: change.After}
+	}
+
+	return &entity.ChangeRecord{
+		ID:         id,
+		EntityType: entity.ChangeEntityType(model.EntityType),
+		EntityID:   entityID,
+		Summary:    model.Summary,
+		Changes:    changes,
+		RevertedAt: model.RevertedAt,
+		CreatedAt:  model.CreatedAt,
+	}, nil
+}
+
+func InboxTransactionToModel(item *entity.InboxTransaction) InboxTransactionModel {
+	return InboxTransactionModel{
+		UUID:        item.ID.String(),
+		Source:      item.Source,
+		Transaction: TransactionToModel(item.Transaction),
+		Status:      string(item.Status),
+		ResolvedAt:  item.ResolvedAt,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
+
+func InboxTransactionFromModel(model InboxTransactionModel) (*entity.InboxTransaction, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := TransactionFromModel(model.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.InboxTransaction{
+		ID:          id,
+		Source:      model.Source,
+		Transaction: transaction,
+		Status:      entity.InboxStatus(model.Status),
+		ResolvedAt:  model.ResolvedAt,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
+
+func FilterPresetToModel(preset *entity.FilterPreset) FilterPresetModel {
+	filter := preset.Filter
+
+	categories := make([]string, len(filter.Categories))
+	for i, category := range filter.Categories {
+		categories[i] = string(category)
+	}
+
+	var accountUUID, creditCardUUID *string
+	if filter.AccountID != nil {
+		id := filter.AccountID.String()
+		accountUUID = &id
+	}
+	if filter.CreditCardID != nil {
+		id := filter.CreditCardID.String()
+		creditCardUUID = &id
+	}
+	var invoiceUUID *string
+	if filter.InvoiceID != nil {
+		id := filter.InvoiceID.String()
+		invoiceUUID = &id
+	}
+
+	return FilterPresetModel{
+		UUID: preset.ID.String(),
+		Name: preset.Name,
+		Filter: TransactionFilterModel{
+			DateRange:      string(filter.DateRange),
+			StartDate:      filter.StartDate,
+			EndDate:        filter.EndDate,
+			Categories:     categories,
+			Source:         string(filter.Source),
+			AccountUUID:    accountUUID,
+			CreditCardUUID: creditCardUUID,
+			Type:           string(filter.Type),
+			PaymentMethod:  string(filter.PaymentMethod),
+			Tag:            filter.Tag,
+			Payee:          filter.Payee,
+			InvoiceUUID:    invoiceUUID,
+		},
+		CreatedAt: preset.CreatedAt,
+		UpdatedAt: preset.UpdatedAt,
+	}
+}
+
+func FilterPresetFromModel(model FilterPresetModel) (*entity.FilterPreset, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]entity.TransactionCategory, len(model.Filter.Categories))
+	for i, category := range model.Filter.Categories {
+		categories[i] = entity.TransactionCategory(category)
+	}
+
+	var accountID, creditCardID *uuid.UUID
+	if model.Filter.AccountUUID != nil {
+		parsed, err := uuid.Parse(*model.Filter.AccountUUID)
+		if err != nil {
+			return nil, err
+		}
+		accountID = &parsed
+	}
+	if model.Filter.CreditCardUUID != nil {
+		parsed, err := uuid.Parse(*model.Filter.CreditCardUUID)
+		if err != nil {
+			return nil, err
+		}
+		creditCardID = &parsed
+	}
+	var invoiceID *uuid.UUID
+	if model.Filter.InvoiceUUID != nil {
+		parsed, err := uuid.Parse(*model.Filter.InvoiceUUID)
+		if err != nil {
+			return nil, err
+		}
+		invoiceID = &parsed
+	}
+
+	return &entity.FilterPreset{
+		ID:   id,
+		Name: model.Name,
+		Filter: entity.TransactionFilter{
+			DateRange:     entity.FilterDateRange(model.Filter.DateRange),
+			StartDate:     model.Filter.StartDate,
+			EndDate:       model.Filter.EndDate,
+			Categories:    categories,
+			Source:        entity.FilterSource(model.Filter.Source),
+			AccountID:     accountID,
+			CreditCardID:  creditCardID,
+			Type:          entity.FilterType(model.Filter.Type),
+			PaymentMethod: entity.PaymentMethod(model.Filter.PaymentMethod),
+			Tag:           model.Filter.Tag,
+			Payee:         model.Filter.Payee,
+			InvoiceID:     invoiceID,
+		},
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}, nil
+}
+
+func CategoryAppearanceToModel(appearance *entity.CategoryAppearance) CategoryAppearanceModel {
+	return CategoryAppearanceModel{
+		Category:  string(appearance.Category),
+		Icon:      appearance.Icon,
+		Color:     appearance.Color,
+		UpdatedAt: appearance.UpdatedAt,
+	}
+}
+
+func CategoryAppearanceFromModel(model CategoryAppearanceModel) *entity.CategoryAppearance {
+	return &entity.CategoryAppearance{
+		Category:  entity.TransactionCategory(model.Category),
+		Icon:      model.Icon,
+		Color:     model.Color,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func FormDraftToModel(draft *entity.FormDraft) FormDraftModel {
+	return FormDraftModel{
+		Form:      string(draft.Form),
+		Fields:    draft.Fields,
+		UpdatedAt: draft.UpdatedAt,
+	}
+}
+
+func FormDraftFromModel(model FormDraftModel) *entity.FormDraft {
+	fields := model.Fields
+	if fields == nil {
+		fields = make(map[string]string)
+	}
+	return &entity.FormDraft{
+		Form:      entity.FormDraftKind(model.Form),
+		Fields:    fields,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func MacroToModel(macro *entity.Macro) MacroModel {
+	keys := make([]MacroKeyModel, len(macro.Keys))
+	for i, key := range macro.Keys {
+		keys[i] = MacroKeyModel{Type: key.Type, Runes: key.Runes, Alt: key.Alt}
+	}
+
+	return MacroModel{
+		UUID:      macro.ID.String(),
+		Name:      macro.Name,
+		Slot:      macro.Slot,
+		Keys:      keys,
+		CreatedAt: macro.CreatedAt,
+		UpdatedAt: macro.UpdatedAt,
+	}
+}
+
+func MacroFromModel(model MacroModel) (*entity.Macro, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]entity.MacroKey, len(model.Keys))
+	for i, key := range model.Keys {
+		keys[i] = entity.MacroKey{Type: key.Type, Runes: key.Runes, Alt: key.Alt}
+	}
+
+	return &entity.Macro{
+		ID:        id,
+		Name:      model.Name,
+		Slot:      model.Slot,
+		Keys:      keys,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}, nil
+}
+
+func CategoryRuleToModel(rule *entity.CategoryRule) CategoryRuleModel {
+	return CategoryRuleModel{
+		UUID:      rule.ID.String(),
+		Keyword:   rule.Keyword,
+		Category:  string(rule.Category),
+		CreatedAt: rule.CreatedAt,
+	}
+}
+
+func CategoryRuleFromModel(model CategoryRuleModel) (*entity.CategoryRule, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CategoryRule{
+		ID:        id,
+		Keyword:   model.Keyword,
+		Category:  entity.TransactionCategory(model.Category),
+		CreatedAt: model.CreatedAt,
+	}, nil
+}
+
+func CategoryToModel(category *entity.Category) CategoryModel {
+	return CategoryModel{
+		UUID:      category.ID.String(),
+		Key:       string(category.Key),
+		Name:      category.Name,
+		Icon:      category.Icon,
+		Kind:      string(category.Kind),
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+func CategoryFromModel(model CategoryModel) (*entity.Category, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Category{
+		ID:        id,
+		Key:       entity.TransactionCategory(model.Key),
+		Name:      model.Name,
+		Icon:      model.Icon,
+		Kind:      entity.CategoryKind(model.Kind),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}, nil
+}
+
+func NotificationToModel(notification *entity.Notification) NotificationModel {
+	return NotificationModel{
+		UUID:      notification.ID.String(),
+		Key:       notification.Key,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Severity:  string(notification.Severity),
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+	}
+}
+
+func NotificationFromModel(model NotificationModel) (*entity.Notification, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notifications saved before severities were added are informational
+	severity := entity.NotificationSeverity(model.Severity)
+	if severity == "" {
+		severity = entity.NotificationSeverityInfo
+	}
+
+	return &entity.Notification{
+		ID:        id,
+		Key:       model.Key,
+		Title:     model.Title,
+		Body:      model.Body,
+		Severity:  severity,
+		CreatedAt: model.CreatedAt,
+		ReadAt:    model.ReadAt,
+	}, nil
+}
+
+func BudgetToModel(budget *entity.Budget) BudgetModel {
+	return BudgetModel{
+		UUID:         budget.ID.String(),
+		Category:     string(budget.Category),
+		MonthlyLimit: MoneyToModel(budget.MonthlyLimit),
+		Rollover:     string(budget.Rollover),
+		Carried:      budget.Carried,
+		CreatedAt:    budget.CreatedAt,
+		UpdatedAt:    budget.UpdatedAt,
+	}
+}
+
+func BudgetFromModel(model BudgetModel) (*entity.Budget, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Budget{
+		ID:           id,
+		Category:     entity.TransactionCategory(model.Category),
+		MonthlyLimit: MoneyFromModel(model.MonthlyLimit),
+		Rollover:     entity.BudgetRollover(model.Rollover),
+		Carried:      model.Carried,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
+
+func GoalToModel(goal *entity.Goal) GoalModel {
+	return GoalModel{
+		UUID:         goal.ID.String(),
+		Name:         goal.Name,
+		TargetAmount: MoneyToModel(goal.TargetAmount),
+		Deadline:     goal.Deadline,
+		AccountUUID:  goal.AccountID.String(),
+		CreatedAt:    goal.CreatedAt,
+		UpdatedAt:    goal.UpdatedAt,
+	}
+}
+
+func GoalFromModel(model GoalModel) (*entity.Goal, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := uuid.Parse(model.AccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Goal{
+		ID:           id,
+		Name:         model.Name,
+		TargetAmount: MoneyFromModel(model.TargetAmount),
+		Deadline:     model.Deadline,
+		AccountID:    accountID,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}, nil
+}
+
+func StandingOrderToModel(order *entity.StandingOrder) StandingOrderModel {
+	return StandingOrderModel{
+		UUID:            order.ID.String(),
+		FromAccountUUID: order.FromAccountID.String(),
+		ToAccountUUID:   order.ToAccountID.String(),
+		Amount:          MoneyToModel(order.Amount),
+		DayOfMonth:      order.DayOfMonth,
+		Description:     order.Description,
+		LastRunMonth:    order.LastRunMonth,
+		CreatedAt:       order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
+	}
+}
+
+func StandingOrderFromModel(model StandingOrderModel) (*entity.StandingOrder, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccountID, err := uuid.Parse(model.FromAccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := uuid.Parse(model.ToAccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.StandingOrder{
+		ID:            id,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        MoneyFromModel(model.Amount),
+		DayOfMonth:    model.DayOfMonth,
+		Description:   model.Description,
+		LastRunMonth:  model.LastRunMonth,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
+
+func ScheduledTransferToModel(transfer *entity.ScheduledTransfer) ScheduledTransferModel {
+	return ScheduledTransferModel{
+		UUID:            transfer.ID.String(),
+		FromAccountUUID: transfer.FromAccountID.String(),
+		ToAccountUUID:   transfer.ToAccountID.String(),
+		Amount:          MoneyToModel(transfer.Amount),
+		ScheduledFor:    transfer.ScheduledFor,
+		Description:     transfer.Description,
+		Status:          string(transfer.Status),
+		TransferredAt:   transfer.TransferredAt,
+		CreatedAt:       transfer.CreatedAt,
+		UpdatedAt:       transfer.UpdatedAt,
+	}
+}
+
+func ScheduledTransferFromModel(model ScheduledTransferModel) (*entity.ScheduledTransfer, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccountID, err := uuid.Parse(model.FromAccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := uuid.Parse(model.ToAccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ScheduledTransfer{
+		ID:            id,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        MoneyFromModel(model.Amount),
+		ScheduledFor:  model.ScheduledFor,
+		Description:   model.Description,
+		Status:        entity.ScheduledTransferStatus(model.Status),
+		TransferredAt: model.TransferredAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
+
+func HoldingToModel(holding *entity.Holding) HoldingModel {
+	return HoldingModel{
+		UUID:           holding.ID.String(),
+		AccountUUID:    holding.AccountID.String(),
+		Ticker:         holding.Ticker,
+		Quantity:       holding.Quantity,
+		AveragePrice:   MoneyToModel(holding.AveragePrice),
+		LastPrice:      MoneyToModel(holding.LastPrice),
+		RealizedProfit: MoneyToModel(holding.RealizedProfit),
+		CreatedAt:      holding.CreatedAt,
+		UpdatedAt:      holding.UpdatedAt,
+	}
+}
+
+func HoldingFromModel(model HoldingModel) (*entity.Holding, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := uuid.Parse(model.AccountUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Holding{
+		ID:             id,
+		AccountID:      accountID,
+		Ticker:         model.Ticker,
+		Quantity:       model.Quantity,
+		AveragePrice:   MoneyFromModel(model.AveragePrice),
+		LastPrice:      MoneyFromModel(model.LastPrice),
+		RealizedProfit: MoneyFromModel(model.RealizedProfit),
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}, nil
+}
+
+func AccountingPeriodToModel(period *entity.AccountingPeriod) AccountingPeriodModel {
+	log := make([]PeriodLogEntryModel, len(period.Log))
+	for i, entry := range period.Log {
+		log[i] = PeriodLogEntryModel{Action: string(entry.Action), Reason: entry.Reason, At: entry.At}
+	}
+
+	return AccountingPeriodModel{
+		UUID:      period.ID.String(),
+		Month:     period.Month,
+		Closed:    period.Closed,
+		Log:       log,
+		CreatedAt: period.CreatedAt,
+		UpdatedAt: period.UpdatedAt,
+	}
+}
+
+func AccountingPeriodFromModel(model AccountingPeriodModel) (*entity.AccountingPeriod, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	log := make([]entity.PeriodLogEntry, len(model.Log))
+	for i, entry := range model.Log {
+		log[i] = entity.PeriodLogEntry{Action: entity.PeriodAction(entry.Action), Reason: entry.Reason, At: entry.At}
+	}
+
+	// Stored times come back in UTC, while the month starts at local midnight
+	return &entity.AccountingPeriod{
+		ID:        id,
+		Month:     entity.PeriodMonth(model.Month.Local()),
+		Closed:    model.Closed,
+		Log:       log,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}, nil
+}
+
+func ReportSnapshotToModel(snapshot *entity.ReportSnapshot) ReportSnapshotModel {
+	categories := make(map[string]MoneyModel, len(snapshot.Categories))
+	for category, amount := range snapshot.Categories {
+		categories[string(category)] = MoneyToModel(amount)
+	}
+
+	return ReportSnapshotModel{
+		UUID:             snapshot.ID.String(),
+		Month:            snapshot.Month,
+		Income:           MoneyToModel(snapshot.Income),
+		Expenses:         MoneyToModel(snapshot.Expenses),
+		TransactionCount: snapshot.TransactionCount,
+		Categories:       categories,
+		TakenAt:          snapshot.TakenAt,
+		CreatedAt:        snapshot.CreatedAt,
+		UpdatedAt:        snapshot.UpdatedAt,
+	}
+}
+
+func ReportSnapshotFromModel(model ReportSnapshotModel) (*entity.ReportSnapshot, error) {
+	id, err := uuid.Parse(model.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[entity.TransactionCategory]valueobject.Money, len(model.Categories))
+	for category, amount := range model.Categories {
+		categories[entity.TransactionCategory(category)] = MoneyFromModel(amount)
+	}
+
+	// Stored times come back in UTC, while the month starts at local midnight
+	return &entity.ReportSnapshot{
+		ID:               id,
+		Month:            entity.PeriodMonth(model.Month.Local()),
+		Income:           MoneyFromModel(model.Income),
+		Expenses:         MoneyFromModel(model.Expenses),
+		TransactionCount: model.TransactionCount,
+		Categories:       categories,
+		TakenAt:          model.TakenAt,
+		CreatedAt:        model.CreatedAt,
+		UpdatedAt:        model.UpdatedAt,
+	}, nil
+}
+
+func EmergencyFundPlanToModel(plan *entity.EmergencyFundPlan) EmergencyFundPlanModel {
+	return EmergencyFundPlanModel{
+		EssentialMonthly: MoneyToModel(plan.EssentialMonthly),
+		TargetMonths:     plan.TargetMonths,
+		AccountUUIDs:     uuidsToStrings(plan.AccountIDs),
+		UpdatedAt:        plan.UpdatedAt,
+	}
+}
+
+func EmergencyFundPlanFromModel(model EmergencyFundPlanModel) (*entity.EmergencyFundPlan, error) {
+	accountIDs, err := stringsToUUIDs(model.AccountUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.EmergencyFundPlan{
+		EssentialMonthly: MoneyFromModel(model.EssentialMonthly),
+		TargetMonths:     model.TargetMonths,
+		AccountIDs:       accountIDs,
+		UpdatedAt:        model.UpdatedAt,
+	}, nil
+}
+
+func CategoryClassifierToModel(classifier *entity.CategoryClassifier) CategoryClassifierModel {
+	model := CategoryClassifierModel{
+		WordCounts:     make(map[string]map[string]int, len(classifier.WordCounts)),
+		CategoryCounts: make(map[string]int, len(classifier.CategoryCounts)),
+		UpdatedAt:      classifier.UpdatedAt,
+	}
+	for word, counts := range classifier.WordCounts {
+		model.WordCounts[word] = make(map[string]int, len(counts))
+		for category, count := range counts {
+			model.WordCounts[word][string(category)] = count
+		}
+	}
+	for category, count := range classifier.CategoryCounts {
+		model.CategoryCounts[string(category)] = count
+	}
+	return model
+}
+
+func CategoryClassifierFromModel(model CategoryClassifierModel) *entity.CategoryClassifier {
+	classifier := entity.NewCategoryClassifier()
+	for word, counts := range model.WordCounts {
+		classifier.WordCounts[word] = make(map[entity.TransactionCategory]int, len(counts))
+		for category, count := range counts {
+			classifier.WordCounts[word][entity.TransactionCategory(category)] = count
+		}
+	}
+	for category, count := range model.CategoryCounts {
+		classifier.CategoryCounts[entity.TransactionCategory(category)] = count
+	}
+	classifier.UpdatedAt = model.UpdatedAt
+	return classifier
+}
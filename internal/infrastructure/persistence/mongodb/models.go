@@ -7,37 +7,85 @@ import (
 )
 
 type AccountModel struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty"`
-	UUID        string             `bson:"uuid"`
-	Name        string             `bson:"name"`
-	Type        string             `bson:"type"`
-	Balance     MoneyModel         `bson:"balance"`
-	Description string             `bson:"description"`
-	CreatedAt   time.Time          `bson:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at"`
+	ID                     primitive.ObjectID `bson:"_id,omitempty"`
+	UUID                   string             `bson:"uuid"`
+	Name                   string             `bson:"name"`
+	Type                   string             `bson:"type"`
+	Balance                MoneyModel         `bson:"balance"`
+	Description            string             `bson:"description"`
+	DefaultCategory        string             `bson:"default_category,omitempty"`
+	DefaultTransactionType string             `bson:"default_transaction_type,omitempty"`
+	YieldType              string             `bson:"yield_type,omitempty"`
+	YieldRate              float64            `bson:"yield_rate,omitempty"`
+	LastYieldMonth         string             `bson:"last_yield_month,omitempty"`
+	Fees                   []AccountFeeModel  `bson:"fees"`
+	FeeCharges             []FeeChargeModel   `bson:"fee_charges"`
+	MinimumBalance         *MoneyModel        `bson:"minimum_balance,omitempty"`
+	OverdraftLimit         MoneyModel         `bson:"overdraft_limit"`
+	OverdraftRate          float64            `bson:"overdraft_rate,omitempty"`
+	LastOverdraftDay       string             `bson:"last_overdraft_day,omitempty"`
+	OverdraftInterest      []OverdraftModel   `bson:"overdraft_interest"`
+	CreatedAt              time.Time          `bson:"created_at"`
+	UpdatedAt              time.Time          `bson:"updated_at"`
+}
+
+type AccountFeeModel struct {
+	UUID             string     `bson:"uuid"`
+	Name             string     `bson:"name"`
+	Amount           MoneyModel `bson:"amount"`
+	DayOfMonth       int        `bson:"day_of_month"`
+	LastChargedMonth string     `bson:"last_charged_month"`
+}
+
+type FeeChargeModel struct {
+	FeeUUID string     `bson:"fee_uuid"`
+	FeeName string     `bson:"fee_name"`
+	Month   string     `bson:"month"`
+	Date    time.Time  `bson:"date"`
+	Amount  MoneyModel `bson:"amount"`
+}
+
+type OverdraftModel struct {
+	Month  string     `bson:"month"`
+	Days   int        `bson:"days"`
+	Amount MoneyModel `bson:"amount"`
 }
 
 type CreditCardModel struct {
-	ID             primitive.ObjectID `bson:"_id,omitempty"`
-	UUID           string             `bson:"uuid"`
-	AccountUUID    string             `bson:"account_uuid"`
-	Name           string             `bson:"name"`
-	LastFourDigits string             `bson:"last_four_digits"`
-	CreditLimit    MoneyModel         `bson:"credit_limit"`
-	CurrentBalance MoneyModel         `bson:"current_balance"`
-	DueDay         int                `bson:"due_day"`
-	CreatedAt      time.Time          `bson:"created_at"`
-	UpdatedAt      time.Time          `bson:"updated_at"`
+	ID                       primitive.ObjectID `bson:"_id,omitempty"`
+	UUID                     string             `bson:"uuid"`
+	AccountUUID              string             `bson:"account_uuid"`
+	Name                     string             `bson:"name"`
+	LastFourDigits           string             `bson:"last_four_digits"`
+	CreditLimit              MoneyModel         `bson:"credit_limit"`
+	CurrentBalance           MoneyModel         `bson:"current_balance"`
+	DueDay                   int                `bson:"due_day"`
+	MinimumPaymentPercentage float64            `bson:"minimum_payment_percentage"`
+	DefaultCategory          string             `bson:"default_category,omitempty"`
+	DefaultTransactionType   string             `bson:"default_transaction_type,omitempty"`
+	RemindersDisabled        bool               `bson:"reminders_disabled"`
+	ReminderEmail            bool               `bson:"reminder_email"`
+	ReminderWebhook          bool               `bson:"reminder_webhook"`
+	InvoiceSplit             *InvoiceSplitModel `bson:"invoice_split"`
+	CreatedAt                time.Time          `bson:"created_at"`
+	UpdatedAt                time.Time          `bson:"updated_at"`
+}
+
+type InvoiceSplitModel struct {
+	PersonUUID string  `bson:"person_uuid"`
+	Percentage float64 `bson:"percentage"`
 }
 
 type PersonModel struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	UUID      string             `bson:"uuid"`
-	Name      string             `bson:"name"`
-	Email     string             `bson:"email"`
-	Phone     string             `bson:"phone"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID                primitive.ObjectID `bson:"_id,omitempty"`
+	UUID              string             `bson:"uuid"`
+	Name              string             `bson:"name"`
+	Email             string             `bson:"email"`
+	Phone             string             `bson:"phone"`
+	NotifyOwedAmounts bool               `bson:"notify_owed_amounts"`
+	LastOwedNotice    string             `bson:"last_owed_notice,omitempty"`
+	CreatedAt         time.Time          `bson:"created_at"`
+	UpdatedAt         time.Time          `bson:"updated_at"`
 }
 
 type BillModel struct {
@@ -62,12 +110,20 @@ type TransactionModel struct {
 	CreditCardUUID        *string              `bson:"credit_card_uuid,omitempty"`
 	CreditCardInvoiceUUID *string              `bson:"credit_card_invoice_uuid,omitempty"`
 	BillUUID              *string              `bson:"bill_uuid,omitempty"`
+	TransferUUID          *string              `bson:"transfer_uuid,omitempty"`
 	Type                  string               `bson:"type"`
 	Category              string               `bson:"category"`
 	Amount                MoneyModel           `bson:"amount"`
 	Description           string               `bson:"description"`
 	Date                  time.Time            `bson:"date"`
 	SharedWith            []SharedExpenseModel `bson:"shared_with"`
+	IgnoreFromBudget      bool                 `bson:"ignore_from_budget"`
+	City                  string               `bson:"city,omitempty"`
+	Venue                 string               `bson:"venue,omitempty"`
+	Client                string               `bson:"client"`
+	Project               string               `bson:"project"`
+	PaymentMethod         string               `bson:"payment_method,omitempty"`
+	Tags                  []string             `bson:"tags,omitempty"`
 	CreatedAt             time.Time            `bson:"created_at"`
 	UpdatedAt             time.Time            `bson:"updated_at"`
 }
@@ -90,6 +146,9 @@ type CreditCardInvoiceModel struct {
 	TotalCharges     MoneyModel         `bson:"total_charges"`
 	TotalPayments    MoneyModel         `bson:"total_payments"`
 	ClosingBalance   MoneyModel         `bson:"closing_balance"`
+	MinimumPayment   MoneyModel         `bson:"minimum_payment"`
+	AmountPaid       MoneyModel         `bson:"amount_paid"`
+	MinimumPaidAt    *time.Time         `bson:"minimum_paid_at,omitempty"`
 	Status           string             `bson:"status"`
 	TransactionUUIDs []string           `bson:"transaction_uuids"`
 	CreatedAt        time.Time          `bson:"created_at"`
@@ -100,3 +159,298 @@ type MoneyModel struct {
 	Amount   float64 `bson:"amount"`
 	Currency string  `bson:"currency"`
 }
+
+type ImportSessionModel struct {
+	ID                         primitive.ObjectID      `bson:"_id,omitempty"`
+	UUID                       string                  `bson:"uuid"`
+	AccountUUID                string                  `bson:"account_uuid"`
+	Source                     string                  `bson:"source"`
+	PeriodStart                time.Time               `bson:"period_start"`
+	PeriodEnd                  time.Time               `bson:"period_end"`
+	MatchedTransactionUUIDs    []string                `bson:"matched_transaction_uuids"`
+	CreatedTransactionUUIDs    []string                `bson:"created_transaction_uuids"`
+	LedgerOnlyTransactionUUIDs []string                `bson:"ledger_only_transaction_uuids"`
+	QueuedInboxUUIDs           []string                `bson:"queued_inbox_uuids,omitempty"`
+	StatementBalance           *MoneyModel             `bson:"statement_balance,omitempty"`
+	LedgerBalance              MoneyModel              `bson:"ledger_balance"`
+	BalanceAssertions          []BalanceAssertionModel `bson:"balance_assertions,omitempty"`
+	CreatedAt                  time.Time               `bson:"created_at"`
+}
+
+type BalanceAssertionModel struct {
+	Date            time.Time  `bson:"date"`
+	Description     string     `bson:"description"`
+	TransactionUUID *string    `bson:"transaction_uuid,omitempty"`
+	Expected        MoneyModel `bson:"expected"`
+	Actual          MoneyModel `bson:"actual"`
+}
+
+type PendingPaymentModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	UUID           string             `bson:"uuid"`
+	AccountUUID    string             `bson:"account_uuid"`
+	CreditCardUUID string             `bson:"credit_card_uuid"`
+	InvoiceUUID    *string            `bson:"invoice_uuid,omitempty"`
+	Amount         MoneyModel         `bson:"amount"`
+	ScheduledFor   time.Time          `bson:"scheduled_for"`
+	Status         string             `bson:"status"`
+	ClearedAt      *time.Time         `bson:"cleared_at,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+type SinkingFundModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UUID         string             `bson:"uuid"`
+	Name         string             `bson:"name"`
+	AnnualAmount MoneyModel         `bson:"annual_amount"`
+	DueMonth     int                `bson:"due_month"`
+	Category     string             `bson:"category,omitempty"`
+	Keyword      string             `bson:"keyword,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+type WishlistItemModel struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	UUID            string             `bson:"uuid"`
+	Name            string             `bson:"name"`
+	EstimatedCost   MoneyModel         `bson:"estimated_cost"`
+	Priority        string             `bson:"priority"`
+	TargetDate      time.Time          `bson:"target_date"`
+	Category        string             `bson:"category"`
+	TransactionUUID *string            `bson:"transaction_uuid,omitempty"`
+	PurchasedAt     *time.Time         `bson:"purchased_at,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
+}
+
+type SubscriptionPriceModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	UUID           string             `bson:"uuid"`
+	Key            string             `bson:"key"`
+	Description    string             `bson:"description"`
+	Amount         MoneyModel         `bson:"amount"`
+	AcknowledgedAt time.Time          `bson:"acknowledged_at"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+type ChangeRecordModel struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UUID       string             `bson:"uuid"`
+	EntityType string             `bson:"entity_type"`
+	EntityUUID string             `bson:"entity_uuid"`
+	Summary    string             `bson:"summary"`
+	Changes    []FieldChangeModel `bson:"changes"`
+	RevertedAt *time.Time         `bson:"reverted_at,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+type FieldChangeModel struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before"`
+	After  string `bson:"after"`
+}
+
+type InboxTransactionModel struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UUID        string             `bson:"uuid"`
+	Source      string             `bson:"source"`
+	Transaction TransactionModel   `bson:"transaction"`
+	Status      string             `bson:"status"`
+	ResolvedAt  *time.Time         `bson:"resolved_at,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+type FilterPresetModel struct {
+	ID        primitive.ObjectID     `bson:"_id,omitempty"`
+	UUID      string                 `bson:"uuid"`
+	Name      string                 `bson:"name"`
+	Filter    TransactionFilterModel `bson:"filter"`
+	CreatedAt time.Time              `bson:"created_at"`
+	UpdatedAt time.Time              `bson:"updated_at"`
+}
+
+type TransactionFilterModel struct {
+	DateRange      string   `bson:"date_range"`
+	StartDate      string   `bson:"start_date,omitempty"`
+	EndDate        string   `bson:"end_date,omitempty"`
+	Categories     []string `bson:"categories,omitempty"`
+	Source         string   `bson:"source"`
+	AccountUUID    *string  `bson:"account_uuid,omitempty"`
+	CreditCardUUID *string  `bson:"credit_card_uuid,omitempty"`
+	Type           string   `bson:"type"`
+	PaymentMethod  string   `bson:"payment_method,omitempty"`
+	Tag            string   `bson:"tag,omitempty"`
+	Payee          string   `bson:"payee,omitempty"`
+	InvoiceUUID    *string  `bson:"invoice_uuid,omitempty"`
+}
+
+type CategoryAppearanceModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Category  string             `bson:"category"`
+	Icon      string             `bson:"icon"`
+	Color     string             `bson:"color"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type FormDraftModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Form      string             `bson:"form"`
+	Fields    map[string]string  `bson:"fields"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type MacroModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UUID      string             `bson:"uuid"`
+	Name      string             `bson:"name"`
+	Slot      int                `bson:"slot"`
+	Keys      []MacroKeyModel    `bson:"keys"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type MacroKeyModel struct {
+	Type  int    `bson:"type"`
+	Runes string `bson:"runes,omitempty"`
+	Alt   bool   `bson:"alt,omitempty"`
+}
+
+type CategoryRuleModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UUID      string             `bson:"uuid"`
+	Keyword   string             `bson:"keyword"`
+	Category  string             `bson:"category"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type CategoryModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UUID      string             `bson:"uuid"`
+	Key       string             `bson:"key"`
+	Name      string             `bson:"name"`
+	Icon      string             `bson:"icon,omitempty"`
+	Kind      string             `bson:"kind"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type NotificationModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UUID      string             `bson:"uuid"`
+	Key       string             `bson:"key"`
+	Title     string             `bson:"title"`
+	Body      string             `bson:"body"`
+	Severity  string             `bson:"severity,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ReadAt    *time.Time         `bson:"read_at,omitempty"`
+}
+
+type BudgetModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UUID         string             `bson:"uuid"`
+	Category     string             `bson:"category"`
+	MonthlyLimit MoneyModel         `bson:"monthly_limit"`
+	Rollover     string             `bson:"rollover,omitempty"`
+	Carried      map[string]float64 `bson:"carried,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+type GoalModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UUID         string             `bson:"uuid"`
+	Name         string             `bson:"name"`
+	TargetAmount MoneyModel         `bson:"target_amount"`
+	Deadline     time.Time          `bson:"deadline"`
+	AccountUUID  string             `bson:"account_uuid"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+type StandingOrderModel struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	UUID            string             `bson:"uuid"`
+	FromAccountUUID string             `bson:"from_account_uuid"`
+	ToAccountUUID   string             `bson:"to_account_uuid"`
+	Amount          MoneyModel         `bson:"amount"`
+	DayOfMonth      int                `bson:"day_of_month"`
+	Description     string             `bson:"description"`
+	LastRunMonth    string             `bson:"last_run_month"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
+}
+
+type ScheduledTransferModel struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	UUID            string             `bson:"uuid"`
+	FromAccountUUID string             `bson:"from_account_uuid"`
+	ToAccountUUID   string             `bson:"to_account_uuid"`
+	Amount          MoneyModel         `bson:"amount"`
+	ScheduledFor    time.Time          `bson:"scheduled_for"`
+	Description     string             `bson:"description"`
+	Status          string             `bson:"status"`
+	TransferredAt   *time.Time         `bson:"transferred_at,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
+}
+
+type HoldingModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	UUID           string             `bson:"uuid"`
+	AccountUUID    string             `bson:"account_uuid"`
+	Ticker         string             `bson:"ticker"`
+	Quantity       float64            `bson:"quantity"`
+	AveragePrice   MoneyModel         `bson:"average_price"`
+	LastPrice      MoneyModel         `bson:"last_price"`
+	RealizedProfit MoneyModel         `bson:"realized_profit"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+type AccountingPeriodModel struct {
+	ID        primitive.ObjectID    `bson:"_id,omitempty"`
+	UUID      string                `bson:"uuid"`
+	Month     time.Time             `bson:"month"`
+	Closed    bool                  `bson:"closed"`
+	Log       []PeriodLogEntryModel `bson:"log"`
+	CreatedAt time.Time             `bson:"created_at"`
+	UpdatedAt time.Time             `bson:"updated_at"`
+}
+
+type PeriodLogEntryModel struct {
+	Action string    `bson:"action"`
+	Reason string    `bson:"reason,omitempty"`
+	At     time.Time `bson:"at"`
+}
+
+type ReportSnapshotModel struct {
+	ID               primitive.ObjectID    `bson:"_id,omitempty"`
+	UUID             string                `bson:"uuid"`
+	Month            time.Time             `bson:"month"`
+	Income           MoneyModel            `bson:"income"`
+	Expenses         MoneyModel            `bson:"expenses"`
+	TransactionCount int                   `bson:"transaction_count"`
+	Categories       map[string]MoneyModel `bson:"categories"`
+	TakenAt          time.Time             `bson:"taken_at"`
+	CreatedAt        time.Time             `bson:"created_at"`
+	UpdatedAt        time.Time             `bson:"updated_at"`
+}
+
+type EmergencyFundPlanModel struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	EssentialMonthly MoneyModel         `bson:"essential_monthly"`
+	TargetMonths     int                `bson:"target_months"`
+	AccountUUIDs     []string           `bson:"account_uuids"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+}
+
+type CategoryClassifierModel struct {
+	ID             primitive.ObjectID        `bson:"_id,omitempty"`
+	WordCounts     map[string]map[string]int `bson:"word_counts"`
+	CategoryCounts map[string]int            `bson:"category_counts"`
+	UpdatedAt      time.Time                 `bson:"updated_at"`
+}
@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type notificationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewNotificationRepository(db *mongo.Database) repository.NotificationRepository {
+	return &notificationRepository{
+		collection: db.Collection("notifications"),
+	}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *entity.Notification) error {
+	model := NotificationToModel(notification)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) Update(ctx context.Context, notification *entity.Notification) error {
+	model := NotificationToModel(notification)
+	filter := bson.M{"uuid": notification.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("notification not found")
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("notification not found")
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) FindAll(ctx context.Context) ([]*entity.Notification, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []*entity.Notification
+	for cursor.Next(ctx) {
+		var model NotificationModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode notification: %w", err)
+		}
+
+		notification, err := NotificationFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepository) ExistsByKey(ctx context.Context, key string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"key": key})
+	if err != nil {
+		return false, fmt.Errorf("failed to find notification: %w", err)
+	}
+	return count > 0, nil
+}
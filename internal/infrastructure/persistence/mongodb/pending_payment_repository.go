@@ -0,0 +1,108 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type pendingPaymentRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPendingPaymentRepository(db *mongo.Database) repository.PendingPaymentRepository {
+	return &pendingPaymentRepository{
+		collection: db.Collection("pending_payments"),
+	}
+}
+
+func (r *pendingPaymentRepository) Create(ctx context.Context, payment *entity.PendingPayment) error {
+	model := PendingPaymentToModel(payment)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create pending payment: %w", err)
+	}
+	return nil
+}
+
+func (r *pendingPaymentRepository) Update(ctx context.Context, payment *entity.PendingPayment) error {
+	model := PendingPaymentToModel(payment)
+	filter := bson.M{"uuid": payment.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update pending payment: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("pending payment not found")
+	}
+
+	return nil
+}
+
+func (r *pendingPaymentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PendingPayment, error) {
+	var model PendingPaymentModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("pending payment not found")
+		}
+		return nil, fmt.Errorf("failed to find pending payment: %w", err)
+	}
+
+	return PendingPaymentFromModel(model)
+}
+
+func (r *pendingPaymentRepository) FindPending(ctx context.Context) ([]*entity.PendingPayment, error) {
+	return r.findByFilter(ctx, bson.M{"status": string(entity.PendingPaymentStatusPending)})
+}
+
+func (r *pendingPaymentRepository) FindPendingByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findByFilter(ctx, bson.M{
+		"account_uuid": accountID.String(),
+		"status":       string(entity.PendingPaymentStatusPending),
+	})
+}
+
+func (r *pendingPaymentRepository) FindPendingByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findByFilter(ctx, bson.M{
+		"credit_card_uuid": creditCardID.String(),
+		"status":           string(entity.PendingPaymentStatusPending),
+	})
+}
+
+func (r *pendingPaymentRepository) findByFilter(ctx context.Context, filter bson.M) ([]*entity.PendingPayment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "scheduled_for", Value: 1}}) // Earliest payment first
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending payments: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var payments []*entity.PendingPayment
+	for cursor.Next(ctx) {
+		var model PendingPaymentModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode pending payment: %w", err)
+		}
+
+		payment, err := PendingPaymentFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type reportSnapshotRepository struct {
+	collection *mongo.Collection
+}
+
+func NewReportSnapshotRepository(db *mongo.Database) repository.ReportSnapshotRepository {
+	return &reportSnapshotRepository{
+		collection: db.Collection("report_snapshots"),
+	}
+}
+
+func (r *reportSnapshotRepository) Create(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := ReportSnapshotToModel(snapshot)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create report snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *reportSnapshotRepository) Update(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := ReportSnapshotToModel(snapshot)
+	filter := bson.M{"uuid": snapshot.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update report snapshot: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("report snapshot not found")
+	}
+
+	return nil
+}
+
+func (r *reportSnapshotRepository) FindAll(ctx context.Context) ([]*entity.ReportSnapshot, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "month", Value: -1}}) // Latest month first
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report snapshots: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var snapshots []*entity.ReportSnapshot
+	for cursor.Next(ctx) {
+		var model ReportSnapshotModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode report snapshot: %w", err)
+		}
+
+		snapshot, err := ReportSnapshotFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"financli/internal/domain/entity"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // Simple implementations for remaining repositories
@@ -310,6 +312,15 @@ func (r *billRepository) FindOverdue(ctx context.Context) ([]*entity.Bill, error
 }
 
 // TransactionRepository implementation
+
+// transactionSort orders transactions newest first. The uuid tiebreaker makes the
+// order total, so lists don't reshuffle between refreshes.
+var transactionSort = bson.D{
+	{Key: "date", Value: -1},
+	{Key: "created_at", Value: -1},
+	{Key: "uuid", Value: 1},
+}
+
 type transactionRepository struct {
 	collection *mongo.Collection
 }
@@ -326,6 +337,21 @@ func (r *transactionRepository) Create(ctx context.Context, transaction *entity.
 	return err
 }
 
+// CreateMany inserts the transactions in batched bulk writes rather than one round trip each
+func (r *transactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(transactions))
+	for _, transaction := range transactions {
+		models = append(models, mongo.NewInsertOneModel().SetDocument(TransactionToModel(transaction)))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, models)
+	return err
+}
+
 func (r *transactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
 	model := TransactionToModel(transaction)
 	filter := bson.M{"uuid": transaction.ID.String()}
@@ -351,25 +377,7 @@ func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*en
 }
 
 func (r *transactionRepository) FindAll(ctx context.Context) ([]*entity.Transaction, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{})
-	if err != nil {
-		return nil, err
-	}
-	defer cursor.Close(ctx)
-
-	var transactions []*entity.Transaction
-	for cursor.Next(ctx) {
-		var model TransactionModel
-		if err := cursor.Decode(&model); err != nil {
-			return nil, err
-		}
-		transaction, err := TransactionFromModel(model)
-		if err != nil {
-			return nil, err
-		}
-		transactions = append(transactions, transaction)
-	}
-	return transactions, nil
+	return r.findByFilter(ctx, bson.M{})
 }
 
 func (r *transactionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Transaction, error) {
@@ -402,11 +410,49 @@ func (r *transactionRepository) FindByDateRange(ctx context.Context, startDate,
 	return r.findByFilter(ctx, filter)
 }
 
+// FindPageByDateRange has the database skip to the page, so only its
+// transactions are decoded
+func (r *transactionRepository) FindPageByDateRange(ctx context.Context, startDate, endDate time.Time, offset, limit int) ([]*entity.Transaction, error) {
+	filter := bson.M{
+		"date": bson.M{
+			"$gte": startDate,
+			"$lte": endDate,
+		},
+	}
+	opts := options.Find().
+		SetSort(transactionSort).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	return r.findByFilter(ctx, filter, opts)
+}
+
 func (r *transactionRepository) FindByCategory(ctx context.Context, category entity.TransactionCategory) ([]*entity.Transaction, error) {
 	filter := bson.M{"category": string(category)}
 	return r.findByFilter(ctx, filter)
 }
 
+func (r *transactionRepository) FindByTag(ctx context.Context, tag string) ([]*entity.Transaction, error) {
+	filter := bson.M{"tags": tag}
+	return r.findByFilter(ctx, filter)
+}
+
+// FindTags asks the database for the distinct tags, so no transaction is decoded
+func (r *transactionRepository) FindTags(ctx context.Context) ([]string, error) {
+	values, err := r.collection.Distinct(ctx, "tags", bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0, len(values))
+	for _, value := range values {
+		if tag, ok := value.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
 func (r *transactionRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
 	filter := bson.M{"shared_with.person_uuid": personID.String()}
 	return r.findByFilter(ctx, filter)
@@ -423,14 +469,189 @@ func (r *transactionRepository) FindUnassignedToBill(ctx context.Context, startD
 	return r.findByFilter(ctx, filter)
 }
 
-func (r *transactionRepository) findByFilter(ctx context.Context, filter bson.M) ([]*entity.Transaction, error) {
-	cursor, err := r.collection.Find(ctx, filter)
+// FindLatest returns the most recent transactions, newest first
+func (r *transactionRepository) FindLatest(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	opts := options.Find().
+		SetSort(transactionSort).
+		SetLimit(int64(limit))
+	return r.findByFilter(ctx, bson.M{}, opts)
+}
+
+// FindCategoryUsage loads only the category and date of the account's or card's
+// transactions since the given date
+func (r *transactionRepository) FindCategoryUsage(ctx context.Context, accountID, creditCardID *uuid.UUID, since time.Time) ([]repository.CategoryUsage, error) {
+	filter := bson.M{"date": bson.M{"$gte": since}}
+	switch {
+	case accountID != nil:
+		filter["account_uuid"] = accountID.String()
+	case creditCardID != nil:
+		filter["credit_card_uuid"] = creditCardID.String()
+	default:
+		return nil, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "category": 1, "date": 1})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Category string    `bson:"category"`
+		Date     time.Time `bson:"date"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	usage := make([]repository.CategoryUsage, 0, len(rows))
+	for _, row := range rows {
+		usage = append(usage, repository.CategoryUsage{
+			Category: entity.TransactionCategory(row.Category),
+			Date:     row.Date,
+		})
+	}
+	return usage, nil
+}
+
+// FindDescriptionUsage loads only the description and date of the transactions
+// since the given date
+func (r *transactionRepository) FindDescriptionUsage(ctx context.Context, since time.Time) ([]repository.DescriptionUsage, error) {
+	filter := bson.M{"date": bson.M{"$gte": since}}
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "description": 1, "date": 1})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Description string    `bson:"description"`
+		Date        time.Time `bson:"date"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	usage := make([]repository.DescriptionUsage, 0, len(rows))
+	for _, row := range rows {
+		usage = append(usage, repository.DescriptionUsage{
+			Description: row.Description,
+			Date:        row.Date,
+		})
+	}
+	return usage, nil
+}
+
+// SumByCategory groups and sums the transactions in the database, so only one
+// row per category comes back
+func (r *transactionRepository) SumByCategory(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryTotal, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"type": string(transactionType),
+			"date": bson.M{"$gte": startDate, "$lte": endDate},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$category",
+			"total": bson.M{"$sum": "$amount.amount"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Category string  `bson:"_id"`
+		Total    float64 `bson:"total"`
+		Count    int     `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	totals := make([]repository.CategoryTotal, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, repository.CategoryTotal{
+			Category: entity.TransactionCategory(row.Category),
+			Total:    row.Total,
+			Count:    row.Count,
+		})
+	}
+	return totals, nil
+}
+
+// SumByCategoryAndMonth groups and sums the transactions in the database by
+// category and calendar month. Months are cut at the local UTC offset of
+// startDate, so across a daylight saving change a transaction in the first
+// hour of a month can be counted in the month before.
+func (r *transactionRepository) SumByCategoryAndMonth(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryMonthTotal, error) {
+	from, to := entity.PeriodMonth(startDate), entity.PeriodMonth(endDate)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"type": string(transactionType),
+			"date": bson.M{"$gte": from, "$lt": to},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"category": "$category",
+				"month":    bson.M{"$dateToString": bson.M{"format": "%Y-%m", "date": "$date", "timezone": from.Format("-07:00")}},
+			},
+			"total": bson.M{"$sum": "$amount.amount"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.month", Value: 1}, {Key: "_id.category", Value: 1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		ID struct {
+			Category string `bson:"category"`
+			Month    string `bson:"month"`
+		} `bson:"_id"`
+		Total float64 `bson:"total"`
+		Count int     `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	totals := make([]repository.CategoryMonthTotal, 0, len(rows))
+	for _, row := range rows {
+		month, err := time.ParseInLocation("2006-01", row.ID.Month, time.Local)
+		if err != nil {
+			return nil, err
+		}
+		totals = append(totals, repository.CategoryMonthTotal{
+			Category: entity.TransactionCategory(row.ID.Category),
+			Month:    month,
+			Total:    row.Total,
+			Count:    row.Count,
+		})
+	}
+	return totals, nil
+}
+
+func (r *transactionRepository) findByFilter(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*entity.Transaction, error) {
+	// Callers may override the order, but never get Mongo's natural order
+	opts = append([]*options.FindOptions{options.Find().SetSort(transactionSort)}, opts...)
+	cursor, err := r.collection.Find(ctx, filter, opts...)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	var transactions []*entity.Transaction
+	// Sized for the first batch, which is already in, rather than counting
+	// the matches in another round trip
+	transactions := make([]*entity.Transaction, 0, cursor.RemainingBatchLength())
 	for cursor.Next(ctx) {
 		var model TransactionModel
 		if err := cursor.Decode(&model); err != nil {
@@ -442,5 +663,5 @@ func (r *transactionRepository) findByFilter(ctx context.Context, filter bson.M)
 		}
 		transactions = append(transactions, transaction)
 	}
-	return transactions, nil
+	return transactions, cursor.Err()
 }
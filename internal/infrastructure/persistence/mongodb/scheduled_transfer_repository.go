@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type scheduledTransferRepository struct {
+	collection *mongo.Collection
+}
+
+func NewScheduledTransferRepository(db *mongo.Database) repository.ScheduledTransferRepository {
+	return &scheduledTransferRepository{
+		collection: db.Collection("scheduled_transfers"),
+	}
+}
+
+func (r *scheduledTransferRepository) Create(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := ScheduledTransferToModel(transfer)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepository) Update(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := ScheduledTransferToModel(transfer)
+	filter := bson.M{"uuid": transfer.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("scheduled transfer not found")
+	}
+
+	return nil
+}
+
+func (r *scheduledTransferRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ScheduledTransfer, error) {
+	var model ScheduledTransferModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("scheduled transfer not found")
+		}
+		return nil, fmt.Errorf("failed to find scheduled transfer: %w", err)
+	}
+
+	return ScheduledTransferFromModel(model)
+}
+
+func (r *scheduledTransferRepository) FindPending(ctx context.Context) ([]*entity.ScheduledTransfer, error) {
+	filter := bson.M{"status": string(entity.ScheduledTransferStatusPending)}
+	opts := options.Find().SetSort(bson.D{{Key: "scheduled_for", Value: 1}}) // Earliest transfer first
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled transfers: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var transfers []*entity.ScheduledTransfer
+	for cursor.Next(ctx) {
+		var model ScheduledTransferModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode scheduled transfer: %w", err)
+		}
+
+		transfer, err := ScheduledTransferFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
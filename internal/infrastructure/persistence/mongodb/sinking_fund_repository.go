@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type sinkingFundRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSinkingFundRepository(db *mongo.Database) repository.SinkingFundRepository {
+	return &sinkingFundRepository{
+		collection: db.Collection("sinking_funds"),
+	}
+}
+
+func (r *sinkingFundRepository) Create(ctx context.Context, fund *entity.SinkingFund) error {
+	model := SinkingFundToModel(fund)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create sinking fund: %w", err)
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) Update(ctx context.Context, fund *entity.SinkingFund) error {
+	model := SinkingFundToModel(fund)
+	filter := bson.M{"uuid": fund.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update sinking fund: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("sinking fund not found")
+	}
+
+	return nil
+}
+
+func (r *sinkingFundRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete sinking fund: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("sinking fund not found")
+	}
+
+	return nil
+}
+
+func (r *sinkingFundRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SinkingFund, error) {
+	var model SinkingFundModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("sinking fund not found")
+		}
+		return nil, fmt.Errorf("failed to find sinking fund: %w", err)
+	}
+
+	return SinkingFundFromModel(model)
+}
+
+func (r *sinkingFundRepository) FindAll(ctx context.Context) ([]*entity.SinkingFund, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "due_month", Value: 1}, {Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sinking funds: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var funds []*entity.SinkingFund
+	for cursor.Next(ctx) {
+		var model SinkingFundModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode sinking fund: %w", err)
+		}
+
+		fund, err := SinkingFundFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		funds = append(funds, fund)
+	}
+
+	return funds, nil
+}
@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type standingOrderRepository struct {
+	collection *mongo.Collection
+}
+
+func NewStandingOrderRepository(db *mongo.Database) repository.StandingOrderRepository {
+	return &standingOrderRepository{
+		collection: db.Collection("standing_orders"),
+	}
+}
+
+func (r *standingOrderRepository) Create(ctx context.Context, order *entity.StandingOrder) error {
+	model := StandingOrderToModel(order)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create standing order: %w", err)
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) Update(ctx context.Context, order *entity.StandingOrder) error {
+	model := StandingOrderToModel(order)
+	filter := bson.M{"uuid": order.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update standing order: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("standing order not found")
+	}
+
+	return nil
+}
+
+func (r *standingOrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete standing order: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("standing order not found")
+	}
+
+	return nil
+}
+
+func (r *standingOrderRepository) FindAll(ctx context.Context) ([]*entity.StandingOrder, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "day_of_month", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find standing orders: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var orders []*entity.StandingOrder
+	for cursor.Next(ctx) {
+		var model StandingOrderModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode standing order: %w", err)
+		}
+
+		order, err := StandingOrderFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type subscriptionPriceRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSubscriptionPriceRepository(db *mongo.Database) repository.SubscriptionPriceRepository {
+	return &subscriptionPriceRepository{
+		collection: db.Collection("subscription_prices"),
+	}
+}
+
+func (r *subscriptionPriceRepository) Create(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := SubscriptionPriceToModel(price)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription price: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionPriceRepository) Update(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := SubscriptionPriceToModel(price)
+	filter := bson.M{"uuid": price.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription price: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("subscription price not found")
+	}
+
+	return nil
+}
+
+func (r *subscriptionPriceRepository) FindByKey(ctx context.Context, key string) (*entity.SubscriptionPrice, error) {
+	var model SubscriptionPriceModel
+	filter := bson.M{"key": key}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("subscription price not found")
+		}
+		return nil, fmt.Errorf("failed to find subscription price: %w", err)
+	}
+
+	return SubscriptionPriceFromModel(model)
+}
+
+func (r *subscriptionPriceRepository) FindAll(ctx context.Context) ([]*entity.SubscriptionPrice, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription prices: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var prices []*entity.SubscriptionPrice
+	for cursor.Next(ctx) {
+		var model SubscriptionPriceModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode subscription price: %w", err)
+		}
+
+		price, err := SubscriptionPriceFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type transactionArchiveRepository struct {
+	transactions *mongo.Collection
+	// archive reads through a transactionRepository so it sorts and decodes the same way
+	archive *transactionRepository
+}
+
+func NewTransactionArchiveRepository(db *mongo.Database) repository.TransactionArchiveRepository {
+	return &transactionArchiveRepository{
+		transactions: db.Collection("transactions"),
+		archive:      &transactionRepository{collection: db.Collection("transactions_archive")},
+	}
+}
+
+// ArchiveBefore copies the transactions into the archive before deleting them.
+// The copies are upserts, so running it again after a failure halfway through
+// doesn't duplicate anything.
+func (r *transactionArchiveRepository) ArchiveBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	filter := bson.M{"date": bson.M{"$lt": cutoff}}
+	cursor, err := r.transactions.Find(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find transactions to archive: %w", err)
+	}
+
+	var models []TransactionModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return 0, fmt.Errorf("failed to find transactions to archive: %w", err)
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(models))
+	ids := make([]string, 0, len(models))
+	for _, model := range models {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"uuid": model.UUID}).
+			SetReplacement(model).
+			SetUpsert(true))
+		ids = append(ids, model.UUID)
+	}
+	if _, err := r.archive.collection.BulkWrite(ctx, writes); err != nil {
+		return 0, fmt.Errorf("failed to archive transactions: %w", err)
+	}
+
+	if _, err := r.transactions.DeleteMany(ctx, bson.M{"uuid": bson.M{"$in": ids}}); err != nil {
+		return 0, fmt.Errorf("failed to remove archived transactions: %w", err)
+	}
+	return len(models), nil
+}
+
+func (r *transactionArchiveRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.archive.FindByDateRange(ctx, startDate, endDate)
+}
+
+func (r *transactionArchiveRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.archive.FindSharedWithPerson(ctx, personID)
+}
@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"financli/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// unitOfWork runs its work in a MongoDB transaction. The repositories join it
+// through the session the context carries.
+type unitOfWork struct {
+	db *mongo.Database
+
+	// Only replica sets and sharded clusters have transactions; on a
+	// standalone server the work runs as it did without them
+	mu        sync.Mutex
+	checked   bool
+	supported bool
+}
+
+func NewUnitOfWork(db *mongo.Database) repository.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	supported, err := u.supportsTransactions(ctx)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fn(ctx)
+	}
+
+	session, err := u.db.Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start MongoDB session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
+
+// supportsTransactions asks the server, the first time, whether it's a
+// replica set member or a mongos router
+func (u *unitOfWork) supportsTransactions(ctx context.Context) (bool, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.checked {
+		return u.supported, nil
+	}
+
+	supported, err := serverHasTransactions(ctx, u.db)
+	if err != nil {
+		return false, err
+	}
+	u.checked, u.supported = true, supported
+	return u.supported, nil
+}
+
+// CheckTransactions fails when the server is standalone, where the unit of
+// work can't make a change's writes atomic and runs them one by one
+func CheckTransactions(ctx context.Context, db *mongo.Database) error {
+	supported, err := serverHasTransactions(ctx, db)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf("MongoDB is a standalone server, so changes are not written atomically; run it as a replica set")
+	}
+	return nil
+}
+
+func serverHasTransactions(ctx context.Context, db *mongo.Database) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, fmt.Errorf("failed to check MongoDB transaction support: %w", err)
+	}
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
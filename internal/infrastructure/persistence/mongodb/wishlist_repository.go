@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type wishlistRepository struct {
+	collection *mongo.Collection
+}
+
+func NewWishlistRepository(db *mongo.Database) repository.WishlistRepository {
+	return &wishlistRepository{
+		collection: db.Collection("wishlist"),
+	}
+}
+
+func (r *wishlistRepository) Create(ctx context.Context, item *entity.WishlistItem) error {
+	model := WishlistItemToModel(item)
+	_, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create wishlist item: %w", err)
+	}
+	return nil
+}
+
+func (r *wishlistRepository) Update(ctx context.Context, item *entity.WishlistItem) error {
+	model := WishlistItemToModel(item)
+	filter := bson.M{"uuid": item.ID.String()}
+	update := bson.M{"$set": model}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update wishlist item: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("wishlist item not found")
+	}
+
+	return nil
+}
+
+func (r *wishlistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	filter := bson.M{"uuid": id.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist item: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("wishlist item not found")
+	}
+
+	return nil
+}
+
+func (r *wishlistRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WishlistItem, error) {
+	var model WishlistItemModel
+	filter := bson.M{"uuid": id.String()}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("wishlist item not found")
+		}
+		return nil, fmt.Errorf("failed to find wishlist item: %w", err)
+	}
+
+	return WishlistItemFromModel(model)
+}
+
+func (r *wishlistRepository) FindAll(ctx context.Context) ([]*entity.WishlistItem, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "target_date", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist items: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []*entity.WishlistItem
+	for cursor.Next(ctx) {
+		var model WishlistItemModel
+		if err := cursor.Decode(&model); err != nil {
+			return nil, fmt.Errorf("failed to decode wishlist item: %w", err)
+		}
+
+		item, err := WishlistItemFromModel(model)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type accountRepository struct {
+	table *documentTable
+}
+
+func NewAccountRepository(db *sql.DB) repository.AccountRepository {
+	return &accountRepository{
+		table: newDocumentTable(db, "accounts", "type"),
+	}
+}
+
+func (r *accountRepository) Create(ctx context.Context, account *entity.Account) error {
+	model := mongodb.AccountToModel(account)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Type); err != nil {
+		return fmt.Errorf("failed to create account: %w", err)
+	}
+	return nil
+}
+
+func (r *accountRepository) Update(ctx context.Context, account *entity.Account) error {
+	model := mongodb.AccountToModel(account)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Type)
+	if err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("account not found")
+	}
+	return nil
+}
+
+func (r *accountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("account not found")
+	}
+	return nil
+}
+
+func (r *accountRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
+	var model mongodb.AccountModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("account not found")
+	}
+	return mongodb.AccountFromModel(model)
+}
+
+func (r *accountRepository) FindAll(ctx context.Context) ([]*entity.Account, error) {
+	return r.findAccounts(ctx, "ORDER BY rowid")
+}
+
+func (r *accountRepository) FindByType(ctx context.Context, accountType entity.AccountType) ([]*entity.Account, error) {
+	return r.findAccounts(ctx, "WHERE type = ? ORDER BY rowid", string(accountType))
+}
+
+func (r *accountRepository) findAccounts(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Account, error) {
+	var accounts []*entity.Account
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.AccountModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		account, err := mongodb.AccountFromModel(model)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounts: %w", err)
+	}
+	return accounts, nil
+}
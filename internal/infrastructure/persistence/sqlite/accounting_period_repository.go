@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type accountingPeriodRepository struct {
+	table *documentTable
+}
+
+func NewAccountingPeriodRepository(db *sql.DB) repository.AccountingPeriodRepository {
+	return &accountingPeriodRepository{
+		table: newDocumentTable(db, "accounting_periods", "month"),
+	}
+}
+
+func (r *accountingPeriodRepository) Create(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := mongodb.AccountingPeriodToModel(period)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.Month)); err != nil {
+		return fmt.Errorf("failed to create accounting period: %w", err)
+	}
+	return nil
+}
+
+func (r *accountingPeriodRepository) Update(ctx context.Context, period *entity.AccountingPeriod) error {
+	model := mongodb.AccountingPeriodToModel(period)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, millis(model.Month))
+	if err != nil {
+		return fmt.Errorf("failed to update accounting period: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("accounting period not found")
+	}
+	return nil
+}
+
+func (r *accountingPeriodRepository) FindAll(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	var periods []*entity.AccountingPeriod
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.AccountingPeriodModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		period, err := mongodb.AccountingPeriodFromModel(model)
+		if err != nil {
+			return err
+		}
+		periods = append(periods, period)
+		return nil
+	}, "ORDER BY month DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounting periods: %w", err)
+	}
+	return periods, nil
+}
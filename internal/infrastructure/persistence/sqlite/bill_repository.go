@@ -0,0 +1,100 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type billRepository struct {
+	table *documentTable
+}
+
+func NewBillRepository(db *sql.DB) repository.BillRepository {
+	return &billRepository{
+		table: newDocumentTable(db, "bills", "status", "start_date", "end_date", "due_date"),
+	}
+}
+
+func (r *billRepository) Create(ctx context.Context, bill *entity.Bill) error {
+	model := mongodb.BillToModel(bill)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, billColumns(model)...); err != nil {
+		return fmt.Errorf("failed to create bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) Update(ctx context.Context, bill *entity.Bill) error {
+	model := mongodb.BillToModel(bill)
+	if _, err := r.table.update(ctx, r.table.db, model.UUID, model, billColumns(model)...); err != nil {
+		return fmt.Errorf("failed to update bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.table.delete(ctx, r.table.db, id.String()); err != nil {
+		return fmt.Errorf("failed to delete bill: %w", err)
+	}
+	return nil
+}
+
+func (r *billRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Bill, error) {
+	var model mongodb.BillModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bill: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("bill not found")
+	}
+	return mongodb.BillFromModel(model)
+}
+
+func (r *billRepository) FindAll(ctx context.Context) ([]*entity.Bill, error) {
+	return r.findBills(ctx, "ORDER BY rowid")
+}
+
+func (r *billRepository) FindByStatus(ctx context.Context, status entity.BillStatus) ([]*entity.Bill, error) {
+	return r.findBills(ctx, "WHERE status = ? ORDER BY rowid", string(status))
+}
+
+// FindByDateRange returns the bills whose period overlaps the range
+func (r *billRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Bill, error) {
+	return r.findBills(ctx, "WHERE start_date <= ? AND end_date >= ? ORDER BY rowid", millis(endDate), millis(startDate))
+}
+
+func (r *billRepository) FindOverdue(ctx context.Context) ([]*entity.Bill, error) {
+	return r.findBills(ctx, "WHERE status != ? AND due_date < ? ORDER BY rowid", string(entity.BillStatusPaid), millis(time.Now()))
+}
+
+func (r *billRepository) findBills(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Bill, error) {
+	var bills []*entity.Bill
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.BillModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		bill, err := mongodb.BillFromModel(model)
+		if err != nil {
+			return err
+		}
+		bills = append(bills, bill)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bills: %w", err)
+	}
+	return bills, nil
+}
+
+func billColumns(model mongodb.BillModel) []interface{} {
+	return []interface{}{model.Status, millis(model.StartDate), millis(model.EndDate), millis(model.DueDate)}
+}
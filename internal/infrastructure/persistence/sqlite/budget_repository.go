@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type budgetRepository struct {
+	table *documentTable
+}
+
+func NewBudgetRepository(db *sql.DB) repository.BudgetRepository {
+	return &budgetRepository{
+		table: newDocumentTable(db, "budgets", "category"),
+	}
+}
+
+func (r *budgetRepository) Create(ctx context.Context, budget *entity.Budget) error {
+	model := mongodb.BudgetToModel(budget)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Category); err != nil {
+		return fmt.Errorf("failed to create budget: %w", err)
+	}
+	return nil
+}
+
+func (r *budgetRepository) Update(ctx context.Context, budget *entity.Budget) error {
+	model := mongodb.BudgetToModel(budget)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Category)
+	if err != nil {
+		return fmt.Errorf("failed to update budget: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("budget not found")
+	}
+	return nil
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("budget not found")
+	}
+	return nil
+}
+
+func (r *budgetRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error) {
+	var model mongodb.BudgetModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budget: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("budget not found")
+	}
+	return mongodb.BudgetFromModel(model)
+}
+
+func (r *budgetRepository) FindAll(ctx context.Context) ([]*entity.Budget, error) {
+	return r.findBudgets(ctx, "ORDER BY category")
+}
+
+func (r *budgetRepository) findBudgets(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Budget, error) {
+	var budgets []*entity.Budget
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.BudgetModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		budget, err := mongodb.BudgetFromModel(model)
+		if err != nil {
+			return err
+		}
+		budgets = append(budgets, budget)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budgets: %w", err)
+	}
+	return budgets, nil
+}
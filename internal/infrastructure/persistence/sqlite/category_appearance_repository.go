@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// categoryAppearanceRepository keys its rows by category rather than uuid
+type categoryAppearanceRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryAppearanceRepository(db *sql.DB) repository.CategoryAppearanceRepository {
+	return &categoryAppearanceRepository{db: db}
+}
+
+func (r *categoryAppearanceRepository) Save(ctx context.Context, appearance *entity.CategoryAppearance) error {
+	model := mongodb.CategoryAppearanceToModel(appearance)
+	document, err := bson.Marshal(model)
+	if err != nil {
+		return fmt.Errorf("failed to save category appearance: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO category_appearances (category, document) VALUES (?, ?)
+		ON CONFLICT (category) DO UPDATE SET document = excluded.document`,
+		model.Category, document)
+	if err != nil {
+		return fmt.Errorf("failed to save category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) Delete(ctx context.Context, category entity.TransactionCategory) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM category_appearances WHERE category = ?", string(category)); err != nil {
+		return fmt.Errorf("failed to delete category appearance: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAppearanceRepository) FindAll(ctx context.Context) ([]*entity.CategoryAppearance, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT document FROM category_appearances ORDER BY category")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category appearances: %w", err)
+	}
+	defer rows.Close()
+
+	var appearances []*entity.CategoryAppearance
+	for rows.Next() {
+		var document []byte
+		if err := rows.Scan(&document); err != nil {
+			return nil, fmt.Errorf("failed to find category appearances: %w", err)
+		}
+		var model mongodb.CategoryAppearanceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return nil, fmt.Errorf("failed to decode category appearance: %w", err)
+		}
+		appearances = append(appearances, mongodb.CategoryAppearanceFromModel(model))
+	}
+	return appearances, rows.Err()
+}
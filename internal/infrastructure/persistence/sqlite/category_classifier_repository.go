@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// categoryClassifierRepository keeps the classifier as the table's only row
+type categoryClassifierRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryClassifierRepository(db *sql.DB) repository.CategoryClassifierRepository {
+	return &categoryClassifierRepository{db: db}
+}
+
+func (r *categoryClassifierRepository) Get(ctx context.Context) (*entity.CategoryClassifier, error) {
+	var document []byte
+	err := r.db.QueryRowContext(ctx, "SELECT document FROM category_classifier WHERE id = 1").Scan(&document)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find category classifier: %w", err)
+	}
+
+	var model mongodb.CategoryClassifierModel
+	if err := bson.Unmarshal(document, &model); err != nil {
+		return nil, fmt.Errorf("failed to decode category classifier: %w", err)
+	}
+	return mongodb.CategoryClassifierFromModel(model), nil
+}
+
+func (r *categoryClassifierRepository) Save(ctx context.Context, classifier *entity.CategoryClassifier) error {
+	document, err := bson.Marshal(mongodb.CategoryClassifierToModel(classifier))
+	if err != nil {
+		return fmt.Errorf("failed to save category classifier: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO category_classifier (id, document) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET document = excluded.document`,
+		document)
+	if err != nil {
+		return fmt.Errorf("failed to save category classifier: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type categoryRepository struct {
+	table *documentTable
+}
+
+func NewCategoryRepository(db *sql.DB) repository.CategoryRepository {
+	return &categoryRepository{
+		table: newDocumentTable(db, "categories", "created_at"),
+	}
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	model := mongodb.CategoryToModel(category)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.CreatedAt)); err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	model := mongodb.CategoryToModel(category)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, millis(model.CreatedAt))
+	if err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category not found")
+	}
+	return nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category not found")
+	}
+	return nil
+}
+
+func (r *categoryRepository) FindAll(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.CategoryModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		category, err := mongodb.CategoryFromModel(model)
+		if err != nil {
+			return err
+		}
+		categories = append(categories, category)
+		return nil
+	}, "ORDER BY created_at")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+	return categories, nil
+}
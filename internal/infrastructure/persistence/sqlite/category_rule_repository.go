@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type categoryRuleRepository struct {
+	table *documentTable
+}
+
+func NewCategoryRuleRepository(db *sql.DB) repository.CategoryRuleRepository {
+	return &categoryRuleRepository{
+		table: newDocumentTable(db, "category_rules", "created_at"),
+	}
+}
+
+func (r *categoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	model := mongodb.CategoryRuleToModel(rule)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.CreatedAt)); err != nil {
+		return fmt.Errorf("failed to create category rule: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete category rule: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("category rule not found")
+	}
+	return nil
+}
+
+func (r *categoryRuleRepository) FindAll(ctx context.Context) ([]*entity.CategoryRule, error) {
+	var rules []*entity.CategoryRule
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.CategoryRuleModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		rule, err := mongodb.CategoryRuleFromModel(model)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+		return nil
+	}, "ORDER BY created_at")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category rules: %w", err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type changeRecordRepository struct {
+	table *documentTable
+}
+
+func NewChangeRecordRepository(db *sql.DB) repository.ChangeRecordRepository {
+	return &changeRecordRepository{
+		table: newDocumentTable(db, "change_records", "entity_type", "entity_uuid", "created_at"),
+	}
+}
+
+func (r *changeRecordRepository) Create(ctx context.Context, record *entity.ChangeRecord) error {
+	model := mongodb.ChangeRecordToModel(record)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.EntityType, model.EntityUUID, millis(model.CreatedAt)); err != nil {
+		return fmt.Errorf("failed to create change record: %w", err)
+	}
+	return nil
+}
+
+func (r *changeRecordRepository) Update(ctx context.Context, record *entity.ChangeRecord) error {
+	model := mongodb.ChangeRecordToModel(record)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.EntityType, model.EntityUUID, millis(model.CreatedAt))
+	if err != nil {
+		return fmt.Errorf("failed to update change record: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("change record not found")
+	}
+	return nil
+}
+
+func (r *changeRecordRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ChangeRecord, error) {
+	var model mongodb.ChangeRecordModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change record: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("change record not found")
+	}
+	return mongodb.ChangeRecordFromModel(model)
+}
+
+func (r *changeRecordRepository) FindByEntity(ctx context.Context, entityType entity.ChangeEntityType, entityID uuid.UUID) ([]*entity.ChangeRecord, error) {
+	return r.findRecords(ctx, "WHERE entity_type = ? AND entity_uuid = ? ORDER BY created_at DESC", string(entityType), entityID.String())
+}
+
+func (r *changeRecordRepository) findRecords(ctx context.Context, clauses string, args ...interface{}) ([]*entity.ChangeRecord, error) {
+	var records []*entity.ChangeRecord
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.ChangeRecordModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		record, err := mongodb.ChangeRecordFromModel(model)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change records: %w", err)
+	}
+	return records, nil
+}
@@ -0,0 +1,47 @@
+// Package sqlite implements the repositories on a local SQLite file, so the app
+// runs without a MongoDB server.
+//
+// Every record is stored as the same BSON document the MongoDB backend keeps,
+// built by the mongodb package's models and mappers, next to the columns the
+// repositories filter and sort on. Both backends therefore share one mapping and
+// behave alike, down to the millisecond precision of stored times.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	_ "modernc.org/sqlite"
+)
+
+type Config struct {
+	Path string
+}
+
+// NewConnection opens the database file, creating it and its schema on first run
+func NewConnection(cfg Config) (*sql.DB, error) {
+	if dir := filepath.Dir(cfg.Path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create SQLite directory: %w", err)
+		}
+	}
+
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", cfg.Path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+	// SQLite takes one writer at a time; a single connection keeps writes from
+	// failing with "database is locked" instead of waiting their turn
+	db.SetMaxOpenConns(1)
+
+	if err := migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
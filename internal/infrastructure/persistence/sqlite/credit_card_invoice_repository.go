@@ -0,0 +1,153 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type creditCardInvoiceRepository struct {
+	table *documentTable
+}
+
+func NewCreditCardInvoiceRepository(db *sql.DB) repository.CreditCardInvoiceRepository {
+	return &creditCardInvoiceRepository{
+		table: newDocumentTable(db, "credit_card_invoices",
+			"credit_card_uuid", "reference_month", "status", "opening_date", "due_date"),
+	}
+}
+
+func (r *creditCardInvoiceRepository) Create(ctx context.Context, invoice *entity.CreditCardInvoice) error {
+	model := mongodb.CreditCardInvoiceToModel(invoice)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, invoiceColumns(model)...); err != nil {
+		return fmt.Errorf("failed to create credit card invoice: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) Update(ctx context.Context, invoice *entity.CreditCardInvoice) error {
+	return r.UpdateMany(ctx, []*entity.CreditCardInvoice{invoice})
+}
+
+// UpdateMany saves the invoices in a single database transaction, saving none
+// if any of them doesn't exist
+func (r *creditCardInvoiceRepository) UpdateMany(ctx context.Context, invoices []*entity.CreditCardInvoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	var missing bool
+	err := withTx(ctx, r.table.db, func(tx *sql.Tx) error {
+		for _, invoice := range invoices {
+			model := mongodb.CreditCardInvoiceToModel(invoice)
+			found, err := r.table.update(ctx, tx, model.UUID, model, invoiceColumns(model)...)
+			if err != nil {
+				return err
+			}
+			if !found {
+				missing = true
+				return fmt.Errorf("credit card invoice not found")
+			}
+		}
+		return nil
+	})
+	if missing {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update credit card invoices: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete credit card invoice: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("credit card invoice not found")
+	}
+	return nil
+}
+
+func (r *creditCardInvoiceRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CreditCardInvoice, error) {
+	invoice, err := r.findInvoice(ctx, "WHERE uuid = ?", id.String())
+	if err == nil && invoice == nil {
+		return nil, fmt.Errorf("credit card invoice not found")
+	}
+	return invoice, err
+}
+
+func (r *creditCardInvoiceRepository) FindByCreditCard(ctx context.Context, creditCardID uuid.UUID) ([]*entity.CreditCardInvoice, error) {
+	return r.findInvoices(ctx, "WHERE credit_card_uuid = ? ORDER BY reference_month DESC", creditCardID.String())
+}
+
+func (r *creditCardInvoiceRepository) FindByMonth(ctx context.Context, creditCardID uuid.UUID, referenceMonth string) (*entity.CreditCardInvoice, error) {
+	invoice, err := r.findInvoice(ctx, "WHERE credit_card_uuid = ? AND reference_month = ?", creditCardID.String(), referenceMonth)
+	if err == nil && invoice == nil {
+		return nil, fmt.Errorf("credit card invoice not found for month %s", referenceMonth)
+	}
+	return invoice, err
+}
+
+func (r *creditCardInvoiceRepository) FindOpenInvoice(ctx context.Context, creditCardID uuid.UUID) (*entity.CreditCardInvoice, error) {
+	invoice, err := r.findInvoice(ctx, "WHERE credit_card_uuid = ? AND status = ?", creditCardID.String(), string(entity.InvoiceStatusOpen))
+	if err == nil && invoice == nil {
+		return nil, fmt.Errorf("no open credit card invoice found")
+	}
+	return invoice, err
+}
+
+func (r *creditCardInvoiceRepository) FindByDateRange(ctx context.Context, creditCardID uuid.UUID, startDate, endDate time.Time) ([]*entity.CreditCardInvoice, error) {
+	clauses := "WHERE credit_card_uuid = ? AND opening_date >= ? AND opening_date <= ? ORDER BY opening_date DESC"
+	return r.findInvoices(ctx, clauses, creditCardID.String(), millis(startDate), millis(endDate))
+}
+
+func (r *creditCardInvoiceRepository) FindByStatus(ctx context.Context, creditCardID uuid.UUID, status entity.InvoiceStatus) ([]*entity.CreditCardInvoice, error) {
+	return r.findInvoices(ctx, "WHERE credit_card_uuid = ? AND status = ? ORDER BY due_date", creditCardID.String(), string(status))
+}
+
+// findInvoice returns nil when no invoice matches, leaving the message to the caller
+func (r *creditCardInvoiceRepository) findInvoice(ctx context.Context, clauses string, args ...interface{}) (*entity.CreditCardInvoice, error) {
+	var model mongodb.CreditCardInvoiceModel
+	found, err := r.table.findOne(ctx, &model, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card invoice: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return mongodb.CreditCardInvoiceFromModel(model)
+}
+
+func (r *creditCardInvoiceRepository) findInvoices(ctx context.Context, clauses string, args ...interface{}) ([]*entity.CreditCardInvoice, error) {
+	var invoices []*entity.CreditCardInvoice
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.CreditCardInvoiceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		invoice, err := mongodb.CreditCardInvoiceFromModel(model)
+		if err != nil {
+			return err
+		}
+		invoices = append(invoices, invoice)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card invoices: %w", err)
+	}
+	return invoices, nil
+}
+
+func invoiceColumns(model mongodb.CreditCardInvoiceModel) []interface{} {
+	return []interface{}{model.CreditCardUUID, model.ReferenceMonth, model.Status, millis(model.OpeningDate), millis(model.DueDate)}
+}
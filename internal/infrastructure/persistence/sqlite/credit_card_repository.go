@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type creditCardRepository struct {
+	table *documentTable
+}
+
+func NewCreditCardRepository(db *sql.DB) repository.CreditCardRepository {
+	return &creditCardRepository{
+		table: newDocumentTable(db, "credit_cards", "account_uuid"),
+	}
+}
+
+func (r *creditCardRepository) Create(ctx context.Context, card *entity.CreditCard) error {
+	model := mongodb.CreditCardToModel(card)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.AccountUUID); err != nil {
+		return fmt.Errorf("failed to create credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) Update(ctx context.Context, card *entity.CreditCard) error {
+	model := mongodb.CreditCardToModel(card)
+	if _, err := r.table.update(ctx, r.table.db, model.UUID, model, model.AccountUUID); err != nil {
+		return fmt.Errorf("failed to update credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.table.delete(ctx, r.table.db, id.String()); err != nil {
+		return fmt.Errorf("failed to delete credit card: %w", err)
+	}
+	return nil
+}
+
+func (r *creditCardRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CreditCard, error) {
+	var model mongodb.CreditCardModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit card: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("credit card not found")
+	}
+	return mongodb.CreditCardFromModel(model)
+}
+
+func (r *creditCardRepository) FindAll(ctx context.Context) ([]*entity.CreditCard, error) {
+	return r.findCards(ctx, "ORDER BY rowid")
+}
+
+func (r *creditCardRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.CreditCard, error) {
+	return r.findCards(ctx, "WHERE account_uuid = ? ORDER BY rowid", accountID.String())
+}
+
+func (r *creditCardRepository) findCards(ctx context.Context, clauses string, args ...interface{}) ([]*entity.CreditCard, error) {
+	var cards []*entity.CreditCard
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.CreditCardModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		card, err := mongodb.CreditCardFromModel(model)
+		if err != nil {
+			return err
+		}
+		cards = append(cards, card)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit cards: %w", err)
+	}
+	return cards, nil
+}
@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// documentTable reads and writes a table keyed by uuid that holds each record
+// as a BSON document, plus the columns listed here, which repositories pass in
+// the same order on every write
+type documentTable struct {
+	db      *sql.DB
+	name    string
+	columns []string
+}
+
+func newDocumentTable(db *sql.DB, name string, columns ...string) *documentTable {
+	return &documentTable{db: db, name: name, columns: columns}
+}
+
+func (t *documentTable) insert(ctx context.Context, exec execer, id string, model interface{}, values ...interface{}) error {
+	document, err := bson.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	columns := append(append([]string{"uuid"}, t.columns...), "document")
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	args := append(append([]interface{}{id}, values...), document)
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", t.name, strings.Join(columns, ", "), placeholders)
+	_, err = exec.ExecContext(ctx, query, args...)
+	return err
+}
+
+// update replaces the record and reports whether it existed
+func (t *documentTable) update(ctx context.Context, exec execer, id string, model interface{}, values ...interface{}) (bool, error) {
+	document, err := bson.Marshal(model)
+	if err != nil {
+		return false, err
+	}
+
+	assignments := make([]string, 0, len(t.columns)+1)
+	for _, column := range t.columns {
+		assignments = append(assignments, column+" = ?")
+	}
+	assignments = append(assignments, "document = ?")
+	args := append(append(values, document), id)
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE uuid = ?", t.name, strings.Join(assignments, ", "))
+	result, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected(result)
+}
+
+// delete removes the record and reports whether it existed
+func (t *documentTable) delete(ctx context.Context, exec execer, id string) (bool, error) {
+	result, err := exec.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE uuid = ?", t.name), id)
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected(result)
+}
+
+// findOne decodes into model the first document selected by clauses, the part
+// of the query after FROM, and reports whether there was one
+func (t *documentTable) findOne(ctx context.Context, model interface{}, clauses string, args ...interface{}) (bool, error) {
+	var document []byte
+	query := fmt.Sprintf("SELECT document FROM %s %s LIMIT 1", t.name, clauses)
+	if err := t.db.QueryRowContext(ctx, query, args...).Scan(&document); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, bson.Unmarshal(document, model)
+}
+
+// find calls decode with every document selected by clauses, the part of the
+// query after FROM
+func (t *documentTable) find(ctx context.Context, decode func(document []byte) error, clauses string, args ...interface{}) error {
+	rows, err := t.db.QueryContext(ctx, fmt.Sprintf("SELECT document FROM %s %s", t.name, clauses), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var document []byte
+		if err := rows.Scan(&document); err != nil {
+			return err
+		}
+		if err := decode(document); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// withTx runs fn in a database transaction, committing only if it succeeds
+func withTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func rowsAffected(result sql.Result) (bool, error) {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// millis is how times are stored in indexed columns, matching the millisecond
+// precision of the BSON documents
+func millis(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+// nullable stores an optional reference as NULL when it's not set
+func nullable(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// emergencyFundRepository keeps the plan as the table's only row
+type emergencyFundRepository struct {
+	db *sql.DB
+}
+
+func NewEmergencyFundRepository(db *sql.DB) repository.EmergencyFundRepository {
+	return &emergencyFundRepository{db: db}
+}
+
+func (r *emergencyFundRepository) Get(ctx context.Context) (*entity.EmergencyFundPlan, error) {
+	var document []byte
+	err := r.db.QueryRowContext(ctx, "SELECT document FROM emergency_fund WHERE id = 1").Scan(&document)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find emergency fund plan: %w", err)
+	}
+
+	var model mongodb.EmergencyFundPlanModel
+	if err := bson.Unmarshal(document, &model); err != nil {
+		return nil, fmt.Errorf("failed to decode emergency fund plan: %w", err)
+	}
+	return mongodb.EmergencyFundPlanFromModel(model)
+}
+
+func (r *emergencyFundRepository) Save(ctx context.Context, plan *entity.EmergencyFundPlan) error {
+	document, err := bson.Marshal(mongodb.EmergencyFundPlanToModel(plan))
+	if err != nil {
+		return fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO emergency_fund (id, document) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET document = excluded.document`,
+		document)
+	if err != nil {
+		return fmt.Errorf("failed to save emergency fund plan: %w", err)
+	}
+	return nil
+}
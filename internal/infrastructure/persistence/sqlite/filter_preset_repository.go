@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type filterPresetRepository struct {
+	table *documentTable
+}
+
+func NewFilterPresetRepository(db *sql.DB) repository.FilterPresetRepository {
+	return &filterPresetRepository{
+		table: newDocumentTable(db, "filter_presets", "name"),
+	}
+}
+
+func (r *filterPresetRepository) Create(ctx context.Context, preset *entity.FilterPreset) error {
+	model := mongodb.FilterPresetToModel(preset)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Name); err != nil {
+		return fmt.Errorf("failed to create filter preset: %w", err)
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) Update(ctx context.Context, preset *entity.FilterPreset) error {
+	model := mongodb.FilterPresetToModel(preset)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Name)
+	if err != nil {
+		return fmt.Errorf("failed to update filter preset: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("filter preset not found")
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete filter preset: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("filter preset not found")
+	}
+	return nil
+}
+
+func (r *filterPresetRepository) FindAll(ctx context.Context) ([]*entity.FilterPreset, error) {
+	return r.findPresets(ctx, "ORDER BY name")
+}
+
+func (r *filterPresetRepository) findPresets(ctx context.Context, clauses string, args ...interface{}) ([]*entity.FilterPreset, error) {
+	var presets []*entity.FilterPreset
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.FilterPresetModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		preset, err := mongodb.FilterPresetFromModel(model)
+		if err != nil {
+			return err
+		}
+		presets = append(presets, preset)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find filter presets: %w", err)
+	}
+	return presets, nil
+}
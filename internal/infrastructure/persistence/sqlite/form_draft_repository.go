@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// formDraftRepository keys its rows by form rather than uuid
+type formDraftRepository struct {
+	db *sql.DB
+}
+
+func NewFormDraftRepository(db *sql.DB) repository.FormDraftRepository {
+	return &formDraftRepository{db: db}
+}
+
+func (r *formDraftRepository) Get(ctx context.Context, form entity.FormDraftKind) (*entity.FormDraft, error) {
+	var document []byte
+	err := r.db.QueryRowContext(ctx, "SELECT document FROM form_drafts WHERE form = ?", string(form)).Scan(&document)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find form draft: %w", err)
+	}
+
+	var model mongodb.FormDraftModel
+	if err := bson.Unmarshal(document, &model); err != nil {
+		return nil, fmt.Errorf("failed to decode form draft: %w", err)
+	}
+	return mongodb.FormDraftFromModel(model), nil
+}
+
+func (r *formDraftRepository) Save(ctx context.Context, draft *entity.FormDraft) error {
+	model := mongodb.FormDraftToModel(draft)
+	document, err := bson.Marshal(model)
+	if err != nil {
+		return fmt.Errorf("failed to save form draft: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO form_drafts (form, document) VALUES (?, ?)
+		ON CONFLICT (form) DO UPDATE SET document = excluded.document`,
+		model.Form, document)
+	if err != nil {
+		return fmt.Errorf("failed to save form draft: %w", err)
+	}
+	return nil
+}
+
+func (r *formDraftRepository) Delete(ctx context.Context, form entity.FormDraftKind) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM form_drafts WHERE form = ?", string(form)); err != nil {
+		return fmt.Errorf("failed to delete form draft: %w", err)
+	}
+	return nil
+}
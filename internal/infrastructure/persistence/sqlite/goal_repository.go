@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type goalRepository struct {
+	table *documentTable
+}
+
+func NewGoalRepository(db *sql.DB) repository.GoalRepository {
+	return &goalRepository{
+		table: newDocumentTable(db, "goals", "deadline"),
+	}
+}
+
+func (r *goalRepository) Create(ctx context.Context, goal *entity.Goal) error {
+	model := mongodb.GoalToModel(goal)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.Deadline)); err != nil {
+		return fmt.Errorf("failed to create goal: %w", err)
+	}
+	return nil
+}
+
+func (r *goalRepository) Update(ctx context.Context, goal *entity.Goal) error {
+	model := mongodb.GoalToModel(goal)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, millis(model.Deadline))
+	if err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+func (r *goalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+func (r *goalRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
+	var model mongodb.GoalModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goal: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("goal not found")
+	}
+	return mongodb.GoalFromModel(model)
+}
+
+func (r *goalRepository) FindAll(ctx context.Context) ([]*entity.Goal, error) {
+	return r.findGoals(ctx, "ORDER BY deadline")
+}
+
+func (r *goalRepository) findGoals(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Goal, error) {
+	var goals []*entity.Goal
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.GoalModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		goal, err := mongodb.GoalFromModel(model)
+		if err != nil {
+			return err
+		}
+		goals = append(goals, goal)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goals: %w", err)
+	}
+	return goals, nil
+}
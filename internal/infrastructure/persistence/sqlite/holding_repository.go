@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type holdingRepository struct {
+	table *documentTable
+}
+
+func NewHoldingRepository(db *sql.DB) repository.HoldingRepository {
+	return &holdingRepository{
+		table: newDocumentTable(db, "holdings", "account_uuid", "ticker"),
+	}
+}
+
+func (r *holdingRepository) Create(ctx context.Context, holding *entity.Holding) error {
+	model := mongodb.HoldingToModel(holding)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.AccountUUID, model.Ticker); err != nil {
+		return fmt.Errorf("failed to create holding: %w", err)
+	}
+	return nil
+}
+
+func (r *holdingRepository) Update(ctx context.Context, holding *entity.Holding) error {
+	model := mongodb.HoldingToModel(holding)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.AccountUUID, model.Ticker)
+	if err != nil {
+		return fmt.Errorf("failed to update holding: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("holding not found")
+	}
+	return nil
+}
+
+func (r *holdingRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Holding, error) {
+	var model mongodb.HoldingModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find holding: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("holding not found")
+	}
+	return mongodb.HoldingFromModel(model)
+}
+
+func (r *holdingRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Holding, error) {
+	return r.find(ctx, "WHERE account_uuid = ? ORDER BY ticker", accountID.String())
+}
+
+func (r *holdingRepository) FindAll(ctx context.Context) ([]*entity.Holding, error) {
+	return r.find(ctx, "ORDER BY ticker")
+}
+
+func (r *holdingRepository) find(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Holding, error) {
+	var holdings []*entity.Holding
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.HoldingModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		holding, err := mongodb.HoldingFromModel(model)
+		if err != nil {
+			return err
+		}
+		holdings = append(holdings, holding)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find holdings: %w", err)
+	}
+	return holdings, nil
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type importSessionRepository struct {
+	table *documentTable
+}
+
+func NewImportSessionRepository(db *sql.DB) repository.ImportSessionRepository {
+	return &importSessionRepository{
+		table: newDocumentTable(db, "import_sessions", "account_uuid", "created_at"),
+	}
+}
+
+func (r *importSessionRepository) Create(ctx context.Context, session *entity.ImportSession) error {
+	model := mongodb.ImportSessionToModel(session)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.AccountUUID, millis(model.CreatedAt)); err != nil {
+		return fmt.Errorf("failed to create import session: %w", err)
+	}
+	return nil
+}
+
+func (r *importSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportSession, error) {
+	var model mongodb.ImportSessionModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import session: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("import session not found")
+	}
+	return mongodb.ImportSessionFromModel(model)
+}
+
+// FindByAccountID returns the account's imports, most recent first
+func (r *importSessionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.ImportSession, error) {
+	return r.findSessions(ctx, "WHERE account_uuid = ? ORDER BY created_at DESC", accountID.String())
+}
+
+func (r *importSessionRepository) findSessions(ctx context.Context, clauses string, args ...interface{}) ([]*entity.ImportSession, error) {
+	var sessions []*entity.ImportSession
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.ImportSessionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		session, err := mongodb.ImportSessionFromModel(model)
+		if err != nil {
+			return err
+		}
+		sessions = append(sessions, session)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import sessions: %w", err)
+	}
+	return sessions, nil
+}
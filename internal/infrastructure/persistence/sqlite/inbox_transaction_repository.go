@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type inboxTransactionRepository struct {
+	table *documentTable
+}
+
+func NewInboxTransactionRepository(db *sql.DB) repository.InboxTransactionRepository {
+	return &inboxTransactionRepository{
+		table: newDocumentTable(db, "transaction_inbox", "status", "transaction_date"),
+	}
+}
+
+func (r *inboxTransactionRepository) CreateMany(ctx context.Context, items []*entity.InboxTransaction) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := withTx(ctx, r.table.db, func(tx *sql.Tx) error {
+		for _, item := range items {
+			model := mongodb.InboxTransactionToModel(item)
+			if err := r.table.insert(ctx, tx, model.UUID, model, inboxColumns(model)...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create inbox transactions: %w", err)
+	}
+	return nil
+}
+
+func (r *inboxTransactionRepository) Update(ctx context.Context, item *entity.InboxTransaction) error {
+	model := mongodb.InboxTransactionToModel(item)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, inboxColumns(model)...)
+	if err != nil {
+		return fmt.Errorf("failed to update inbox transaction: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("inbox transaction not found")
+	}
+	return nil
+}
+
+func (r *inboxTransactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InboxTransaction, error) {
+	var model mongodb.InboxTransactionModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbox transaction: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("inbox transaction not found")
+	}
+	return mongodb.InboxTransactionFromModel(model)
+}
+
+// FindPending returns the items waiting for review, oldest first
+func (r *inboxTransactionRepository) FindPending(ctx context.Context) ([]*entity.InboxTransaction, error) {
+	var items []*entity.InboxTransaction
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.InboxTransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		item, err := mongodb.InboxTransactionFromModel(model)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	}, "WHERE status = ? ORDER BY transaction_date, uuid", string(entity.InboxStatusPending))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbox transactions: %w", err)
+	}
+	return items, nil
+}
+
+func inboxColumns(model mongodb.InboxTransactionModel) []interface{} {
+	return []interface{}{model.Status, millis(model.Transaction.Date)}
+}
@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type macroRepository struct {
+	table *documentTable
+}
+
+func NewMacroRepository(db *sql.DB) repository.MacroRepository {
+	return &macroRepository{
+		table: newDocumentTable(db, "macros", "slot"),
+	}
+}
+
+func (r *macroRepository) Create(ctx context.Context, macro *entity.Macro) error {
+	model := mongodb.MacroToModel(macro)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Slot); err != nil {
+		return fmt.Errorf("failed to create macro: %w", err)
+	}
+	return nil
+}
+
+func (r *macroRepository) Update(ctx context.Context, macro *entity.Macro) error {
+	model := mongodb.MacroToModel(macro)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Slot)
+	if err != nil {
+		return fmt.Errorf("failed to update macro: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("macro not found")
+	}
+	return nil
+}
+
+func (r *macroRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete macro: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("macro not found")
+	}
+	return nil
+}
+
+func (r *macroRepository) FindAll(ctx context.Context) ([]*entity.Macro, error) {
+	return r.findMacros(ctx, "ORDER BY slot")
+}
+
+func (r *macroRepository) findMacros(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Macro, error) {
+	var macros []*entity.Macro
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.MacroModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		macro, err := mongodb.MacroFromModel(model)
+		if err != nil {
+			return err
+		}
+		macros = append(macros, macro)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find macros: %w", err)
+	}
+	return macros, nil
+}
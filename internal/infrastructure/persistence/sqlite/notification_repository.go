@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type notificationRepository struct {
+	table *documentTable
+}
+
+func NewNotificationRepository(db *sql.DB) repository.NotificationRepository {
+	return &notificationRepository{
+		table: newDocumentTable(db, "notifications", "key", "created_at"),
+	}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *entity.Notification) error {
+	model := mongodb.NotificationToModel(notification)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Key, millis(model.CreatedAt)); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) Update(ctx context.Context, notification *entity.Notification) error {
+	model := mongodb.NotificationToModel(notification)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Key, millis(model.CreatedAt))
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+func (r *notificationRepository) FindAll(ctx context.Context) ([]*entity.Notification, error) {
+	return r.findNotifications(ctx, "ORDER BY created_at DESC")
+}
+
+func (r *notificationRepository) ExistsByKey(ctx context.Context, key string) (bool, error) {
+	var count int
+	if err := r.table.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM notifications WHERE key = ?", key).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to find notification: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *notificationRepository) findNotifications(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Notification, error) {
+	var notifications []*entity.Notification
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.NotificationModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		notification, err := mongodb.NotificationFromModel(model)
+		if err != nil {
+			return err
+		}
+		notifications = append(notifications, notification)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+	return notifications, nil
+}
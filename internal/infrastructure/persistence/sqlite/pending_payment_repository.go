@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type pendingPaymentRepository struct {
+	table *documentTable
+}
+
+func NewPendingPaymentRepository(db *sql.DB) repository.PendingPaymentRepository {
+	return &pendingPaymentRepository{
+		table: newDocumentTable(db, "pending_payments", "account_uuid", "credit_card_uuid", "status", "scheduled_for"),
+	}
+}
+
+func (r *pendingPaymentRepository) Create(ctx context.Context, payment *entity.PendingPayment) error {
+	model := mongodb.PendingPaymentToModel(payment)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.AccountUUID, model.CreditCardUUID, model.Status, millis(model.ScheduledFor)); err != nil {
+		return fmt.Errorf("failed to create pending payment: %w", err)
+	}
+	return nil
+}
+
+func (r *pendingPaymentRepository) Update(ctx context.Context, payment *entity.PendingPayment) error {
+	model := mongodb.PendingPaymentToModel(payment)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.AccountUUID, model.CreditCardUUID, model.Status, millis(model.ScheduledFor))
+	if err != nil {
+		return fmt.Errorf("failed to update pending payment: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("pending payment not found")
+	}
+	return nil
+}
+
+func (r *pendingPaymentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PendingPayment, error) {
+	var model mongodb.PendingPaymentModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending payment: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pending payment not found")
+	}
+	return mongodb.PendingPaymentFromModel(model)
+}
+
+// Earliest payment first
+const pendingPaymentOrder = "ORDER BY scheduled_for"
+
+func (r *pendingPaymentRepository) FindPending(ctx context.Context) ([]*entity.PendingPayment, error) {
+	return r.findPayments(ctx, "WHERE status = ? "+pendingPaymentOrder, string(entity.PendingPaymentStatusPending))
+}
+
+func (r *pendingPaymentRepository) FindPendingByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findPayments(ctx, "WHERE account_uuid = ? AND status = ? "+pendingPaymentOrder, accountID.String(), string(entity.PendingPaymentStatusPending))
+}
+
+func (r *pendingPaymentRepository) FindPendingByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.PendingPayment, error) {
+	return r.findPayments(ctx, "WHERE credit_card_uuid = ? AND status = ? "+pendingPaymentOrder, creditCardID.String(), string(entity.PendingPaymentStatusPending))
+}
+
+func (r *pendingPaymentRepository) findPayments(ctx context.Context, clauses string, args ...interface{}) ([]*entity.PendingPayment, error) {
+	var payments []*entity.PendingPayment
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.PendingPaymentModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		payment, err := mongodb.PendingPaymentFromModel(model)
+		if err != nil {
+			return err
+		}
+		payments = append(payments, payment)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending payments: %w", err)
+	}
+	return payments, nil
+}
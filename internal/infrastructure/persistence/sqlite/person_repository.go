@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type personRepository struct {
+	table *documentTable
+}
+
+func NewPersonRepository(db *sql.DB) repository.PersonRepository {
+	return &personRepository{
+		table: newDocumentTable(db, "people", "email"),
+	}
+}
+
+func (r *personRepository) Create(ctx context.Context, person *entity.Person) error {
+	model := mongodb.PersonToModel(person)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Email); err != nil {
+		return fmt.Errorf("failed to create person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) Update(ctx context.Context, person *entity.Person) error {
+	model := mongodb.PersonToModel(person)
+	if _, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Email); err != nil {
+		return fmt.Errorf("failed to update person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.table.delete(ctx, r.table.db, id.String()); err != nil {
+		return fmt.Errorf("failed to delete person: %w", err)
+	}
+	return nil
+}
+
+func (r *personRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Person, error) {
+	return r.findPerson(ctx, "WHERE uuid = ?", id.String())
+}
+
+func (r *personRepository) FindAll(ctx context.Context) ([]*entity.Person, error) {
+	var people []*entity.Person
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.PersonModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		person, err := mongodb.PersonFromModel(model)
+		if err != nil {
+			return err
+		}
+		people = append(people, person)
+		return nil
+	}, "ORDER BY rowid")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find people: %w", err)
+	}
+	return people, nil
+}
+
+func (r *personRepository) FindByEmail(ctx context.Context, email string) (*entity.Person, error) {
+	return r.findPerson(ctx, "WHERE email = ?", email)
+}
+
+func (r *personRepository) findPerson(ctx context.Context, clauses string, args ...interface{}) (*entity.Person, error) {
+	var model mongodb.PersonModel
+	found, err := r.table.findOne(ctx, &model, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find person: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("person not found")
+	}
+	return mongodb.PersonFromModel(model)
+}
@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type reportSnapshotRepository struct {
+	table *documentTable
+}
+
+func NewReportSnapshotRepository(db *sql.DB) repository.ReportSnapshotRepository {
+	return &reportSnapshotRepository{
+		table: newDocumentTable(db, "report_snapshots", "month"),
+	}
+}
+
+func (r *reportSnapshotRepository) Create(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := mongodb.ReportSnapshotToModel(snapshot)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.Month)); err != nil {
+		return fmt.Errorf("failed to create report snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *reportSnapshotRepository) Update(ctx context.Context, snapshot *entity.ReportSnapshot) error {
+	model := mongodb.ReportSnapshotToModel(snapshot)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, millis(model.Month))
+	if err != nil {
+		return fmt.Errorf("failed to update report snapshot: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("report snapshot not found")
+	}
+	return nil
+}
+
+func (r *reportSnapshotRepository) FindAll(ctx context.Context) ([]*entity.ReportSnapshot, error) {
+	var snapshots []*entity.ReportSnapshot
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.ReportSnapshotModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		snapshot, err := mongodb.ReportSnapshotFromModel(model)
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	}, "ORDER BY month DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report snapshots: %w", err)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type scheduledTransferRepository struct {
+	table *documentTable
+}
+
+func NewScheduledTransferRepository(db *sql.DB) repository.ScheduledTransferRepository {
+	return &scheduledTransferRepository{
+		table: newDocumentTable(db, "scheduled_transfers", "status", "scheduled_for"),
+	}
+}
+
+func (r *scheduledTransferRepository) Create(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := mongodb.ScheduledTransferToModel(transfer)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Status, millis(model.ScheduledFor)); err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepository) Update(ctx context.Context, transfer *entity.ScheduledTransfer) error {
+	model := mongodb.ScheduledTransferToModel(transfer)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Status, millis(model.ScheduledFor))
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("scheduled transfer not found")
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ScheduledTransfer, error) {
+	var model mongodb.ScheduledTransferModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled transfer: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("scheduled transfer not found")
+	}
+	return mongodb.ScheduledTransferFromModel(model)
+}
+
+func (r *scheduledTransferRepository) FindPending(ctx context.Context) ([]*entity.ScheduledTransfer, error) {
+	var transfers []*entity.ScheduledTransfer
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.ScheduledTransferModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		transfer, err := mongodb.ScheduledTransferFromModel(model)
+		if err != nil {
+			return err
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	}, "WHERE status = ? ORDER BY scheduled_for", string(entity.ScheduledTransferStatusPending))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled transfers: %w", err)
+	}
+	return transfers, nil
+}
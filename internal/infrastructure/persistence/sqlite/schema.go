@@ -0,0 +1,344 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// migrations create and evolve the schema. Each entry runs once, in order, and
+// the number applied is kept in the database's user_version; add new entries at
+// the end and never edit one that has shipped.
+var migrations = []string{
+	`
+	CREATE TABLE accounts (
+		uuid     TEXT PRIMARY KEY,
+		type     TEXT NOT NULL,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE credit_cards (
+		uuid         TEXT PRIMARY KEY,
+		account_uuid TEXT NOT NULL,
+		document     BLOB NOT NULL
+	);
+	CREATE INDEX credit_cards_account ON credit_cards (account_uuid);
+
+	CREATE TABLE credit_card_invoices (
+		uuid             TEXT PRIMARY KEY,
+		credit_card_uuid TEXT NOT NULL,
+		reference_month  TEXT NOT NULL,
+		status           TEXT NOT NULL,
+		opening_date     INTEGER NOT NULL,
+		due_date         INTEGER NOT NULL,
+		document         BLOB NOT NULL
+	);
+	CREATE INDEX credit_card_invoices_card ON credit_card_invoices (credit_card_uuid, reference_month);
+
+	CREATE TABLE people (
+		uuid     TEXT PRIMARY KEY,
+		email    TEXT NOT NULL,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE bills (
+		uuid       TEXT PRIMARY KEY,
+		status     TEXT NOT NULL,
+		start_date INTEGER NOT NULL,
+		end_date   INTEGER NOT NULL,
+		due_date   INTEGER NOT NULL,
+		document   BLOB NOT NULL
+	);
+
+	CREATE TABLE transactions (
+		uuid                     TEXT PRIMARY KEY,
+		account_uuid             TEXT,
+		credit_card_uuid         TEXT,
+		credit_card_invoice_uuid TEXT,
+		bill_uuid                TEXT,
+		category                 TEXT NOT NULL,
+		description              TEXT NOT NULL,
+		date                     INTEGER NOT NULL,
+		created_at               INTEGER NOT NULL,
+		document                 BLOB NOT NULL
+	);
+	CREATE INDEX transactions_date ON transactions (date DESC, created_at DESC, uuid);
+	CREATE INDEX transactions_account ON transactions (account_uuid, date);
+	CREATE INDEX transactions_credit_card ON transactions (credit_card_uuid, date);
+	CREATE INDEX transactions_invoice ON transactions (credit_card_invoice_uuid);
+	CREATE INDEX transactions_bill ON transactions (bill_uuid);
+	CREATE INDEX transactions_category ON transactions (category);
+
+	-- Who shares each transaction, so shared expenses can be found by person
+	CREATE TABLE transaction_shares (
+		transaction_uuid TEXT NOT NULL,
+		person_uuid      TEXT NOT NULL,
+		PRIMARY KEY (transaction_uuid, person_uuid)
+	);
+	CREATE INDEX transaction_shares_person ON transaction_shares (person_uuid);
+
+	CREATE TABLE import_sessions (
+		uuid         TEXT PRIMARY KEY,
+		account_uuid TEXT NOT NULL,
+		created_at   INTEGER NOT NULL,
+		document     BLOB NOT NULL
+	);
+
+	CREATE TABLE pending_payments (
+		uuid             TEXT PRIMARY KEY,
+		account_uuid     TEXT NOT NULL,
+		credit_card_uuid TEXT NOT NULL,
+		status           TEXT NOT NULL,
+		scheduled_for    INTEGER NOT NULL,
+		document         BLOB NOT NULL
+	);
+
+	CREATE TABLE sinking_funds (
+		uuid      TEXT PRIMARY KEY,
+		name      TEXT NOT NULL,
+		due_month INTEGER NOT NULL,
+		document  BLOB NOT NULL
+	);
+
+	CREATE TABLE wishlist_items (
+		uuid        TEXT PRIMARY KEY,
+		target_date INTEGER NOT NULL,
+		document    BLOB NOT NULL
+	);
+
+	CREATE TABLE subscription_prices (
+		uuid     TEXT PRIMARY KEY,
+		key      TEXT NOT NULL,
+		document BLOB NOT NULL
+	);
+	CREATE INDEX subscription_prices_key ON subscription_prices (key);
+
+	CREATE TABLE change_records (
+		uuid        TEXT PRIMARY KEY,
+		entity_type TEXT NOT NULL,
+		entity_uuid TEXT NOT NULL,
+		created_at  INTEGER NOT NULL,
+		document    BLOB NOT NULL
+	);
+	CREATE INDEX change_records_entity ON change_records (entity_type, entity_uuid);
+
+	CREATE TABLE transaction_inbox (
+		uuid             TEXT PRIMARY KEY,
+		status           TEXT NOT NULL,
+		transaction_date INTEGER NOT NULL,
+		document         BLOB NOT NULL
+	);
+
+	CREATE TABLE filter_presets (
+		uuid     TEXT PRIMARY KEY,
+		name     TEXT NOT NULL,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE category_appearances (
+		category TEXT PRIMARY KEY,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE macros (
+		uuid     TEXT PRIMARY KEY,
+		slot     INTEGER NOT NULL,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE notifications (
+		uuid       TEXT PRIMARY KEY,
+		key        TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		document   BLOB NOT NULL
+	);
+	CREATE INDEX notifications_key ON notifications (key);
+
+	CREATE TABLE budgets (
+		uuid     TEXT PRIMARY KEY,
+		category TEXT NOT NULL,
+		document BLOB NOT NULL
+	);
+
+	CREATE TABLE standing_orders (
+		uuid         TEXT PRIMARY KEY,
+		day_of_month INTEGER NOT NULL,
+		document     BLOB NOT NULL
+	);
+
+	-- The plan is a single row
+	CREATE TABLE emergency_fund (
+		id       INTEGER PRIMARY KEY CHECK (id = 1),
+		document BLOB NOT NULL
+	);
+	`,
+	`
+	-- Transactions moved out of the transactions table once they get old
+	CREATE TABLE transactions_archive (
+		uuid       TEXT PRIMARY KEY,
+		date       INTEGER NOT NULL,
+		created_at INTEGER NOT NULL,
+		document   BLOB NOT NULL
+	);
+	CREATE INDEX transactions_archive_date ON transactions_archive (date DESC, created_at DESC, uuid);
+	`,
+	`
+	-- The category classifier is a single row
+	CREATE TABLE category_classifier (
+		id       INTEGER PRIMARY KEY CHECK (id = 1),
+		document BLOB NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE category_rules (
+		uuid       TEXT PRIMARY KEY,
+		created_at INTEGER NOT NULL,
+		document   BLOB NOT NULL
+	);
+	`,
+	`
+	-- The type and amount of each transaction, so totals are summed in SQL.
+	-- Existing rows are filled in from their documents by backfillTransactionAmounts.
+	ALTER TABLE transactions ADD COLUMN type TEXT;
+	ALTER TABLE transactions ADD COLUMN amount REAL;
+	CREATE INDEX transactions_type_date ON transactions (type, date);
+	`,
+	`
+	CREATE TABLE goals (
+		uuid     TEXT PRIMARY KEY,
+		deadline INTEGER NOT NULL,
+		document BLOB NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE scheduled_transfers (
+		uuid          TEXT PRIMARY KEY,
+		status        TEXT NOT NULL,
+		scheduled_for INTEGER NOT NULL,
+		document      BLOB NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE holdings (
+		uuid         TEXT PRIMARY KEY,
+		account_uuid TEXT NOT NULL,
+		ticker       TEXT NOT NULL,
+		document     BLOB NOT NULL
+	);
+	CREATE INDEX holdings_account ON holdings (account_uuid, ticker);
+	`,
+	`
+	CREATE TABLE accounting_periods (
+		uuid     TEXT PRIMARY KEY,
+		month    INTEGER NOT NULL,
+		document BLOB NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE report_snapshots (
+		uuid     TEXT PRIMARY KEY,
+		month    INTEGER NOT NULL,
+		document BLOB NOT NULL
+	);
+	`,
+	`
+	-- The tags of each transaction, so transactions can be found by tag
+	CREATE TABLE transaction_tags (
+		transaction_uuid TEXT NOT NULL,
+		tag              TEXT NOT NULL,
+		PRIMARY KEY (transaction_uuid, tag)
+	);
+	CREATE INDEX transaction_tags_tag ON transaction_tags (tag);
+	`,
+	`
+	CREATE TABLE categories (
+		uuid       TEXT PRIMARY KEY,
+		created_at INTEGER NOT NULL,
+		document   BLOB NOT NULL
+	);
+	`,
+	`
+	CREATE TABLE form_drafts (
+		form     TEXT PRIMARY KEY,
+		document BLOB NOT NULL
+	);
+	`,
+}
+
+// transactionAmountsVersion is the schema version that added the type and
+// amount columns of the transactions
+const transactionAmountsVersion = 5
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	var version int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to migrate schema: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply schema migration %d: %w", i+1, err)
+		}
+		// PRAGMA doesn't take parameters
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply schema migration %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to apply schema migration %d: %w", i+1, err)
+		}
+	}
+
+	if version < transactionAmountsVersion {
+		if err := backfillTransactionAmounts(ctx, db); err != nil {
+			return fmt.Errorf("failed to fill in transaction amounts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// backfillTransactionAmounts copies the type and amount of the transactions
+// stored before they had their own columns out of their documents
+func backfillTransactionAmounts(ctx context.Context, db *sql.DB) error {
+	return withTx(ctx, db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT uuid, document FROM transactions WHERE amount IS NULL")
+		if err != nil {
+			return err
+		}
+		models := make(map[string]mongodb.TransactionModel)
+		for rows.Next() {
+			var id string
+			var document []byte
+			if err := rows.Scan(&id, &document); err != nil {
+				rows.Close()
+				return err
+			}
+			var model mongodb.TransactionModel
+			if err := bson.Unmarshal(document, &model); err != nil {
+				rows.Close()
+				return err
+			}
+			models[id] = model
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for id, model := range models {
+			if _, err := tx.ExecContext(ctx, "UPDATE transactions SET type = ?, amount = ? WHERE uuid = ?", model.Type, model.Amount.Amount, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type sinkingFundRepository struct {
+	table *documentTable
+}
+
+func NewSinkingFundRepository(db *sql.DB) repository.SinkingFundRepository {
+	return &sinkingFundRepository{
+		table: newDocumentTable(db, "sinking_funds", "name", "due_month"),
+	}
+}
+
+func (r *sinkingFundRepository) Create(ctx context.Context, fund *entity.SinkingFund) error {
+	model := mongodb.SinkingFundToModel(fund)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Name, model.DueMonth); err != nil {
+		return fmt.Errorf("failed to create sinking fund: %w", err)
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) Update(ctx context.Context, fund *entity.SinkingFund) error {
+	model := mongodb.SinkingFundToModel(fund)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Name, model.DueMonth)
+	if err != nil {
+		return fmt.Errorf("failed to update sinking fund: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("sinking fund not found")
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete sinking fund: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("sinking fund not found")
+	}
+	return nil
+}
+
+func (r *sinkingFundRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SinkingFund, error) {
+	var model mongodb.SinkingFundModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sinking fund: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("sinking fund not found")
+	}
+	return mongodb.SinkingFundFromModel(model)
+}
+
+func (r *sinkingFundRepository) FindAll(ctx context.Context) ([]*entity.SinkingFund, error) {
+	return r.findFunds(ctx, "ORDER BY due_month, name")
+}
+
+func (r *sinkingFundRepository) findFunds(ctx context.Context, clauses string, args ...interface{}) ([]*entity.SinkingFund, error) {
+	var funds []*entity.SinkingFund
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.SinkingFundModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		fund, err := mongodb.SinkingFundFromModel(model)
+		if err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sinking funds: %w", err)
+	}
+	return funds, nil
+}
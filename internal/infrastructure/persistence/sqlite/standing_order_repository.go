@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type standingOrderRepository struct {
+	table *documentTable
+}
+
+func NewStandingOrderRepository(db *sql.DB) repository.StandingOrderRepository {
+	return &standingOrderRepository{
+		table: newDocumentTable(db, "standing_orders", "day_of_month"),
+	}
+}
+
+func (r *standingOrderRepository) Create(ctx context.Context, order *entity.StandingOrder) error {
+	model := mongodb.StandingOrderToModel(order)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.DayOfMonth); err != nil {
+		return fmt.Errorf("failed to create standing order: %w", err)
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) Update(ctx context.Context, order *entity.StandingOrder) error {
+	model := mongodb.StandingOrderToModel(order)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.DayOfMonth)
+	if err != nil {
+		return fmt.Errorf("failed to update standing order: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("standing order not found")
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete standing order: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("standing order not found")
+	}
+	return nil
+}
+
+func (r *standingOrderRepository) FindAll(ctx context.Context) ([]*entity.StandingOrder, error) {
+	return r.findOrders(ctx, "ORDER BY day_of_month")
+}
+
+func (r *standingOrderRepository) findOrders(ctx context.Context, clauses string, args ...interface{}) ([]*entity.StandingOrder, error) {
+	var orders []*entity.StandingOrder
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.StandingOrderModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		order, err := mongodb.StandingOrderFromModel(model)
+		if err != nil {
+			return err
+		}
+		orders = append(orders, order)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find standing orders: %w", err)
+	}
+	return orders, nil
+}
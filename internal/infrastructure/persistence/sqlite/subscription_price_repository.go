@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type subscriptionPriceRepository struct {
+	table *documentTable
+}
+
+func NewSubscriptionPriceRepository(db *sql.DB) repository.SubscriptionPriceRepository {
+	return &subscriptionPriceRepository{
+		table: newDocumentTable(db, "subscription_prices", "key"),
+	}
+}
+
+func (r *subscriptionPriceRepository) Create(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := mongodb.SubscriptionPriceToModel(price)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, model.Key); err != nil {
+		return fmt.Errorf("failed to create subscription price: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionPriceRepository) Update(ctx context.Context, price *entity.SubscriptionPrice) error {
+	model := mongodb.SubscriptionPriceToModel(price)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, model.Key)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription price: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("subscription price not found")
+	}
+	return nil
+}
+
+func (r *subscriptionPriceRepository) FindByKey(ctx context.Context, key string) (*entity.SubscriptionPrice, error) {
+	var model mongodb.SubscriptionPriceModel
+	found, err := r.table.findOne(ctx, &model, "WHERE key = ?", key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription price: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("subscription price not found")
+	}
+	return mongodb.SubscriptionPriceFromModel(model)
+}
+
+func (r *subscriptionPriceRepository) FindAll(ctx context.Context) ([]*entity.SubscriptionPrice, error) {
+	return r.findPrices(ctx, "ORDER BY rowid")
+}
+
+func (r *subscriptionPriceRepository) findPrices(ctx context.Context, clauses string, args ...interface{}) ([]*entity.SubscriptionPrice, error) {
+	var prices []*entity.SubscriptionPrice
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.SubscriptionPriceModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		price, err := mongodb.SubscriptionPriceFromModel(model)
+		if err != nil {
+			return err
+		}
+		prices = append(prices, price)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription prices: %w", err)
+	}
+	return prices, nil
+}
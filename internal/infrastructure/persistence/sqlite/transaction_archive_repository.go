@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type transactionArchiveRepository struct {
+	// archive reads through a transactionRepository so it sorts and decodes the same way
+	archive *transactionRepository
+}
+
+func NewTransactionArchiveRepository(db *sql.DB) repository.TransactionArchiveRepository {
+	return &transactionArchiveRepository{
+		archive: &transactionRepository{table: newDocumentTable(db, "transactions_archive", "date", "created_at")},
+	}
+}
+
+// ArchiveBefore moves the rows in a single database transaction. Their
+// transaction_shares rows stay, so shared expenses can still be found by person.
+func (r *transactionArchiveRepository) ArchiveBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	var moved int64
+	err := withTx(ctx, r.archive.table.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT OR REPLACE INTO transactions_archive (uuid, date, created_at, document)
+			SELECT uuid, date, created_at, document FROM transactions WHERE date < ?`, millis(cutoff))
+		if err != nil {
+			return err
+		}
+		result, err := tx.ExecContext(ctx, "DELETE FROM transactions WHERE date < ?", millis(cutoff))
+		if err != nil {
+			return err
+		}
+		moved, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive transactions: %w", err)
+	}
+	return int(moved), nil
+}
+
+func (r *transactionArchiveRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.archive.FindByDateRange(ctx, startDate, endDate)
+}
+
+func (r *transactionArchiveRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.archive.FindSharedWithPerson(ctx, personID)
+}
@@ -0,0 +1,355 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// transactionOrder lists transactions newest first. The uuid tiebreaker makes the
+// order total, so lists don't reshuffle between refreshes.
+const transactionOrder = "ORDER BY date DESC, created_at DESC, uuid"
+
+type transactionRepository struct {
+	table *documentTable
+}
+
+func NewTransactionRepository(db *sql.DB) repository.TransactionRepository {
+	return &transactionRepository{
+		table: newDocumentTable(db, "transactions",
+			"account_uuid", "credit_card_uuid", "credit_card_invoice_uuid", "bill_uuid",
+			"category", "description", "date", "created_at", "type", "amount"),
+	}
+}
+
+func (r *transactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	return r.CreateMany(ctx, []*entity.Transaction{transaction})
+}
+
+// CreateMany inserts the transactions in a single database transaction
+func (r *transactionRepository) CreateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	err := withTx(ctx, r.table.db, func(tx *sql.Tx) error {
+		for _, transaction := range transactions {
+			model := mongodb.TransactionToModel(transaction)
+			if err := r.table.insert(ctx, tx, model.UUID, model, transactionColumns(model)...); err != nil {
+				return err
+			}
+			if err := insertShares(ctx, tx, model); err != nil {
+				return err
+			}
+			if err := insertTags(ctx, tx, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	model := mongodb.TransactionToModel(transaction)
+	err := withTx(ctx, r.table.db, func(tx *sql.Tx) error {
+		if _, err := r.table.update(ctx, tx, model.UUID, model, transactionColumns(model)...); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM transaction_shares WHERE transaction_uuid = ?", model.UUID); err != nil {
+			return err
+		}
+		if err := insertShares(ctx, tx, model); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM transaction_tags WHERE transaction_uuid = ?", model.UUID); err != nil {
+			return err
+		}
+		return insertTags(ctx, tx, model)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := withTx(ctx, r.table.db, func(tx *sql.Tx) error {
+		if _, err := r.table.delete(ctx, tx, id.String()); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM transaction_shares WHERE transaction_uuid = ?", id.String()); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM transaction_tags WHERE transaction_uuid = ?", id.String())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	var model mongodb.TransactionModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("transaction not found")
+	}
+	return mongodb.TransactionFromModel(model)
+}
+
+func (r *transactionRepository) FindAll(ctx context.Context) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, transactionOrder)
+}
+
+func (r *transactionRepository) FindByAccountID(ctx context.Context, accountID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE account_uuid = ? "+transactionOrder, accountID.String())
+}
+
+func (r *transactionRepository) FindByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE credit_card_uuid = ? "+transactionOrder, creditCardID.String())
+}
+
+func (r *transactionRepository) FindByCreditCardInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE credit_card_invoice_uuid = ? "+transactionOrder, invoiceID.String())
+}
+
+func (r *transactionRepository) FindByBillID(ctx context.Context, billID uuid.UUID) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE bill_uuid = ? "+transactionOrder, billID.String())
+}
+
+func (r *transactionRepository) FindByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE date >= ? AND date <= ? "+transactionOrder, millis(startDate), millis(endDate))
+}
+
+func (r *transactionRepository) FindPageByDateRange(ctx context.Context, startDate, endDate time.Time, offset, limit int) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE date >= ? AND date <= ? "+transactionOrder+" LIMIT ? OFFSET ?",
+		millis(startDate), millis(endDate), limit, offset)
+}
+
+func (r *transactionRepository) FindByCategory(ctx context.Context, category entity.TransactionCategory) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, "WHERE category = ? "+transactionOrder, string(category))
+}
+
+func (r *transactionRepository) FindByTag(ctx context.Context, tag string) ([]*entity.Transaction, error) {
+	clauses := "WHERE uuid IN (SELECT transaction_uuid FROM transaction_tags WHERE tag = ?) " + transactionOrder
+	return r.findTransactions(ctx, clauses, tag)
+}
+
+// FindTags reads the distinct tags of the transactions, leaving out those only
+// archived transactions have
+func (r *transactionRepository) FindTags(ctx context.Context) ([]string, error) {
+	rows, err := r.table.db.QueryContext(ctx,
+		"SELECT DISTINCT tag FROM transaction_tags WHERE transaction_uuid IN (SELECT uuid FROM transactions) ORDER BY tag")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("failed to find tags: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+func (r *transactionRepository) FindSharedWithPerson(ctx context.Context, personID uuid.UUID) ([]*entity.Transaction, error) {
+	clauses := "WHERE uuid IN (SELECT transaction_uuid FROM transaction_shares WHERE person_uuid = ?) " + transactionOrder
+	return r.findTransactions(ctx, clauses, personID.String())
+}
+
+func (r *transactionRepository) FindUnassignedToBill(ctx context.Context, startDate, endDate time.Time) ([]*entity.Transaction, error) {
+	clauses := "WHERE bill_uuid IS NULL AND date >= ? AND date <= ? " + transactionOrder
+	return r.findTransactions(ctx, clauses, millis(startDate), millis(endDate))
+}
+
+// FindLatest returns the most recent transactions, newest first
+func (r *transactionRepository) FindLatest(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	return r.findTransactions(ctx, transactionOrder+" LIMIT ?", limit)
+}
+
+// FindCategoryUsage reads only the category and date columns of the account's
+// or card's transactions since the given date
+func (r *transactionRepository) FindCategoryUsage(ctx context.Context, accountID, creditCardID *uuid.UUID, since time.Time) ([]repository.CategoryUsage, error) {
+	var query string
+	var source string
+	switch {
+	case accountID != nil:
+		query = "SELECT category, date FROM transactions WHERE account_uuid = ? AND date >= ?"
+		source = accountID.String()
+	case creditCardID != nil:
+		query = "SELECT category, date FROM transactions WHERE credit_card_uuid = ? AND date >= ?"
+		source = creditCardID.String()
+	default:
+		return nil, nil
+	}
+
+	rows, err := r.table.db.QueryContext(ctx, query, source, millis(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.CategoryUsage
+	for rows.Next() {
+		var category string
+		var date int64
+		if err := rows.Scan(&category, &date); err != nil {
+			return nil, fmt.Errorf("failed to find category usage: %w", err)
+		}
+		usage = append(usage, repository.CategoryUsage{
+			Category: entity.TransactionCategory(category),
+			Date:     time.UnixMilli(date).UTC(),
+		})
+	}
+	return usage, rows.Err()
+}
+
+// FindDescriptionUsage reads only the description and date columns of the
+// transactions since the given date
+func (r *transactionRepository) FindDescriptionUsage(ctx context.Context, since time.Time) ([]repository.DescriptionUsage, error) {
+	rows, err := r.table.db.QueryContext(ctx, "SELECT description, date FROM transactions WHERE date >= ?", millis(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find description usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.DescriptionUsage
+	for rows.Next() {
+		var description string
+		var date int64
+		if err := rows.Scan(&description, &date); err != nil {
+			return nil, fmt.Errorf("failed to find description usage: %w", err)
+		}
+		usage = append(usage, repository.DescriptionUsage{
+			Description: description,
+			Date:        time.UnixMilli(date).UTC(),
+		})
+	}
+	return usage, rows.Err()
+}
+
+// SumByCategory groups and sums the amount column, without reading any document
+func (r *transactionRepository) SumByCategory(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryTotal, error) {
+	rows, err := r.table.db.QueryContext(ctx, `
+		SELECT category, SUM(amount), COUNT(*) FROM transactions
+		WHERE type = ? AND date >= ? AND date <= ?
+		GROUP BY category ORDER BY SUM(amount) DESC, category`,
+		string(transactionType), millis(startDate), millis(endDate))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum transactions by category: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []repository.CategoryTotal
+	for rows.Next() {
+		var total repository.CategoryTotal
+		var category string
+		if err := rows.Scan(&category, &total.Total, &total.Count); err != nil {
+			return nil, fmt.Errorf("failed to sum transactions by category: %w", err)
+		}
+		total.Category = entity.TransactionCategory(category)
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
+
+// SumByCategoryAndMonth groups by category and local calendar month in the
+// database, so only one row per category and month comes back
+func (r *transactionRepository) SumByCategoryAndMonth(ctx context.Context, transactionType entity.TransactionType, startDate, endDate time.Time) ([]repository.CategoryMonthTotal, error) {
+	rows, err := r.table.db.QueryContext(ctx, `
+		SELECT category, strftime('%Y-%m', date / 1000, 'unixepoch', 'localtime') AS month, SUM(amount), COUNT(*) FROM transactions
+		WHERE type = ? AND date >= ? AND date < ?
+		GROUP BY category, month ORDER BY month, category`,
+		string(transactionType), millis(entity.PeriodMonth(startDate)), millis(entity.PeriodMonth(endDate)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum transactions by category and month: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []repository.CategoryMonthTotal
+	for rows.Next() {
+		var total repository.CategoryMonthTotal
+		var category, month string
+		if err := rows.Scan(&category, &month, &total.Total, &total.Count); err != nil {
+			return nil, fmt.Errorf("failed to sum transactions by category and month: %w", err)
+		}
+		total.Category = entity.TransactionCategory(category)
+		if total.Month, err = time.ParseInLocation("2006-01", month, time.Local); err != nil {
+			return nil, fmt.Errorf("failed to sum transactions by category and month: %w", err)
+		}
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
+
+func (r *transactionRepository) findTransactions(ctx context.Context, clauses string, args ...interface{}) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.TransactionModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		transaction, err := mongodb.TransactionFromModel(model)
+		if err != nil {
+			return err
+		}
+		transactions = append(transactions, transaction)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+func transactionColumns(model mongodb.TransactionModel) []interface{} {
+	return []interface{}{
+		nullable(model.AccountUUID), nullable(model.CreditCardUUID),
+		nullable(model.CreditCardInvoiceUUID), nullable(model.BillUUID),
+		model.Category, model.Description, millis(model.Date), millis(model.CreatedAt),
+		model.Type, model.Amount.Amount,
+	}
+}
+
+func insertTags(ctx context.Context, tx *sql.Tx, model mongodb.TransactionModel) error {
+	for _, tag := range model.Tags {
+		_, err := tx.ExecContext(ctx,
+			"INSERT OR IGNORE INTO transaction_tags (transaction_uuid, tag) VALUES (?, ?)",
+			model.UUID, tag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertShares(ctx context.Context, tx *sql.Tx, model mongodb.TransactionModel) error {
+	for _, shared := range model.SharedWith {
+		_, err := tx.ExecContext(ctx,
+			"INSERT OR IGNORE INTO transaction_shares (transaction_uuid, person_uuid) VALUES (?, ?)",
+			model.UUID, shared.PersonUUID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
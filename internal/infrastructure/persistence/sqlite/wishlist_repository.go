@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/repository"
+	"financli/internal/infrastructure/persistence/mongodb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type wishlistRepository struct {
+	table *documentTable
+}
+
+func NewWishlistRepository(db *sql.DB) repository.WishlistRepository {
+	return &wishlistRepository{
+		table: newDocumentTable(db, "wishlist_items", "target_date"),
+	}
+}
+
+func (r *wishlistRepository) Create(ctx context.Context, item *entity.WishlistItem) error {
+	model := mongodb.WishlistItemToModel(item)
+	if err := r.table.insert(ctx, r.table.db, model.UUID, model, millis(model.TargetDate)); err != nil {
+		return fmt.Errorf("failed to create wishlist item: %w", err)
+	}
+	return nil
+}
+
+func (r *wishlistRepository) Update(ctx context.Context, item *entity.WishlistItem) error {
+	model := mongodb.WishlistItemToModel(item)
+	found, err := r.table.update(ctx, r.table.db, model.UUID, model, millis(model.TargetDate))
+	if err != nil {
+		return fmt.Errorf("failed to update wishlist item: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("wishlist item not found")
+	}
+	return nil
+}
+
+func (r *wishlistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	found, err := r.table.delete(ctx, r.table.db, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist item: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("wishlist item not found")
+	}
+	return nil
+}
+
+func (r *wishlistRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WishlistItem, error) {
+	var model mongodb.WishlistItemModel
+	found, err := r.table.findOne(ctx, &model, "WHERE uuid = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist item: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("wishlist item not found")
+	}
+	return mongodb.WishlistItemFromModel(model)
+}
+
+func (r *wishlistRepository) FindAll(ctx context.Context) ([]*entity.WishlistItem, error) {
+	return r.findItems(ctx, "ORDER BY target_date")
+}
+
+func (r *wishlistRepository) findItems(ctx context.Context, clauses string, args ...interface{}) ([]*entity.WishlistItem, error) {
+	var items []*entity.WishlistItem
+	err := r.table.find(ctx, func(document []byte) error {
+		var model mongodb.WishlistItemModel
+		if err := bson.Unmarshal(document, &model); err != nil {
+			return err
+		}
+		item, err := mongodb.WishlistItemFromModel(model)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	}, clauses, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist items: %w", err)
+	}
+	return items, nil
+}
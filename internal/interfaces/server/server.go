@@ -0,0 +1,135 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+)
+
+// maxAlertBytes bounds the body of a posted notification
+const maxAlertBytes = 64 << 10
+
+// Server accepts the bank notifications phone automation apps (Tasker,
+// Shortcuts) forward, queueing the purchases they describe for review
+type Server struct {
+	cardAlertUseCase *usecase.CardAlertUseCase
+	token            string
+}
+
+// alertRequest is a forwarded notification. Apps that can't send JSON post
+// the text as the plain body instead.
+type alertRequest struct {
+	// App or bank the notification came from, shown as the inbox source
+	App   string `json:"app"`
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type alertResponse struct {
+	ID          string  `json:"id,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Amount      float64 `json:"amount,omitempty"`
+	Error       string  `json:"error,omitempty"`
+}
+
+// NewServer serves the intake; requests must carry token as a bearer token,
+// unless it is empty
+func NewServer(cardAlertUseCase *usecase.CardAlertUseCase, token string) *Server {
+	return &Server{
+		cardAlertUseCase: cardAlertUseCase,
+		token:            token,
+	}
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/alerts", s.handleAlert)
+	return mux
+}
+
+func (s *Server) handleAlert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, alertResponse{Error: "use POST"})
+		return
+	}
+	if !s.authorized(r) {
+		writeJSON(w, http.StatusUnauthorized, alertResponse{Error: "invalid token"})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxAlertBytes))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, alertResponse{Error: "failed to read the request"})
+		return
+	}
+
+	var alert alertRequest
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+		if err := json.Unmarshal(body, &alert); err != nil {
+			writeJSON(w, http.StatusBadRequest, alertResponse{Error: "invalid JSON: " + err.Error()})
+			return
+		}
+	} else {
+		alert.Text = string(body)
+		alert.App = r.URL.Query().Get("app")
+	}
+
+	text := strings.TrimSpace(alert.Title + "\n" + alert.Text)
+	source := "notification"
+	if alert.App != "" {
+		source += ": " + alert.App
+	}
+
+	item, err := s.cardAlertUseCase.IngestAlert(r.Context(), source, text, time.Now())
+	if err != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, alertResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, alertResponse{
+		ID:          item.ID.String(),
+		Description: item.Transaction.Description,
+		Amount:      item.Transaction.Amount.Amount(),
+	})
+}
+
+// authorized checks the bearer token of the Authorization header. The query
+// string isn't accepted, since proxies and access logs keep it.
+func (s *Server) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	header := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// IsLoopback reports whether addr only listens on this machine, such as
+// "127.0.0.1:8080"; ":8080" listens on every interface
+func IsLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeJSON(w http.ResponseWriter, status int, response alertResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"financli/internal/application/usecase"
 	"financli/internal/interfaces/tui/screen"
@@ -17,6 +18,21 @@ type FormModeChecker interface {
 	IsInFormMode() bool
 }
 
+// AutoRefresher interface for screens that can reload their data periodically
+type AutoRefresher interface {
+	SetRefreshInterval(interval time.Duration)
+}
+
+// PageSizer interface for screens whose lists are split in pages
+type PageSizer interface {
+	SetItemsPerPage(n int)
+}
+
+// BusinessModer interface for screens that show the client and project of business expenses
+type BusinessModer interface {
+	SetBusinessMode(enabled bool)
+}
+
 // Message types for inter-screen communication
 
 type Screen int
@@ -29,6 +45,12 @@ const (
 	TransactionsScreen
 	PeopleScreen
 	ReportsScreen
+	WishlistScreen
+	InboxScreen
+	CategoriesScreen
+	BudgetsScreen
+	GoalsScreen
+	InvestmentsScreen
 )
 
 type App struct {
@@ -40,66 +62,173 @@ type App struct {
 	transactionsModel tea.Model
 	peopleModel       tea.Model
 	reportsModel      tea.Model
+	wishlistModel     tea.Model
+	inboxModel        tea.Model
+	categoriesModel   tea.Model
+	budgetsModel      tea.Model
+	goalsModel        tea.Model
+	investmentsModel  tea.Model
 	width             int
 	height            int
+	lock              passcodeLock
+	macros            macroRecorder
+	notifications     notificationCenter
+	startup           startupState
+	workspaces        workspaceSwitcher
+	refreshInterval   time.Duration
+	itemsPerPage      int
+	businessMode      bool
+	formDrafts        bool
+	unsaved           unsavedGuard
 	ctx               context.Context
 }
 
 type UseCases struct {
-	Account           *usecase.AccountUseCase
-	CreditCard        *usecase.CreditCardUseCase
-	CreditCardInvoice *usecase.CreditCardInvoiceUseCase
-	Bill              *usecase.BillUseCase
-	Transaction       *usecase.TransactionUseCase
-	Person            *usecase.PersonUseCase
-	Report            *usecase.ReportUseCase
+	Account            *usecase.AccountUseCase
+	CreditCard         *usecase.CreditCardUseCase
+	CreditCardInvoice  *usecase.CreditCardInvoiceUseCase
+	Bill               *usecase.BillUseCase
+	Transaction        *usecase.TransactionUseCase
+	Person             *usecase.PersonUseCase
+	Report             *usecase.ReportUseCase
+	InvoiceExport      *usecase.InvoiceExportUseCase
+	InvoiceForecast    *usecase.InvoiceForecastUseCase
+	Yield              *usecase.YieldUseCase
+	Import             *usecase.ImportUseCase
+	PendingPayment     *usecase.PendingPaymentUseCase
+	SinkingFund        *usecase.SinkingFundUseCase
+	Wishlist           *usecase.WishlistUseCase
+	Subscription       *usecase.SubscriptionUseCase
+	ChangeHistory      *usecase.ChangeHistoryUseCase
+	Inbox              *usecase.InboxUseCase
+	FilterPreset       *usecase.FilterPresetUseCase
+	PeopleExchange     *usecase.PeopleExchangeUseCase
+	CategoryAppearance *usecase.CategoryAppearanceUseCase
+	Macro              *usecase.MacroUseCase
+	Notification       *usecase.NotificationUseCase
+	DueReminder        *usecase.DueReminderUseCase
+	AccountFee         *usecase.AccountFeeUseCase
+	Overdraft          *usecase.OverdraftUseCase
+	Budget             *usecase.BudgetUseCase
+	StandingOrder      *usecase.StandingOrderUseCase
+	ScheduledTransfer  *usecase.ScheduledTransferUseCase
+	TransferSuggestion *usecase.TransferSuggestionUseCase
+	EmergencyFund      *usecase.EmergencyFundUseCase
+	KPI                *usecase.KPIUseCase
+	Dashboard          *usecase.DashboardUseCase
+	CategorySuggestion *usecase.CategorySuggestionUseCase
+	CategoryRule       *usecase.CategoryRuleUseCase
+	ProjectExport      *usecase.ProjectExpenseExportUseCase
+	Receipt            *usecase.ReceiptUseCase
+	StatementExport    *usecase.StatementExportUseCase
+	YearReviewExport   *usecase.YearReviewExportUseCase
+	Variance           *usecase.VarianceUseCase
+	Goal               *usecase.GoalUseCase
+	Investment         *usecase.InvestmentUseCase
+	PeriodLock         *usecase.PeriodLockUseCase
+	ReportSnapshot     *usecase.ReportSnapshotUseCase
+	Category           *usecase.CategoryUseCase
+	FormDraft          *usecase.FormDraftUseCase
 }
 
 func NewApp(ctx context.Context, useCases UseCases) *App {
-	return &App{
-		currentScreen:     DashboardScreen,
-		dashboardModel:    screen.NewDashboardModel(ctx, useCases.Account, useCases.Transaction, useCases.Bill),
-		accountsModel:     screen.NewAccountsModel(ctx, useCases.Account),
-		creditCardsModel:  screen.NewCreditCardsModel(ctx, useCases.CreditCard, useCases.CreditCardInvoice, useCases.Account),
-		billsModel:        screen.NewBillsModel(ctx, useCases.Bill),
-		transactionsModel: screen.NewTransactionsModel(ctx, useCases.Transaction, useCases.Account, useCases.CreditCard, useCases.CreditCardInvoice, useCases.Bill, useCases.Person),
-		peopleModel:       screen.NewPeopleModel(ctx, useCases.Person),
-		reportsModel:      screen.NewReportsModel(ctx, useCases.Report, useCases.Person, useCases.Bill),
-		ctx:               ctx,
+	a := &App{ctx: ctx}
+	a.setUseCases(useCases)
+	return a
+}
+
+// setUseCases builds every screen over useCases, starting from the dashboard
+func (a *App) setUseCases(useCases UseCases) {
+	ctx := a.ctx
+	a.currentScreen = DashboardScreen
+	a.dashboardModel = screen.NewDashboardModel(ctx, useCases.Account, useCases.Transaction, useCases.Bill, useCases.Subscription, useCases.EmergencyFund, useCases.KPI, useCases.Dashboard, useCases.DueReminder)
+	a.accountsModel = screen.NewAccountsModel(ctx, useCases.Account, useCases.Yield, useCases.Import, useCases.PendingPayment, useCases.AccountFee, useCases.StandingOrder, useCases.EmergencyFund, useCases.Overdraft, useCases.ScheduledTransfer, useCases.TransferSuggestion)
+	a.creditCardsModel = screen.NewCreditCardsModel(ctx, useCases.CreditCard, useCases.CreditCardInvoice, useCases.Account, useCases.InvoiceExport, useCases.InvoiceForecast, useCases.PendingPayment, useCases.Person, useCases.StatementExport)
+	a.billsModel = screen.NewBillsModel(ctx, useCases.Bill, useCases.SinkingFund, useCases.ChangeHistory, useCases.Report, useCases.FormDraft)
+	a.transactionsModel = screen.NewTransactionsModel(ctx, useCases.Transaction, useCases.Account, useCases.CreditCard, useCases.CreditCardInvoice, useCases.Bill, useCases.Person, useCases.Report, useCases.ChangeHistory, useCases.FilterPreset, useCases.CategorySuggestion, useCases.Receipt, useCases.FormDraft)
+	a.peopleModel = screen.NewPeopleModel(ctx, useCases.Person, useCases.PeopleExchange, useCases.Report)
+	a.reportsModel = screen.NewReportsModel(ctx, useCases.Report, useCases.Person, useCases.Bill, useCases.ProjectExport, useCases.StatementExport, useCases.YearReviewExport, useCases.Variance, useCases.PeriodLock, useCases.ReportSnapshot)
+	a.wishlistModel = screen.NewWishlistModel(ctx, useCases.Wishlist, useCases.Account, useCases.CreditCard)
+	a.inboxModel = screen.NewInboxModel(ctx, useCases.Inbox, useCases.Account, useCases.CreditCard)
+	a.categoriesModel = screen.NewCategoriesModel(ctx, useCases.CategoryAppearance, useCases.CategoryRule, useCases.Category)
+	a.budgetsModel = screen.NewBudgetsModel(ctx, useCases.Budget, useCases.Report)
+	a.goalsModel = screen.NewGoalsModel(ctx, useCases.Goal, useCases.Account)
+	a.investmentsModel = screen.NewInvestmentsModel(ctx, useCases.Investment)
+	a.macros = macroRecorder{useCase: useCases.Macro}
+	a.notifications = notificationCenter{useCase: useCases.Notification}
+}
+
+// SetRefreshInterval makes the screens that support it reload every interval while shown
+func (a *App) SetRefreshInterval(interval time.Duration) {
+	a.refreshInterval = interval
+	for _, model := range []tea.Model{a.dashboardModel, a.transactionsModel} {
+		if refresher, ok := model.(AutoRefresher); ok {
+			refresher.SetRefreshInterval(interval)
+		}
+	}
+}
+
+// SetItemsPerPage sets how many items the paged lists show at first
+func (a *App) SetItemsPerPage(n int) {
+	a.itemsPerPage = n
+	for _, model := range []tea.Model{a.transactionsModel} {
+		if sizer, ok := model.(PageSizer); ok {
+			sizer.SetItemsPerPage(n)
+		}
+	}
+}
+
+// SetBusinessMode lets business expenses be tagged with a client and project and
+// grouped by project in the reports
+func (a *App) SetBusinessMode(enabled bool) {
+	a.businessMode = enabled
+	for _, model := range []tea.Model{a.transactionsModel, a.reportsModel} {
+		if moder, ok := model.(BusinessModer); ok {
+			moder.SetBusinessMode(enabled)
+		}
 	}
 }
 
 func (a *App) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		a.dashboardModel.Init(),
+		a.categoriesModel.Init(),
 		tea.EnterAltScreen,
-	)
+		a.checkIdle(),
+	}
+	if a.macros.useCase != nil {
+		cmds = append(cmds, a.loadMacros)
+	}
+	if a.notifications.useCase != nil {
+		cmds = append(cmds, a.loadNotifications)
+	}
+	return tea.Batch(cmds...)
 }
 
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, handled := a.updateLock(msg); handled {
+		return a, cmd
+	}
+	if cmd, handled := a.updateMacros(msg); handled {
+		return a, cmd
+	}
+	if cmd, handled := a.updateNotifications(msg); handled {
+		return a, cmd
+	}
+	if cmd, handled := a.updateUnsaved(msg); handled {
+		return a, cmd
+	}
+	if cmd, handled := a.updateWorkspaces(msg); handled {
+		return a, cmd
+	}
+	if cmd, handled := a.updateStartup(msg); handled {
+		return a, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// Check if current screen is in form mode before handling navigation
-		var isInFormMode bool
-		switch a.currentScreen {
-		case AccountsScreen:
-			if checker, ok := a.accountsModel.(FormModeChecker); ok {
-				isInFormMode = checker.IsInFormMode()
-			}
-		case TransactionsScreen:
-			if checker, ok := a.transactionsModel.(FormModeChecker); ok {
-				isInFormMode = checker.IsInFormMode()
-			}
-		case CreditCardsScreen:
-			if checker, ok := a.creditCardsModel.(FormModeChecker); ok {
-				isInFormMode = checker.IsInFormMode()
-			}
-		case BillsScreen:
-			if checker, ok := a.billsModel.(FormModeChecker); ok {
-				isInFormMode = checker.IsInFormMode()
-			}
-			// Add other screens here when they implement forms
-		}
+		isInFormMode := a.inFormMode()
 
 		// Only handle menu navigation if not in form mode
 		if !isInFormMode {
@@ -127,6 +256,24 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "7":
 				a.currentScreen = ReportsScreen
 				return a, a.reportsModel.Init()
+			case "8":
+				a.currentScreen = WishlistScreen
+				return a, a.wishlistModel.Init()
+			case "9":
+				a.currentScreen = InboxScreen
+				return a, a.inboxModel.Init()
+			case "0":
+				a.currentScreen = CategoriesScreen
+				return a, a.categoriesModel.Init()
+			case "-":
+				a.currentScreen = BudgetsScreen
+				return a, a.budgetsModel.Init()
+			case "=":
+				a.currentScreen = GoalsScreen
+				return a, a.goalsModel.Init()
+			case "\\":
+				a.currentScreen = InvestmentsScreen
+				return a, a.investmentsModel.Init()
 			}
 		} else {
 			// Always allow quit even in form mode
@@ -136,6 +283,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if msg.String() == "ctrl+p" {
+			screen.TogglePrivacyMode()
+			return a, nil
+		}
+
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
 		a.height = msg.Height
@@ -146,6 +298,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case screen.BackToDashboardMsg:
 			a.currentScreen = DashboardScreen
 			return a, a.dashboardModel.Init()
+		case screen.CategoryAppearancesLoadedMsg:
+			var cmd tea.Cmd
+			a.categoriesModel, cmd = a.categoriesModel.Update(msg)
+			return a, cmd
 		}
 	}
 
@@ -165,12 +321,83 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.peopleModel, cmd = a.peopleModel.Update(msg)
 	case ReportsScreen:
 		a.reportsModel, cmd = a.reportsModel.Update(msg)
+	case WishlistScreen:
+		a.wishlistModel, cmd = a.wishlistModel.Update(msg)
+	case InboxScreen:
+		a.inboxModel, cmd = a.inboxModel.Update(msg)
+	case CategoriesScreen:
+		a.categoriesModel, cmd = a.categoriesModel.Update(msg)
+	case BudgetsScreen:
+		a.budgetsModel, cmd = a.budgetsModel.Update(msg)
+	case GoalsScreen:
+		a.goalsModel, cmd = a.goalsModel.Update(msg)
+	case InvestmentsScreen:
+		a.investmentsModel, cmd = a.investmentsModel.Update(msg)
 	}
 
 	return a, cmd
 }
 
+// inFormMode reports whether the current screen is editing something, when
+// the menu keys belong to the screen
+func (a *App) inFormMode() bool {
+	var isInFormMode bool
+	switch a.currentScreen {
+	case AccountsScreen:
+		if checker, ok := a.accountsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case TransactionsScreen:
+		if checker, ok := a.transactionsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case CreditCardsScreen:
+		if checker, ok := a.creditCardsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case BillsScreen:
+		if checker, ok := a.billsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case WishlistScreen:
+		if checker, ok := a.wishlistModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case InboxScreen:
+		if checker, ok := a.inboxModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case CategoriesScreen:
+		if checker, ok := a.categoriesModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case BudgetsScreen:
+		if checker, ok := a.budgetsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case ReportsScreen:
+		if checker, ok := a.reportsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case GoalsScreen:
+		if checker, ok := a.goalsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+	case InvestmentsScreen:
+		if checker, ok := a.investmentsModel.(FormModeChecker); ok {
+			isInFormMode = checker.IsInFormMode()
+		}
+		// Add other screens here when they implement forms
+	}
+	return isInFormMode
+}
+
 func (a *App) View() string {
+	a.startup.markDrawn()
+	if a.lock.locked {
+		return a.renderLockScreen()
+	}
+
 	header := a.renderHeader()
 
 	var content string
@@ -189,16 +416,46 @@ func (a *App) View() string {
 		content = a.peopleModel.View()
 	case ReportsScreen:
 		content = a.reportsModel.View()
+	case WishlistScreen:
+		content = a.wishlistModel.View()
+	case InboxScreen:
+		content = a.inboxModel.View()
+	case CategoriesScreen:
+		content = a.categoriesModel.View()
+	case BudgetsScreen:
+		content = a.budgetsModel.View()
+	case GoalsScreen:
+		content = a.goalsModel.View()
+	case InvestmentsScreen:
+		content = a.investmentsModel.View()
 	}
 
-	help := a.renderHelp()
+	// The macro prompts and the notifications cover whichever screen is shown
+	if a.notifications.open {
+		content = a.renderNotifications()
+	}
+	if a.workspaces.picking {
+		content = a.renderWorkspacePicker()
+	}
+	if a.macros.naming {
+		content = a.renderMacroPrompt()
+	} else if a.macros.listing {
+		content = a.renderMacroList()
+	}
+	if a.unsaved.confirming {
+		content = a.renderUnsavedPrompt()
+	}
 
-	return lipgloss.JoinVertical(
-		lipgloss.Top,
-		header,
-		content,
-		help,
-	)
+	sections := []string{header, content}
+	if status := a.renderMacroStatus(); status != "" {
+		sections = append(sections, status)
+	}
+	if warnings := a.renderStartupWarnings(); warnings != "" {
+		sections = append(sections, warnings)
+	}
+	sections = append(sections, a.renderHelp())
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
 
 func (a *App) renderHeader() string {
@@ -210,6 +467,12 @@ func (a *App) renderHeader() string {
 		"[5] Transactions",
 		"[6] People",
 		"[7] Reports",
+		"[8] Wishlist",
+		"[9] Inbox",
+		"[0] Categories",
+		"[-] Budgets",
+		"[=] Goals",
+		"[\\] Investments",
 	}
 
 	for i, item := range menu {
@@ -223,6 +486,15 @@ func (a *App) renderHeader() string {
 	menuBar := lipgloss.JoinHorizontal(lipgloss.Top, menu...)
 
 	title := style.TitleStyle.Render("💰 FinanCLI - Personal Finance Manager")
+	if screen.PrivacyMode() {
+		title = lipgloss.JoinHorizontal(lipgloss.Center, title, style.WarningStyle.Render("  🙈 Privacy mode"))
+	}
+	if a.workspaces.enabled() {
+		title = lipgloss.JoinHorizontal(lipgloss.Center, title, style.InfoStyle.Render("  📁 "+a.workspaces.current))
+	}
+	if unread := a.notifications.unread(); unread > 0 {
+		title = lipgloss.JoinHorizontal(lipgloss.Center, title, style.InfoStyle.Render(fmt.Sprintf("  🔔 %d", unread)))
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
@@ -238,7 +510,19 @@ func (a *App) renderHeader() string {
 }
 
 func (a *App) renderHelp() string {
-	help := "[q] Quit • [1-7] Navigate • [↑/↓] Select • [Enter] Confirm • [Esc] Cancel"
+	help := "[q] Quit • [0-9/-/=/\\] Navigate • [↑/↓] Select • [Enter] Confirm • [Esc] Cancel • [Ctrl+P] Privacy"
+	if a.macros.useCase != nil {
+		help += " • [Ctrl+R] Record Macro • [Alt+1-9] Play • [Ctrl+K] Macros"
+	}
+	if a.notifications.useCase != nil {
+		help += " • [Ctrl+N] Notifications"
+	}
+	if a.workspaces.enabled() {
+		help += " • [Ctrl+W] Workspaces"
+	}
+	if a.lock.enabled() {
+		help += " • [Ctrl+L] Lock"
+	}
 	return style.HelpStyle.
 		Width(a.width).
 		Align(lipgloss.Center).
@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// idleCheckInterval is how often the app checks whether it has been idle long enough to lock
+const idleCheckInterval = 15 * time.Second
+
+// Wrong passcodes make the next attempt wait, doubling from firstRetryDelay up
+// to maxRetryDelay, so guessing it takes too long to be worth it
+const (
+	firstRetryDelay = time.Second
+	maxRetryDelay   = 5 * time.Minute
+)
+
+type idleCheckMsg struct{}
+
+// lockRetryMsg redraws the lock screen once another attempt is allowed
+type lockRetryMsg struct{}
+
+// passcodeLock hides the screens behind a passcode prompt on launch and after
+// the terminal has been left idle
+type passcodeLock struct {
+	hash         string
+	idleTimeout  time.Duration
+	locked       bool
+	input        string
+	failed       bool
+	failures     int       // Wrong passcodes in a row
+	retryAt      time.Time // No attempt is checked before then
+	lastActivity time.Time
+}
+
+func (l *passcodeLock) enabled() bool {
+	return l.hash != ""
+}
+
+func (l *passcodeLock) lock() {
+	l.locked = true
+	l.input = ""
+	l.failed = false
+}
+
+func (l *passcodeLock) verify(passcode string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(l.hash), []byte(passcode)) == nil
+}
+
+// retryDelay is how long the lock waits after the latest wrong passcode
+func (l *passcodeLock) retryDelay() time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < l.failures && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// SetPasscodeLock requires the passcode whose bcrypt hash is hash on launch and
+// after idleTimeout without input. An empty hash disables the lock and a zero
+// timeout disables the auto-lock.
+func (a *App) SetPasscodeLock(hash string, idleTimeout time.Duration) {
+	a.lock = passcodeLock{
+		hash:         hash,
+		idleTimeout:  idleTimeout,
+		locked:       hash != "",
+		lastActivity: time.Now(),
+	}
+}
+
+func (a *App) checkIdle() tea.Cmd {
+	if !a.lock.enabled() || a.lock.idleTimeout <= 0 {
+		return nil
+	}
+	return tea.Tick(idleCheckInterval, func(time.Time) tea.Msg {
+		return idleCheckMsg{}
+	})
+}
+
+// updateLock handles the messages owned by the lock, reporting whether msg was
+// consumed. Keys never reach the screens while locked.
+func (a *App) updateLock(msg tea.Msg) (tea.Cmd, bool) {
+	if !a.lock.enabled() {
+		return nil, false
+	}
+
+	switch msg := msg.(type) {
+	case idleCheckMsg:
+		if !a.lock.locked && time.Since(a.lock.lastActivity) >= a.lock.idleTimeout {
+			a.lock.lock()
+		}
+		return a.checkIdle(), true
+
+	case lockRetryMsg:
+		return nil, true
+
+	case tea.MouseMsg:
+		a.lock.lastActivity = time.Now()
+		return nil, a.lock.locked
+
+	case tea.KeyMsg:
+		a.lock.lastActivity = time.Now()
+		if !a.lock.locked {
+			if msg.String() == "ctrl+l" {
+				a.lock.lock()
+				return nil, true
+			}
+			return nil, false
+		}
+
+		switch msg.String() {
+		case "ctrl+c":
+			return tea.Quit, true
+		case "enter":
+			if time.Now().Before(a.lock.retryAt) {
+				return nil, true
+			}
+			if a.lock.verify(a.lock.input) {
+				a.lock.locked = false
+				a.lock.failed = false
+				a.lock.failures = 0
+				a.lock.input = ""
+				return nil, true
+			}
+			a.lock.failed = true
+			a.lock.failures++
+			a.lock.input = ""
+			delay := a.lock.retryDelay()
+			a.lock.retryAt = time.Now().Add(delay)
+			return tea.Tick(delay, func(time.Time) tea.Msg { return lockRetryMsg{} }), true
+		case "backspace":
+			_, size := utf8.DecodeLastRuneInString(a.lock.input)
+			a.lock.input = a.lock.input[:len(a.lock.input)-size]
+		default:
+			if msg.Type == tea.KeyRunes && !msg.Alt {
+				a.lock.input += string(msg.Runes)
+			}
+		}
+		return nil, true
+	}
+
+	return nil, false
+}
+
+func (a *App) renderLockScreen() string {
+	title := style.TitleStyle.Render("💰 FinanCLI - Personal Finance Manager")
+
+	prompt := lipgloss.NewStyle().Bold(true).Foreground(style.Text).Render("🔒 Locked — enter your passcode")
+	input := style.FocusedInputStyle.Width(30).Render(strings.Repeat("•", len([]rune(a.lock.input))))
+
+	lines := []string{prompt, "", input}
+	if wait := time.Until(a.lock.retryAt); wait > 0 {
+		lines = append(lines, "", style.ErrorStyle.Render(fmt.Sprintf("Wrong passcode — try again in %s", wait.Truncate(time.Second)+time.Second)))
+	} else if a.lock.failed {
+		lines = append(lines, "", style.ErrorStyle.Render("Wrong passcode"))
+	}
+
+	box := style.BorderStyle.Padding(1, 3).Render(lipgloss.JoinVertical(lipgloss.Center, lines...))
+	help := style.HelpStyle.MarginTop(1).Render("[Enter] Unlock • [Ctrl+C] Quit")
+
+	content := lipgloss.JoinVertical(lipgloss.Center, title, box, help)
+	if a.width == 0 || a.height == 0 {
+		return content
+	}
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, content)
+}
@@ -0,0 +1,334 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// macroKeyDelay spaces out replayed keystrokes so the data a key loads can
+// arrive before the next one, as it would when typing
+const macroKeyDelay = 50 * time.Millisecond
+
+type macrosLoadedMsg struct {
+	macros []*entity.Macro
+}
+
+type macroSavedMsg struct {
+	macro *entity.Macro
+}
+
+type macroDeletedMsg struct{}
+
+type macroErrMsg struct {
+	err error
+}
+
+// macroKeyMsg is a replayed keystroke, kept apart from typed keys so replays
+// aren't recorded into the macro being recorded
+type macroKeyMsg struct {
+	key tea.KeyMsg
+}
+
+// macroRecorder records keystrokes as named macros bound to Alt+1 to Alt+9.
+// Recording happens at the app level, so a macro can switch screens and
+// drive any form.
+type macroRecorder struct {
+	useCase *usecase.MacroUseCase
+	macros  []*entity.Macro
+
+	recording bool
+	replaying bool
+	keys      []entity.MacroKey
+
+	// Naming prompt shown when recording stops
+	naming bool
+	name   string
+	slot   int
+
+	// Macro list
+	listing  bool
+	selected int
+
+	message string
+	err     error
+}
+
+func (r *macroRecorder) bySlot(slot int) *entity.Macro {
+	for _, macro := range r.macros {
+		if macro.Slot == slot {
+			return macro
+		}
+	}
+	return nil
+}
+
+// freeSlot is the first slot without a macro, or 1 when they are all taken
+func (r *macroRecorder) freeSlot() int {
+	for slot := 1; slot <= entity.MaxMacroSlot; slot++ {
+		if r.bySlot(slot) == nil {
+			return slot
+		}
+	}
+	return 1
+}
+
+func (a *App) loadMacros() tea.Msg {
+	macros, err := a.macros.useCase.ListMacros(a.ctx)
+	if err != nil {
+		return macroErrMsg{err: err}
+	}
+	return macrosLoadedMsg{macros: macros}
+}
+
+// updateMacros handles the messages owned by the macro recorder, reporting
+// whether msg was consumed. Keys pass through to the screens while recording.
+func (a *App) updateMacros(msg tea.Msg) (tea.Cmd, bool) {
+	r := &a.macros
+	if r.useCase == nil {
+		return nil, false
+	}
+
+	switch msg := msg.(type) {
+	case macrosLoadedMsg:
+		r.macros = msg.macros
+		if r.selected >= len(r.macros) {
+			r.selected = 0
+		}
+		return nil, true
+
+	case macroSavedMsg:
+		r.message = fmt.Sprintf("Saved macro %q on Alt+%d", msg.macro.Name, msg.macro.Slot)
+		return a.loadMacros, true
+
+	case macroDeletedMsg:
+		return a.loadMacros, true
+
+	case macroErrMsg:
+		r.err = msg.err
+		return nil, true
+
+	case macroKeyMsg:
+		r.replaying = true
+		_, cmd := a.Update(msg.key)
+		r.replaying = false
+		return cmd, true
+
+	case tea.KeyMsg:
+		if r.naming {
+			return a.handleMacroNameKeys(msg), true
+		}
+		if r.listing {
+			return a.handleMacroListKeys(msg), true
+		}
+		if r.replaying {
+			return nil, false
+		}
+
+		// Messages last until the next key
+		r.message = ""
+		r.err = nil
+
+		key := msg.String()
+		switch {
+		case key == "ctrl+r":
+			if !r.recording {
+				r.recording = true
+				r.keys = nil
+				return nil, true
+			}
+
+			r.recording = false
+			if len(r.keys) == 0 {
+				r.message = "Nothing was recorded"
+				return nil, true
+			}
+			r.naming = true
+			r.name = ""
+			r.slot = r.freeSlot()
+			return nil, true
+
+		case key == "ctrl+k" && !r.recording:
+			r.listing = true
+			return nil, true
+
+		case msg.Alt && len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '9':
+			if r.recording {
+				r.message = "Stop recording before replaying a macro"
+				return nil, true
+			}
+			macro := r.bySlot(int(msg.Runes[0] - '0'))
+			if macro == nil {
+				r.message = fmt.Sprintf("No macro on Alt+%c", msg.Runes[0])
+				return nil, true
+			}
+			return replayMacro(macro), true
+		}
+
+		if r.recording {
+			r.keys = append(r.keys, entity.MacroKey{Type: int(msg.Type), Runes: string(msg.Runes), Alt: msg.Alt})
+		}
+	}
+
+	return nil, false
+}
+
+func replayMacro(macro *entity.Macro) tea.Cmd {
+	cmds := make([]tea.Cmd, len(macro.Keys))
+	for i, key := range macro.Keys {
+		msg := macroKeyMsg{key: tea.KeyMsg{Type: tea.KeyType(key.Type), Runes: []rune(key.Runes), Alt: key.Alt}}
+		cmds[i] = tea.Tick(macroKeyDelay, func(time.Time) tea.Msg { return msg })
+	}
+	return tea.Sequence(cmds...)
+}
+
+func (a *App) handleMacroNameKeys(msg tea.KeyMsg) tea.Cmd {
+	r := &a.macros
+
+	switch msg.String() {
+	case "esc":
+		r.naming = false
+		r.keys = nil
+		r.message = "Recording discarded"
+	case "tab":
+		r.slot = r.slot%entity.MaxMacroSlot + 1
+	case "enter":
+		if strings.TrimSpace(r.name) == "" {
+			r.err = fmt.Errorf("macro name is required")
+			return nil
+		}
+		name, slot, keys := r.name, r.slot, r.keys
+		r.naming = false
+		r.keys = nil
+		r.err = nil
+		return func() tea.Msg {
+			macro, err := r.useCase.SaveMacro(a.ctx, name, slot, keys)
+			if err != nil {
+				return macroErrMsg{err: err}
+			}
+			return macroSavedMsg{macro: macro}
+		}
+	case "backspace":
+		if runes := []rune(r.name); len(runes) > 0 {
+			r.name = string(runes[:len(runes)-1])
+		}
+	default:
+		if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+			r.name += string(msg.Runes)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) handleMacroListKeys(msg tea.KeyMsg) tea.Cmd {
+	r := &a.macros
+
+	switch msg.String() {
+	case "esc", "ctrl+k", "b":
+		r.listing = false
+	case "up", "k":
+		if r.selected > 0 {
+			r.selected--
+		}
+	case "down", "j":
+		if r.selected < len(r.macros)-1 {
+			r.selected++
+		}
+	case "d":
+		if r.selected < len(r.macros) {
+			macro := r.macros[r.selected]
+			return func() tea.Msg {
+				if err := r.useCase.DeleteMacro(a.ctx, macro.ID); err != nil {
+					return macroErrMsg{err: err}
+				}
+				return macroDeletedMsg{}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *App) renderMacroPrompt() string {
+	r := &a.macros
+
+	var lines []string
+	lines = append(lines, style.TitleStyle.Render("⏺ Save Macro"))
+	lines = append(lines, fmt.Sprintf("%d keystrokes recorded", len(r.keys)))
+	if r.err != nil {
+		lines = append(lines, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", r.err)))
+	}
+
+	hotkey := fmt.Sprintf("Alt+%d", r.slot)
+	if existing := r.bySlot(r.slot); existing != nil {
+		hotkey += style.WarningStyle.Render(fmt.Sprintf("  (replaces %q)", existing.Name))
+	}
+
+	lines = append(lines,
+		"",
+		style.HeaderStyle.Render("Name:"),
+		style.FocusedInputStyle.Width(40).Render(r.name),
+		"",
+		"Hotkey: "+hotkey,
+	)
+	lines = append(lines, style.HelpStyle.MarginTop(1).Render("[Enter] Save • [Tab] Change Hotkey • [Esc] Discard"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (a *App) renderMacroList() string {
+	r := &a.macros
+
+	var lines []string
+	lines = append(lines, style.TitleStyle.Render("⏺ Macros"))
+	if r.err != nil {
+		lines = append(lines, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", r.err)))
+	}
+
+	if len(r.macros) == 0 {
+		lines = append(lines, style.InfoStyle.Render("No macros yet. Press Ctrl+R to start recording and Ctrl+R again to stop."))
+	} else {
+		for i, macro := range r.macros {
+			line := fmt.Sprintf("Alt+%d  %-30s %3d keys", macro.Slot, truncateName(macro.Name, 30), len(macro.Keys))
+			if i == r.selected {
+				lines = append(lines, style.SelectedMenuItemStyle.Render("► "+line))
+			} else {
+				lines = append(lines, style.MenuItemStyle.Render("  "+line))
+			}
+		}
+	}
+
+	lines = append(lines, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [d] Delete • [Esc] Close"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderMacroStatus shows the recording indicator and the last macro message
+func (a *App) renderMacroStatus() string {
+	r := &a.macros
+	switch {
+	case r.recording:
+		return style.ErrorStyle.Render(fmt.Sprintf("⏺ Recording macro (%d keys) — Ctrl+R to stop", len(r.keys)))
+	case r.err != nil:
+		return style.ErrorStyle.Render(fmt.Sprintf("Macro error: %v", r.err))
+	case r.message != "":
+		return style.InfoStyle.Render(r.message)
+	}
+	return ""
+}
+
+func truncateName(name string, length int) string {
+	runes := []rune(name)
+	if len(runes) <= length {
+		return name
+	}
+	return string(runes[:length-3]) + "..."
+}
@@ -0,0 +1,203 @@
+package tui
+
+import (
+	"fmt"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type notificationsLoadedMsg struct {
+	notifications []*entity.Notification
+}
+
+type notificationChangedMsg struct{}
+
+type notificationErrMsg struct {
+	err error
+}
+
+// notificationCenter lists the notifications, such as the weekly digest, over
+// whichever screen is shown
+type notificationCenter struct {
+	useCase       *usecase.NotificationUseCase
+	notifications []*entity.Notification
+
+	open     bool
+	selected int
+	// Notification being read, nil while on the list
+	viewing *entity.Notification
+
+	err error
+}
+
+func (c *notificationCenter) unread() int {
+	count := 0
+	for _, notification := range c.notifications {
+		if !notification.IsRead() {
+			count++
+		}
+	}
+	return count
+}
+
+func (a *App) loadNotifications() tea.Msg {
+	notifications, err := a.notifications.useCase.ListNotifications(a.ctx)
+	if err != nil {
+		return notificationErrMsg{err: err}
+	}
+	return notificationsLoadedMsg{notifications: notifications}
+}
+
+// updateNotifications handles the messages owned by the notifications center,
+// reporting whether msg was consumed
+func (a *App) updateNotifications(msg tea.Msg) (tea.Cmd, bool) {
+	c := &a.notifications
+	if c.useCase == nil {
+		return nil, false
+	}
+
+	switch msg := msg.(type) {
+	case notificationsLoadedMsg:
+		c.notifications = msg.notifications
+		if c.selected >= len(c.notifications) {
+			c.selected = 0
+		}
+		return nil, true
+
+	case notificationChangedMsg:
+		return a.loadNotifications, true
+
+	case notificationErrMsg:
+		c.err = msg.err
+		return nil, true
+
+	case tea.KeyMsg:
+		if c.open {
+			return a.handleNotificationKeys(msg), true
+		}
+		if msg.String() == "ctrl+n" {
+			c.open = true
+			c.err = nil
+			return a.loadNotifications, true
+		}
+	}
+
+	return nil, false
+}
+
+func (a *App) handleNotificationKeys(msg tea.KeyMsg) tea.Cmd {
+	c := &a.notifications
+
+	if c.viewing != nil {
+		switch msg.String() {
+		case "esc", "enter", "b":
+			c.viewing = nil
+		case "ctrl+n":
+			c.viewing = nil
+			c.open = false
+		}
+		return nil
+	}
+
+	switch msg.String() {
+	case "esc", "ctrl+n", "b":
+		c.open = false
+	case "up", "k":
+		if c.selected > 0 {
+			c.selected--
+		}
+	case "down", "j":
+		if c.selected < len(c.notifications)-1 {
+			c.selected++
+		}
+	case "enter":
+		if c.selected < len(c.notifications) {
+			notification := c.notifications[c.selected]
+			c.viewing = notification
+			if notification.IsRead() {
+				return nil
+			}
+			return func() tea.Msg {
+				if err := c.useCase.MarkRead(a.ctx, notification); err != nil {
+					return notificationErrMsg{err: err}
+				}
+				return notificationChangedMsg{}
+			}
+		}
+	case "d":
+		if c.selected < len(c.notifications) {
+			notification := c.notifications[c.selected]
+			return func() tea.Msg {
+				if err := c.useCase.DeleteNotification(a.ctx, notification.ID); err != nil {
+					return notificationErrMsg{err: err}
+				}
+				return notificationChangedMsg{}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *App) renderNotifications() string {
+	c := &a.notifications
+
+	if c.viewing != nil {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			style.TitleStyle.Render(severityIcon(c.viewing.Severity)+" "+c.viewing.Title),
+			style.HelpStyle.Render(c.viewing.CreatedAt.Format("02/01/2006 15:04")),
+			lipgloss.NewStyle().MarginTop(1).Render(c.viewing.Body),
+			style.HelpStyle.MarginTop(1).Render("[Esc] Back"),
+		)
+	}
+
+	var lines []string
+	lines = append(lines, style.TitleStyle.Render("🔔 Notifications"))
+	if c.err != nil {
+		lines = append(lines, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", c.err)))
+	}
+
+	if len(c.notifications) == 0 {
+		lines = append(lines, style.InfoStyle.Render("No notifications yet. The weekly digest arrives here every Monday."))
+	} else {
+		for i, notification := range c.notifications {
+			marker := "•"
+			if notification.IsRead() {
+				marker = " "
+			}
+			line := fmt.Sprintf("%s %s %s  %s", marker, severityIcon(notification.Severity), notification.CreatedAt.Format("02/01/2006"), truncateName(notification.Title, 60))
+			switch {
+			case i == c.selected:
+				lines = append(lines, style.SelectedMenuItemStyle.Render("► "+line))
+			case notification.IsRead():
+				lines = append(lines, style.MenuItemStyle.Render("  "+line))
+			case notification.Severity == entity.NotificationSeverityCritical:
+				lines = append(lines, style.MenuItemStyle.Foreground(style.Danger).Render("  "+line))
+			case notification.Severity == entity.NotificationSeverityWarning:
+				lines = append(lines, style.MenuItemStyle.Foreground(style.Warning).Render("  "+line))
+			default:
+				lines = append(lines, style.MenuItemStyle.Render("  "+line))
+			}
+		}
+	}
+
+	lines = append(lines, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [Enter] Read • [d] Delete • [Esc] Close"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func severityIcon(severity entity.NotificationSeverity) string {
+	switch severity {
+	case entity.NotificationSeverityCritical:
+		return "🚨"
+	case entity.NotificationSeverityWarning:
+		return "⚠️"
+	default:
+		return "🔔"
+	}
+}
@@ -0,0 +1,214 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// emergencyFundModel edits the emergency fund plan: the essential expenses it
+// covers, the target in months and the accounts that hold it
+type emergencyFundModel struct {
+	status *usecase.EmergencyFundStatus
+
+	// Form: 0: essential expenses, 1: target months, 2: accounts, 3: save, 4: cancel
+	focusedField   int
+	essentialInput string
+	monthsInput    string
+	accountCursor  int
+	holds          map[uuid.UUID]bool
+	err            error
+}
+
+type emergencyFundLoadedMsg struct {
+	status *usecase.EmergencyFundStatus
+}
+
+func (m *AccountsModel) openEmergencyFund() (tea.Model, tea.Cmd) {
+	m.emergency = &emergencyFundModel{}
+	m.viewMode = AccountViewEmergencyFund
+	m.loading = true
+	return m, m.loadEmergencyFund
+}
+
+func (m *AccountsModel) loadEmergencyFund() tea.Msg {
+	status, err := m.emergencyUseCase.GetStatus(m.ctx, time.Now())
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return emergencyFundLoadedMsg{status: status}
+}
+
+// setStatus fills the form from the loaded plan
+func (e *emergencyFundModel) setStatus(status *usecase.EmergencyFundStatus) {
+	e.status = status
+	e.essentialInput = ""
+	if !status.Plan.IsInferred() {
+		e.essentialInput = strconv.FormatFloat(status.Plan.EssentialMonthly.Amount(), 'f', 2, 64)
+	}
+	e.monthsInput = strconv.Itoa(status.Plan.TargetMonths)
+	e.holds = make(map[uuid.UUID]bool)
+	for _, id := range status.Plan.AccountIDs {
+		e.holds[id] = true
+	}
+}
+
+func (m *AccountsModel) handleEmergencyFundKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	e := m.emergency
+	if e.status == nil {
+		if msg.String() == "esc" {
+			m.emergency = nil
+			m.viewMode = AccountViewList
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.emergency = nil
+		m.viewMode = AccountViewList
+	case "tab", "down":
+		e.focusedField = (e.focusedField + 1) % 5
+	case "shift+tab", "up":
+		e.focusedField = (e.focusedField - 1 + 5) % 5
+	case "enter":
+		switch e.focusedField {
+		case 3:
+			return m.submitEmergencyFund()
+		case 4:
+			m.emergency = nil
+			m.viewMode = AccountViewList
+		}
+	default:
+		switch e.focusedField {
+		case 0:
+			e.essentialInput = editAmountInput(e.essentialInput, msg)
+		case 1:
+			e.monthsInput = editDigitsInput(e.monthsInput, msg, 2)
+		case 2:
+			switch msg.String() {
+			case "left", "right":
+				e.accountCursor = cycleOption(e.accountCursor, len(m.accounts), msg.String())
+			case " ":
+				id := m.accounts[e.accountCursor].ID
+				e.holds[id] = !e.holds[id]
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) submitEmergencyFund() (tea.Model, tea.Cmd) {
+	e := m.emergency
+
+	var essential float64
+	if e.essentialInput != "" {
+		amount, err := strconv.ParseFloat(e.essentialInput, 64)
+		if err != nil || amount < 0 {
+			e.err = fmt.Errorf("invalid essential expenses")
+			return m, nil
+		}
+		essential = amount
+	}
+	months, err := strconv.Atoi(e.monthsInput)
+	if err != nil {
+		e.err = fmt.Errorf("invalid target months")
+		return m, nil
+	}
+
+	var accountIDs []uuid.UUID
+	for _, account := range m.accounts {
+		if e.holds[account.ID] {
+			accountIDs = append(accountIDs, account.ID)
+		}
+	}
+
+	e.err = nil
+	return m, func() tea.Msg {
+		if _, err := m.emergencyUseCase.SavePlan(m.ctx, essential, months, accountIDs); err != nil {
+			return errMsg{err: err}
+		}
+		return m.loadEmergencyFund()
+	}
+}
+
+func (m *AccountsModel) renderEmergencyFund() string {
+	e := m.emergency
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🛟 Emergency Fund"))
+	if e.status == nil {
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	status := e.status
+	if status.EssentialMonthly > 0 {
+		summary := []string{
+			fmt.Sprintf("Saved:              %s", formatAmount(status.Saved)),
+			fmt.Sprintf("Essential expenses: %s a month", formatAmount(status.EssentialMonthly)),
+			fmt.Sprintf("Coverage:           %.1f of %d months (%s)", status.MonthsCovered(), status.Plan.TargetMonths, formatAmount(status.TargetAmount())),
+		}
+		if missing := status.Missing(); missing > 0 {
+			summary = append(summary, style.WarningStyle.Render(fmt.Sprintf("Missing:            %s", formatAmount(missing))))
+		} else {
+			summary = append(summary, style.SuccessStyle.Render("Target reached"))
+		}
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(summary, "\n")))
+	}
+
+	if e.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", e.err)))
+	}
+
+	essentialLabel := e.essentialInput
+	if essentialLabel == "" && e.focusedField != 0 {
+		essentialLabel = fmt.Sprintf("inferred: %s", formatAmount(status.EssentialMonthly))
+	}
+	fields := []string{
+		renderTextField("Essential / Month:", essentialLabel, e.focusedField == 0),
+		renderTextField("Target (months):", e.monthsInput, e.focusedField == 1),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Leave the essential expenses blank to average the last 3 months of food, transportation, utilities and healthcare"))
+
+	// Accounts holding the fund; none checked counts every savings account
+	var accounts []string
+	for i, account := range m.accounts {
+		check := "[ ]"
+		if e.holds[account.ID] {
+			check = "[x]"
+		}
+		line := fmt.Sprintf("%s %-25s %14s", check, truncateString(account.Name, 25), formatMoney(account.Balance))
+		if e.focusedField == 2 && i == e.accountCursor {
+			accounts = append(accounts, style.SelectedMenuItemStyle.Render("► "+line))
+		} else {
+			accounts = append(accounts, style.MenuItemStyle.Render("  "+line))
+		}
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render("Accounts holding the fund:\n"+strings.Join(accounts, "\n")))
+	if !anyHeld(e.holds) {
+		sections = append(sections, style.HelpStyle.Render("None checked: every savings account counts"))
+	}
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", e.focusedField, 3)))
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [←/→] Choose Account • [Space] Toggle • [Enter] Confirm • [Esc] Back"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func anyHeld(holds map[uuid.UUID]bool) bool {
+	for _, held := range holds {
+		if held {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,291 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// accountFeesModel manages the monthly fees of the selected account and the
+// yearly report of the fees paid by every account
+type accountFeesModel struct {
+	selected int
+
+	// Fee form: 0: name, 1: amount, 2: day, 3: save, 4: cancel
+	adding       bool
+	focusedField int
+	nameInput    string
+	amountInput  string
+	dayInput     string
+	err          error
+
+	reportYear int
+	report     *usecase.FeesReport
+}
+
+type feesChangedMsg struct{}
+
+type feesReportLoadedMsg struct {
+	report *usecase.FeesReport
+}
+
+func (m *AccountsModel) openFees() (tea.Model, tea.Cmd) {
+	m.fees = &accountFeesModel{}
+	m.viewMode = AccountViewFees
+	return m, nil
+}
+
+func (m *AccountsModel) openFeesReport() (tea.Model, tea.Cmd) {
+	m.fees = &accountFeesModel{reportYear: time.Now().Year()}
+	m.viewMode = AccountViewFeesReport
+	m.loading = true
+	return m, m.loadFeesReport(m.fees.reportYear)
+}
+
+func (m *AccountsModel) loadFeesReport(year int) tea.Cmd {
+	return func() tea.Msg {
+		report, err := m.feeUseCase.GetFeesReport(m.ctx, year)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return feesReportLoadedMsg{report: report}
+	}
+}
+
+func (m *AccountsModel) closeFees() {
+	m.fees = nil
+	m.viewMode = AccountViewList
+}
+
+func (m *AccountsModel) handleFeesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	fees := m.fees
+	if fees.adding {
+		return m.handleFeeFormKeys(msg)
+	}
+
+	account := m.accounts[m.selectedIndex]
+	switch msg.String() {
+	case "esc", "b":
+		m.closeFees()
+	case "up", "k":
+		if fees.selected > 0 {
+			fees.selected--
+		}
+	case "down", "j":
+		if fees.selected < len(account.Fees)-1 {
+			fees.selected++
+		}
+	case "n":
+		fees.adding = true
+		fees.focusedField = 0
+		fees.nameInput = ""
+		fees.amountInput = ""
+		fees.dayInput = ""
+		fees.err = nil
+	case "d":
+		if fees.selected < len(account.Fees) {
+			feeID := account.Fees[fees.selected].ID
+			return m, func() tea.Msg {
+				if err := m.feeUseCase.RemoveFee(m.ctx, account.ID, feeID); err != nil {
+					return errMsg{err: err}
+				}
+				return feesChangedMsg{}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) handleFeeFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	fees := m.fees
+
+	switch msg.String() {
+	case "esc":
+		fees.adding = false
+	case "tab", "down":
+		fees.focusedField = (fees.focusedField + 1) % 5
+	case "shift+tab", "up":
+		fees.focusedField = (fees.focusedField - 1 + 5) % 5
+	case "enter":
+		switch fees.focusedField {
+		case 3:
+			return m.submitFee()
+		case 4:
+			fees.adding = false
+		}
+	default:
+		switch fees.focusedField {
+		case 0:
+			fees.nameInput = editTextInput(fees.nameInput, msg)
+		case 1:
+			fees.amountInput = editAmountInput(fees.amountInput, msg)
+		case 2:
+			if msg.String() == "backspace" || (len(msg.Runes) == 1 && msg.Runes[0] >= '0' && msg.Runes[0] <= '9') {
+				fees.dayInput = editTextInput(fees.dayInput, msg)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) submitFee() (tea.Model, tea.Cmd) {
+	fees := m.fees
+
+	amount, err := strconv.ParseFloat(fees.amountInput, 64)
+	if err != nil || amount <= 0 {
+		fees.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+	day, err := strconv.Atoi(fees.dayInput)
+	if err != nil || day < 1 || day > 31 {
+		fees.err = fmt.Errorf("charge day must be between 1 and 31")
+		return m, nil
+	}
+
+	account := m.accounts[m.selectedIndex]
+	name := fees.nameInput
+	fees.adding = false
+	fees.err = nil
+	return m, func() tea.Msg {
+		if _, err := m.feeUseCase.AddFee(m.ctx, account.ID, name, amount, day); err != nil {
+			return errMsg{err: err}
+		}
+		return feesChangedMsg{}
+	}
+}
+
+func (m *AccountsModel) handleFeesReportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.closeFees()
+	case "left", "right":
+		if msg.String() == "left" {
+			m.fees.reportYear--
+		} else if m.fees.reportYear < time.Now().Year() {
+			m.fees.reportYear++
+		} else {
+			return m, nil
+		}
+		m.loading = true
+		return m, m.loadFeesReport(m.fees.reportYear)
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) renderFees() string {
+	fees := m.fees
+	account := m.accounts[m.selectedIndex]
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🧾 Monthly Fees of %s", account.Name)))
+
+	if fees.adding {
+		if fees.err != nil {
+			sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", fees.err)))
+		}
+		fields := []string{
+			renderTextField("Name:", fees.nameInput, fees.focusedField == 0),
+			renderTextField("Amount:", fees.amountInput, fees.focusedField == 1),
+			renderTextField("Charge Day (1-31):", fees.dayInput, fees.focusedField == 2),
+		}
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+		sections = append(sections, style.HelpStyle.Render("The first charge is posted on the next charge day"))
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Add Fee", fees.focusedField, 3)))
+		sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [Enter] Confirm • [Esc] Cancel"))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	if len(account.Fees) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No fees on this account. Press 'n' to add the maintenance or package fee your bank charges."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-25s %14s %6s %14s", "Fee", "Amount", "Day", "Per Year"))}
+		var monthly float64
+		for i, fee := range account.Fees {
+			monthly += fee.Amount.Amount()
+			row := fmt.Sprintf("%-25s %14s %6d %14s", truncateString(fee.Name, 25), formatMoney(fee.Amount), fee.DayOfMonth, formatAmount(fee.Amount.Amount()*12))
+			if i == fees.selected {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► "+row))
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  "+row))
+			}
+		}
+		rows = append(rows, "", style.WarningStyle.Render(fmt.Sprintf("Total: %s a month, %s a year", formatAmount(monthly), formatAmount(monthly*12))))
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+	}
+
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [n] Add Fee • [d] Remove • [b/Esc] Back"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *AccountsModel) renderFeesReport() string {
+	fees := m.fees
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🧾 Fees Paid in %d", fees.reportYear)))
+
+	if fees.report == nil || len(fees.report.Accounts) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No fees were charged this year."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-25s %8s %14s", "Account", "Charges", "Paid"))}
+		for _, paid := range fees.report.Accounts {
+			rows = append(rows, fmt.Sprintf("%-25s %8d %14s", truncateString(paid.Account.Name, 25), len(paid.Charges), formatAmount(paid.Total)))
+
+			// Break the account's total down by fee
+			byFee := make(map[string]float64)
+			var names []string
+			for _, charge := range paid.Charges {
+				if _, ok := byFee[charge.FeeName]; !ok {
+					names = append(names, charge.FeeName)
+				}
+				byFee[charge.FeeName] += charge.Amount.Amount()
+			}
+			for _, name := range names {
+				rows = append(rows, style.HelpStyle.Render(fmt.Sprintf("  %-23s %8s %14s", truncateString(name, 23), "", formatAmount(byFee[name]))))
+			}
+		}
+		rows = append(rows, "", style.ErrorStyle.Render(fmt.Sprintf("%-25s %8s %14s", "Total", "", formatAmount(fees.report.Total))))
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+
+		if months := reportMonths(fees.reportYear); months > 0 {
+			sections = append(sections, style.InfoStyle.Render(fmt.Sprintf("That is %s a month on average. A fee-free account would have kept it in your pocket.", formatAmount(fees.report.Total/float64(months)))))
+		}
+	}
+
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[←/→] Change Year • [b/Esc] Back"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// reportMonths is how many months of the year have started
+func reportMonths(year int) int {
+	now := time.Now()
+	switch {
+	case year < now.Year():
+		return 12
+	case year == now.Year():
+		return int(now.Month())
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,106 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// overdraft parses the overdraft limit and monthly rate of the form, both zero
+// when the limit is left blank
+func (m *AccountsModel) overdraft() (float64, float64, error) {
+	input := strings.TrimSpace(m.formModel.overdraftLimitInput)
+	if input == "" {
+		return 0, 0, nil
+	}
+	limit, err := strconv.ParseFloat(input, 64)
+	if err != nil || limit < 0 {
+		return 0, 0, fmt.Errorf("invalid overdraft limit")
+	}
+	if limit == 0 {
+		return 0, 0, nil
+	}
+	rate, err := strconv.ParseFloat(strings.TrimSpace(m.formModel.overdraftRateInput), 64)
+	if err != nil || rate < 0 {
+		return 0, 0, fmt.Errorf("invalid overdraft rate")
+	}
+	return limit, rate, nil
+}
+
+func (m *AccountsModel) saveOverdraft(accountID uuid.UUID) error {
+	if m.overdraftUseCase == nil {
+		return nil
+	}
+
+	limit, rate, err := m.overdraft()
+	if err != nil {
+		return err
+	}
+	return m.overdraftUseCase.SetAccountOverdraft(m.ctx, accountID, limit, rate)
+}
+
+// renderOverdraftBanner tells, above everything else, how much overdraft the
+// accounts are using and what it costs. It is empty when none is in use.
+func (m *AccountsModel) renderOverdraftBanner() string {
+	month := time.Now().Format("2006-01")
+
+	var used, daily, interest float64
+	var names []string
+	for _, account := range m.accounts {
+		interest += account.OverdraftInterestIn(month)
+		if !account.IsInOverdraft() {
+			continue
+		}
+		used += account.OverdraftUsed()
+		daily += account.DailyOverdraftCost()
+		names = append(names, account.Name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+
+	bannerStyle := lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder()).
+		BorderForeground(style.Danger).
+		Padding(0, 2).
+		MarginTop(1)
+
+	lines := []string{
+		style.ErrorStyle.Render(fmt.Sprintf("🔻 Overdraft in use: %s (%s)", formatAmount(used), strings.Join(names, ", "))),
+		style.ErrorStyle.Render(fmt.Sprintf("Costing ≈ %s a day, ≈ %s over 30 days • %s of interest charged this month",
+			formatAmount(daily), formatAmount(daily*30), formatAmount(interest))),
+	}
+	return bannerStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderOverdraftDetails describes the overdraft of account: the limit, how
+// much of it is used and the interest it charged
+func (m *AccountsModel) renderOverdraftDetails(account *entity.Account) []string {
+	now := time.Now()
+
+	var lines []string
+	if account.IsInOverdraft() {
+		lines = append(lines,
+			style.ErrorStyle.Render(fmt.Sprintf("Overdraft: %s used of %s at %s%% a.m.",
+				formatAmount(account.OverdraftUsed()), formatMoney(account.OverdraftLimit), formatPercentage(account.OverdraftRate))),
+			style.ErrorStyle.Render(fmt.Sprintf("Costing ≈ %s a day", formatAmount(account.DailyOverdraftCost()))),
+		)
+	} else {
+		lines = append(lines, fmt.Sprintf("Overdraft: %s at %s%% a.m. (not in use)",
+			formatMoney(account.OverdraftLimit), formatPercentage(account.OverdraftRate)))
+	}
+
+	month, year := account.OverdraftInterestIn(now.Format("2006-01")), account.OverdraftInterestIn(now.Format("2006"))
+	if year > 0 {
+		lines = append(lines, style.WarningStyle.Render(fmt.Sprintf("Overdraft Interest: %s this month, %s this year",
+			formatAmount(month), formatAmount(year))))
+	}
+	return lines
+}
@@ -0,0 +1,225 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// standingOrdersModel manages the monthly transfers between the user's accounts
+type standingOrdersModel struct {
+	orders   []*entity.StandingOrder
+	selected int
+
+	// Order form: 0: from, 1: to, 2: amount, 3: day, 4: description, 5: save, 6: cancel
+	adding       bool
+	focusedField int
+	fromIndex    int
+	toIndex      int
+	amountInput  string
+	dayInput     string
+	descInput    string
+	err          error
+}
+
+type standingOrdersLoadedMsg struct {
+	orders []*entity.StandingOrder
+}
+
+func (m *AccountsModel) openStandingOrders() (tea.Model, tea.Cmd) {
+	m.orders = &standingOrdersModel{}
+	m.viewMode = AccountViewStandingOrders
+	m.loading = true
+	return m, m.loadStandingOrders
+}
+
+func (m *AccountsModel) loadStandingOrders() tea.Msg {
+	orders, err := m.orderUseCase.ListStandingOrders(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return standingOrdersLoadedMsg{orders: orders}
+}
+
+func (m *AccountsModel) handleStandingOrdersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	orders := m.orders
+	if orders.adding {
+		return m.handleStandingOrderFormKeys(msg)
+	}
+
+	switch msg.String() {
+	case "esc", "b":
+		m.orders = nil
+		m.viewMode = AccountViewList
+	case "up", "k":
+		if orders.selected > 0 {
+			orders.selected--
+		}
+	case "down", "j":
+		if orders.selected < len(orders.orders)-1 {
+			orders.selected++
+		}
+	case "n":
+		// Start from the account selected in the list, into the next one
+		orders.adding = true
+		orders.focusedField = 0
+		orders.fromIndex = m.selectedIndex
+		orders.toIndex = (m.selectedIndex + 1) % len(m.accounts)
+		orders.amountInput = ""
+		orders.dayInput = ""
+		orders.descInput = ""
+		orders.err = nil
+	case "d":
+		if orders.selected < len(orders.orders) {
+			id := orders.orders[orders.selected].ID
+			return m, func() tea.Msg {
+				if err := m.orderUseCase.DeleteStandingOrder(m.ctx, id); err != nil {
+					return errMsg{err: err}
+				}
+				return m.loadStandingOrders()
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) handleStandingOrderFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	orders := m.orders
+
+	switch msg.String() {
+	case "esc":
+		orders.adding = false
+	case "tab", "down":
+		orders.focusedField = (orders.focusedField + 1) % 7
+	case "shift+tab", "up":
+		orders.focusedField = (orders.focusedField - 1 + 7) % 7
+	case "enter":
+		switch orders.focusedField {
+		case 5:
+			return m.submitStandingOrder()
+		case 6:
+			orders.adding = false
+		}
+	default:
+		switch orders.focusedField {
+		case 0:
+			orders.fromIndex = cycleOption(orders.fromIndex, len(m.accounts), msg.String())
+		case 1:
+			orders.toIndex = cycleOption(orders.toIndex, len(m.accounts), msg.String())
+		case 2:
+			orders.amountInput = editAmountInput(orders.amountInput, msg)
+		case 3:
+			orders.dayInput = editDigitsInput(orders.dayInput, msg, 2)
+		case 4:
+			orders.descInput = editTextInput(orders.descInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) submitStandingOrder() (tea.Model, tea.Cmd) {
+	orders := m.orders
+
+	if orders.fromIndex == orders.toIndex {
+		orders.err = fmt.Errorf("source and destination accounts must be different")
+		return m, nil
+	}
+	amount, err := strconv.ParseFloat(orders.amountInput, 64)
+	if err != nil || amount <= 0 {
+		orders.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+	day, err := strconv.Atoi(orders.dayInput)
+	if err != nil || day < 1 || day > 31 {
+		orders.err = fmt.Errorf("transfer day must be between 1 and 31")
+		return m, nil
+	}
+
+	fromID := m.accounts[orders.fromIndex].ID
+	toID := m.accounts[orders.toIndex].ID
+	description := orders.descInput
+	orders.adding = false
+	orders.err = nil
+	return m, func() tea.Msg {
+		if _, err := m.orderUseCase.CreateStandingOrder(m.ctx, fromID, toID, amount, day, description); err != nil {
+			return errMsg{err: err}
+		}
+		return m.loadStandingOrders()
+	}
+}
+
+func (m *AccountsModel) accountName(id uuid.UUID) string {
+	for _, account := range m.accounts {
+		if account.ID == id {
+			return account.Name
+		}
+	}
+	return "(deleted account)"
+}
+
+func (m *AccountsModel) renderStandingOrders() string {
+	orders := m.orders
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🔁 Standing Orders"))
+
+	if orders.adding {
+		if orders.err != nil {
+			sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", orders.err)))
+		}
+		fields := []string{
+			renderDefaultSelector("From:", m.accounts[orders.fromIndex].Name, orders.focusedField == 0),
+			renderDefaultSelector("To:", m.accounts[orders.toIndex].Name, orders.focusedField == 1),
+			renderTextField("Amount:", orders.amountInput, orders.focusedField == 2),
+			renderTextField("Transfer Day (1-31):", orders.dayInput, orders.focusedField == 3),
+			renderTextField("Description:", orders.descInput, orders.focusedField == 4),
+		}
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+		sections = append(sections, style.HelpStyle.Render("The first transfer runs on the next transfer day"))
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Add Order", orders.focusedField, 5)))
+		sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [←/→] Change Account • [Enter] Confirm • [Esc] Cancel"))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	if len(orders.orders) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No standing orders. Press 'n' to transfer money between your accounts every month."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		now := time.Now()
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-20s %-18s %-18s %14s %6s %-10s", "Description", "From", "To", "Amount", "Day", "Next"))}
+		for i, order := range orders.orders {
+			row := fmt.Sprintf("%-20s %-18s %-18s %14s %6d %-10s",
+				truncateString(order.Description, 20),
+				truncateString(m.accountName(order.FromAccountID), 18),
+				truncateString(m.accountName(order.ToAccountID), 18),
+				formatMoney(order.Amount),
+				order.DayOfMonth,
+				order.NextRunDate(now).Format("2006-01-02"))
+			if i == orders.selected {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► "+row))
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  "+row))
+			}
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+		sections = append(sections, style.HelpStyle.Render("Due transfers run when financli starts, catching up on missed months"))
+	}
+
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [n] New Order • [d] Delete • [b/Esc] Back"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
@@ -0,0 +1,131 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// accountTransferModel moves money between two of the user's accounts right away
+type accountTransferModel struct {
+	// Form: 0: from, 1: to, 2: amount, 3: date, 4: description, 5: transfer, 6: cancel
+	focusedField int
+	fromIndex    int
+	toIndex      int
+	amountInput  string
+	dateInput    string
+	descInput    string
+	err          error
+}
+
+func (m *AccountsModel) openTransfer() (tea.Model, tea.Cmd) {
+	// Start from the account selected in the list, into the next one
+	m.transfer = &accountTransferModel{
+		fromIndex: m.selectedIndex,
+		toIndex:   (m.selectedIndex + 1) % len(m.accounts),
+		dateInput: time.Now().Format("2006-01-02"),
+	}
+	m.viewMode = AccountViewTransfer
+	return m, nil
+}
+
+func (m *AccountsModel) handleTransferKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	transfer := m.transfer
+
+	switch msg.String() {
+	case "esc":
+		m.transfer = nil
+		m.viewMode = AccountViewList
+	case "tab", "down":
+		transfer.focusedField = (transfer.focusedField + 1) % 7
+	case "shift+tab", "up":
+		transfer.focusedField = (transfer.focusedField - 1 + 7) % 7
+	case "enter":
+		switch transfer.focusedField {
+		case 5:
+			return m.submitTransfer()
+		case 6:
+			m.transfer = nil
+			m.viewMode = AccountViewList
+		}
+	default:
+		switch transfer.focusedField {
+		case 0:
+			transfer.fromIndex = cycleOption(transfer.fromIndex, len(m.accounts), msg.String())
+		case 1:
+			transfer.toIndex = cycleOption(transfer.toIndex, len(m.accounts), msg.String())
+		case 2:
+			transfer.amountInput = editAmountInput(transfer.amountInput, msg)
+		case 3:
+			transfer.dateInput = editDateInput(transfer.dateInput, msg)
+		case 4:
+			transfer.descInput = editTextInput(transfer.descInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) submitTransfer() (tea.Model, tea.Cmd) {
+	transfer := m.transfer
+
+	if transfer.fromIndex == transfer.toIndex {
+		transfer.err = fmt.Errorf("source and destination accounts must be different")
+		return m, nil
+	}
+	amount, err := strconv.ParseFloat(transfer.amountInput, 64)
+	if err != nil || amount <= 0 {
+		transfer.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+	date, err := time.Parse("2006-01-02", transfer.dateInput)
+	if err != nil {
+		transfer.err = fmt.Errorf("invalid date format (use YYYY-MM-DD)")
+		return m, nil
+	}
+
+	fromID := m.accounts[transfer.fromIndex].ID
+	toID := m.accounts[transfer.toIndex].ID
+	description := transfer.descInput
+	m.transfer = nil
+	m.loading = true
+	return m, func() tea.Msg {
+		if _, _, err := m.accountUseCase.Transfer(m.ctx, fromID, toID, amount, description, date); err != nil {
+			return errMsg{err: err}
+		}
+		return accountActionMsg{}
+	}
+}
+
+func (m *AccountsModel) renderTransfer() string {
+	transfer := m.transfer
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🔄 Transfer Between Accounts"))
+
+	if transfer.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", transfer.err)))
+	}
+
+	from := m.accounts[transfer.fromIndex]
+	to := m.accounts[transfer.toIndex]
+	fields := []string{
+		renderDefaultSelector("From:", fmt.Sprintf("%s (%s)", from.Name, formatMoney(from.Balance)), transfer.focusedField == 0),
+		renderDefaultSelector("To:", fmt.Sprintf("%s (%s)", to.Name, formatMoney(to.Balance)), transfer.focusedField == 1),
+		renderTextField("Amount:", transfer.amountInput, transfer.focusedField == 2),
+		renderTextField("Date:", transfer.dateInput, transfer.focusedField == 3),
+		renderTextField("Description:", transfer.descInput, transfer.focusedField == 4),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Both sides are recorded as Transfer transactions, so they count as neither income nor expense"))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Transfer", transfer.focusedField, 5)))
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [←/→] Change Account • [Enter] Confirm • [Esc] Cancel"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
@@ -0,0 +1,197 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// transferSuggestionsModel lists the transfers that would let the accounts
+// cover what comes due, followed by the transfers already scheduled. The
+// selection runs over both lists.
+type transferSuggestionsModel struct {
+	suggestions []*usecase.TransferSuggestion
+	transfers   []*entity.ScheduledTransfer
+	selected    int
+	notice      string
+	err         error
+}
+
+type transferSuggestionsLoadedMsg struct {
+	suggestions []*usecase.TransferSuggestion
+	transfers   []*entity.ScheduledTransfer
+}
+
+type transferScheduledMsg struct {
+	notice string
+	err    error
+}
+
+func (m *AccountsModel) openTransferSuggestions() (tea.Model, tea.Cmd) {
+	m.suggestions = &transferSuggestionsModel{}
+	m.viewMode = AccountViewTransferSuggestions
+	m.loading = true
+	return m, m.loadTransferSuggestions
+}
+
+func (m *AccountsModel) loadTransferSuggestions() tea.Msg {
+	suggestions, err := m.suggestionUseCase.SuggestTransfers(m.ctx, time.Now())
+	if err != nil {
+		return errMsg{err: err}
+	}
+	transfers, err := m.scheduledTransferUseCase.ListPendingTransfers(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return transferSuggestionsLoadedMsg{suggestions: suggestions, transfers: transfers}
+}
+
+func (m *AccountsModel) handleTransferSuggestionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	suggestions := m.suggestions
+
+	switch msg.String() {
+	case "esc", "b":
+		m.suggestions = nil
+		m.viewMode = AccountViewList
+	case "up", "k":
+		if suggestions.selected > 0 {
+			suggestions.selected--
+		}
+	case "down", "j":
+		if suggestions.selected < len(suggestions.suggestions)+len(suggestions.transfers)-1 {
+			suggestions.selected++
+		}
+	case "enter", "a":
+		if suggestions.selected < len(suggestions.suggestions) {
+			return m.acceptTransferSuggestion(suggestions.suggestions[suggestions.selected])
+		}
+	case "x":
+		if index := suggestions.selected - len(suggestions.suggestions); index >= 0 && index < len(suggestions.transfers) {
+			id := suggestions.transfers[index].ID
+			return m, func() tea.Msg {
+				if err := m.scheduledTransferUseCase.CancelTransfer(m.ctx, id); err != nil {
+					return transferScheduledMsg{err: err}
+				}
+				return transferScheduledMsg{notice: "Scheduled transfer cancelled"}
+			}
+		}
+	case "r":
+		m.loading = true
+		return m, m.loadTransferSuggestions
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) acceptTransferSuggestion(suggestion *usecase.TransferSuggestion) (tea.Model, tea.Cmd) {
+	if suggestion.From == nil {
+		m.suggestions.err = fmt.Errorf("no account can spare %s, move money in from elsewhere", formatMoney(suggestion.Amount))
+		return m, nil
+	}
+
+	m.suggestions.err = nil
+	return m, func() tea.Msg {
+		transfer, err := m.suggestionUseCase.AcceptSuggestion(m.ctx, suggestion)
+		if err != nil {
+			return transferScheduledMsg{err: err}
+		}
+		notice := fmt.Sprintf("Scheduled %s from %s to %s on %s", formatMoney(transfer.Amount),
+			suggestion.From.Name, suggestion.To.Name, transfer.ScheduledFor.Format("2006-01-02"))
+		if !transfer.IsPending() {
+			notice = fmt.Sprintf("Transferred %s from %s to %s", formatMoney(transfer.Amount), suggestion.From.Name, suggestion.To.Name)
+		}
+		return transferScheduledMsg{notice: notice}
+	}
+}
+
+func (m *AccountsModel) renderTransferSuggestions() string {
+	suggestions := m.suggestions
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("💸 Suggested Transfers"))
+
+	if suggestions.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", suggestions.err)))
+	} else if suggestions.notice != "" {
+		sections = append(sections, style.SuccessStyle.Render("✓ "+suggestions.notice))
+	}
+
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if len(suggestions.suggestions) == 0 {
+		sections = append(sections, style.SuccessStyle.Render("✓ Every account covers what comes due in the next 30 days"))
+	} else {
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-10s %-18s %-18s %14s  %s", "Date", "From", "To", "Amount", "To Cover"))}
+		for i, suggestion := range suggestions.suggestions {
+			from := "(no account can spare it)"
+			if suggestion.From != nil {
+				from = suggestion.From.Name
+			}
+			uncovered := fmt.Sprintf("%s on %s", suggestion.Uncovered.Description, suggestion.Uncovered.Date.Format("02/01"))
+			row := fmt.Sprintf("%-10s %-18s %-18s %14s  %s",
+				suggestion.Date.Format("2006-01-02"),
+				truncateString(from, 18),
+				truncateString(suggestion.To.Name, 18),
+				formatMoney(suggestion.Amount),
+				truncateString(uncovered, 40))
+			switch {
+			case i == suggestions.selected:
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► "+row))
+			case suggestion.From == nil:
+				rows = append(rows, style.ErrorStyle.Render("  "+row))
+			default:
+				rows = append(rows, style.MenuItemStyle.Render("  "+row))
+			}
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+		sections = append(sections, style.HelpStyle.Render("Each transfer lands the day before the first payment the account can't cover, taken from the account with the most to spare"))
+	}
+
+	if len(suggestions.transfers) > 0 {
+		rows := []string{style.SubtitleStyle.Render("Scheduled Transfers"),
+			style.TableHeaderStyle.Render(fmt.Sprintf("%-10s %-18s %-18s %14s  %s", "Date", "From", "To", "Amount", "Description"))}
+		for i, transfer := range suggestions.transfers {
+			row := fmt.Sprintf("%-10s %-18s %-18s %14s  %s",
+				transfer.ScheduledFor.Format("2006-01-02"),
+				truncateString(m.accountName(transfer.FromAccountID), 18),
+				truncateString(m.accountName(transfer.ToAccountID), 18),
+				formatMoney(transfer.Amount),
+				truncateString(transfer.Description, 40))
+			if len(suggestions.suggestions)+i == suggestions.selected {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► "+row))
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  "+row))
+			}
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+		sections = append(sections, style.HelpStyle.Render("Due transfers run when financli starts"))
+	}
+
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [Enter/a] Accept Suggestion • [x] Cancel Scheduled • [r] Refresh • [b/Esc] Back"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderUncoveredBanner points to the suggested transfers when an account
+// can't cover what comes due
+func (m *AccountsModel) renderUncoveredBanner() string {
+	if m.uncoveredAccounts == 0 {
+		return ""
+	}
+	accounts := "account can't"
+	if m.uncoveredAccounts > 1 {
+		accounts = "accounts can't"
+	}
+	return style.WarningStyle.MarginTop(1).Render(fmt.Sprintf("⚠ %d %s cover what comes due in the next 30 days • [s] Suggested Transfers",
+		m.uncoveredAccounts, accounts))
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"financli/internal/application/usecase"
 	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
 	"financli/internal/interfaces/tui/style"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,18 +20,54 @@ import (
 type AccountsModel struct {
 	ctx            context.Context
 	accountUseCase *usecase.AccountUseCase
+	yieldUseCase   *usecase.YieldUseCase
+	importUseCase  *usecase.ImportUseCase
+	pendingUseCase *usecase.PendingPaymentUseCase
+	feeUseCase     *usecase.AccountFeeUseCase
+	orderUseCase   *usecase.StandingOrderUseCase
 
-	accounts      []*entity.Account
-	selectedIndex int
-	viewMode      AccountViewMode
+	emergencyUseCase *usecase.EmergencyFundUseCase
+	overdraftUseCase *usecase.OverdraftUseCase
+
+	scheduledTransferUseCase *usecase.ScheduledTransferUseCase
+	suggestionUseCase        *usecase.TransferSuggestionUseCase
+
+	accounts       []*entity.Account
+	importSessions []*entity.ImportSession
+
+	// Balance minus pending card payments, only for accounts that have any
+	availableToSpend map[uuid.UUID]valueobject.Money
+	// How many accounts can't cover what comes due
+	uncoveredAccounts int
+	selectedIndex     int
+	viewMode          AccountViewMode
 
 	loading bool
 	err     error
 
 	// Form state
-	formModel         *AccountFormModel
+	formModel *AccountFormModel
+	formGuard[*AccountFormModel]
 	showConfirmDelete bool
 
+	// Statement import state
+	statementImport *StatementImportModel
+
+	// Fees and fees report state
+	fees *accountFeesModel
+
+	// Standing orders state
+	orders *standingOrdersModel
+
+	// Transfer form state
+	transfer *accountTransferModel
+
+	// Emergency fund plan state
+	emergency *emergencyFundModel
+
+	// Suggested and scheduled transfers state
+	suggestions *transferSuggestionsModel
+
 	width  int
 	height int
 }
@@ -40,6 +78,14 @@ const (
 	AccountViewList AccountViewMode = iota
 	AccountViewForm
 	AccountViewConfirm
+	AccountViewImports
+	AccountViewStatementImport
+	AccountViewFees
+	AccountViewFeesReport
+	AccountViewStandingOrders
+	AccountViewEmergencyFund
+	AccountViewTransfer
+	AccountViewTransferSuggestions
 )
 
 type AccountFormModel struct {
@@ -57,17 +103,82 @@ type AccountFormModel struct {
 	descriptionInput string
 	typeOptions      []string
 	selectedType     int
+
+	// Transaction defaults
+	selectedDefaultType     int
+	selectedDefaultCategory int
+
+	// Monthly yield
+	selectedYieldType int
+	yieldRateInput    string
+
+	// Low balance alert threshold, blank for none
+	minimumBalanceInput string
+
+	// Overdraft (cheque especial), blank limit for none
+	overdraftLimitInput string
+	overdraftRateInput  string
+}
+
+var yieldTypeOptions = []entity.YieldType{
+	entity.YieldTypeNone,
+	entity.YieldTypeCDI,
+	entity.YieldTypeFixed,
 }
 
-func NewAccountsModel(ctx context.Context, accountUC *usecase.AccountUseCase) tea.Model {
-	return &AccountsModel{
-		ctx:            ctx,
-		accountUseCase: accountUC,
-		viewMode:       AccountViewList,
-		loading:        true,
-		formModel: &AccountFormModel{
-			typeOptions: []string{"Checking", "Savings", "Investment"},
-		},
+func yieldTypeLabel(yieldType entity.YieldType) string {
+	switch yieldType {
+	case entity.YieldTypeCDI:
+		return "% of CDI"
+	case entity.YieldTypeFixed:
+		return "Fixed % per month"
+	default:
+		return "None"
+	}
+}
+
+func yieldTypeIndex(yieldType entity.YieldType) int {
+	for i, option := range yieldTypeOptions {
+		if option == yieldType {
+			return i
+		}
+	}
+	return 0
+}
+
+func NewAccountsModel(ctx context.Context, accountUC *usecase.AccountUseCase, yieldUC *usecase.YieldUseCase, importUC *usecase.ImportUseCase, pendingUC *usecase.PendingPaymentUseCase, feeUC *usecase.AccountFeeUseCase, orderUC *usecase.StandingOrderUseCase, emergencyUC *usecase.EmergencyFundUseCase, overdraftUC *usecase.OverdraftUseCase, scheduledTransferUC *usecase.ScheduledTransferUseCase, suggestionUC *usecase.TransferSuggestionUseCase) tea.Model {
+	m := &AccountsModel{
+		ctx:              ctx,
+		accountUseCase:   accountUC,
+		yieldUseCase:     yieldUC,
+		importUseCase:    importUC,
+		pendingUseCase:   pendingUC,
+		feeUseCase:       feeUC,
+		orderUseCase:     orderUC,
+		emergencyUseCase: emergencyUC,
+		overdraftUseCase: overdraftUC,
+
+		scheduledTransferUseCase: scheduledTransferUC,
+		suggestionUseCase:        suggestionUC,
+
+		viewMode:  AccountViewList,
+		loading:   true,
+		formModel: newAccountForm(),
+	}
+	m.formGuard.screen = formScreen[*AccountFormModel]{
+		form:        &m.formModel,
+		fingerprint: m.formFingerprint,
+		editing:     func() bool { return m.formModel.editing },
+		showing:     func() bool { return m.viewMode == AccountViewForm },
+		reset:       m.resetForm,
+		list:        func() { m.viewMode = AccountViewList },
+	}
+	return m
+}
+
+func newAccountForm() *AccountFormModel {
+	return &AccountFormModel{
+		typeOptions: []string{"Checking", "Savings", "Investment"},
 	}
 }
 
@@ -85,13 +196,85 @@ func (m *AccountsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case accountsLoadedMsg:
 		m.loading = false
 		m.accounts = msg.accounts
+		m.availableToSpend = msg.availableToSpend
+		m.uncoveredAccounts = msg.uncoveredAccounts
 		if len(m.accounts) > 0 && m.selectedIndex >= len(m.accounts) {
 			m.selectedIndex = len(m.accounts) - 1
 		}
 		return m, nil
 
+	case importSessionsLoadedMsg:
+		m.loading = false
+		m.importSessions = msg.sessions
+		return m, nil
+
+	case statementReadMsg:
+		if m.statementImport == nil {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.statementImport.previewErr = msg.err
+			return m, nil
+		}
+		m.statementImport.setStatement(msg.statement)
+		return m, nil
+
+	case statementImportedMsg:
+		// Show the outcome in the import history, and reload the balances it changed
+		m.statementImport = nil
+		m.viewMode = AccountViewImports
+		return m, tea.Batch(m.loadAccounts, m.loadImportSessions(msg.session.AccountID))
+
+	case feesChangedMsg:
+		return m, m.loadAccounts
+
+	case feesReportLoadedMsg:
+		m.loading = false
+		if m.fees != nil {
+			m.fees.report = msg.report
+		}
+		return m, nil
+
+	case standingOrdersLoadedMsg:
+		m.loading = false
+		if m.orders != nil {
+			m.orders.orders = msg.orders
+			if m.orders.selected >= len(msg.orders) && len(msg.orders) > 0 {
+				m.orders.selected = len(msg.orders) - 1
+			}
+		}
+		return m, nil
+
+	case transferSuggestionsLoadedMsg:
+		m.loading = false
+		if m.suggestions != nil {
+			m.suggestions.suggestions = msg.suggestions
+			m.suggestions.transfers = msg.transfers
+			if total := len(msg.suggestions) + len(msg.transfers); m.suggestions.selected >= total && total > 0 {
+				m.suggestions.selected = total - 1
+			}
+		}
+		return m, nil
+
+	case transferScheduledMsg:
+		if m.suggestions != nil {
+			m.suggestions.notice = msg.notice
+			m.suggestions.err = msg.err
+		}
+		return m, tea.Batch(m.loadAccounts, m.loadTransferSuggestions)
+
+	case emergencyFundLoadedMsg:
+		m.loading = false
+		if m.emergency != nil {
+			m.emergency.setStatus(msg.status)
+		}
+		return m, nil
+
 	case accountActionMsg:
 		m.loading = false
+		if m.viewMode == AccountViewForm && !m.formModel.editing {
+			m.formGuard.saved()
+		}
 		m.viewMode = AccountViewList
 		m.formModel.editing = false
 		m.formModel.editingID = nil
@@ -111,6 +294,22 @@ func (m *AccountsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleFormKeys(msg)
 		case AccountViewConfirm:
 			return m.handleConfirmKeys(msg)
+		case AccountViewImports:
+			return m.handleImportsKeys(msg)
+		case AccountViewStatementImport:
+			return m.handleStatementImportKeys(msg)
+		case AccountViewFees:
+			return m.handleFeesKeys(msg)
+		case AccountViewFeesReport:
+			return m.handleFeesReportKeys(msg)
+		case AccountViewStandingOrders:
+			return m.handleStandingOrdersKeys(msg)
+		case AccountViewEmergencyFund:
+			return m.handleEmergencyFundKeys(msg)
+		case AccountViewTransfer:
+			return m.handleTransferKeys(msg)
+		case AccountViewTransferSuggestions:
+			return m.handleTransferSuggestionsKeys(msg)
 		}
 	}
 
@@ -136,6 +335,7 @@ func (m *AccountsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.formModel.editing = false
 		m.formModel.editingID = nil
 		m.resetForm()
+		return m, m.openForm()
 	case "e":
 		if len(m.accounts) > 0 {
 			return m.editAccount()
@@ -145,6 +345,40 @@ func (m *AccountsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.viewMode = AccountViewConfirm
 			m.showConfirmDelete = true
 		}
+	case "h":
+		if len(m.accounts) > 0 && m.importUseCase != nil {
+			m.viewMode = AccountViewImports
+			m.loading = true
+			return m, m.loadImportSessions(m.accounts[m.selectedIndex].ID)
+		}
+	case "i":
+		if len(m.accounts) > 0 && m.importUseCase != nil {
+			return m.openStatementImport()
+		}
+	case "f":
+		if len(m.accounts) > 0 && m.feeUseCase != nil {
+			return m.openFees()
+		}
+	case "y":
+		if m.feeUseCase != nil {
+			return m.openFeesReport()
+		}
+	case "t":
+		if len(m.accounts) > 1 {
+			return m.openTransfer()
+		}
+	case "o":
+		if len(m.accounts) > 1 && m.orderUseCase != nil {
+			return m.openStandingOrders()
+		}
+	case "m":
+		if len(m.accounts) > 0 && m.emergencyUseCase != nil {
+			return m.openEmergencyFund()
+		}
+	case "s":
+		if len(m.accounts) > 0 && m.suggestionUseCase != nil {
+			return m.openTransferSuggestions()
+		}
 	case "r":
 		m.loading = true
 		return m, m.loadAccounts
@@ -157,21 +391,30 @@ func (m *AccountsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *AccountsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if handled, discarded := m.formGuard.handlePromptKeys(msg.String()); handled {
+		if discarded {
+			m.closeForm()
+		}
+		return m, nil
+	}
+
 	switch msg.String() {
 	case "esc":
-		m.viewMode = AccountViewList
-		m.resetForm()
+		return m, m.leaveForm()
+	case "ctrl+x":
+		if m.formGuard.fromDraft {
+			return m, m.discardDraft()
+		}
 	case "tab", "down":
-		m.formModel.focusedField = (m.formModel.focusedField + 1) % 6
+		m.formModel.focusedField = (m.formModel.focusedField + 1) % 13
 	case "shift+tab", "up":
-		m.formModel.focusedField = (m.formModel.focusedField - 1 + 6) % 6
+		m.formModel.focusedField = (m.formModel.focusedField - 1 + 13) % 13
 	case "enter":
-		if m.formModel.focusedField == 4 {
+		if m.formModel.focusedField == 11 {
 			return m.submitForm()
-		} else if m.formModel.focusedField == 5 {
+		} else if m.formModel.focusedField == 12 {
 			// Cancel button
-			m.viewMode = AccountViewList
-			m.resetForm()
+			return m, m.leaveForm()
 		}
 	default:
 		return m.handleFormInput(msg)
@@ -181,24 +424,15 @@ func (m *AccountsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *AccountsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Only handle input for fields 0-3 (name, type, balance, description)
-	// Fields 4-5 are buttons
-	if m.formModel.focusedField > 3 {
+	// Only handle input for fields 0-10 (name, type, balance, description, default type, default category,
+	// yield type, yield rate, minimum balance, overdraft limit, overdraft rate). Fields 11-12 are buttons
+	if m.formModel.focusedField > 10 {
 		return m, nil
 	}
 
 	switch m.formModel.focusedField {
 	case 0:
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.nameInput) > 0 {
-				m.formModel.nameInput = m.formModel.nameInput[:len(m.formModel.nameInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.nameInput += msg.String()
-			}
-		}
+		m.formModel.nameInput = editTextInput(m.formModel.nameInput, msg)
 	case 1:
 		switch msg.String() {
 		case "left":
@@ -211,32 +445,75 @@ func (m *AccountsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 	case 2:
+		m.formModel.balanceInput = editAmountInput(m.formModel.balanceInput, msg)
+	case 3:
+		m.formModel.descriptionInput = editTextInput(m.formModel.descriptionInput, msg)
+	case 4:
 		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.balanceInput) > 0 {
-				m.formModel.balanceInput = m.formModel.balanceInput[:len(m.formModel.balanceInput)-1]
+		case "left":
+			if m.formModel.selectedDefaultType > 0 {
+				m.formModel.selectedDefaultType--
 			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-				m.formModel.balanceInput += msg.String()
+		case "right":
+			if m.formModel.selectedDefaultType < len(defaultTypeOptions)-1 {
+				m.formModel.selectedDefaultType++
 			}
 		}
-	case 3:
+	case 5:
 		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.descriptionInput) > 0 {
-				m.formModel.descriptionInput = m.formModel.descriptionInput[:len(m.formModel.descriptionInput)-1]
+		case "left":
+			if m.formModel.selectedDefaultCategory > 0 {
+				m.formModel.selectedDefaultCategory--
 			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.descriptionInput += msg.String()
+		case "right":
+			if m.formModel.selectedDefaultCategory < len(defaultCategoryOptions())-1 {
+				m.formModel.selectedDefaultCategory++
 			}
 		}
+	case 6:
+		switch msg.String() {
+		case "left":
+			if m.formModel.selectedYieldType > 0 {
+				m.formModel.selectedYieldType--
+			}
+		case "right":
+			if m.formModel.selectedYieldType < len(yieldTypeOptions)-1 {
+				m.formModel.selectedYieldType++
+			}
+		}
+	case 7:
+		m.formModel.yieldRateInput = editAmountInput(m.formModel.yieldRateInput, msg)
+	case 8:
+		m.formModel.minimumBalanceInput = editAmountInput(m.formModel.minimumBalanceInput, msg)
+	case 9:
+		m.formModel.overdraftLimitInput = editAmountInput(m.formModel.overdraftLimitInput, msg)
+	case 10:
+		m.formModel.overdraftRateInput = editAmountInput(m.formModel.overdraftRateInput, msg)
 	}
 
 	return m, nil
 }
 
+func (m *AccountsModel) handleImportsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = AccountViewList
+		m.importSessions = nil
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) loadImportSessions(accountID uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		sessions, err := m.importUseCase.ListImportSessions(m.ctx, accountID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return importSessionsLoadedMsg{sessions: sessions}
+	}
+}
+
 func (m *AccountsModel) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "y", "enter":
@@ -268,6 +545,22 @@ func (m *AccountsModel) View() string {
 		return m.renderAccountForm()
 	case AccountViewConfirm:
 		return m.renderConfirmDialog()
+	case AccountViewImports:
+		return m.renderImportSessions()
+	case AccountViewStatementImport:
+		return m.renderStatementImport()
+	case AccountViewFees:
+		return m.renderFees()
+	case AccountViewFeesReport:
+		return m.renderFeesReport()
+	case AccountViewStandingOrders:
+		return m.renderStandingOrders()
+	case AccountViewEmergencyFund:
+		return m.renderEmergencyFund()
+	case AccountViewTransfer:
+		return m.renderTransfer()
+	case AccountViewTransferSuggestions:
+		return m.renderTransferSuggestions()
 	}
 
 	return ""
@@ -279,6 +572,13 @@ func (m *AccountsModel) renderAccountsList() string {
 	title := style.TitleStyle.Render("📊 Accounts Management")
 	sections = append(sections, title)
 
+	if banner := m.renderOverdraftBanner(); banner != "" {
+		sections = append(sections, banner)
+	}
+	if banner := m.renderUncoveredBanner(); banner != "" {
+		sections = append(sections, banner)
+	}
+
 	if len(m.accounts) == 0 {
 		empty := style.InfoStyle.Render("No accounts found. Press 'n' to create your first account.")
 		sections = append(sections, empty)
@@ -314,7 +614,7 @@ func (m *AccountsModel) renderAccountsTable() string {
 	for i, account := range m.accounts {
 		icon := m.getAccountIcon(account.Type)
 		name := truncateString(account.Name, 20)
-		balance := account.Balance.String()
+		balance := formatMoney(account.Balance)
 		description := truncateString(account.Description, 30)
 
 		row := fmt.Sprintf("%-8s %-20s %-15s %s",
@@ -329,6 +629,13 @@ func (m *AccountsModel) renderAccountsTable() string {
 		rows = append(rows, row)
 	}
 
+	var balance float64
+	for _, account := range m.accounts {
+		balance += account.Balance.Amount()
+	}
+	rows = append(rows, renderTotalsRow(fmt.Sprintf("%-8s %-20s %-15s",
+		"Total", countLabel(len(m.accounts), "account"), formatAmount(balance)), true))
+
 	table := strings.Join(rows, "\n")
 	return tableStyle.Render(table)
 }
@@ -349,16 +656,53 @@ func (m *AccountsModel) renderAccountDetails() string {
 	details := []string{
 		fmt.Sprintf("Account ID: %s", account.ID.String()),
 		fmt.Sprintf("Type: %s", m.getAccountTypeName(account.Type)),
+	}
+
+	if available, ok := m.availableToSpend[account.ID]; ok {
+		details = append(details, style.WarningStyle.Render(
+			fmt.Sprintf("Available to Spend: %s (card payments scheduled)", formatMoney(available))))
+	}
+
+	if account.HasYield() {
+		yield := fmt.Sprintf("Yield: %s %s", formatPercentage(account.YieldRate), yieldTypeLabel(account.YieldType))
+		if m.yieldUseCase != nil {
+			yield += fmt.Sprintf(" (≈ %s this month)", formatAmount(m.yieldUseCase.EstimateMonthlyYield(account)))
+		}
+		details = append(details, yield)
+	}
+
+	if account.MinimumBalance != nil {
+		minimum := fmt.Sprintf("Minimum Balance: %s", formatMoney(*account.MinimumBalance))
+		if account.IsBelowMinimumBalance() {
+			details = append(details, style.ErrorStyle.Render(minimum+" (balance is below it)"))
+		} else {
+			details = append(details, minimum)
+		}
+	}
+
+	if account.HasOverdraft() {
+		details = append(details, m.renderOverdraftDetails(account)...)
+	}
+
+	if len(account.Fees) > 0 {
+		var monthly float64
+		for _, fee := range account.Fees {
+			monthly += fee.Amount.Amount()
+		}
+		details = append(details, style.WarningStyle.Render(fmt.Sprintf("Fees: %s a month (%d)", formatAmount(monthly), len(account.Fees))))
+	}
+
+	details = append(details,
 		fmt.Sprintf("Created: %s", account.CreatedAt.Format("2006-01-02 15:04")),
 		fmt.Sprintf("Updated: %s", account.UpdatedAt.Format("2006-01-02 15:04")),
-	}
+	)
 
 	content := strings.Join(details, "\n")
 	return detailsStyle.Render(content)
 }
 
 func (m *AccountsModel) renderListHelp() string {
-	help := "[↑/↓] Navigate • [Enter] View • [n] New • [e] Edit • [d] Delete • [r] Refresh • [b] Back"
+	help := "[↑/↓] Navigate • [Enter] View • [n] New • [e] Edit • [d] Delete • [i] Import Statement • [h] Import History • [f] Fees • [y] Fees Paid • [t] Transfer • [o] Standing Orders • [m] Emergency Fund • [s] Suggested Transfers • [r] Refresh • [b] Back"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -424,15 +768,39 @@ func (m *AccountsModel) editAccount() (tea.Model, tea.Cmd) {
 		m.formModel.selectedType = 2
 	}
 
+	m.formModel.selectedDefaultType = defaultTypeIndex(account.DefaultTransactionType)
+	m.formModel.selectedDefaultCategory = defaultCategoryIndex(account.DefaultCategory)
+	m.formModel.selectedYieldType = yieldTypeIndex(account.YieldType)
+	m.formModel.yieldRateInput = ""
+	if account.HasYield() {
+		m.formModel.yieldRateInput = formatPercentage(account.YieldRate)
+	}
+	m.formModel.minimumBalanceInput = ""
+	if account.MinimumBalance != nil {
+		m.formModel.minimumBalanceInput = fmt.Sprintf("%.2f", account.MinimumBalance.Amount())
+	}
+	m.formModel.overdraftLimitInput = ""
+	m.formModel.overdraftRateInput = ""
+	if account.HasOverdraft() {
+		m.formModel.overdraftLimitInput = fmt.Sprintf("%.2f", account.OverdraftLimit.Amount())
+		m.formModel.overdraftRateInput = formatPercentage(account.OverdraftRate)
+	}
+	m.formGuard.open(m.formFingerprint(), false)
+
 	return m, nil
 }
 
 func (m *AccountsModel) resetForm() {
-	m.formModel.nameInput = ""
-	m.formModel.balanceInput = ""
-	m.formModel.descriptionInput = ""
-	m.formModel.selectedType = 0
-	m.formModel.focusedField = 0
+	m.formModel = newAccountForm()
+}
+
+// formFingerprint sums up the fields of the form the user can change
+func (m *AccountsModel) formFingerprint() string {
+	f := m.formModel
+	return fmt.Sprintf("%q %q %q %d %d %d %d %q %q %q %q",
+		f.nameInput, f.balanceInput, f.descriptionInput, f.selectedType, f.selectedDefaultType,
+		f.selectedDefaultCategory, f.selectedYieldType, f.yieldRateInput, f.minimumBalanceInput,
+		f.overdraftLimitInput, f.overdraftRateInput)
 }
 
 func (m *AccountsModel) loadAccounts() tea.Msg {
@@ -441,7 +809,25 @@ func (m *AccountsModel) loadAccounts() tea.Msg {
 		return errMsg{err: err}
 	}
 
-	return accountsLoadedMsg{accounts: accounts}
+	availableToSpend := make(map[uuid.UUID]valueobject.Money)
+	if m.pendingUseCase != nil {
+		for _, account := range accounts {
+			available, err := m.pendingUseCase.GetAvailableToSpend(m.ctx, account.ID)
+			if err == nil && !available.Equals(account.Balance) {
+				availableToSpend[account.ID] = available
+			}
+		}
+	}
+
+	// Only the count is needed here, the suggestions view loads its own
+	var uncovered int
+	if m.suggestionUseCase != nil {
+		if suggestions, err := m.suggestionUseCase.SuggestTransfers(m.ctx, time.Now()); err == nil {
+			uncovered = len(suggestions)
+		}
+	}
+
+	return accountsLoadedMsg{accounts: accounts, availableToSpend: availableToSpend, uncoveredAccounts: uncovered}
 }
 
 func (m *AccountsModel) submitForm() (tea.Model, tea.Cmd) {
@@ -458,6 +844,35 @@ func (m *AccountsModel) submitForm() (tea.Model, tea.Cmd) {
 
 	accountType := m.getAccountTypeFromSelection()
 
+	yieldType := yieldTypeOptions[m.formModel.selectedYieldType]
+	var yieldRate float64
+	if yieldType != entity.YieldTypeNone {
+		if accountType == entity.AccountTypeChecking {
+			m.err = fmt.Errorf("yield is only available for savings and investment accounts")
+			return m, nil
+		}
+		yieldRate, err = strconv.ParseFloat(m.formModel.yieldRateInput, 64)
+		if err != nil || yieldRate <= 0 {
+			m.err = fmt.Errorf("invalid yield rate")
+			return m, nil
+		}
+	}
+
+	if _, err := m.minimumBalance(); err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	limit, _, err := m.overdraft()
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	if limit > 0 && accountType != entity.AccountTypeChecking {
+		m.err = fmt.Errorf("overdraft is only available for checking accounts")
+		return m, nil
+	}
+
 	m.loading = true
 
 	if m.formModel.editing && m.formModel.editingID != nil {
@@ -481,7 +896,7 @@ func (m *AccountsModel) getAccountTypeFromSelection() entity.AccountType {
 }
 
 func (m *AccountsModel) createAccount(accountType entity.AccountType, balance float64) tea.Msg {
-	_, err := m.accountUseCase.CreateAccount(
+	account, err := m.accountUseCase.CreateAccount(
 		m.ctx,
 		m.formModel.nameInput,
 		accountType,
@@ -493,9 +908,62 @@ func (m *AccountsModel) createAccount(accountType entity.AccountType, balance fl
 		return errMsg{err: err}
 	}
 
+	if err := m.saveTransactionDefaults(account.ID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveYield(account.ID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveMinimumBalance(account.ID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveOverdraft(account.ID); err != nil {
+		return errMsg{err: err}
+	}
+
 	return accountActionMsg{}
 }
 
+func (m *AccountsModel) saveTransactionDefaults(accountID uuid.UUID) error {
+	category := defaultCategoryOptions()[m.formModel.selectedDefaultCategory]
+	transactionType := defaultTypeOptions[m.formModel.selectedDefaultType]
+	return m.accountUseCase.SetTransactionDefaults(m.ctx, accountID, category, transactionType)
+}
+
+func (m *AccountsModel) saveYield(accountID uuid.UUID) error {
+	if m.yieldUseCase == nil {
+		return nil
+	}
+
+	yieldType := yieldTypeOptions[m.formModel.selectedYieldType]
+	rate, _ := strconv.ParseFloat(m.formModel.yieldRateInput, 64)
+	return m.yieldUseCase.SetAccountYield(m.ctx, accountID, yieldType, rate)
+}
+
+// minimumBalance parses the low balance alert threshold, nil when left blank
+func (m *AccountsModel) minimumBalance() (*float64, error) {
+	input := strings.TrimSpace(m.formModel.minimumBalanceInput)
+	if input == "" {
+		return nil, nil
+	}
+	threshold, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum balance")
+	}
+	return &threshold, nil
+}
+
+func (m *AccountsModel) saveMinimumBalance(accountID uuid.UUID) error {
+	threshold, err := m.minimumBalance()
+	if err != nil {
+		return err
+	}
+	return m.accountUseCase.SetMinimumBalance(m.ctx, accountID, threshold)
+}
+
 func (m *AccountsModel) updateAccount(accountType entity.AccountType, balance float64) tea.Msg {
 	if m.formModel.editingID == nil {
 		return errMsg{err: fmt.Errorf("no account ID for editing")}
@@ -514,6 +982,22 @@ func (m *AccountsModel) updateAccount(accountType entity.AccountType, balance fl
 		return errMsg{err: err}
 	}
 
+	if err := m.saveTransactionDefaults(*m.formModel.editingID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveYield(*m.formModel.editingID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveMinimumBalance(*m.formModel.editingID); err != nil {
+		return errMsg{err: err}
+	}
+
+	if err := m.saveOverdraft(*m.formModel.editingID); err != nil {
+		return errMsg{err: err}
+	}
+
 	return accountActionMsg{}
 }
 
@@ -539,12 +1023,18 @@ func (m *AccountsModel) renderAccountForm() string {
 		title = "✏️ Edit Account"
 	}
 	sections = append(sections, style.TitleStyle.Render(title))
+	if note := m.formGuard.renderDraftNote(); note != "" {
+		sections = append(sections, note)
+	}
 
 	form := m.renderForm()
 	sections = append(sections, form)
 
 	help := m.renderFormHelp()
 	sections = append(sections, help)
+	if prompt := m.formGuard.renderPrompt(); prompt != "" {
+		sections = append(sections, prompt)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
@@ -562,6 +1052,16 @@ func (m *AccountsModel) renderForm() string {
 	fields = append(fields, m.renderTypeSelector())
 	fields = append(fields, m.renderFormField("Initial Balance:", m.formModel.balanceInput, 2))
 	fields = append(fields, m.renderFormField("Description:", m.formModel.descriptionInput, 3))
+	fields = append(fields, renderDefaultSelector("Default Type:",
+		defaultTypeLabel(defaultTypeOptions[m.formModel.selectedDefaultType]), m.formModel.focusedField == 4))
+	fields = append(fields, renderDefaultSelector("Default Category:",
+		defaultCategoryLabel(defaultCategoryOptions()[m.formModel.selectedDefaultCategory]), m.formModel.focusedField == 5))
+	fields = append(fields, renderDefaultSelector("Yield:",
+		yieldTypeLabel(yieldTypeOptions[m.formModel.selectedYieldType]), m.formModel.focusedField == 6))
+	fields = append(fields, m.renderFormField("Yield Rate (%):", m.formModel.yieldRateInput, 7))
+	fields = append(fields, m.renderFormField("Minimum Balance:", m.formModel.minimumBalanceInput, 8))
+	fields = append(fields, m.renderFormField("Overdraft Limit:", m.formModel.overdraftLimitInput, 9))
+	fields = append(fields, m.renderFormField("Overdraft (% a.m.):", m.formModel.overdraftRateInput, 10))
 
 	buttons := m.renderFormButtons()
 	fields = append(fields, buttons)
@@ -637,14 +1137,14 @@ func (m *AccountsModel) renderFormButtons() string {
 	var submitStyle, cancelStyle lipgloss.Style
 
 	// Submit button styling
-	if m.formModel.focusedField == 4 {
+	if m.formModel.focusedField == 11 {
 		submitStyle = style.ButtonStyle.Background(style.Success)
 	} else {
 		submitStyle = style.SecondaryButtonStyle
 	}
 
 	// Cancel button styling
-	if m.formModel.focusedField == 5 {
+	if m.formModel.focusedField == 12 {
 		cancelStyle = style.ButtonStyle.Background(style.Danger)
 	} else {
 		cancelStyle = style.SecondaryButtonStyle
@@ -654,9 +1154,9 @@ func (m *AccountsModel) renderFormButtons() string {
 	cancelBtn := cancelStyle.Render("Cancel")
 
 	// Add focus indicators
-	if m.formModel.focusedField == 4 {
+	if m.formModel.focusedField == 11 {
 		submitBtn = submitBtn + " ◄"
-	} else if m.formModel.focusedField == 5 {
+	} else if m.formModel.focusedField == 12 {
 		cancelBtn = cancelBtn + " ◄"
 	}
 
@@ -668,7 +1168,7 @@ func (m *AccountsModel) renderFormButtons() string {
 }
 
 func (m *AccountsModel) renderFormHelp() string {
-	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Select Type • [Enter] Confirm • [Esc] Cancel"
+	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -706,13 +1206,114 @@ func (m *AccountsModel) renderConfirmDialog() string {
 }
 
 func (m *AccountsModel) IsInFormMode() bool {
-	return m.viewMode == AccountViewForm || m.viewMode == AccountViewConfirm
+	return m.viewMode == AccountViewForm || m.viewMode == AccountViewConfirm || m.viewMode == AccountViewStatementImport ||
+		(m.viewMode == AccountViewFees && m.fees.adding) ||
+		(m.viewMode == AccountViewStandingOrders && m.orders.adding) ||
+		m.viewMode == AccountViewEmergencyFund || m.viewMode == AccountViewTransfer
 }
 
 type accountsLoadedMsg struct {
-	accounts []*entity.Account
+	accounts          []*entity.Account
+	availableToSpend  map[uuid.UUID]valueobject.Money
+	uncoveredAccounts int
 }
 
 type accountActionMsg struct{}
 
+type importSessionsLoadedMsg struct {
+	sessions []*entity.ImportSession
+}
+
 type BackToDashboardMsg struct{}
+
+func (m *AccountsModel) renderImportSessions() string {
+	if m.selectedIndex >= len(m.accounts) {
+		return ""
+	}
+
+	account := m.accounts[m.selectedIndex]
+
+	var sections []string
+
+	title := style.TitleStyle.Render(fmt.Sprintf("📥 Import History for %s", account.Name))
+	sections = append(sections, title)
+
+	if len(m.importSessions) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No statements imported into this account yet."))
+	} else {
+		sections = append(sections, m.renderImportSessionsTable())
+	}
+
+	help := "[b/Esc] Back • Score: share of statement entries already in the ledger, halved while the balance differs • Assertions: days whose running balance the ledger matches"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *AccountsModel) renderImportSessionsTable() string {
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	headerRow := style.TableHeaderStyle.Render(
+		fmt.Sprintf("%-17s %-20s %-23s %7s %7s %7s %7s %-14s %-10s %s",
+			"Imported", "Source", "Period", "Matched", "New", "Inbox", "Ledger", "Balance Delta", "Assertions", "Score"),
+	)
+
+	rows := []string{headerRow}
+	for _, session := range m.importSessions {
+		period := fmt.Sprintf("%s - %s", session.PeriodStart.Format("02/01/06"), session.PeriodEnd.Format("02/01/06"))
+
+		delta := "n/a"
+		if session.StatementBalance != nil {
+			delta = formatMoney(session.BalanceDelta())
+		}
+
+		score := fmt.Sprintf("%.0f%%", session.Score())
+		switch {
+		case session.Score() >= 90:
+			score = style.SuccessStyle.Render(score)
+		case session.Score() >= 60:
+			score = style.WarningStyle.Render(score)
+		default:
+			score = style.ErrorStyle.Render(score)
+		}
+
+		assertions := "n/a"
+		if len(session.BalanceAssertions) > 0 {
+			assertions = style.SuccessStyle.Render(fmt.Sprintf("%-10s", fmt.Sprintf("%d ok", len(session.BalanceAssertions))))
+			if failed := session.FirstFailedAssertion(); failed != nil {
+				assertions = style.ErrorStyle.Render(fmt.Sprintf("%-10s", "✗ "+failed.Date.Format("02/01/06")))
+			}
+		}
+
+		row := fmt.Sprintf("%-17s %-20s %-23s %7d %7d %7d %7d %-14s %-10s %s",
+			session.CreatedAt.Format("2006-01-02 15:04"),
+			truncateString(session.Source, 20),
+			period,
+			len(session.MatchedTransactionIDs),
+			len(session.CreatedTransactionIDs),
+			len(session.QueuedInboxIDs),
+			len(session.LedgerOnlyTransactionIDs),
+			delta,
+			assertions,
+			score,
+		)
+		rows = append(rows, style.MenuItemStyle.Render("  "+row))
+	}
+
+	// The newest failed check tells where to start looking for the difference
+	for _, session := range m.importSessions {
+		if failed := session.FirstFailedAssertion(); failed != nil {
+			rows = append(rows, "", style.ErrorStyle.Render(fmt.Sprintf(
+				"First divergence in %s: %s %q, statement balance %s but ledger %s",
+				truncateString(session.Source, 20), failed.Date.Format("02/01/06"), truncateString(failed.Description, 30),
+				formatMoney(failed.Expected), formatMoney(failed.Actual))))
+			break
+		}
+	}
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
@@ -0,0 +1,41 @@
+package screen
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// refreshMsg asks the screen owning the tick chain to reload its data
+type refreshMsg struct {
+	owner *autoRefresh
+	id    int
+}
+
+// autoRefresh reloads a screen's data on a fixed interval, so a terminal left
+// open on a wall display stays current. Every start supersedes the previous
+// tick chain, since ticks delivered while another screen is shown are lost.
+type autoRefresh struct {
+	interval time.Duration
+	id       int
+}
+
+func (r *autoRefresh) start() tea.Cmd {
+	if r.interval <= 0 {
+		return nil
+	}
+	r.id++
+	return r.tick()
+}
+
+func (r *autoRefresh) tick() tea.Cmd {
+	id := r.id
+	return tea.Tick(r.interval, func(time.Time) tea.Msg {
+		return refreshMsg{owner: r, id: id}
+	})
+}
+
+// due reports whether msg belongs to the current tick chain
+func (r *autoRefresh) due(msg refreshMsg) bool {
+	return r.interval > 0 && msg.owner == r && msg.id == r.id
+}
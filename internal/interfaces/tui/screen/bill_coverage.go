@@ -0,0 +1,128 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxCoverageRows caps each transaction list of the coverage report
+const maxCoverageRows = 10
+
+type billCoverageLoadedMsg struct {
+	report *usecase.BillCoverageReport
+}
+
+// showBillCoverage opens the coverage report of the selected bill
+func (m *BillsModel) showBillCoverage() (tea.Model, tea.Cmd) {
+	if m.selectedIndex >= len(m.bills) {
+		return m, nil
+	}
+
+	billID := m.bills[m.selectedIndex].ID
+	m.viewMode = BillViewCoverage
+	m.coverage = nil
+	m.loading = true
+	return m, func() tea.Msg {
+		report, err := m.reportUseCase.GetBillCoverageReport(m.ctx, billID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return billCoverageLoadedMsg{report: report}
+	}
+}
+
+func (m *BillsModel) handleCoverageKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "r":
+		return m.showBillCoverage()
+	case "esc", "b":
+		m.viewMode = BillViewDetails
+	}
+	return m, nil
+}
+
+func (m *BillsModel) renderBillCoverage() string {
+	report := m.coverage
+	if report == nil {
+		return ""
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🧾 Bill Coverage: %s", report.Bill.Name)))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	var gap string
+	switch {
+	case report.Gap.IsZero():
+		gap = style.SuccessStyle.Render("✓ Fully covered")
+	case report.Gap.IsNegative():
+		gap = style.WarningStyle.Render(fmt.Sprintf("Over the total by %s", formatAmount(-report.Gap.Amount())))
+	default:
+		gap = style.ErrorStyle.Render(fmt.Sprintf("Missing %s", formatMoney(report.Gap)))
+	}
+
+	var percentage float64
+	if total := report.Bill.TotalAmount.Amount(); total > 0 {
+		percentage = report.Covered.Amount() / total * 100
+	}
+	summary := []string{
+		fmt.Sprintf("Period: %s to %s", report.Bill.StartDate.Format("2006-01-02"), report.Bill.EndDate.Format("2006-01-02")),
+		"",
+		fmt.Sprintf("Bill Total:  %s", formatMoney(report.Bill.TotalAmount)),
+		fmt.Sprintf("Covered:     %s (%.1f%%)", formatMoney(report.Covered), percentage),
+		fmt.Sprintf("Gap:         %s", gap),
+		"",
+		m.renderProgressBar(percentage, 50),
+	}
+	sections = append(sections, boxStyle.Render(strings.Join(summary, "\n")))
+
+	linked := []string{style.SubtitleStyle.Render(fmt.Sprintf("Linked transactions (%d)", len(report.Linked)))}
+	if len(report.Linked) == 0 {
+		linked = append(linked, style.InfoStyle.Render("No transactions are linked to this bill"))
+	}
+	linked = append(linked, m.renderCoverageRows(report.Linked)...)
+	sections = append(sections, boxStyle.Render(strings.Join(linked, "\n")))
+
+	candidates := []string{style.SubtitleStyle.Render(fmt.Sprintf("Unlinked expenses in the period (%d, %s)",
+		len(report.Candidates), formatMoney(report.CandidatesTotal)))}
+	if len(report.Candidates) == 0 {
+		candidates = append(candidates, style.InfoStyle.Render("Every expense of the period is linked to a bill"))
+	}
+	candidates = append(candidates, m.renderCoverageRows(report.Candidates)...)
+	sections = append(sections, boxStyle.Render(strings.Join(candidates, "\n")))
+
+	help := "[r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *BillsModel) renderCoverageRows(transactions []*entity.Transaction) []string {
+	var rows []string
+	for i, txn := range transactions {
+		if i == maxCoverageRows {
+			rows = append(rows, lipgloss.NewStyle().Foreground(style.TextMuted).Render(fmt.Sprintf("... and %d more", len(transactions)-maxCoverageRows)))
+			break
+		}
+
+		amount := formatMoney(txn.Amount)
+		if txn.Type == entity.TransactionTypeCredit {
+			amount = "-" + amount
+		}
+		rows = append(rows, fmt.Sprintf("%s  %-30s %-16s %14s",
+			txn.Date.Format("2006-01-02"), truncateString(txn.Description, 30), categoryDisplayName(txn.Category), amount))
+	}
+	return rows
+}
@@ -17,8 +17,11 @@ import (
 )
 
 type BillsModel struct {
-	ctx         context.Context
-	billUseCase *usecase.BillUseCase
+	ctx                context.Context
+	billUseCase        *usecase.BillUseCase
+	sinkingFundUseCase *usecase.SinkingFundUseCase
+	historyUseCase     *usecase.ChangeHistoryUseCase
+	reportUseCase      *usecase.ReportUseCase
 
 	// Data
 	bills []*entity.Bill
@@ -33,10 +36,22 @@ type BillsModel struct {
 
 	// Form state
 	formModel *BillFormModel
+	formGuard[*BillFormModel]
 
 	// Payment state
 	paymentModel *BillPaymentFormModel
 
+	// Sinking funds state
+	sinkingFunds     []*usecase.SinkingFundStatus
+	sinkingFundIndex int
+	sinkingFundForm  *SinkingFundFormModel
+
+	// Change history state
+	historyModel *ChangeHistoryModel
+
+	// Coverage report of the selected bill
+	coverage *usecase.BillCoverageReport
+
 	// Confirmation state
 	showConfirmDelete bool
 	confirmMessage    string
@@ -54,6 +69,10 @@ const (
 	BillViewDetails
 	BillViewPayment
 	BillViewConfirm
+	BillViewSinkingFunds
+	BillViewSinkingFundForm
+	BillViewHistory
+	BillViewCoverage
 )
 
 type BillFormModel struct {
@@ -99,14 +118,34 @@ type billsLoadedMsg struct {
 
 type billActionMsg struct{}
 
-func NewBillsModel(ctx context.Context, billUC *usecase.BillUseCase) tea.Model {
-	return &BillsModel{
-		ctx:         ctx,
-		billUseCase: billUC,
-		viewMode:    BillViewList,
-		loading:     true,
-		formModel:   &BillFormModel{},
+func NewBillsModel(ctx context.Context, billUC *usecase.BillUseCase, sinkingFundUC *usecase.SinkingFundUseCase, historyUC *usecase.ChangeHistoryUseCase, reportUC *usecase.ReportUseCase, draftUC *usecase.FormDraftUseCase) tea.Model {
+	m := &BillsModel{
+		ctx:                ctx,
+		billUseCase:        billUC,
+		sinkingFundUseCase: sinkingFundUC,
+		historyUseCase:     historyUC,
+		reportUseCase:      reportUC,
+		viewMode:           BillViewList,
+		loading:            true,
+		formModel:          &BillFormModel{},
+		sinkingFundForm:    newSinkingFundForm(),
 	}
+	m.formGuard.screen = formScreen[*BillFormModel]{
+		form:        &m.formModel,
+		fingerprint: m.formFingerprint,
+		editing:     func() bool { return m.formModel.editing },
+		showing:     func() bool { return m.viewMode == BillViewForm },
+		reset:       m.resetForm,
+		list:        func() { m.viewMode = BillViewList },
+		fields:      m.draftFields,
+		restore: func(fields map[string]string) tea.Cmd {
+			m.applyDraftFields(fields)
+			return nil
+		},
+	}
+	// The new bill's draft is kept across runs
+	m.formGuard.store = newDraftStore(ctx, draftUC, entity.FormDraftBill)
+	return m
 }
 
 func (m *BillsModel) Init() tea.Cmd {
@@ -114,6 +153,10 @@ func (m *BillsModel) Init() tea.Cmd {
 }
 
 func (m *BillsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := m.formGuard.update(msg); ok {
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -130,11 +173,50 @@ func (m *BillsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case billActionMsg:
 		m.loading = false
+		var draftCmd tea.Cmd
+		if m.viewMode == BillViewForm && !m.formModel.editing {
+			draftCmd = m.savedForm()
+		}
 		m.viewMode = BillViewList
 		m.formModel.editing = false
 		m.formModel.editingID = nil
 		m.resetForm()
-		return m, m.loadBills
+		return m, tea.Batch(m.loadBills, draftCmd)
+
+	case sinkingFundsLoadedMsg:
+		m.loading = false
+		m.sinkingFunds = msg.statuses
+		if m.sinkingFundIndex >= len(m.sinkingFunds) {
+			m.sinkingFundIndex = 0
+		}
+		return m, nil
+
+	case sinkingFundActionMsg:
+		m.viewMode = BillViewSinkingFunds
+		return m, m.loadSinkingFunds
+
+	case editorFinishedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+		} else if msg.field == "description" {
+			m.formModel.descriptionInput = msg.value
+		}
+		return m, nil
+
+	case changeHistoryLoadedMsg:
+		m.loading = false
+		if m.historyModel != nil {
+			m.historyModel.setRecords(msg.records)
+		}
+		return m, nil
+
+	case billCoverageLoadedMsg:
+		m.loading = false
+		m.coverage = msg.report
+		return m, nil
+
+	case changeRevertedMsg:
+		return m, tea.Batch(m.loadBills, m.historyModel.load(m.ctx, m.historyUseCase))
 
 	case errMsg:
 		m.loading = false
@@ -146,13 +228,23 @@ func (m *BillsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case BillViewList:
 			return m.handleListKeys(msg)
 		case BillViewForm:
-			return m.handleFormKeys(msg)
+			before := m.formFingerprint()
+			model, cmd := m.handleFormKeys(msg)
+			return model, tea.Batch(cmd, m.formGuard.autosave(before))
 		case BillViewDetails:
 			return m.handleDetailsKeys(msg)
 		case BillViewPayment:
 			return m.handlePaymentKeys(msg)
 		case BillViewConfirm:
 			return m.handleConfirmKeys(msg)
+		case BillViewSinkingFunds:
+			return m.handleSinkingFundsKeys(msg)
+		case BillViewSinkingFundForm:
+			return m.handleSinkingFundFormKeys(msg)
+		case BillViewHistory:
+			return m.handleHistoryKeys(msg)
+		case BillViewCoverage:
+			return m.handleCoverageKeys(msg)
 		}
 	}
 
@@ -178,6 +270,7 @@ func (m *BillsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.formModel.editing = false
 		m.formModel.editingID = nil
 		m.resetForm()
+		return m, m.openForm()
 	case "e":
 		if len(m.bills) > 0 {
 			return m.editBill()
@@ -195,6 +288,10 @@ func (m *BillsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.viewMode = BillViewConfirm
 			m.showConfirmDelete = true
 		}
+	case "s":
+		m.viewMode = BillViewSinkingFunds
+		m.loading = true
+		return m, m.loadSinkingFunds
 	case "r":
 		m.loading = true
 		return m, m.loadBills
@@ -206,10 +303,24 @@ func (m *BillsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *BillsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if handled, discarded := m.formGuard.handlePromptKeys(msg.String()); handled {
+		if discarded {
+			m.closeForm()
+		}
+		return m, nil
+	}
+
 	switch msg.String() {
 	case "esc":
-		m.viewMode = BillViewList
-		m.resetForm()
+		return m, m.leaveForm()
+	case "ctrl+x":
+		if m.formGuard.fromDraft {
+			return m, m.discardDraft()
+		}
+	case "ctrl+e":
+		if m.formModel.focusedField == 1 {
+			return m, openInEditor("description", m.formModel.descriptionInput, false)
+		}
 	case "tab", "down":
 		m.formModel.focusedField = (m.formModel.focusedField + 1) % 8
 	case "shift+tab", "up":
@@ -219,8 +330,7 @@ func (m *BillsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.submitForm()
 		} else if m.formModel.focusedField == 7 {
 			// Cancel button
-			m.viewMode = BillViewList
-			m.resetForm()
+			return m, m.leaveForm()
 		}
 	default:
 		return m.handleFormInput(msg)
@@ -238,71 +348,17 @@ func (m *BillsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	switch m.formModel.focusedField {
 	case 0: // Name
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.nameInput) > 0 {
-				m.formModel.nameInput = m.formModel.nameInput[:len(m.formModel.nameInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.nameInput += msg.String()
-			}
-		}
+		m.formModel.nameInput = editTextInput(m.formModel.nameInput, msg)
 	case 1: // Description
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.descriptionInput) > 0 {
-				m.formModel.descriptionInput = m.formModel.descriptionInput[:len(m.formModel.descriptionInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.descriptionInput += msg.String()
-			}
-		}
+		m.formModel.descriptionInput = editTextInput(m.formModel.descriptionInput, msg)
 	case 2: // Total Amount
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.amountInput) > 0 {
-				m.formModel.amountInput = m.formModel.amountInput[:len(m.formModel.amountInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-				m.formModel.amountInput += msg.String()
-			}
-		}
+		m.formModel.amountInput = editAmountInput(m.formModel.amountInput, msg)
 	case 3: // Start Date
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.startDateInput) > 0 {
-				m.formModel.startDateInput = m.formModel.startDateInput[:len(m.formModel.startDateInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == "-") {
-				m.formModel.startDateInput += msg.String()
-			}
-		}
+		m.formModel.startDateInput = editDateInput(m.formModel.startDateInput, msg)
 	case 4: // End Date
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.endDateInput) > 0 {
-				m.formModel.endDateInput = m.formModel.endDateInput[:len(m.formModel.endDateInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == "-") {
-				m.formModel.endDateInput += msg.String()
-			}
-		}
+		m.formModel.endDateInput = editDateInput(m.formModel.endDateInput, msg)
 	case 5: // Due Date
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.dueDateInput) > 0 {
-				m.formModel.dueDateInput = m.formModel.dueDateInput[:len(m.formModel.dueDateInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == "-") {
-				m.formModel.dueDateInput += msg.String()
-			}
-		}
+		m.formModel.dueDateInput = editDateInput(m.formModel.dueDateInput, msg)
 	}
 
 	return m, nil
@@ -321,11 +377,30 @@ func (m *BillsModel) handleDetailsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "d":
 		m.viewMode = BillViewConfirm
 		m.showConfirmDelete = true
+	case "h":
+		if m.selectedIndex < len(m.bills) {
+			bill := m.bills[m.selectedIndex]
+			m.historyModel = newChangeHistoryModel(entity.ChangeEntityBill, bill.ID, bill.Name)
+			m.viewMode = BillViewHistory
+			m.loading = true
+			return m, m.historyModel.load(m.ctx, m.historyUseCase)
+		}
+	case "v":
+		return m.showBillCoverage()
 	}
 
 	return m, nil
 }
 
+func (m *BillsModel) handleHistoryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = BillViewDetails
+		return m, nil
+	}
+	return m, m.historyModel.handleKeys(m.ctx, m.historyUseCase, msg.String())
+}
+
 func (m *BillsModel) handlePaymentKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
@@ -345,16 +420,7 @@ func (m *BillsModel) handlePaymentKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	default:
 		if m.paymentModel.focusedField == 0 {
-			switch msg.String() {
-			case "backspace":
-				if len(m.paymentModel.amountInput) > 0 {
-					m.paymentModel.amountInput = m.paymentModel.amountInput[:len(m.paymentModel.amountInput)-1]
-				}
-			default:
-				if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-					m.paymentModel.amountInput += msg.String()
-				}
-			}
+			m.paymentModel.amountInput = editAmountInput(m.paymentModel.amountInput, msg)
 		}
 	}
 
@@ -396,6 +462,14 @@ func (m *BillsModel) View() string {
 		return m.renderPaymentForm()
 	case BillViewConfirm:
 		return m.renderConfirmDialog()
+	case BillViewSinkingFunds:
+		return m.renderSinkingFunds()
+	case BillViewSinkingFundForm:
+		return m.renderSinkingFundForm()
+	case BillViewHistory:
+		return m.historyModel.render()
+	case BillViewCoverage:
+		return m.renderBillCoverage()
 	}
 
 	return ""
@@ -443,8 +517,8 @@ func (m *BillsModel) renderBillsTable() string {
 	for i, bill := range m.bills {
 		status := m.getBillStatusIcon(bill.Status)
 		name := truncateString(bill.Name, 20)
-		total := bill.TotalAmount.String()
-		paid := bill.PaidAmount.String()
+		total := formatMoney(bill.TotalAmount)
+		paid := formatMoney(bill.PaidAmount)
 		progress := m.renderProgressBar(bill.GetPaymentPercentage(), 20)
 		dueDate := bill.DueDate.Format("2006-01-02")
 
@@ -496,9 +570,9 @@ func (m *BillsModel) renderBillsSummary() string {
 		fmt.Sprintf("Total Bills: %d", totalBills),
 		fmt.Sprintf("Open Bills: %d", openBills),
 		fmt.Sprintf("Overdue Bills: %d", overdueBills),
-		fmt.Sprintf("Total Amount: R$ %.2f", totalAmount),
-		fmt.Sprintf("Total Paid: R$ %.2f", totalPaid),
-		fmt.Sprintf("Remaining: R$ %.2f", totalAmount-totalPaid),
+		fmt.Sprintf("Total Amount: %s", formatAmount(totalAmount)),
+		fmt.Sprintf("Total Paid: %s", formatAmount(totalPaid)),
+		fmt.Sprintf("Remaining: %s", formatAmount(totalAmount-totalPaid)),
 	}
 
 	content := strings.Join(summary, " • ")
@@ -531,12 +605,12 @@ func (m *BillsModel) renderBillDetails() string {
 		fmt.Sprintf("Period: %s to %s", bill.StartDate.Format("2006-01-02"), bill.EndDate.Format("2006-01-02")),
 		fmt.Sprintf("Due Date: %s", bill.DueDate.Format("2006-01-02")),
 		"",
-		fmt.Sprintf("Total Amount: %s", bill.TotalAmount.String()),
-		fmt.Sprintf("Paid Amount: %s", bill.PaidAmount.String()),
+		fmt.Sprintf("Total Amount: %s", formatMoney(bill.TotalAmount)),
+		fmt.Sprintf("Paid Amount: %s", formatMoney(bill.PaidAmount)),
 	}
 
 	remaining, _ := bill.GetRemainingAmount()
-	details = append(details, fmt.Sprintf("Remaining: %s", remaining.String()))
+	details = append(details, fmt.Sprintf("Remaining: %s", formatMoney(remaining)))
 
 	content := strings.Join(details, "\n")
 	sections = append(sections, detailsStyle.Render(content))
@@ -555,7 +629,7 @@ func (m *BillsModel) renderBillDetails() string {
 	sections = append(sections, progressStyle.Render(progressInfo))
 
 	// Actions help
-	help := "[e] Edit • [p] Add Payment • [c] Close Bill • [d] Delete • [b] Back"
+	help := "[e] Edit • [p] Add Payment • [c] Close Bill • [d] Delete • [h] History • [v] Coverage • [b] Back"
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
@@ -569,12 +643,18 @@ func (m *BillsModel) renderBillForm() string {
 		title = "✏️ Edit Bill"
 	}
 	sections = append(sections, style.TitleStyle.Render(title))
+	if note := m.formGuard.renderDraftNote(); note != "" {
+		sections = append(sections, note)
+	}
 
 	form := m.renderForm()
 	sections = append(sections, form)
 
 	help := m.renderFormHelp()
 	sections = append(sections, help)
+	if prompt := m.formGuard.renderPrompt(); prompt != "" {
+		sections = append(sections, prompt)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
@@ -668,7 +748,7 @@ func (m *BillsModel) renderFormButtons() string {
 }
 
 func (m *BillsModel) renderFormHelp() string {
-	help := "[Tab] Next Field • [Shift+Tab] Previous • [Enter] Confirm • [Esc] Cancel"
+	help := "[Tab] Next Field • [Shift+Tab] Previous • [Ctrl+E] Edit Description in $EDITOR • [Enter] Confirm • [Esc] Cancel"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -693,11 +773,11 @@ func (m *BillsModel) renderPaymentForm() string {
 	var fields []string
 
 	// Bill info
-	fields = append(fields, style.InfoStyle.Render(fmt.Sprintf("Total Amount: %s", m.paymentModel.bill.TotalAmount.String())))
-	fields = append(fields, style.InfoStyle.Render(fmt.Sprintf("Already Paid: %s", m.paymentModel.bill.PaidAmount.String())))
+	fields = append(fields, style.InfoStyle.Render(fmt.Sprintf("Total Amount: %s", formatMoney(m.paymentModel.bill.TotalAmount))))
+	fields = append(fields, style.InfoStyle.Render(fmt.Sprintf("Already Paid: %s", formatMoney(m.paymentModel.bill.PaidAmount))))
 
 	remaining, _ := m.paymentModel.bill.GetRemainingAmount()
-	fields = append(fields, style.WarningStyle.Render(fmt.Sprintf("Remaining: %s", remaining.String())))
+	fields = append(fields, style.WarningStyle.Render(fmt.Sprintf("Remaining: %s", formatMoney(remaining))))
 
 	fields = append(fields, "") // Empty line
 
@@ -781,7 +861,7 @@ func (m *BillsModel) renderConfirmDialog() string {
 
 	title := style.ErrorStyle.Render("⚠️  Confirm Delete")
 	message := fmt.Sprintf("Are you sure you want to delete bill '%s'?", bill.Name)
-	warning := style.WarningStyle.Render("This action cannot be undone!")
+	warning := style.WarningStyle.Render("Its transactions are kept but unlinked. This action cannot be undone!")
 	help := "[y] Yes, Delete • [n] Cancel"
 
 	content := lipgloss.JoinVertical(
@@ -798,7 +878,7 @@ func (m *BillsModel) renderConfirmDialog() string {
 }
 
 func (m *BillsModel) renderListHelp() string {
-	help := "[↑/↓] Navigate • [Enter] View • [n] New • [e] Edit • [p] Payment • [c] Close • [d] Delete • [r] Refresh • [b] Back"
+	help := "[↑/↓] Navigate • [Enter] View • [n] New • [e] Edit • [p] Payment • [c] Close • [d] Delete • [s] Sinking Funds • [r] Refresh • [b] Back"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -884,6 +964,7 @@ func (m *BillsModel) editBill() (tea.Model, tea.Cmd) {
 	m.formModel.startDateInput = bill.StartDate.Format("2006-01-02")
 	m.formModel.endDateInput = bill.EndDate.Format("2006-01-02")
 	m.formModel.dueDateInput = bill.DueDate.Format("2006-01-02")
+	m.formGuard.open(m.formFingerprint(), false)
 
 	return m, nil
 }
@@ -1001,9 +1082,21 @@ func (m *BillsModel) createBill(amount float64, startDate, endDate, dueDate time
 }
 
 func (m *BillsModel) updateBill(id uuid.UUID, amount float64, startDate, endDate, dueDate time.Time) tea.Msg {
-	// TODO: Implement UpdateBill in use case
-	// For now, return an error
-	return errMsg{err: fmt.Errorf("bill update not implemented in backend")}
+	_, err := m.billUseCase.UpdateBill(
+		m.ctx,
+		id,
+		m.formModel.nameInput,
+		m.formModel.descriptionInput,
+		startDate,
+		endDate,
+		dueDate,
+		amount,
+	)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return billActionMsg{}
 }
 
 func (m *BillsModel) submitPayment() (tea.Model, tea.Cmd) {
@@ -1028,15 +1121,40 @@ func (m *BillsModel) submitPayment() (tea.Model, tea.Cmd) {
 }
 
 func (m *BillsModel) resetForm() {
-	m.formModel.nameInput = ""
-	m.formModel.descriptionInput = ""
-	m.formModel.amountInput = ""
-	m.formModel.startDateInput = ""
-	m.formModel.endDateInput = ""
-	m.formModel.dueDateInput = ""
-	m.formModel.focusedField = 0
+	m.formModel = &BillFormModel{}
+}
+
+// formFingerprint sums up the fields of the form the user can change
+func (m *BillsModel) formFingerprint() string {
+	f := m.formModel
+	return fmt.Sprintf("%q %q %q %q %q %q",
+		f.nameInput, f.descriptionInput, f.amountInput, f.startDateInput, f.endDateInput, f.dueDateInput)
+}
+
+// draftFields is the form as a stored draft
+func (m *BillsModel) draftFields() map[string]string {
+	f := m.formModel
+	return map[string]string{
+		"name":        f.nameInput,
+		"description": f.descriptionInput,
+		"amount":      f.amountInput,
+		"start_date":  f.startDateInput,
+		"end_date":    f.endDateInput,
+		"due_date":    f.dueDateInput,
+	}
+}
+
+// applyDraftFields fills the form in from a stored draft
+func (m *BillsModel) applyDraftFields(fields map[string]string) {
+	f := m.formModel
+	f.nameInput = fields["name"]
+	f.descriptionInput = fields["description"]
+	f.amountInput = fields["amount"]
+	f.startDateInput = fields["start_date"]
+	f.endDateInput = fields["end_date"]
+	f.dueDateInput = fields["due_date"]
 }
 
 func (m *BillsModel) IsInFormMode() bool {
-	return m.viewMode == BillViewForm || m.viewMode == BillViewPayment || m.viewMode == BillViewConfirm
+	return m.viewMode == BillViewForm || m.viewMode == BillViewPayment || m.viewMode == BillViewConfirm || m.viewMode == BillViewSinkingFundForm
 }
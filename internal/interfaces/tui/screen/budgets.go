@@ -0,0 +1,465 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type BudgetsViewMode int
+
+const (
+	BudgetsViewList BudgetsViewMode = iota
+	BudgetsViewForm
+	BudgetsViewConfirmDelete
+	BudgetsViewWhatIf
+)
+
+type BudgetsModel struct {
+	ctx           context.Context
+	budgetUseCase *usecase.BudgetUseCase
+	reportUseCase *usecase.ReportUseCase
+
+	month         time.Time
+	progress      []*usecase.BudgetProgress
+	selectedIndex int
+	viewMode      BudgetsViewMode
+
+	loading bool
+	err     error
+	message string
+
+	// Form state; the category can't change once the budget exists
+	editing          *entity.Budget
+	focusedField     int // 0: category, 1: limit, 2: rollover, 3: save, 4: cancel
+	selectedCategory int
+	selectedRollover int
+	limitInput       string
+	formErr          error
+
+	// What-if simulator state
+	whatIf *whatIfModel
+}
+
+func NewBudgetsModel(ctx context.Context, budgetUC *usecase.BudgetUseCase, reportUC *usecase.ReportUseCase) tea.Model {
+	now := time.Now()
+	return &BudgetsModel{
+		ctx:           ctx,
+		budgetUseCase: budgetUC,
+		reportUseCase: reportUC,
+		month:         time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
+		viewMode:      BudgetsViewList,
+		loading:       true,
+	}
+}
+
+type budgetsLoadedMsg struct {
+	progress []*usecase.BudgetProgress
+}
+
+type budgetSavedMsg struct {
+	message string
+}
+
+func (m *BudgetsModel) Init() tea.Cmd {
+	return m.loadBudgets
+}
+
+func (m *BudgetsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case budgetsLoadedMsg:
+		m.loading = false
+		m.progress = msg.progress
+		if m.selectedIndex >= len(m.progress) {
+			m.selectedIndex = 0
+		}
+		return m, nil
+
+	case budgetSavedMsg:
+		m.viewMode = BudgetsViewList
+		m.message = msg.message
+		return m, m.loadBudgets
+
+	case whatIfSimulatedMsg:
+		if m.whatIf != nil {
+			m.whatIf.running = false
+			m.whatIf.simulation = msg.simulation
+			m.whatIf.err = msg.err
+		}
+		return m, nil
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case BudgetsViewList:
+			return m.handleListKeys(msg)
+		case BudgetsViewForm:
+			return m.handleFormKeys(msg)
+		case BudgetsViewConfirmDelete:
+			return m.handleConfirmKeys(msg)
+		case BudgetsViewWhatIf:
+			return m.handleWhatIfKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *BudgetsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(m.progress)-1 {
+			m.selectedIndex++
+		}
+	case "left", "h":
+		m.month = m.month.AddDate(0, -1, 0)
+		m.loading = true
+		return m, m.loadBudgets
+	case "right", "l":
+		m.month = m.month.AddDate(0, 1, 0)
+		m.loading = true
+		return m, m.loadBudgets
+	case "n":
+		m.openForm(nil)
+	case "e", "enter":
+		if len(m.progress) > 0 {
+			m.openForm(m.progress[m.selectedIndex].Budget)
+		}
+	case "d":
+		if len(m.progress) > 0 {
+			m.viewMode = BudgetsViewConfirmDelete
+		}
+	case "w":
+		if m.reportUseCase != nil {
+			m.openWhatIf()
+		}
+	case "r":
+		m.loading = true
+		m.err = nil
+		return m, m.loadBudgets
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *BudgetsModel) openForm(budget *entity.Budget) {
+	m.editing = budget
+	m.formErr = nil
+	m.message = ""
+	m.limitInput = ""
+	m.selectedCategory = 0
+	m.selectedRollover = 0
+	m.focusedField = 0
+	if budget != nil {
+		m.limitInput = fmt.Sprintf("%.2f", budget.MonthlyLimit.Amount())
+		for i, rollover := range budgetRollovers {
+			if rollover == budget.Rollover {
+				m.selectedRollover = i
+			}
+		}
+		m.focusedField = 1
+	}
+	m.viewMode = BudgetsViewForm
+}
+
+func budgetCategories() []entity.TransactionCategory {
+	var categories []entity.TransactionCategory
+	for _, category := range transactionCategories() {
+		if isSpendingCategory(category) {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+var budgetRollovers = []entity.BudgetRollover{
+	entity.BudgetRolloverReset,
+	entity.BudgetRolloverUnspent,
+	entity.BudgetRolloverOverspend,
+}
+
+func budgetRolloverName(rollover entity.BudgetRollover) string {
+	switch rollover {
+	case entity.BudgetRolloverUnspent:
+		return "Carry unspent to next month"
+	case entity.BudgetRolloverOverspend:
+		return "Deduct overspending next month"
+	}
+	return "Reset every month"
+}
+
+func (m *BudgetsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The category selector is skipped when editing
+	first := 0
+	if m.editing != nil {
+		first = 1
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = BudgetsViewList
+	case "tab", "down":
+		m.focusedField++
+		if m.focusedField > 4 {
+			m.focusedField = first
+		}
+	case "shift+tab", "up":
+		m.focusedField--
+		if m.focusedField < first {
+			m.focusedField = 4
+		}
+	case "enter":
+		switch m.focusedField {
+		case 3:
+			return m, m.saveBudget()
+		case 4:
+			m.viewMode = BudgetsViewList
+		}
+	case "left", "right":
+		switch m.focusedField {
+		case 0:
+			m.selectedCategory = cycleOption(m.selectedCategory, len(budgetCategories()), msg.String())
+		case 2:
+			m.selectedRollover = cycleOption(m.selectedRollover, len(budgetRollovers), msg.String())
+		}
+	default:
+		if m.focusedField == 1 {
+			m.limitInput = editAmountInput(m.limitInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *BudgetsModel) saveBudget() tea.Cmd {
+	limit, err := strconv.ParseFloat(m.limitInput, 64)
+	if err != nil || limit <= 0 {
+		m.formErr = fmt.Errorf("invalid monthly limit")
+		return nil
+	}
+	m.formErr = nil
+	rollover := budgetRollovers[m.selectedRollover]
+
+	if m.editing != nil {
+		budget := m.editing
+		return func() tea.Msg {
+			if _, err := m.budgetUseCase.UpdateBudget(m.ctx, budget.ID, limit, rollover); err != nil {
+				return errMsg{err}
+			}
+			return budgetSavedMsg{message: fmt.Sprintf("Updated the %s budget", categoryName(budget.Category))}
+		}
+	}
+
+	category := budgetCategories()[m.selectedCategory]
+	return func() tea.Msg {
+		if _, err := m.budgetUseCase.CreateBudget(m.ctx, category, limit, "BRL", rollover); err != nil {
+			return errMsg{err}
+		}
+		return budgetSavedMsg{message: fmt.Sprintf("Created a budget for %s", categoryName(category))}
+	}
+}
+
+func (m *BudgetsModel) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "enter":
+		budget := m.progress[m.selectedIndex].Budget
+		m.viewMode = BudgetsViewList
+		return m, func() tea.Msg {
+			if err := m.budgetUseCase.DeleteBudget(m.ctx, budget.ID); err != nil {
+				return errMsg{err}
+			}
+			return budgetSavedMsg{message: fmt.Sprintf("Deleted the %s budget", categoryName(budget.Category))}
+		}
+	case "n", "esc":
+		m.viewMode = BudgetsViewList
+	}
+
+	return m, nil
+}
+
+func (m *BudgetsModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading budgets...")
+	}
+
+	if m.err != nil {
+		return style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	}
+
+	switch m.viewMode {
+	case BudgetsViewForm:
+		return m.renderForm()
+	case BudgetsViewConfirmDelete:
+		return m.renderConfirmDelete()
+	case BudgetsViewWhatIf:
+		return m.renderWhatIf()
+	}
+	return m.renderList()
+}
+
+func (m *BudgetsModel) renderList() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🎯 Budgets — %s", m.month.Format("January 2006"))))
+
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	if len(m.progress) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No budgets yet. Press 'n' to set a monthly limit for a category."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-20s %-22s %5s %14s %14s %12s %14s", "Category", "Progress", "", "Spent", "Limit", "Carried", "Left"))}
+		var totalSpent, totalLimit float64
+		for i, progress := range m.progress {
+			totalSpent += progress.Spent
+			totalLimit += progress.Available()
+
+			carried := ""
+			if progress.Carried != 0 {
+				carried = fmt.Sprintf("%+.2f", progress.Carried)
+			}
+
+			left := formatAmount(progress.Remaining())
+			if progress.IsOver() {
+				left = style.ErrorStyle.Render(fmt.Sprintf("%14s", left))
+			} else {
+				left = fmt.Sprintf("%14s", left)
+			}
+
+			row := fmt.Sprintf("%s %s %4.0f%% %14s %14s %12s %s",
+				renderCategoryCell(progress.Budget.Category, 20),
+				renderBudgetBar(progress.Percentage(), 22),
+				progress.Percentage(),
+				formatAmount(progress.Spent),
+				formatMoney(progress.Budget.MonthlyLimit),
+				carried,
+				left)
+
+			if i == m.selectedIndex {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► ")+row)
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  ")+row)
+			}
+		}
+
+		total := fmt.Sprintf("Total: %s of %s", formatAmount(totalSpent), formatAmount(totalLimit))
+		if totalSpent > totalLimit {
+			total = style.ErrorStyle.Render(total + " — over budget")
+		} else {
+			total = style.InfoStyle.Render(total)
+		}
+		rows = append(rows, "", total)
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+	}
+
+	help := "[↑/↓] Navigate • [←/→] Month • [n] New • [e] Edit Limit • [d] Delete • [w] What If • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderBudgetBar fills up as the budget is spent, turning yellow past 80% and
+// red once it is exceeded
+func renderBudgetBar(percentage float64, width int) string {
+	filled := int(percentage * float64(width) / 100)
+	if filled > width {
+		filled = width
+	}
+
+	color := style.Success
+	switch {
+	case percentage > 100:
+		color = style.Danger
+	case percentage >= 80:
+		color = style.Warning
+	}
+
+	return lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(style.Border).Render(strings.Repeat("░", width-filled))
+}
+
+func (m *BudgetsModel) renderForm() string {
+	title := "🎯 New Budget"
+	category := budgetCategories()[m.selectedCategory]
+	if m.editing != nil {
+		title = "🎯 Edit Budget"
+		category = m.editing.Category
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(title))
+
+	if m.formErr != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.formErr)))
+	}
+
+	fields := []string{
+		renderDefaultSelector("Category:", categoryDisplayName(category), m.focusedField == 0),
+		renderTextField("Monthly Limit:", m.limitInput, m.focusedField == 1),
+		renderDefaultSelector("At Month End:", budgetRolloverName(budgetRollovers[m.selectedRollover]), m.focusedField == 2),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Expenses in this category count against the limit every month"))
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", m.focusedField, 3)))
+
+	help := "[Tab/↑↓] Navigate • [←/→] Category/Rollover • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *BudgetsModel) renderConfirmDelete() string {
+	budget := m.progress[m.selectedIndex].Budget
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Danger).
+		Padding(1, 2).
+		MarginTop(1)
+
+	content := fmt.Sprintf("Delete the %s budget of %s?\n\n%s",
+		categoryName(budget.Category),
+		formatMoney(budget.MonthlyLimit),
+		style.HelpStyle.Render("[y] Yes • [n] No"))
+
+	return dialogStyle.Render(content)
+}
+
+// IsInFormMode implements the FormModeChecker interface
+func (m *BudgetsModel) IsInFormMode() bool {
+	return m.viewMode == BudgetsViewForm || m.viewMode == BudgetsViewConfirmDelete || m.viewMode == BudgetsViewWhatIf
+}
+
+func (m *BudgetsModel) loadBudgets() tea.Msg {
+	progress, err := m.budgetUseCase.GetMonthProgress(m.ctx, m.month.Year(), m.month.Month())
+	if err != nil {
+		return errMsg{err}
+	}
+	return budgetsLoadedMsg{progress: progress}
+}
@@ -0,0 +1,397 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Choices offered by the picker; any other emoji or #RRGGBB color can be typed in
+var (
+	categoryIconChoices  = []string{"🍔", "🛒", "☕", "🚗", "⛽", "🚌", "💡", "🏠", "📱", "🎮", "🎬", "🛍️", "👕", "🏥", "💊", "📚", "🎓", "💰", "💵", "🔄", "✈️", "🐶", "🎁", "📋", "⭐"}
+	categoryColorChoices = []string{"#EF4444", "#F97316", "#F59E0B", "#EAB308", "#84CC16", "#10B981", "#14B8A6", "#06B6D4", "#3B82F6", "#6366F1", "#7D56F4", "#A855F7", "#EC4899", "#9CA3AF"}
+)
+
+type CategoriesViewMode int
+
+const (
+	CategoriesViewList CategoriesViewMode = iota
+	CategoriesViewForm
+	CategoriesViewRules
+	CategoriesViewRuleForm
+	CategoriesViewBackApply
+	CategoriesViewCategoryForm
+)
+
+type CategoriesModel struct {
+	ctx               context.Context
+	appearanceUseCase *usecase.CategoryAppearanceUseCase
+	ruleUseCase       *usecase.CategoryRuleUseCase
+	categoryUseCase   *usecase.CategoryUseCase
+
+	customized    map[entity.TransactionCategory]bool
+	selectedIndex int
+	viewMode      CategoriesViewMode
+
+	loading bool
+	err     error
+	message string
+
+	// Picker state
+	focusedField int // 0: icon, 1: color, 2: save, 3: cancel
+	iconInput    string
+	colorInput   string
+
+	// Rules state
+	rules     []*entity.CategoryRule
+	ruleIndex int
+	ruleForm  *categoryRuleFormModel
+	backApply *categoryRuleBackApply
+
+	// categoryForm adds a category or edits one added by the user
+	categoryForm *categoryFormModel
+}
+
+func NewCategoriesModel(ctx context.Context, appearanceUC *usecase.CategoryAppearanceUseCase, ruleUC *usecase.CategoryRuleUseCase, categoryUC *usecase.CategoryUseCase) tea.Model {
+	return &CategoriesModel{
+		ctx:               ctx,
+		appearanceUseCase: appearanceUC,
+		ruleUseCase:       ruleUC,
+		categoryUseCase:   categoryUC,
+		customized:        make(map[entity.TransactionCategory]bool),
+		viewMode:          CategoriesViewList,
+		loading:           true,
+	}
+}
+
+// CategoryAppearancesLoadedMsg carries the categories added by the user and
+// the customized category looks. The app routes it to the categories screen
+// whichever screen is shown, since every screen renders categories.
+type CategoryAppearancesLoadedMsg struct {
+	categories  []*entity.Category
+	appearances []*entity.CategoryAppearance
+}
+
+type categoryAppearanceSavedMsg struct {
+	message string
+}
+
+func (m *CategoriesModel) Init() tea.Cmd {
+	return m.loadAppearances
+}
+
+func (m *CategoriesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case CategoryAppearancesLoadedMsg:
+		m.loading = false
+		SetCustomCategories(msg.categories)
+		SetCategoryAppearances(msg.appearances)
+		if m.selectedIndex >= len(transactionCategories()) {
+			m.selectedIndex = len(transactionCategories()) - 1
+		}
+		m.customized = make(map[entity.TransactionCategory]bool)
+		for _, appearance := range msg.appearances {
+			m.customized[appearance.Category] = true
+		}
+		return m, nil
+
+	case categoryAppearanceSavedMsg:
+		m.viewMode = CategoriesViewList
+		m.categoryForm = nil
+		m.message = msg.message
+		return m, m.loadAppearances
+
+	case categoryRulesLoadedMsg:
+		m.rules = msg.rules
+		if m.ruleIndex >= len(m.rules) && m.ruleIndex > 0 {
+			m.ruleIndex = len(m.rules) - 1
+		}
+		return m, nil
+
+	case categoryRuleCreatedMsg:
+		// Offer to apply the new rule to the transactions recorded so far
+		m.ruleForm = nil
+		return m.previewRule(msg.rule)
+
+	case categoryRulePreviewMsg:
+		if m.backApply != nil {
+			m.backApply.loading = false
+			m.backApply.matches = msg.matches
+		}
+		return m, nil
+
+	case categoryRuleBatchAppliedMsg:
+		return m, m.updateBackApply(msg)
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		if m.backApply != nil {
+			m.backApply.loading = false
+			m.backApply.applying = false
+			m.backApply.stopping = false
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case CategoriesViewList:
+			return m.handleListKeys(msg)
+		case CategoriesViewForm:
+			return m.handleFormKeys(msg)
+		case CategoriesViewRules:
+			return m.handleRulesKeys(msg)
+		case CategoriesViewRuleForm:
+			return m.handleRuleFormKeys(msg)
+		case CategoriesViewBackApply:
+			return m.handleBackApplyKeys(msg)
+		case CategoriesViewCategoryForm:
+			return m.handleCategoryFormKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	categories := transactionCategories()
+
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(categories)-1 {
+			m.selectedIndex++
+		}
+	case "enter", "e":
+		category := categories[m.selectedIndex]
+		look, _ := categoryLookFor(category)
+		m.iconInput = look.icon
+		m.colorInput = look.color
+		m.focusedField = 0
+		m.err = nil
+		m.message = ""
+		m.viewMode = CategoriesViewForm
+	case "x":
+		category := categories[m.selectedIndex]
+		if m.customized[category] {
+			m.err = nil
+			return m, m.resetAppearance(category)
+		}
+	case "n":
+		m.openCategoryForm(nil)
+	case "m":
+		if category := customCategory(categories[m.selectedIndex]); category != nil {
+			m.openCategoryForm(category)
+		}
+	case "d":
+		if category := customCategory(categories[m.selectedIndex]); category != nil {
+			m.err = nil
+			return m, m.deleteCategory(category)
+		}
+	case "u":
+		return m.openRules()
+	case "r":
+		m.loading = true
+		return m, m.loadAppearances
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = CategoriesViewList
+		m.err = nil
+	case "tab", "down":
+		m.focusedField = (m.focusedField + 1) % 4
+	case "shift+tab", "up":
+		m.focusedField = (m.focusedField - 1 + 4) % 4
+	case "enter":
+		switch m.focusedField {
+		case 2:
+			return m, m.saveAppearance
+		case 3:
+			m.viewMode = CategoriesViewList
+			m.err = nil
+		}
+	case "left", "right":
+		switch m.focusedField {
+		case 0:
+			m.iconInput = categoryIconChoices[cycleOption(indexOf(categoryIconChoices, m.iconInput), len(categoryIconChoices), msg.String())]
+		case 1:
+			m.colorInput = categoryColorChoices[cycleOption(indexOf(categoryColorChoices, strings.ToUpper(m.colorInput)), len(categoryColorChoices), msg.String())]
+		}
+	default:
+		switch m.focusedField {
+		case 0:
+			// A typed or pasted emoji replaces the icon instead of adding to it
+			if msg.String() == "backspace" {
+				m.iconInput = ""
+			} else if text := typedText(msg); strings.TrimSpace(text) != "" {
+				m.iconInput = strings.TrimSpace(text)
+			}
+		case 1:
+			m.colorInput = editTextInput(m.colorInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading categories...")
+	}
+
+	switch m.viewMode {
+	case CategoriesViewForm:
+		return m.renderForm()
+	case CategoriesViewRules:
+		return m.renderRules()
+	case CategoriesViewRuleForm:
+		return m.renderRuleForm()
+	case CategoriesViewBackApply:
+		return m.renderBackApply()
+	case CategoriesViewCategoryForm:
+		return m.renderCategoryForm()
+	}
+	return m.renderList()
+}
+
+func (m *CategoriesModel) renderList() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🏷️  Categories"))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-6s %-20s %-10s %s", "Icon", "Category", "Color", ""))}
+	for i, category := range transactionCategories() {
+		look, _ := categoryLookFor(category)
+
+		status := "default"
+		if m.customized[category] {
+			status = "custom"
+		}
+		if custom := customCategory(category); custom != nil {
+			status += fmt.Sprintf(" • added, %s", categoryKindNames[indexOf(categoryKinds, custom.Kind)])
+		}
+
+		line := fmt.Sprintf("%-6s %-20s %-10s %s  %s",
+			look.icon,
+			categoryName(category),
+			look.color,
+			lipgloss.NewStyle().Foreground(lipgloss.Color(look.color)).Render("████"),
+			status)
+
+		if i == m.selectedIndex {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		rows = append(rows, line)
+	}
+	sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+
+	help := "[↑/↓] Navigate • [Enter/e] Customize • [x] Reset to Default • [n] New Category • [u] Rules • [r] Refresh • [b] Back"
+	if customCategory(transactionCategories()[m.selectedIndex]) != nil {
+		help = "[m] Edit • [d] Delete • " + help
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *CategoriesModel) renderForm() string {
+	category := transactionCategories()[m.selectedIndex]
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🎨 Customize %s", categoryName(category))))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+
+	fields := []string{
+		renderDefaultSelector("Icon:", m.iconInput, m.focusedField == 0),
+		renderDefaultSelector("Color:", m.colorInput, m.focusedField == 1),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+
+	preview := m.iconInput + " " + categoryName(category)
+	if hexColorInput(m.colorInput) {
+		preview = lipgloss.NewStyle().Foreground(lipgloss.Color(m.colorInput)).Bold(true).Render(preview)
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render("Preview: "+preview))
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", m.focusedField, 2)))
+
+	help := "[Tab/↑↓] Navigate • [←/→] Pick • Type an emoji or #RRGGBB to use your own • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// hexColorInput tells whether the color being typed is complete enough to preview
+func hexColorInput(value string) bool {
+	if len(value) != 7 || value[0] != '#' {
+		return false
+	}
+	return strings.Trim(strings.ToLower(value[1:]), "0123456789abcdef") == ""
+}
+
+// IsInFormMode implements the FormModeChecker interface
+func (m *CategoriesModel) IsInFormMode() bool {
+	return m.viewMode == CategoriesViewForm || m.viewMode == CategoriesViewRuleForm || m.viewMode == CategoriesViewBackApply || m.viewMode == CategoriesViewCategoryForm
+}
+
+func (m *CategoriesModel) loadAppearances() tea.Msg {
+	categories, err := m.categoryUseCase.ListCategories(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	appearances, err := m.appearanceUseCase.ListAppearances(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	return CategoryAppearancesLoadedMsg{categories: categories, appearances: appearances}
+}
+
+func (m *CategoriesModel) saveAppearance() tea.Msg {
+	category := transactionCategories()[m.selectedIndex]
+	if _, err := m.appearanceUseCase.SaveAppearance(m.ctx, category, m.iconInput, m.colorInput); err != nil {
+		return errMsg{err}
+	}
+	return categoryAppearanceSavedMsg{message: fmt.Sprintf("Saved the look of %s", categoryName(category))}
+}
+
+func (m *CategoriesModel) resetAppearance(category entity.TransactionCategory) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.appearanceUseCase.ResetAppearance(m.ctx, category); err != nil {
+			return errMsg{err}
+		}
+		return categoryAppearanceSavedMsg{message: fmt.Sprintf("%s is back to its default look", categoryName(category))}
+	}
+}
@@ -0,0 +1,371 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// categoryRuleBatchSize is how many transactions a back-apply step
+// recategorizes before reporting its progress
+const categoryRuleBatchSize = 25
+
+// maxBackApplyRows caps the transactions listed in the back-apply preview
+const maxBackApplyRows = 10
+
+// categoryRuleFormModel creates a keyword rule
+type categoryRuleFormModel struct {
+	// Form: 0: keyword, 1: category, 2: save, 3: cancel
+	focusedField  int
+	keywordInput  string
+	categoryIndex int
+	err           error
+}
+
+// categoryRuleBackApply previews the past transactions a rule matches and
+// recategorizes them in batches
+type categoryRuleBackApply struct {
+	rule     *entity.CategoryRule
+	matches  []*entity.Transaction
+	loading  bool
+	applying bool
+	// stopping ends the back-apply once the batch in flight is done
+	stopping bool
+	applied  int
+}
+
+type categoryRulesLoadedMsg struct {
+	rules []*entity.CategoryRule
+}
+
+type categoryRuleCreatedMsg struct {
+	rule *entity.CategoryRule
+}
+
+type categoryRulePreviewMsg struct {
+	matches []*entity.Transaction
+}
+
+type categoryRuleBatchAppliedMsg struct {
+	count int
+}
+
+func (m *CategoriesModel) openRules() (tea.Model, tea.Cmd) {
+	m.viewMode = CategoriesViewRules
+	m.ruleIndex = 0
+	m.err = nil
+	m.message = ""
+	return m, m.loadRules
+}
+
+func (m *CategoriesModel) handleRulesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.ruleIndex > 0 {
+			m.ruleIndex--
+		}
+	case "down", "j":
+		if m.ruleIndex < len(m.rules)-1 {
+			m.ruleIndex++
+		}
+	case "n":
+		m.ruleForm = &categoryRuleFormModel{}
+		m.err = nil
+		m.message = ""
+		m.viewMode = CategoriesViewRuleForm
+	case "a", "enter":
+		if m.ruleIndex < len(m.rules) {
+			return m.previewRule(m.rules[m.ruleIndex])
+		}
+	case "d":
+		if m.ruleIndex < len(m.rules) {
+			rule := m.rules[m.ruleIndex]
+			m.err = nil
+			return m, func() tea.Msg {
+				if err := m.ruleUseCase.DeleteRule(m.ctx, rule.ID); err != nil {
+					return errMsg{err}
+				}
+				return m.loadRules()
+			}
+		}
+	case "esc", "b":
+		m.viewMode = CategoriesViewList
+		m.err = nil
+		m.message = ""
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) handleRuleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.ruleForm
+	categories := transactionCategories()
+
+	switch msg.String() {
+	case "esc":
+		m.ruleForm = nil
+		m.viewMode = CategoriesViewRules
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 4
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 4) % 4
+	case "enter":
+		switch form.focusedField {
+		case 2:
+			return m.submitRule()
+		case 3:
+			m.ruleForm = nil
+			m.viewMode = CategoriesViewRules
+		}
+	default:
+		switch form.focusedField {
+		case 0:
+			form.keywordInput = editTextInput(form.keywordInput, msg)
+		case 1:
+			form.categoryIndex = cycleOption(form.categoryIndex, len(categories), msg.String())
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) submitRule() (tea.Model, tea.Cmd) {
+	form := m.ruleForm
+	if strings.TrimSpace(form.keywordInput) == "" {
+		form.err = fmt.Errorf("keyword is required")
+		return m, nil
+	}
+
+	keyword := form.keywordInput
+	category := transactionCategories()[form.categoryIndex]
+	return m, func() tea.Msg {
+		rule, err := m.ruleUseCase.CreateRule(m.ctx, keyword, category)
+		if err != nil {
+			return errMsg{err}
+		}
+		return categoryRuleCreatedMsg{rule: rule}
+	}
+}
+
+// previewRule looks for the past transactions the rule would recategorize
+func (m *CategoriesModel) previewRule(rule *entity.CategoryRule) (tea.Model, tea.Cmd) {
+	m.backApply = &categoryRuleBackApply{rule: rule, loading: true}
+	m.viewMode = CategoriesViewBackApply
+	m.err = nil
+	m.message = ""
+	return m, func() tea.Msg {
+		matches, err := m.ruleUseCase.PreviewRule(m.ctx, rule)
+		if err != nil {
+			return errMsg{err}
+		}
+		return categoryRulePreviewMsg{matches: matches}
+	}
+}
+
+func (m *CategoriesModel) handleBackApplyKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	backApply := m.backApply
+
+	if backApply.applying {
+		if msg.String() == "esc" {
+			backApply.stopping = true
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "a", "enter":
+		if !backApply.loading && backApply.applied < len(backApply.matches) {
+			backApply.applying = true
+			m.err = nil
+			return m, m.applyRuleBatch()
+		}
+	case "esc", "b":
+		m.backApply = nil
+		m.viewMode = CategoriesViewRules
+		m.err = nil
+		return m, m.loadRules
+	}
+
+	return m, nil
+}
+
+// applyRuleBatch recategorizes the next batch of the preview
+func (m *CategoriesModel) applyRuleBatch() tea.Cmd {
+	backApply := m.backApply
+	end := backApply.applied + categoryRuleBatchSize
+	if end > len(backApply.matches) {
+		end = len(backApply.matches)
+	}
+	batch := backApply.matches[backApply.applied:end]
+	rule := backApply.rule
+
+	return func() tea.Msg {
+		if err := m.ruleUseCase.ApplyRule(m.ctx, rule, batch); err != nil {
+			return errMsg{err}
+		}
+		return categoryRuleBatchAppliedMsg{count: len(batch)}
+	}
+}
+
+// updateBackApply counts a finished batch and starts the next one, if any
+func (m *CategoriesModel) updateBackApply(msg categoryRuleBatchAppliedMsg) tea.Cmd {
+	backApply := m.backApply
+	if backApply == nil {
+		return nil
+	}
+
+	backApply.applied += msg.count
+	if backApply.applied < len(backApply.matches) && !backApply.stopping {
+		return m.applyRuleBatch()
+	}
+
+	backApply.applying = false
+	backApply.stopping = false
+	if backApply.applied == len(backApply.matches) {
+		m.message = fmt.Sprintf("Moved %d transactions to %s", backApply.applied, categoryName(backApply.rule.Category))
+	} else {
+		m.message = fmt.Sprintf("Stopped after %d of %d transactions", backApply.applied, len(backApply.matches))
+	}
+	return nil
+}
+
+func (m *CategoriesModel) loadRules() tea.Msg {
+	rules, err := m.ruleUseCase.ListRules(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	return categoryRulesLoadedMsg{rules: rules}
+}
+
+func (m *CategoriesModel) renderRules() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📐 Category Rules"))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-30s %s", "Description contains", "Category"))}
+	if len(m.rules) == 0 {
+		rows = append(rows, style.InfoStyle.Render("No rules yet. Press 'n' to file transactions by a keyword."))
+	}
+	for i, rule := range m.rules {
+		line := fmt.Sprintf("%-30s %s", truncateString(rule.Keyword, 30), categoryDisplayName(rule.Category))
+		if i == m.ruleIndex {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		rows = append(rows, line)
+	}
+	sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Imported transactions are categorized by these rules; the longest matching keyword wins"))
+
+	help := "[↑/↓] Navigate • [n] New Rule • [a] Apply to Past Transactions • [d] Delete • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *CategoriesModel) renderRuleForm() string {
+	form := m.ruleForm
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📐 New Category Rule"))
+
+	if form.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", form.err)))
+	} else if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+
+	fields := []string{
+		renderTextField("Description contains:", form.keywordInput, form.focusedField == 0),
+		renderDefaultSelector("Category:", categoryDisplayName(transactionCategories()[form.categoryIndex]), form.focusedField == 1),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("The keyword is matched anywhere in the description, ignoring case"))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", form.focusedField, 2)))
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [←/→] Change Category • [Enter] Confirm • [Esc] Cancel"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *CategoriesModel) renderBackApply() string {
+	backApply := m.backApply
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("📐 Apply \"%s\" → %s", backApply.rule.Keyword, categoryName(backApply.rule.Category))))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	if backApply.loading {
+		sections = append(sections, style.InfoStyle.Render("Looking for matching transactions..."))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	total := len(backApply.matches)
+	if total == 0 {
+		sections = append(sections, boxStyle.Render(style.InfoStyle.Render("No past transaction needs to change")))
+		sections = append(sections, style.HelpStyle.MarginTop(1).Render("[b] Back"))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	rows := []string{style.SubtitleStyle.Render(fmt.Sprintf("%d past transactions would move to %s", total, categoryName(backApply.rule.Category)))}
+	for i, txn := range backApply.matches {
+		if i == maxBackApplyRows {
+			rows = append(rows, lipgloss.NewStyle().Foreground(style.TextMuted).Render(fmt.Sprintf("... and %d more", total-maxBackApplyRows)))
+			break
+		}
+		rows = append(rows, fmt.Sprintf("%s  %-30s %-16s → %s",
+			txn.Date.Format("2006-01-02"), truncateString(txn.Description, 30), categoryDisplayName(txn.Category), categoryDisplayName(backApply.rule.Category)))
+	}
+	sections = append(sections, boxStyle.Render(strings.Join(rows, "\n")))
+
+	if backApply.applying || backApply.applied > 0 {
+		percentage := float64(backApply.applied) / float64(total) * 100
+		filled := int(percentage * 50 / 100)
+		bar := lipgloss.NewStyle().Foreground(style.Success).Render(strings.Repeat("█", filled) + strings.Repeat("░", 50-filled))
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(fmt.Sprintf("%s %d/%d", bar, backApply.applied, total)))
+	}
+
+	var help string
+	switch {
+	case backApply.stopping:
+		help = "Stopping after this batch..."
+	case backApply.applying:
+		help = "Recategorizing... • [Esc] Stop"
+	case backApply.applied == total:
+		help = "[b] Back"
+	case backApply.applied > 0:
+		help = fmt.Sprintf("[a] Resume (%d left) • [b] Back", total-backApply.applied)
+	default:
+		help = fmt.Sprintf("[a] Apply to %d Transactions • [b] Skip", total)
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
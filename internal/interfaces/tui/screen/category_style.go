@@ -0,0 +1,112 @@
+package screen
+
+import (
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type categoryLook struct {
+	icon  string
+	color string
+}
+
+// defaultCategoryLooks is how each category is shown until the user customizes it
+var defaultCategoryLooks = map[entity.TransactionCategory]categoryLook{
+	entity.TransactionCategoryFood:           {icon: "🍔", color: "#F97316"},
+	entity.TransactionCategoryTransportation: {icon: "🚗", color: "#3B82F6"},
+	entity.TransactionCategoryUtilities:      {icon: "💡", color: "#EAB308"},
+	entity.TransactionCategoryEntertainment:  {icon: "🎮", color: "#A855F7"},
+	entity.TransactionCategoryShopping:       {icon: "🛍️", color: "#EC4899"},
+	entity.TransactionCategoryHealthcare:     {icon: "🏥", color: "#EF4444"},
+	entity.TransactionCategoryEducation:      {icon: "📚", color: "#6366F1"},
+	entity.TransactionCategoryIncome:         {icon: "💰", color: "#10B981"},
+	entity.TransactionCategoryTransfer:       {icon: "🔄", color: "#06B6D4"},
+	entity.TransactionCategoryOther:          {icon: "📋", color: "#9CA3AF"},
+}
+
+// customCategoryColor is the color of the categories added by the user until
+// they pick one
+const customCategoryColor = "#7D56F4"
+
+// customCategoryLooks holds the icons and colors picked by the user. Like
+// privacy mode it is shared by every screen, so a change shows up everywhere.
+var customCategoryLooks = map[entity.TransactionCategory]categoryLook{}
+
+// SetCategoryAppearances replaces the customized category icons and colors
+func SetCategoryAppearances(appearances []*entity.CategoryAppearance) {
+	looks := make(map[entity.TransactionCategory]categoryLook, len(appearances))
+	for _, appearance := range appearances {
+		looks[appearance.Category] = categoryLook{icon: appearance.Icon, color: appearance.Color}
+	}
+	customCategoryLooks = looks
+}
+
+// customCategories are the categories added by the user, shared by every
+// screen like their looks
+var customCategories []*entity.Category
+
+// SetCustomCategories replaces the categories added by the user
+func SetCustomCategories(categories []*entity.Category) {
+	customCategories = categories
+}
+
+// customCategory returns the category added by the user with the key, or nil
+// for the built-in ones
+func customCategory(cat entity.TransactionCategory) *entity.Category {
+	for _, category := range customCategories {
+		if category.Key == cat {
+			return category
+		}
+	}
+	return nil
+}
+
+// isSpendingCategory tells whether a category files spending, which budgets
+// and spending breakdowns cover. Income and transfers between the user's own
+// accounts aren't spending.
+func isSpendingCategory(cat entity.TransactionCategory) bool {
+	if cat == entity.TransactionCategoryIncome || cat == entity.TransactionCategoryTransfer {
+		return false
+	}
+	if category := customCategory(cat); category != nil {
+		return category.Kind != entity.CategoryKindIncome
+	}
+	return true
+}
+
+func categoryLookFor(cat entity.TransactionCategory) (categoryLook, bool) {
+	if look, ok := customCategoryLooks[cat]; ok {
+		return look, true
+	}
+	if look, ok := defaultCategoryLooks[cat]; ok {
+		return look, true
+	}
+	if category := customCategory(cat); category != nil {
+		return categoryLook{icon: category.Icon, color: customCategoryColor}, true
+	}
+	return categoryLook{}, false
+}
+
+func categoryIcon(cat entity.TransactionCategory) string {
+	look, _ := categoryLookFor(cat)
+	return look.icon
+}
+
+func categoryColor(cat entity.TransactionCategory) lipgloss.Color {
+	look, ok := categoryLookFor(cat)
+	if !ok {
+		return style.TextMuted
+	}
+	return lipgloss.Color(look.color)
+}
+
+// renderCategoryCell renders the category name in its color, truncated and
+// padded to width so table columns stay aligned
+func renderCategoryCell(cat entity.TransactionCategory, width int) string {
+	return lipgloss.NewStyle().
+		Foreground(categoryColor(cat)).
+		Width(width).
+		Render(truncateString(categoryDisplayName(cat), width))
+}
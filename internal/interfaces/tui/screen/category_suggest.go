@@ -0,0 +1,66 @@
+package screen
+
+import (
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minCategorySuggestionConfidence is how sure the classifier must be before its
+// suggestion is pre-selected; less likely ones are only shown as a hint
+const minCategorySuggestionConfidence = 0.4
+
+type categoryClassifierLoadedMsg struct {
+	classifier *entity.CategoryClassifier
+}
+
+func (m *TransactionsModel) loadCategoryClassifier() tea.Msg {
+	if m.suggestionUseCase == nil {
+		return categoryClassifierLoadedMsg{}
+	}
+	classifier, err := m.suggestionUseCase.Classifier(m.ctx)
+	if err != nil {
+		// Suggestions are a convenience, the form works without them
+		return categoryClassifierLoadedMsg{}
+	}
+	return categoryClassifierLoadedMsg{classifier: classifier}
+}
+
+// categorySuggestion is the category past transactions point to for the
+// description typed so far
+func (m *TransactionsModel) categorySuggestion() (entity.CategorySuggestion, bool) {
+	if m.formModel.classifier == nil {
+		return entity.CategorySuggestion{}, false
+	}
+	return m.formModel.classifier.Suggest(m.formModel.descriptionInput)
+}
+
+// applyCategorySuggestion pre-selects the suggested category on a new
+// transaction, without overriding a category the user already picked
+func (m *TransactionsModel) applyCategorySuggestion() {
+	if m.formModel.editing || m.formModel.categoryTouched {
+		return
+	}
+	if suggestion, ok := m.categorySuggestion(); ok && suggestion.Confidence >= minCategorySuggestionConfidence {
+		m.selectFormCategory(suggestion.Category)
+	}
+}
+
+// renderCategorySuggestionHint tells how likely the suggested category is, or
+// which one is suggested when another is selected
+func (m *TransactionsModel) renderCategorySuggestionHint(selected entity.TransactionCategory) string {
+	suggestion, ok := m.categorySuggestion()
+	if !ok {
+		return ""
+	}
+
+	hint := fmt.Sprintf("💡 %.0f%% likely", suggestion.Confidence*100)
+	if suggestion.Category != selected {
+		hint = fmt.Sprintf("💡 Suggested: %s (%.0f%%)", m.getCategoryDisplay(suggestion.Category), suggestion.Confidence*100)
+	}
+	return " " + lipgloss.NewStyle().Foreground(style.TextMuted).Render(hint)
+}
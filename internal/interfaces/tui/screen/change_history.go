@@ -0,0 +1,131 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// ChangeHistoryModel lists the recorded changes of one transaction or bill and
+// lets the user revert a selected change
+type ChangeHistoryModel struct {
+	entityType    entity.ChangeEntityType
+	entityID      uuid.UUID
+	title         string
+	records       []*entity.ChangeRecord
+	selectedIndex int
+}
+
+type changeHistoryLoadedMsg struct {
+	records []*entity.ChangeRecord
+}
+
+type changeRevertedMsg struct{}
+
+func newChangeHistoryModel(entityType entity.ChangeEntityType, entityID uuid.UUID, title string) *ChangeHistoryModel {
+	return &ChangeHistoryModel{entityType: entityType, entityID: entityID, title: title}
+}
+
+func (h *ChangeHistoryModel) load(ctx context.Context, historyUC *usecase.ChangeHistoryUseCase) tea.Cmd {
+	entityType, entityID := h.entityType, h.entityID
+	return func() tea.Msg {
+		records, err := historyUC.GetHistory(ctx, entityType, entityID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return changeHistoryLoadedMsg{records: records}
+	}
+}
+
+func (h *ChangeHistoryModel) setRecords(records []*entity.ChangeRecord) {
+	h.records = records
+	if h.selectedIndex >= len(records) {
+		h.selectedIndex = 0
+	}
+}
+
+// handleKeys moves the selection or reverts the selected change, returning a
+// command when the revert was requested
+func (h *ChangeHistoryModel) handleKeys(ctx context.Context, historyUC *usecase.ChangeHistoryUseCase, key string) tea.Cmd {
+	switch key {
+	case "up", "k":
+		if h.selectedIndex > 0 {
+			h.selectedIndex--
+		}
+	case "down", "j":
+		if h.selectedIndex < len(h.records)-1 {
+			h.selectedIndex++
+		}
+	case "r":
+		if h.selectedIndex >= len(h.records) || h.records[h.selectedIndex].IsReverted() {
+			return nil
+		}
+		record := h.records[h.selectedIndex]
+		return func() tea.Msg {
+			if err := historyUC.RevertChange(ctx, record.ID); err != nil {
+				return errMsg{err: err}
+			}
+			return changeRevertedMsg{}
+		}
+	}
+	return nil
+}
+
+func (h *ChangeHistoryModel) render() string {
+	var sections []string
+
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🕘 History: %s", h.title)))
+
+	if len(h.records) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No changes recorded yet."))
+	} else {
+		listStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		var rows []string
+		for i, record := range h.records {
+			header := fmt.Sprintf("%s  %s", record.CreatedAt.Format("2006-01-02 15:04"), record.Summary)
+			if record.IsReverted() {
+				header += style.HelpStyle.Render(" (reverted)")
+			}
+			if i == h.selectedIndex {
+				header = style.SelectedMenuItemStyle.Render("► " + header)
+			} else {
+				header = "  " + header
+			}
+			rows = append(rows, header)
+
+			for _, change := range record.Changes {
+				before, after := displayChangeValue(change.Before), displayChangeValue(change.After)
+				if privacyMode && strings.HasSuffix(change.Field, "_amount") {
+					before, after = maskedAmount, maskedAmount
+				}
+				rows = append(rows, fmt.Sprintf("      %s: %s → %s", change.Field, before, after))
+			}
+		}
+		sections = append(sections, listStyle.Render(strings.Join(rows, "\n")))
+	}
+
+	help := "[↑/↓] Navigate • [r] Revert Change • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func displayChangeValue(value string) string {
+	if value == "" {
+		return "(empty)"
+	}
+	return value
+}
@@ -0,0 +1,155 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type openInvoicesLoadedMsg struct {
+	invoices []usecase.OpenInvoice
+}
+
+func (m *CreditCardsModel) loadOpenInvoices() tea.Msg {
+	invoices, err := m.creditCardInvoiceUseCase.GetOpenInvoices(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return openInvoicesLoadedMsg{invoices: invoices}
+}
+
+// openOpenInvoices switches to the tab of every card's open invoice
+func (m *CreditCardsModel) openOpenInvoices() (tea.Model, tea.Cmd) {
+	m.viewMode = CreditCardViewOpenInvoices
+	m.loading = true
+	return m, m.loadOpenInvoices
+}
+
+func (m *CreditCardsModel) handleOpenInvoicesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "tab", "esc":
+		m.viewMode = CreditCardViewList
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(m.openInvoices)-1 {
+			m.selectedIndex++
+		}
+	case "enter":
+		// The rows follow the cards list, so the selection carries over
+		if m.selectedIndex < len(m.openInvoices) {
+			card := m.openInvoices[m.selectedIndex].Card
+			m.viewMode = CreditCardViewInvoices
+			m.loading = true
+			return m, m.loadInvoices(card.ID)
+		}
+	case "r":
+		m.loading = true
+		return m, m.loadOpenInvoices
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+// renderCardTabs is the tab bar of the list, with the active tab highlighted
+func (m *CreditCardsModel) renderCardTabs() string {
+	tabs := []string{"Cards", "Open Invoices"}
+	active := 0
+	if m.viewMode == CreditCardViewOpenInvoices {
+		active = 1
+	}
+
+	rendered := make([]string, len(tabs))
+	for i, tab := range tabs {
+		if i == active {
+			rendered[i] = style.SelectedMenuItemStyle.Render("[" + tab + "]")
+		} else {
+			rendered[i] = style.MenuItemStyle.Render(" " + tab + " ")
+		}
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Left, rendered...)
+}
+
+func (m *CreditCardsModel) renderOpenInvoices() string {
+	var sections []string
+
+	sections = append(sections, style.TitleStyle.Render("💳 Credit Cards Management"))
+	sections = append(sections, m.renderCardTabs())
+
+	if len(m.openInvoices) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No credit cards found. Press 'n' on the Cards tab to add one."))
+	} else {
+		sections = append(sections, m.renderOpenInvoicesTable(time.Now()))
+	}
+
+	help := "[↑/↓] Navigate • [Enter] Invoices • [Tab] Cards • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *CreditCardsModel) renderOpenInvoicesTable(now time.Time) string {
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	rows := []string{style.TableHeaderStyle.Render(
+		fmt.Sprintf("%-20s %-8s %14s  %-18s %-18s", "Card", "Month", "So Far", "Closes", "Due"))}
+
+	var total float64
+	for i, open := range m.openInvoices {
+		name := truncateString(open.Card.Name, 20)
+
+		var row string
+		if invoice := open.Invoice; invoice == nil {
+			row = fmt.Sprintf("%-20s %-8s %14s  %s", name, "—", "—", style.HelpStyle.Render("no open invoice yet"))
+		} else {
+			total += invoice.ClosingBalance.Amount()
+			row = fmt.Sprintf("%-20s %-8s %14s  %-18s %-18s", name, invoice.ReferenceMonth, formatMoney(invoice.ClosingBalance),
+				relativeDay(invoice.ClosingDate, now), relativeDay(invoice.DueDate, now))
+		}
+
+		if i == m.selectedIndex {
+			row = style.SelectedMenuItemStyle.Render("► " + row)
+		} else {
+			row = style.MenuItemStyle.Render("  " + row)
+		}
+		rows = append(rows, row)
+	}
+
+	rows = append(rows, renderTotalsRow(fmt.Sprintf("%-20s %-8s %14s",
+		"Total", countLabel(len(m.openInvoices), "card"), formatAmount(total)), true))
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
+
+// relativeDay tells how many days from now's day date is, such as "in 3 days"
+func relativeDay(date, now time.Time) string {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, now.Location())
+	days := int(day.Sub(today).Hours() / 24)
+
+	switch {
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days < 0:
+		return fmt.Sprintf("%d days ago", -days)
+	}
+	return fmt.Sprintf("in %d days", days)
+}
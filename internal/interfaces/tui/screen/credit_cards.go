@@ -9,6 +9,7 @@ import (
 
 	"financli/internal/application/usecase"
 	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
 	"financli/internal/interfaces/tui/style"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -21,6 +22,11 @@ type CreditCardsModel struct {
 	creditCardUseCase        *usecase.CreditCardUseCase
 	creditCardInvoiceUseCase *usecase.CreditCardInvoiceUseCase
 	accountUseCase           *usecase.AccountUseCase
+	invoiceExportUseCase     *usecase.InvoiceExportUseCase
+	invoiceForecastUseCase   *usecase.InvoiceForecastUseCase
+	pendingPaymentUseCase    *usecase.PendingPaymentUseCase
+	personUseCase            *usecase.PersonUseCase
+	statementUseCase         *usecase.StatementExportUseCase
 
 	// Data
 	creditCards         []*entity.CreditCard
@@ -28,6 +34,11 @@ type CreditCardsModel struct {
 	invoices            []*entity.CreditCardInvoice
 	selectedInvoice     *entity.CreditCardInvoice
 	invoiceTransactions []*entity.Transaction
+	forecasts           []*usecase.InvoiceForecast
+	pendingPayments     []*entity.PendingPayment
+	people              []*entity.Person
+	openInvoices        []usecase.OpenInvoice
+	lookup              lookupIndex
 
 	// View state
 	selectedIndex        int
@@ -35,15 +46,20 @@ type CreditCardsModel struct {
 	viewMode             CreditCardViewMode
 
 	// Loading and errors
-	loading bool
-	err     error
+	loading       bool
+	err           error
+	statusMessage string
 
 	// Form state
 	formModel *CreditCardFormModel
+	formGuard[*CreditCardFormModel]
 
 	// Payment state
 	paymentModel *PaymentFormModel
 
+	// Invoice split state
+	split *invoiceSplitModel
+
 	// Confirmation state
 	showConfirmDelete bool
 	confirmMessage    string
@@ -63,8 +79,14 @@ const (
 	CreditCardViewInvoiceDetails
 	CreditCardViewPayment
 	CreditCardViewConfirm
+	CreditCardViewForecast
+	CreditCardViewSplit
+	CreditCardViewOpenInvoices
 )
 
+// forecastMonths is how many invoices the forecast view projects, including the current one
+const forecastMonths = 3
+
 type CreditCardFormModel struct {
 	// Form fields
 	name           string
@@ -73,13 +95,17 @@ type CreditCardFormModel struct {
 	dueDay         string
 
 	// Selection states
-	selectedAccount int
+	selectedAccount         int
+	selectedDefaultType     int
+	selectedDefaultCategory int
+	selectedReminders       int
 
 	// Input fields
 	nameInput     string
 	lastFourInput string
 	limitInput    string
 	dueDayInput   string
+	minimumInput  string
 
 	// Navigation
 	focusedField int
@@ -93,32 +119,54 @@ type PaymentFormModel struct {
 	cardID uuid.UUID
 	card   *entity.CreditCard
 
-	// Payment amount
+	// Payment amount and date; a future date schedules the payment
 	amountInput string
+	dateInput   string
+
+	// Balance minus payments already pending on the linked account
+	availableToSpend *valueobject.Money
 
 	// Navigation
 	focusedField int
 }
 
-func NewCreditCardsModel(ctx context.Context, creditCardUC *usecase.CreditCardUseCase, invoiceUC *usecase.CreditCardInvoiceUseCase, accountUC *usecase.AccountUseCase) tea.Model {
-	return &CreditCardsModel{
+func NewCreditCardsModel(ctx context.Context, creditCardUC *usecase.CreditCardUseCase, invoiceUC *usecase.CreditCardInvoiceUseCase, accountUC *usecase.AccountUseCase, invoiceExportUC *usecase.InvoiceExportUseCase, invoiceForecastUC *usecase.InvoiceForecastUseCase, pendingPaymentUC *usecase.PendingPaymentUseCase, personUC *usecase.PersonUseCase, statementUC *usecase.StatementExportUseCase) tea.Model {
+	m := &CreditCardsModel{
 		ctx:                      ctx,
 		creditCardUseCase:        creditCardUC,
 		creditCardInvoiceUseCase: invoiceUC,
 		accountUseCase:           accountUC,
+		invoiceExportUseCase:     invoiceExportUC,
+		invoiceForecastUseCase:   invoiceForecastUC,
+		pendingPaymentUseCase:    pendingPaymentUC,
+		personUseCase:            personUC,
+		statementUseCase:         statementUC,
 		viewMode:                 CreditCardViewList,
 		loading:                  true,
 		formModel: &CreditCardFormModel{
-			dueDayInput: "1",
+			dueDayInput:  "1",
+			minimumInput: formatPercentage(entity.DefaultMinimumPaymentPercentage),
+		},
+		paymentModel: &PaymentFormModel{
+			dateInput: time.Now().Format("2006-01-02"),
 		},
-		paymentModel: &PaymentFormModel{},
 	}
+	m.formGuard.screen = formScreen[*CreditCardFormModel]{
+		form:        &m.formModel,
+		fingerprint: m.formFingerprint,
+		editing:     func() bool { return m.formModel.editing },
+		showing:     func() bool { return m.viewMode == CreditCardViewForm },
+		reset:       m.resetForm,
+		list:        func() { m.viewMode = CreditCardViewList },
+	}
+	return m
 }
 
 func (m *CreditCardsModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.loadCreditCards,
 		m.loadAccounts,
+		m.loadPeople,
 	)
 }
 
@@ -139,8 +187,19 @@ func (m *CreditCardsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case accountsLoadedMsg:
 		m.accounts = msg.accounts
+		m.lookup.setAccounts(msg.accounts)
+		return m, nil
+
+	case peopleLoadedForCardsMsg:
+		m.people = msg.people
+		m.lookup.setPeople(msg.people)
 		return m, nil
 
+	case invoiceSplitSavedMsg:
+		m.loading = false
+		m.viewMode = CreditCardViewDetails
+		return m, m.loadCreditCards
+
 	case invoicesLoadedMsg:
 		m.loading = false
 		m.invoices = msg.invoices
@@ -154,8 +213,37 @@ func (m *CreditCardsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.invoiceTransactions = msg.transactions
 		return m, nil
 
+	case pendingPaymentsLoadedMsg:
+		m.pendingPayments = msg.payments
+		return m, nil
+
+	case availableToSpendLoadedMsg:
+		m.paymentModel.availableToSpend = &msg.amount
+		return m, nil
+
+	case openInvoicesLoadedMsg:
+		m.loading = false
+		m.openInvoices = msg.invoices
+		if len(m.openInvoices) > 0 && m.selectedIndex >= len(m.openInvoices) {
+			m.selectedIndex = len(m.openInvoices) - 1
+		}
+		return m, nil
+
+	case forecastLoadedMsg:
+		m.loading = false
+		m.forecasts = msg.forecasts
+		return m, nil
+
+	case invoiceExportedMsg:
+		m.loading = false
+		m.statusMessage = fmt.Sprintf("Invoice exported to %s", msg.path)
+		return m, nil
+
 	case creditCardActionMsg:
 		m.loading = false
+		if m.viewMode == CreditCardViewForm && !m.formModel.editing {
+			m.formGuard.saved()
+		}
 		m.viewMode = CreditCardViewList
 		m.resetForm()
 		m.resetPaymentForm()
@@ -167,6 +255,12 @@ func (m *CreditCardsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		// The first key dismisses the last error, such as a refused delete
+		if m.err != nil {
+			m.err = nil
+			return m, nil
+		}
+
 		switch m.viewMode {
 		case CreditCardViewList:
 			return m.handleListKeys(msg)
@@ -182,6 +276,12 @@ func (m *CreditCardsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handlePaymentKeys(msg)
 		case CreditCardViewConfirm:
 			return m.handleConfirmKeys(msg)
+		case CreditCardViewForecast:
+			return m.handleForecastKeys(msg)
+		case CreditCardViewSplit:
+			return m.handleInvoiceSplitKeys(msg)
+		case CreditCardViewOpenInvoices:
+			return m.handleOpenInvoicesKeys(msg)
 		}
 	}
 
@@ -212,6 +312,12 @@ func (m *CreditCardsModel) View() string {
 		return m.renderPaymentForm()
 	case CreditCardViewConfirm:
 		return m.renderConfirmDialog()
+	case CreditCardViewForecast:
+		return m.renderForecast()
+	case CreditCardViewSplit:
+		return m.renderInvoiceSplit()
+	case CreditCardViewOpenInvoices:
+		return m.renderOpenInvoices()
 	}
 
 	return ""
@@ -254,31 +360,119 @@ func (m *CreditCardsModel) loadInvoiceTransactions(invoiceID uuid.UUID) tea.Cmd
 	}
 }
 
+// Options for where a card's invoice reminders go
+var invoiceReminderOptions = []entity.InvoiceReminders{
+	{},
+	{Email: true},
+	{Webhook: true},
+	{Email: true, Webhook: true},
+	{Disabled: true},
+}
+
+func invoiceRemindersLabel(reminders entity.InvoiceReminders) string {
+	switch {
+	case reminders.Disabled:
+		return "Off"
+	case reminders.Email && reminders.Webhook:
+		return "In-app, email and webhook"
+	case reminders.Email:
+		return "In-app and email"
+	case reminders.Webhook:
+		return "In-app and webhook"
+	default:
+		return "In-app"
+	}
+}
+
+func invoiceRemindersIndex(reminders entity.InvoiceReminders) int {
+	for i, option := range invoiceReminderOptions {
+		if option == reminders {
+			return i
+		}
+	}
+	return 0
+}
+
 // Helper to reset form
 func (m *CreditCardsModel) resetForm() {
 	m.formModel = &CreditCardFormModel{
 		dueDayInput:     "1",
+		minimumInput:    formatPercentage(entity.DefaultMinimumPaymentPercentage),
 		focusedField:    0,
 		selectedAccount: 0,
 	}
 }
 
+// formFingerprint sums up the fields of the form the user can change
+func (m *CreditCardsModel) formFingerprint() string {
+	f := m.formModel
+	return fmt.Sprintf("%q %q %q %q %q %d %d %d %d",
+		f.nameInput, f.lastFourInput, f.limitInput, f.dueDayInput, f.minimumInput,
+		f.selectedAccount, f.selectedDefaultType, f.selectedDefaultCategory, f.selectedReminders)
+}
+
 // Helper to reset payment form
 func (m *CreditCardsModel) resetPaymentForm() {
 	m.paymentModel = &PaymentFormModel{
 		amountInput:  "",
+		dateInput:    time.Now().Format("2006-01-02"),
 		focusedField: 0,
 	}
 }
 
-// Get account name by ID
-func (m *CreditCardsModel) getAccountName(accountID uuid.UUID) string {
-	for _, acc := range m.accounts {
-		if acc.ID == accountID {
-			return acc.Name
+func (m *CreditCardsModel) startPayment(card *entity.CreditCard) tea.Cmd {
+	m.paymentModel.cardID = card.ID
+	m.paymentModel.card = card
+	m.viewMode = CreditCardViewPayment
+
+	if m.pendingPaymentUseCase == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		available, err := m.pendingPaymentUseCase.GetAvailableToSpend(m.ctx, card.AccountID)
+		if err != nil {
+			return errMsg{err: err}
 		}
+		return availableToSpendLoadedMsg{amount: available}
 	}
-	return "Unknown Account"
+}
+
+func (m *CreditCardsModel) loadPendingPayments(creditCardID uuid.UUID) tea.Cmd {
+	if m.pendingPaymentUseCase == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		payments, err := m.pendingPaymentUseCase.ListPendingByCreditCard(m.ctx, creditCardID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return pendingPaymentsLoadedMsg{payments: payments}
+	}
+}
+
+func (m *CreditCardsModel) cancelPendingPayment(paymentID uuid.UUID, creditCardID uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.pendingPaymentUseCase.CancelPayment(m.ctx, paymentID); err != nil {
+			return errMsg{err: err}
+		}
+
+		payments, err := m.pendingPaymentUseCase.ListPendingByCreditCard(m.ctx, creditCardID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return pendingPaymentsLoadedMsg{payments: payments}
+	}
+}
+
+// Get account name by ID
+func (m *CreditCardsModel) getAccountName(accountID uuid.UUID) string {
+	return m.lookup.accountName(accountID)
+}
+
+func formatPercentage(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
 }
 
 // Calculate next due date
@@ -343,7 +537,7 @@ func (m *CreditCardsModel) renderProgressBar(percentage float64, width int) stri
 
 // FormModeChecker interface implementation
 func (m *CreditCardsModel) IsInFormMode() bool {
-	return m.viewMode != CreditCardViewList
+	return m.viewMode != CreditCardViewList && m.viewMode != CreditCardViewOpenInvoices
 }
 
 // Message types
@@ -357,6 +551,22 @@ type invoicesLoadedMsg struct {
 	invoices []*entity.CreditCardInvoice
 }
 
+type pendingPaymentsLoadedMsg struct {
+	payments []*entity.PendingPayment
+}
+
+type availableToSpendLoadedMsg struct {
+	amount valueobject.Money
+}
+
+type forecastLoadedMsg struct {
+	forecasts []*usecase.InvoiceForecast
+}
+
+type invoiceExportedMsg struct {
+	path string
+}
+
 type invoiceTransactionsLoadedMsg struct {
 	transactions []*entity.Transaction
 }
@@ -375,12 +585,15 @@ func (m *CreditCardsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		if len(m.creditCards) > 0 {
 			m.viewMode = CreditCardViewDetails
+			m.pendingPayments = nil
+			return m, m.loadPendingPayments(m.creditCards[m.selectedIndex].ID)
 		}
 	case "n":
 		m.viewMode = CreditCardViewForm
 		m.formModel.editing = false
 		m.formModel.editingID = nil
 		m.resetForm()
+		return m, m.openForm()
 	case "e":
 		if len(m.creditCards) > 0 {
 			return m.editCreditCard()
@@ -392,10 +605,7 @@ func (m *CreditCardsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	case "p":
 		if len(m.creditCards) > 0 && m.selectedIndex < len(m.creditCards) {
-			card := m.creditCards[m.selectedIndex]
-			m.paymentModel.cardID = card.ID
-			m.paymentModel.card = card
-			m.viewMode = CreditCardViewPayment
+			return m, m.startPayment(m.creditCards[m.selectedIndex])
 		}
 	case "r":
 		m.loading = true
@@ -403,6 +613,8 @@ func (m *CreditCardsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.loadCreditCards,
 			m.loadAccounts,
 		)
+	case "tab":
+		return m.openOpenInvoices()
 	case "b":
 		return m, func() tea.Msg { return BackToDashboardMsg{} }
 	}
@@ -411,24 +623,33 @@ func (m *CreditCardsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *CreditCardsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	totalFields := 6 // name, last4, limit, account, dueday, buttons
+	totalFields := 11 // name, last4, limit, account, dueday, minimum, default type, default category, reminders, submit, cancel
+
+	if handled, discarded := m.formGuard.handlePromptKeys(msg.String()); handled {
+		if discarded {
+			m.closeForm()
+		}
+		return m, nil
+	}
 
 	switch msg.String() {
 	case "esc":
-		m.viewMode = CreditCardViewList
-		m.resetForm()
+		return m, m.leaveForm()
+	case "ctrl+x":
+		if m.formGuard.fromDraft {
+			return m, m.discardDraft()
+		}
 	case "tab", "down":
 		m.formModel.focusedField = (m.formModel.focusedField + 1) % totalFields
 	case "shift+tab", "up":
 		m.formModel.focusedField = (m.formModel.focusedField - 1 + totalFields) % totalFields
 	case "enter":
-		if m.formModel.focusedField == 4 {
+		if m.formModel.focusedField == 9 {
 			// Submit button
 			return m.submitForm()
-		} else if m.formModel.focusedField == 5 {
+		} else if m.formModel.focusedField == 10 {
 			// Cancel button
-			m.viewMode = CreditCardViewList
-			m.resetForm()
+			return m, m.leaveForm()
 		}
 	default:
 		return m.handleFormInput(msg)
@@ -448,10 +669,11 @@ func (m *CreditCardsModel) handleDetailsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 		m.showConfirmDelete = true
 	case "p":
 		if m.selectedIndex < len(m.creditCards) {
-			card := m.creditCards[m.selectedIndex]
-			m.paymentModel.cardID = card.ID
-			m.paymentModel.card = card
-			m.viewMode = CreditCardViewPayment
+			return m, m.startPayment(m.creditCards[m.selectedIndex])
+		}
+	case "c":
+		if len(m.pendingPayments) > 0 && m.selectedIndex < len(m.creditCards) {
+			return m, m.cancelPendingPayment(m.pendingPayments[0].ID, m.creditCards[m.selectedIndex].ID)
 		}
 	case "i":
 		if m.selectedIndex < len(m.creditCards) {
@@ -460,23 +682,52 @@ func (m *CreditCardsModel) handleDetailsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 			m.loading = true
 			return m, m.loadInvoices(card.ID)
 		}
+	case "f":
+		if m.selectedIndex < len(m.creditCards) && m.invoiceForecastUseCase != nil {
+			card := m.creditCards[m.selectedIndex]
+			m.viewMode = CreditCardViewForecast
+			m.loading = true
+			return m, m.loadForecast(card.ID)
+		}
+	case "s":
+		return m.openInvoiceSplit()
 	}
 
 	return m, nil
 }
 
+func (m *CreditCardsModel) handleForecastKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = CreditCardViewDetails
+		m.forecasts = nil
+	}
+
+	return m, nil
+}
+
+func (m *CreditCardsModel) loadForecast(creditCardID uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		forecasts, err := m.invoiceForecastUseCase.ForecastInvoices(m.ctx, creditCardID, forecastMonths)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return forecastLoadedMsg{forecasts: forecasts}
+	}
+}
+
 func (m *CreditCardsModel) handlePaymentKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
 		m.viewMode = CreditCardViewDetails
 		m.resetPaymentForm()
 	case "tab", "down":
-		m.paymentModel.focusedField = (m.paymentModel.focusedField + 1) % 2
+		m.paymentModel.focusedField = (m.paymentModel.focusedField + 1) % 3
 	case "shift+tab", "up":
-		m.paymentModel.focusedField = (m.paymentModel.focusedField - 1 + 2) % 2
+		m.paymentModel.focusedField = (m.paymentModel.focusedField - 1 + 3) % 3
 	case "enter":
-		if m.paymentModel.focusedField == 0 {
-			// Submit payment
+		if m.paymentModel.focusedField < 2 {
+			// Submit payment from either input
 			return m.submitPayment()
 		} else {
 			// Cancel button
@@ -486,12 +737,14 @@ func (m *CreditCardsModel) handlePaymentKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 	case "backspace":
 		if m.paymentModel.focusedField == 0 && len(m.paymentModel.amountInput) > 0 {
 			m.paymentModel.amountInput = m.paymentModel.amountInput[:len(m.paymentModel.amountInput)-1]
+		} else if m.paymentModel.focusedField == 1 && len(m.paymentModel.dateInput) > 0 {
+			m.paymentModel.dateInput = m.paymentModel.dateInput[:len(m.paymentModel.dateInput)-1]
 		}
 	default:
-		if m.paymentModel.focusedField == 0 && len(msg.String()) == 1 {
-			if msg.String() >= "0" && msg.String() <= "9" || msg.String() == "." {
-				m.paymentModel.amountInput += msg.String()
-			}
+		if m.paymentModel.focusedField == 0 {
+			m.paymentModel.amountInput = editAmountInput(m.paymentModel.amountInput, msg)
+		} else if m.paymentModel.focusedField == 1 {
+			m.paymentModel.dateInput = editDateInput(m.paymentModel.dateInput, msg)
 		}
 	}
 
@@ -545,11 +798,42 @@ func (m *CreditCardsModel) handleInvoiceDetailsKeys(msg tea.KeyMsg) (tea.Model,
 		m.viewMode = CreditCardViewInvoices
 		m.invoiceTransactions = nil
 		m.selectedInvoice = nil
+		m.statusMessage = ""
+	case "x":
+		if m.selectedInvoice != nil && m.invoiceExportUseCase != nil {
+			m.statusMessage = ""
+			return m, m.exportInvoice(m.selectedInvoice.ID)
+		}
+	case "X":
+		if m.selectedInvoice != nil && m.statementUseCase != nil {
+			m.statusMessage = ""
+			return m, m.exportInvoicePDF(m.selectedInvoice.ID)
+		}
 	}
 
 	return m, nil
 }
 
+func (m *CreditCardsModel) exportInvoice(invoiceID uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		path, err := m.invoiceExportUseCase.ExportFatura(m.ctx, invoiceID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return invoiceExportedMsg{path: path}
+	}
+}
+
+func (m *CreditCardsModel) exportInvoicePDF(invoiceID uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		path, err := m.statementUseCase.ExportInvoiceStatement(m.ctx, invoiceID)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return invoiceExportedMsg{path: path}
+	}
+}
+
 // Helper method to edit a credit card
 func (m *CreditCardsModel) editCreditCard() (tea.Model, tea.Cmd) {
 	if m.selectedIndex >= len(m.creditCards) {
@@ -566,6 +850,10 @@ func (m *CreditCardsModel) editCreditCard() (tea.Model, tea.Cmd) {
 	m.formModel.lastFourInput = card.LastFourDigits
 	m.formModel.limitInput = fmt.Sprintf("%.2f", card.CreditLimit.Amount())
 	m.formModel.dueDayInput = strconv.Itoa(card.DueDay)
+	m.formModel.minimumInput = formatPercentage(card.MinimumPaymentPercentage)
+	m.formModel.selectedDefaultType = defaultTypeIndex(card.DefaultTransactionType)
+	m.formModel.selectedDefaultCategory = defaultCategoryIndex(card.DefaultCategory)
+	m.formModel.selectedReminders = invoiceRemindersIndex(card.InvoiceReminders)
 
 	// Find and set the account
 	for i, acc := range m.accounts {
@@ -574,6 +862,7 @@ func (m *CreditCardsModel) editCreditCard() (tea.Model, tea.Cmd) {
 			break
 		}
 	}
+	m.formGuard.open(m.formFingerprint(), false)
 
 	return m, nil
 }
@@ -584,6 +873,7 @@ func (m *CreditCardsModel) renderCreditCardsList() string {
 
 	title := style.TitleStyle.Render("💳 Credit Cards Management")
 	sections = append(sections, title)
+	sections = append(sections, m.renderCardTabs())
 
 	// Summary section
 	summary := m.renderSummary()
@@ -634,9 +924,9 @@ func (m *CreditCardsModel) renderSummary() string {
 		MarginTop(1)
 
 	cards := fmt.Sprintf("Cards: %d", cardCount)
-	limit := fmt.Sprintf("Total Limit: R$ %.2f", totalLimit)
-	balance := fmt.Sprintf("Total Balance: R$ %.2f", totalBalance)
-	available := style.SuccessStyle.Render(fmt.Sprintf("Available: R$ %.2f", totalAvailable))
+	limit := fmt.Sprintf("Total Limit: %s", formatAmount(totalLimit))
+	balance := fmt.Sprintf("Total Balance: %s", formatAmount(totalBalance))
+	available := style.SuccessStyle.Render(fmt.Sprintf("Available: %s", formatAmount(totalAvailable)))
 
 	utilColor := m.getUtilizationColor(avgUtilization)
 	utilization := lipgloss.NewStyle().Foreground(utilColor).Render(fmt.Sprintf("Avg Utilization: %.1f%%", avgUtilization))
@@ -677,11 +967,11 @@ func (m *CreditCardsModel) renderCreditCardsTable() string {
 	for i, card := range m.creditCards {
 		name := truncateString(card.Name, 20)
 		lastFour := card.LastFourDigits
-		balance := fmt.Sprintf("R$ %.2f", card.CurrentBalance.Amount())
-		limit := fmt.Sprintf("R$ %.2f", card.CreditLimit.Amount())
+		balance := formatMoney(card.CurrentBalance)
+		limit := formatMoney(card.CreditLimit)
 
 		available, _ := card.GetAvailableCredit()
-		availableStr := fmt.Sprintf("R$ %.2f", available.Amount())
+		availableStr := formatMoney(available)
 
 		utilization := card.GetUtilizationPercentage()
 		utilizationBar := m.renderProgressBar(utilization, 10)
@@ -699,6 +989,16 @@ func (m *CreditCardsModel) renderCreditCardsTable() string {
 		rows = append(rows, row)
 	}
 
+	var balance, limit, available float64
+	for _, card := range m.creditCards {
+		cardAvailable, _ := card.GetAvailableCredit()
+		balance += card.CurrentBalance.Amount()
+		limit += card.CreditLimit.Amount()
+		available += cardAvailable.Amount()
+	}
+	rows = append(rows, renderTotalsRow(fmt.Sprintf("%-20s %-8s %-12s %-12s %-12s",
+		"Total", countLabel(len(m.creditCards), "card"), formatAmount(balance), formatAmount(limit), formatAmount(available)), true))
+
 	table := strings.Join(rows, "\n")
 	return tableStyle.Render(table)
 }
@@ -734,7 +1034,7 @@ func (m *CreditCardsModel) renderSelectedCardInfo() string {
 
 // Render help text for list view
 func (m *CreditCardsModel) renderListHelp() string {
-	help := "[↑/↓] Navigate • [Enter] Details • [n] New • [e] Edit • [d] Delete • [p] Payment • [r] Refresh • [b] Back"
+	help := "[↑/↓] Navigate • [Enter] Details • [n] New • [e] Edit • [d] Delete • [p] Payment • [Tab] Open Invoices • [r] Refresh • [b] Back"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -744,38 +1044,11 @@ func (m *CreditCardsModel) renderListHelp() string {
 func (m *CreditCardsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch m.formModel.focusedField {
 	case 0: // Name
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.nameInput) > 0 {
-				m.formModel.nameInput = m.formModel.nameInput[:len(m.formModel.nameInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.nameInput += msg.String()
-			}
-		}
+		m.formModel.nameInput = editTextInput(m.formModel.nameInput, msg)
 	case 1: // Last 4 digits
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.lastFourInput) > 0 {
-				m.formModel.lastFourInput = m.formModel.lastFourInput[:len(m.formModel.lastFourInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && msg.String() >= "0" && msg.String() <= "9" && len(m.formModel.lastFourInput) < 4 {
-				m.formModel.lastFourInput += msg.String()
-			}
-		}
+		m.formModel.lastFourInput = editDigitsInput(m.formModel.lastFourInput, msg, 4)
 	case 2: // Credit limit
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.limitInput) > 0 {
-				m.formModel.limitInput = m.formModel.limitInput[:len(m.formModel.limitInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-				m.formModel.limitInput += msg.String()
-			}
-		}
+		m.formModel.limitInput = editAmountInput(m.formModel.limitInput, msg)
 	case 3: // Account selection
 		switch msg.String() {
 		case "left":
@@ -788,16 +1061,33 @@ func (m *CreditCardsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 			}
 		}
 	case 4: // Due day
+		m.formModel.dueDayInput = editDigitsInput(m.formModel.dueDayInput, msg, 2)
+	case 5: // Minimum payment percentage
+		m.formModel.minimumInput = editAmountInput(m.formModel.minimumInput, msg)
+	case 6: // Default transaction type
 		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.dueDayInput) > 0 {
-				m.formModel.dueDayInput = m.formModel.dueDayInput[:len(m.formModel.dueDayInput)-1]
+		case "left":
+			if m.formModel.selectedDefaultType > 0 {
+				m.formModel.selectedDefaultType--
 			}
-		default:
-			if len(msg.String()) == 1 && msg.String() >= "0" && msg.String() <= "9" && len(m.formModel.dueDayInput) < 2 {
-				m.formModel.dueDayInput += msg.String()
+		case "right":
+			if m.formModel.selectedDefaultType < len(defaultTypeOptions)-1 {
+				m.formModel.selectedDefaultType++
 			}
 		}
+	case 7: // Default category
+		switch msg.String() {
+		case "left":
+			if m.formModel.selectedDefaultCategory > 0 {
+				m.formModel.selectedDefaultCategory--
+			}
+		case "right":
+			if m.formModel.selectedDefaultCategory < len(defaultCategoryOptions())-1 {
+				m.formModel.selectedDefaultCategory++
+			}
+		}
+	case 8: // Invoice reminders
+		m.formModel.selectedReminders = cycleOption(m.formModel.selectedReminders, len(invoiceReminderOptions), msg.String())
 	}
 
 	return m, nil
@@ -828,6 +1118,12 @@ func (m *CreditCardsModel) submitForm() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	minimumPercentage, err := strconv.ParseFloat(m.formModel.minimumInput, 64)
+	if err != nil || minimumPercentage <= 0 || minimumPercentage > 100 {
+		m.err = fmt.Errorf("minimum payment must be between 0 and 100%%")
+		return m, nil
+	}
+
 	if len(m.accounts) == 0 {
 		m.err = fmt.Errorf("no accounts available to link")
 		return m, nil
@@ -837,16 +1133,34 @@ func (m *CreditCardsModel) submitForm() (tea.Model, tea.Cmd) {
 
 	m.loading = true
 
+	defaultCategory := defaultCategoryOptions()[m.formModel.selectedDefaultCategory]
+	defaultType := defaultTypeOptions[m.formModel.selectedDefaultType]
+	reminders := invoiceReminderOptions[m.formModel.selectedReminders]
+
 	if m.formModel.editing && m.formModel.editingID != nil {
-		// TODO: Implement update credit card
+		cardID := *m.formModel.editingID
+		name := m.formModel.nameInput
+		lastFour := m.formModel.lastFourInput
 		return m, func() tea.Msg {
-			return errMsg{err: fmt.Errorf("update credit card not implemented yet")}
+			if _, err := m.creditCardUseCase.UpdateCreditCard(m.ctx, cardID, accountID, name, lastFour, limit, dueDay, minimumPercentage); err != nil {
+				return errMsg{err: err}
+			}
+
+			if err := m.creditCardUseCase.SetTransactionDefaults(m.ctx, cardID, defaultCategory, defaultType); err != nil {
+				return errMsg{err: err}
+			}
+
+			if err := m.creditCardUseCase.SetInvoiceReminders(m.ctx, cardID, reminders); err != nil {
+				return errMsg{err: err}
+			}
+
+			return creditCardActionMsg{}
 		}
 	}
 
 	// Create credit card
 	return m, func() tea.Msg {
-		_, err := m.creditCardUseCase.CreateCreditCard(
+		card, err := m.creditCardUseCase.CreateCreditCard(
 			m.ctx,
 			accountID,
 			m.formModel.nameInput,
@@ -854,11 +1168,20 @@ func (m *CreditCardsModel) submitForm() (tea.Model, tea.Cmd) {
 			limit,
 			"BRL",
 			dueDay,
+			minimumPercentage,
 		)
 		if err != nil {
 			return errMsg{err: err}
 		}
 
+		if err := m.creditCardUseCase.SetTransactionDefaults(m.ctx, card.ID, defaultCategory, defaultType); err != nil {
+			return errMsg{err: err}
+		}
+
+		if err := m.creditCardUseCase.SetInvoiceReminders(m.ctx, card.ID, reminders); err != nil {
+			return errMsg{err: err}
+		}
+
 		return creditCardActionMsg{}
 	}
 }
@@ -876,12 +1199,18 @@ func (m *CreditCardsModel) renderCreditCardForm() string {
 		errorMsg := style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
 		sections = append(sections, errorMsg)
 	}
+	if note := m.formGuard.renderDraftNote(); note != "" {
+		sections = append(sections, note)
+	}
 
 	form := m.renderForm()
 	sections = append(sections, form)
 
 	help := m.renderFormHelp()
 	sections = append(sections, help)
+	if prompt := m.formGuard.renderPrompt(); prompt != "" {
+		sections = append(sections, prompt)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
@@ -911,6 +1240,17 @@ func (m *CreditCardsModel) renderForm() string {
 	// Due day field
 	fields = append(fields, m.renderFormField("Due Day (1-31):", m.formModel.dueDayInput, 4))
 
+	// Minimum payment field
+	fields = append(fields, m.renderFormField("Min. Payment (%):", m.formModel.minimumInput, 5))
+
+	// Transaction defaults
+	fields = append(fields, renderDefaultSelector("Default Type:",
+		defaultTypeLabel(defaultTypeOptions[m.formModel.selectedDefaultType]), m.formModel.focusedField == 6))
+	fields = append(fields, renderDefaultSelector("Default Category:",
+		defaultCategoryLabel(defaultCategoryOptions()[m.formModel.selectedDefaultCategory]), m.formModel.focusedField == 7))
+	fields = append(fields, renderDefaultSelector("Invoice Reminders:",
+		invoiceRemindersLabel(invoiceReminderOptions[m.formModel.selectedReminders]), m.formModel.focusedField == 8))
+
 	// Buttons
 	buttons := m.renderFormButtons()
 	fields = append(fields, buttons)
@@ -962,7 +1302,7 @@ func (m *CreditCardsModel) renderAccountSelector() string {
 	}
 
 	account := m.accounts[m.formModel.selectedAccount]
-	display := fmt.Sprintf("%s (R$ %.2f)", account.Name, account.Balance.Amount())
+	display := fmt.Sprintf("%s (%s)", account.Name, formatMoney(account.Balance))
 
 	var selector string
 	if m.formModel.focusedField == 3 {
@@ -989,14 +1329,14 @@ func (m *CreditCardsModel) renderFormButtons() string {
 	var submitStyle, cancelStyle lipgloss.Style
 
 	// Submit button styling
-	if m.formModel.focusedField == 4 {
+	if m.formModel.focusedField == 9 {
 		submitStyle = style.ButtonStyle.Background(style.Success)
 	} else {
 		submitStyle = style.SecondaryButtonStyle
 	}
 
 	// Cancel button styling
-	if m.formModel.focusedField == 5 {
+	if m.formModel.focusedField == 10 {
 		cancelStyle = style.ButtonStyle.Background(style.Danger)
 	} else {
 		cancelStyle = style.SecondaryButtonStyle
@@ -1006,9 +1346,9 @@ func (m *CreditCardsModel) renderFormButtons() string {
 	cancelBtn := cancelStyle.Render("Cancel")
 
 	// Add focus indicators
-	if m.formModel.focusedField == 4 {
+	if m.formModel.focusedField == 9 {
 		submitBtn = submitBtn + " ◄"
-	} else if m.formModel.focusedField == 5 {
+	} else if m.formModel.focusedField == 10 {
 		cancelBtn = cancelBtn + " ◄"
 	}
 
@@ -1021,7 +1361,7 @@ func (m *CreditCardsModel) renderFormButtons() string {
 
 // Render form help
 func (m *CreditCardsModel) renderFormHelp() string {
-	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Select Account • [Enter] Confirm • [Esc] Cancel"
+	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -1055,7 +1395,7 @@ func (m *CreditCardsModel) renderCreditCardDetails() string {
 	actions := m.renderDetailsActions()
 	sections = append(sections, actions)
 
-	help := "[Esc/Enter] Back • [p] Make Payment • [e] Edit • [d] Delete"
+	help := "[Esc/Enter] Back • [p] Make Payment • [c] Cancel Scheduled • [i] Invoices • [f] Forecast • [e] Edit • [d] Delete"
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
@@ -1074,12 +1414,12 @@ func (m *CreditCardsModel) renderCardMainInfo(card *entity.CreditCard) string {
 	info = append(info, style.HeaderStyle.Render("Card Information"))
 	info = append(info, fmt.Sprintf("Name: %s", card.Name))
 	info = append(info, fmt.Sprintf("Last 4 Digits: •••• %s", card.LastFourDigits))
-	info = append(info, fmt.Sprintf("Credit Limit: R$ %.2f", card.CreditLimit.Amount()))
-	info = append(info, fmt.Sprintf("Current Balance: R$ %.2f", card.CurrentBalance.Amount()))
+	info = append(info, fmt.Sprintf("Credit Limit: %s", formatMoney(card.CreditLimit)))
+	info = append(info, fmt.Sprintf("Current Balance: %s", formatMoney(card.CurrentBalance)))
 
 	available, _ := card.GetAvailableCredit()
 	info = append(info, fmt.Sprintf("Available Credit: %s",
-		style.SuccessStyle.Render(fmt.Sprintf("R$ %.2f", available.Amount()))))
+		style.SuccessStyle.Render(formatMoney(available))))
 
 	content := strings.Join(info, "\n")
 	return infoStyle.Render(content)
@@ -1110,10 +1450,10 @@ func (m *CreditCardsModel) renderUtilizationVisualization(card *entity.CreditCar
 		Bold(true).
 		Render(fmt.Sprintf("%.1f%%", utilization))
 
-	details := fmt.Sprintf("%s - R$ %.2f of R$ %.2f used",
+	details := fmt.Sprintf("%s - %s of %s used",
 		percentStr,
-		card.CurrentBalance.Amount(),
-		card.CreditLimit.Amount())
+		formatMoney(card.CurrentBalance),
+		formatMoney(card.CreditLimit))
 
 	content = append(content, details)
 
@@ -1164,6 +1504,14 @@ func (m *CreditCardsModel) renderCardAdditionalInfo(card *entity.CreditCard) str
 
 	info = append(info, fmt.Sprintf("Next Due Date: %s (%s)",
 		nextDue.Format("Monday, Jan 2, 2006"), dueStatus))
+	info = append(info, fmt.Sprintf("Minimum Payment: %s%% of the invoice", formatPercentage(card.MinimumPaymentPercentage)))
+	info = append(info, fmt.Sprintf("Invoice Reminders: %s", invoiceRemindersLabel(card.InvoiceReminders)))
+	info = append(info, fmt.Sprintf("Invoice Split: %s", m.invoiceSplitLabel(card)))
+
+	for _, payment := range m.pendingPayments {
+		info = append(info, style.WarningStyle.Render(fmt.Sprintf("Scheduled Payment: %s on %s (pending)",
+			formatMoney(payment.Amount), payment.ScheduledFor.Format("Jan 02, 2006"))))
+	}
 
 	// Timestamps
 	info = append(info, "")
@@ -1186,7 +1534,10 @@ func (m *CreditCardsModel) renderDetailsActions() string {
 
 	actions := []string{
 		"[i] View Invoices - See monthly statements",
-		"[p] Make Payment - Pay down your balance",
+		"[f] Forecast - Preview upcoming invoices",
+		"[p] Make Payment - Pay now or schedule for a later date",
+		"[c] Cancel Scheduled Payment - Drop the next pending payment",
+		"[s] Split Invoices - Share every charge with a partner",
 		"[e] Edit Card - Update card information",
 		"[d] Delete Card - Remove this credit card",
 	}
@@ -1213,8 +1564,26 @@ func (m *CreditCardsModel) submitPayment() (tea.Model, tea.Cmd) {
 		amount = m.paymentModel.card.CurrentBalance.Amount()
 	}
 
+	payOn, err := time.ParseInLocation("2006-01-02", m.paymentModel.dateInput, time.Local)
+	if err != nil {
+		m.err = fmt.Errorf("invalid payment date, use YYYY-MM-DD")
+		return m, nil
+	}
+
 	m.loading = true
 
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if payOn.After(today) && m.pendingPaymentUseCase != nil {
+		return m, func() tea.Msg {
+			_, err := m.pendingPaymentUseCase.SchedulePayment(m.ctx, m.paymentModel.cardID, amount, "BRL", payOn)
+			if err != nil {
+				return errMsg{err: err}
+			}
+			return creditCardActionMsg{}
+		}
+	}
+
 	return m, func() tea.Msg {
 		err := m.creditCardUseCase.MakePayment(
 			m.ctx,
@@ -1274,25 +1643,25 @@ func (m *CreditCardsModel) renderPaymentCardInfo() string {
 	info = append(info, style.HeaderStyle.Render("Card Information"))
 	info = append(info, fmt.Sprintf("Card: %s (•••• %s)", card.Name, card.LastFourDigits))
 	info = append(info, fmt.Sprintf("Current Balance: %s",
-		style.ErrorStyle.Render(fmt.Sprintf("R$ %.2f", card.CurrentBalance.Amount()))))
+		style.ErrorStyle.Render(formatMoney(card.CurrentBalance))))
 
 	available, _ := card.GetAvailableCredit()
-	info = append(info, fmt.Sprintf("Available After Payment: R$ %.2f", available.Amount()))
+	info = append(info, fmt.Sprintf("Available After Payment: %s", formatMoney(available)))
 
 	// Get linked account
 	var accountBalance float64
 	accountName := "Unknown Account"
-	for _, acc := range m.accounts {
-		if acc.ID == card.AccountID {
-			accountName = acc.Name
-			accountBalance = acc.Balance.Amount()
-			break
-		}
+	if acc := m.lookup.account(card.AccountID); acc != nil {
+		accountName = acc.Name
+		accountBalance = acc.Balance.Amount()
 	}
 
 	info = append(info, "")
 	info = append(info, fmt.Sprintf("Payment From: %s", accountName))
-	info = append(info, fmt.Sprintf("Account Balance: R$ %.2f", accountBalance))
+	info = append(info, fmt.Sprintf("Account Balance: %s", formatAmount(accountBalance)))
+	if available := m.paymentModel.availableToSpend; available != nil && available.Amount() != accountBalance {
+		info = append(info, fmt.Sprintf("Available to Spend: %s (after scheduled payments)", formatMoney(*available)))
+	}
 
 	content := strings.Join(info, "\n")
 	return infoStyle.Render(content)
@@ -1333,13 +1702,29 @@ func (m *CreditCardsModel) renderPaymentFormFields() string {
 	)
 	fields = append(fields, amountField)
 
+	// Payment date input
+	dateStyle := style.InputStyle.Width(30)
+	if m.paymentModel.focusedField == 1 {
+		dateStyle = style.FocusedInputStyle.Width(30)
+	}
+	dateInput := dateStyle.Render(m.paymentModel.dateInput)
+	if m.paymentModel.focusedField == 1 {
+		dateInput = dateInput + " ◄"
+	}
+	fields = append(fields, lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		labelStyle.Render("Pay On (YYYY-MM-DD):"),
+		dateInput,
+	))
+	fields = append(fields, style.HelpStyle.Render("A future date schedules the payment; it clears automatically on that day"))
+
 	// Quick amount suggestions
 	if m.paymentModel.card != nil {
 		balance := m.paymentModel.card.CurrentBalance.Amount()
 		suggestions := []string{
-			fmt.Sprintf("Full Balance: R$ %.2f", balance),
-			fmt.Sprintf("Minimum: R$ %.2f", balance*0.1),
-			fmt.Sprintf("Half: R$ %.2f", balance*0.5),
+			fmt.Sprintf("Full Balance: %s", formatAmount(balance)),
+			fmt.Sprintf("Minimum: %s", formatAmount(balance*0.1)),
+			fmt.Sprintf("Half: %s", formatAmount(balance*0.5)),
 		}
 		suggestionText := style.InfoStyle.Render(strings.Join(suggestions, " | "))
 		fields = append(fields, suggestionText)
@@ -1350,7 +1735,7 @@ func (m *CreditCardsModel) renderPaymentFormFields() string {
 
 	var submitStyle, cancelStyle lipgloss.Style
 
-	if m.paymentModel.focusedField == 0 {
+	if m.paymentModel.focusedField < 2 {
 		submitStyle = style.ButtonStyle.Background(style.Success)
 		cancelStyle = style.SecondaryButtonStyle
 	} else {
@@ -1358,10 +1743,14 @@ func (m *CreditCardsModel) renderPaymentFormFields() string {
 		cancelStyle = style.ButtonStyle.Background(style.Danger)
 	}
 
-	submitBtn := submitStyle.Render("Make Payment")
+	submitText := "Make Payment"
+	if payOn, err := time.ParseInLocation("2006-01-02", m.paymentModel.dateInput, time.Local); err == nil && payOn.After(time.Now()) {
+		submitText = "Schedule Payment"
+	}
+	submitBtn := submitStyle.Render(submitText)
 	cancelBtn := cancelStyle.Render("Cancel")
 
-	if m.paymentModel.focusedField == 0 {
+	if m.paymentModel.focusedField < 2 {
 		submitBtn = submitBtn + " ◄"
 	} else {
 		cancelBtn = cancelBtn + " ◄"
@@ -1384,11 +1773,12 @@ func (m *CreditCardsModel) deleteCreditCard() tea.Msg {
 		return errMsg{err: fmt.Errorf("no credit card selected")}
 	}
 
-	_ = m.creditCards[m.selectedIndex]
+	card := m.creditCards[m.selectedIndex]
+	if err := m.creditCardUseCase.DeleteCreditCard(m.ctx, card.ID); err != nil {
+		return errMsg{err: err}
+	}
 
-	// TODO: Implement delete in use case
-	// For now, we just return an error
-	return errMsg{err: fmt.Errorf("delete credit card not implemented yet")}
+	return creditCardActionMsg{}
 }
 
 func (m *CreditCardsModel) renderConfirmDialog() string {
@@ -1415,9 +1805,9 @@ func (m *CreditCardsModel) renderConfirmDialog() string {
 	warningMessages = append(warningMessages,
 		fmt.Sprintf("Card: •••• %s", card.LastFourDigits))
 	warningMessages = append(warningMessages,
-		fmt.Sprintf("Current Balance: R$ %.2f", card.CurrentBalance.Amount()))
+		fmt.Sprintf("Current Balance: %s", formatMoney(card.CurrentBalance)))
 	warningMessages = append(warningMessages,
-		fmt.Sprintf("Available Credit: R$ %.2f", available.Amount()))
+		fmt.Sprintf("Available Credit: %s", formatMoney(available)))
 
 	// Add extra warning if there's a balance
 	if card.CurrentBalance.Amount() > 0 {
@@ -1494,9 +1884,9 @@ func (m *CreditCardsModel) renderInvoicesTable() string {
 		status := m.getInvoiceStatusIcon(invoice.Status)
 		month := invoice.ReferenceMonth
 		period := invoice.GetStatementPeriod()
-		total := invoice.TotalCharges.String()
-		paid := invoice.TotalPayments.String()
-		balance := invoice.ClosingBalance.String()
+		total := formatMoney(invoice.TotalCharges)
+		paid := formatMoney(invoice.TotalPayments)
+		balance := formatMoney(invoice.ClosingBalance)
 		dueDate := invoice.DueDate.Format("Jan 02, 2006")
 
 		// Color code due date if overdue
@@ -1544,10 +1934,14 @@ func (m *CreditCardsModel) renderInvoiceDetails() string {
 		fmt.Sprintf("Statement Period: %s", invoice.GetStatementPeriod()),
 		fmt.Sprintf("Due Date: %s", invoice.GetDueDateFormatted()),
 		"",
-		fmt.Sprintf("Previous Balance: %s", invoice.PreviousBalance.String()),
-		fmt.Sprintf("Total Charges: %s", invoice.TotalCharges.String()),
-		fmt.Sprintf("Total Payments: %s", invoice.TotalPayments.String()),
-		fmt.Sprintf("Closing Balance: %s", invoice.ClosingBalance.String()),
+		fmt.Sprintf("Previous Balance: %s", formatMoney(invoice.PreviousBalance)),
+		fmt.Sprintf("Total Charges: %s", formatMoney(invoice.TotalCharges)),
+		fmt.Sprintf("Total Payments: %s", formatMoney(invoice.TotalPayments)),
+		fmt.Sprintf("Closing Balance: %s", formatMoney(invoice.ClosingBalance)),
+	}
+
+	if invoice.IsClosed() {
+		summary = append(summary, "", m.renderMinimumPaymentStatus(invoice))
 	}
 
 	sections = append(sections, summaryStyle.Render(strings.Join(summary, "\n")))
@@ -1567,12 +1961,30 @@ func (m *CreditCardsModel) renderInvoiceDetails() string {
 		sections = append(sections, transStyle.Render(transHeader+"\n\n"+transContent))
 	}
 
-	help := "[b/Esc] Back to Invoices"
+	if m.statusMessage != "" {
+		sections = append(sections, style.SuccessStyle.MarginTop(1).Render(m.statusMessage))
+	}
+
+	help := "[x] Export Fatura • [X] Export PDF • [b/Esc] Back to Invoices"
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
 
+func (m *CreditCardsModel) renderMinimumPaymentStatus(invoice *entity.CreditCardInvoice) string {
+	line := fmt.Sprintf("Minimum Payment: %s", formatMoney(invoice.MinimumPayment))
+
+	switch {
+	case invoice.IsMinimumPaymentMet():
+		return line + " " + style.SuccessStyle.Render("✓ paid")
+	case invoice.MissedMinimumPayment(time.Now()):
+		return line + " " + style.ErrorStyle.Render("✗ missed")
+	default:
+		remaining := invoice.GetMinimumPaymentRemaining()
+		return line + " " + style.WarningStyle.Render(fmt.Sprintf("(%s remaining)", formatMoney(remaining)))
+	}
+}
+
 func (m *CreditCardsModel) getInvoiceStatusIcon(status entity.InvoiceStatus) string {
 	switch status {
 	case entity.InvoiceStatusOpen:
@@ -1602,3 +2014,67 @@ func (m *CreditCardsModel) getInvoiceStatusName(status entity.InvoiceStatus) str
 		return "Unknown"
 	}
 }
+
+func (m *CreditCardsModel) renderForecast() string {
+	if m.selectedIndex >= len(m.creditCards) {
+		return ""
+	}
+
+	card := m.creditCards[m.selectedIndex]
+
+	var sections []string
+
+	title := style.TitleStyle.Render(fmt.Sprintf("🔮 Invoice Forecast for %s", card.Name))
+	sections = append(sections, title)
+
+	if len(m.forecasts) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No upcoming charges to forecast."))
+	}
+
+	for _, forecast := range m.forecasts {
+		sections = append(sections, m.renderForecastCard(forecast))
+	}
+
+	help := "[b/Esc] Back to Card • ◆ posted  ◇ installment  ↻ recurring"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *CreditCardsModel) renderForecastCard(forecast *usecase.InvoiceForecast) string {
+	cardStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(0, 2).
+		MarginTop(1)
+
+	header := style.HeaderStyle.Render(fmt.Sprintf("%s • Due %s • %s",
+		forecast.ReferenceMonth, forecast.DueDate.Format("Jan 02, 2006"), formatMoney(forecast.Total)))
+
+	lines := []string{
+		header,
+		fmt.Sprintf("Posted: %-14s Installments: %-14s Recurring: %s",
+			formatMoney(forecast.PostedCharges), formatMoney(forecast.Installments), formatMoney(forecast.Recurring)),
+	}
+
+	if len(forecast.Items) > 0 {
+		lines = append(lines, "")
+	}
+	for _, item := range forecast.Items {
+		lines = append(lines, fmt.Sprintf("%s %-40s %s",
+			forecastItemIcon(item.Kind), truncateString(item.Description, 40), formatMoney(item.Amount)))
+	}
+
+	return cardStyle.Render(strings.Join(lines, "\n"))
+}
+
+func forecastItemIcon(kind usecase.ForecastItemKind) string {
+	switch kind {
+	case usecase.ForecastItemInstallment:
+		return "◇"
+	case usecase.ForecastItemRecurring:
+		return "↻"
+	default:
+		return "◆"
+	}
+}
@@ -0,0 +1,155 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	categoryKinds     = []entity.CategoryKind{entity.CategoryKindExpense, entity.CategoryKindIncome}
+	categoryKindNames = []string{"expense", "income"}
+)
+
+// categoryFormModel adds a category, or edits one added by the user. The
+// built-in categories can only have their look customized.
+type categoryFormModel struct {
+	// editing is nil when adding a category
+	editing *entity.Category
+	// Form: 0: name, 1: icon, 2: kind, 3: save, 4: cancel
+	focusedField int
+	nameInput    string
+	iconInput    string
+	kindIndex    int
+	err          error
+}
+
+func (m *CategoriesModel) openCategoryForm(category *entity.Category) {
+	form := &categoryFormModel{editing: category, iconInput: categoryIconChoices[0]}
+	if category != nil {
+		form.nameInput = category.Name
+		form.iconInput = category.Icon
+		form.kindIndex = indexOf(categoryKinds, category.Kind)
+	}
+	m.categoryForm = form
+	m.err = nil
+	m.message = ""
+	m.viewMode = CategoriesViewCategoryForm
+}
+
+func (m *CategoriesModel) handleCategoryFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.categoryForm
+
+	switch msg.String() {
+	case "esc":
+		m.categoryForm = nil
+		m.viewMode = CategoriesViewList
+		m.err = nil
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 5
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 5) % 5
+	case "enter":
+		switch form.focusedField {
+		case 3:
+			return m, m.saveCategory()
+		case 4:
+			m.categoryForm = nil
+			m.viewMode = CategoriesViewList
+			m.err = nil
+		}
+	case "left", "right":
+		switch form.focusedField {
+		case 1:
+			form.iconInput = categoryIconChoices[cycleOption(indexOf(categoryIconChoices, form.iconInput), len(categoryIconChoices), msg.String())]
+		case 2:
+			form.kindIndex = cycleOption(form.kindIndex, len(categoryKinds), msg.String())
+		}
+	default:
+		switch form.focusedField {
+		case 0:
+			form.nameInput = editTextInput(form.nameInput, msg)
+		case 1:
+			// Like on the look form, a typed emoji replaces the icon
+			if msg.String() == "backspace" {
+				form.iconInput = ""
+			} else if text := typedText(msg); strings.TrimSpace(text) != "" {
+				form.iconInput = strings.TrimSpace(text)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CategoriesModel) saveCategory() tea.Cmd {
+	form := m.categoryForm
+	name, icon, kind := form.nameInput, form.iconInput, categoryKinds[form.kindIndex]
+
+	if form.editing == nil {
+		return func() tea.Msg {
+			category, err := m.categoryUseCase.CreateCategory(m.ctx, name, icon, kind)
+			if err != nil {
+				return errMsg{err}
+			}
+			return categoryAppearanceSavedMsg{message: fmt.Sprintf("Added the %s category", category.Name)}
+		}
+	}
+
+	id := form.editing.ID
+	return func() tea.Msg {
+		category, err := m.categoryUseCase.UpdateCategory(m.ctx, id, name, icon, kind)
+		if err != nil {
+			return errMsg{err}
+		}
+		return categoryAppearanceSavedMsg{message: fmt.Sprintf("Saved the %s category", category.Name)}
+	}
+}
+
+func (m *CategoriesModel) deleteCategory(category *entity.Category) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.categoryUseCase.DeleteCategory(m.ctx, category.ID); err != nil {
+			return errMsg{err}
+		}
+		return categoryAppearanceSavedMsg{message: fmt.Sprintf("Deleted the %s category", category.Name)}
+	}
+}
+
+func (m *CategoriesModel) renderCategoryForm() string {
+	form := m.categoryForm
+
+	title := "🏷️  New Category"
+	if form.editing != nil {
+		title = fmt.Sprintf("🏷️  Edit %s", form.editing.Name)
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(title))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+
+	fields := []string{
+		renderTextField("Name:", form.nameInput, form.focusedField == 0),
+		renderDefaultSelector("Icon:", form.iconInput, form.focusedField == 1),
+		renderDefaultSelector("Type:", categoryKindNames[form.kindIndex], form.focusedField == 2),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Income categories are left out of budgets and spending breakdowns"))
+	if form.editing != nil {
+		sections = append(sections, style.HelpStyle.Render("Renaming keeps the category's transactions, budgets and rules in it"))
+	}
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", form.focusedField, 3)))
+
+	help := "[Tab/↑↓] Navigate • [←/→] Pick • Type an emoji to use your own • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
@@ -13,77 +13,306 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/guptarohit/asciigraph"
+	"golang.org/x/sync/errgroup"
 )
 
+// recentTransactionsShown is how many transactions the dashboard lists
+const recentTransactionsShown = 5
+
+// balanceTrendDays is how many days the balance trend charts
+const balanceTrendDays = 30
+
+// topCategoriesShown is how many of the month's spending categories the dashboard charts
+const topCategoriesShown = 5
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// dashboardSection is a group of widgets fed by the same query. Sections load
+// independently so a slow or failing query only affects its own widgets.
+type dashboardSection int
+
+const (
+	dashboardSectionAccounts dashboardSection = iota
+	dashboardSectionTransactions
+	dashboardSectionBills
+	dashboardSectionAlerts
+	dashboardSectionEmergencyFund
+	dashboardSectionKPIs
+	dashboardSectionBalanceTrend
+	dashboardSectionTopCategories
+	dashboardSectionDueSoon
+)
+
+var dashboardSectionNames = map[dashboardSection]string{
+	dashboardSectionAccounts:      "accounts",
+	dashboardSectionTransactions:  "transactions",
+	dashboardSectionBills:         "bills",
+	dashboardSectionAlerts:        "price alerts",
+	dashboardSectionEmergencyFund: "emergency fund",
+	dashboardSectionKPIs:          "KPIs",
+	dashboardSectionBalanceTrend:  "balance trend",
+	dashboardSectionTopCategories: "top categories",
+	dashboardSectionDueSoon:       "due soon",
+}
+
 type DashboardModel struct {
 	ctx                context.Context
 	accountUseCase     *usecase.AccountUseCase
 	transactionUseCase *usecase.TransactionUseCase
 	billUseCase        *usecase.BillUseCase
+	subscriptionUC     *usecase.SubscriptionUseCase
+	emergencyFundUC    *usecase.EmergencyFundUseCase
+	kpiUC              *usecase.KPIUseCase
+	dashboardUC        *usecase.DashboardUseCase
+	dueReminderUC      *usecase.DueReminderUseCase
 
 	accounts     []*entity.Account
 	recentTxns   []*entity.Transaction
+	latestTxns   []*entity.Transaction
 	pendingBills []*entity.Bill
+	priceAlerts  []*usecase.PriceChangeAlert
+
+	emergencyFund *usecase.EmergencyFundStatus
+	kpis          []usecase.KPIResult
+	balanceTrend  *usecase.BalanceTrend
+	topCategories []usecase.CategorySpending
+	dueSoon       []usecase.DueItem
 
 	totalBalance    float64
 	monthlyIncome   float64
 	monthlyExpenses float64
+	spendPace       usecase.SpendPace
+
+	loading      map[dashboardSection]bool
+	sectionErrs  map[dashboardSection]error
+	spinnerID    int
+	spinnerFrame int
+	refresh      autoRefresh
 
-	loading bool
-	err     error
+	// profile times the first load of each section; ready is set once it's done
+	profile *StartupProfile
+	ready   bool
 }
 
-func NewDashboardModel(ctx context.Context, accountUC *usecase.AccountUseCase, txnUC *usecase.TransactionUseCase, billUC *usecase.BillUseCase) tea.Model {
+func NewDashboardModel(ctx context.Context, accountUC *usecase.AccountUseCase, txnUC *usecase.TransactionUseCase, billUC *usecase.BillUseCase, subscriptionUC *usecase.SubscriptionUseCase, emergencyFundUC *usecase.EmergencyFundUseCase, kpiUC *usecase.KPIUseCase, dashboardUC *usecase.DashboardUseCase, dueReminderUC *usecase.DueReminderUseCase) tea.Model {
 	return &DashboardModel{
 		ctx:                ctx,
 		accountUseCase:     accountUC,
 		transactionUseCase: txnUC,
 		billUseCase:        billUC,
-		loading:            true,
+		subscriptionUC:     subscriptionUC,
+		emergencyFundUC:    emergencyFundUC,
+		kpiUC:              kpiUC,
+		dashboardUC:        dashboardUC,
+		dueReminderUC:      dueReminderUC,
+		loading:            make(map[dashboardSection]bool),
+		sectionErrs:        make(map[dashboardSection]error),
 	}
 }
 
 func (m *DashboardModel) Init() tea.Cmd {
-	return m.loadData
+	m.spinnerID++
+	return tea.Batch(
+		m.startLoading(dashboardSectionAccounts, timedLoad(m.loadAccounts)),
+		m.startLoading(dashboardSectionTransactions, timedLoad(m.loadTransactions)),
+		m.startLoading(dashboardSectionBills, timedLoad(m.loadBills)),
+		m.startLoading(dashboardSectionAlerts, timedLoad(m.loadPriceAlerts)),
+		m.startLoading(dashboardSectionEmergencyFund, timedLoad(m.loadEmergencyFund)),
+		m.startLoading(dashboardSectionKPIs, timedLoad(m.loadKPIs)),
+		m.startLoading(dashboardSectionBalanceTrend, timedLoad(m.loadBalanceTrend)),
+		m.startLoading(dashboardSectionTopCategories, timedLoad(m.loadTopCategories)),
+		m.startLoading(dashboardSectionDueSoon, timedLoad(m.loadDueSoon)),
+		m.tickSpinner(),
+		m.refresh.start(),
+	)
+}
+
+// SetRefreshInterval reloads the dashboard every interval while it is shown; zero disables it
+func (m *DashboardModel) SetRefreshInterval(interval time.Duration) {
+	m.refresh.interval = interval
+}
+
+// SetStartupProfile records how long each section takes to load the first time
+func (m *DashboardModel) SetStartupProfile(profile *StartupProfile) {
+	m.profile = profile
+}
+
+// timedLoad stamps the section a load returns with how long it took
+func timedLoad(load tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		started := time.Now()
+		msg := load()
+		if loaded, ok := msg.(dashboardSectionLoadedMsg); ok {
+			loaded.took = time.Since(started)
+			return loaded
+		}
+		return msg
+	}
+}
+
+func (m *DashboardModel) startLoading(section dashboardSection, load tea.Cmd) tea.Cmd {
+	m.loading[section] = true
+	return load
 }
 
 func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case dataLoadedMsg:
-		m.loading = false
-		m.accounts = msg.accounts
-		m.recentTxns = msg.transactions
-		m.pendingBills = msg.bills
+	case dashboardSectionLoadedMsg:
+		m.loading[msg.section] = false
+		m.sectionErrs[msg.section] = msg.err
+		readyCmd := m.checkReady(msg)
+		if msg.err != nil {
+			return m, readyCmd
+		}
+
+		switch msg.section {
+		case dashboardSectionAccounts:
+			m.accounts = msg.accounts
+		case dashboardSectionTransactions:
+			m.recentTxns = msg.transactions
+			m.latestTxns = msg.latest
+		case dashboardSectionBills:
+			m.pendingBills = msg.bills
+		case dashboardSectionAlerts:
+			m.priceAlerts = msg.priceAlerts
+		case dashboardSectionEmergencyFund:
+			m.emergencyFund = msg.emergencyFund
+		case dashboardSectionKPIs:
+			m.kpis = msg.kpis
+		case dashboardSectionBalanceTrend:
+			m.balanceTrend = msg.balanceTrend
+		case dashboardSectionTopCategories:
+			m.topCategories = msg.topCategories
+		case dashboardSectionDueSoon:
+			m.dueSoon = msg.dueSoon
+		}
 		m.calculateTotals()
-		return m, nil
+		return m, readyCmd
+
+	case dashboardSpinnerMsg:
+		if msg.id != m.spinnerID || !m.isLoading() {
+			return m, nil
+		}
+		m.spinnerFrame = (m.spinnerFrame + 1) % len(spinnerFrames)
+		return m, m.tickSpinner()
+
+	case refreshMsg:
+		if !m.refresh.due(msg) {
+			return m, nil
+		}
+		// Reload in the background, keeping the current figures on screen until the new ones arrive
+		return m, tea.Batch(m.loadAccounts, m.loadTransactions, m.loadBills, m.loadPriceAlerts, m.loadEmergencyFund, m.loadKPIs, m.loadBalanceTrend, m.loadTopCategories, m.loadDueSoon, m.refresh.tick())
+
+	case priceAcknowledgedMsg:
+		m.spinnerID++
+		return m, tea.Batch(m.startLoading(dashboardSectionAlerts, m.loadPriceAlerts), m.tickSpinner())
+
+	case tea.KeyMsg:
+		if msg.String() == "a" && len(m.priceAlerts) > 0 {
+			return m, m.acknowledgePrice(m.priceAlerts[0])
+		}
 
 	case errMsg:
-		m.loading = false
-		m.err = msg.err
+		m.sectionErrs[dashboardSectionAlerts] = msg.err
 		return m, nil
 	}
 
 	return m, nil
 }
 
-func (m *DashboardModel) View() string {
-	if m.loading {
-		return style.InfoStyle.Render("Loading dashboard data...")
+// checkReady records the first load of a section in the startup profile and,
+// once every section is in, announces the dashboard is ready
+func (m *DashboardModel) checkReady(msg dashboardSectionLoadedMsg) tea.Cmd {
+	if m.profile == nil || m.ready {
+		return nil
+	}
+	m.profile.Record("dashboard: "+dashboardSectionNames[msg.section], msg.took)
+	if m.isLoading() {
+		return nil
 	}
 
-	if m.err != nil {
-		return style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	m.ready = true
+	m.profile.Mark("dashboard: ready")
+	return func() tea.Msg { return DashboardReadyMsg{} }
+}
+
+func (m *DashboardModel) isLoading() bool {
+	for _, loading := range m.loading {
+		if loading {
+			return true
+		}
 	}
+	return false
+}
 
+func (m *DashboardModel) tickSpinner() tea.Cmd {
+	id := m.spinnerID
+	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		return dashboardSpinnerMsg{id: id}
+	})
+}
+
+func (m *DashboardModel) spinner(label string) string {
+	return style.InfoStyle.Render(spinnerFrames[m.spinnerFrame] + " " + label)
+}
+
+// sectionStatus returns the placeholder to show instead of a widget while its
+// section is loading or after it failed, or "" once its data is ready
+func (m *DashboardModel) sectionStatus(section dashboardSection) string {
+	if m.loading[section] {
+		return m.spinner("Loading...")
+	}
+	if err := m.sectionErrs[section]; err != nil {
+		return style.ErrorStyle.Render(fmt.Sprintf("Error: %v", err))
+	}
+	return ""
+}
+
+// amountOrStatus formats a summary amount, or the status of the sections it depends on
+func (m *DashboardModel) amountOrStatus(amount float64, sections ...dashboardSection) string {
+	for _, section := range sections {
+		if m.loading[section] {
+			return spinnerFrames[m.spinnerFrame]
+		}
+		if m.sectionErrs[section] != nil {
+			return "—"
+		}
+	}
+	return formatAmount(amount)
+}
+
+func (m *DashboardModel) View() string {
 	var sections []string
 
 	// Summary Cards
 	summaryCards := m.renderSummaryCards()
 	sections = append(sections, summaryCards)
+	if kpiCards := m.renderKPICards(); kpiCards != "" {
+		sections = append(sections, kpiCards)
+	}
+	sections = append(sections, m.renderSpendPace())
+	if line := m.renderEmergencyFund(); line != "" {
+		sections = append(sections, line)
+	}
+
+	// Bills and invoices coming due
+	if err := m.sectionErrs[dashboardSectionDueSoon]; err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error loading due bills: %v", err)))
+	} else if len(m.dueSoon) > 0 {
+		sections = append(sections, m.renderDueSoon())
+	}
+
+	// Subscription price changes waiting for acknowledgement
+	if err := m.sectionErrs[dashboardSectionAlerts]; err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error loading price alerts: %v", err)))
+	} else if len(m.priceAlerts) > 0 {
+		sections = append(sections, m.renderPriceAlerts())
+	}
 
 	// Monthly Trend Chart
 	trendChart := m.renderMonthlyTrend()
 	sections = append(sections, trendChart)
+	sections = append(sections, m.renderTopCategories())
 
 	// Bottom Section: Accounts, Recent Transactions, Pending Bills
 	bottomSection := lipgloss.JoinHorizontal(
@@ -99,15 +328,95 @@ func (m *DashboardModel) View() string {
 
 func (m *DashboardModel) renderSummaryCards() string {
 	cards := []string{
-		m.renderCard("Total Balance", fmt.Sprintf("R$ %.2f", m.totalBalance), style.Primary),
-		m.renderCard("Monthly Income", fmt.Sprintf("R$ %.2f", m.monthlyIncome), style.Success),
-		m.renderCard("Monthly Expenses", fmt.Sprintf("R$ %.2f", m.monthlyExpenses), style.Danger),
-		m.renderCard("Net Savings", fmt.Sprintf("R$ %.2f", m.monthlyIncome-m.monthlyExpenses), style.Info),
+		m.renderCard("Total Balance", m.amountOrStatus(m.totalBalance, dashboardSectionAccounts), style.Primary),
+		m.renderCard("Monthly Income", m.amountOrStatus(m.monthlyIncome, dashboardSectionTransactions), style.Success),
+		m.renderCard("Monthly Expenses", m.amountOrStatus(m.monthlyExpenses, dashboardSectionTransactions), style.Danger),
+		m.renderCard("Net Savings", m.amountOrStatus(m.monthlyIncome-m.monthlyExpenses, dashboardSectionTransactions), style.Info),
 	}
 
 	return lipgloss.JoinHorizontal(lipgloss.Top, cards...)
 }
 
+// renderKPICards shows a card for each KPI formula the user defined
+func (m *DashboardModel) renderKPICards() string {
+	if m.kpiUC == nil || !m.kpiUC.HasFormulas() {
+		return ""
+	}
+	if status := m.sectionStatus(dashboardSectionKPIs); status != "" {
+		return status
+	}
+
+	var cards []string
+	for _, kpi := range m.kpis {
+		value, color := formatAmount(kpi.Value), style.Secondary
+		if kpi.Err != nil {
+			value, color = "—", style.Danger
+		}
+		cards = append(cards, m.renderCard(truncate(kpi.Name, 16), value, color))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, cards...)}
+	for _, kpi := range m.kpis {
+		if kpi.Err != nil {
+			rows = append(rows, style.ErrorStyle.Render(fmt.Sprintf("%s: %v", truncate(kpi.Name, 30), kpi.Err)))
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func (m *DashboardModel) renderSpendPace() string {
+	if status := m.sectionStatus(dashboardSectionTransactions); status != "" {
+		return status
+	}
+
+	pace := m.spendPace
+	line := fmt.Sprintf("🏃 You can spend %s/day for the rest of the month (%d days left)",
+		formatAmount(pace.DailyAllowance), pace.DaysLeft)
+
+	today := fmt.Sprintf("Today: %s spent, %s left", formatAmount(pace.SpentToday), formatAmount(pace.RemainingToday()))
+	todayStyle := style.SuccessStyle
+	if pace.IsOverPace() {
+		today = fmt.Sprintf("Today: %s spent, %s over pace", formatAmount(pace.SpentToday), formatAmount(-pace.RemainingToday()))
+		todayStyle = style.WarningStyle
+	}
+
+	return lipgloss.NewStyle().MarginTop(1).Render(line + " • " + todayStyle.Render(today))
+}
+
+// renderEmergencyFund shows how many months of essential expenses the
+// emergency fund covers against its target
+func (m *DashboardModel) renderEmergencyFund() string {
+	if m.emergencyFundUC == nil {
+		return ""
+	}
+	if status := m.sectionStatus(dashboardSectionEmergencyFund); status != "" {
+		return status
+	}
+
+	fund := m.emergencyFund
+	if fund == nil || fund.EssentialMonthly <= 0 {
+		return style.HelpStyle.Render("🛟 Emergency fund: no essential expenses yet, set them in Accounts with [m]")
+	}
+
+	progress := fund.Progress()
+	barWidth := 20
+	filled := int(progress * float64(barWidth) / 100)
+	color := style.Danger
+	switch {
+	case progress >= 100:
+		color = style.Success
+	case progress >= 50:
+		color = style.Warning
+	}
+	bar := lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(style.Border).Render(strings.Repeat("░", barWidth-filled))
+
+	line := fmt.Sprintf("🛟 Emergency fund covers %.1f of %d months %s %.0f%%", fund.MonthsCovered(), fund.Plan.TargetMonths, bar, progress)
+	if missing := fund.Missing(); missing > 0 {
+		line += style.HelpStyle.Render(fmt.Sprintf(" • %s to go", formatAmount(missing)))
+	}
+	return line
+}
+
 func (m *DashboardModel) renderCard(title, value string, color lipgloss.Color) string {
 	cardStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -134,32 +443,147 @@ func (m *DashboardModel) renderCard(title, value string, color lipgloss.Color) s
 	return cardStyle.Render(content)
 }
 
+func (m *DashboardModel) renderPriceAlerts() string {
+	alertStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Warning).
+		Padding(0, 2).
+		MarginTop(1)
+
+	lines := []string{style.WarningStyle.Render("🔔 Subscription Price Changes")}
+	for i, alert := range m.priceAlerts {
+		if i >= 3 {
+			lines = append(lines, fmt.Sprintf("   ... and %d more", len(m.priceAlerts)-3))
+			break
+		}
+
+		line := fmt.Sprintf("%-20s %s → %s (%+.2f, %+.1f%%)",
+			truncate(alert.Subscription.Description, 20),
+			formatMoney(alert.PreviousAmount),
+			formatMoney(alert.NewAmount),
+			alert.Delta.Amount(),
+			alert.DeltaPercentage(),
+		)
+		if i == 0 {
+			line = "► " + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, style.HelpStyle.Render("[a] Acknowledge new price"))
+
+	return alertStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderDueSoon is the banner of the bills and invoices due within the days
+// ahead or overdue
+func (m *DashboardModel) renderDueSoon() string {
+	color := style.Warning
+	for _, item := range m.dueSoon {
+		if item.DaysLeft <= 0 {
+			color = style.Danger
+		}
+	}
+
+	bannerStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(0, 2).
+		MarginTop(1)
+
+	title := fmt.Sprintf("⏰ Due in the next %d days", m.dueReminderUC.DaysAhead())
+	lines := []string{lipgloss.NewStyle().Foreground(color).Bold(true).Render(title)}
+	for i, item := range m.dueSoon {
+		if i >= 5 {
+			lines = append(lines, fmt.Sprintf("   ... and %d more", len(m.dueSoon)-5))
+			break
+		}
+
+		when := item.When()
+		if item.DaysLeft <= 0 {
+			when = style.ErrorStyle.Render(when)
+		}
+		lines = append(lines, fmt.Sprintf("%-30s %14s  %s", truncate(item.Name, 30), formatAmount(item.Amount), when))
+	}
+
+	return bannerStyle.Render(strings.Join(lines, "\n"))
+}
+
 func (m *DashboardModel) renderMonthlyTrend() string {
-	// Generate sample data for the last 30 days
-	data := make([]float64, 30)
-	for i := range data {
-		// Simulate daily balance changes
-		data[i] = m.totalBalance + float64(i-15)*100 + float64(i%7)*50
+	chartStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if status := m.sectionStatus(dashboardSectionBalanceTrend); status != "" {
+		return chartStyle.Render(status)
+	}
+
+	// The axis labels would give the balance away
+	if privacyMode {
+		return chartStyle.Render(style.HelpStyle.Render("30-Day Balance Trend hidden in privacy mode"))
+	}
+
+	trend := m.balanceTrend
+	if trend == nil || trend.Transactions == 0 {
+		return chartStyle.Render(style.HelpStyle.Render("30-Day Balance Trend: no account transactions in the last 30 days to chart"))
+	}
+
+	data := make([]float64, len(trend.Days))
+	for i, day := range trend.Days {
+		data[i] = day.Balance
 	}
 
 	graph := asciigraph.Plot(data,
 		asciigraph.Height(10),
 		asciigraph.Width(80),
-		asciigraph.Caption("30-Day Balance Trend"),
+		asciigraph.Caption(fmt.Sprintf("30-Day Balance Trend (%s – %s)", trend.Days[0].Date.Format("02/01"), trend.Days[len(trend.Days)-1].Date.Format("02/01"))),
 	)
 
-	chartStyle := lipgloss.NewStyle().
+	return chartStyle.Render(graph)
+}
+
+// renderTopCategories charts the month's largest spending categories, the
+// largest filling the whole bar
+func (m *DashboardModel) renderTopCategories() string {
+	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(style.Border).
-		Padding(1, 2).
+		Padding(0, 2).
 		MarginTop(1)
 
-	return chartStyle.Render(graph)
+	title := style.HeaderStyle.Render("Top Spending This Month")
+	if status := m.sectionStatus(dashboardSectionTopCategories); status != "" {
+		return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, status))
+	}
+	if len(m.topCategories) == 0 {
+		return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, style.HelpStyle.Render("No spending recorded this month")))
+	}
+
+	rows := []string{title}
+	largest := m.topCategories[0].Total
+	for _, spending := range m.topCategories {
+		filled := int(spending.Total / largest * reportBarWidth)
+		if filled < 1 {
+			filled = 1
+		}
+		bar := lipgloss.NewStyle().Foreground(categoryColor(spending.Category)).Render(strings.Repeat("█", filled)) +
+			strings.Repeat(" ", reportBarWidth-filled)
+		rows = append(rows, fmt.Sprintf("%s %s %5.1f%% %14s",
+			renderCategoryCell(spending.Category, 20), bar, spending.Share, formatAmount(spending.Total)))
+	}
+	return boxStyle.Render(strings.Join(rows, "\n"))
 }
 
 func (m *DashboardModel) renderAccountsList() string {
 	title := style.TitleStyle.Render("Accounts")
 
+	if status := m.sectionStatus(dashboardSectionAccounts); status != "" {
+		return m.renderSection(title, status, 35)
+	}
+
 	if len(m.accounts) == 0 {
 		return m.renderSection(title, "No accounts found", 30)
 	}
@@ -170,7 +594,7 @@ func (m *DashboardModel) renderAccountsList() string {
 		line := fmt.Sprintf("%s %-15s %10s",
 			icon,
 			truncate(acc.Name, 15),
-			acc.Balance.String(),
+			formatMoney(acc.Balance),
 		)
 		lines = append(lines, line)
 	}
@@ -182,16 +606,16 @@ func (m *DashboardModel) renderAccountsList() string {
 func (m *DashboardModel) renderRecentTransactions() string {
 	title := style.TitleStyle.Render("Recent Transactions")
 
-	if len(m.recentTxns) == 0 {
+	if status := m.sectionStatus(dashboardSectionTransactions); status != "" {
+		return m.renderSection(title, status, 45)
+	}
+
+	if len(m.latestTxns) == 0 {
 		return m.renderSection(title, "No recent transactions", 40)
 	}
 
 	var lines []string
-	for i, txn := range m.recentTxns {
-		if i >= 5 {
-			break
-		}
-
+	for _, txn := range m.latestTxns {
 		icon := "📤"
 		if txn.Type == entity.TransactionTypeCredit {
 			icon = "📥"
@@ -201,7 +625,7 @@ func (m *DashboardModel) renderRecentTransactions() string {
 			icon,
 			txn.Date.Format("Jan 02"),
 			truncate(txn.Description, 15),
-			txn.Amount.String(),
+			formatMoney(txn.Amount),
 		)
 		lines = append(lines, line)
 	}
@@ -213,6 +637,10 @@ func (m *DashboardModel) renderRecentTransactions() string {
 func (m *DashboardModel) renderPendingBills() string {
 	title := style.TitleStyle.Render("Pending Bills")
 
+	if status := m.sectionStatus(dashboardSectionBills); status != "" {
+		return m.renderSection(title, status, 35)
+	}
+
 	if len(m.pendingBills) == 0 {
 		return m.renderSection(title, "No pending bills", 30)
 	}
@@ -229,7 +657,7 @@ func (m *DashboardModel) renderPendingBills() string {
 		line := fmt.Sprintf("%s %-15s %10s",
 			statusIcon,
 			truncate(bill.Name, 15),
-			remaining.String(),
+			formatMoney(remaining),
 		)
 		lines = append(lines, line)
 	}
@@ -277,6 +705,8 @@ func (m *DashboardModel) calculateTotals() {
 			}
 		}
 	}
+
+	m.spendPace = usecase.CalculateSpendPace(m.recentTxns, now)
 }
 
 func (m *DashboardModel) getAccountIcon(accountType entity.AccountType) string {
@@ -315,38 +745,120 @@ func truncate(s string, max int) string {
 }
 
 // Commands
-func (m *DashboardModel) loadData() tea.Msg {
+func (m *DashboardModel) loadAccounts() tea.Msg {
 	accounts, err := m.accountUseCase.ListAccounts(m.ctx)
-	if err != nil {
-		return errMsg{err: err}
+	return dashboardSectionLoadedMsg{section: dashboardSectionAccounts, accounts: accounts, err: err}
+}
+
+func (m *DashboardModel) loadTransactions() tea.Msg {
+	msg := dashboardSectionLoadedMsg{section: dashboardSectionTransactions}
+
+	// The totals and the recent list come from separate queries, so run them side by side
+	g, ctx := errgroup.WithContext(m.ctx)
+	g.Go(func() error {
+		now := time.Now()
+		// Cover the whole month so far even in 31-day months, for the monthly totals and spend pace
+		since := now.AddDate(0, 0, -30)
+		if startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()); startOfMonth.Before(since) {
+			since = startOfMonth
+		}
+		transactions, err := m.transactionUseCase.GetTransactionsByDateRange(ctx, since, now)
+		msg.transactions = transactions
+		return err
+	})
+	g.Go(func() error {
+		latest, err := m.transactionUseCase.GetLatestTransactions(ctx, recentTransactionsShown)
+		msg.latest = latest
+		return err
+	})
+	msg.err = g.Wait()
+
+	return msg
+}
+
+func (m *DashboardModel) loadBills() tea.Msg {
+	bills, err := m.billUseCase.GetPendingBills(m.ctx)
+	return dashboardSectionLoadedMsg{section: dashboardSectionBills, bills: bills, err: err}
+}
+
+func (m *DashboardModel) loadPriceAlerts() tea.Msg {
+	alerts, err := m.subscriptionUC.GetPriceChangeAlerts(m.ctx, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionAlerts, priceAlerts: alerts, err: err}
+}
+
+func (m *DashboardModel) loadEmergencyFund() tea.Msg {
+	if m.emergencyFundUC == nil {
+		return dashboardSectionLoadedMsg{section: dashboardSectionEmergencyFund}
 	}
+	status, err := m.emergencyFundUC.GetStatus(m.ctx, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionEmergencyFund, emergencyFund: status, err: err}
+}
 
-	now := time.Now()
-	thirtyDaysAgo := now.AddDate(0, 0, -30)
-	transactions, err := m.transactionUseCase.GetTransactionsByDateRange(m.ctx, thirtyDaysAgo, now)
-	if err != nil {
-		return errMsg{err: err}
+func (m *DashboardModel) loadKPIs() tea.Msg {
+	if m.kpiUC == nil {
+		return dashboardSectionLoadedMsg{section: dashboardSectionKPIs}
 	}
+	kpis, err := m.kpiUC.EvaluateKPIs(m.ctx, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionKPIs, kpis: kpis, err: err}
+}
 
-	bills, err := m.billUseCase.GetPendingBills(m.ctx)
-	if err != nil {
-		return errMsg{err: err}
+func (m *DashboardModel) loadBalanceTrend() tea.Msg {
+	if m.dashboardUC == nil {
+		return dashboardSectionLoadedMsg{section: dashboardSectionBalanceTrend}
 	}
+	trend, err := m.dashboardUC.GetBalanceTrend(m.ctx, balanceTrendDays, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionBalanceTrend, balanceTrend: trend, err: err}
+}
 
-	return dataLoadedMsg{
-		accounts:     accounts,
-		transactions: transactions,
-		bills:        bills,
+func (m *DashboardModel) loadTopCategories() tea.Msg {
+	if m.dashboardUC == nil {
+		return dashboardSectionLoadedMsg{section: dashboardSectionTopCategories}
+	}
+	top, err := m.dashboardUC.GetTopSpendingCategories(m.ctx, topCategoriesShown, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionTopCategories, topCategories: top, err: err}
+}
+
+func (m *DashboardModel) loadDueSoon() tea.Msg {
+	if m.dueReminderUC == nil {
+		return dashboardSectionLoadedMsg{section: dashboardSectionDueSoon}
+	}
+	due, err := m.dueReminderUC.UpcomingDue(m.ctx, time.Now())
+	return dashboardSectionLoadedMsg{section: dashboardSectionDueSoon, dueSoon: due, err: err}
+}
+
+func (m *DashboardModel) acknowledgePrice(alert *usecase.PriceChangeAlert) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.subscriptionUC.AcknowledgePrice(m.ctx, alert); err != nil {
+			return errMsg{err: err}
+		}
+		return priceAcknowledgedMsg{}
 	}
 }
 
 // Messages
-type dataLoadedMsg struct {
-	accounts     []*entity.Account
-	transactions []*entity.Transaction
-	bills        []*entity.Bill
+type dashboardSectionLoadedMsg struct {
+	section       dashboardSection
+	accounts      []*entity.Account
+	transactions  []*entity.Transaction
+	latest        []*entity.Transaction
+	bills         []*entity.Bill
+	priceAlerts   []*usecase.PriceChangeAlert
+	emergencyFund *usecase.EmergencyFundStatus
+	kpis          []usecase.KPIResult
+	balanceTrend  *usecase.BalanceTrend
+	topCategories []usecase.CategorySpending
+	dueSoon       []usecase.DueItem
+	err           error
+	// took is how long the load took, set on the initial loads
+	took time.Duration
 }
 
+type dashboardSpinnerMsg struct {
+	id int
+}
+
+type priceAcknowledgedMsg struct{}
+
 type errMsg struct {
 	err error
 }
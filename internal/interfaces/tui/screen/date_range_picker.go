@@ -0,0 +1,205 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// reportDateRanges are the periods reports and exports can cover
+var reportDateRanges = []entity.FilterDateRange{
+	entity.FilterDateRangeMonth,
+	entity.FilterDateRangeLastMonth,
+	entity.FilterDateRangeQuarter,
+	entity.FilterDateRangeYear,
+	entity.FilterDateRangeLast12Months,
+	entity.FilterDateRangeCustom,
+}
+
+// dateRangePickerResult is what a key press did to the picker
+type dateRangePickerResult int
+
+const (
+	dateRangePicking dateRangePickerResult = iota
+	dateRangePicked
+	dateRangeCancelled
+)
+
+// dateRangePicker picks a period without leaving the keyboard's home row: a
+// list of relative presets, and under Custom a second level with the dates
+type dateRangePicker struct {
+	ranges []entity.FilterDateRange
+	cursor int
+
+	// Second level: the custom dates, 0: from, 1: to
+	custom       bool
+	focusedField int
+	startDate    string
+	endDate      string
+
+	err error
+}
+
+// newDateRangePicker offers ranges with the cursor on the current period
+func newDateRangePicker(ranges []entity.FilterDateRange, current entity.TransactionFilter) *dateRangePicker {
+	p := &dateRangePicker{
+		ranges:    ranges,
+		cursor:    indexOf(ranges, current.DateRange),
+		startDate: current.StartDate,
+		endDate:   current.EndDate,
+	}
+
+	// Custom dates start from the current period, ready to be adjusted
+	if p.startDate == "" || p.endDate == "" {
+		start, end, bounded := current.Period(time.Now())
+		if !bounded {
+			now := time.Now()
+			start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+			end = start.AddDate(0, 1, 0)
+		}
+		p.startDate = start.Format("2006-01-02")
+		p.endDate = end.AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	return p
+}
+
+// selected is the picked period as a filter date range
+func (p *dateRangePicker) selected() entity.TransactionFilter {
+	filter := entity.TransactionFilter{DateRange: p.ranges[p.cursor]}
+	if filter.DateRange == entity.FilterDateRangeCustom {
+		filter.StartDate, filter.EndDate = p.startDate, p.endDate
+	}
+	return filter
+}
+
+func (p *dateRangePicker) handleKey(msg tea.KeyMsg) dateRangePickerResult {
+	p.err = nil
+	if p.custom {
+		return p.handleCustomKey(msg)
+	}
+
+	key := msg.String()
+	switch key {
+	case "esc":
+		return dateRangeCancelled
+	case "up", "k":
+		p.cursor = (p.cursor - 1 + len(p.ranges)) % len(p.ranges)
+	case "down", "j":
+		p.cursor = (p.cursor + 1) % len(p.ranges)
+	case "enter", "right", "l":
+		if p.ranges[p.cursor] == entity.FilterDateRangeCustom {
+			p.custom = true
+			p.focusedField = 0
+			return dateRangePicking
+		}
+		return dateRangePicked
+	default:
+		// The number keys pick a preset straight away
+		if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+			if index := int(key[0] - '1'); index < len(p.ranges) {
+				p.cursor = index
+				if p.ranges[index] == entity.FilterDateRangeCustom {
+					p.custom = true
+					p.focusedField = 0
+					return dateRangePicking
+				}
+				return dateRangePicked
+			}
+		}
+	}
+	return dateRangePicking
+}
+
+func (p *dateRangePicker) handleCustomKey(msg tea.KeyMsg) dateRangePickerResult {
+	switch msg.String() {
+	case "esc":
+		p.custom = false
+	case "tab", "shift+tab", "up", "down":
+		p.focusedField = 1 - p.focusedField
+	case "enter":
+		if err := p.selected().Validate(); err != nil {
+			p.err = err
+			return dateRangePicking
+		}
+		return dateRangePicked
+	case "+", "=", "-":
+		// Step the focused date a day at a time
+		step := 1
+		if msg.String() == "-" {
+			step = -1
+		}
+		date := &p.startDate
+		if p.focusedField == 1 {
+			date = &p.endDate
+		}
+		if parsed, err := time.Parse("2006-01-02", *date); err == nil {
+			*date = parsed.AddDate(0, 0, step).Format("2006-01-02")
+		}
+	default:
+		if p.focusedField == 0 {
+			p.startDate = editDateInput(p.startDate, msg)
+		} else {
+			p.endDate = editDateInput(p.endDate, msg)
+		}
+	}
+	return dateRangePicking
+}
+
+func (p *dateRangePicker) view() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📅 Pick a Period"))
+
+	now := time.Now()
+	var rows []string
+	for i, dateRange := range p.ranges {
+		label := fmt.Sprintf("%d. %s", i+1, filterDateRangeNames[indexOf(filterDateRanges, dateRange)])
+		dates := ""
+		if dateRange != entity.FilterDateRangeCustom {
+			dates = describePeriod(entity.TransactionFilter{DateRange: dateRange}, now)
+		}
+		line := fmt.Sprintf("%-22s %s", label, lipgloss.NewStyle().Foreground(style.TextMuted).Render(dates))
+		if i == p.cursor {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		rows = append(rows, line)
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(rows, "\n")))
+
+	help := "[↑/↓] Navigate • [Enter/→] Pick • [1-9] Pick Directly • [Esc] Cancel"
+	if p.custom {
+		fields := []string{
+			renderTextField("From (YYYY-MM-DD):", p.startDate, p.focusedField == 0),
+			renderTextField("To (YYYY-MM-DD):", p.endDate, p.focusedField == 1),
+		}
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).MarginLeft(4).Render(strings.Join(fields, "\n")))
+		help = "[Tab] Switch Date • [+/-] Next/Previous Day • [Enter] Pick • [Esc] Back to Presets"
+	}
+	if p.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(p.err.Error()))
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// describePeriod shows the dates a filter's range covers at now, such as
+// "01/04/2026 – 30/06/2026"
+func describePeriod(filter entity.TransactionFilter, now time.Time) string {
+	start, end, bounded := filter.Period(now)
+	if !bounded {
+		return ""
+	}
+	last := end.AddDate(0, 0, -1)
+	if last.Equal(start) {
+		return start.Format("02/01/2006")
+	}
+	return fmt.Sprintf("%s – %s", start.Format("02/01/2006"), last.Format("02/01/2006"))
+}
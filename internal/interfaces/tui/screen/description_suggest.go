@@ -0,0 +1,40 @@
+package screen
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// descriptionHistoryWindow is how far back past descriptions are offered as completions
+const descriptionHistoryWindow = 365 * 24 * time.Hour
+
+type descriptionHistoryLoadedMsg struct {
+	descriptions []string
+}
+
+func (m *TransactionsModel) loadDescriptionHistory() tea.Msg {
+	descriptions, err := m.transactionUseCase.GetFrequentDescriptions(m.ctx, time.Now().Add(-descriptionHistoryWindow))
+	if err != nil {
+		// Completions are a convenience, the form works without them
+		return descriptionHistoryLoadedMsg{}
+	}
+	return descriptionHistoryLoadedMsg{descriptions: descriptions}
+}
+
+// descriptionCompletion is the most used past description starting with what has
+// been typed so far, or "" when there is none
+func (m *TransactionsModel) descriptionCompletion() string {
+	typed := m.formModel.descriptionInput
+	if strings.TrimSpace(typed) == "" {
+		return ""
+	}
+
+	for _, description := range m.formModel.descriptionHistory {
+		if len(description) > len(typed) && strings.EqualFold(description[:len(typed)], typed) {
+			return description
+		}
+	}
+	return ""
+}
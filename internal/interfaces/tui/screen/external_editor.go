@@ -0,0 +1,72 @@
+package screen
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorFinishedMsg carries the text saved in the external editor back to the
+// form field identified by field
+type editorFinishedMsg struct {
+	field string
+	value string
+	err   error
+}
+
+// openInEditor suspends the TUI and opens value in $VISUAL or $EDITOR (vi when
+// neither is set), resuming once the editor exits. With singleLine the result is
+// collapsed to one line, for fields shown in tables.
+func openInEditor(field, value string, singleLine bool) tea.Cmd {
+	file, err := os.CreateTemp("", "financli-*.txt")
+	if err != nil {
+		return func() tea.Msg {
+			return editorFinishedMsg{field: field, err: fmt.Errorf("failed to create temp file: %w", err)}
+		}
+	}
+	path := file.Name()
+
+	_, err = file.WriteString(value)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return func() tea.Msg {
+			return editorFinishedMsg{field: field, err: fmt.Errorf("failed to write temp file: %w", err)}
+		}
+	}
+
+	args := append(strings.Fields(editorCommand()), path)
+	cmd := exec.Command(args[0], args[1:]...)
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return editorFinishedMsg{field: field, err: fmt.Errorf("editor failed: %w", err)}
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return editorFinishedMsg{field: field, err: fmt.Errorf("failed to read edited text: %w", err)}
+		}
+
+		text := strings.TrimRight(string(content), " \t\r\n")
+		if singleLine {
+			text = strings.Join(strings.Fields(text), " ")
+		}
+		return editorFinishedMsg{field: field, value: text}
+	})
+}
+
+func editorCommand() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
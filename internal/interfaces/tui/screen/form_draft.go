@@ -0,0 +1,298 @@
+package screen
+
+import (
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// formGuard keeps the long forms from losing what was typed into them.
+// Leaving the form of a new record with something typed keeps it as a draft,
+// filled back in the next time the form opens; leaving an edit, or any form
+// with drafts off, asks before throwing the changes away. Drafts last while
+// the app runs, and quitting with one asks first too, unless the guard has a
+// store: then the draft is saved in storage as it's typed instead, and
+// survives quitting.
+//
+// Screens embed the guard and fill in screen with their side of the form,
+// the rest of the flow is the guard's.
+type formGuard[T any] struct {
+	screen     formScreen[T]
+	keepDrafts bool
+	// store keeps the draft across runs, nil to keep it in memory
+	store *draftStore
+
+	// opened fingerprints the form as it opened, to tell whether it changed
+	opened string
+
+	draft    T
+	hasDraft bool
+	// fromDraft is set while the open form was filled in from the draft
+	fromDraft bool
+
+	// confirming is set while asking whether to discard the changes
+	confirming bool
+}
+
+// formScreen is a screen's side of its formGuard
+type formScreen[T any] struct {
+	// form is the screen's form, replaced by the draft when it's restored
+	form *T
+	// fingerprint sums up the fields of the form the user can change
+	fingerprint func() string
+	// editing reports whether the form is for a record already saved
+	editing func() bool
+	// showing reports whether the screen shows the form
+	showing func() bool
+	// reset sets a blank form for a new record. It's a new value rather than
+	// the old one emptied, which may be kept as the draft.
+	reset func()
+	// list goes back from the form to the list
+	list func()
+
+	// fields and restore turn the form into the fields of a stored draft and
+	// back, for a guard with a store
+	fields  func() map[string]string
+	restore func(fields map[string]string) tea.Cmd
+}
+
+// SetFormDrafts makes leaving a new record half filled keep it as a draft
+// instead of asking to discard it
+func (g *formGuard[T]) SetFormDrafts(enabled bool) {
+	g.keepDrafts = enabled
+}
+
+// HasUnsavedChanges reports whether anything would be lost by quitting: a
+// draft kept in memory, or changes to the form if it's shown. A stored draft
+// isn't lost, what's still to save of it is flushed on quitting.
+func (g *formGuard[T]) HasUnsavedChanges() bool {
+	if g.storing() {
+		return g.screen.showing() && g.screen.editing() && g.changed(g.screen.fingerprint())
+	}
+	return (g.keepDrafts && g.hasDraft) || (g.screen.showing() && g.changed(g.screen.fingerprint()))
+}
+
+// FlushDraft saves what's typed into the new record's form and not stored
+// yet, for when the app quits before typing pauses
+func (g *formGuard[T]) FlushDraft() error {
+	if !g.storing() || !g.screen.showing() || g.screen.editing() {
+		return nil
+	}
+	return g.store.flush(g.screen.fields(), g.blank())
+}
+
+// storing reports whether the drafts are saved in storage as they're typed
+func (g *formGuard[T]) storing() bool {
+	return g.store != nil && g.keepDrafts
+}
+
+// openForm fills the new record's form in from the draft, if there's one,
+// and starts telling its changes apart. A stored draft is looked up first,
+// and fills the form in once it's found.
+func (g *formGuard[T]) openForm() tea.Cmd {
+	if g.storing() {
+		g.open(g.screen.fingerprint(), false)
+		return g.store.load()
+	}
+
+	draft, ok := g.restore()
+	if ok {
+		*g.screen.form = draft
+	}
+	g.open(g.screen.fingerprint(), ok)
+	return nil
+}
+
+// leaveForm closes the form unless the guard asks about its changes first.
+// A stored draft left behind is saved right away rather than once typing
+// pauses.
+func (g *formGuard[T]) leaveForm() tea.Cmd {
+	if g.storing() && !g.screen.editing() {
+		var cmd tea.Cmd
+		if g.store.pending() {
+			cmd = g.store.keep(g.screen.fields(), g.blank())
+		}
+		g.closeForm()
+		return cmd
+	}
+
+	if g.leave(*g.screen.form, g.screen.fingerprint(), g.screen.editing()) {
+		g.closeForm()
+	}
+	return nil
+}
+
+// closeForm goes back to the list with a blank form, whatever was typed
+func (g *formGuard[T]) closeForm() {
+	g.screen.list()
+	g.screen.reset()
+}
+
+// discardDraft empties the form restored from the draft and drops the draft
+func (g *formGuard[T]) discardDraft() tea.Cmd {
+	g.discard()
+	g.screen.reset()
+	g.open(g.screen.fingerprint(), false)
+
+	if g.storing() {
+		return g.store.remove()
+	}
+	return nil
+}
+
+// savedForm forgets the draft once the new record it was for is saved
+func (g *formGuard[T]) savedForm() tea.Cmd {
+	g.saved()
+	if g.storing() {
+		return g.store.remove()
+	}
+	return nil
+}
+
+// autosave saves the new record's form once typing pauses, when the key
+// handled since before changed it
+func (g *formGuard[T]) autosave(before string) tea.Cmd {
+	if !g.storing() || !g.screen.showing() || g.screen.editing() {
+		return nil
+	}
+	fingerprint := g.screen.fingerprint()
+	if fingerprint == before {
+		return nil
+	}
+	// Restoring or discarding the draft sets the form as it opens, untyped
+	if fingerprint == g.opened && !g.store.pending() {
+		return nil
+	}
+	return g.store.touch()
+}
+
+// blank reports whether the form is as it opened without a draft
+func (g *formGuard[T]) blank() bool {
+	return !g.fromDraft && !g.changed(g.screen.fingerprint())
+}
+
+// update handles the messages of the stored draft, reporting whether msg was
+// one of them
+func (g *formGuard[T]) update(msg tea.Msg) (tea.Cmd, bool) {
+	if g.store == nil {
+		return nil, false
+	}
+
+	switch msg := msg.(type) {
+	case draftAutosaveMsg:
+		if msg.form != g.store.form {
+			return nil, false
+		}
+		if g.store.due(msg) && g.screen.showing() && !g.screen.editing() {
+			return g.store.keep(g.screen.fields(), g.blank()), true
+		}
+		return nil, true
+
+	case storedDraftLoadedMsg:
+		if msg.form != g.store.form {
+			return nil, false
+		}
+		// Only filled in while the form is still as it opened
+		if msg.draft == nil || !g.screen.showing() || g.screen.editing() || g.changed(g.screen.fingerprint()) {
+			return nil, true
+		}
+		cmd := g.screen.restore(msg.draft.Fields)
+		g.open(g.screen.fingerprint(), true)
+		return cmd, true
+
+	case draftStoredMsg:
+		if msg.form != g.store.form {
+			return nil, false
+		}
+		g.store.err = msg.err
+		return nil, true
+	}
+
+	return nil, false
+}
+
+// open records how the form looks as it opens. fingerprint sums up the
+// fields the user can change, and fromDraft tells the form was filled in from
+// the draft.
+func (g *formGuard[T]) open(fingerprint string, fromDraft bool) {
+	g.opened = fingerprint
+	g.fromDraft = fromDraft
+	g.confirming = false
+}
+
+// restore returns the draft to fill a new record's form with, if there's one
+func (g *formGuard[T]) restore() (T, bool) {
+	return g.draft, g.keepDrafts && g.hasDraft
+}
+
+func (g *formGuard[T]) changed(fingerprint string) bool {
+	return fingerprint != g.opened
+}
+
+// leave reports whether the form can close now. A changed new record is kept
+// as the draft; any other change makes the guard ask first, and the form
+// closes once discard is answered.
+func (g *formGuard[T]) leave(form T, fingerprint string, editing bool) bool {
+	if !g.changed(fingerprint) {
+		return true
+	}
+	if g.keepDrafts && !editing {
+		g.draft, g.hasDraft = form, true
+		return true
+	}
+	g.confirming = true
+	return false
+}
+
+// saved forgets the draft kept in memory
+func (g *formGuard[T]) saved() {
+	var zero T
+	g.draft, g.hasDraft = zero, false
+	g.fromDraft = false
+}
+
+// discard throws the draft away
+func (g *formGuard[T]) discard() {
+	g.saved()
+}
+
+// handlePromptKeys answers the discard prompt while it's shown, reporting
+// whether key was for it and whether the changes were discarded
+func (g *formGuard[T]) handlePromptKeys(key string) (handled, discarded bool) {
+	if !g.confirming {
+		return false, false
+	}
+
+	switch key {
+	case "y", "Y":
+		g.confirming = false
+		return true, true
+	case "n", "N", "esc":
+		g.confirming = false
+	}
+	return true, false
+}
+
+// renderPrompt is the discard question, or "" when not asking
+func (g *formGuard[T]) renderPrompt() string {
+	if !g.confirming {
+		return ""
+	}
+	return style.WarningStyle.MarginTop(1).Render("⚠️  Discard your changes? [y] Discard • [n] Keep editing")
+}
+
+// renderDraftNote tells the form was filled in from the draft and whether
+// the draft couldn't be stored, or is "" when neither
+func (g *formGuard[T]) renderDraftNote() string {
+	var notes []string
+	if g.fromDraft {
+		notes = append(notes, style.InfoStyle.Render("📝 Restored from your draft • [Ctrl+X] Discard draft"))
+	}
+	if g.store != nil {
+		if note := g.store.renderNote(); note != "" {
+			notes = append(notes, note)
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, notes...)
+}
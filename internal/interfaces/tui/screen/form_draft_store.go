@@ -0,0 +1,130 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// draftAutosaveDelay is how long typing has to pause before the draft is saved
+const draftAutosaveDelay = time.Second
+
+type draftAutosaveMsg struct {
+	form entity.FormDraftKind
+	seq  int
+}
+
+type draftStoredMsg struct {
+	form entity.FormDraftKind
+	err  error
+}
+
+type storedDraftLoadedMsg struct {
+	form  entity.FormDraftKind
+	draft *entity.FormDraft
+}
+
+// draftStore saves the draft of a new record's form in storage as it's typed,
+// so quitting or a crash doesn't lose it, and looks it up the next time the
+// form opens
+type draftStore struct {
+	ctx     context.Context
+	useCase *usecase.FormDraftUseCase
+	form    entity.FormDraftKind
+
+	// seq counts the changes typed into the form, saved is the last one stored
+	seq   int
+	saved int
+
+	err error
+}
+
+// newDraftStore returns nil without a use case, leaving the drafts in memory
+func newDraftStore(ctx context.Context, useCase *usecase.FormDraftUseCase, form entity.FormDraftKind) *draftStore {
+	if useCase == nil {
+		return nil
+	}
+	return &draftStore{ctx: ctx, useCase: useCase, form: form}
+}
+
+// load looks up the stored draft, to fill in a form opened blank
+func (s *draftStore) load() tea.Cmd {
+	return func() tea.Msg {
+		draft, err := s.useCase.GetDraft(s.ctx, s.form)
+		if err != nil {
+			return draftStoredMsg{form: s.form, err: err}
+		}
+		return storedDraftLoadedMsg{form: s.form, draft: draft}
+	}
+}
+
+// touch notes a change to the form, to save it once typing pauses
+func (s *draftStore) touch() tea.Cmd {
+	s.seq++
+	seq := s.seq
+	return tea.Tick(draftAutosaveDelay, func(time.Time) tea.Msg {
+		return draftAutosaveMsg{form: s.form, seq: seq}
+	})
+}
+
+// due reports whether msg is for the latest change and it isn't saved yet
+func (s *draftStore) due(msg draftAutosaveMsg) bool {
+	return msg.form == s.form && msg.seq == s.seq && s.pending()
+}
+
+// pending reports whether there are changes not saved yet
+func (s *draftStore) pending() bool {
+	return s.saved != s.seq
+}
+
+// save stores fields as the draft
+func (s *draftStore) save(fields map[string]string) tea.Cmd {
+	s.saved = s.seq
+	return func() tea.Msg {
+		return draftStoredMsg{form: s.form, err: s.useCase.SaveDraft(s.ctx, s.form, fields)}
+	}
+}
+
+// keep stores fields as the draft, or drops it when the form is back to blank,
+// as it opened, since what was typed is gone
+func (s *draftStore) keep(fields map[string]string, blank bool) tea.Cmd {
+	if blank {
+		return s.remove()
+	}
+	return s.save(fields)
+}
+
+// flush stores the changes not saved yet right away, rather than in a
+// command, as the app quits
+func (s *draftStore) flush(fields map[string]string, blank bool) error {
+	if !s.pending() {
+		return nil
+	}
+	s.saved = s.seq
+	if blank {
+		return s.useCase.DiscardDraft(s.ctx, s.form)
+	}
+	return s.useCase.SaveDraft(s.ctx, s.form, fields)
+}
+
+// remove drops the stored draft, once its record is saved or it's discarded
+func (s *draftStore) remove() tea.Cmd {
+	s.saved = s.seq
+	return func() tea.Msg {
+		return draftStoredMsg{form: s.form, err: s.useCase.DiscardDraft(s.ctx, s.form)}
+	}
+}
+
+// renderNote tells the draft couldn't be saved, or is ""
+func (s *draftStore) renderNote() string {
+	if s.err == nil {
+		return ""
+	}
+	return style.WarningStyle.Render(fmt.Sprintf("⚠️  Draft not saved: %v", s.err))
+}
@@ -0,0 +1,149 @@
+package screen
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	digitRunes  = "0123456789"
+	amountRunes = "0123456789."
+	dateRunes   = "0123456789-"
+)
+
+// editTextInput applies a backspace or typed text to a text input
+func editTextInput(value string, msg tea.KeyMsg) string {
+	if msg.String() == "backspace" {
+		return deleteLastRune(value)
+	}
+	return value + typedText(msg)
+}
+
+// editAmountInput is editTextInput for amounts. Pasted amounts written with a
+// decimal comma, such as "R$ 1.234,56", are converted to "1234.56".
+func editAmountInput(value string, msg tea.KeyMsg) string {
+	if msg.String() == "backspace" {
+		return deleteLastRune(value)
+	}
+
+	text := typedText(msg)
+	if strings.Contains(text, ",") {
+		text = strings.ReplaceAll(text, ".", "")
+		text = strings.ReplaceAll(text, ",", ".")
+	}
+	return value + filterRunes(text, amountRunes)
+}
+
+// editDateInput is editTextInput for YYYY-MM-DD dates
+func editDateInput(value string, msg tea.KeyMsg) string {
+	if msg.String() == "backspace" {
+		return deleteLastRune(value)
+	}
+	return value + filterRunes(typedText(msg), dateRunes)
+}
+
+// editDigitsInput is editTextInput for numbers of at most maxLen digits
+func editDigitsInput(value string, msg tea.KeyMsg, maxLen int) string {
+	if msg.String() == "backspace" {
+		return deleteLastRune(value)
+	}
+
+	value += filterRunes(typedText(msg), digitRunes)
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	return value
+}
+
+// typedText returns the text a key event inserts. Terminals deliver a paste as
+// a single burst of runes, so this can be a whole pasted chunk rather than one
+// character; control keys insert nothing.
+func typedText(msg tea.KeyMsg) string {
+	if msg.Alt {
+		return ""
+	}
+
+	switch msg.Type {
+	case tea.KeySpace:
+		return " "
+	case tea.KeyRunes:
+		return string(msg.Runes)
+	}
+	return ""
+}
+
+func filterRunes(text, allowed string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(allowed, r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+func deleteLastRune(value string) string {
+	_, size := utf8.DecodeLastRuneInString(value)
+	return value[:len(value)-size]
+}
+
+// cycleOption moves a selector index left or right, wrapping around
+func cycleOption(index, count int, key string) int {
+	switch key {
+	case "left", "h":
+		return (index - 1 + count) % count
+	case "right", "l":
+		return (index + 1) % count
+	}
+	return index
+}
+
+func renderTextField(label, value string, focused bool) string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(style.Text).
+		Bold(true).
+		Width(20)
+
+	var input string
+	if focused {
+		input = style.FocusedInputStyle.Width(30).Render(value) + " ◄"
+	} else {
+		input = style.InputStyle.Width(30).Render(value)
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		labelStyle.Render(label),
+		input,
+	)
+}
+
+// renderSubmitCancelButtons renders the submit and cancel buttons, with submit at
+// field index submitField and cancel right after it
+func renderSubmitCancelButtons(submitText string, focusedField, submitField int) string {
+	submitStyle, cancelStyle := style.SecondaryButtonStyle, style.SecondaryButtonStyle
+	if focusedField == submitField {
+		submitStyle = style.ButtonStyle.Background(style.Success)
+	}
+	if focusedField == submitField+1 {
+		cancelStyle = style.ButtonStyle.Background(style.Danger)
+	}
+
+	submitBtn := submitStyle.Render(submitText)
+	cancelBtn := cancelStyle.Render("Cancel")
+	if focusedField == submitField {
+		submitBtn = submitBtn + " ◄"
+	} else if focusedField == submitField+1 {
+		cancelBtn = cancelBtn + " ◄"
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		submitBtn,
+		lipgloss.NewStyle().MarginLeft(2).Render(cancelBtn),
+	)
+}
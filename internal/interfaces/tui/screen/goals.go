@@ -0,0 +1,437 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type GoalsViewMode int
+
+const (
+	GoalsViewList GoalsViewMode = iota
+	GoalsViewForm
+	GoalsViewConfirmDelete
+)
+
+type GoalsModel struct {
+	ctx            context.Context
+	goalUseCase    *usecase.GoalUseCase
+	accountUseCase *usecase.AccountUseCase
+
+	progress      []*usecase.GoalProgress
+	accounts      []*entity.Account
+	selectedIndex int
+	viewMode      GoalsViewMode
+
+	loading bool
+	err     error
+	message string
+
+	// Form state
+	editing         *entity.Goal
+	focusedField    int // 0: name, 1: target, 2: deadline, 3: account, 4: save, 5: cancel
+	nameInput       string
+	targetInput     string
+	deadlineInput   string
+	selectedAccount int
+	formErr         error
+}
+
+func NewGoalsModel(ctx context.Context, goalUC *usecase.GoalUseCase, accountUC *usecase.AccountUseCase) tea.Model {
+	return &GoalsModel{
+		ctx:            ctx,
+		goalUseCase:    goalUC,
+		accountUseCase: accountUC,
+		viewMode:       GoalsViewList,
+		loading:        true,
+	}
+}
+
+type goalsLoadedMsg struct {
+	progress []*usecase.GoalProgress
+	accounts []*entity.Account
+}
+
+type goalSavedMsg struct {
+	message string
+}
+
+func (m *GoalsModel) Init() tea.Cmd {
+	return m.loadGoals
+}
+
+func (m *GoalsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case goalsLoadedMsg:
+		m.loading = false
+		m.progress = msg.progress
+		m.accounts = msg.accounts
+		if m.selectedIndex >= len(m.progress) {
+			m.selectedIndex = 0
+		}
+		return m, nil
+
+	case goalSavedMsg:
+		m.viewMode = GoalsViewList
+		m.message = msg.message
+		return m, m.loadGoals
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case GoalsViewList:
+			return m.handleListKeys(msg)
+		case GoalsViewForm:
+			return m.handleFormKeys(msg)
+		case GoalsViewConfirmDelete:
+			return m.handleConfirmKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *GoalsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(m.progress)-1 {
+			m.selectedIndex++
+		}
+	case "n":
+		m.openForm(nil)
+	case "e", "enter":
+		if len(m.progress) > 0 {
+			m.openForm(m.progress[m.selectedIndex].Goal)
+		}
+	case "d":
+		if len(m.progress) > 0 {
+			m.viewMode = GoalsViewConfirmDelete
+		}
+	case "r":
+		m.loading = true
+		m.err = nil
+		return m, m.loadGoals
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *GoalsModel) openForm(goal *entity.Goal) {
+	m.editing = goal
+	m.formErr = nil
+	m.message = ""
+	m.nameInput = ""
+	m.targetInput = ""
+	m.deadlineInput = time.Now().AddDate(1, 0, 0).Format("2006-01-02")
+	m.selectedAccount = 0
+	m.focusedField = 0
+	if goal != nil {
+		m.nameInput = goal.Name
+		m.targetInput = fmt.Sprintf("%.2f", goal.TargetAmount.Amount())
+		m.deadlineInput = goal.Deadline.Format("2006-01-02")
+		for i, account := range m.accounts {
+			if account.ID == goal.AccountID {
+				m.selectedAccount = i
+			}
+		}
+	}
+	m.viewMode = GoalsViewForm
+}
+
+func (m *GoalsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = GoalsViewList
+	case "tab", "down":
+		m.focusedField = (m.focusedField + 1) % 6
+	case "shift+tab", "up":
+		m.focusedField = (m.focusedField - 1 + 6) % 6
+	case "enter":
+		switch m.focusedField {
+		case 4:
+			return m, m.saveGoal()
+		case 5:
+			m.viewMode = GoalsViewList
+		}
+	case "left", "right":
+		if m.focusedField == 3 && len(m.accounts) > 0 {
+			m.selectedAccount = cycleOption(m.selectedAccount, len(m.accounts), msg.String())
+		}
+	default:
+		switch m.focusedField {
+		case 0:
+			m.nameInput = editTextInput(m.nameInput, msg)
+		case 1:
+			m.targetInput = editAmountInput(m.targetInput, msg)
+		case 2:
+			m.deadlineInput = editDateInput(m.deadlineInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *GoalsModel) saveGoal() tea.Cmd {
+	if strings.TrimSpace(m.nameInput) == "" {
+		m.formErr = fmt.Errorf("goal name is required")
+		return nil
+	}
+	target, err := strconv.ParseFloat(m.targetInput, 64)
+	if err != nil || target <= 0 {
+		m.formErr = fmt.Errorf("invalid target amount")
+		return nil
+	}
+	deadline, err := time.ParseInLocation("2006-01-02", m.deadlineInput, time.Local)
+	if err != nil {
+		m.formErr = fmt.Errorf("invalid deadline (use YYYY-MM-DD)")
+		return nil
+	}
+	if m.selectedAccount >= len(m.accounts) {
+		m.formErr = fmt.Errorf("create an account first, goals are saved in one")
+		return nil
+	}
+	m.formErr = nil
+
+	name := m.nameInput
+	accountID := m.accounts[m.selectedAccount].ID
+	if m.editing != nil {
+		goal := m.editing
+		return func() tea.Msg {
+			if _, err := m.goalUseCase.UpdateGoal(m.ctx, goal.ID, name, target, deadline, accountID); err != nil {
+				return errMsg{err}
+			}
+			return goalSavedMsg{message: fmt.Sprintf("Updated the %s goal", name)}
+		}
+	}
+
+	return func() tea.Msg {
+		if _, err := m.goalUseCase.CreateGoal(m.ctx, name, target, "BRL", deadline, accountID); err != nil {
+			return errMsg{err}
+		}
+		return goalSavedMsg{message: fmt.Sprintf("Created the %s goal", name)}
+	}
+}
+
+func (m *GoalsModel) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "enter":
+		goal := m.progress[m.selectedIndex].Goal
+		m.viewMode = GoalsViewList
+		return m, func() tea.Msg {
+			if err := m.goalUseCase.DeleteGoal(m.ctx, goal.ID); err != nil {
+				return errMsg{err}
+			}
+			return goalSavedMsg{message: fmt.Sprintf("Deleted the %s goal", goal.Name)}
+		}
+	case "n", "esc":
+		m.viewMode = GoalsViewList
+	}
+
+	return m, nil
+}
+
+func (m *GoalsModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading goals...")
+	}
+
+	if m.err != nil {
+		return style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	}
+
+	switch m.viewMode {
+	case GoalsViewForm:
+		return m.renderForm()
+	case GoalsViewConfirmDelete:
+		return m.renderConfirmDelete()
+	}
+	return m.renderList()
+}
+
+func (m *GoalsModel) renderList() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🏁 Savings Goals"))
+
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	now := time.Now()
+	if len(m.progress) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No goals yet. Press 'n' to save towards an amount by a deadline."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-20s %-22s %5s %14s %14s %-10s  %s", "Goal", "Progress", "", "Saved", "Target", "Deadline", "Projected"))}
+		for i, progress := range m.progress {
+			row := fmt.Sprintf("%-20s %s %4.0f%% %14s %14s %-10s  %s",
+				truncateString(progress.Goal.Name, 20),
+				renderGoalBar(progress, now, 22),
+				progress.Percentage(),
+				formatAmount(progress.Saved),
+				formatMoney(progress.Goal.TargetAmount),
+				progress.Goal.Deadline.Format("02/01/2006"),
+				renderGoalProjection(progress, now))
+
+			if i == m.selectedIndex {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► ")+row)
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  ")+row)
+			}
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+		sections = append(sections, m.renderGoalDetails(m.progress[m.selectedIndex], now))
+	}
+
+	help := "[↑/↓] Navigate • [n] New • [e] Edit • [d] Delete • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderGoalBar fills up as the goal is saved, green while the recent savings
+// rate reaches it by the deadline and yellow when it falls behind
+func renderGoalBar(progress *usecase.GoalProgress, now time.Time, width int) string {
+	filled := int(progress.Percentage() * float64(width) / 100)
+
+	color := style.Warning
+	if progress.IsReached() || progress.OnTrack(now) {
+		color = style.Success
+	}
+
+	return lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(style.Border).Render(strings.Repeat("░", width-filled))
+}
+
+// renderGoalProjection tells when the goal is reached at the recent savings rate
+func renderGoalProjection(progress *usecase.GoalProgress, now time.Time) string {
+	if progress.IsReached() {
+		return style.SuccessStyle.Render("Reached")
+	}
+	date, ok := progress.ProjectedCompletion(now)
+	if !ok {
+		return style.WarningStyle.Render("Not saving")
+	}
+	if date.After(progress.Goal.Deadline) {
+		return style.WarningStyle.Render(date.Format("01/2006") + " (late)")
+	}
+	return style.SuccessStyle.Render(date.Format("01/2006"))
+}
+
+func (m *GoalsModel) renderGoalDetails(progress *usecase.GoalProgress, now time.Time) string {
+	detailsStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if progress.Account == nil {
+		return detailsStyle.Render(style.ErrorStyle.Render("The linked account was deleted. Press 'e' to pick another one."))
+	}
+
+	details := []string{
+		fmt.Sprintf("Account: %s", progress.Account.Name),
+		fmt.Sprintf("Saving lately: %s a month (last 3 months)", formatAmount(progress.MonthlySavings)),
+	}
+	if !progress.IsReached() {
+		details = append(details, fmt.Sprintf("Missing: %s", formatAmount(progress.Remaining())))
+		if months := progress.Goal.MonthsLeft(now); months > 0 {
+			details = append(details, fmt.Sprintf("Needed to make the deadline: %s a month for %d months", formatAmount(progress.RequiredMonthly(now)), months))
+		} else {
+			details = append(details, style.ErrorStyle.Render("The deadline has passed"))
+		}
+	}
+	return detailsStyle.Render(strings.Join(details, "\n"))
+}
+
+func (m *GoalsModel) renderForm() string {
+	title := "🏁 New Goal"
+	if m.editing != nil {
+		title = "🏁 Edit Goal"
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(title))
+
+	if m.formErr != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.formErr)))
+	}
+
+	account := "No accounts"
+	if m.selectedAccount < len(m.accounts) {
+		account = m.accounts[m.selectedAccount].Name
+	}
+	fields := []string{
+		renderTextField("Name:", m.nameInput, m.focusedField == 0),
+		renderTextField("Target Amount:", m.targetInput, m.focusedField == 1),
+		renderTextField("Deadline:", m.deadlineInput, m.focusedField == 2),
+		renderDefaultSelector("Account:", account, m.focusedField == 3),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("The balance of the account counts as saved towards the goal"))
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", m.focusedField, 4)))
+
+	help := "[Tab/↑↓] Navigate • [←/→] Account • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *GoalsModel) renderConfirmDelete() string {
+	goal := m.progress[m.selectedIndex].Goal
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Danger).
+		Padding(1, 2).
+		MarginTop(1)
+
+	content := fmt.Sprintf("Delete the %s goal of %s?\n\n%s",
+		goal.Name,
+		formatMoney(goal.TargetAmount),
+		style.HelpStyle.Render("[y] Yes • [n] No"))
+
+	return dialogStyle.Render(content)
+}
+
+// IsInFormMode implements the FormModeChecker interface
+func (m *GoalsModel) IsInFormMode() bool {
+	return m.viewMode == GoalsViewForm || m.viewMode == GoalsViewConfirmDelete
+}
+
+func (m *GoalsModel) loadGoals() tea.Msg {
+	progress, err := m.goalUseCase.GetGoalProgress(m.ctx, time.Now())
+	if err != nil {
+		return errMsg{err}
+	}
+	accounts, err := m.accountUseCase.ListAccounts(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	return goalsLoadedMsg{progress: progress, accounts: accounts}
+}
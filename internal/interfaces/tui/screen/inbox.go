@@ -0,0 +1,418 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type InboxViewMode int
+
+const (
+	InboxViewList InboxViewMode = iota
+	InboxViewEdit
+)
+
+// InboxModel lists the imported transactions waiting for review, which only
+// affect balances once approved
+type InboxModel struct {
+	ctx               context.Context
+	inboxUseCase      *usecase.InboxUseCase
+	accountUseCase    *usecase.AccountUseCase
+	creditCardUseCase *usecase.CreditCardUseCase
+
+	items  []*entity.InboxTransaction
+	lookup lookupIndex
+
+	// View state
+	selectedIndex int
+	viewMode      InboxViewMode
+
+	// Loading and errors
+	loading bool
+	err     error
+	message string
+
+	form *InboxEditFormModel
+}
+
+type InboxEditFormModel struct {
+	item             *entity.InboxTransaction
+	descriptionInput string
+	categoryIndex    int
+	amountInput      string
+	dateInput        string
+
+	// Navigation
+	focusedField int
+}
+
+type inboxLoadedMsg struct {
+	items       []*entity.InboxTransaction
+	accounts    []*entity.Account
+	creditCards []*entity.CreditCard
+}
+
+type inboxActionMsg struct {
+	message string
+}
+
+func NewInboxModel(ctx context.Context, inboxUC *usecase.InboxUseCase, accountUC *usecase.AccountUseCase, creditCardUC *usecase.CreditCardUseCase) tea.Model {
+	return &InboxModel{
+		ctx:               ctx,
+		inboxUseCase:      inboxUC,
+		accountUseCase:    accountUC,
+		creditCardUseCase: creditCardUC,
+		viewMode:          InboxViewList,
+		loading:           true,
+	}
+}
+
+func (m *InboxModel) Init() tea.Cmd {
+	return m.loadInbox
+}
+
+func (m *InboxModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case inboxLoadedMsg:
+		m.loading = false
+		m.err = nil
+		m.items = msg.items
+		m.lookup.setAccounts(msg.accounts)
+		m.lookup.setCreditCards(msg.creditCards)
+		if m.selectedIndex >= len(m.items) {
+			m.selectedIndex = 0
+		}
+		return m, nil
+
+	case inboxActionMsg:
+		m.viewMode = InboxViewList
+		m.form = nil
+		m.message = msg.message
+		return m, m.loadInbox
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case InboxViewList:
+			return m.handleListKeys(msg)
+		case InboxViewEdit:
+			return m.handleEditKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *InboxModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(m.items)-1 {
+			m.selectedIndex++
+		}
+	case "a":
+		if m.selectedIndex < len(m.items) {
+			m.loading = true
+			return m, m.approve(m.items[m.selectedIndex])
+		}
+	case "A":
+		if len(m.items) > 0 {
+			m.loading = true
+			return m, m.approveAll
+		}
+	case "x":
+		if m.selectedIndex < len(m.items) {
+			m.loading = true
+			return m, m.reject(m.items[m.selectedIndex])
+		}
+	case "e", "enter":
+		if m.selectedIndex < len(m.items) {
+			m.startEdit(m.items[m.selectedIndex])
+		}
+	case "r":
+		m.loading = true
+		m.message = ""
+		return m, m.loadInbox
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *InboxModel) startEdit(item *entity.InboxTransaction) {
+	txn := item.Transaction
+
+	categoryIndex := 0
+	for i, category := range transactionCategories() {
+		if category == txn.Category {
+			categoryIndex = i
+		}
+	}
+
+	m.viewMode = InboxViewEdit
+	m.err = nil
+	m.form = &InboxEditFormModel{
+		item:             item,
+		descriptionInput: txn.Description,
+		categoryIndex:    categoryIndex,
+		amountInput:      fmt.Sprintf("%.2f", txn.Amount.Amount()),
+		dateInput:        txn.Date.Format("2006-01-02"),
+	}
+}
+
+func (m *InboxModel) handleEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.form
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = InboxViewList
+		m.form = nil
+		return m, nil
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 6
+		return m, nil
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 6) % 6
+		return m, nil
+	case "enter":
+		if form.focusedField == 4 {
+			return m.submitEdit()
+		} else if form.focusedField == 5 {
+			// Cancel button
+			m.viewMode = InboxViewList
+			m.form = nil
+		}
+		return m, nil
+	}
+
+	switch form.focusedField {
+	case 0: // Description
+		form.descriptionInput = editTextInput(form.descriptionInput, msg)
+	case 1: // Category
+		form.categoryIndex = cycleOption(form.categoryIndex, len(transactionCategories()), msg.String())
+	case 2: // Amount
+		form.amountInput = editAmountInput(form.amountInput, msg)
+	case 3: // Date
+		form.dateInput = editDateInput(form.dateInput, msg)
+	}
+
+	return m, nil
+}
+
+func (m *InboxModel) submitEdit() (tea.Model, tea.Cmd) {
+	form := m.form
+
+	if strings.TrimSpace(form.descriptionInput) == "" {
+		m.err = fmt.Errorf("description is required")
+		return m, nil
+	}
+
+	amount, err := strconv.ParseFloat(form.amountInput, 64)
+	if err != nil || amount <= 0 {
+		m.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", form.dateInput, time.Local)
+	if err != nil {
+		m.err = fmt.Errorf("invalid date format (use YYYY-MM-DD)")
+		return m, nil
+	}
+
+	category := transactionCategories()[form.categoryIndex]
+
+	m.loading = true
+	return m, func() tea.Msg {
+		if _, err := m.inboxUseCase.Edit(m.ctx, form.item.ID, form.descriptionInput, category, amount, date); err != nil {
+			return errMsg{err: err}
+		}
+		return inboxActionMsg{message: "Transaction updated, still waiting for approval"}
+	}
+}
+
+func (m *InboxModel) loadInbox() tea.Msg {
+	items, err := m.inboxUseCase.ListPending(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	accounts, err := m.accountUseCase.ListAccounts(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	cards, err := m.creditCardUseCase.ListCreditCards(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return inboxLoadedMsg{items: items, accounts: accounts, creditCards: cards}
+}
+
+func (m *InboxModel) approve(item *entity.InboxTransaction) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.inboxUseCase.Approve(m.ctx, item.ID); err != nil {
+			return errMsg{err: err}
+		}
+		return inboxActionMsg{message: fmt.Sprintf("Approved: %s", item.Transaction.Description)}
+	}
+}
+
+func (m *InboxModel) approveAll() tea.Msg {
+	for i, item := range m.items {
+		if err := m.inboxUseCase.Approve(m.ctx, item.ID); err != nil {
+			return errMsg{err: fmt.Errorf("approved %d of %d: %w", i, len(m.items), err)}
+		}
+	}
+	return inboxActionMsg{message: fmt.Sprintf("Approved %d transactions", len(m.items))}
+}
+
+func (m *InboxModel) reject(item *entity.InboxTransaction) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.inboxUseCase.Reject(m.ctx, item.ID); err != nil {
+			return errMsg{err: err}
+		}
+		return inboxActionMsg{message: fmt.Sprintf("Rejected: %s", item.Transaction.Description)}
+	}
+}
+
+func (m *InboxModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading inbox...")
+	}
+
+	var content string
+	switch m.viewMode {
+	case InboxViewList:
+		content = m.renderList()
+	case InboxViewEdit:
+		content = m.renderEditForm()
+	}
+
+	if m.err != nil {
+		return lipgloss.JoinVertical(lipgloss.Top, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)), content)
+	}
+	return content
+}
+
+func (m *InboxModel) renderList() string {
+	var sections []string
+
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("📥 Review Inbox (%d pending)", len(m.items))))
+
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	if len(m.items) == 0 {
+		sections = append(sections, style.InfoStyle.Render("Nothing to review. Imported transactions land here before they affect balances."))
+	} else {
+		sections = append(sections, m.renderTable())
+	}
+
+	help := "[↑/↓] Navigate • [a] Approve • [A] Approve All • [e] Edit • [x] Reject • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *InboxModel) renderTable() string {
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	headerRow := style.TableHeaderStyle.Render(
+		fmt.Sprintf("%-10s %-30s %-18s %-14s %-20s %s",
+			"Date", "Description", "Category", "Amount", "Source", "Imported From"),
+	)
+
+	rows := []string{headerRow}
+	for i, item := range m.items {
+		txn := item.Transaction
+
+		amount := formatMoney(txn.Amount)
+		if txn.Type == entity.TransactionTypeDebit {
+			amount = "-" + amount
+		}
+
+		line := fmt.Sprintf("%-10s %-30s %-18s %-14s %-20s %s",
+			txn.Date.Format("2006-01-02"),
+			truncateString(txn.Description, 30),
+			renderCategoryCell(txn.Category, 18),
+			amount,
+			truncateString(m.sourceName(txn), 20),
+			truncateString(item.Source, 20))
+
+		if i == m.selectedIndex {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		rows = append(rows, line)
+	}
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
+
+func (m *InboxModel) sourceName(txn *entity.Transaction) string {
+	if txn.AccountID != nil {
+		return m.lookup.accountName(*txn.AccountID)
+	}
+	if txn.CreditCardID != nil {
+		return m.lookup.creditCardName(*txn.CreditCardID)
+	}
+	return "Unknown"
+}
+
+func (m *InboxModel) renderEditForm() string {
+	form := m.form
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("✏️ Review Transaction"))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(2, 4).
+		MarginTop(1)
+
+	fields := []string{
+		style.InfoStyle.Render(fmt.Sprintf("Imported from %s into %s", form.item.Source, m.sourceName(form.item.Transaction))),
+		renderTextField("Description:", form.descriptionInput, form.focusedField == 0),
+		renderDefaultSelector("Category:", categoryDisplayName(transactionCategories()[form.categoryIndex]), form.focusedField == 1),
+		renderTextField("Amount:", form.amountInput, form.focusedField == 2),
+		renderTextField("Date:", form.dateInput, form.focusedField == 3),
+		renderSubmitCancelButtons("Save", form.focusedField, 4),
+	}
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *InboxModel) IsInFormMode() bool {
+	return m.viewMode == InboxViewEdit
+}
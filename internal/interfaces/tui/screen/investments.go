@@ -0,0 +1,457 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type InvestmentsViewMode int
+
+const (
+	InvestmentsViewList InvestmentsViewMode = iota
+	InvestmentsViewForm
+)
+
+// tradeKind is what the investments form does
+type tradeKind int
+
+const (
+	tradeBuy tradeKind = iota
+	tradeSell
+	tradePrice
+)
+
+type InvestmentsModel struct {
+	ctx               context.Context
+	investmentUseCase *usecase.InvestmentUseCase
+
+	portfolio     *usecase.Portfolio
+	accounts      []*entity.Account
+	selectedIndex int
+	viewMode      InvestmentsViewMode
+
+	loading bool
+	err     error
+	message string
+
+	// Form state
+	trade           tradeKind
+	position        *usecase.Position // the position sold or repriced
+	focusedField    int
+	selectedAccount int
+	tickerInput     string
+	quantityInput   string
+	priceInput      string
+	formErr         error
+}
+
+func NewInvestmentsModel(ctx context.Context, investmentUC *usecase.InvestmentUseCase) tea.Model {
+	return &InvestmentsModel{
+		ctx:               ctx,
+		investmentUseCase: investmentUC,
+		viewMode:          InvestmentsViewList,
+		loading:           true,
+	}
+}
+
+type investmentsLoadedMsg struct {
+	portfolio *usecase.Portfolio
+	accounts  []*entity.Account
+}
+
+type tradeSavedMsg struct {
+	message string
+}
+
+func (m *InvestmentsModel) Init() tea.Cmd {
+	return m.loadInvestments
+}
+
+func (m *InvestmentsModel) loadInvestments() tea.Msg {
+	portfolio, err := m.investmentUseCase.GetPortfolio(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	accounts, err := m.investmentUseCase.ListInvestmentAccounts(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	return investmentsLoadedMsg{portfolio: portfolio, accounts: accounts}
+}
+
+func (m *InvestmentsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case investmentsLoadedMsg:
+		m.loading = false
+		m.portfolio = msg.portfolio
+		m.accounts = msg.accounts
+		if m.selectedIndex >= len(m.portfolio.Positions) {
+			m.selectedIndex = 0
+		}
+		return m, nil
+
+	case tradeSavedMsg:
+		m.viewMode = InvestmentsViewList
+		m.message = msg.message
+		return m, m.loadInvestments
+
+	case errMsg:
+		m.loading = false
+		if m.viewMode == InvestmentsViewForm {
+			m.formErr = msg.err
+			return m, nil
+		}
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case InvestmentsViewList:
+			return m.handleListKeys(msg)
+		case InvestmentsViewForm:
+			return m.handleFormKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *InvestmentsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.portfolio != nil && m.selectedIndex < len(m.portfolio.Positions)-1 {
+			m.selectedIndex++
+		}
+	case "n":
+		m.openForm(tradeBuy)
+	case "s":
+		if m.selectedPosition() != nil {
+			m.openForm(tradeSell)
+		}
+	case "p", "enter":
+		if m.selectedPosition() != nil {
+			m.openForm(tradePrice)
+		}
+	case "r":
+		m.loading = true
+		m.err = nil
+		return m, m.loadInvestments
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *InvestmentsModel) selectedPosition() *usecase.Position {
+	if m.portfolio == nil || m.selectedIndex >= len(m.portfolio.Positions) {
+		return nil
+	}
+	return m.portfolio.Positions[m.selectedIndex]
+}
+
+func (m *InvestmentsModel) openForm(trade tradeKind) {
+	m.trade = trade
+	m.position = nil
+	m.formErr = nil
+	m.message = ""
+	m.focusedField = 0
+	m.selectedAccount = 0
+	m.tickerInput = ""
+	m.quantityInput = ""
+	m.priceInput = ""
+	if trade != tradeBuy {
+		m.position = m.selectedPosition()
+		m.priceInput = fmt.Sprintf("%.2f", m.position.Holding.LastPrice.Amount())
+	}
+	if trade == tradeSell {
+		m.quantityInput = strconv.FormatFloat(m.position.Holding.Quantity, 'f', -1, 64)
+	}
+	m.viewMode = InvestmentsViewForm
+}
+
+// formFields lists the inputs of the form being shown, in focus order; the
+// save and cancel buttons follow them
+func (m *InvestmentsModel) formFields() []string {
+	switch m.trade {
+	case tradeSell:
+		return []string{"quantity", "price"}
+	case tradePrice:
+		return []string{"price"}
+	}
+	return []string{"account", "ticker", "quantity", "price"}
+}
+
+func (m *InvestmentsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	fields := m.formFields()
+	count := len(fields) + 2
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = InvestmentsViewList
+	case "tab", "down":
+		m.focusedField = (m.focusedField + 1) % count
+	case "shift+tab", "up":
+		m.focusedField = (m.focusedField - 1 + count) % count
+	case "enter":
+		switch m.focusedField {
+		case len(fields):
+			return m, m.saveTrade()
+		case len(fields) + 1:
+			m.viewMode = InvestmentsViewList
+		}
+	case "left", "right":
+		if m.focusedField < len(fields) && fields[m.focusedField] == "account" && len(m.accounts) > 0 {
+			m.selectedAccount = cycleOption(m.selectedAccount, len(m.accounts), msg.String())
+		}
+	default:
+		if m.focusedField >= len(fields) {
+			break
+		}
+		switch fields[m.focusedField] {
+		case "ticker":
+			m.tickerInput = strings.ToUpper(editTextInput(m.tickerInput, msg))
+		case "quantity":
+			m.quantityInput = editAmountInput(m.quantityInput, msg)
+		case "price":
+			m.priceInput = editAmountInput(m.priceInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *InvestmentsModel) saveTrade() tea.Cmd {
+	price, err := strconv.ParseFloat(m.priceInput, 64)
+	if err != nil || price <= 0 {
+		m.formErr = fmt.Errorf("invalid price")
+		return nil
+	}
+
+	if m.trade == tradePrice {
+		m.formErr = nil
+		holding := m.position.Holding
+		return func() tea.Msg {
+			if _, err := m.investmentUseCase.UpdatePrice(m.ctx, holding.ID, price); err != nil {
+				return errMsg{err}
+			}
+			return tradeSavedMsg{message: fmt.Sprintf("Updated the price of %s", holding.Ticker)}
+		}
+	}
+
+	quantity, err := strconv.ParseFloat(m.quantityInput, 64)
+	if err != nil || quantity <= 0 {
+		m.formErr = fmt.Errorf("invalid quantity")
+		return nil
+	}
+
+	if m.trade == tradeSell {
+		m.formErr = nil
+		holding := m.position.Holding
+		return func() tea.Msg {
+			profit, err := m.investmentUseCase.Sell(m.ctx, holding.ID, quantity, price)
+			if err != nil {
+				return errMsg{err}
+			}
+			return tradeSavedMsg{message: fmt.Sprintf("Sold %g %s, %s realized", quantity, holding.Ticker, formatAmount(profit))}
+		}
+	}
+
+	ticker := strings.TrimSpace(m.tickerInput)
+	if ticker == "" {
+		m.formErr = fmt.Errorf("ticker is required")
+		return nil
+	}
+	if m.selectedAccount >= len(m.accounts) {
+		m.formErr = fmt.Errorf("create an investment account first, holdings are bought in one")
+		return nil
+	}
+	m.formErr = nil
+
+	accountID := m.accounts[m.selectedAccount].ID
+	return func() tea.Msg {
+		holding, err := m.investmentUseCase.Buy(m.ctx, accountID, ticker, quantity, price)
+		if err != nil {
+			return errMsg{err}
+		}
+		return tradeSavedMsg{message: fmt.Sprintf("Bought %g %s", quantity, holding.Ticker)}
+	}
+}
+
+func (m *InvestmentsModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading investments...")
+	}
+
+	if m.err != nil {
+		return style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	}
+
+	if m.viewMode == InvestmentsViewForm {
+		return m.renderForm()
+	}
+	return m.renderList()
+}
+
+func (m *InvestmentsModel) renderList() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📈 Investments"))
+
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.Render(m.message))
+	}
+
+	portfolio := m.portfolio
+	if len(m.accounts) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No investment accounts yet. Create one on the Accounts screen to buy holdings in it."))
+	} else if len(portfolio.Positions) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No positions yet. Press 'n' to buy one."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-8s %-16s %10s %12s %12s %14s %14s %8s",
+			"Ticker", "Account", "Quantity", "Avg Price", "Price", "Value", "P/L", "P/L %"))}
+		for i, position := range portfolio.Positions {
+			holding := position.Holding
+			account := "(deleted)"
+			if position.Account != nil {
+				account = position.Account.Name
+			}
+			row := fmt.Sprintf("%-8s %-16s %10s %12s %12s %14s %s",
+				truncateString(holding.Ticker, 8),
+				truncateString(account, 16),
+				strconv.FormatFloat(holding.Quantity, 'f', -1, 64),
+				formatMoney(holding.AveragePrice),
+				formatMoney(holding.LastPrice),
+				formatAmount(holding.MarketValue()),
+				renderProfitLoss(fmt.Sprintf("%14s %7.2f%%", formatAmount(holding.ProfitLoss()), holding.ProfitLossPercentage()), holding.ProfitLoss()))
+
+			if i == m.selectedIndex {
+				rows = append(rows, style.SelectedMenuItemStyle.Render("► ")+row)
+			} else {
+				rows = append(rows, style.MenuItemStyle.Render("  ")+row)
+			}
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+	}
+
+	if len(m.accounts) > 0 {
+		sections = append(sections, m.renderTotals())
+	}
+
+	help := "[↑/↓] Navigate • [n] Buy • [s] Sell • [p/Enter] Update Price • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderProfitLoss shows text in green for a profit and red for a loss
+func renderProfitLoss(text string, amount float64) string {
+	switch {
+	case amount > 0:
+		return style.SuccessStyle.Render(text)
+	case amount < 0:
+		return style.ErrorStyle.Render(text)
+	}
+	return text
+}
+
+func (m *InvestmentsModel) renderTotals() string {
+	portfolio := m.portfolio
+
+	totalsStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(1, 2).
+		MarginTop(1)
+
+	totals := []string{
+		fmt.Sprintf("Invested:       %s", formatAmount(portfolio.Cost)),
+		fmt.Sprintf("Market value:   %s", formatAmount(portfolio.Value)),
+		fmt.Sprintf("Unrealized P/L: %s", renderProfitLoss(fmt.Sprintf("%s (%.2f%%)", formatAmount(portfolio.ProfitLoss), portfolio.ProfitLossPercentage()), portfolio.ProfitLoss)),
+		fmt.Sprintf("Realized P/L:   %s", renderProfitLoss(formatAmount(portfolio.Realized), portfolio.Realized)),
+		fmt.Sprintf("Cash:           %s", formatAmount(portfolio.Cash)),
+		fmt.Sprintf("Total:          %s", formatAmount(portfolio.Total())),
+	}
+	return totalsStyle.Render(strings.Join(totals, "\n"))
+}
+
+func (m *InvestmentsModel) renderForm() string {
+	var title, button string
+	switch m.trade {
+	case tradeSell:
+		title, button = fmt.Sprintf("📈 Sell %s", m.position.Holding.Ticker), "Sell"
+	case tradePrice:
+		title, button = fmt.Sprintf("📈 Update %s Price", m.position.Holding.Ticker), "Save"
+	default:
+		title, button = "📈 Buy", "Buy"
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(title))
+
+	if m.formErr != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.formErr)))
+	}
+
+	var fields []string
+	for i, field := range m.formFields() {
+		focused := m.focusedField == i
+		switch field {
+		case "account":
+			account := "No investment accounts"
+			if m.selectedAccount < len(m.accounts) {
+				account = fmt.Sprintf("%s (%s available)", m.accounts[m.selectedAccount].Name, formatMoney(m.accounts[m.selectedAccount].Balance))
+			}
+			fields = append(fields, renderDefaultSelector("Account:", account, focused))
+		case "ticker":
+			fields = append(fields, renderTextField("Ticker:", m.tickerInput, focused))
+		case "quantity":
+			fields = append(fields, renderTextField("Quantity:", m.quantityInput, focused))
+		case "price":
+			fields = append(fields, renderTextField("Price:", m.priceInput, focused))
+		}
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+
+	switch m.trade {
+	case tradeBuy:
+		sections = append(sections, style.HelpStyle.Render("The cost is paid out of the account's balance"))
+	case tradeSell:
+		holding := m.position.Holding
+		sections = append(sections, style.HelpStyle.Render(fmt.Sprintf("%s held at an average of %s; the proceeds go to the account's balance",
+			strconv.FormatFloat(holding.Quantity, 'f', -1, 64), formatMoney(holding.AveragePrice))))
+	}
+
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons(button, m.focusedField, len(m.formFields()))))
+
+	help := "[Tab/↑↓] Navigate • [Enter] Confirm • [Esc] Cancel"
+	if m.trade == tradeBuy {
+		help = "[Tab/↑↓] Navigate • [←/→] Account • [Enter] Confirm • [Esc] Cancel"
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// IsInFormMode implements the FormModeChecker interface
+func (m *InvestmentsModel) IsInFormMode() bool {
+	return m.viewMode == InvestmentsViewForm
+}
@@ -0,0 +1,162 @@
+package screen
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// invoicePeriods are the opening-date windows offered by the invoice list; zero months means no limit
+var invoicePeriods = []struct {
+	label  string
+	months int
+}{
+	{"Last 3 Months", 3},
+	{"Last 6 Months", 6},
+	{"Last 12 Months", 12},
+	{"All Time", 0},
+}
+
+// invoiceStatusFilters lists the status options, "" meaning any status
+var invoiceStatusFilters = []entity.InvoiceStatus{
+	"",
+	entity.InvoiceStatusOpen,
+	entity.InvoiceStatusClosed,
+	entity.InvoiceStatusOverdue,
+	entity.InvoiceStatusPaid,
+}
+
+// InvoiceFilterState scopes the invoice list so only the invoices asked for are loaded
+type InvoiceFilterState struct {
+	cardIndex   int // 0 = all cards, otherwise creditCards[cardIndex-1]
+	periodIndex int
+	statusIndex int
+}
+
+func (f *InvoiceFilterState) cardLabel(m *TransactionsModel) string {
+	if f.cardIndex == 0 || f.cardIndex > len(m.creditCards) {
+		return "All Cards"
+	}
+	return m.creditCards[f.cardIndex-1].Name
+}
+
+func (f *InvoiceFilterState) statusLabel() string {
+	status := invoiceStatusFilters[f.statusIndex]
+	if status == "" {
+		return "Any Status"
+	}
+	return string(status)
+}
+
+// handleInvoiceFilterKeys cycles the filters and reloads the list, reporting whether the key was a filter key
+func (m *TransactionsModel) handleInvoiceFilterKeys(key string) (tea.Cmd, bool) {
+	filters := &m.invoiceModel.filters
+	switch key {
+	case "c":
+		filters.cardIndex = (filters.cardIndex + 1) % (len(m.creditCards) + 1)
+	case "p":
+		filters.periodIndex = (filters.periodIndex + 1) % len(invoicePeriods)
+	case "s":
+		filters.statusIndex = (filters.statusIndex + 1) % len(invoiceStatusFilters)
+	default:
+		return nil, false
+	}
+
+	m.invoiceModel.selectedInvoiceIndex = 0
+	m.loading = true
+	return m.loadAllInvoices, true
+}
+
+// Load the invoices matching the current filters. Each card is queried by status
+// or by period through the repository, and the other filter is applied here.
+func (m *TransactionsModel) loadAllInvoices() tea.Msg {
+	filters := m.invoiceModel.filters
+
+	cards := m.creditCards
+	if filters.cardIndex > 0 && filters.cardIndex <= len(m.creditCards) {
+		cards = m.creditCards[filters.cardIndex-1 : filters.cardIndex]
+	}
+
+	status := invoiceStatusFilters[filters.statusIndex]
+	var since time.Time
+	if months := invoicePeriods[filters.periodIndex].months; months > 0 {
+		now := time.Now()
+		since = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(months - 1), 0)
+	}
+
+	var allInvoices []*entity.CreditCardInvoice
+	for _, card := range cards {
+		var invoices []*entity.CreditCardInvoice
+		var err error
+		switch {
+		case status != "":
+			invoices, err = m.creditCardInvoiceUseCase.GetInvoicesByStatus(m.ctx, card.ID, status)
+		case !since.IsZero():
+			invoices, err = m.creditCardInvoiceUseCase.GetInvoicesByDateRange(m.ctx, card.ID, since, time.Now())
+		default:
+			invoices, err = m.creditCardInvoiceUseCase.ListInvoicesByCard(m.ctx, card.ID)
+		}
+		if err != nil {
+			continue // Skip cards with errors
+		}
+
+		for _, invoice := range invoices {
+			if !since.IsZero() && invoice.OpeningDate.Before(since) {
+				continue
+			}
+			allInvoices = append(allInvoices, invoice)
+		}
+	}
+
+	// Cards share due dates, so break ties on the card to keep the order stable across reloads
+	sort.Slice(allInvoices, func(i, j int) bool {
+		a, b := allInvoices[i], allInvoices[j]
+		if !a.DueDate.Equal(b.DueDate) {
+			return a.DueDate.After(b.DueDate)
+		}
+		if a.CreditCardID != b.CreditCardID {
+			return a.CreditCardID.String() < b.CreditCardID.String()
+		}
+		return a.ID.String() < b.ID.String()
+	})
+
+	return invoicesLoadedMsg{invoices: allInvoices}
+}
+
+func (m *TransactionsModel) renderInvoiceFilters() string {
+	filters := m.invoiceModel.filters
+	return style.InfoStyle.Render(fmt.Sprintf("Card: %s • Period: %s • Status: %s",
+		filters.cardLabel(m),
+		invoicePeriods[filters.periodIndex].label,
+		filters.statusLabel(),
+	))
+}
+
+// renderInvoiceListTotals totals the filtered invoices
+func (m *TransactionsModel) renderInvoiceListTotals() string {
+	var charges, payments, outstanding float64
+	for _, invoice := range m.invoiceModel.invoices {
+		charges += invoice.TotalCharges.Amount()
+		payments += invoice.TotalPayments.Amount()
+		if invoice.Status != entity.InvoiceStatusPaid && invoice.ClosingBalance.Amount() > 0 {
+			outstanding += invoice.ClosingBalance.Amount()
+		}
+	}
+
+	summaryStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Primary).
+		Padding(0, 2).
+		MarginTop(1)
+
+	summary := fmt.Sprintf("Invoices: %d • Charges: %s • Paid: %s • Outstanding: %s",
+		len(m.invoiceModel.invoices), formatAmount(charges), formatAmount(payments), formatAmount(outstanding))
+
+	return summaryStyle.Render(summary)
+}
@@ -0,0 +1,163 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// invoicePaymentForm pays the invoice shown in the invoice details from the
+// card's linked account
+type invoicePaymentForm struct {
+	// Form: 0: amount, 1: date, 2: pay, 3: cancel
+	focusedField int
+	amountInput  string
+	dateInput    string
+	err          error
+}
+
+type invoicePaidMsg struct {
+	invoice *entity.CreditCardInvoice
+}
+
+type invoicePaymentFailedMsg struct {
+	err error
+}
+
+func (m *TransactionsModel) openInvoicePayment() (tea.Model, tea.Cmd) {
+	invoice := m.invoiceModel.selectedInvoice
+	if invoice == nil {
+		return m, nil
+	}
+
+	switch {
+	case invoice.IsOpen():
+		m.invoiceModel.message = fmt.Sprintf("The %s invoice is still open: it can be paid once it closes", invoice.ReferenceMonth)
+		return m, nil
+	case invoice.Status == entity.InvoiceStatusPaid || invoice.ClosingBalance.IsZero() || invoice.ClosingBalance.IsNegative():
+		m.invoiceModel.message = fmt.Sprintf("Nothing is left to pay on the %s invoice", invoice.ReferenceMonth)
+		return m, nil
+	}
+
+	m.invoiceModel.message = ""
+
+	m.invoiceModel.payment = &invoicePaymentForm{
+		amountInput: fmt.Sprintf("%.2f", invoice.ClosingBalance.Amount()),
+		dateInput:   time.Now().Format("2006-01-02"),
+	}
+	return m, nil
+}
+
+func (m *TransactionsModel) handleInvoicePaymentKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.invoiceModel.payment
+
+	switch msg.String() {
+	case "esc":
+		m.invoiceModel.payment = nil
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 4
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 4) % 4
+	case "enter":
+		switch form.focusedField {
+		case 2:
+			return m.submitInvoicePayment()
+		case 3:
+			m.invoiceModel.payment = nil
+		}
+	default:
+		switch form.focusedField {
+		case 0:
+			form.amountInput = editAmountInput(form.amountInput, msg)
+		case 1:
+			form.dateInput = editDateInput(form.dateInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) submitInvoicePayment() (tea.Model, tea.Cmd) {
+	form := m.invoiceModel.payment
+
+	amount, err := strconv.ParseFloat(form.amountInput, 64)
+	if err != nil || amount <= 0 {
+		form.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+	date, err := time.Parse("2006-01-02", form.dateInput)
+	if err != nil {
+		form.err = fmt.Errorf("invalid date format (use YYYY-MM-DD)")
+		return m, nil
+	}
+
+	invoiceID := m.invoiceModel.selectedInvoice.ID
+	form.err = nil
+	return m, func() tea.Msg {
+		invoice, err := m.transactionUseCase.PayInvoice(m.ctx, invoiceID, amount, date)
+		if err != nil {
+			return invoicePaymentFailedMsg{err: err}
+		}
+		return invoicePaidMsg{invoice: invoice}
+	}
+}
+
+// updateInvoicePaid shows the paid invoice and reloads what the payment changed
+func (m *TransactionsModel) updateInvoicePaid(msg invoicePaidMsg) tea.Cmd {
+	m.invoiceModel.payment = nil
+	m.invoiceModel.selectedInvoice = msg.invoice
+
+	m.invoiceModel.message = fmt.Sprintf("Paid the %s invoice", msg.invoice.ReferenceMonth)
+	if msg.invoice.Status != entity.InvoiceStatusPaid {
+		m.invoiceModel.message = fmt.Sprintf("Paid part of the %s invoice, %s left", msg.invoice.ReferenceMonth, formatMoney(msg.invoice.ClosingBalance))
+	}
+
+	return tea.Batch(m.loadAllInvoices, m.loadInvoiceTransactions(msg.invoice.ID), m.loadAccounts)
+}
+
+func (m *TransactionsModel) renderInvoicePayment() string {
+	form := m.invoiceModel.payment
+	invoice := m.invoiceModel.selectedInvoice
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("💰 Pay Invoice - %s (%s)",
+		m.getCardNameForInvoice(invoice.CreditCardID), invoice.ReferenceMonth)))
+
+	if form.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", form.err)))
+	}
+
+	from := "the linked account"
+	if card, ok := m.lookup.creditCards[invoice.CreditCardID]; ok {
+		if account := m.lookup.account(card.AccountID); account != nil {
+			from = fmt.Sprintf("%s (%s)", account.Name, formatMoney(account.Balance))
+		}
+	}
+
+	info := []string{
+		fmt.Sprintf("Outstanding: %s", formatMoney(invoice.ClosingBalance)),
+		fmt.Sprintf("Due Date:    %s", invoice.DueDate.Format("2006-01-02")),
+		fmt.Sprintf("Paid From:   %s", from),
+	}
+	if remaining := invoice.GetMinimumPaymentRemaining(); !remaining.IsZero() {
+		info = append(info, fmt.Sprintf("Minimum:     %s still due", formatMoney(remaining)))
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(info, "\n")))
+
+	fields := []string{
+		renderTextField("Amount:", form.amountInput, form.focusedField == 0),
+		renderTextField("Date:", form.dateInput, form.focusedField == 1),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Pay", form.focusedField, 2)))
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [Enter] Confirm • [Esc] Cancel"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
@@ -0,0 +1,158 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// invoiceSplitModel picks the person a card's invoices are always split with
+type invoiceSplitModel struct {
+	// Form: 0: person, 1: percentage, 2: save, 3: cancel
+	focusedField    int
+	selectedPerson  int // 0 is "Not split", then m.people
+	percentageInput string
+	err             error
+}
+
+type peopleLoadedForCardsMsg struct {
+	people []*entity.Person
+}
+
+type invoiceSplitSavedMsg struct{}
+
+func (m *CreditCardsModel) loadPeople() tea.Msg {
+	if m.personUseCase == nil {
+		return peopleLoadedForCardsMsg{}
+	}
+	people, err := m.personUseCase.ListPeople(m.ctx)
+	if err != nil {
+		// The split still shows as "Unknown Person" without them
+		return peopleLoadedForCardsMsg{}
+	}
+	return peopleLoadedForCardsMsg{people: people}
+}
+
+func (m *CreditCardsModel) openInvoiceSplit() (tea.Model, tea.Cmd) {
+	if m.selectedIndex >= len(m.creditCards) {
+		return m, nil
+	}
+	if len(m.people) == 0 {
+		m.err = fmt.Errorf("add the person to split the invoices with in People first")
+		return m, nil
+	}
+
+	card := m.creditCards[m.selectedIndex]
+	split := &invoiceSplitModel{percentageInput: "50"}
+	if card.InvoiceSplit != nil {
+		split.percentageInput = formatPercentage(card.InvoiceSplit.Percentage)
+		for i, person := range m.people {
+			if person.ID == card.InvoiceSplit.PersonID {
+				split.selectedPerson = i + 1
+			}
+		}
+	}
+
+	m.split = split
+	m.viewMode = CreditCardViewSplit
+	return m, nil
+}
+
+func (m *CreditCardsModel) handleInvoiceSplitKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	split := m.split
+
+	switch msg.String() {
+	case "esc":
+		m.split = nil
+		m.viewMode = CreditCardViewDetails
+	case "tab", "down":
+		split.focusedField = (split.focusedField + 1) % 4
+	case "shift+tab", "up":
+		split.focusedField = (split.focusedField - 1 + 4) % 4
+	case "enter":
+		switch split.focusedField {
+		case 2:
+			return m.submitInvoiceSplit()
+		case 3:
+			m.split = nil
+			m.viewMode = CreditCardViewDetails
+		}
+	default:
+		switch split.focusedField {
+		case 0:
+			split.selectedPerson = cycleOption(split.selectedPerson, len(m.people)+1, msg.String())
+		case 1:
+			split.percentageInput = editAmountInput(split.percentageInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CreditCardsModel) submitInvoiceSplit() (tea.Model, tea.Cmd) {
+	split := m.split
+
+	var personID *uuid.UUID
+	var percentage float64
+	if split.selectedPerson > 0 {
+		var err error
+		percentage, err = strconv.ParseFloat(split.percentageInput, 64)
+		if err != nil || percentage <= 0 || percentage > 100 {
+			split.err = fmt.Errorf("percentage must be between 0 and 100")
+			return m, nil
+		}
+		personID = &m.people[split.selectedPerson-1].ID
+	}
+
+	cardID := m.creditCards[m.selectedIndex].ID
+	m.split = nil
+	m.loading = true
+	return m, func() tea.Msg {
+		if err := m.creditCardUseCase.SetInvoiceSplit(m.ctx, cardID, personID, percentage); err != nil {
+			return errMsg{err: err}
+		}
+		return invoiceSplitSavedMsg{}
+	}
+}
+
+// invoiceSplitLabel describes the standing split of a card's invoices
+func (m *CreditCardsModel) invoiceSplitLabel(card *entity.CreditCard) string {
+	if card.InvoiceSplit == nil {
+		return "Not split"
+	}
+	return fmt.Sprintf("%s%% with %s", formatPercentage(card.InvoiceSplit.Percentage), m.lookup.personName(card.InvoiceSplit.PersonID))
+}
+
+func (m *CreditCardsModel) renderInvoiceSplit() string {
+	split := m.split
+	card := m.creditCards[m.selectedIndex]
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("👥 Split Invoices - %s", card.Name)))
+
+	if split.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", split.err)))
+	}
+
+	person := "Not split"
+	if split.selectedPerson > 0 {
+		person = m.people[split.selectedPerson-1].Name
+	}
+	fields := []string{
+		renderDefaultSelector("Split With:", person, split.focusedField == 0),
+		renderTextField("Their Share (%):", split.percentageInput, split.focusedField == 1),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("When an invoice closes, each of its charges is shared with the person at this percentage"))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Save", split.focusedField, 2)))
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Tab/↑↓] Navigate • [←/→] Change Person • [Enter] Confirm • [Esc] Cancel"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
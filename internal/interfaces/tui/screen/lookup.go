@@ -0,0 +1,61 @@
+package screen
+
+import (
+	"financli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// lookupIndex maps IDs to the loaded reference data so that table rows can
+// resolve names without scanning the full lists on every render. It is rebuilt
+// from the loaded messages, which always carry the complete lists.
+type lookupIndex struct {
+	accounts    map[uuid.UUID]*entity.Account
+	creditCards map[uuid.UUID]*entity.CreditCard
+	people      map[uuid.UUID]*entity.Person
+}
+
+func (ix *lookupIndex) setAccounts(accounts []*entity.Account) {
+	ix.accounts = make(map[uuid.UUID]*entity.Account, len(accounts))
+	for _, account := range accounts {
+		ix.accounts[account.ID] = account
+	}
+}
+
+func (ix *lookupIndex) setCreditCards(cards []*entity.CreditCard) {
+	ix.creditCards = make(map[uuid.UUID]*entity.CreditCard, len(cards))
+	for _, card := range cards {
+		ix.creditCards[card.ID] = card
+	}
+}
+
+func (ix *lookupIndex) setPeople(people []*entity.Person) {
+	ix.people = make(map[uuid.UUID]*entity.Person, len(people))
+	for _, person := range people {
+		ix.people[person.ID] = person
+	}
+}
+
+func (ix *lookupIndex) account(id uuid.UUID) *entity.Account {
+	return ix.accounts[id]
+}
+
+func (ix *lookupIndex) accountName(id uuid.UUID) string {
+	if account, ok := ix.accounts[id]; ok {
+		return account.Name
+	}
+	return "Unknown Account"
+}
+
+func (ix *lookupIndex) creditCardName(id uuid.UUID) string {
+	if card, ok := ix.creditCards[id]; ok {
+		return card.Name
+	}
+	return "Unknown Card"
+}
+
+func (ix *lookupIndex) personName(id uuid.UUID) string {
+	if person, ok := ix.people[id]; ok {
+		return person.Name
+	}
+	return "Unknown Person"
+}
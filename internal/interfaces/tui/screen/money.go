@@ -0,0 +1,40 @@
+package screen
+
+import (
+	"fmt"
+
+	"financli/internal/domain/valueobject"
+)
+
+// maskedAmount is shown in place of every amount while privacy mode is on
+const maskedAmount = "R$ ••••"
+
+// privacyMode masks rendered amounts for screen sharing or use in public. It is
+// shared by every screen so the toggle applies everywhere at once.
+var privacyMode bool
+
+// TogglePrivacyMode switches amount masking on or off and returns the new state
+func TogglePrivacyMode() bool {
+	privacyMode = !privacyMode
+	return privacyMode
+}
+
+func PrivacyMode() bool {
+	return privacyMode
+}
+
+// formatAmount renders an amount in reais, masked in privacy mode
+func formatAmount(amount float64) string {
+	if privacyMode {
+		return maskedAmount
+	}
+	return fmt.Sprintf("R$ %.2f", amount)
+}
+
+// formatMoney renders a money value, masked in privacy mode
+func formatMoney(money valueobject.Money) string {
+	if privacyMode {
+		return maskedAmount
+	}
+	return money.String()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"financli/internal/application/usecase"
 	"financli/internal/domain/entity"
@@ -15,8 +16,10 @@ import (
 )
 
 type PeopleModel struct {
-	ctx           context.Context
-	personUseCase *usecase.PersonUseCase
+	ctx             context.Context
+	personUseCase   *usecase.PersonUseCase
+	exchangeUseCase *usecase.PeopleExchangeUseCase
+	reportUseCase   *usecase.ReportUseCase
 
 	people        []*entity.Person
 	selectedIndex int
@@ -24,11 +27,20 @@ type PeopleModel struct {
 
 	loading bool
 	err     error
+	message string
+
+	// Import state
+	importPath string
 
 	// Form state
 	formModel         *PersonFormModel
 	showConfirmDelete bool
 
+	// Household state
+	householdMonth time.Time
+	household      *usecase.HouseholdReport
+	memberIndex    int
+
 	width  int
 	height int
 }
@@ -39,6 +51,9 @@ const (
 	PeopleViewList PeopleViewMode = iota
 	PeopleViewForm
 	PeopleViewConfirm
+	PeopleViewImport
+	PeopleViewHousehold
+	PeopleViewHouseholdMember
 )
 
 type PersonFormModel struct {
@@ -55,13 +70,17 @@ type PersonFormModel struct {
 	phoneInput string
 }
 
-func NewPeopleModel(ctx context.Context, personUC *usecase.PersonUseCase) tea.Model {
+func NewPeopleModel(ctx context.Context, personUC *usecase.PersonUseCase, exchangeUC *usecase.PeopleExchangeUseCase, reportUC *usecase.ReportUseCase) tea.Model {
+	now := time.Now()
 	return &PeopleModel{
-		ctx:           ctx,
-		personUseCase: personUC,
-		viewMode:      PeopleViewList,
-		loading:       true,
-		formModel:     &PersonFormModel{},
+		ctx:             ctx,
+		personUseCase:   personUC,
+		exchangeUseCase: exchangeUC,
+		reportUseCase:   reportUC,
+		viewMode:        PeopleViewList,
+		loading:         true,
+		formModel:       &PersonFormModel{},
+		householdMonth:  time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
 	}
 }
 
@@ -92,6 +111,25 @@ func (m *PeopleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.resetForm()
 		return m, m.loadPeople
 
+	case peopleExportedMsg:
+		m.loading = false
+		m.message = fmt.Sprintf("Exported to %s and %s", msg.csvPath, msg.jsonPath)
+		return m, nil
+
+	case householdLoadedMsg:
+		m.loading = false
+		m.household = msg.report
+		if m.memberIndex >= len(m.household.Members) {
+			m.memberIndex = len(m.household.Members) - 1
+		}
+		return m, nil
+
+	case peopleImportedMsg:
+		m.viewMode = PeopleViewList
+		m.importPath = ""
+		m.message = fmt.Sprintf("Imported %d people, skipped %d", msg.result.Created, msg.result.Skipped)
+		return m, m.loadPeople
+
 	case errMsg:
 		m.loading = false
 		m.err = msg.err
@@ -101,10 +139,16 @@ func (m *PeopleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch m.viewMode {
 		case PeopleViewList:
 			return m.handleListKeys(msg)
+		case PeopleViewImport:
+			return m.handleImportKeys(msg)
 		case PeopleViewForm:
 			return m.handleFormKeys(msg)
 		case PeopleViewConfirm:
 			return m.handleConfirmKeys(msg)
+		case PeopleViewHousehold:
+			return m.handleHouseholdKeys(msg)
+		case PeopleViewHouseholdMember:
+			return m.handleHouseholdMemberKeys(msg)
 		}
 	}
 
@@ -139,6 +183,23 @@ func (m *PeopleModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.viewMode = PeopleViewConfirm
 			m.showConfirmDelete = true
 		}
+	case "m":
+		if len(m.people) > 0 {
+			m.err = nil
+			m.message = ""
+			return m, m.toggleOwedNotice
+		}
+	case "x":
+		m.loading = true
+		m.err = nil
+		m.message = ""
+		return m, m.exportPeople
+	case "i":
+		m.viewMode = PeopleViewImport
+		m.err = nil
+		m.message = ""
+	case "h":
+		return m.showHousehold()
 	case "r":
 		m.loading = true
 		return m, m.loadPeople
@@ -183,38 +244,31 @@ func (m *PeopleModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	switch m.formModel.focusedField {
 	case 0:
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.nameInput) > 0 {
-				m.formModel.nameInput = m.formModel.nameInput[:len(m.formModel.nameInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.nameInput += msg.String()
-			}
-		}
+		m.formModel.nameInput = editTextInput(m.formModel.nameInput, msg)
 	case 1:
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.emailInput) > 0 {
-				m.formModel.emailInput = m.formModel.emailInput[:len(m.formModel.emailInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.emailInput += msg.String()
-			}
-		}
+		m.formModel.emailInput = editTextInput(m.formModel.emailInput, msg)
 	case 2:
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.phoneInput) > 0 {
-				m.formModel.phoneInput = m.formModel.phoneInput[:len(m.formModel.phoneInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.phoneInput += msg.String()
-			}
+		m.formModel.phoneInput = editTextInput(m.formModel.phoneInput, msg)
+	}
+
+	return m, nil
+}
+
+func (m *PeopleModel) handleImportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.viewMode = PeopleViewList
+		m.importPath = ""
+	case "enter":
+		if strings.TrimSpace(m.importPath) == "" {
+			m.err = fmt.Errorf("file path is required")
+			return m, nil
 		}
+		m.loading = true
+		m.err = nil
+		return m, m.importPeople
+	default:
+		m.importPath = editTextInput(m.importPath, msg)
 	}
 
 	return m, nil
@@ -302,6 +356,12 @@ func (m *PeopleModel) View() string {
 		return m.renderForm()
 	case PeopleViewConfirm:
 		return m.renderConfirm()
+	case PeopleViewImport:
+		return m.renderImport()
+	case PeopleViewHousehold:
+		return m.renderHousehold()
+	case PeopleViewHouseholdMember:
+		return m.renderHouseholdMember()
 	}
 
 	return ""
@@ -316,6 +376,9 @@ func (m *PeopleModel) renderList() string {
 	if m.err != nil {
 		content.WriteString(style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
 		content.WriteString("\n\n")
+	} else if m.message != "" {
+		content.WriteString(style.SuccessStyle.Render(m.message))
+		content.WriteString("\n\n")
 	}
 
 	if len(m.people) == 0 {
@@ -334,6 +397,7 @@ func (m *PeopleModel) renderList() string {
 		content.WriteString(headerStyle.Width(30).Render("Email"))
 		content.WriteString(headerStyle.Width(20).Render("Phone"))
 		content.WriteString(headerStyle.Width(12).Render("Created"))
+		content.WriteString(headerStyle.Render("Monthly"))
 		content.WriteString("\n")
 
 		// Table rows
@@ -348,11 +412,17 @@ func (m *PeopleModel) renderList() string {
 			// Format created date
 			createdDate := person.CreatedAt.Format("2006-01-02")
 
-			row := fmt.Sprintf("%-25s %-30s %-20s %s",
+			monthly := ""
+			if person.NotifyOwedAmounts {
+				monthly = "✉"
+			}
+
+			row := fmt.Sprintf("%-25s %-30s %-20s %-12s %s",
 				person.Name,
 				person.Email,
 				person.Phone,
 				createdDate,
+				monthly,
 			)
 
 			content.WriteString(rowStyle.Render(row))
@@ -361,7 +431,7 @@ func (m *PeopleModel) renderList() string {
 	}
 
 	content.WriteString("\n")
-	content.WriteString(style.HelpStyle.Render("[n] New • [e] Edit • [d] Delete • [r] Refresh • [b] Back • [q] Quit"))
+	content.WriteString(style.HelpStyle.Render("[n] New • [e] Edit • [d] Delete • [m] Monthly Email • [h] Household • [i] Import • [x] Export • [r] Refresh • [b] Back • [q] Quit"))
 
 	return content.String()
 }
@@ -436,6 +506,31 @@ func (m *PeopleModel) renderForm() string {
 	return content.String()
 }
 
+func (m *PeopleModel) renderImport() string {
+	var content strings.Builder
+
+	content.WriteString(style.HeaderStyle.Render("Import People"))
+	content.WriteString("\n\n")
+
+	if m.err != nil {
+		content.WriteString(style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		content.WriteString("\n\n")
+	}
+
+	content.WriteString("Import contacts from a vCard (.vcf), a phone contacts CSV or a people export (.csv or .json).\n")
+	content.WriteString("People already registered with the same email or phone, or name when there's no email, are skipped.")
+	content.WriteString("\n\n")
+
+	content.WriteString(style.HeaderStyle.Render("File path:"))
+	content.WriteString("\n")
+	content.WriteString(style.FocusedInputStyle.Render(m.importPath))
+	content.WriteString("\n\n")
+
+	content.WriteString(style.HelpStyle.Render("[Enter] Import • [Esc] Cancel"))
+
+	return content.String()
+}
+
 func (m *PeopleModel) renderConfirm() string {
 	var content strings.Builder
 
@@ -457,12 +552,21 @@ func (m *PeopleModel) renderConfirm() string {
 
 // IsInFormMode implements the FormModeChecker interface
 func (m *PeopleModel) IsInFormMode() bool {
-	return m.viewMode == PeopleViewForm || m.viewMode == PeopleViewConfirm
+	return m.viewMode == PeopleViewForm || m.viewMode == PeopleViewConfirm || m.viewMode == PeopleViewImport
 }
 
 // Message types
 type personActionMsg struct{}
 
+type peopleExportedMsg struct {
+	csvPath  string
+	jsonPath string
+}
+
+type peopleImportedMsg struct {
+	result *usecase.ContactImportResult
+}
+
 // Commands
 func (m *PeopleModel) loadPeople() tea.Msg {
 	people, err := m.personUseCase.ListPeople(m.ctx)
@@ -515,4 +619,36 @@ func (m *PeopleModel) deletePersonCmd() tea.Msg {
 	}
 
 	return personActionMsg{}
-}
\ No newline at end of file
+}
+
+// exportPeople writes both formats: CSV for spreadsheets and contact apps,
+// JSON with the settlement ledger to share with the group
+func (m *PeopleModel) exportPeople() tea.Msg {
+	csvPath, err := m.exchangeUseCase.ExportPeopleCSV(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	jsonPath, err := m.exchangeUseCase.ExportPeopleJSON(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return peopleExportedMsg{csvPath: csvPath, jsonPath: jsonPath}
+}
+
+func (m *PeopleModel) importPeople() tea.Msg {
+	result, err := m.exchangeUseCase.ImportPeople(m.ctx, strings.TrimSpace(m.importPath))
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return peopleImportedMsg{result: result}
+}
+
+func (m *PeopleModel) toggleOwedNotice() tea.Msg {
+	person := m.people[m.selectedIndex]
+	if err := m.personUseCase.SetOwedNotice(m.ctx, person.ID, !person.NotifyOwedAmounts); err != nil {
+		return errMsg{err: err}
+	}
+	return personActionMsg{}
+}
@@ -0,0 +1,224 @@
+package screen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+type householdLoadedMsg struct {
+	report *usecase.HouseholdReport
+}
+
+// showHousehold opens the household view of the month's shared expenses
+func (m *PeopleModel) showHousehold() (tea.Model, tea.Cmd) {
+	m.viewMode = PeopleViewHousehold
+	return m, m.loadHousehold()
+}
+
+func (m *PeopleModel) loadHousehold() tea.Cmd {
+	month := m.householdMonth
+	m.household = nil
+	m.err = nil
+	m.loading = true
+	return func() tea.Msg {
+		report, err := m.reportUseCase.GetHouseholdReport(m.ctx, month.Year(), month.Month())
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return householdLoadedMsg{report: report}
+	}
+}
+
+func (m *PeopleModel) handleHouseholdKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "left", "h":
+		m.householdMonth = m.householdMonth.AddDate(0, -1, 0)
+		return m, m.loadHousehold()
+	case "right", "l":
+		m.householdMonth = m.householdMonth.AddDate(0, 1, 0)
+		return m, m.loadHousehold()
+	case "up", "k":
+		if m.memberIndex > 0 {
+			m.memberIndex--
+		}
+	case "down", "j":
+		if m.household != nil && m.memberIndex < len(m.household.Members)-1 {
+			m.memberIndex++
+		}
+	case "enter":
+		if m.household != nil && m.memberIndex < len(m.household.Members) {
+			m.viewMode = PeopleViewHouseholdMember
+		}
+	case "r":
+		return m, m.loadHousehold()
+	case "esc", "b":
+		m.viewMode = PeopleViewList
+		m.err = nil
+	}
+	return m, nil
+}
+
+func (m *PeopleModel) handleHouseholdMemberKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = PeopleViewHousehold
+	}
+	return m, nil
+}
+
+// memberName is how the household view names a member
+func memberName(member *usecase.HouseholdMember) string {
+	if member.Person == nil {
+		return "You"
+	}
+	return member.Person.Name
+}
+
+func (m *PeopleModel) renderHousehold() string {
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🏠 Household — %s", m.householdMonth.Format("January 2006"))))
+
+	if m.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+
+	report := m.household
+	if report != nil {
+		boxStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		summary := []string{
+			fmt.Sprintf("Shared expenses: %s", formatAmount(report.Total)),
+			fmt.Sprintf("Expenses shared: %d", len(report.Members[0].Expenses)),
+		}
+		sections = append(sections, boxStyle.Render(strings.Join(summary, "\n")))
+
+		members := []string{
+			style.SubtitleStyle.Render("Who paid what"),
+			fmt.Sprintf("  %-24s %14s %14s %22s", "Member", "Paid", "Share", "Balance"),
+		}
+		for i, member := range report.Members {
+			cursor := "  "
+			if i == m.memberIndex {
+				cursor = "> "
+			}
+
+			var balance string
+			switch b := member.Balance(); {
+			case b > 0.005:
+				balance = style.SuccessStyle.Render(fmt.Sprintf("is owed %s", formatAmount(b)))
+			case b < -0.005:
+				balance = style.ErrorStyle.Render(fmt.Sprintf("owes %s", formatAmount(-b)))
+			default:
+				balance = "settled"
+			}
+
+			row := fmt.Sprintf("%s%-24s %14s %14s", cursor, truncateString(memberName(member), 24), formatAmount(member.Paid), formatAmount(member.Share))
+			if i == m.memberIndex {
+				row = style.SelectedMenuItemStyle.Render(row)
+			}
+			members = append(members, fmt.Sprintf("%s %22s", row, balance))
+		}
+		sections = append(sections, boxStyle.Render(strings.Join(members, "\n")))
+
+		bills := []string{style.SubtitleStyle.Render(fmt.Sprintf("Shared bills (%d)", len(report.Bills)))}
+		if len(report.Bills) == 0 {
+			bills = append(bills, style.InfoStyle.Render("No shared expenses are linked to a bill this month"))
+		}
+		for _, bill := range report.Bills {
+			bills = append(bills, fmt.Sprintf("%-30s %14s of %s", truncateString(bill.Bill.Name, 30), formatAmount(bill.Covered), formatMoney(bill.Bill.TotalAmount)))
+			bills = append(bills, lipgloss.NewStyle().Foreground(style.TextMuted).Render("  "+m.renderBillShares(bill)))
+		}
+		sections = append(sections, boxStyle.Render(strings.Join(bills, "\n")))
+	}
+
+	help := "[←/→] Month • [↑/↓] Navigate • [enter] Expenses • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+// renderBillShares lists each member's part of the bill, in the members' order
+func (m *PeopleModel) renderBillShares(bill *usecase.HouseholdBill) string {
+	var shares []string
+	for _, member := range m.household.Members {
+		id := uuid.Nil
+		if member.Person != nil {
+			id = member.Person.ID
+		}
+		if share, ok := bill.Shares[id]; ok {
+			shares = append(shares, fmt.Sprintf("%s %s", memberName(member), formatAmount(share)))
+		}
+	}
+	return strings.Join(shares, " • ")
+}
+
+func (m *PeopleModel) renderHouseholdMember() string {
+	member := m.household.Members[m.memberIndex]
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🏠 %s — %s", memberName(member), m.householdMonth.Format("January 2006"))))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	rows := []string{
+		fmt.Sprintf("Paid:  %s", formatAmount(member.Paid)),
+		fmt.Sprintf("Share: %s", formatAmount(member.Share)),
+		"",
+		style.SubtitleStyle.Render(fmt.Sprintf("Shared expenses (%d)", len(member.Expenses))),
+	}
+	if len(member.Expenses) == 0 {
+		rows = append(rows, style.InfoStyle.Render("No shared expenses this month"))
+	}
+	for _, txn := range member.Expenses {
+		rows = append(rows, fmt.Sprintf("%s  %-30s %-16s %14s %14s",
+			txn.Date.Format("2006-01-02"), truncateString(txn.Description, 30), categoryDisplayName(txn.Category),
+			formatMoney(txn.Amount), formatAmount(member.ShareOf(txn))))
+	}
+	sections = append(sections, boxStyle.Render(strings.Join(rows, "\n")))
+
+	sections = append(sections, style.SubtitleStyle.MarginTop(1).Render("By category"))
+	sections = append(sections, m.renderMemberCategories(member))
+
+	help := "[b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+// renderMemberCategories sums the member's shares by category, largest first
+func (m *PeopleModel) renderMemberCategories(member *usecase.HouseholdMember) string {
+	totals := make(map[entity.TransactionCategory]float64)
+	for _, txn := range member.Expenses {
+		totals[txn.Category] += member.ShareOf(txn)
+	}
+	categories := make([]entity.TransactionCategory, 0, len(totals))
+	for category := range totals {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return totals[categories[i]] > totals[categories[j]]
+	})
+
+	var rows []string
+	for _, category := range categories {
+		rows = append(rows, fmt.Sprintf("  %-16s %14s", categoryDisplayName(category), formatAmount(totals[category])))
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,48 @@
+package screen
+
+import (
+	"fmt"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type categoryTrendsLoadedMsg struct {
+	period reportPeriod
+	trends map[entity.TransactionCategory]usecase.CategoryTrend
+}
+
+// loadCategoryTrends reads each category's average over the months before the
+// month shown; other periods have none
+func (m *ReportsModel) loadCategoryTrends() tea.Msg {
+	period := m.period
+	trends, err := m.reportUseCase.GetCategoryTrends(m.ctx, period.start)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return categoryTrendsLoadedMsg{period: period, trends: trends}
+}
+
+// renderCategoryTrend is the category's average and which way the month's
+// spending strays from it, padded to line up in the breakdown
+func (m *ReportsModel) renderCategoryTrend(category entity.TransactionCategory) string {
+	trend, ok := m.categoryTrends[category]
+	if !ok || trend.Average == 0 {
+		return fmt.Sprintf("%14s %s", "—", style.HelpStyle.Render("new"))
+	}
+
+	arrow := style.InfoStyle.Render("→")
+	switch trend.Direction() {
+	case 1:
+		arrow = style.ErrorStyle.Render("↑")
+	case -1:
+		arrow = style.SuccessStyle.Render("↓")
+	}
+	if change, ok := trend.Change(); ok {
+		arrow += fmt.Sprintf(" %+.0f%%", change)
+	}
+	return fmt.Sprintf("%14s %s", formatAmount(trend.Average), arrow)
+}
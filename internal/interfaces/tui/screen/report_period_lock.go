@@ -0,0 +1,155 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// periodLockPrompt confirms closing the books of the month shown, or asks why
+// they're being reopened
+type periodLockPrompt struct {
+	reopening bool
+	reason    string
+	err       error
+}
+
+type periodLockLoadedMsg struct {
+	period reportPeriod
+	lock   *entity.AccountingPeriod
+}
+
+type periodLockSavedMsg struct {
+	lock    *entity.AccountingPeriod
+	message string
+	err     error
+}
+
+// loadPeriodLock reads whether the books of the month shown are closed; other
+// periods can't be closed
+func (m *ReportsModel) loadPeriodLock() tea.Msg {
+	period := m.period
+	if !period.isMonth() {
+		return periodLockLoadedMsg{period: period}
+	}
+
+	lock, err := m.periodLockUseCase.GetPeriod(m.ctx, period.start)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return periodLockLoadedMsg{period: period, lock: lock}
+}
+
+// canToggleLock tells whether the books of the month shown can be closed or
+// reopened: closed ones always, open ones once the month is over
+func (m *ReportsModel) canToggleLock() bool {
+	if m.periodLock == nil {
+		return false
+	}
+	return m.periodLock.Closed || m.periodLock.Month.Before(entity.PeriodMonth(time.Now()))
+}
+
+func (m *ReportsModel) openPeriodLockPrompt() {
+	if !m.canToggleLock() {
+		return
+	}
+	m.lockPrompt = &periodLockPrompt{reopening: m.periodLock.Closed}
+}
+
+func (m *ReportsModel) handlePeriodLockKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := m.lockPrompt
+	month := m.periodLock.Month
+
+	if !prompt.reopening {
+		switch msg.String() {
+		case "y", "enter":
+			return m, func() tea.Msg {
+				lock, err := m.periodLockUseCase.CloseMonth(m.ctx, month)
+				if err != nil {
+					return periodLockSavedMsg{err: err}
+				}
+				return periodLockSavedMsg{lock: lock, message: fmt.Sprintf("Closed the books of %s", month.Format("01/2006"))}
+			}
+		case "n", "esc":
+			m.lockPrompt = nil
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.lockPrompt = nil
+	case "enter":
+		reason := prompt.reason
+		return m, func() tea.Msg {
+			lock, err := m.periodLockUseCase.ReopenMonth(m.ctx, month, reason)
+			if err != nil {
+				return periodLockSavedMsg{err: err}
+			}
+			return periodLockSavedMsg{lock: lock, message: fmt.Sprintf("Reopened the books of %s", month.Format("01/2006"))}
+		}
+	default:
+		prompt.reason = editTextInput(prompt.reason, msg)
+	}
+	return m, nil
+}
+
+func (m *ReportsModel) renderPeriodLockPrompt() string {
+	prompt := m.lockPrompt
+	month := m.periodLock.Month.Format("01/2006")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Warning).
+		Padding(1, 2).
+		MarginTop(1)
+
+	var lines []string
+	if prompt.reopening {
+		lines = append(lines,
+			style.TitleStyle.Render(fmt.Sprintf("🔓 Reopen the books of %s", month)),
+			"Its transactions can be added, edited and deleted again, changing its reports.",
+			"",
+			renderTextField("Reason:", prompt.reason, true))
+	} else {
+		lines = append(lines,
+			style.TitleStyle.Render(fmt.Sprintf("🔒 Close the books of %s", month)),
+			"Its transactions can't be added, edited or deleted until the month is reopened,",
+			"which takes a reason, so its reports stay as they are.")
+	}
+
+	if prompt.err != nil {
+		lines = append(lines, "", style.ErrorStyle.Render(fmt.Sprintf("Error: %v", prompt.err)))
+	}
+
+	if log := m.periodLock.Log; len(log) > 0 {
+		lines = append(lines, "", style.SubtitleStyle.Render("History"))
+		for _, entry := range log {
+			line := fmt.Sprintf("%s  %s", entry.At.Format("2006-01-02 15:04"), entry.Action)
+			if entry.Reason != "" {
+				line += ": " + entry.Reason
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	help := "[y/Enter] Close Books • [n/Esc] Cancel"
+	if prompt.reopening {
+		help = "[Enter] Reopen • [Esc] Cancel"
+	}
+	lines = append(lines, "", style.HelpStyle.Render(help))
+
+	return dialogStyle.Render(strings.Join(lines, "\n"))
+}
+
+// IsInFormMode implements the FormModeChecker interface, so the reason typed
+// doesn't switch screens
+func (m *ReportsModel) IsInFormMode() bool {
+	return m.lockPrompt != nil
+}
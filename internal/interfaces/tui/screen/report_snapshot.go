@@ -0,0 +1,49 @@
+package screen
+
+import (
+	"fmt"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type reportSnapshotLoadedMsg struct {
+	period   reportPeriod
+	snapshot *entity.ReportSnapshot
+}
+
+// loadSnapshot reads the report the month shown had when it ended
+func (m *ReportsModel) loadSnapshot() tea.Msg {
+	period := m.period
+	snapshot, err := m.reportSnapshotUseCase.GetSnapshot(m.ctx, period.start)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return reportSnapshotLoadedMsg{period: period, snapshot: snapshot}
+}
+
+// shownReport is the month's snapshot when viewing it as closed, otherwise the
+// report recomputed from the transactions
+func (m *ReportsModel) shownReport() map[string]interface{} {
+	if m.asClosed && m.snapshot != nil {
+		return usecase.SnapshotReport(m.snapshot)
+	}
+	return m.report
+}
+
+// renderSnapshotBadge tells when the report shown is the snapshot, or warns
+// when the recomputed one no longer matches it
+func (m *ReportsModel) renderSnapshotBadge() string {
+	switch {
+	case m.snapshot == nil:
+		return ""
+	case m.asClosed:
+		return style.InfoStyle.Render(fmt.Sprintf("  📸 As closed on %s", m.snapshot.TakenAt.Format("02/01/2006")))
+	case m.report != nil && !usecase.SnapshotMatchesReport(m.snapshot, m.report):
+		return style.WarningStyle.Render("  ⚠ Changed since the month closed")
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type tagReportLoadedMsg struct {
+	period reportPeriod
+	tags   []*usecase.TagReport
+}
+
+func (m *ReportsModel) loadTagReport() tea.Msg {
+	period := m.period
+	tags, err := m.reportUseCase.GetTagReport(m.ctx, period.start, period.last())
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return tagReportLoadedMsg{period: period, tags: tags}
+}
+
+// renderTagBreakdown totals the period's transactions by tag, next to what each
+// tag's expenses add up to across all time
+func (m *ReportsModel) renderTagBreakdown() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if m.tags == nil {
+		return boxStyle.Render(style.InfoStyle.Render("Loading tags..."))
+	}
+	if len(m.tags) == 0 {
+		return boxStyle.Render(style.InfoStyle.Render("No tagged transactions in this period. Add tags on the transaction form."))
+	}
+
+	rows := []string{
+		style.HeaderStyle.Render("Spending by Tag"),
+		"",
+		style.TableHeaderStyle.Render(fmt.Sprintf("%-24s %-8s %14s %14s %14s", "Tag", "Count", "Income", "Expenses", "All Time")),
+	}
+	for _, tag := range m.tags {
+		rows = append(rows, fmt.Sprintf("%-24s %-8d %14s %14s %14s",
+			truncateString("#"+tag.Tag, 24), tag.TransactionCount, formatMoney(tag.Income), formatMoney(tag.Expenses), formatMoney(tag.AllTimeExpenses)))
+	}
+	rows = append(rows, "", style.HelpStyle.Render("A transaction with several tags counts under each of them"))
+
+	return boxStyle.Render(strings.Join(rows, "\n"))
+}
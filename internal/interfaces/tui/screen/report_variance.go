@@ -0,0 +1,81 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// varianceDriftShown is how many of an item's latest periods the variance
+// report lists the drift of
+const varianceDriftShown = 6
+
+type varianceReportLoadedMsg struct {
+	variances []*usecase.RecurringVariance
+}
+
+func (m *ReportsModel) loadVariance() tea.Cmd {
+	return func() tea.Msg {
+		variances, err := m.varianceUseCase.GetVarianceReport(m.ctx, time.Now())
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return varianceReportLoadedMsg{variances: variances}
+	}
+}
+
+// renderVariance compares the planned and realized amounts of the recurring
+// bills and subscriptions, flagging those that keep drifting the same way
+func (m *ReportsModel) renderVariance() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if m.variances == nil {
+		return boxStyle.Render(style.InfoStyle.Render("Loading variance report..."))
+	}
+	if len(m.variances) == 0 {
+		return boxStyle.Render(style.InfoStyle.Render("No recurring bills or subscriptions with posted amounts yet. Link transactions to bills to compare them with the plan."))
+	}
+
+	lines := []string{
+		style.HeaderStyle.Render("Planned vs Realized: Recurring Items"),
+		"",
+		style.TableHeaderStyle.Render(fmt.Sprintf("%-28s %-13s %14s %14s %8s  %s", "Item", "Kind", "Planned", "Last Actual", "Avg", "Drift by Period")),
+	}
+	for _, variance := range m.variances {
+		latest := variance.Periods[len(variance.Periods)-1]
+
+		periods := variance.Periods
+		if len(periods) > varianceDriftShown {
+			periods = periods[len(periods)-varianceDriftShown:]
+		}
+		drifts := make([]string, len(periods))
+		for i, period := range periods {
+			drifts[i] = fmt.Sprintf("%+.0f%%", period.Drift())
+		}
+
+		line := fmt.Sprintf("%-28s %-13s %14s %14s %+7.1f%%  %s",
+			truncateString(variance.Name, 28), variance.Kind,
+			formatAmount(latest.Planned), formatAmount(latest.Actual),
+			variance.AverageDrift(), strings.Join(drifts, " "))
+		switch variance.ConsistentDrift() {
+		case 1:
+			line = style.ErrorStyle.Render(line + "  ▲ above plan")
+		case -1:
+			line = style.WarningStyle.Render(line + "  ▼ below plan")
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "", style.HelpStyle.Render("Flagged items strayed 10% or more the same way in each of their latest periods (up to 3)"))
+
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,761 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guptarohit/asciigraph"
+)
+
+// reportBarWidth is the width of the longest bar of the category breakdown
+const reportBarWidth = 30
+
+// reportView is the breakdown shown under the period's summary
+type reportView int
+
+const (
+	reportViewCategories reportView = iota
+	reportViewProjects
+	reportViewTrend
+	reportViewVariance
+	reportViewTags
+)
+
+type ReportsModel struct {
+	ctx                     context.Context
+	reportUseCase           *usecase.ReportUseCase
+	projectExportUseCase    *usecase.ProjectExpenseExportUseCase
+	statementUseCase        *usecase.StatementExportUseCase
+	yearReviewExportUseCase *usecase.YearReviewExportUseCase
+	varianceUseCase         *usecase.VarianceUseCase
+	periodLockUseCase       *usecase.PeriodLockUseCase
+	reportSnapshotUseCase   *usecase.ReportSnapshotUseCase
+
+	// The period covered, a calendar month unless another is picked
+	period      reportPeriod
+	rangePicker *dateRangePicker
+
+	// yearReview, when open, replaces the reports with the year review deck
+	yearReview *yearReviewDeck
+
+	// periodLock tells whether the books of the month shown are closed, nil for
+	// other periods; lockPrompt, when open, closes or reopens them
+	periodLock *entity.AccountingPeriod
+	lockPrompt *periodLockPrompt
+
+	// snapshot is the month's report as it was when the month ended, nil when
+	// none was taken; asClosed shows it in place of the recomputed report
+	snapshot *entity.ReportSnapshot
+	asClosed bool
+
+	report map[string]interface{}
+	view   reportView
+	trend  *usecase.TrendReport
+	// categoryTrends average each category over the months before the month
+	// shown, nil for other periods
+	categoryTrends map[entity.TransactionCategory]usecase.CategoryTrend
+	// variances don't depend on the period, and load when first shown
+	variances []*usecase.RecurringVariance
+	// tags load while their view is shown
+	tags []*usecase.TagReport
+
+	// Business mode adds the period's expenses grouped by client and project
+	businessMode    bool
+	projects        []*usecase.ProjectReport
+	selectedProject int
+
+	loading bool
+	message string
+	err     error
+}
+
+// reportPeriod is the half-open interval [start, end) a report covers
+type reportPeriod struct {
+	start time.Time
+	end   time.Time
+	// dateRange is how it was picked, for the picker to start from
+	dateRange entity.TransactionFilter
+}
+
+// monthPeriod covers the calendar month of month
+func monthPeriod(month time.Time) reportPeriod {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	return reportPeriod{start: start, end: start.AddDate(0, 1, 0)}
+}
+
+func (p reportPeriod) equal(other reportPeriod) bool {
+	return p.start.Equal(other.start) && p.end.Equal(other.end)
+}
+
+// last is the last instant of the period, for the use cases taking inclusive ranges
+func (p reportPeriod) last() time.Time {
+	return p.end.Add(-time.Nanosecond)
+}
+
+// isMonth tells whether the period is exactly one calendar month
+func (p reportPeriod) isMonth() bool {
+	return p.start.Day() == 1 && p.start.AddDate(0, 1, 0).Equal(p.end)
+}
+
+func (p reportPeriod) label() string {
+	if p.isMonth() && p.dateRange.DateRange != entity.FilterDateRangeLastMonth {
+		return p.start.Format("January 2006")
+	}
+	dates := fmt.Sprintf("%s – %s", p.start.Format("02/01/2006"), p.end.AddDate(0, 0, -1).Format("02/01/2006"))
+	if p.dateRange.DateRange == "" || p.dateRange.DateRange == entity.FilterDateRangeCustom {
+		return dates
+	}
+	return fmt.Sprintf("%s (%s)", filterDateRangeNames[indexOf(filterDateRanges, p.dateRange.DateRange)], dates)
+}
+
+// categorySpend is one row of the category breakdown
+type categorySpend struct {
+	category entity.TransactionCategory
+	amount   valueobject.Money
+}
+
+type periodReportLoadedMsg struct {
+	period reportPeriod
+	report map[string]interface{}
+}
+
+type trendReportLoadedMsg struct {
+	period reportPeriod
+	trend  *usecase.TrendReport
+}
+
+type projectReportLoadedMsg struct {
+	period   reportPeriod
+	projects []*usecase.ProjectReport
+}
+
+type projectExpensesExportedMsg struct {
+	path string
+}
+
+type statementsExportedMsg struct {
+	paths []string
+}
+
+func NewReportsModel(ctx context.Context, reportUC *usecase.ReportUseCase, personUC *usecase.PersonUseCase, billUC *usecase.BillUseCase, projectExportUC *usecase.ProjectExpenseExportUseCase, statementUC *usecase.StatementExportUseCase, yearReviewExportUC *usecase.YearReviewExportUseCase, varianceUC *usecase.VarianceUseCase, periodLockUC *usecase.PeriodLockUseCase, reportSnapshotUC *usecase.ReportSnapshotUseCase) tea.Model {
+	now := time.Now()
+	return &ReportsModel{
+		ctx:                     ctx,
+		reportUseCase:           reportUC,
+		projectExportUseCase:    projectExportUC,
+		statementUseCase:        statementUC,
+		yearReviewExportUseCase: yearReviewExportUC,
+		varianceUseCase:         varianceUC,
+		periodLockUseCase:       periodLockUC,
+		reportSnapshotUseCase:   reportSnapshotUC,
+		period:                  monthPeriod(now),
+		loading:                 true,
+	}
+}
+
+// SetBusinessMode enables the breakdown of business expenses by project
+func (m *ReportsModel) SetBusinessMode(enabled bool) {
+	m.businessMode = enabled
+}
+
+func (m *ReportsModel) Init() tea.Cmd {
+	return m.load()
+}
+
+// load reads the reports of the selected period
+func (m *ReportsModel) load() tea.Cmd {
+	m.loading = true
+	cmds := []tea.Cmd{m.loadReport, m.loadTrend}
+	if m.businessMode {
+		cmds = append(cmds, m.loadProjects)
+	}
+	if m.periodLockUseCase != nil {
+		cmds = append(cmds, m.loadPeriodLock)
+	}
+	m.categoryTrends = nil
+	if m.period.isMonth() {
+		cmds = append(cmds, m.loadCategoryTrends)
+	}
+	m.tags = nil
+	if m.view == reportViewTags {
+		cmds = append(cmds, m.loadTagReport)
+	}
+	m.snapshot = nil
+	if m.reportSnapshotUseCase != nil && m.period.isMonth() {
+		cmds = append(cmds, m.loadSnapshot)
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *ReportsModel) loadReport() tea.Msg {
+	period := m.period
+	report, err := m.reportUseCase.GetPeriodReport(m.ctx, period.start, period.last(), period.label())
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return periodReportLoadedMsg{period: period, report: report}
+}
+
+// loadTrend reads the 12 months up to the last one of the period, or up to the
+// last closed month while that one isn't over
+func (m *ReportsModel) loadTrend() tea.Msg {
+	period := m.period
+	last := period.last()
+	lastMonth := time.Date(last.Year(), last.Month(), 1, 0, 0, 0, 0, last.Location())
+	if now := time.Now(); !lastMonth.Before(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())) {
+		lastMonth = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+	}
+
+	trend, err := m.reportUseCase.GetTrendReport(m.ctx, lastMonth)
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return trendReportLoadedMsg{period: period, trend: trend}
+}
+
+func (m *ReportsModel) loadProjects() tea.Msg {
+	period := m.period
+	projects, err := m.reportUseCase.GetProjectReport(m.ctx, period.start, period.last())
+	if err != nil {
+		return errMsg{err: err}
+	}
+	return projectReportLoadedMsg{period: period, projects: projects}
+}
+
+func (m *ReportsModel) exportProject() tea.Cmd {
+	if m.selectedProject >= len(m.projects) {
+		return nil
+	}
+
+	project := m.projects[m.selectedProject]
+	period := m.period
+	return func() tea.Msg {
+		path, err := m.projectExportUseCase.ExportProjectExpenses(m.ctx, project.Client, project.Project, period.start, period.last())
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return projectExpensesExportedMsg{path: path}
+	}
+}
+
+// exportStatements writes the PDF statement of the period for every account
+func (m *ReportsModel) exportStatements() tea.Cmd {
+	period := m.period
+	return func() tea.Msg {
+		paths, err := m.statementUseCase.ExportAccountStatements(m.ctx, period.start, period.last())
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return statementsExportedMsg{paths: paths}
+	}
+}
+
+func (m *ReportsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case periodReportLoadedMsg:
+		// A report for a period already navigated away from is dropped
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.loading = false
+		m.report = msg.report
+		return m, nil
+
+	case trendReportLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.trend = msg.trend
+		return m, nil
+
+	case projectReportLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.projects = msg.projects
+		if m.selectedProject >= len(m.projects) {
+			m.selectedProject = 0
+		}
+		return m, nil
+
+	case projectExpensesExportedMsg:
+		m.message = fmt.Sprintf("Expense report exported to %s", msg.path)
+		return m, nil
+
+	case statementsExportedMsg:
+		if len(msg.paths) == 1 {
+			m.message = fmt.Sprintf("Statement exported to %s", msg.paths[0])
+		} else {
+			m.message = fmt.Sprintf("%d statements exported to %s", len(msg.paths), filepath.Dir(msg.paths[0]))
+		}
+		return m, nil
+
+	case varianceReportLoadedMsg:
+		m.variances = msg.variances
+		return m, nil
+
+	case categoryTrendsLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.categoryTrends = msg.trends
+		return m, nil
+
+	case tagReportLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.tags = msg.tags
+		return m, nil
+
+	case yearReviewLoadedMsg:
+		if m.yearReview == nil || msg.year != m.yearReview.year {
+			return m, nil
+		}
+		m.yearReview.review = msg.review
+		return m, nil
+
+	case yearReviewExportedMsg:
+		m.message = fmt.Sprintf("Year review exported to %s", msg.path)
+		return m, nil
+
+	case periodLockLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.periodLock = msg.lock
+		return m, nil
+
+	case periodLockSavedMsg:
+		if msg.err != nil {
+			if m.lockPrompt != nil {
+				m.lockPrompt.err = msg.err
+			}
+			return m, nil
+		}
+		m.lockPrompt = nil
+		m.periodLock = msg.lock
+		m.message = msg.message
+		// Closing the books takes the month's snapshot again
+		if m.reportSnapshotUseCase != nil {
+			return m, m.loadSnapshot
+		}
+		return m, nil
+
+	case reportSnapshotLoadedMsg:
+		if !msg.period.equal(m.period) {
+			return m, nil
+		}
+		m.snapshot = msg.snapshot
+		return m, nil
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.lockPrompt != nil {
+			return m.handlePeriodLockKeys(msg)
+		}
+		if m.rangePicker != nil {
+			return m.handleRangePickerKeys(msg)
+		}
+		if m.yearReview != nil {
+			return m.handleYearReviewKeys(msg)
+		}
+
+		m.err = nil
+		m.message = ""
+		switch msg.String() {
+		case "left", "h":
+			m.period = monthPeriod(m.period.start.AddDate(0, -1, 0))
+			return m, m.load()
+		case "right", "l":
+			m.period = monthPeriod(m.period.start.AddDate(0, 1, 0))
+			return m, m.load()
+		case "t":
+			m.period = monthPeriod(time.Now())
+			return m, m.load()
+		case "d":
+			m.rangePicker = newDateRangePicker(reportDateRanges, m.period.dateRange)
+			return m, nil
+		case "r":
+			if m.view == reportViewVariance {
+				m.variances = nil
+				return m, tea.Batch(m.load(), m.loadVariance())
+			}
+			return m, m.load()
+		case "y":
+			m.view = m.toggleView(reportViewTrend)
+		case "w":
+			return m.openYearReview()
+		case "v":
+			if m.varianceUseCase != nil {
+				m.view = m.toggleView(reportViewVariance)
+				if m.view == reportViewVariance && m.variances == nil {
+					return m, m.loadVariance()
+				}
+			}
+		case "g":
+			m.view = m.toggleView(reportViewTags)
+			if m.view == reportViewTags && m.tags == nil {
+				return m, m.loadTagReport
+			}
+		case "p":
+			if m.businessMode {
+				m.view = m.toggleView(reportViewProjects)
+			}
+		case "up", "k":
+			if m.view == reportViewProjects && m.selectedProject > 0 {
+				m.selectedProject--
+			}
+		case "down", "j":
+			if m.view == reportViewProjects && m.selectedProject < len(m.projects)-1 {
+				m.selectedProject++
+			}
+		case "x":
+			if m.view == reportViewProjects {
+				return m, m.exportProject()
+			}
+		case "s":
+			if m.statementUseCase != nil {
+				return m, m.exportStatements()
+			}
+		case "c":
+			m.openPeriodLockPrompt()
+		case "a":
+			if m.snapshot != nil {
+				m.asClosed = !m.asClosed
+			}
+		case "b", "esc":
+			return m, func() tea.Msg { return BackToDashboardMsg{} }
+		}
+	}
+
+	return m, nil
+}
+
+// handleRangePickerKeys drives the period picker, reloading the reports for
+// the picked period
+func (m *ReportsModel) handleRangePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch m.rangePicker.handleKey(msg) {
+	case dateRangePicked:
+		dateRange := m.rangePicker.selected()
+		m.rangePicker = nil
+		start, end, bounded := dateRange.Period(time.Now())
+		if !bounded {
+			return m, nil
+		}
+		m.period = reportPeriod{start: start, end: end, dateRange: dateRange}
+		return m, m.load()
+	case dateRangeCancelled:
+		m.rangePicker = nil
+	}
+	return m, nil
+}
+
+// toggleView switches to view, or back to the categories when it is already shown
+func (m *ReportsModel) toggleView(view reportView) reportView {
+	if m.view == view {
+		return reportViewCategories
+	}
+	return view
+}
+
+// categorySpending lists the spending categories of the report, largest first.
+// Income and transfers between the user's own accounts aren't spending.
+func (m *ReportsModel) categorySpending() []categorySpend {
+	breakdown, _ := m.shownReport()["categoryBreakdown"].(map[entity.TransactionCategory]valueobject.Money)
+
+	spending := make([]categorySpend, 0, len(breakdown))
+	for category, amount := range breakdown {
+		if !isSpendingCategory(category) || amount.IsZero() {
+			continue
+		}
+		spending = append(spending, categorySpend{category: category, amount: amount})
+	}
+	sort.Slice(spending, func(i, j int) bool {
+		if spending[i].amount.Amount() != spending[j].amount.Amount() {
+			return spending[i].amount.Amount() > spending[j].amount.Amount()
+		}
+		return spending[i].category < spending[j].category
+	})
+	return spending
+}
+
+func (m *ReportsModel) View() string {
+	if m.rangePicker != nil {
+		return m.rangePicker.view()
+	}
+	if m.yearReview != nil {
+		return m.renderYearReview()
+	}
+	if m.lockPrompt != nil {
+		return m.renderPeriodLockPrompt()
+	}
+
+	title := style.TitleStyle.Render(fmt.Sprintf("📊 Reports — %s", m.period.label()))
+	if m.periodLock != nil && m.periodLock.Closed {
+		title = lipgloss.JoinHorizontal(lipgloss.Center, title, style.WarningStyle.Render("  🔒 Books closed"))
+	}
+	if badge := m.renderSnapshotBadge(); badge != "" {
+		title = lipgloss.JoinHorizontal(lipgloss.Center, title, badge)
+	}
+
+	if m.loading {
+		return lipgloss.JoinVertical(lipgloss.Left, title, style.InfoStyle.Render("Loading report..."))
+	}
+	if m.err != nil {
+		return lipgloss.JoinVertical(lipgloss.Left, title, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+
+	sections := []string{title, m.renderSummary()}
+	switch m.view {
+	case reportViewProjects:
+		sections = append(sections, m.renderProjectBreakdown())
+	case reportViewTrend:
+		sections = append(sections, m.renderTrend())
+	case reportViewVariance:
+		sections = append(sections, m.renderVariance())
+	case reportViewTags:
+		sections = append(sections, m.renderTagBreakdown())
+	default:
+		sections = append(sections, m.renderCategoryBreakdown())
+	}
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.MarginTop(1).Render(m.message))
+	}
+
+	help := "[←/→] Month • [t] This Month • [d] Period • [w] Year Wrapped • [s] Export Statements (PDF) • [r] Refresh • [b] Back"
+	switch m.view {
+	case reportViewProjects:
+		help = "[↑/↓] Select Project • [x] Export Expenses • [p] Categories • [y] Trend • " + help
+	case reportViewTrend:
+		help = "[y] Categories • [v] Variance • [g] Tags • " + help
+	case reportViewVariance:
+		help = "[v] Categories • [y] Trend • [g] Tags • " + help
+	case reportViewTags:
+		help = "[g] Categories • [y] Trend • [v] Variance • " + help
+	default:
+		help = "[y] Trend • [v] Variance • [g] Tags • " + help
+	}
+	if m.businessMode && m.view != reportViewProjects {
+		help = "[p] Projects • " + help
+	}
+	if m.snapshot != nil {
+		if m.asClosed {
+			help = "[a] Recomputed • " + help
+		} else {
+			help = "[a] As Closed • " + help
+		}
+	}
+	if m.canToggleLock() {
+		if m.periodLock.Closed {
+			help = "[c] Reopen Books • " + help
+		} else {
+			help = "[c] Close Books • " + help
+		}
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *ReportsModel) renderSummary() string {
+	report := m.shownReport()
+	income, _ := report["totalIncome"].(valueobject.Money)
+	expenses, _ := report["totalExpenses"].(valueobject.Money)
+	net, _ := report["netSavings"].(valueobject.Money)
+	count, _ := report["transactionCount"].(int)
+
+	netStyle := style.SuccessStyle
+	if net.IsNegative() {
+		netStyle = style.ErrorStyle
+	}
+
+	summary := []string{
+		fmt.Sprintf("Income:       %s", formatMoney(income)),
+		fmt.Sprintf("Expenses:     %s", formatMoney(expenses)),
+		fmt.Sprintf("Net:          %s", netStyle.Render(formatMoney(net))),
+		fmt.Sprintf("Transactions: %d", count),
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1).
+		Render(strings.Join(summary, "\n"))
+}
+
+// renderCategoryBreakdown charts each category's share of the month's spending,
+// the largest category filling the whole bar
+func (m *ReportsModel) renderCategoryBreakdown() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	spending := m.categorySpending()
+	if len(spending) == 0 {
+		return boxStyle.Render(style.InfoStyle.Render("No spending recorded in this period."))
+	}
+
+	var total float64
+	for _, spend := range spending {
+		total += spend.amount.Amount()
+	}
+	largest := spending[0].amount.Amount()
+
+	header := fmt.Sprintf("%-20s %-*s %6s %14s", "Category", reportBarWidth, "Spending", "Share", "Amount")
+	if m.categoryTrends != nil {
+		header += fmt.Sprintf(" %14s %s", "6-Mo Avg", "Trend")
+	}
+	rows := []string{style.TableHeaderStyle.Render(header)}
+	for _, spend := range spending {
+		filled := int(spend.amount.Amount() / largest * reportBarWidth)
+		if filled < 1 {
+			filled = 1
+		}
+		bar := lipgloss.NewStyle().Foreground(categoryColor(spend.category)).Render(strings.Repeat("█", filled)) +
+			strings.Repeat(" ", reportBarWidth-filled)
+
+		row := fmt.Sprintf("%s %s %5.1f%% %14s",
+			renderCategoryCell(spend.category, 20),
+			bar,
+			spend.amount.Amount()/total*100,
+			formatMoney(spend.amount))
+		if m.categoryTrends != nil {
+			row += " " + m.renderCategoryTrend(spend.category)
+		}
+		rows = append(rows, row)
+	}
+	rows = append(rows, "", style.InfoStyle.Render(fmt.Sprintf("Total spending: %s", formatAmount(total))))
+
+	return boxStyle.Render(strings.Join(rows, "\n"))
+}
+
+// renderProjectBreakdown lists the period's business expenses by client and
+// project, with the expenses of the selected one
+func (m *ReportsModel) renderProjectBreakdown() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if len(m.projects) == 0 {
+		return boxStyle.Render(style.InfoStyle.Render("No business expenses in this period. Tag transactions with [w] in their details."))
+	}
+
+	rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-36s %-8s %14s", "Client / Project", "Count", "Total"))}
+	for i, project := range m.projects {
+		row := fmt.Sprintf("%-36s %-8d %14s", truncateString(project.Label(), 36), len(project.Transactions), formatMoney(project.Total))
+		if i == m.selectedProject {
+			row = style.SelectedMenuItemStyle.Render("► " + row)
+		} else {
+			row = style.MenuItemStyle.Render("  " + row)
+		}
+		rows = append(rows, row)
+	}
+	sections := []string{boxStyle.Render(strings.Join(rows, "\n"))}
+
+	if m.selectedProject < len(m.projects) {
+		project := m.projects[m.selectedProject]
+		lines := []string{style.HeaderStyle.Render(fmt.Sprintf("Expenses of %s", project.Label()))}
+		for _, txn := range project.Transactions {
+			lines = append(lines, fmt.Sprintf("%s  %-30s %-16s %14s",
+				txn.Date.Format("2006-01-02"), truncateString(txn.Description, 30), categoryDisplayName(txn.Category), formatMoney(txn.Amount)))
+		}
+		sections = append(sections, boxStyle.BorderForeground(style.Info).Render(strings.Join(lines, "\n")))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// renderTrend charts income and expenses over the last 12 months and tells
+// which way spending is heading
+func (m *ReportsModel) renderTrend() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	if m.trend == nil {
+		return boxStyle.Render(style.InfoStyle.Render("Loading trend..."))
+	}
+
+	trend := m.trend
+	first, latest := trend.Months[0], trend.Latest()
+	lines := []string{style.HeaderStyle.Render(fmt.Sprintf("12-Month Trend: %s to %s", first.Month.Format("Jan 2006"), latest.Month.Format("Jan 2006")))}
+
+	// The axis labels would give the amounts away
+	if privacyMode {
+		lines = append(lines, "", style.HelpStyle.Render("Trend chart hidden in privacy mode"))
+	} else {
+		income := make([]float64, len(trend.Months))
+		expenses := make([]float64, len(trend.Months))
+		for i, month := range trend.Months {
+			income[i] = month.Income
+			expenses[i] = month.Expenses
+		}
+		lines = append(lines, "", asciigraph.PlotMany([][]float64{income, expenses},
+			asciigraph.Height(10),
+			asciigraph.Width(72),
+			asciigraph.SeriesColors(asciigraph.Green, asciigraph.Red),
+			asciigraph.Caption("Income (green) and expenses (red) per month"),
+		))
+	}
+
+	lines = append(lines, "")
+	if change, ok := trend.MonthOverMonth(); ok {
+		lines = append(lines, fmt.Sprintf("Month over month: %s expenses in %s", renderTrendChange(change), latest.Month.Format("January")))
+	}
+	if change, ok := trend.YearOverYear(); ok {
+		lines = append(lines, fmt.Sprintf("Year over year:   %s expenses against %s", renderTrendChange(change), latest.Month.AddDate(-1, 0, 0).Format("January 2006")))
+	}
+
+	slope := trend.ExpenseSlope()
+	switch {
+	case slope > 0.5:
+		lines = append(lines, style.ErrorStyle.Render(fmt.Sprintf("↗ Spending is trending up, by about %s a month", formatAmount(slope))))
+	case slope < -0.5:
+		lines = append(lines, style.SuccessStyle.Render(fmt.Sprintf("↘ Spending is trending down, by about %s a month", formatAmount(-slope))))
+	default:
+		lines = append(lines, style.InfoStyle.Render("→ Spending is holding steady"))
+	}
+
+	lines = append(lines, "", style.TableHeaderStyle.Render(fmt.Sprintf("%-10s %14s %14s %14s %14s", "Month", "Income", "Expenses", "Net", "Spent Yr Ago")))
+	for _, month := range trend.Months {
+		lines = append(lines, fmt.Sprintf("%-10s %14s %14s %14s %14s",
+			month.Month.Format("Jan 2006"),
+			formatAmount(month.Income),
+			formatAmount(month.Expenses),
+			formatAmount(month.Income-month.Expenses),
+			formatAmount(month.LastYearExpenses)))
+	}
+
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
+
+// renderTrendChange shows a change in expenses, red when they went up
+func renderTrendChange(change float64) string {
+	if change > 0 {
+		return style.ErrorStyle.Render(fmt.Sprintf("+%.1f%%", change))
+	}
+	return style.SuccessStyle.Render(fmt.Sprintf("%.1f%%", change))
+}
@@ -0,0 +1,349 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Sinking funds live on the bills screen: they are the yearly counterpart of
+// bills, for expenses such as IPVA or insurance that come due once a year.
+
+type SinkingFundFormModel struct {
+	nameInput     string
+	amountInput   string
+	dueMonth      time.Month
+	categoryIndex int
+	keywordInput  string
+
+	// Navigation
+	focusedField int
+}
+
+type sinkingFundsLoadedMsg struct {
+	statuses []*usecase.SinkingFundStatus
+}
+
+type sinkingFundActionMsg struct{}
+
+func newSinkingFundForm() *SinkingFundFormModel {
+	return &SinkingFundFormModel{dueMonth: time.January}
+}
+
+func sinkingFundCategoryLabel(category entity.TransactionCategory) string {
+	if category == "" {
+		return "Any"
+	}
+	return categoryDisplayName(category)
+}
+
+func (m *BillsModel) handleSinkingFundsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.sinkingFundIndex > 0 {
+			m.sinkingFundIndex--
+		}
+	case "down", "j":
+		if m.sinkingFundIndex < len(m.sinkingFunds)-1 {
+			m.sinkingFundIndex++
+		}
+	case "n":
+		m.viewMode = BillViewSinkingFundForm
+		m.sinkingFundForm = newSinkingFundForm()
+	case "d":
+		if m.sinkingFundIndex < len(m.sinkingFunds) {
+			m.loading = true
+			return m, m.deleteSinkingFund
+		}
+	case "r":
+		m.loading = true
+		return m, m.loadSinkingFunds
+	case "esc", "b":
+		m.viewMode = BillViewList
+	}
+
+	return m, nil
+}
+
+func (m *BillsModel) handleSinkingFundFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.sinkingFundForm
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = BillViewSinkingFunds
+		return m, nil
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 7
+		return m, nil
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 7) % 7
+		return m, nil
+	case "enter":
+		if form.focusedField == 5 {
+			return m.submitSinkingFund()
+		} else if form.focusedField == 6 {
+			// Cancel button
+			m.viewMode = BillViewSinkingFunds
+		}
+		return m, nil
+	}
+
+	key := msg.String()
+	switch form.focusedField {
+	case 0: // Name
+		form.nameInput = editTextInput(form.nameInput, msg)
+	case 1: // Annual amount
+		form.amountInput = editAmountInput(form.amountInput, msg)
+	case 2: // Due month
+		switch key {
+		case "left", "h":
+			form.dueMonth = (form.dueMonth+10)%12 + 1
+		case "right", "l":
+			form.dueMonth = form.dueMonth%12 + 1
+		}
+	case 3: // Category
+		options := defaultCategoryOptions()
+		switch key {
+		case "left", "h":
+			form.categoryIndex = (form.categoryIndex - 1 + len(options)) % len(options)
+		case "right", "l":
+			form.categoryIndex = (form.categoryIndex + 1) % len(options)
+		}
+	case 4: // Keyword
+		form.keywordInput = editTextInput(form.keywordInput, msg)
+	}
+
+	return m, nil
+}
+
+func (m *BillsModel) submitSinkingFund() (tea.Model, tea.Cmd) {
+	form := m.sinkingFundForm
+
+	if strings.TrimSpace(form.nameInput) == "" {
+		m.err = fmt.Errorf("fund name is required")
+		return m, nil
+	}
+
+	amount, err := strconv.ParseFloat(form.amountInput, 64)
+	if err != nil || amount <= 0 {
+		m.err = fmt.Errorf("invalid annual amount")
+		return m, nil
+	}
+
+	category := defaultCategoryOptions()[form.categoryIndex]
+	if category == "" && strings.TrimSpace(form.keywordInput) == "" {
+		m.err = fmt.Errorf("choose a category or a keyword to track spending")
+		return m, nil
+	}
+
+	m.loading = true
+	return m, func() tea.Msg {
+		_, err := m.sinkingFundUseCase.CreateSinkingFund(m.ctx, form.nameInput, amount, "BRL", form.dueMonth, category, form.keywordInput)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return sinkingFundActionMsg{}
+	}
+}
+
+func (m *BillsModel) loadSinkingFunds() tea.Msg {
+	statuses, err := m.sinkingFundUseCase.GetSinkingFundStatuses(m.ctx, time.Now())
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return sinkingFundsLoadedMsg{statuses: statuses}
+}
+
+func (m *BillsModel) deleteSinkingFund() tea.Msg {
+	fund := m.sinkingFunds[m.sinkingFundIndex].Fund
+	if err := m.sinkingFundUseCase.DeleteSinkingFund(m.ctx, fund.ID); err != nil {
+		return errMsg{err: err}
+	}
+
+	return sinkingFundActionMsg{}
+}
+
+func (m *BillsModel) renderSinkingFunds() string {
+	var sections []string
+
+	sections = append(sections, style.TitleStyle.Render("🏦 Sinking Funds"))
+
+	if len(m.sinkingFunds) == 0 {
+		empty := style.InfoStyle.Render("No sinking funds yet. Press 'n' to plan a yearly expense.")
+		sections = append(sections, empty)
+	} else {
+		sections = append(sections, m.renderSinkingFundsTable())
+		sections = append(sections, m.renderSinkingFundsSummary())
+	}
+
+	help := "[↑/↓] Navigate • [n] New • [d] Delete • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *BillsModel) renderSinkingFundsTable() string {
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	headerRow := style.TableHeaderStyle.Render(
+		fmt.Sprintf("%-18s %-10s %-12s %-14s %-12s %-14s %s",
+			"Fund", "Due", "Monthly", "Should Have", "Spent", "Available", "Cycle"),
+	)
+
+	rows := []string{headerRow}
+	for i, status := range m.sinkingFunds {
+		available := formatMoney(status.Available)
+		if status.Available.IsNegative() {
+			available = style.ErrorStyle.Render(available)
+		}
+
+		row := fmt.Sprintf("%-18s %-10s %-12s %-14s %-12s %-14s %s",
+			truncateString(status.Fund.Name, 18),
+			status.NextDueDate.Format("Jan 2006"),
+			formatMoney(status.MonthlyAccrual),
+			formatMoney(status.ExpectedSetAside),
+			formatMoney(status.SpentThisCycle),
+			available,
+			m.renderProgressBar(status.Progress(), 12))
+
+		if i == m.sinkingFundIndex {
+			row = style.SelectedMenuItemStyle.Render("► " + row)
+		} else {
+			row = style.MenuItemStyle.Render("  " + row)
+		}
+		rows = append(rows, row)
+	}
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
+
+func (m *BillsModel) renderSinkingFundsSummary() string {
+	summaryStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(1, 2).
+		MarginTop(1)
+
+	var monthly, expected, available float64
+	for _, status := range m.sinkingFunds {
+		monthly += status.MonthlyAccrual.Amount()
+		expected += status.ExpectedSetAside.Amount()
+		available += status.Available.Amount()
+	}
+
+	summary := []string{
+		fmt.Sprintf("Set Aside Monthly: %s", formatAmount(monthly)),
+		fmt.Sprintf("Should Have Saved: %s", formatAmount(expected)),
+		fmt.Sprintf("Still Reserved: %s", formatAmount(available)),
+	}
+
+	var lines []string
+	lines = append(lines, strings.Join(summary, " • "))
+
+	if m.sinkingFundIndex < len(m.sinkingFunds) {
+		fund := m.sinkingFunds[m.sinkingFundIndex].Fund
+		tracking := fmt.Sprintf("Tracks: %s", sinkingFundCategoryLabel(fund.Category))
+		if fund.Keyword != "" {
+			tracking += fmt.Sprintf(" matching \"%s\"", fund.Keyword)
+		}
+		lines = append(lines, style.HelpStyle.Render(fmt.Sprintf("%s • Annual: %s", tracking, formatMoney(fund.AnnualAmount))))
+	}
+
+	return summaryStyle.Render(strings.Join(lines, "\n"))
+}
+
+func (m *BillsModel) renderSinkingFundForm() string {
+	form := m.sinkingFundForm
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📝 New Sinking Fund"))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(2, 4).
+		MarginTop(1)
+
+	var fields []string
+	fields = append(fields, m.renderSinkingFundField("Name:", form.nameInput, 0))
+	fields = append(fields, m.renderSinkingFundField("Annual Amount:", form.amountInput, 1))
+	fields = append(fields, renderDefaultSelector("Due Month:", form.dueMonth.String(), form.focusedField == 2))
+	category := defaultCategoryOptions()[form.categoryIndex]
+	fields = append(fields, renderDefaultSelector("Category:", sinkingFundCategoryLabel(category), form.focusedField == 3))
+	fields = append(fields, m.renderSinkingFundField("Keyword:", form.keywordInput, 4))
+
+	if amount, err := strconv.ParseFloat(form.amountInput, 64); err == nil && amount > 0 {
+		fields = append(fields, style.InfoStyle.Render(fmt.Sprintf("Set aside %s per month", formatAmount(amount/12))))
+	}
+
+	var submitStyle, cancelStyle lipgloss.Style
+	if form.focusedField == 5 {
+		submitStyle = style.ButtonStyle.Background(style.Success)
+	} else {
+		submitStyle = style.SecondaryButtonStyle
+	}
+	if form.focusedField == 6 {
+		cancelStyle = style.ButtonStyle.Background(style.Danger)
+	} else {
+		cancelStyle = style.SecondaryButtonStyle
+	}
+
+	submitBtn := submitStyle.Render("Create Fund")
+	cancelBtn := cancelStyle.Render("Cancel")
+	if form.focusedField == 5 {
+		submitBtn = submitBtn + " ◄"
+	} else if form.focusedField == 6 {
+		cancelBtn = cancelBtn + " ◄"
+	}
+
+	fields = append(fields, lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		submitBtn,
+		lipgloss.NewStyle().MarginLeft(2).Render(cancelBtn),
+	))
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *BillsModel) renderSinkingFundField(label, value string, fieldIndex int) string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(style.Text).
+		Bold(true).
+		Width(20)
+
+	var inputStyle lipgloss.Style
+	if m.sinkingFundForm.focusedField == fieldIndex {
+		inputStyle = style.FocusedInputStyle.Width(30)
+	} else {
+		inputStyle = style.InputStyle.Width(30)
+	}
+
+	input := inputStyle.Render(value)
+	if m.sinkingFundForm.focusedField == fieldIndex {
+		input = input + " ◄"
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		labelStyle.Render(label),
+		input,
+	)
+}
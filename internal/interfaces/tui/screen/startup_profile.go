@@ -0,0 +1,85 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StartupProfile records how long each step of the launch took, for
+// --profile-startup. A nil profile records nothing, so callers don't need to
+// check whether profiling is on.
+type StartupProfile struct {
+	start time.Time
+
+	mu    sync.Mutex
+	steps []StartupStep
+}
+
+// StartupStep is one timed step of the launch
+type StartupStep struct {
+	Name string
+	Took time.Duration
+	// Done is when the step finished, counted from the start of the process
+	Done time.Duration
+}
+
+func NewStartupProfile(start time.Time) *StartupProfile {
+	return &StartupProfile{start: start}
+}
+
+// Record adds a step that just finished after taking took
+func (p *StartupProfile) Record(name string, took time.Duration) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = append(p.steps, StartupStep{Name: name, Took: took, Done: time.Since(p.start)})
+}
+
+// Measure starts timing a step and returns the func that records it
+func (p *StartupProfile) Measure(name string) func() {
+	started := time.Now()
+	return func() {
+		p.Record(name, time.Since(started))
+	}
+}
+
+// Mark records a milestone, a step timed from the start of the process
+func (p *StartupProfile) Mark(name string) {
+	if p == nil {
+		return
+	}
+	p.Record(name, time.Since(p.start))
+}
+
+// Report lists the steps in the order they finished
+func (p *StartupProfile) Report() string {
+	if p == nil {
+		return ""
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("Startup profile\n")
+	fmt.Fprintf(&b, "  %-36s %10s %10s\n", "Step", "Took", "Done at")
+	for _, step := range p.steps {
+		fmt.Fprintf(&b, "  %-36s %10s %10s\n", step.Name, formatStartupDuration(step.Took), formatStartupDuration(step.Done))
+	}
+	return b.String()
+}
+
+func formatStartupDuration(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d.Microseconds())/1000)
+}
+
+// StartupProfiler is implemented by the screens that time their initial load
+type StartupProfiler interface {
+	SetStartupProfile(profile *StartupProfile)
+}
+
+// DashboardReadyMsg is sent once every dashboard section finished its first load
+type DashboardReadyMsg struct{}
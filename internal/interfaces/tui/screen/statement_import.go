@@ -0,0 +1,236 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statementPreviewRows is how many parsed entries the mapping step shows
+const statementPreviewRows = 8
+
+// StatementImportModel walks through importing a CSV bank statement: pick the
+// file, map its columns while previewing the rows, then import them
+type StatementImportModel struct {
+	path      string
+	statement *usecase.StatementCSV
+	columns   usecase.StatementColumns
+
+	// Mapping step: 0: date, 1: description, 2: amount, 3: debit, 4: balance, 5: import, 6: cancel
+	focusedField int
+
+	entries    []usecase.StatementEntry
+	skipped    int
+	previewErr error
+}
+
+// statementReadMsg carries its error instead of going through errMsg, so a
+// mistyped path can be corrected without leaving the import
+type statementReadMsg struct {
+	statement *usecase.StatementCSV
+	err       error
+}
+
+type statementImportedMsg struct {
+	session *entity.ImportSession
+}
+
+func (m *AccountsModel) openStatementImport() (tea.Model, tea.Cmd) {
+	m.statementImport = &StatementImportModel{}
+	m.viewMode = AccountViewStatementImport
+	return m, nil
+}
+
+func (m *AccountsModel) handleStatementImportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	imp := m.statementImport
+	if imp.statement == nil {
+		switch msg.String() {
+		case "esc":
+			m.closeStatementImport()
+		case "enter":
+			path := strings.TrimSpace(imp.path)
+			if path == "" {
+				imp.previewErr = fmt.Errorf("file path is required")
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				statement, err := m.importUseCase.ReadStatementCSV(path)
+				return statementReadMsg{statement: statement, err: err}
+			}
+		default:
+			imp.path = editTextInput(imp.path, msg)
+			imp.previewErr = nil
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.closeStatementImport()
+	case "tab", "down":
+		imp.focusedField = (imp.focusedField + 1) % 7
+	case "shift+tab", "up":
+		imp.focusedField = (imp.focusedField - 1 + 7) % 7
+	case "left", "right":
+		header := len(imp.statement.Header)
+		switch imp.focusedField {
+		case 0:
+			imp.columns.Date = cycleOption(imp.columns.Date, header, msg.String())
+		case 1:
+			imp.columns.Description = cycleOption(imp.columns.Description, header, msg.String())
+		case 2:
+			imp.columns.Amount = cycleOption(imp.columns.Amount+1, header+1, msg.String()) - 1
+		case 3:
+			imp.columns.Debit = cycleOption(imp.columns.Debit+1, header+1, msg.String()) - 1
+		case 4:
+			imp.columns.Balance = cycleOption(imp.columns.Balance+1, header+1, msg.String()) - 1
+		}
+		imp.preview()
+	case "enter":
+		switch imp.focusedField {
+		case 5:
+			if imp.previewErr != nil {
+				return m, nil
+			}
+			account := m.accounts[m.selectedIndex]
+			entries, source := imp.entries, imp.statement.Source
+			m.loading = true
+			return m, func() tea.Msg {
+				session, err := m.importUseCase.ImportStatement(m.ctx, account.ID, source, entries, nil)
+				if err != nil {
+					return errMsg{err: err}
+				}
+				return statementImportedMsg{session: session}
+			}
+		case 6:
+			m.closeStatementImport()
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) closeStatementImport() {
+	m.statementImport = nil
+	m.viewMode = AccountViewList
+}
+
+// setStatement starts the mapping step with the columns guessed from the header
+func (imp *StatementImportModel) setStatement(statement *usecase.StatementCSV) {
+	imp.statement = statement
+	imp.columns = statement.GuessColumns()
+	// Selectors need a column to start from even when none could be guessed
+	if imp.columns.Date < 0 {
+		imp.columns.Date = 0
+	}
+	if imp.columns.Description < 0 {
+		imp.columns.Description = 0
+	}
+	imp.focusedField = 0
+	imp.preview()
+}
+
+func (imp *StatementImportModel) preview() {
+	imp.entries, imp.skipped, imp.previewErr = imp.statement.Entries(imp.columns)
+}
+
+func (m *AccountsModel) renderStatementImport() string {
+	imp := m.statementImport
+	account := m.accounts[m.selectedIndex]
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("📥 Import Statement into %s", account.Name)))
+
+	if imp.statement == nil {
+		var content strings.Builder
+		content.WriteString("Import a bank statement exported as CSV. Comma, semicolon and tab separated files are accepted.\n")
+		content.WriteString("Entries already in the ledger are matched and left alone; the rest are created.")
+		content.WriteString("\n\n")
+		content.WriteString(style.HeaderStyle.Render("File path:"))
+		content.WriteString("\n")
+		content.WriteString(style.FocusedInputStyle.Render(imp.path))
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(content.String()))
+
+		if imp.previewErr != nil {
+			sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", imp.previewErr)))
+		}
+
+		sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Enter] Continue • [Esc] Cancel"))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	fields := []string{
+		renderDefaultSelector("Date column:", imp.columnName(imp.columns.Date), imp.focusedField == 0),
+		renderDefaultSelector("Description column:", imp.columnName(imp.columns.Description), imp.focusedField == 1),
+		renderDefaultSelector("Amount column:", imp.columnName(imp.columns.Amount), imp.focusedField == 2),
+		renderDefaultSelector("Debit column:", imp.columnName(imp.columns.Debit), imp.focusedField == 3),
+		renderDefaultSelector("Balance column:", imp.columnName(imp.columns.Balance), imp.focusedField == 4),
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+	sections = append(sections, style.HelpStyle.Render("Only set a debit column when the statement puts debits and credits in separate columns"))
+	sections = append(sections, style.HelpStyle.Render("A balance column turns the running balances into assertions the ledger is checked against"))
+
+	sections = append(sections, m.renderStatementPreview())
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderSubmitCancelButtons("Import", imp.focusedField, 5)))
+
+	help := "[Tab/↑↓] Navigate • [←/→] Change Column • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *AccountsModel) renderStatementPreview() string {
+	imp := m.statementImport
+	if imp.previewErr != nil {
+		return lipgloss.NewStyle().MarginTop(1).Render(style.ErrorStyle.Render(fmt.Sprintf("Error: %v", imp.previewErr)))
+	}
+
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(0, 2).
+		MarginTop(1)
+
+	rows := []string{style.TableHeaderStyle.Render(fmt.Sprintf("%-12s %-35s %14s %14s", "Date", "Description", "Amount", "Balance"))}
+	for i, entry := range imp.entries {
+		if i == statementPreviewRows {
+			rows = append(rows, style.HelpStyle.Render(fmt.Sprintf("… and %d more", len(imp.entries)-statementPreviewRows)))
+			break
+		}
+
+		amount := style.SuccessStyle.Render(fmt.Sprintf("%14s", "+"+formatAmount(entry.Amount)))
+		if entry.Amount < 0 {
+			amount = style.ErrorStyle.Render(fmt.Sprintf("%14s", "-"+formatAmount(-entry.Amount)))
+		}
+		balance := ""
+		if entry.Balance != nil {
+			balance = formatAmount(*entry.Balance)
+		}
+		rows = append(rows, fmt.Sprintf("%-12s %-35s %s %14s", entry.Date.Format("2006-01-02"), truncateString(entry.Description, 35), amount, balance))
+	}
+
+	summary := fmt.Sprintf("%d entries ready to import", len(imp.entries))
+	if imp.skipped > 0 {
+		summary += fmt.Sprintf(", %d rows skipped (no date or amount)", imp.skipped)
+	}
+	rows = append(rows, "", style.InfoStyle.Render(summary))
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
+
+func (imp *StatementImportModel) columnName(index int) string {
+	if index < 0 || index >= len(imp.statement.Header) {
+		return "None"
+	}
+	name := strings.TrimSpace(imp.statement.Header[index])
+	if name == "" {
+		name = fmt.Sprintf("Column %d", index+1)
+	}
+	return name
+}
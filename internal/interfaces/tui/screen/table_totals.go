@@ -0,0 +1,66 @@
+package screen
+
+import (
+	"fmt"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+)
+
+// totalsScope is which transactions the totals row of the transactions table
+// adds up: the ones on the page shown or all the ones the filters let through
+type totalsScope int
+
+const (
+	totalsPage totalsScope = iota
+	totalsFiltered
+)
+
+func (s totalsScope) String() string {
+	if s == totalsFiltered {
+		return "Filtered total"
+	}
+	return "Page total"
+}
+
+func (s totalsScope) next() totalsScope {
+	return (s + 1) % 2
+}
+
+// sumTransactions adds up the income and the expenses of the transactions
+func sumTransactions(transactions []*entity.Transaction) (income, expense float64) {
+	for _, txn := range transactions {
+		if txn.Type == entity.TransactionTypeCredit {
+			income += txn.Amount.Amount()
+		} else {
+			expense += txn.Amount.Amount()
+		}
+	}
+	return income, expense
+}
+
+// formatNetAmount renders the net of income and expenses signed and colored
+// like the amounts of the transactions tables
+func formatNetAmount(net float64) string {
+	if net < 0 {
+		return style.ErrorStyle.Render("-" + formatAmount(-net))
+	}
+	return style.SuccessStyle.Render("+" + formatAmount(net))
+}
+
+// countLabel is the count of a totals row, such as "3 transactions"
+func countLabel(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// renderTotalsRow renders the footer row of a table, under a line. indent
+// lines it up with rows prefixed by the selection marker.
+func renderTotalsRow(row string, indent bool) string {
+	if indent {
+		row = "  " + row
+	}
+	return style.TableFooterStyle.Render(row)
+}
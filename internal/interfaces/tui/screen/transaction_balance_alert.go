@@ -0,0 +1,57 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+
+	"financli/internal/domain/entity"
+	"financli/internal/domain/valueobject"
+	"financli/internal/interfaces/tui/style"
+)
+
+// lowBalanceWarning warns, before saving, that the expense in the form would
+// drop the selected account below its minimum balance. It is empty when the
+// account has no threshold or stays above it.
+func (m *TransactionsModel) lowBalanceWarning() string {
+	if m.formModel.selectedSource != 0 || m.formModel.selectedType != 0 || m.formModel.selectedAccount >= len(m.accounts) {
+		return ""
+	}
+	account := m.accounts[m.formModel.selectedAccount]
+	if account.MinimumBalance == nil {
+		return ""
+	}
+	amount, err := strconv.ParseFloat(m.formModel.amountInput, 64)
+	if err != nil || amount <= 0 {
+		return ""
+	}
+
+	// An edited transaction already moved the balance, so only what it
+	// changes counts
+	if original := m.editedTransaction(); original != nil && original.AccountID != nil && *original.AccountID == account.ID {
+		if original.Type == entity.TransactionTypeDebit {
+			amount -= original.Amount.Amount()
+		} else {
+			amount += original.Amount.Amount()
+		}
+	}
+
+	if !account.WouldDropBelowMinimumBalance(valueobject.NewMoney(amount, account.Balance.Currency())) {
+		return ""
+	}
+	return style.WarningStyle.Render(fmt.Sprintf("⚠ Leaves %s at %s, below its minimum balance of %s",
+		account.Name, formatAmount(account.Balance.Amount()-amount), formatMoney(*account.MinimumBalance)))
+}
+
+// editedTransaction returns the transaction the form is editing, or nil for a
+// new one
+func (m *TransactionsModel) editedTransaction() *entity.Transaction {
+	if !m.formModel.editing || m.formModel.editingID == nil {
+		return nil
+	}
+	for _, txn := range m.transactions {
+		if txn.ID == *m.formModel.editingID {
+			return txn
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TransactionBusinessModel edits the client and project a business expense is billed to
+type TransactionBusinessModel struct {
+	transaction  *entity.Transaction
+	clientInput  string
+	projectInput string
+
+	// Navigation: 0 client, 1 project, 2 save, 3 cancel
+	focusedField int
+}
+
+func (m *TransactionsModel) startBusinessEdit(txn *entity.Transaction) {
+	m.businessModel = &TransactionBusinessModel{
+		transaction:  txn,
+		clientInput:  txn.Client,
+		projectInput: txn.Project,
+	}
+	m.viewMode = TransactionViewBusiness
+}
+
+func (m *TransactionsModel) handleBusinessKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.businessModel
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = TransactionViewDetails
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 4
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 4) % 4
+	case "enter":
+		if form.focusedField == 2 {
+			m.viewMode = TransactionViewDetails
+			return m, m.saveBusinessTags(form.transaction, form.clientInput, form.projectInput)
+		} else if form.focusedField == 3 {
+			m.viewMode = TransactionViewDetails
+		}
+	default:
+		switch form.focusedField {
+		case 0:
+			form.clientInput = editTextInput(form.clientInput, msg)
+		case 1:
+			form.projectInput = editTextInput(form.projectInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) saveBusinessTags(txn *entity.Transaction, client, project string) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := m.transactionUseCase.SetBusinessTags(m.ctx, txn.ID, client, project)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return transactionUpdatedMsg{transaction: updated}
+	}
+}
+
+func (m *TransactionsModel) renderBusinessForm() string {
+	form := m.businessModel
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("💼 Client/Project: %s", form.transaction.Description)))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(2, 4).
+		MarginTop(1)
+
+	fields := []string{
+		renderTextField("Client:", form.clientInput, form.focusedField == 0),
+		renderTextField("Project:", form.projectInput, form.focusedField == 1),
+		style.HelpStyle.Render("Tagged expenses are grouped by project in Reports; leave both empty to clear them"),
+		renderSubmitCancelButtons("Save", form.focusedField, 2),
+	}
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
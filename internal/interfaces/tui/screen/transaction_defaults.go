@@ -0,0 +1,78 @@
+package screen
+
+import (
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Options for the per-source transaction defaults on the account and card
+// forms. The empty value means "no default".
+var defaultTypeOptions = []entity.TransactionType{
+	"",
+	entity.TransactionTypeDebit,
+	entity.TransactionTypeCredit,
+}
+
+func defaultCategoryOptions() []entity.TransactionCategory {
+	return append([]entity.TransactionCategory{""}, transactionCategories()...)
+}
+
+func defaultTypeLabel(transactionType entity.TransactionType) string {
+	switch transactionType {
+	case entity.TransactionTypeDebit:
+		return "Expense"
+	case entity.TransactionTypeCredit:
+		return "Income"
+	default:
+		return "None"
+	}
+}
+
+func defaultCategoryLabel(category entity.TransactionCategory) string {
+	if category == "" {
+		return "None"
+	}
+	return categoryDisplayName(category)
+}
+
+func defaultTypeIndex(transactionType entity.TransactionType) int {
+	for i, option := range defaultTypeOptions {
+		if option == transactionType {
+			return i
+		}
+	}
+	return 0
+}
+
+func defaultCategoryIndex(category entity.TransactionCategory) int {
+	for i, option := range defaultCategoryOptions() {
+		if option == category {
+			return i
+		}
+	}
+	return 0
+}
+
+// renderDefaultSelector renders a left/right selector row in the style of the other form selectors
+func renderDefaultSelector(label, display string, focused bool) string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(style.Text).
+		Bold(true).
+		Width(20)
+
+	var selector string
+	if focused {
+		selector = style.FocusedInputStyle.Width(30).Render("< " + display + " >")
+		selector = selector + " ◄"
+	} else {
+		selector = style.InputStyle.Width(30).Render(display)
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		labelStyle.Render(label),
+		selector,
+	)
+}
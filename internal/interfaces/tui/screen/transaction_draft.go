@@ -0,0 +1,99 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+
+	"financli/internal/domain/entity"
+)
+
+// formFingerprint sums up the fields of the form the user can change. The
+// category only counts once picked, since the form moves it by itself as the
+// source's history loads.
+func (m *TransactionsModel) formFingerprint() string {
+	f := m.formModel
+	category := ""
+	if f.editing || f.categoryTouched {
+		if categories := m.getFormCategories(); f.selectedCategory < len(categories) {
+			category = string(categories[f.selectedCategory])
+		}
+	}
+	return fmt.Sprintf("%q %q %q %q %d %q %d %d %d %d %t %d %q",
+		f.descriptionInput, f.amountInput, f.dateInput, f.tagsInput, f.selectedType, category,
+		f.selectedSource, f.selectedAccount, f.selectedCard, f.selectedPaymentMethod,
+		f.enableSharing, f.selectedPerson, f.sharePercentage)
+}
+
+// draftFields is the form as a stored draft, with the account, card and
+// person by ID so they're found again after the lists change
+func (m *TransactionsModel) draftFields() map[string]string {
+	f := m.formModel
+	fields := map[string]string{
+		"description":    f.descriptionInput,
+		"amount":         f.amountInput,
+		"date":           f.dateInput,
+		"tags":           f.tagsInput,
+		"type":           strconv.Itoa(f.selectedType),
+		"source":         strconv.Itoa(f.selectedSource),
+		"payment_method": string(paymentMethodOptions()[f.selectedPaymentMethod]),
+		"share":          f.sharePercentage,
+	}
+	if f.categoryTouched {
+		if categories := m.getFormCategories(); f.selectedCategory < len(categories) {
+			fields["category"] = string(categories[f.selectedCategory])
+		}
+	}
+	if f.selectedAccount < len(m.accounts) {
+		fields["account"] = m.accounts[f.selectedAccount].ID.String()
+	}
+	if f.selectedCard < len(m.creditCards) {
+		fields["card"] = m.creditCards[f.selectedCard].ID.String()
+	}
+	if f.enableSharing {
+		fields["sharing"] = "true"
+		if f.selectedPerson < len(m.people) {
+			fields["person"] = m.people[f.selectedPerson].ID.String()
+		}
+	}
+	return fields
+}
+
+// applyDraftFields fills the form in from a stored draft. Accounts, cards and
+// people no longer there leave their selection alone.
+func (m *TransactionsModel) applyDraftFields(fields map[string]string) {
+	f := m.formModel
+	f.descriptionInput = fields["description"]
+	f.amountInput = fields["amount"]
+	f.dateInput = fields["date"]
+	f.tagsInput = fields["tags"]
+	f.sharePercentage = fields["share"]
+	f.enableSharing = fields["sharing"] == "true"
+
+	if value, err := strconv.Atoi(fields["type"]); err == nil && value >= 0 && value <= 1 {
+		f.selectedType = value
+	}
+	if value, err := strconv.Atoi(fields["source"]); err == nil && value >= 0 && value <= 2 {
+		f.selectedSource = value
+	}
+	f.selectedPaymentMethod = indexOf(paymentMethodOptions(), entity.PaymentMethod(fields["payment_method"]))
+	if category := fields["category"]; category != "" {
+		m.selectFormCategory(entity.TransactionCategory(category))
+		f.categoryTouched = true
+	}
+
+	for i, account := range m.accounts {
+		if account.ID.String() == fields["account"] {
+			f.selectedAccount = i
+		}
+	}
+	for i, card := range m.creditCards {
+		if card.ID.String() == fields["card"] {
+			f.selectedCard = i
+		}
+	}
+	for i, person := range m.people {
+		if person.ID.String() == fields["person"] {
+			f.selectedPerson = i
+		}
+	}
+}
@@ -0,0 +1,598 @@
+package screen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// Filter view fields, in focus order
+const (
+	filterFieldDateRange = iota
+	filterFieldStartDate
+	filterFieldEndDate
+	filterFieldSource
+	filterFieldSourceItem
+	filterFieldType
+	filterFieldPaymentMethod
+	filterFieldTag
+	filterFieldCategories
+	filterFieldCount
+)
+
+// The filter model keeps its selectors as indexes into these lists
+var (
+	filterDateRanges = []entity.FilterDateRange{entity.FilterDateRangeAll, entity.FilterDateRangeToday, entity.FilterDateRangeWeek, entity.FilterDateRangeMonth,
+		entity.FilterDateRangeLastMonth, entity.FilterDateRangeQuarter, entity.FilterDateRangeYear, entity.FilterDateRangeLast12Months, entity.FilterDateRangeCustom}
+	filterSources = []entity.FilterSource{entity.FilterSourceAll, entity.FilterSourceAccounts, entity.FilterSourceCards}
+	filterTypes   = []entity.FilterType{entity.FilterTypeAll, entity.FilterTypeIncome, entity.FilterTypeExpense}
+
+	filterDateRangeNames = []string{"All time", "Today", "This week", "This month", "Last month", "Quarter to date", "Year to date", "Last 12 months", "Custom"}
+	filterSourceNames    = []string{"All", "Accounts only", "Cards only"}
+	filterTypeNames      = []string{"All", "Income only", "Expense only"}
+)
+
+type filterPresetsLoadedMsg struct {
+	presets []*entity.FilterPreset
+}
+
+type filterPresetSavedMsg struct {
+	preset *entity.FilterPreset
+}
+
+type filterPresetDeletedMsg struct{}
+
+// filter returns the current filter combination
+func (f *TransactionFilterModel) filter() entity.TransactionFilter {
+	filter := entity.TransactionFilter{
+		DateRange:     filterDateRanges[f.dateRangeType],
+		Source:        filterSources[f.filterBySource],
+		AccountID:     f.selectedAccountID,
+		CreditCardID:  f.selectedCardID,
+		Type:          filterTypes[f.typeFilter],
+		PaymentMethod: paymentMethodOptions()[f.paymentMethodFilter],
+		Tag:           f.tagFilter,
+		Payee:         f.payeeFilter,
+		InvoiceID:     f.invoiceFilter,
+	}
+	if filter.DateRange == entity.FilterDateRangeCustom {
+		filter.StartDate, filter.EndDate = f.startDate, f.endDate
+	}
+
+	// Listed in selector order so saved presets don't depend on map iteration
+	for _, category := range transactionCategories() {
+		if f.selectedCategories[category] {
+			filter.Categories = append(filter.Categories, category)
+		}
+	}
+
+	return filter
+}
+
+// setFilter replaces the current filters with a saved combination
+func (f *TransactionFilterModel) setFilter(filter entity.TransactionFilter) {
+	f.dateRangeType = indexOf(filterDateRanges, filter.DateRange)
+	f.startDate, f.endDate = filter.StartDate, filter.EndDate
+	f.filterBySource = indexOf(filterSources, filter.Source)
+	f.selectedAccountID, f.selectedCardID = filter.AccountID, filter.CreditCardID
+	f.typeFilter = indexOf(filterTypes, filter.Type)
+	f.paymentMethodFilter = indexOf(paymentMethodOptions(), filter.PaymentMethod)
+	f.tagFilter = filter.Tag
+	f.payeeFilter, f.invoiceFilter = filter.Payee, filter.InvoiceID
+
+	f.selectedCategories = make(map[entity.TransactionCategory]bool)
+	for _, category := range filter.Categories {
+		f.selectedCategories[category] = true
+	}
+}
+
+// isActive tells whether any filter narrows the list
+func (f *TransactionFilterModel) isActive() bool {
+	return f.dateRangeType != 0 || f.filterBySource != 0 || f.typeFilter != 0 || f.paymentMethodFilter != 0 || f.tagFilter != "" || f.payeeFilter != "" || f.invoiceFilter != nil || len(f.selectedCategories) > 0
+}
+
+func indexOf[T comparable](values []T, value T) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return 0
+}
+
+// openFilterView shows the filter view, remembering the current filters so
+// that leaving with esc discards the changes
+func (m *TransactionsModel) openFilterView(pickPreset bool) (tea.Model, tea.Cmd) {
+	m.viewMode = TransactionViewFilter
+	m.filterModel.previous = m.filterModel.filter()
+	m.filterModel.previousPreset = m.filterModel.activePreset
+	m.filterModel.focusedField = filterFieldDateRange
+	m.filterModel.pickingPreset = pickPreset
+	m.filterModel.namingPreset = false
+	m.filterModel.message = ""
+	return m, m.loadFilterPresets
+}
+
+func (m *TransactionsModel) loadFilterPresets() tea.Msg {
+	presets, err := m.filterPresetUseCase.ListPresets(m.ctx)
+	if err != nil {
+		return errMsg{err}
+	}
+	return filterPresetsLoadedMsg{presets: presets}
+}
+
+func (m *TransactionsModel) saveFilterPreset() tea.Msg {
+	preset, err := m.filterPresetUseCase.SavePreset(m.ctx, m.filterModel.presetName, m.filterModel.filter())
+	if err != nil {
+		return errMsg{err}
+	}
+	return filterPresetSavedMsg{preset: preset}
+}
+
+func (m *TransactionsModel) deleteFilterPreset(id uuid.UUID) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.filterPresetUseCase.DeletePreset(m.ctx, id); err != nil {
+			return errMsg{err}
+		}
+		return filterPresetDeletedMsg{}
+	}
+}
+
+func (m *TransactionsModel) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.filterModel
+	if f.namingPreset {
+		return m.handlePresetNameKeys(msg)
+	}
+	if f.pickingPreset {
+		return m.handlePresetPickerKeys(msg)
+	}
+	if f.rangePicker != nil {
+		m.handleRangePickerKeys(msg)
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		f.setFilter(f.previous)
+		f.activePreset = f.previousPreset
+		m.viewMode = TransactionViewList
+		return m, nil
+	case "enter":
+		if err := f.filter().Validate(); err != nil {
+			f.message = err.Error()
+			return m, nil
+		}
+		m.applyFilters()
+		m.viewMode = TransactionViewList
+		return m, m.reloadPage(m.currentPage, m.itemsPerPage)
+	case "tab", "down":
+		m.moveFilterFocus(1)
+		return m, nil
+	case "shift+tab", "up":
+		m.moveFilterFocus(-1)
+		return m, nil
+	case "s":
+		if err := f.filter().Validate(); err != nil {
+			f.message = err.Error()
+			return m, nil
+		}
+		f.namingPreset = true
+		f.presetName = f.activePreset
+		f.message = ""
+		return m, nil
+	case "p":
+		f.pickingPreset = true
+		f.message = ""
+		return m, nil
+	case "x":
+		f.setFilter(entity.TransactionFilter{})
+		f.activePreset = ""
+		return m, nil
+	}
+
+	// Any other change means the filters no longer match the recalled preset
+	before := f.filter()
+	m.handleFilterInput(msg)
+	if !reflect.DeepEqual(before, f.filter()) {
+		f.activePreset = ""
+		f.message = ""
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) handleFilterInput(msg tea.KeyMsg) {
+	f := m.filterModel
+	key := msg.String()
+
+	switch f.focusedField {
+	case filterFieldDateRange:
+		if key == " " {
+			f.rangePicker = newDateRangePicker(filterDateRanges, f.filter())
+			return
+		}
+		f.dateRangeType = cycleOption(f.dateRangeType, len(filterDateRanges), key)
+	case filterFieldStartDate:
+		f.startDate = editDateInput(f.startDate, msg)
+	case filterFieldEndDate:
+		f.endDate = editDateInput(f.endDate, msg)
+	case filterFieldSource:
+		source := cycleOption(f.filterBySource, len(filterSources), key)
+		if source != f.filterBySource {
+			f.filterBySource = source
+			f.selectedAccountID, f.selectedCardID, f.invoiceFilter = nil, nil, nil
+		}
+	case filterFieldSourceItem:
+		m.cycleFilterSourceItem(key)
+	case filterFieldType:
+		f.typeFilter = cycleOption(f.typeFilter, len(filterTypes), key)
+	case filterFieldPaymentMethod:
+		f.paymentMethodFilter = cycleOption(f.paymentMethodFilter, len(paymentMethodOptions()), key)
+	case filterFieldTag:
+		tags := m.tagFilterOptions()
+		f.tagFilter = tags[cycleOption(indexOf(tags, f.tagFilter), len(tags), key)]
+	case filterFieldCategories:
+		categories := transactionCategories()
+		switch key {
+		case "left", "h", "right", "l":
+			f.categoryCursor = cycleOption(f.categoryCursor, len(categories), key)
+		case " ":
+			category := categories[f.categoryCursor]
+			if f.selectedCategories[category] {
+				delete(f.selectedCategories, category)
+			} else {
+				f.selectedCategories[category] = true
+			}
+		}
+	}
+}
+
+// handleRangePickerKeys drives the period picker opened from the date range
+// field, copying the picked period into the filters
+func (m *TransactionsModel) handleRangePickerKeys(msg tea.KeyMsg) {
+	f := m.filterModel
+	switch f.rangePicker.handleKey(msg) {
+	case dateRangePicked:
+		picked := f.rangePicker.selected()
+		before := f.filter()
+		f.dateRangeType = indexOf(filterDateRanges, picked.DateRange)
+		if picked.DateRange == entity.FilterDateRangeCustom {
+			f.startDate, f.endDate = picked.StartDate, picked.EndDate
+		}
+		if !reflect.DeepEqual(before, f.filter()) {
+			f.activePreset = ""
+		}
+		f.rangePicker = nil
+	case dateRangeCancelled:
+		f.rangePicker = nil
+	}
+}
+
+// moveFilterFocus moves to the next or previous field, skipping the ones that
+// don't apply to the current selection
+func (m *TransactionsModel) moveFilterFocus(step int) {
+	f := m.filterModel
+	for {
+		f.focusedField = (f.focusedField + step + filterFieldCount) % filterFieldCount
+		switch f.focusedField {
+		case filterFieldStartDate, filterFieldEndDate:
+			if filterDateRanges[f.dateRangeType] != entity.FilterDateRangeCustom {
+				continue
+			}
+		case filterFieldSourceItem:
+			if filterSources[f.filterBySource] == entity.FilterSourceAll {
+				continue
+			}
+		}
+		return
+	}
+}
+
+// cycleFilterSourceItem steps through "any" followed by each account or card
+func (m *TransactionsModel) cycleFilterSourceItem(key string) {
+	f := m.filterModel
+
+	var ids []uuid.UUID
+	var selected *uuid.UUID
+	switch filterSources[f.filterBySource] {
+	case entity.FilterSourceAccounts:
+		for _, account := range m.accounts {
+			ids = append(ids, account.ID)
+		}
+		selected = f.selectedAccountID
+	case entity.FilterSourceCards:
+		for _, card := range m.creditCards {
+			ids = append(ids, card.ID)
+		}
+		selected = f.selectedCardID
+	default:
+		return
+	}
+
+	// Index 0 is "any", so the items start at 1
+	current := 0
+	for i, id := range ids {
+		if selected != nil && *selected == id {
+			current = i + 1
+		}
+	}
+
+	next := cycleOption(current, len(ids)+1, key)
+	var choice *uuid.UUID
+	if next > 0 {
+		id := ids[next-1]
+		choice = &id
+	}
+
+	// The invoice picked by a quick filter belongs to the card being changed
+	f.invoiceFilter = nil
+	if filterSources[f.filterBySource] == entity.FilterSourceAccounts {
+		f.selectedAccountID = choice
+	} else {
+		f.selectedCardID = choice
+	}
+}
+
+func (m *TransactionsModel) handlePresetNameKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.filterModel
+
+	switch msg.String() {
+	case "esc":
+		f.namingPreset = false
+	case "enter":
+		if strings.TrimSpace(f.presetName) == "" {
+			f.message = "Preset name is required"
+			return m, nil
+		}
+		return m, m.saveFilterPreset
+	default:
+		f.presetName = editTextInput(f.presetName, msg)
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) handlePresetPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	f := m.filterModel
+
+	switch msg.String() {
+	case "esc":
+		f.pickingPreset = false
+	case "up", "k":
+		if f.selectedPreset > 0 {
+			f.selectedPreset--
+		}
+	case "down", "j":
+		if f.selectedPreset < len(f.presets)-1 {
+			f.selectedPreset++
+		}
+	case "enter":
+		if f.selectedPreset >= len(f.presets) {
+			return m, nil
+		}
+		preset := f.presets[f.selectedPreset]
+		f.setFilter(preset.Filter)
+		f.activePreset = preset.Name
+		f.pickingPreset = false
+		m.applyFilters()
+		m.viewMode = TransactionViewList
+		return m, m.reloadPage(m.currentPage, m.itemsPerPage)
+	case "d":
+		if f.selectedPreset < len(f.presets) {
+			return m, m.deleteFilterPreset(f.presets[f.selectedPreset].ID)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) setFilterPresets(presets []*entity.FilterPreset) {
+	f := m.filterModel
+	f.presets = presets
+	if f.selectedPreset >= len(presets) {
+		f.selectedPreset = len(presets) - 1
+	}
+	if f.selectedPreset < 0 {
+		f.selectedPreset = 0
+	}
+}
+
+func (m *TransactionsModel) renderFilterView() string {
+	f := m.filterModel
+	if f.pickingPreset {
+		return m.renderPresetPicker()
+	}
+	if f.rangePicker != nil {
+		return f.rangePicker.view()
+	}
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🔍 Filter Transactions"))
+
+	if f.activePreset != "" {
+		sections = append(sections, style.InfoStyle.Render("Preset: "+f.activePreset))
+	}
+
+	dateRange := renderDefaultSelector("Date Range:", filterDateRangeNames[f.dateRangeType], f.focusedField == filterFieldDateRange)
+	if period := describePeriod(f.filter(), time.Now()); period != "" && filterDateRanges[f.dateRangeType] != entity.FilterDateRangeCustom {
+		dateRange = lipgloss.JoinHorizontal(lipgloss.Center, dateRange, lipgloss.NewStyle().Foreground(style.TextMuted).MarginLeft(2).Render(period))
+	}
+	fields := []string{dateRange}
+	if filterDateRanges[f.dateRangeType] == entity.FilterDateRangeCustom {
+		fields = append(fields,
+			renderTextField("From (YYYY-MM-DD):", f.startDate, f.focusedField == filterFieldStartDate),
+			renderTextField("To (YYYY-MM-DD):", f.endDate, f.focusedField == filterFieldEndDate),
+		)
+	}
+	fields = append(fields, renderDefaultSelector("Source:", filterSourceNames[f.filterBySource], f.focusedField == filterFieldSource))
+	switch filterSources[f.filterBySource] {
+	case entity.FilterSourceAccounts:
+		name := "Any account"
+		if f.selectedAccountID != nil {
+			name = m.lookup.accountName(*f.selectedAccountID)
+		}
+		fields = append(fields, renderDefaultSelector("Account:", name, f.focusedField == filterFieldSourceItem))
+	case entity.FilterSourceCards:
+		name := "Any card"
+		if f.selectedCardID != nil {
+			name = m.lookup.creditCardName(*f.selectedCardID)
+		}
+		fields = append(fields, renderDefaultSelector("Card:", name, f.focusedField == filterFieldSourceItem))
+	}
+	fields = append(fields, renderDefaultSelector("Type:", filterTypeNames[f.typeFilter], f.focusedField == filterFieldType))
+	paymentMethod := "Any"
+	if method := paymentMethodOptions()[f.paymentMethodFilter]; method != "" {
+		paymentMethod = paymentMethodLabel(method)
+	}
+	fields = append(fields, renderDefaultSelector("Payment Method:", paymentMethod, f.focusedField == filterFieldPaymentMethod))
+	tag := "Any"
+	if f.tagFilter != "" {
+		tag = "#" + f.tagFilter
+	}
+	fields = append(fields, renderDefaultSelector("Tag:", tag, f.focusedField == filterFieldTag))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(fields, "\n")))
+
+	sections = append(sections, m.renderCategoryFilter())
+
+	if f.namingPreset {
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(renderTextField("Preset name:", f.presetName, true)))
+	}
+
+	if f.message != "" {
+		sections = append(sections, style.WarningStyle.Render(f.message))
+	}
+
+	help := "[Tab/↑↓] Navigate • [←/→] Change • [Space] Pick Period / Toggle Category • [Enter] Apply • [s] Save Preset • [p] Presets • [x] Clear • [Esc] Cancel"
+	if f.namingPreset {
+		help = "[Enter] Save Preset • [Esc] Cancel"
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func (m *TransactionsModel) renderCategoryFilter() string {
+	f := m.filterModel
+	focused := f.focusedField == filterFieldCategories
+
+	label := "Categories: all"
+	if len(f.selectedCategories) > 0 {
+		label = fmt.Sprintf("Categories: %d selected", len(f.selectedCategories))
+	}
+	lines := []string{lipgloss.NewStyle().Foreground(style.Text).Bold(true).Render(label)}
+
+	for i, category := range transactionCategories() {
+		check := "[ ]"
+		if f.selectedCategories[category] {
+			check = "[x]"
+		}
+		line := fmt.Sprintf("%s %s", check, categoryDisplayName(category))
+		if focused && i == f.categoryCursor {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		lines = append(lines, line)
+	}
+
+	return lipgloss.NewStyle().MarginTop(1).Render(strings.Join(lines, "\n"))
+}
+
+func (m *TransactionsModel) renderPresetPicker() string {
+	f := m.filterModel
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("⭐ Filter Presets"))
+
+	if len(f.presets) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No presets saved yet. Set up filters and press 's' to save them."))
+	} else {
+		var rows []string
+		for i, preset := range f.presets {
+			line := fmt.Sprintf("%-30s %s", truncateString(preset.Name, 30), m.describeFilter(preset.Filter))
+			if i == f.selectedPreset {
+				line = style.SelectedMenuItemStyle.Render("► " + line)
+			} else {
+				line = style.MenuItemStyle.Render("  " + line)
+			}
+			rows = append(rows, line)
+		}
+		sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(rows, "\n")))
+	}
+
+	help := "[↑/↓] Navigate • [Enter] Apply • [d] Delete • [Esc] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// describeFilter summarizes a filter in one line, such as "This month • Cards only • Food"
+func (m *TransactionsModel) describeFilter(filter entity.TransactionFilter) string {
+	var parts []string
+
+	switch filter.DateRange {
+	case entity.FilterDateRangeCustom:
+		parts = append(parts, filter.StartDate+" to "+filter.EndDate)
+	case entity.FilterDateRangeAll, "":
+	default:
+		parts = append(parts, filterDateRangeNames[indexOf(filterDateRanges, filter.DateRange)])
+	}
+
+	switch {
+	case filter.AccountID != nil:
+		parts = append(parts, m.lookup.accountName(*filter.AccountID))
+	case filter.CreditCardID != nil && filter.InvoiceID != nil:
+		parts = append(parts, m.lookup.creditCardName(*filter.CreditCardID)+", one invoice")
+	case filter.CreditCardID != nil:
+		parts = append(parts, m.lookup.creditCardName(*filter.CreditCardID))
+	case filter.Source == entity.FilterSourceAccounts || filter.Source == entity.FilterSourceCards:
+		parts = append(parts, filterSourceNames[indexOf(filterSources, filter.Source)])
+	}
+
+	if filter.Type == entity.FilterTypeIncome || filter.Type == entity.FilterTypeExpense {
+		parts = append(parts, filterTypeNames[indexOf(filterTypes, filter.Type)])
+	}
+
+	if filter.PaymentMethod != "" {
+		parts = append(parts, paymentMethodLabel(filter.PaymentMethod))
+	}
+
+	if filter.Tag != "" {
+		parts = append(parts, "#"+filter.Tag)
+	}
+
+	if filter.Payee != "" {
+		parts = append(parts, fmt.Sprintf("%q", filter.Payee))
+	}
+
+	for _, category := range filter.Categories {
+		parts = append(parts, categoryDisplayName(category))
+	}
+
+	if len(parts) == 0 {
+		return "No filters"
+	}
+	return strings.Join(parts, " • ")
+}
+
+// renderActiveFilter shows which filters narrow the list, if any
+func (m *TransactionsModel) renderActiveFilter() string {
+	f := m.filterModel
+	if !f.isActive() {
+		return ""
+	}
+
+	text := "Filtered: " + m.describeFilter(f.filter())
+	if f.activePreset != "" {
+		text = fmt.Sprintf("Preset %q: %s", f.activePreset, m.describeFilter(f.filter()))
+	}
+	return style.InfoStyle.Render(text)
+}
@@ -0,0 +1,111 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// transactionGrouping sections the transaction list into days or weeks
+type transactionGrouping int
+
+const (
+	groupingNone transactionGrouping = iota
+	groupingDay
+	groupingWeek
+)
+
+func (g transactionGrouping) next() transactionGrouping {
+	return (g + 1) % 3
+}
+
+func (g transactionGrouping) String() string {
+	switch g {
+	case groupingDay:
+		return "Day"
+	case groupingWeek:
+		return "Week"
+	default:
+		return "None"
+	}
+}
+
+// groupStart returns the first day of the group the date belongs to. Weeks
+// start on Monday.
+func (g transactionGrouping) groupStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	if g == groupingWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		day = day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+func (g transactionGrouping) label(start time.Time) string {
+	if g == groupingWeek {
+		end := start.AddDate(0, 0, 6)
+		return fmt.Sprintf("Week of %s – %s", start.Format("02 Jan"), end.Format("02 Jan 2006"))
+	}
+	return start.Format("Mon, 02 Jan 2006")
+}
+
+type groupSubtotal struct {
+	count   int
+	income  float64
+	expense float64
+}
+
+// groupSubtotals adds up every transaction of each group, not only the ones on
+// the current page, so a header shows the same figures on every page.
+// Transfers are counted but left out of the totals, as in the other summaries,
+// since they only move money between accounts.
+func groupSubtotals(transactions []*entity.Transaction, grouping transactionGrouping) map[time.Time]*groupSubtotal {
+	subtotals := make(map[time.Time]*groupSubtotal)
+	for _, txn := range transactions {
+		key := grouping.groupStart(txn.Date)
+		subtotal, ok := subtotals[key]
+		if !ok {
+			subtotal = &groupSubtotal{}
+			subtotals[key] = subtotal
+		}
+		subtotal.count++
+		if txn.Category == entity.TransactionCategoryTransfer {
+			continue
+		}
+		if txn.Type == entity.TransactionTypeCredit {
+			subtotal.income += txn.Amount.Amount()
+		} else {
+			subtotal.expense += txn.Amount.Amount()
+		}
+	}
+	return subtotals
+}
+
+func renderGroupHeader(grouping transactionGrouping, start time.Time, subtotal *groupSubtotal) string {
+	noun := "transactions"
+	if subtotal.count == 1 {
+		noun = "transaction"
+	}
+
+	var totals []string
+	if subtotal.income > 0 {
+		totals = append(totals, style.SuccessStyle.Render("+"+formatAmount(subtotal.income)))
+	}
+	if subtotal.expense > 0 {
+		totals = append(totals, style.ErrorStyle.Render("-"+formatAmount(subtotal.expense)))
+	}
+	net := subtotal.income - subtotal.expense
+	netStr := style.SuccessStyle.Render("Net " + formatAmount(net))
+	if net < 0 {
+		netStr = style.ErrorStyle.Render("Net " + formatAmount(net))
+	}
+	totals = append(totals, netStr)
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(style.Primary).Render(fmt.Sprintf("── %s (%d %s)", grouping.label(start), subtotal.count, noun))
+	return title + "  " + strings.Join(totals, "  ")
+}
@@ -0,0 +1,109 @@
+package screen
+
+import (
+	"fmt"
+	"strconv"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// inlineEditModel edits the amount and category of the selected transaction
+// right in the list, without opening the full form
+type inlineEditModel struct {
+	transaction  *entity.Transaction
+	focusedField int // 0: amount, 1: category
+	amountInput  string
+	category     int
+	err          error
+}
+
+func (m *TransactionsModel) openInlineEdit() (tea.Model, tea.Cmd) {
+	idx := m.selectedPosition()
+	if idx >= len(m.filteredTransactions) {
+		return m, nil
+	}
+
+	txn := m.filteredTransactions[idx]
+	m.inlineEdit = &inlineEditModel{
+		transaction: txn,
+		amountInput: fmt.Sprintf("%.2f", txn.Amount.Amount()),
+		category:    indexOf(transactionCategories(), txn.Category),
+	}
+	if m.inlineEdit.category < 0 {
+		m.inlineEdit.category = 0
+	}
+	m.viewMode = TransactionViewInlineEdit
+	return m, nil
+}
+
+func (m *TransactionsModel) handleInlineEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	edit := m.inlineEdit
+	categories := transactionCategories()
+
+	switch msg.String() {
+	case "esc":
+		m.inlineEdit = nil
+		m.viewMode = TransactionViewList
+	case "tab", "shift+tab":
+		edit.focusedField = 1 - edit.focusedField
+	case "enter":
+		amount, err := strconv.ParseFloat(edit.amountInput, 64)
+		if err != nil || amount <= 0 {
+			edit.err = fmt.Errorf("invalid amount")
+			return m, nil
+		}
+		category := categories[edit.category]
+		txn := edit.transaction
+
+		m.inlineEdit = nil
+		m.viewMode = TransactionViewList
+		return m, func() tea.Msg {
+			updated, err := m.transactionUseCase.UpdateAmountAndCategory(m.ctx, txn.ID, amount, category)
+			if err != nil {
+				return errMsg{err: err}
+			}
+			return transactionUpdatedMsg{transaction: updated}
+		}
+	case "left", "right":
+		if edit.focusedField == 1 {
+			edit.category = cycleOption(edit.category, len(categories), msg.String())
+		}
+	default:
+		if edit.focusedField == 0 {
+			edit.amountInput = editAmountInput(edit.amountInput, msg)
+			edit.err = nil
+		}
+	}
+
+	return m, nil
+}
+
+// renderInlineEditRow takes the place of the selected row while it is edited
+func (m *TransactionsModel) renderInlineEditRow() string {
+	edit := m.inlineEdit
+	focused := lipgloss.NewStyle().Bold(true).Foreground(style.Primary).Underline(true)
+	blurred := lipgloss.NewStyle().Foreground(style.Text)
+
+	amount := blurred.Render(edit.amountInput)
+	category := blurred.Render(categoryDisplayName(transactionCategories()[edit.category]))
+	if edit.focusedField == 0 {
+		amount = focused.Render(edit.amountInput + "▏")
+	} else {
+		category = focused.Render("< " + categoryDisplayName(transactionCategories()[edit.category]) + " >")
+	}
+
+	row := fmt.Sprintf("%-12s %-25s Amount: %s  Category: %s",
+		edit.transaction.Date.Format("2006-01-02"),
+		truncateString(edit.transaction.Description, 25),
+		amount,
+		category)
+	if edit.err != nil {
+		row += "  " + style.ErrorStyle.Render(edit.err.Error())
+	}
+
+	return style.SelectedMenuItemStyle.Render("✎ " + row)
+}
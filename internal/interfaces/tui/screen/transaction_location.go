@@ -0,0 +1,230 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TransactionLocationModel edits the optional city and venue of a transaction
+type TransactionLocationModel struct {
+	transaction *entity.Transaction
+	cityInput   string
+	venueInput  string
+
+	// Navigation: 0 city, 1 venue, 2 save, 3 cancel
+	focusedField int
+}
+
+// LocationReportModel holds the spend-by-city report shown from the transactions list
+type LocationReportModel struct {
+	reports       []*usecase.LocationReport
+	periodIndex   int
+	selectedIndex int
+}
+
+type locationReportLoadedMsg struct {
+	reports []*usecase.LocationReport
+}
+
+var locationReportPeriods = []string{"This Month", "Last 3 Months", "This Year", "All Time"}
+
+// locationReportRange returns the date range covered by the selected report period
+func locationReportRange(periodIndex int, now time.Time) (time.Time, time.Time) {
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	// End at the close of today rather than now, so the range (and the cached report) stays put all day
+	endOfDay := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, now.Location())
+	switch periodIndex {
+	case 0:
+		return startOfMonth, endOfDay
+	case 1:
+		return startOfMonth.AddDate(0, -2, 0), endOfDay
+	case 2:
+		return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location()), endOfDay
+	default:
+		return time.Time{}, endOfDay
+	}
+}
+
+func (m *TransactionsModel) startLocationEdit(txn *entity.Transaction) {
+	m.locationModel = &TransactionLocationModel{
+		transaction: txn,
+		cityInput:   txn.City,
+		venueInput:  txn.Venue,
+	}
+	m.viewMode = TransactionViewLocation
+}
+
+func (m *TransactionsModel) handleLocationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.locationModel
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = TransactionViewDetails
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 4
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 4) % 4
+	case "enter":
+		if form.focusedField == 2 {
+			m.viewMode = TransactionViewDetails
+			return m, m.saveLocation(form.transaction, form.cityInput, form.venueInput)
+		} else if form.focusedField == 3 {
+			m.viewMode = TransactionViewDetails
+		}
+	default:
+		switch form.focusedField {
+		case 0:
+			form.cityInput = editTextInput(form.cityInput, msg)
+		case 1:
+			form.venueInput = editTextInput(form.venueInput, msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) saveLocation(txn *entity.Transaction, city, venue string) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := m.transactionUseCase.SetLocation(m.ctx, txn.ID, city, venue)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return transactionUpdatedMsg{transaction: updated}
+	}
+}
+
+func (m *TransactionsModel) handleLocationReportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	report := m.locationReport
+
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = TransactionViewList
+	case "up", "k":
+		if report.selectedIndex > 0 {
+			report.selectedIndex--
+		}
+	case "down", "j":
+		if report.selectedIndex < len(report.reports)-1 {
+			report.selectedIndex++
+		}
+	case "left", "h":
+		report.periodIndex = cycleOption(report.periodIndex, len(locationReportPeriods), "left")
+		m.loading = true
+		return m, m.loadLocationReport
+	case "right", "l":
+		report.periodIndex = cycleOption(report.periodIndex, len(locationReportPeriods), "right")
+		m.loading = true
+		return m, m.loadLocationReport
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) loadLocationReport() tea.Msg {
+	startDate, endDate := locationReportRange(m.locationReport.periodIndex, time.Now())
+
+	reports, err := m.reportUseCase.GetLocationReport(m.ctx, startDate, endDate)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return locationReportLoadedMsg{reports: reports}
+}
+
+func (m *TransactionsModel) renderLocationForm() string {
+	form := m.locationModel
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("📍 Location: %s", form.transaction.Description)))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(2, 4).
+		MarginTop(1)
+
+	fields := []string{
+		renderTextField("City:", form.cityInput, form.focusedField == 0),
+		renderTextField("Venue:", form.venueInput, form.focusedField == 1),
+		style.HelpStyle.Render("Leave both empty to clear the location"),
+		renderSubmitCancelButtons("Save Location", form.focusedField, 2),
+	}
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *TransactionsModel) renderLocationReport() string {
+	report := m.locationReport
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🗺️ Spending by City"))
+	sections = append(sections, style.HeaderStyle.Render(fmt.Sprintf("Period: < %s >", locationReportPeriods[report.periodIndex])))
+
+	if len(report.reports) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No expenses with a city in this period. Tag transactions with [l] in their details."))
+	} else {
+		tableStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(style.Border).
+			Padding(1, 2).
+			MarginTop(1)
+
+		headerRow := style.TableHeaderStyle.Render(
+			fmt.Sprintf("%-20s %-14s %-8s %s", "City", "Total", "Count", "Dates"))
+
+		rows := []string{headerRow}
+		for i, city := range report.reports {
+			dates := city.FirstDate.Format("2006-01-02")
+			if !city.LastDate.Equal(city.FirstDate) {
+				dates += " → " + city.LastDate.Format("2006-01-02")
+			}
+
+			row := fmt.Sprintf("%-20s %-14s %-8d %s",
+				truncateString(city.City, 20), formatMoney(city.Total), city.TransactionCount, dates)
+			if i == report.selectedIndex {
+				row = style.SelectedMenuItemStyle.Render("► " + row)
+			} else {
+				row = style.MenuItemStyle.Render("  " + row)
+			}
+			rows = append(rows, row)
+		}
+		sections = append(sections, tableStyle.Render(strings.Join(rows, "\n")))
+
+		if report.selectedIndex < len(report.reports) {
+			sections = append(sections, renderVenueBreakdown(report.reports[report.selectedIndex]))
+		}
+	}
+
+	help := "[↑/↓] Select City • [←/→] Period • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func renderVenueBreakdown(city *usecase.LocationReport) string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(1, 2).
+		MarginTop(1)
+
+	lines := []string{style.HeaderStyle.Render(fmt.Sprintf("Venues in %s", city.City))}
+	for _, venue := range city.Venues {
+		lines = append(lines, fmt.Sprintf("%-24s %-14s %d", truncateString(venue.Venue, 24), formatMoney(venue.Total), venue.TransactionCount))
+	}
+
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,148 @@
+package screen
+
+import (
+	"time"
+
+	"financli/internal/application/usecase"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+)
+
+const (
+	// minItemsPerPage and maxItemsPerPage bound the transaction list's pages:
+	// a page renders every row of it, so very large ones slow down each frame
+	minItemsPerPage = 5
+	maxItemsPerPage = 100
+
+	// itemsPerPageStep is how much + and - change the page size by
+	itemsPerPageStep = 5
+)
+
+// SetItemsPerPage sets how many transactions a page of the list shows,
+// within the bounds
+func (m *TransactionsModel) SetItemsPerPage(n int) {
+	m.resizePage(n)
+}
+
+// resizePage changes the page size keeping the selected transaction selected
+func (m *TransactionsModel) resizePage(n int) {
+	if n < minItemsPerPage {
+		n = minItemsPerPage
+	}
+	if n > maxItemsPerPage {
+		n = maxItemsPerPage
+	}
+
+	idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+	m.itemsPerPage = n
+	m.currentPage = idx / n
+	m.selectedIndex = idx % n
+}
+
+// stepPageSize grows the page size with + and shrinks it with -, in steps
+func (m *TransactionsModel) stepPageSize(key string) {
+	if key == "-" {
+		m.resizePage(m.itemsPerPage - itemsPerPageStep)
+	} else {
+		m.resizePage(m.itemsPerPage + itemsPerPageStep)
+	}
+}
+
+// transactionListRange is the period the transaction list covers, the last
+// year up to tomorrow
+func transactionListRange() (time.Time, time.Time) {
+	return time.Now().AddDate(-1, 0, 0), time.Now().AddDate(0, 0, 1)
+}
+
+// pagesInRepository reports whether the list loads only the page shown. The
+// filters and the grouping's subtotals need every transaction of the period,
+// so with any of them on the list is loaded whole and paged in memory.
+func (m *TransactionsModel) pagesInRepository() bool {
+	return !m.filterModel.isActive() && m.grouping == groupingNone
+}
+
+// reloadPage loads the list again when the repository pages it and the page
+// moved or resized since page and perPage, or when it should switch between
+// paging there and in memory
+func (m *TransactionsModel) reloadPage(page, perPage int) tea.Cmd {
+	paged := m.page != nil
+	if m.pagesInRepository() == paged && (!paged || (m.currentPage == page && m.itemsPerPage == perPage)) {
+		return nil
+	}
+	m.loading = true
+	return m.loadTransactions
+}
+
+// showPage shows the page the repository loaded. A page left empty past the
+// end, such as by deleting its only transaction, moves to the last one.
+func (m *TransactionsModel) showPage(page *usecase.TransactionPage, keepSelection bool) tea.Cmd {
+	var selectedID uuid.UUID
+	if keepSelection && m.selectedIndex < len(m.filteredTransactions) {
+		selectedID = m.filteredTransactions[m.selectedIndex].ID
+	}
+
+	m.page = page
+	m.transactions = page.Transactions
+	m.filteredTransactions = page.Transactions
+	if len(page.Transactions) == 0 && m.currentPage > 0 {
+		m.currentPage = 0
+		if page.Total > 0 {
+			m.currentPage = (page.Total - 1) / m.itemsPerPage
+		}
+		m.loading = true
+		return m.loadTransactions
+	}
+
+	if m.selectedIndex >= len(page.Transactions) {
+		m.selectedIndex = 0
+		if len(page.Transactions) > 0 {
+			m.selectedIndex = len(page.Transactions) - 1
+		}
+	}
+	if keepSelection {
+		for i, txn := range page.Transactions {
+			if txn.ID == selectedID {
+				m.selectedIndex = i
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// listCount is how many transactions the list has across its pages
+func (m *TransactionsModel) listCount() int {
+	if m.page != nil {
+		return m.page.Total
+	}
+	return len(m.filteredTransactions)
+}
+
+// listTotals sums the income and expenses of the list across its pages
+func (m *TransactionsModel) listTotals() (income, expense float64) {
+	if m.page != nil {
+		return m.page.Income, m.page.Expense
+	}
+	return sumTransactions(m.filteredTransactions)
+}
+
+// pageBounds are where the page shown starts and ends in filteredTransactions,
+// which holds only that page while the repository pages the list
+func (m *TransactionsModel) pageBounds() (start, end int) {
+	if m.page != nil {
+		return 0, len(m.filteredTransactions)
+	}
+	start = m.currentPage * m.itemsPerPage
+	end = start + m.itemsPerPage
+	if end > len(m.filteredTransactions) {
+		end = len(m.filteredTransactions)
+	}
+	return start, end
+}
+
+// selectedPosition is where the selected transaction is in filteredTransactions
+func (m *TransactionsModel) selectedPosition() int {
+	start, _ := m.pageBounds()
+	return start + m.selectedIndex
+}
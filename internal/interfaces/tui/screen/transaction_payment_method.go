@@ -0,0 +1,26 @@
+package screen
+
+import "financli/internal/domain/entity"
+
+// Options for the payment method selector on the transaction form and filter.
+// The empty value means "not recorded" on the form and "any" in the filter.
+func paymentMethodOptions() []entity.PaymentMethod {
+	return append([]entity.PaymentMethod{""}, entity.PaymentMethods()...)
+}
+
+func paymentMethodLabel(method entity.PaymentMethod) string {
+	switch method {
+	case entity.PaymentMethodPix:
+		return "Pix"
+	case entity.PaymentMethodBoleto:
+		return "Boleto"
+	case entity.PaymentMethodDebitCard:
+		return "Debit card"
+	case entity.PaymentMethodCash:
+		return "Cash"
+	case entity.PaymentMethodTransfer:
+		return "Transfer"
+	default:
+		return "Not set"
+	}
+}
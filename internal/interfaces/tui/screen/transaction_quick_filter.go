@@ -0,0 +1,143 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// quickFilter narrows the list to the transactions sharing a value with the
+// selected one, on top of the filters already set
+type quickFilter struct {
+	label string
+	apply func(f *TransactionFilterModel)
+}
+
+// quickFilterMenu offers the quick filters of the selected transaction
+type quickFilterMenu struct {
+	filters  []quickFilter
+	selected int
+}
+
+// openQuickFilters offers filters derived from the selected transaction
+func (m *TransactionsModel) openQuickFilters() (tea.Model, tea.Cmd) {
+	idx := m.selectedPosition()
+	if idx >= len(m.filteredTransactions) {
+		return m, nil
+	}
+
+	m.quickFilters = &quickFilterMenu{filters: m.quickFiltersFor(m.filteredTransactions[idx])}
+	m.viewMode = TransactionViewQuickFilter
+	return m, nil
+}
+
+func (m *TransactionsModel) quickFiltersFor(txn *entity.Transaction) []quickFilter {
+	category := txn.Category
+	filters := []quickFilter{{
+		label: "Same category: " + categoryDisplayName(category),
+		apply: func(f *TransactionFilterModel) {
+			f.selectedCategories = map[entity.TransactionCategory]bool{category: true}
+		},
+	}}
+
+	if payee := strings.TrimSpace(txn.Description); payee != "" {
+		filters = append(filters, quickFilter{
+			label: fmt.Sprintf("Same payee: %q", truncateString(payee, 40)),
+			apply: func(f *TransactionFilterModel) {
+				f.payeeFilter = payee
+			},
+		})
+	}
+
+	if txn.CreditCardID != nil {
+		cardID, invoiceID := *txn.CreditCardID, txn.CreditCardInvoiceID
+		label := "Same card: " + m.lookup.creditCardName(cardID)
+		if invoiceID != nil {
+			label = "Same card, this invoice: " + m.lookup.creditCardName(cardID)
+		}
+		filters = append(filters, quickFilter{
+			label: label,
+			apply: func(f *TransactionFilterModel) {
+				f.filterBySource = indexOf(filterSources, entity.FilterSourceCards)
+				f.selectedAccountID, f.selectedCardID = nil, &cardID
+				f.invoiceFilter = invoiceID
+			},
+		})
+	} else if txn.AccountID != nil {
+		accountID := *txn.AccountID
+		filters = append(filters, quickFilter{
+			label: "Same account: " + m.lookup.accountName(accountID),
+			apply: func(f *TransactionFilterModel) {
+				f.filterBySource = indexOf(filterSources, entity.FilterSourceAccounts)
+				f.selectedAccountID, f.selectedCardID = &accountID, nil
+				f.invoiceFilter = nil
+			},
+		})
+	}
+
+	return filters
+}
+
+func (m *TransactionsModel) handleQuickFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	menu := m.quickFilters
+
+	switch key := msg.String(); key {
+	case "esc", "F":
+		m.quickFilters = nil
+		m.viewMode = TransactionViewList
+	case "up", "k":
+		if menu.selected > 0 {
+			menu.selected--
+		}
+	case "down", "j":
+		if menu.selected < len(menu.filters)-1 {
+			menu.selected++
+		}
+	case "enter":
+		return m, m.applyQuickFilter(menu.filters[menu.selected])
+	default:
+		// The number keys pick a filter directly
+		if len(key) == 1 && key[0] >= '1' && int(key[0]-'1') < len(menu.filters) {
+			return m, m.applyQuickFilter(menu.filters[key[0]-'1'])
+		}
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) applyQuickFilter(filter quickFilter) tea.Cmd {
+	filter.apply(m.filterModel)
+	m.filterModel.activePreset = ""
+	m.quickFilters = nil
+	m.viewMode = TransactionViewList
+	m.applyFilters()
+	return m.reloadPage(m.currentPage, m.itemsPerPage)
+}
+
+func (m *TransactionsModel) renderQuickFilterMenu() string {
+	menu := m.quickFilters
+
+	lines := []string{style.HeaderStyle.Render("Quick filter")}
+	for i, filter := range menu.filters {
+		line := fmt.Sprintf("%d. %s", i+1, filter.label)
+		if i == menu.selected {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, style.HelpStyle.Render("Added to the filters already set; [x] in the filter view clears them all"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(0, 1).
+		MarginTop(1).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,126 @@
+package screen
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// receiptScanModel asks for the receipt image read into a new transaction
+type receiptScanModel struct {
+	path     string
+	scanning bool
+	err      error
+}
+
+// receiptScannedMsg carries its error instead of going through errMsg, so a
+// mistyped path can be corrected without leaving the prompt
+type receiptScannedMsg struct {
+	path string
+	scan *usecase.ReceiptScan
+	err  error
+}
+
+func (m *TransactionsModel) openReceiptScan() (tea.Model, tea.Cmd) {
+	m.receiptModel = &receiptScanModel{}
+	m.viewMode = TransactionViewReceipt
+	return m, nil
+}
+
+func (m *TransactionsModel) handleReceiptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	scan := m.receiptModel
+	if scan.scanning {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.receiptModel = nil
+		m.viewMode = TransactionViewList
+	case "enter":
+		// Dragging a file into the terminal pastes its path quoted
+		path := strings.Trim(strings.TrimSpace(scan.path), `"'`)
+		if path == "" {
+			scan.err = fmt.Errorf("image path is required")
+			return m, nil
+		}
+		scan.scanning = true
+		scan.err = nil
+		return m, func() tea.Msg {
+			result, err := m.receiptUseCase.ScanReceipt(m.ctx, path, time.Now())
+			return receiptScannedMsg{path: path, scan: result, err: err}
+		}
+	default:
+		scan.path = editTextInput(scan.path, msg)
+		scan.err = nil
+	}
+
+	return m, nil
+}
+
+// applyReceiptScan opens the new transaction form filled in with what the
+// receipt said, for the user to check and save
+func (m *TransactionsModel) applyReceiptScan(msg receiptScannedMsg) (tea.Model, tea.Cmd) {
+	if m.receiptModel == nil {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.receiptModel.scanning = false
+		m.receiptModel.err = msg.err
+		return m, nil
+	}
+	m.receiptModel = nil
+
+	m.viewMode = TransactionViewForm
+	m.resetForm()
+	// Left blank, so the scanned fields count as changes to keep
+	m.formGuard.open(m.formFingerprint(), false)
+	m.formModel.receiptSource = filepath.Base(msg.path)
+	m.applySourceDefaults()
+	// A receipt is always something paid
+	m.formModel.selectedType = 0
+
+	scan := msg.scan
+	m.formModel.descriptionInput = scan.Merchant
+	if scan.Amount > 0 {
+		m.formModel.amountInput = fmt.Sprintf("%.2f", scan.Amount)
+	}
+	if !scan.Date.IsZero() {
+		m.formModel.dateInput = scan.Date.Format("2006-01-02")
+	}
+
+	return m, tea.Batch(m.loadRecentCategories(), m.loadDescriptionHistory, m.loadCategoryClassifier)
+}
+
+func (m *TransactionsModel) renderReceiptScan() string {
+	scan := m.receiptModel
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("🧾 Scan Receipt"))
+
+	var content strings.Builder
+	content.WriteString("Read the total, date and store from a photo of a receipt into a new transaction.\n")
+	content.WriteString("Nothing is saved until you check the form and submit it.")
+	content.WriteString("\n\n")
+	content.WriteString(style.HeaderStyle.Render("Image path:"))
+	content.WriteString("\n")
+	content.WriteString(style.FocusedInputStyle.Render(scan.path))
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(content.String()))
+
+	if scan.scanning {
+		sections = append(sections, style.InfoStyle.Render("Reading the receipt..."))
+	}
+	if scan.err != nil {
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", scan.err)))
+	}
+
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[Enter] Scan • [Esc] Cancel"))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
@@ -0,0 +1,54 @@
+package screen
+
+import (
+	"strings"
+
+	"financli/internal/domain/entity"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type tagsLoadedMsg struct {
+	tags []string
+}
+
+func (m *TransactionsModel) loadTags() tea.Msg {
+	tags, err := m.transactionUseCase.ListTags(m.ctx)
+	if err != nil {
+		// Like description completions, the form and filter work without them
+		return tagsLoadedMsg{}
+	}
+	return tagsLoadedMsg{tags: tags}
+}
+
+// tagCompletion is the rest of the first known tag starting with the tag being
+// typed, skipping tags already entered, or "" when there is none
+func (m *TransactionsModel) tagCompletion() string {
+	input := m.formModel.tagsInput
+	if input == "" || strings.ContainsAny(input[len(input)-1:], ", ") {
+		return ""
+	}
+
+	entered := entity.ParseTags(input)
+	typed := entered[len(entered)-1]
+	for _, tag := range m.tags {
+		if len(tag) > len(typed) && strings.HasPrefix(tag, typed) && !containsString(entered, tag) {
+			return tag[len(typed):]
+		}
+	}
+	return ""
+}
+
+// tagFilterOptions lists the tags the filter cycles through, "" meaning any tag
+func (m *TransactionsModel) tagFilterOptions() []string {
+	return append([]string{""}, m.tags...)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,11 @@ type TransactionsModel struct {
 	creditCardInvoiceUseCase *usecase.CreditCardInvoiceUseCase
 	billUseCase              *usecase.BillUseCase
 	personUseCase            *usecase.PersonUseCase
+	reportUseCase            *usecase.ReportUseCase
+	changeHistoryUseCase     *usecase.ChangeHistoryUseCase
+	filterPresetUseCase      *usecase.FilterPresetUseCase
+	suggestionUseCase        *usecase.CategorySuggestionUseCase
+	receiptUseCase           *usecase.ReceiptUseCase
 
 	// Data
 	transactions         []*entity.Transaction
@@ -31,21 +36,31 @@ type TransactionsModel struct {
 	accounts             []*entity.Account
 	creditCards          []*entity.CreditCard
 	people               []*entity.Person
+	tags                 []string // Every tag in use, offered as completions and filters
+	lookup               lookupIndex
 
 	// View state
 	selectedIndex int
 	viewMode      TransactionViewMode
+	grouping      transactionGrouping
+	totals        totalsScope
 
 	// Pagination
 	currentPage  int
 	itemsPerPage int
+	// page is set while the repository pages the list, holding only the page shown
+	page *usecase.TransactionPage
 
 	// Loading and errors
 	loading bool
 	err     error
 
+	// Auto-refresh state
+	refresh autoRefresh
+
 	// Form state
 	formModel *TransactionFormModel
+	formGuard[*TransactionFormModel]
 
 	// Filter state
 	filterModel *TransactionFilterModel
@@ -56,6 +71,25 @@ type TransactionsModel struct {
 	// Invoice state
 	invoiceModel *InvoiceViewModel
 
+	// Location state
+	locationModel  *TransactionLocationModel
+	locationReport *LocationReportModel
+
+	// Business mode shows the client and project of business expenses
+	businessMode  bool
+	businessModel *TransactionBusinessModel
+
+	// Receipt scan state
+	receiptModel *receiptScanModel
+
+	// Change history state
+	historyModel *ChangeHistoryModel
+
+	// Inline edit state
+	inlineEdit *inlineEditModel
+	// quickFilters, while open, offers filters derived from the selected row
+	quickFilters *quickFilterMenu
+
 	// Confirmation state
 	showConfirmDelete bool
 	confirmMessage    string
@@ -76,6 +110,13 @@ const (
 	TransactionViewConfirm
 	TransactionViewInvoices
 	TransactionViewInvoiceTransactions
+	TransactionViewLocation
+	TransactionViewLocationReport
+	TransactionViewHistory
+	TransactionViewInlineEdit
+	TransactionViewBusiness
+	TransactionViewReceipt
+	TransactionViewQuickFilter
 )
 
 type TransactionFormModel struct {
@@ -87,14 +128,27 @@ type TransactionFormModel struct {
 	// Selection states
 	selectedType     int // 0: expense, 1: income
 	selectedCategory int
-	selectedSource   int // 0: account, 1: credit card
+	selectedSource   int // 0: account, 1: credit card, 2: cash
 	selectedAccount  int
 	selectedCard     int
+	selectedPaymentMethod int // Index into paymentMethodOptions, 0 when not recorded
+
+	// Category ordering learned from the selected source's history
+	categoryOrder    []entity.TransactionCategory
+	recentCategories map[entity.TransactionCategory]bool
+	// Past descriptions, most used first, offered as completions
+	descriptionHistory []string
+	// Learned from past transactions to suggest the category of the description
+	classifier *entity.CategoryClassifier
+	categoryTouched  bool
+	// Image the form was pre-filled from, when scanned from a receipt
+	receiptSource string
 
 	// Input fields
 	descriptionInput string
 	amountInput      string
 	dateInput        string
+	tagsInput        string // Comma-separated
 
 	// Sharing fields
 	enableSharing    bool
@@ -111,9 +165,10 @@ type TransactionFormModel struct {
 
 type TransactionFilterModel struct {
 	// Date range
-	dateRangeType int // 0: all, 1: today, 2: this week, 3: this month, 4: custom
+	dateRangeType int // Index into filterDateRanges
 	startDate     string
 	endDate       string
+	rangePicker   *dateRangePicker // Open while picking the period from the presets
 
 	// Category filter
 	selectedCategories map[entity.TransactionCategory]bool
@@ -126,9 +181,31 @@ type TransactionFilterModel struct {
 	// Type filter
 	typeFilter int // 0: all, 1: income only, 2: expense only
 
+	// Payment method filter
+	paymentMethodFilter int // Index into paymentMethodOptions, 0 for any
+	tagFilter           string // Empty for any
+
+	// Quick filters from a row, see transaction_quick_filter.go
+	payeeFilter   string     // Empty for any
+	invoiceFilter *uuid.UUID // Nil for any
+
 	// Navigation
 	focusedSection int
 	focusedField   int
+	categoryCursor int
+
+	// Filters when the view was opened, restored on cancel
+	previous       entity.TransactionFilter
+	previousPreset string
+
+	// Presets
+	presets        []*entity.FilterPreset
+	selectedPreset int
+	activePreset   string // Name of the recalled preset, cleared once the filters change
+	pickingPreset  bool
+	namingPreset   bool
+	presetName     string
+	message        string
 }
 
 type SharedExpenseModel struct {
@@ -163,10 +240,17 @@ type InvoiceViewModel struct {
 
 	// Pagination for transactions
 	currentTransactionPage int
+
+	// List filters
+	filters InvoiceFilterState
+
+	// Paying the selected invoice
+	payment *invoicePaymentForm
+	message string
 }
 
-func NewTransactionsModel(ctx context.Context, txnUC *usecase.TransactionUseCase, accountUC *usecase.AccountUseCase, cardUC *usecase.CreditCardUseCase, invoiceUC *usecase.CreditCardInvoiceUseCase, billUC *usecase.BillUseCase, personUC *usecase.PersonUseCase) tea.Model {
-	return &TransactionsModel{
+func NewTransactionsModel(ctx context.Context, txnUC *usecase.TransactionUseCase, accountUC *usecase.AccountUseCase, cardUC *usecase.CreditCardUseCase, invoiceUC *usecase.CreditCardInvoiceUseCase, billUC *usecase.BillUseCase, personUC *usecase.PersonUseCase, reportUC *usecase.ReportUseCase, historyUC *usecase.ChangeHistoryUseCase, presetUC *usecase.FilterPresetUseCase, suggestionUC *usecase.CategorySuggestionUseCase, receiptUC *usecase.ReceiptUseCase, draftUC *usecase.FormDraftUseCase) tea.Model {
+	m := &TransactionsModel{
 		ctx:                      ctx,
 		transactionUseCase:       txnUC,
 		accountUseCase:           accountUC,
@@ -174,6 +258,11 @@ func NewTransactionsModel(ctx context.Context, txnUC *usecase.TransactionUseCase
 		creditCardInvoiceUseCase: invoiceUC,
 		billUseCase:              billUC,
 		personUseCase:            personUC,
+		reportUseCase:            reportUC,
+		changeHistoryUseCase:     historyUC,
+		filterPresetUseCase:      presetUC,
+		suggestionUseCase:        suggestionUC,
+		receiptUseCase:           receiptUC,
 		viewMode:                 TransactionViewList,
 		loading:                  true,
 		itemsPerPage:             10,
@@ -198,7 +287,28 @@ func NewTransactionsModel(ctx context.Context, txnUC *usecase.TransactionUseCase
 			selectedTransactionIndex: 0,
 			currentTransactionPage: 0,
 		},
+		locationModel:  &TransactionLocationModel{},
+		locationReport: &LocationReportModel{},
+	}
+	m.formGuard.screen = formScreen[*TransactionFormModel]{
+		form:        &m.formModel,
+		fingerprint: m.formFingerprint,
+		editing:     func() bool { return m.formModel.editing },
+		showing:     func() bool { return m.viewMode == TransactionViewForm },
+		reset: func() {
+			m.resetForm()
+			m.applySourceDefaults()
+		},
+		list:        func() { m.viewMode = TransactionViewList },
+		fields:      m.draftFields,
+		restore: func(fields map[string]string) tea.Cmd {
+			m.applyDraftFields(fields)
+			return m.loadRecentCategories()
+		},
 	}
+	// The new transaction's draft is kept across runs
+	m.formGuard.store = newDraftStore(ctx, draftUC, entity.FormDraftTransaction)
+	return m
 }
 
 func (m *TransactionsModel) Init() tea.Cmd {
@@ -207,10 +317,26 @@ func (m *TransactionsModel) Init() tea.Cmd {
 		m.loadAccounts,
 		m.loadCreditCards,
 		m.loadPeople,
+		m.loadTags,
+		m.refresh.start(),
 	)
 }
 
+// SetRefreshInterval reloads the transaction list every interval while it is shown; zero disables it
+func (m *TransactionsModel) SetRefreshInterval(interval time.Duration) {
+	m.refresh.interval = interval
+}
+
+// SetBusinessMode enables tagging transactions with a client and project
+func (m *TransactionsModel) SetBusinessMode(enabled bool) {
+	m.businessMode = enabled
+}
+
 func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, handled := m.formGuard.update(msg); handled {
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -219,39 +345,147 @@ func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case transactionsLoadedMsg:
 		m.loading = false
+		if msg.page != nil {
+			return m, m.showPage(msg.page, msg.keepSelection)
+		}
+		m.page = nil
+		var selectedID uuid.UUID
+		if msg.keepSelection && m.selectedIndex < len(m.filteredTransactions) {
+			selectedID = m.filteredTransactions[m.selectedIndex].ID
+		}
 		m.transactions = msg.transactions
 		m.applyFilters()
+		if msg.keepSelection {
+			for i, txn := range m.filteredTransactions {
+				if txn.ID == selectedID {
+					m.selectedIndex = i
+					break
+				}
+			}
+		}
 		return m, nil
 
+	case refreshMsg:
+		if !m.refresh.due(msg) {
+			return m, nil
+		}
+		// Forms and detail views are left alone so the refresh never discards what is being edited
+		if m.viewMode != TransactionViewList {
+			return m, m.refresh.tick()
+		}
+		return m, tea.Batch(m.refreshTransactions, m.refresh.tick())
+
 	case accountsLoadedMsg:
 		m.accounts = msg.accounts
+		m.lookup.setAccounts(msg.accounts)
 		return m, nil
 
 	case creditCardsLoadedMsg:
 		m.creditCards = msg.creditCards
+		m.lookup.setCreditCards(msg.creditCards)
 		return m, nil
 
 	case peopleLoadedMsg:
 		m.people = msg.people
+		m.lookup.setPeople(msg.people)
+		return m, nil
+
+	case descriptionHistoryLoadedMsg:
+		m.formModel.descriptionHistory = msg.descriptions
+		return m, nil
+
+	case tagsLoadedMsg:
+		m.tags = msg.tags
+		return m, nil
+
+	case categoryClassifierLoadedMsg:
+		m.formModel.classifier = msg.classifier
+		// The scanned store name is there before the classifier
+		if m.formModel.receiptSource != "" {
+			m.applyCategorySuggestion()
+		}
+		return m, nil
+
+	case receiptScannedMsg:
+		return m.applyReceiptScan(msg)
+
+	case recentCategoriesLoadedMsg:
+		if msg.sourceKey == m.formSourceKey() {
+			m.applyCategoryOrder(msg.categories)
+		}
 		return m, nil
 
 	case transactionActionMsg:
 		m.loading = false
+		var draftCmd tea.Cmd
+		if m.viewMode == TransactionViewForm && !m.formModel.editing {
+			draftCmd = m.savedForm()
+		}
 		m.viewMode = TransactionViewList
 		m.resetForm()
 		m.resetSharedModel()
-		return m, m.loadTransactions
+		return m, tea.Batch(m.loadTransactions, draftCmd)
+
+	case transactionUpdatedMsg:
+		m.replaceTransaction(msg.transaction)
+		return m, nil
+
+	case locationReportLoadedMsg:
+		m.loading = false
+		m.locationReport.reports = msg.reports
+		m.locationReport.selectedIndex = 0
+		return m, nil
 
 	case invoicesLoadedMsg:
 		m.loading = false
 		m.invoiceModel.invoices = msg.invoices
 		return m, nil
 
+	case editorFinishedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+		} else if msg.field == "description" {
+			m.formModel.descriptionInput = msg.value
+		}
+		return m, nil
+
+	case changeHistoryLoadedMsg:
+		m.loading = false
+		if m.historyModel != nil {
+			m.historyModel.setRecords(msg.records)
+		}
+		return m, nil
+
+	case changeRevertedMsg:
+		return m, tea.Batch(m.loadTransactions, m.historyModel.load(m.ctx, m.changeHistoryUseCase))
+
+	case filterPresetsLoadedMsg:
+		m.setFilterPresets(msg.presets)
+		return m, nil
+
+	case filterPresetSavedMsg:
+		m.filterModel.namingPreset = false
+		m.filterModel.activePreset = msg.preset.Name
+		m.filterModel.message = fmt.Sprintf("Saved preset %q", msg.preset.Name)
+		return m, m.loadFilterPresets
+
+	case filterPresetDeletedMsg:
+		return m, m.loadFilterPresets
+
 	case invoiceTransactionsLoadedMsg:
 		m.loading = false
 		m.invoiceModel.invoiceTransactions = msg.transactions
 		return m, nil
 
+	case invoicePaidMsg:
+		return m, m.updateInvoicePaid(msg)
+
+	case invoicePaymentFailedMsg:
+		if m.invoiceModel.payment != nil {
+			m.invoiceModel.payment.err = msg.err
+		}
+		return m, nil
+
 	case errMsg:
 		m.loading = false
 		m.err = msg.err
@@ -262,7 +496,9 @@ func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case TransactionViewList:
 			return m.handleListKeys(msg)
 		case TransactionViewForm:
-			return m.handleFormKeys(msg)
+			before := m.formFingerprint()
+			model, cmd := m.handleFormKeys(msg)
+			return model, tea.Batch(cmd, m.formGuard.autosave(before))
 		case TransactionViewDetails:
 			return m.handleDetailsKeys(msg)
 		case TransactionViewShared:
@@ -275,6 +511,20 @@ func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleInvoicesKeys(msg)
 		case TransactionViewInvoiceTransactions:
 			return m.handleInvoiceTransactionsKeys(msg)
+		case TransactionViewLocation:
+			return m.handleLocationKeys(msg)
+		case TransactionViewLocationReport:
+			return m.handleLocationReportKeys(msg)
+		case TransactionViewHistory:
+			return m.handleHistoryKeys(msg)
+		case TransactionViewInlineEdit:
+			return m.handleInlineEditKeys(msg)
+		case TransactionViewBusiness:
+			return m.handleBusinessKeys(msg)
+		case TransactionViewReceipt:
+			return m.handleReceiptKeys(msg)
+		case TransactionViewQuickFilter:
+			return m.handleQuickFilterKeys(msg)
 		}
 	}
 
@@ -291,7 +541,7 @@ func (m *TransactionsModel) View() string {
 	}
 
 	switch m.viewMode {
-	case TransactionViewList:
+	case TransactionViewList, TransactionViewInlineEdit, TransactionViewQuickFilter:
 		return m.renderTransactionsList()
 	case TransactionViewForm:
 		return m.renderTransactionForm()
@@ -307,6 +557,16 @@ func (m *TransactionsModel) View() string {
 		return m.renderInvoicesList()
 	case TransactionViewInvoiceTransactions:
 		return m.renderInvoiceTransactions()
+	case TransactionViewLocation:
+		return m.renderLocationForm()
+	case TransactionViewLocationReport:
+		return m.renderLocationReport()
+	case TransactionViewHistory:
+		return m.historyModel.render()
+	case TransactionViewBusiness:
+		return m.renderBusinessForm()
+	case TransactionViewReceipt:
+		return m.renderReceiptScan()
 	}
 
 	return ""
@@ -314,11 +574,16 @@ func (m *TransactionsModel) View() string {
 
 // Helper functions for loading data
 func (m *TransactionsModel) loadTransactions() tea.Msg {
-	transactions, err := m.transactionUseCase.GetTransactionsByDateRange(
-		m.ctx,
-		time.Now().AddDate(-1, 0, 0), // Last year
-		time.Now().AddDate(0, 0, 1),  // Tomorrow
-	)
+	start, end := transactionListRange()
+	if m.pagesInRepository() {
+		page, err := m.transactionUseCase.GetTransactionPage(m.ctx, start, end, m.currentPage*m.itemsPerPage, m.itemsPerPage)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return transactionsLoadedMsg{page: page}
+	}
+
+	transactions, err := m.transactionUseCase.GetTransactionsByDateRange(m.ctx, start, end)
 	if err != nil {
 		return errMsg{err: err}
 	}
@@ -326,6 +591,16 @@ func (m *TransactionsModel) loadTransactions() tea.Msg {
 	return transactionsLoadedMsg{transactions: transactions}
 }
 
+// refreshTransactions reloads the list keeping the selected transaction selected
+func (m *TransactionsModel) refreshTransactions() tea.Msg {
+	msg := m.loadTransactions()
+	if loaded, ok := msg.(transactionsLoadedMsg); ok {
+		loaded.keepSelection = true
+		return loaded
+	}
+	return msg
+}
+
 func (m *TransactionsModel) loadAccounts() tea.Msg {
 	accounts, err := m.accountUseCase.ListAccounts(m.ctx)
 	if err != nil {
@@ -378,6 +653,24 @@ func (m *TransactionsModel) applyFilters() {
 			continue
 		}
 
+		// Payment method filter
+		if method := paymentMethodOptions()[m.filterModel.paymentMethodFilter]; method != "" && txn.PaymentMethod != method {
+			continue
+		}
+
+		// Tag filter
+		if tag := m.filterModel.tagFilter; tag != "" && !txn.HasTag(tag) {
+			continue
+		}
+
+		// Payee and invoice filters
+		if !(entity.TransactionFilter{Payee: m.filterModel.payeeFilter}).MatchesPayee(txn.Description) {
+			continue
+		}
+		if id := m.filterModel.invoiceFilter; id != nil && (txn.CreditCardInvoiceID == nil || *txn.CreditCardInvoiceID != *id) {
+			continue
+		}
+
 		filtered = append(filtered, txn)
 	}
 
@@ -387,30 +680,11 @@ func (m *TransactionsModel) applyFilters() {
 }
 
 func (m *TransactionsModel) matchesDateFilter(txn *entity.Transaction) bool {
-	switch m.filterModel.dateRangeType {
-	case 0: // All
+	start, end, bounded := m.filterModel.filter().Period(time.Now())
+	if !bounded {
 		return true
-	case 1: // Today
-		today := time.Now().Truncate(24 * time.Hour)
-		txnDate := txn.Date.Truncate(24 * time.Hour)
-		return txnDate.Equal(today)
-	case 2: // This week
-		now := time.Now()
-		weekStart := now.AddDate(0, 0, -int(now.Weekday()))
-		weekEnd := weekStart.AddDate(0, 0, 7)
-		return txn.Date.After(weekStart) && txn.Date.Before(weekEnd)
-	case 3: // This month
-		now := time.Now()
-		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-		monthEnd := monthStart.AddDate(0, 1, 0)
-		return txn.Date.After(monthStart) && txn.Date.Before(monthEnd)
-	case 4: // Custom
-		// Parse custom date range
-		startDate, _ := time.Parse("2006-01-02", m.filterModel.startDate)
-		endDate, _ := time.Parse("2006-01-02", m.filterModel.endDate)
-		return txn.Date.After(startDate) && txn.Date.Before(endDate.AddDate(0, 0, 1))
 	}
-	return true
+	return !txn.Date.Before(start) && txn.Date.Before(end)
 }
 
 func (m *TransactionsModel) matchesSourceFilter(txn *entity.Transaction) bool {
@@ -481,7 +755,9 @@ func (m *TransactionsModel) IsInFormMode() bool {
 
 // Message types
 type transactionsLoadedMsg struct {
-	transactions []*entity.Transaction
+	transactions  []*entity.Transaction
+	page          *usecase.TransactionPage
+	keepSelection bool
 }
 
 type creditCardsLoadedMsg struct {
@@ -494,17 +770,23 @@ type peopleLoadedMsg struct {
 
 type transactionActionMsg struct{}
 
+type recentCategoriesLoadedMsg struct {
+	sourceKey  string
+	categories []entity.TransactionCategory
+}
+
+type transactionUpdatedMsg struct {
+	transaction *entity.Transaction
+}
+
 
 
 // Key handler for list view
 func (m *TransactionsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	totalPages := (len(m.filteredTransactions) + m.itemsPerPage - 1) / m.itemsPerPage
-	pageStart := m.currentPage * m.itemsPerPage
-	pageEnd := pageStart + m.itemsPerPage
-	if pageEnd > len(m.filteredTransactions) {
-		pageEnd = len(m.filteredTransactions)
-	}
+	totalPages := (m.listCount() + m.itemsPerPage - 1) / m.itemsPerPage
+	pageStart, pageEnd := m.pageBounds()
 	itemsOnPage := pageEnd - pageStart
+	page, perPage := m.currentPage, m.itemsPerPage
 
 	switch msg.String() {
 	case "up", "k":
@@ -542,13 +824,20 @@ func (m *TransactionsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		m.formModel.editing = false
 		m.formModel.editingID = nil
 		m.resetForm()
+		m.applySourceDefaults()
+		draftCmd := m.openForm()
+		return m, tea.Batch(m.loadRecentCategories(), m.loadDescriptionHistory, m.loadCategoryClassifier, m.loadTags, draftCmd)
 	case "e":
 		if len(m.filteredTransactions) > 0 {
 			return m.editTransaction()
 		}
+	case "a":
+		if len(m.filteredTransactions) > 0 {
+			return m.openInlineEdit()
+		}
 	case "d":
 		if len(m.filteredTransactions) > 0 {
-			idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+			idx := m.selectedPosition()
 			if idx < len(m.filteredTransactions) {
 				m.viewMode = TransactionViewConfirm
 				m.showConfirmDelete = true
@@ -556,7 +845,7 @@ func (m *TransactionsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		}
 	case "s":
 		if len(m.filteredTransactions) > 0 {
-			idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+			idx := m.selectedPosition()
 			if idx < len(m.filteredTransactions) {
 				txn := m.filteredTransactions[idx]
 				m.sharedModel.transactionID = txn.ID
@@ -565,11 +854,29 @@ func (m *TransactionsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 			}
 		}
 	case "f":
-		m.viewMode = TransactionViewFilter
+		return m.openFilterView(false)
+	case "F":
+		if len(m.filteredTransactions) > 0 {
+			return m.openQuickFilters()
+		}
+	case "p":
+		return m.openFilterView(true)
+	case "o":
+		return m.openReceiptScan()
+	case "g":
+		m.grouping = m.grouping.next()
+	case "t":
+		m.totals = m.totals.next()
+	case "+", "=", "-":
+		m.stepPageSize(msg.String())
 	case "i":
 		m.viewMode = TransactionViewInvoices
 		m.loading = true
 		return m, m.loadAllInvoices
+	case "c":
+		m.viewMode = TransactionViewLocationReport
+		m.loading = true
+		return m, m.loadLocationReport
 	case "r":
 		m.loading = true
 		return m, tea.Batch(
@@ -582,29 +889,56 @@ func (m *TransactionsModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		return m, func() tea.Msg { return BackToDashboardMsg{} }
 	}
 
-	return m, nil
+	return m, m.reloadPage(page, perPage)
 }
 
 func (m *TransactionsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Calculate total fields based on whether sharing is enabled
-	totalFields := 9 // description, type, category, amount, date, source, account/card, submit, cancel
+	totalFields := 11 // description, type, category, amount, date, source, account/card, payment method, tags, submit, cancel
 	if m.formModel.selectedType == 0 && m.formModel.enableSharing { // Expense with sharing
-		totalFields = 12 // + sharing toggle, person, percentage
+		totalFields = 14 // + sharing toggle, person, percentage
 	}
 
 	// Calculate submit/cancel field indices
-	submitFieldIndex := 7
-	cancelFieldIndex := 8
+	submitFieldIndex := 9
+	cancelFieldIndex := 10
 	if m.formModel.selectedType == 0 && m.formModel.enableSharing {
-		submitFieldIndex = 10
-		cancelFieldIndex = 11
+		submitFieldIndex = 12
+		cancelFieldIndex = 13
 	}
 
+	if handled, discarded := m.formGuard.handlePromptKeys(msg.String()); handled {
+		if discarded {
+			m.closeForm()
+		}
+		return m, nil
+	}
 	switch msg.String() {
 	case "esc":
-		m.viewMode = TransactionViewList
-		m.resetForm()
+		return m, m.leaveForm()
+	case "ctrl+x":
+		if m.formGuard.fromDraft {
+			return m, m.discardDraft()
+		}
+	case "ctrl+e":
+		if m.formModel.focusedField == 0 {
+			return m, openInEditor("description", m.formModel.descriptionInput, true)
+		}
 	case "tab", "down":
+		// Tab on the description takes the suggested completion before moving on
+		if completion := m.descriptionCompletion(); msg.String() == "tab" && m.formModel.focusedField == 0 && completion != "" {
+			m.formModel.descriptionInput = completion
+			return m, nil
+		}
+		// Tab on the tags completes the tag being typed
+		if completion := m.tagCompletion(); msg.String() == "tab" && m.formModel.focusedField == 8 && completion != "" {
+			m.formModel.tagsInput += completion + ", "
+			return m, nil
+		}
+		// Leaving the description pre-selects the category it suggests
+		if m.formModel.focusedField == 0 {
+			m.applyCategorySuggestion()
+		}
 		m.formModel.focusedField = (m.formModel.focusedField + 1) % totalFields
 	case "shift+tab", "up":
 		m.formModel.focusedField = (m.formModel.focusedField - 1 + totalFields) % totalFields
@@ -614,8 +948,7 @@ func (m *TransactionsModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 			return m.submitForm()
 		} else if m.formModel.focusedField == cancelFieldIndex {
 			// Cancel button
-			m.viewMode = TransactionViewList
-			m.resetForm()
+			return m, m.leaveForm()
 		}
 	default:
 		return m.handleFormInput(msg)
@@ -634,25 +967,77 @@ func (m *TransactionsModel) handleDetailsKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 		m.viewMode = TransactionViewConfirm
 		m.showConfirmDelete = true
 	case "s":
-		idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+		idx := m.selectedPosition()
 		if idx < len(m.filteredTransactions) {
 			txn := m.filteredTransactions[idx]
 			m.sharedModel.transactionID = txn.ID
 			m.sharedModel.transaction = txn
 			m.viewMode = TransactionViewShared
 		}
+	case "i":
+		idx := m.selectedPosition()
+		if idx < len(m.filteredTransactions) {
+			return m, m.toggleIgnoreFromBudget(m.filteredTransactions[idx])
+		}
+	case "l":
+		idx := m.selectedPosition()
+		if idx < len(m.filteredTransactions) {
+			m.startLocationEdit(m.filteredTransactions[idx])
+		}
+	case "w":
+		idx := m.selectedPosition()
+		if m.businessMode && idx < len(m.filteredTransactions) {
+			m.startBusinessEdit(m.filteredTransactions[idx])
+		}
+	case "h":
+		idx := m.selectedPosition()
+		if idx < len(m.filteredTransactions) {
+			txn := m.filteredTransactions[idx]
+			m.historyModel = newChangeHistoryModel(entity.ChangeEntityTransaction, txn.ID, txn.Description)
+			m.viewMode = TransactionViewHistory
+			m.loading = true
+			return m, m.historyModel.load(m.ctx, m.changeHistoryUseCase)
+		}
 	}
 
 	return m, nil
 }
 
-func (m *TransactionsModel) handleSharedKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// TODO: Implement shared expense key handling
-	return m, nil
+func (m *TransactionsModel) handleHistoryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "b":
+		m.viewMode = TransactionViewDetails
+		return m, nil
+	}
+	return m, m.historyModel.handleKeys(m.ctx, m.changeHistoryUseCase, msg.String())
 }
 
-func (m *TransactionsModel) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// TODO: Implement filter key handling
+func (m *TransactionsModel) toggleIgnoreFromBudget(txn *entity.Transaction) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := m.transactionUseCase.SetIgnoreFromBudget(m.ctx, txn.ID, !txn.IgnoreFromBudget)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return transactionUpdatedMsg{transaction: updated}
+	}
+}
+
+// replaceTransaction swaps an updated transaction into the loaded lists in place
+func (m *TransactionsModel) replaceTransaction(updated *entity.Transaction) {
+	for i, txn := range m.transactions {
+		if txn.ID == updated.ID {
+			m.transactions[i] = updated
+		}
+	}
+	for i, txn := range m.filteredTransactions {
+		if txn.ID == updated.ID {
+			m.filteredTransactions[i] = updated
+		}
+	}
+}
+
+func (m *TransactionsModel) handleSharedKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// TODO: Implement shared expense key handling
 	return m, nil
 }
 
@@ -678,7 +1063,7 @@ func (m *TransactionsModel) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 
 // Helper method to edit a transaction
 func (m *TransactionsModel) editTransaction() (tea.Model, tea.Cmd) {
-	idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+	idx := m.selectedPosition()
 	if idx >= len(m.filteredTransactions) {
 		return m, nil
 	}
@@ -701,7 +1086,7 @@ func (m *TransactionsModel) editTransaction() (tea.Model, tea.Cmd) {
 	}
 
 	// Set category
-	categories := m.getCategories()
+	categories := m.getFormCategories()
 	for i, cat := range categories {
 		if cat == txn.Category {
 			m.formModel.selectedCategory = i
@@ -726,51 +1111,190 @@ func (m *TransactionsModel) editTransaction() (tea.Model, tea.Cmd) {
 				break
 			}
 		}
+	} else {
+		m.formModel.selectedSource = 2
 	}
 
-	return m, nil
+	m.formModel.selectedPaymentMethod = indexOf(paymentMethodOptions(), txn.PaymentMethod)
+	m.formModel.tagsInput = strings.Join(txn.Tags, ", ")
+	m.formGuard.open(m.formFingerprint(), false)
+
+	return m, tea.Batch(m.loadRecentCategories(), m.loadTags)
+}
+
+// recentCategoriesWindow is how far back category usage is considered when ordering the selector
+const recentCategoriesWindow = 90 * 24 * time.Hour
+
+// formSourceKey identifies the account or card currently selected in the form
+func (m *TransactionsModel) formSourceKey() string {
+	if m.formModel.selectedSource == 0 && m.formModel.selectedAccount < len(m.accounts) {
+		return "account:" + m.accounts[m.formModel.selectedAccount].ID.String()
+	}
+	if m.formModel.selectedSource == 1 && m.formModel.selectedCard < len(m.creditCards) {
+		return "card:" + m.creditCards[m.formModel.selectedCard].ID.String()
+	}
+	return ""
+}
+
+func (m *TransactionsModel) loadRecentCategories() tea.Cmd {
+	var accountID, creditCardID *uuid.UUID
+	if m.formModel.selectedSource == 0 && m.formModel.selectedAccount < len(m.accounts) {
+		accountID = &m.accounts[m.formModel.selectedAccount].ID
+	} else if m.formModel.selectedSource == 1 && m.formModel.selectedCard < len(m.creditCards) {
+		creditCardID = &m.creditCards[m.formModel.selectedCard].ID
+	}
+	if accountID == nil && creditCardID == nil {
+		return nil
+	}
+
+	sourceKey := m.formSourceKey()
+	return func() tea.Msg {
+		categories, err := m.transactionUseCase.GetRecentCategories(m.ctx, accountID, creditCardID, time.Now().Add(-recentCategoriesWindow))
+		if err != nil {
+			// Ordering is a convenience, fall back to the default list
+			return recentCategoriesLoadedMsg{sourceKey: sourceKey}
+		}
+		return recentCategoriesLoadedMsg{sourceKey: sourceKey, categories: categories}
+	}
+}
+
+// applyCategoryOrder puts recently used categories first, keeping the current
+// choice unless the user hasn't picked one yet on a new transaction
+func (m *TransactionsModel) applyCategoryOrder(recent []entity.TransactionCategory) {
+	current := m.getFormCategories()[m.formModel.selectedCategory]
+
+	order := make([]entity.TransactionCategory, 0, len(m.getCategories()))
+	m.formModel.recentCategories = make(map[entity.TransactionCategory]bool)
+	for _, cat := range recent {
+		m.formModel.recentCategories[cat] = true
+		order = append(order, cat)
+	}
+	for _, cat := range m.getCategories() {
+		if !m.formModel.recentCategories[cat] {
+			order = append(order, cat)
+		}
+	}
+	m.formModel.categoryOrder = order
+
+	if !m.formModel.editing && !m.formModel.categoryTouched {
+		m.formModel.selectedCategory = 0
+		if category, _ := m.sourceDefaults(); category != "" {
+			m.selectFormCategory(category)
+		}
+		// The description says more than the source's default
+		m.applyCategorySuggestion()
+		return
+	}
+
+	m.selectFormCategory(current)
+}
+
+func (m *TransactionsModel) selectFormCategory(category entity.TransactionCategory) {
+	for i, cat := range m.getFormCategories() {
+		if cat == category {
+			m.formModel.selectedCategory = i
+			return
+		}
+	}
+}
+
+// sourceDefaults returns the default category and type configured on the selected account or card
+func (m *TransactionsModel) sourceDefaults() (entity.TransactionCategory, entity.TransactionType) {
+	if m.formModel.selectedSource == 0 && m.formModel.selectedAccount < len(m.accounts) {
+		account := m.accounts[m.formModel.selectedAccount]
+		return account.DefaultCategory, account.DefaultTransactionType
+	}
+	if m.formModel.selectedSource == 1 && m.formModel.selectedCard < len(m.creditCards) {
+		card := m.creditCards[m.formModel.selectedCard]
+		return card.DefaultCategory, card.DefaultTransactionType
+	}
+	return "", ""
+}
+
+// applySourceDefaults pre-fills type and category for a new transaction from
+// the selected source, without overriding a category the user already picked
+func (m *TransactionsModel) applySourceDefaults() {
+	if m.formModel.editing {
+		return
+	}
+
+	category, transactionType := m.sourceDefaults()
+
+	switch transactionType {
+	case entity.TransactionTypeDebit:
+		m.formModel.selectedType = 0
+	case entity.TransactionTypeCredit:
+		m.formModel.selectedType = 1
+	}
+
+	if category != "" && !m.formModel.categoryTouched {
+		m.selectFormCategory(category)
+	}
+	m.applyCategorySuggestion()
+}
+
+// getFormCategories returns the categories in the order shown by the form selector
+func (m *TransactionsModel) getFormCategories() []entity.TransactionCategory {
+	if len(m.formModel.categoryOrder) > 0 {
+		return m.formModel.categoryOrder
+	}
+	return m.getCategories()
 }
 
 // Get list of transaction categories
 func (m *TransactionsModel) getCategories() []entity.TransactionCategory {
-	return []entity.TransactionCategory{
-		entity.TransactionCategoryFood,
-		entity.TransactionCategoryTransportation,
-		entity.TransactionCategoryUtilities,
-		entity.TransactionCategoryEntertainment,
-		entity.TransactionCategoryShopping,
-		entity.TransactionCategoryHealthcare,
-		entity.TransactionCategoryEducation,
-		entity.TransactionCategoryIncome,
-		entity.TransactionCategoryTransfer,
-		entity.TransactionCategoryOther,
-	}
+	return transactionCategories()
 }
 
 // Get category display name
 func (m *TransactionsModel) getCategoryDisplay(cat entity.TransactionCategory) string {
+	return categoryDisplayName(cat)
+}
+
+// transactionCategories lists the built-in categories followed by those added
+// by the user
+func transactionCategories() []entity.TransactionCategory {
+	categories := entity.BuiltInCategories()
+	for _, category := range customCategories {
+		categories = append(categories, category.Key)
+	}
+	return categories
+}
+
+func categoryDisplayName(cat entity.TransactionCategory) string {
+	name := categoryName(cat)
+	if icon := categoryIcon(cat); icon != "" {
+		return icon + " " + name
+	}
+	return name
+}
+
+func categoryName(cat entity.TransactionCategory) string {
 	switch cat {
 	case entity.TransactionCategoryFood:
-		return "🍔 Food"
+		return "Food"
 	case entity.TransactionCategoryTransportation:
-		return "🚗 Transportation"
+		return "Transportation"
 	case entity.TransactionCategoryUtilities:
-		return "💡 Utilities"
+		return "Utilities"
 	case entity.TransactionCategoryEntertainment:
-		return "🎮 Entertainment"
+		return "Entertainment"
 	case entity.TransactionCategoryShopping:
-		return "🛍️ Shopping"
+		return "Shopping"
 	case entity.TransactionCategoryHealthcare:
-		return "🏥 Healthcare"
+		return "Healthcare"
 	case entity.TransactionCategoryEducation:
-		return "📚 Education"
+		return "Education"
 	case entity.TransactionCategoryIncome:
-		return "💰 Income"
+		return "Income"
 	case entity.TransactionCategoryTransfer:
-		return "🔄 Transfer"
+		return "Transfer"
 	case entity.TransactionCategoryOther:
-		return "📋 Other"
+		return "Other"
 	default:
+		if category := customCategory(cat); category != nil {
+			return category.Name
+		}
 		return string(cat)
 	}
 }
@@ -786,6 +1310,10 @@ func (m *TransactionsModel) renderTransactionsList() string {
 	summary := m.renderSummaryBar()
 	sections = append(sections, summary)
 
+	if activeFilter := m.renderActiveFilter(); activeFilter != "" {
+		sections = append(sections, activeFilter)
+	}
+
 	if len(m.filteredTransactions) == 0 {
 		empty := style.InfoStyle.Render("No transactions found. Press 'n' to create your first transaction.")
 		sections = append(sections, empty)
@@ -799,6 +1327,10 @@ func (m *TransactionsModel) renderTransactionsList() string {
 		sections = append(sections, pagination)
 	}
 
+	if m.viewMode == TransactionViewQuickFilter {
+		sections = append(sections, m.renderQuickFilterMenu())
+	}
+
 	help := m.renderListHelp()
 	sections = append(sections, help)
 
@@ -807,15 +1339,7 @@ func (m *TransactionsModel) renderTransactionsList() string {
 
 // Render summary bar with totals
 func (m *TransactionsModel) renderSummaryBar() string {
-	var totalIncome, totalExpense float64
-
-	for _, txn := range m.filteredTransactions {
-		if txn.Type == entity.TransactionTypeCredit {
-			totalIncome += txn.Amount.Amount()
-		} else {
-			totalExpense += txn.Amount.Amount()
-		}
-	}
+	totalIncome, totalExpense := m.listTotals()
 
 	balance := totalIncome - totalExpense
 
@@ -825,14 +1349,14 @@ func (m *TransactionsModel) renderSummaryBar() string {
 		Padding(0, 1).
 		MarginTop(1)
 
-	incomeStr := style.SuccessStyle.Render(fmt.Sprintf("Income: R$ %.2f", totalIncome))
-	expenseStr := style.ErrorStyle.Render(fmt.Sprintf("Expense: R$ %.2f", totalExpense))
+	incomeStr := style.SuccessStyle.Render(fmt.Sprintf("Income: %s", formatAmount(totalIncome)))
+	expenseStr := style.ErrorStyle.Render(fmt.Sprintf("Expense: %s", formatAmount(totalExpense)))
 
 	var balanceStr string
 	if balance >= 0 {
-		balanceStr = style.SuccessStyle.Render(fmt.Sprintf("Balance: R$ %.2f", balance))
+		balanceStr = style.SuccessStyle.Render(fmt.Sprintf("Balance: %s", formatAmount(balance)))
 	} else {
-		balanceStr = style.ErrorStyle.Render(fmt.Sprintf("Balance: R$ %.2f", balance))
+		balanceStr = style.ErrorStyle.Render(fmt.Sprintf("Balance: %s", formatAmount(balance)))
 	}
 
 	content := lipgloss.JoinHorizontal(
@@ -865,22 +1389,31 @@ func (m *TransactionsModel) renderTransactionsTable() string {
 	rows = append(rows, headerRow)
 
 	// Calculate page boundaries
-	start := m.currentPage * m.itemsPerPage
-	end := start + m.itemsPerPage
-	if end > len(m.filteredTransactions) {
-		end = len(m.filteredTransactions)
+	start, end := m.pageBounds()
+
+	var subtotals map[time.Time]*groupSubtotal
+	if m.grouping != groupingNone {
+		subtotals = groupSubtotals(m.filteredTransactions, m.grouping)
 	}
 
+	var currentGroup time.Time
 	for i := start; i < end; i++ {
 		txn := m.filteredTransactions[i]
 
+		if m.grouping != groupingNone {
+			if group := m.grouping.groupStart(txn.Date); i == start || !group.Equal(currentGroup) {
+				currentGroup = group
+				rows = append(rows, renderGroupHeader(m.grouping, group, subtotals[group]))
+			}
+		}
+
 		date := txn.Date.Format("2006-01-02")
 		description := truncateString(txn.Description, 25)
-		category := truncateString(m.getCategoryDisplay(txn.Category), 15)
+		category := renderCategoryCell(txn.Category, 15)
 
 		// Format amount with color
 		var amountStr string
-		amount := fmt.Sprintf("R$ %.2f", txn.Amount.Amount())
+		amount := formatMoney(txn.Amount)
 		if txn.Type == entity.TransactionTypeCredit {
 			amountStr = style.SuccessStyle.Render("+" + amount)
 		} else {
@@ -900,7 +1433,9 @@ func (m *TransactionsModel) renderTransactionsTable() string {
 		row := fmt.Sprintf("%-12s %-25s %-15s %-12s %-15s %-6s",
 			date, description, category, amountStr, source, shared)
 
-		if i-start == m.selectedIndex {
+		if i-start == m.selectedIndex && m.inlineEdit != nil {
+			row = m.renderInlineEditRow()
+		} else if i-start == m.selectedIndex {
 			row = style.SelectedMenuItemStyle.Render("► " + row)
 		} else {
 			row = style.MenuItemStyle.Render("  " + row)
@@ -909,33 +1444,44 @@ func (m *TransactionsModel) renderTransactionsTable() string {
 		rows = append(rows, row)
 	}
 
+	rows = append(rows, m.renderTransactionsTotals(start, end))
+
 	table := strings.Join(rows, "\n")
 	return tableStyle.Render(table)
 }
 
+// renderTransactionsTotals is the totals row of the page shown or of all the
+// filtered transactions, as [t] picks
+func (m *TransactionsModel) renderTransactionsTotals(start, end int) string {
+	if start > end {
+		start = end
+	}
+	transactions := m.filteredTransactions[start:end]
+	income, expense := sumTransactions(transactions)
+	count := len(transactions)
+	if m.totals == totalsFiltered {
+		income, expense = m.listTotals()
+		count = m.listCount()
+	}
+
+	row := fmt.Sprintf("%-12s %-25s %-15s %s",
+		m.totals.String(), countLabel(count, "transaction"), "", formatNetAmount(income-expense))
+	return renderTotalsRow(row, true)
+}
+
 // Get transaction source display
 func (m *TransactionsModel) getTransactionSource(txn *entity.Transaction) string {
 	if txn.AccountID != nil {
-		for _, acc := range m.accounts {
-			if acc.ID == *txn.AccountID {
-				return acc.Name
-			}
-		}
-		return "Unknown Account"
+		return m.lookup.accountName(*txn.AccountID)
 	} else if txn.CreditCardID != nil {
-		for _, card := range m.creditCards {
-			if card.ID == *txn.CreditCardID {
-				return card.Name
-			}
-		}
-		return "Unknown Card"
+		return m.lookup.creditCardName(*txn.CreditCardID)
 	}
 	return "Cash"
 }
 
 // Render pagination information
 func (m *TransactionsModel) renderPagination() string {
-	totalPages := (len(m.filteredTransactions) + m.itemsPerPage - 1) / m.itemsPerPage
+	totalPages := (m.listCount() + m.itemsPerPage - 1) / m.itemsPerPage
 	if totalPages == 0 {
 		totalPages = 1
 	}
@@ -944,15 +1490,28 @@ func (m *TransactionsModel) renderPagination() string {
 		Foreground(style.TextMuted).
 		MarginTop(1)
 
-	info := fmt.Sprintf("Page %d of %d | Total: %d transactions | Use ← → to navigate pages",
-		m.currentPage+1, totalPages, len(m.filteredTransactions))
+	info := fmt.Sprintf("Page %d of %d | Total: %d transactions | %d per page | Use ← → to navigate pages",
+		m.currentPage+1, totalPages, m.listCount(), m.itemsPerPage)
+	if m.grouping != groupingNone {
+		info += fmt.Sprintf(" | Grouped by %s", strings.ToLower(m.grouping.String()))
+	}
 
 	return paginationStyle.Render(info)
 }
 
 // Render help text for list view
 func (m *TransactionsModel) renderListHelp() string {
-	help := "[↑/↓] Navigate • [Enter] Details • [n] New • [e] Edit • [d] Delete • [s] Share • [f] Filter • [i] Invoices • [r] Refresh • [b] Back"
+	if m.viewMode == TransactionViewInlineEdit {
+		return style.HelpStyle.
+			MarginTop(1).
+			Render("[Tab] Amount/Category • [←/→] Change Category • [Enter] Save • [Esc] Cancel")
+	}
+	if m.viewMode == TransactionViewQuickFilter {
+		return style.HelpStyle.
+			MarginTop(1).
+			Render("[↑/↓] Navigate • [1-9/Enter] Apply • [Esc] Cancel")
+	}
+	help := "[↑/↓] Navigate • [Enter] Details • [n] New • [e] Edit • [a] Quick Edit • [d] Delete • [s] Share • [f] Filter • [F] Quick Filter • [p] Presets • [g] Group • [t] Page/Filtered Total • [+/-] Page Size • [i] Invoices • [c] By City • [o] Scan Receipt • [r] Refresh • [b] Back"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -962,16 +1521,7 @@ func (m *TransactionsModel) renderListHelp() string {
 func (m *TransactionsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch m.formModel.focusedField {
 	case 0: // Description
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.descriptionInput) > 0 {
-				m.formModel.descriptionInput = m.formModel.descriptionInput[:len(m.formModel.descriptionInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 {
-				m.formModel.descriptionInput += msg.String()
-			}
-		}
+		m.formModel.descriptionInput = editTextInput(m.formModel.descriptionInput, msg)
 	case 1: // Type (income/expense)
 		switch msg.String() {
 		case "left":
@@ -980,49 +1530,41 @@ func (m *TransactionsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 			m.formModel.selectedType = 1
 		}
 	case 2: // Category
-		categories := m.getCategories()
+		categories := m.getFormCategories()
 		switch msg.String() {
 		case "left":
 			if m.formModel.selectedCategory > 0 {
 				m.formModel.selectedCategory--
+				m.formModel.categoryTouched = true
 			}
 		case "right":
 			if m.formModel.selectedCategory < len(categories)-1 {
 				m.formModel.selectedCategory++
+				m.formModel.categoryTouched = true
 			}
 		}
 	case 3: // Amount
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.amountInput) > 0 {
-				m.formModel.amountInput = m.formModel.amountInput[:len(m.formModel.amountInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-				m.formModel.amountInput += msg.String()
-			}
-		}
+		m.formModel.amountInput = editAmountInput(m.formModel.amountInput, msg)
 	case 4: // Date
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.dateInput) > 0 {
-				m.formModel.dateInput = m.formModel.dateInput[:len(m.formModel.dateInput)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == "-") {
-				m.formModel.dateInput += msg.String()
-			}
-		}
-	case 5: // Source type (account/card)
+		m.formModel.dateInput = editDateInput(m.formModel.dateInput, msg)
+	case 5: // Source type (account/card/cash)
 		switch msg.String() {
 		case "left":
-			m.formModel.selectedSource = 0
+			if m.formModel.selectedSource > 0 {
+				m.formModel.selectedSource--
+			}
 		case "right":
-			m.formModel.selectedSource = 1
+			if m.formModel.selectedSource < 2 {
+				m.formModel.selectedSource++
+			}
 		}
 		// Reset selection when changing source type
 		m.formModel.selectedAccount = 0
 		m.formModel.selectedCard = 0
+		if msg.String() == "left" || msg.String() == "right" {
+			m.applySourceDefaults()
+			return m, m.loadRecentCategories()
+		}
 	case 6: // Account or Card selection
 		if m.formModel.selectedSource == 0 {
 			// Account selection
@@ -1036,7 +1578,7 @@ func (m *TransactionsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 					m.formModel.selectedAccount++
 				}
 			}
-		} else {
+		} else if m.formModel.selectedSource == 1 {
 			// Card selection
 			switch msg.String() {
 			case "left":
@@ -1049,14 +1591,22 @@ func (m *TransactionsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 				}
 			}
 		}
-	case 7: // Sharing toggle
+		if msg.String() == "left" || msg.String() == "right" {
+			m.applySourceDefaults()
+			return m, m.loadRecentCategories()
+		}
+	case 7: // Payment method
+		m.formModel.selectedPaymentMethod = cycleOption(m.formModel.selectedPaymentMethod, len(paymentMethodOptions()), msg.String())
+	case 8: // Tags
+		m.formModel.tagsInput = editTextInput(m.formModel.tagsInput, msg)
+	case 9: // Sharing toggle
 		switch msg.String() {
 		case "left":
 			m.formModel.enableSharing = false
 		case "right":
 			m.formModel.enableSharing = true
 		}
-	case 8: // Person selection
+	case 10: // Person selection
 		if len(m.people) > 0 {
 			switch msg.String() {
 			case "left":
@@ -1069,17 +1619,8 @@ func (m *TransactionsModel) handleFormInput(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 				}
 			}
 		}
-	case 9: // Share percentage
-		switch msg.String() {
-		case "backspace":
-			if len(m.formModel.sharePercentage) > 0 {
-				m.formModel.sharePercentage = m.formModel.sharePercentage[:len(m.formModel.sharePercentage)-1]
-			}
-		default:
-			if len(msg.String()) == 1 && (msg.String() >= "0" && msg.String() <= "9" || msg.String() == ".") {
-				m.formModel.sharePercentage += msg.String()
-			}
-		}
+	case 11: // Share percentage
+		m.formModel.sharePercentage = editAmountInput(m.formModel.sharePercentage, msg)
 	}
 
 	return m, nil
@@ -1114,25 +1655,54 @@ func (m *TransactionsModel) submitForm() (tea.Model, tea.Cmd) {
 	}
 
 	// Get category
-	categories := m.getCategories()
+	categories := m.getFormCategories()
 	category := categories[m.formModel.selectedCategory]
 
 	// Get account/card
 	var accountID *uuid.UUID
 	var creditCardID *uuid.UUID
 
-	if m.formModel.selectedSource == 0 && len(m.accounts) > 0 {
+	// Cash leaves both unset, so no balance is touched
+	switch m.formModel.selectedSource {
+	case 0:
+		if len(m.accounts) == 0 {
+			m.err = fmt.Errorf("no accounts available, pick Cash instead")
+			return m, nil
+		}
 		accountID = &m.accounts[m.formModel.selectedAccount].ID
-	} else if m.formModel.selectedSource == 1 && len(m.creditCards) > 0 {
+	case 1:
+		if len(m.creditCards) == 0 {
+			m.err = fmt.Errorf("no credit cards available, pick Cash instead")
+			return m, nil
+		}
 		creditCardID = &m.creditCards[m.formModel.selectedCard].ID
 	}
 
+	paymentMethod := paymentMethodOptions()[m.formModel.selectedPaymentMethod]
+	tags := entity.ParseTags(m.formModel.tagsInput)
+
 	m.loading = true
 
 	if m.formModel.editing && m.formModel.editingID != nil {
-		// TODO: Implement update transaction
+		// Shares are kept as they are, rescaled to the new amount
+		id := *m.formModel.editingID
+		description := m.formModel.descriptionInput
 		return m, func() tea.Msg {
-			return errMsg{err: fmt.Errorf("update transaction not implemented yet")}
+			updated, err := m.transactionUseCase.UpdateTransaction(m.ctx, id, accountID, creditCardID, txnType, category, amount, description, date)
+			if err != nil {
+				return errMsg{err: err}
+			}
+			if updated.PaymentMethod != paymentMethod {
+				if _, err := m.transactionUseCase.SetPaymentMethod(m.ctx, id, paymentMethod); err != nil {
+					return errMsg{err: err}
+				}
+			}
+			if strings.Join(updated.Tags, ",") != strings.Join(entity.NormalizeTags(tags), ",") {
+				if _, err := m.transactionUseCase.SetTags(m.ctx, id, tags); err != nil {
+					return errMsg{err: err}
+				}
+			}
+			return transactionActionMsg{}
 		}
 	}
 
@@ -1167,6 +1737,17 @@ func (m *TransactionsModel) submitForm() (tea.Model, tea.Cmd) {
 			return errMsg{err: err}
 		}
 
+		if paymentMethod != "" {
+			if _, err := m.transactionUseCase.SetPaymentMethod(m.ctx, transaction.ID, paymentMethod); err != nil {
+				return errMsg{err: fmt.Errorf("failed to set payment method: %w", err)}
+			}
+		}
+		if len(tags) > 0 {
+			if _, err := m.transactionUseCase.SetTags(m.ctx, transaction.ID, tags); err != nil {
+				return errMsg{err: fmt.Errorf("failed to set tags: %w", err)}
+			}
+		}
+
 		// Add sharing if enabled
 		if m.formModel.enableSharing && txnType == entity.TransactionTypeDebit && len(m.people) > 0 {
 			percentage, _ := strconv.ParseFloat(m.formModel.sharePercentage, 64)
@@ -1195,12 +1776,21 @@ func (m *TransactionsModel) renderTransactionForm() string {
 		errorMsg := style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
 		sections = append(sections, errorMsg)
 	}
+	if m.formModel.receiptSource != "" {
+		sections = append(sections, style.InfoStyle.Render(fmt.Sprintf("🧾 Filled in from %s: check the fields before saving", m.formModel.receiptSource)))
+	}
+	if note := m.formGuard.renderDraftNote(); note != "" {
+		sections = append(sections, note)
+	}
 
 	form := m.renderForm()
 	sections = append(sections, form)
 
 	help := m.renderFormHelp()
 	sections = append(sections, help)
+	if prompt := m.formGuard.renderPrompt(); prompt != "" {
+		sections = append(sections, prompt)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
 }
@@ -1215,8 +1805,12 @@ func (m *TransactionsModel) renderForm() string {
 
 	var fields []string
 
-	// Description field
-	fields = append(fields, m.renderFormField("Description:", m.formModel.descriptionInput, 0))
+	// Description field, with the rest of the suggested completion dimmed after the cursor
+	description := m.formModel.descriptionInput
+	if completion := m.descriptionCompletion(); completion != "" && m.formModel.focusedField == 0 {
+		description += lipgloss.NewStyle().Foreground(style.TextMuted).Render(completion[len(description):])
+	}
+	fields = append(fields, m.renderFormField("Description:", description, 0))
 
 	// Type selector (Income/Expense)
 	fields = append(fields, m.renderTypeSelector())
@@ -1234,18 +1828,34 @@ func (m *TransactionsModel) renderForm() string {
 	fields = append(fields, m.renderSourceSelector())
 
 	// Account/Card selector
-	if m.formModel.selectedSource == 0 {
+	switch m.formModel.selectedSource {
+	case 0:
 		fields = append(fields, m.renderAccountSelector())
-	} else {
+		if warning := m.lowBalanceWarning(); warning != "" {
+			fields = append(fields, warning)
+		}
+	case 1:
 		fields = append(fields, m.renderCardSelector())
+	default:
+		fields = append(fields, m.renderCashNote())
+	}
+
+	// Payment method selector
+	fields = append(fields, renderDefaultSelector("Payment Method:", paymentMethodLabel(paymentMethodOptions()[m.formModel.selectedPaymentMethod]), m.formModel.focusedField == 7))
+
+	// Tags field, with the rest of the completed tag dimmed after the cursor
+	tagsDisplay := m.formModel.tagsInput
+	if completion := m.tagCompletion(); completion != "" && m.formModel.focusedField == 8 {
+		tagsDisplay += lipgloss.NewStyle().Foreground(style.TextMuted).Render(completion)
 	}
+	fields = append(fields, m.renderFormField("Tags:", tagsDisplay, 8))
 
 	// Sharing options (only for expenses)
 	if m.formModel.selectedType == 0 { // Expense
 		fields = append(fields, m.renderSharingToggle())
 		if m.formModel.enableSharing {
 			fields = append(fields, m.renderPersonSelector())
-			fields = append(fields, m.renderFormField("Share % (0-100):", m.formModel.sharePercentage, 9))
+			fields = append(fields, m.renderFormField("Share % (0-100):", m.formModel.sharePercentage, 11))
 		}
 	}
 
@@ -1326,9 +1936,12 @@ func (m *TransactionsModel) renderCategorySelector() string {
 		Bold(true).
 		Width(20)
 
-	categories := m.getCategories()
+	categories := m.getFormCategories()
 	selectedCat := categories[m.formModel.selectedCategory]
 	display := m.getCategoryDisplay(selectedCat)
+	if m.formModel.recentCategories[selectedCat] {
+		display += " ★"
+	}
 
 	var selector string
 	if m.formModel.focusedField == 2 {
@@ -1342,17 +1955,18 @@ func (m *TransactionsModel) renderCategorySelector() string {
 		lipgloss.Left,
 		labelStyle.Render("Category:"),
 		selector,
+		m.renderCategorySuggestionHint(selectedCat),
 	)
 }
 
-// Render source selector (Account/Card)
+// Render source selector (Account/Card/Cash)
 func (m *TransactionsModel) renderSourceSelector() string {
 	labelStyle := lipgloss.NewStyle().
 		Foreground(style.Text).
 		Bold(true).
 		Width(20)
 
-	sources := []string{"🏦 Account", "💳 Credit Card"}
+	sources := []string{"🏦 Account", "💳 Credit Card", "💵 Cash"}
 	var options []string
 
 	for i, s := range sources {
@@ -1396,7 +2010,7 @@ func (m *TransactionsModel) renderAccountSelector() string {
 	}
 
 	account := m.accounts[m.formModel.selectedAccount]
-	display := fmt.Sprintf("%s (R$ %.2f)", account.Name, account.Balance.Amount())
+	display := fmt.Sprintf("%s (%s)", account.Name, formatMoney(account.Balance))
 
 	var selector string
 	if m.formModel.focusedField == 6 {
@@ -1413,6 +2027,25 @@ func (m *TransactionsModel) renderAccountSelector() string {
 	)
 }
 
+// Render the note shown in place of the account/card selector for cash
+func (m *TransactionsModel) renderCashNote() string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(style.Text).
+		Bold(true).
+		Width(20)
+
+	note := style.InfoStyle.Render("Paid in cash, no account balance is affected")
+	if m.formModel.focusedField == 6 {
+		note = note + " ◄"
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		labelStyle.Render("Wallet:"),
+		note,
+	)
+}
+
 // Render credit card selector
 func (m *TransactionsModel) renderCardSelector() string {
 	labelStyle := lipgloss.NewStyle().
@@ -1430,7 +2063,7 @@ func (m *TransactionsModel) renderCardSelector() string {
 
 	card := m.creditCards[m.formModel.selectedCard]
 	available := card.CreditLimit.Amount() - card.CurrentBalance.Amount()
-	display := fmt.Sprintf("%s (Available: R$ %.2f)", card.Name, available)
+	display := fmt.Sprintf("%s (Available: %s)", card.Name, formatAmount(available))
 
 	var selector string
 	if m.formModel.focusedField == 6 {
@@ -1517,7 +2150,7 @@ func (m *TransactionsModel) renderSharingToggle() string {
 	for i, option := range toggleOptions {
 		isSelected := (i == 1 && m.formModel.enableSharing) || (i == 0 && !m.formModel.enableSharing)
 		if isSelected {
-			if m.formModel.focusedField == 7 {
+			if m.formModel.focusedField == 9 {
 				options = append(options, style.SelectedMenuItemStyle.Render("► "+option))
 			} else {
 				options = append(options, style.InfoStyle.Render("• "+option))
@@ -1529,7 +2162,7 @@ func (m *TransactionsModel) renderSharingToggle() string {
 
 	selector := lipgloss.JoinHorizontal(lipgloss.Left, options...)
 
-	if m.formModel.focusedField == 7 {
+	if m.formModel.focusedField == 9 {
 		selector = selector + " ◄"
 	}
 
@@ -1562,7 +2195,7 @@ func (m *TransactionsModel) renderPersonSelector() string {
 	}
 
 	var selector string
-	if m.formModel.focusedField == 8 {
+	if m.formModel.focusedField == 10 {
 		selector = style.FocusedInputStyle.Width(30).Render("< " + display + " >")
 		selector = selector + " ◄"
 	} else {
@@ -1585,11 +2218,11 @@ func (m *TransactionsModel) renderFormButtons() string {
 	var submitStyle, cancelStyle lipgloss.Style
 
 	// Calculate submit/cancel field indices based on whether sharing is enabled
-	submitFieldIndex := 10
-	cancelFieldIndex := 11
+	submitFieldIndex := 12
+	cancelFieldIndex := 13
 	if !m.formModel.enableSharing || m.formModel.selectedType != 0 {
-		submitFieldIndex = 7
-		cancelFieldIndex = 8
+		submitFieldIndex = 9
+		cancelFieldIndex = 10
 	}
 
 	// Submit button styling
@@ -1625,7 +2258,13 @@ func (m *TransactionsModel) renderFormButtons() string {
 
 // Render form help
 func (m *TransactionsModel) renderFormHelp() string {
-	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Select Option • [Enter] Confirm • [Esc] Cancel"
+	if (m.formModel.focusedField == 0 && m.descriptionCompletion() != "") || (m.formModel.focusedField == 8 && m.tagCompletion() != "") {
+		help := "[Tab] Accept Suggestion • [↓] Next Field • [Ctrl+E] Edit Description in $EDITOR • [Enter] Confirm • [Esc] Cancel"
+		return style.HelpStyle.
+			MarginTop(1).
+			Render(help)
+	}
+	help := "[Tab] Next Field • [Shift+Tab] Previous • [←/→] Select Option • [Ctrl+E] Edit Description in $EDITOR • [Enter] Confirm • [Esc] Cancel"
 	return style.HelpStyle.
 		MarginTop(1).
 		Render(help)
@@ -1633,7 +2272,7 @@ func (m *TransactionsModel) renderFormHelp() string {
 
 // Delete transaction
 func (m *TransactionsModel) deleteTransaction() tea.Msg {
-	idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+	idx := m.selectedPosition()
 	if idx >= len(m.filteredTransactions) {
 		return errMsg{err: fmt.Errorf("no transaction selected")}
 	}
@@ -1649,7 +2288,7 @@ func (m *TransactionsModel) deleteTransaction() tea.Msg {
 }
 
 func (m *TransactionsModel) renderTransactionDetails() string {
-	idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+	idx := m.selectedPosition()
 	if idx >= len(m.filteredTransactions) {
 		return style.ErrorStyle.Render("No transaction selected")
 	}
@@ -1674,6 +2313,12 @@ func (m *TransactionsModel) renderTransactionDetails() string {
 	details = append(details, fmt.Sprintf("ID: %s", txn.ID.String()))
 	details = append(details, fmt.Sprintf("Date: %s", txn.Date.Format("Monday, January 2, 2006")))
 	details = append(details, fmt.Sprintf("Description: %s", txn.Description))
+	if txn.HasLocation() {
+		details = append(details, fmt.Sprintf("Location: 📍 %s", txn.LocationLabel()))
+	}
+	if txn.IsBusiness() {
+		details = append(details, fmt.Sprintf("Client/Project: 💼 %s", txn.BusinessLabel()))
+	}
 
 	// Type and amount
 	details = append(details, "")
@@ -1684,7 +2329,7 @@ func (m *TransactionsModel) renderTransactionDetails() string {
 	}
 	details = append(details, fmt.Sprintf("Type: %s", typeStr))
 
-	amountStr := fmt.Sprintf("R$ %.2f", txn.Amount.Amount())
+	amountStr := formatMoney(txn.Amount)
 	if txn.Type == entity.TransactionTypeCredit {
 		amountStr = style.SuccessStyle.Render("+" + amountStr)
 	} else {
@@ -1692,25 +2337,36 @@ func (m *TransactionsModel) renderTransactionDetails() string {
 	}
 	details = append(details, fmt.Sprintf("Amount: %s", amountStr))
 	details = append(details, fmt.Sprintf("Category: %s", m.getCategoryDisplay(txn.Category)))
+	if len(txn.Tags) > 0 {
+		details = append(details, fmt.Sprintf("Tags: %s", strings.Join(txn.Tags, ", ")))
+	}
+	if txn.IgnoreFromBudget {
+		details = append(details, fmt.Sprintf("Budget: %s", style.WarningStyle.Render("Ignored")))
+	} else {
+		details = append(details, "Budget: Included")
+	}
 
 	// Source
 	details = append(details, "")
 	details = append(details, style.HeaderStyle.Render("Payment Source"))
 	source := m.getTransactionSource(txn)
 	details = append(details, fmt.Sprintf("Source: %s", source))
+	if txn.PaymentMethod != "" {
+		details = append(details, fmt.Sprintf("Method: %s", paymentMethodLabel(txn.PaymentMethod)))
+	}
 
 	// Shared expenses
 	if len(txn.SharedWith) > 0 {
 		details = append(details, "")
 		details = append(details, style.HeaderStyle.Render("Shared Expenses"))
 		personalAmount := txn.GetPersonalAmount()
-		details = append(details, fmt.Sprintf("Your portion: R$ %.2f", personalAmount.Amount()))
+		details = append(details, fmt.Sprintf("Your portion: %s", formatMoney(personalAmount)))
 		details = append(details, fmt.Sprintf("Shared with %d people", len(txn.SharedWith)))
 
 		for _, share := range txn.SharedWith {
 			personName := m.getPersonName(share.PersonID)
-			details = append(details, fmt.Sprintf("  • %s: R$ %.2f (%.1f%%)",
-				personName, share.Amount.Amount(), share.Percentage))
+			details = append(details, fmt.Sprintf("  • %s: %s (%.1f%%)",
+				personName, formatMoney(share.Amount), share.Percentage))
 		}
 	}
 
@@ -1723,7 +2379,10 @@ func (m *TransactionsModel) renderTransactionDetails() string {
 	content := strings.Join(details, "\n")
 	sections = append(sections, detailsStyle.Render(content))
 
-	help := "[Esc/Enter] Back • [e] Edit • [d] Delete • [s] Share"
+	help := "[Esc/Enter] Back • [e] Edit • [d] Delete • [s] Share • [i] Toggle Ignore from Budget • [l] Location • [h] History"
+	if m.businessMode {
+		help += " • [w] Client/Project"
+	}
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
@@ -1736,21 +2395,11 @@ func (m *TransactionsModel) renderSharedExpense() string {
 
 // Get person name by ID
 func (m *TransactionsModel) getPersonName(personID uuid.UUID) string {
-	for _, person := range m.people {
-		if person.ID == personID {
-			return person.Name
-		}
-	}
-	return "Unknown Person"
-}
-
-func (m *TransactionsModel) renderFilterView() string {
-	// TODO: Implement filter view rendering
-	return "Filter view - implementation pending"
+	return m.lookup.personName(personID)
 }
 
 func (m *TransactionsModel) renderConfirmDialog() string {
-	idx := m.currentPage*m.itemsPerPage + m.selectedIndex
+	idx := m.selectedPosition()
 	if idx >= len(m.filteredTransactions) {
 		return style.ErrorStyle.Render("No transaction selected")
 	}
@@ -1765,7 +2414,7 @@ func (m *TransactionsModel) renderConfirmDialog() string {
 
 	title := style.ErrorStyle.Render("⚠️  Confirm Delete")
 
-	amountStr := fmt.Sprintf("R$ %.2f", txn.Amount.Amount())
+	amountStr := formatMoney(txn.Amount)
 	if txn.Type == entity.TransactionTypeCredit {
 		amountStr = "+" + amountStr
 	} else {
@@ -1794,21 +2443,6 @@ func (m *TransactionsModel) renderConfirmDialog() string {
 	return dialogStyle.Render(content)
 }
 
-// Load all invoices from all credit cards
-func (m *TransactionsModel) loadAllInvoices() tea.Msg {
-	var allInvoices []*entity.CreditCardInvoice
-	
-	for _, card := range m.creditCards {
-		invoices, err := m.creditCardInvoiceUseCase.ListInvoicesByCard(m.ctx, card.ID)
-		if err != nil {
-			continue // Skip cards with errors
-		}
-		allInvoices = append(allInvoices, invoices...)
-	}
-	
-	return invoicesLoadedMsg{invoices: allInvoices}
-}
-
 // Load transactions for a specific invoice
 func (m *TransactionsModel) loadInvoiceTransactions(invoiceID uuid.UUID) tea.Cmd {
 	return func() tea.Msg {
@@ -1822,6 +2456,10 @@ func (m *TransactionsModel) loadInvoiceTransactions(invoiceID uuid.UUID) tea.Cmd
 
 // Handle keys for invoice list view
 func (m *TransactionsModel) handleInvoicesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if cmd, ok := m.handleInvoiceFilterKeys(msg.String()); ok {
+		return m, cmd
+	}
+
 	switch msg.String() {
 	case "esc", "b":
 		m.viewMode = TransactionViewList
@@ -1848,11 +2486,18 @@ func (m *TransactionsModel) handleInvoicesKeys(msg tea.KeyMsg) (tea.Model, tea.C
 
 // Handle keys for invoice transactions view
 func (m *TransactionsModel) handleInvoiceTransactionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.invoiceModel.payment != nil {
+		return m.handleInvoicePaymentKeys(msg)
+	}
+
 	switch msg.String() {
 	case "esc", "b":
 		m.viewMode = TransactionViewInvoices
 		m.invoiceModel.invoiceTransactions = nil
 		m.invoiceModel.selectedInvoice = nil
+		m.invoiceModel.message = ""
+	case "p":
+		return m.openInvoicePayment()
 	case "up", "k":
 		if m.invoiceModel.selectedTransactionIndex > 0 {
 			m.invoiceModel.selectedTransactionIndex--
@@ -1872,16 +2517,18 @@ func (m *TransactionsModel) renderInvoicesList() string {
 	
 	title := style.TitleStyle.Render("📋 Credit Card Invoices")
 	sections = append(sections, title)
+	sections = append(sections, m.renderInvoiceFilters())
 	
 	if len(m.invoiceModel.invoices) == 0 {
-		empty := style.InfoStyle.Render("No invoices found. Make sure you have credit cards with invoices.")
+		empty := style.InfoStyle.Render("No invoices match the filters.")
 		sections = append(sections, empty)
 	} else {
+		sections = append(sections, m.renderInvoiceListTotals())
 		table := m.renderInvoicesTable()
 		sections = append(sections, table)
 	}
 	
-	help := "[↑/↓] Navigate • [Enter] View Transactions • [b] Back"
+	help := "[↑/↓] Navigate • [Enter] View Transactions • [c] Card • [p] Period • [s] Status • [b] Back"
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 	
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
@@ -1906,18 +2553,12 @@ func (m *TransactionsModel) renderInvoicesTable() string {
 	
 	for i, invoice := range m.invoiceModel.invoices {
 		// Get card name
-		cardName := "Unknown Card"
-		for _, card := range m.creditCards {
-			if card.ID == invoice.CreditCardID {
-				cardName = card.Name
-				break
-			}
-		}
+		cardName := m.lookup.creditCardName(invoice.CreditCardID)
 		
 		// Format amounts
-		totalCharges := fmt.Sprintf("R$ %.2f", invoice.TotalCharges.Amount())
-		paidAmount := fmt.Sprintf("R$ %.2f", invoice.TotalPayments.Amount())
-		balanceAmount := fmt.Sprintf("R$ %.2f", invoice.ClosingBalance.Amount())
+		totalCharges := formatMoney(invoice.TotalCharges)
+		paidAmount := formatMoney(invoice.TotalPayments)
+		balanceAmount := formatMoney(invoice.ClosingBalance)
 		
 		// Format due date
 		dueDate := invoice.DueDate.Format("2006-01-02")
@@ -1935,15 +2576,35 @@ func (m *TransactionsModel) renderInvoicesTable() string {
 		rows = append(rows, row)
 	}
 	
+	rows = append(rows, m.renderInvoicesTotals())
+	
 	content := strings.Join(rows, "\n")
 	return tableStyle.Render(content)
 }
 
+// renderInvoicesTotals is the totals row of the filtered invoices
+func (m *TransactionsModel) renderInvoicesTotals() string {
+	var charges, payments, balance float64
+	for _, invoice := range m.invoiceModel.invoices {
+		charges += invoice.TotalCharges.Amount()
+		payments += invoice.TotalPayments.Amount()
+		balance += invoice.ClosingBalance.Amount()
+	}
+	
+	row := fmt.Sprintf("%-15s %-17s %-12s %-12s %-12s",
+		"Total", countLabel(len(m.invoiceModel.invoices), "invoice"),
+		formatAmount(charges), formatAmount(payments), formatAmount(balance))
+	return renderTotalsRow(row, false)
+}
+
 // Render invoice transactions
 func (m *TransactionsModel) renderInvoiceTransactions() string {
 	if m.invoiceModel.selectedInvoice == nil {
 		return style.ErrorStyle.Render("No invoice selected")
 	}
+	if m.invoiceModel.payment != nil {
+		return m.renderInvoicePayment()
+	}
 	
 	var sections []string
 	
@@ -1964,7 +2625,11 @@ func (m *TransactionsModel) renderInvoiceTransactions() string {
 		sections = append(sections, table)
 	}
 	
-	help := "[↑/↓] Navigate • [b] Back to Invoices"
+	if m.invoiceModel.message != "" {
+		sections = append(sections, style.InfoStyle.Render(m.invoiceModel.message))
+	}
+	
+	help := "[↑/↓] Navigate • [p] Pay Invoice • [b] Back to Invoices"
 	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
 	
 	return lipgloss.JoinVertical(lipgloss.Top, sections...)
@@ -1986,9 +2651,9 @@ func (m *TransactionsModel) renderInvoiceSummary() string {
 		invoice.OpeningDate.Format("2006-01-02"),
 		invoice.ClosingDate.Format("2006-01-02")))
 	summary = append(summary, fmt.Sprintf("Status: %s", string(invoice.Status)))
-	summary = append(summary, fmt.Sprintf("Total Charges: R$ %.2f", invoice.TotalCharges.Amount()))
-	summary = append(summary, fmt.Sprintf("Paid Amount: R$ %.2f", invoice.TotalPayments.Amount()))
-	summary = append(summary, fmt.Sprintf("Balance: R$ %.2f", invoice.ClosingBalance.Amount()))
+	summary = append(summary, fmt.Sprintf("Total Charges: %s", formatMoney(invoice.TotalCharges)))
+	summary = append(summary, fmt.Sprintf("Paid Amount: %s", formatMoney(invoice.TotalPayments)))
+	summary = append(summary, fmt.Sprintf("Balance: %s", formatMoney(invoice.ClosingBalance)))
 	summary = append(summary, fmt.Sprintf("Due Date: %s", invoice.DueDate.Format("2006-01-02")))
 	
 	content := strings.Join(summary, "\n")
@@ -2013,7 +2678,7 @@ func (m *TransactionsModel) renderInvoiceTransactionsTable() string {
 	rows = append(rows, headerRow)
 	
 	for i, txn := range m.invoiceModel.invoiceTransactions {
-		amountStr := fmt.Sprintf("R$ %.2f", txn.Amount.Amount())
+		amountStr := formatMoney(txn.Amount)
 		if txn.Type == entity.TransactionTypeCredit {
 			amountStr = style.SuccessStyle.Render("+" + amountStr)
 		} else {
@@ -2035,18 +2700,18 @@ func (m *TransactionsModel) renderInvoiceTransactionsTable() string {
 		rows = append(rows, row)
 	}
 	
+	income, expense := sumTransactions(m.invoiceModel.invoiceTransactions)
+	totals := fmt.Sprintf("%-12s %-30s %-15s %s",
+		"Total", countLabel(len(m.invoiceModel.invoiceTransactions), "transaction"), "", formatNetAmount(income-expense))
+	rows = append(rows, renderTotalsRow(totals, false))
+	
 	content := strings.Join(rows, "\n")
 	return tableStyle.Render(content)
 }
 
 // Helper to get card name for invoice
 func (m *TransactionsModel) getCardNameForInvoice(cardID uuid.UUID) string {
-	for _, card := range m.creditCards {
-		if card.ID == cardID {
-			return card.Name
-		}
-	}
-	return "Unknown Card"
+	return m.lookup.creditCardName(cardID)
 }
 
 // Helper to truncate string
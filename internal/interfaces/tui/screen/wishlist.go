@@ -0,0 +1,609 @@
+package screen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/domain/entity"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+type WishlistViewMode int
+
+const (
+	WishlistViewList WishlistViewMode = iota
+	WishlistViewForm
+	WishlistViewPurchase
+)
+
+var wishlistPriorityOptions = []entity.WishlistPriority{
+	entity.WishlistPriorityHigh,
+	entity.WishlistPriorityMedium,
+	entity.WishlistPriorityLow,
+}
+
+type WishlistModel struct {
+	ctx               context.Context
+	wishlistUseCase   *usecase.WishlistUseCase
+	accountUseCase    *usecase.AccountUseCase
+	creditCardUseCase *usecase.CreditCardUseCase
+
+	// Data, planned items first in funding order, then purchased ones
+	rows       []*wishlistRow
+	projection *usecase.CashFlowProjection
+
+	// View state
+	selectedIndex int
+	viewMode      WishlistViewMode
+
+	// Loading and errors
+	loading bool
+	err     error
+
+	form     *WishlistFormModel
+	purchase *WishlistPurchaseModel
+}
+
+type wishlistRow struct {
+	item          *entity.WishlistItem
+	affordability *usecase.WishlistAffordability
+}
+
+type WishlistFormModel struct {
+	nameInput       string
+	costInput       string
+	priorityIndex   int
+	targetDateInput string
+	categoryIndex   int
+
+	// Navigation
+	focusedField int
+}
+
+// purchaseSource is an account or credit card the purchase can be paid with
+type purchaseSource struct {
+	label        string
+	accountID    *uuid.UUID
+	creditCardID *uuid.UUID
+}
+
+type WishlistPurchaseModel struct {
+	item        *entity.WishlistItem
+	sources     []purchaseSource
+	sourceIndex int
+	amountInput string
+	dateInput   string
+
+	// Navigation
+	focusedField int
+}
+
+type wishlistLoadedMsg struct {
+	items         []*entity.WishlistItem
+	projection    *usecase.CashFlowProjection
+	affordability []*usecase.WishlistAffordability
+}
+
+type purchaseSourcesLoadedMsg struct {
+	sources []purchaseSource
+}
+
+type wishlistActionMsg struct{}
+
+func NewWishlistModel(ctx context.Context, wishlistUC *usecase.WishlistUseCase, accountUC *usecase.AccountUseCase, creditCardUC *usecase.CreditCardUseCase) tea.Model {
+	return &WishlistModel{
+		ctx:               ctx,
+		wishlistUseCase:   wishlistUC,
+		accountUseCase:    accountUC,
+		creditCardUseCase: creditCardUC,
+		viewMode:          WishlistViewList,
+		loading:           true,
+	}
+}
+
+func (m *WishlistModel) Init() tea.Cmd {
+	return m.loadWishlist
+}
+
+func (m *WishlistModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case wishlistLoadedMsg:
+		m.loading = false
+		m.err = nil
+		m.projection = msg.projection
+		m.rows = buildWishlistRows(msg.items, msg.affordability)
+		if m.selectedIndex >= len(m.rows) {
+			m.selectedIndex = 0
+		}
+		return m, nil
+
+	case purchaseSourcesLoadedMsg:
+		if m.purchase != nil {
+			m.purchase.sources = msg.sources
+		}
+		return m, nil
+
+	case wishlistActionMsg:
+		m.viewMode = WishlistViewList
+		m.form = nil
+		m.purchase = nil
+		return m, m.loadWishlist
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.viewMode {
+		case WishlistViewList:
+			return m.handleListKeys(msg)
+		case WishlistViewForm:
+			return m.handleFormKeys(msg)
+		case WishlistViewPurchase:
+			return m.handlePurchaseKeys(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *WishlistModel) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(m.rows)-1 {
+			m.selectedIndex++
+		}
+	case "n":
+		m.viewMode = WishlistViewForm
+		m.form = &WishlistFormModel{
+			priorityIndex:   1,
+			categoryIndex:   defaultCategoryIndex(entity.TransactionCategoryShopping),
+			targetDateInput: time.Now().AddDate(0, 3, 0).Format("2006-01-02"),
+		}
+	case "p":
+		if m.selectedIndex < len(m.rows) && !m.rows[m.selectedIndex].item.IsPurchased() {
+			item := m.rows[m.selectedIndex].item
+			m.viewMode = WishlistViewPurchase
+			m.purchase = &WishlistPurchaseModel{
+				item:        item,
+				amountInput: fmt.Sprintf("%.2f", item.EstimatedCost.Amount()),
+				dateInput:   time.Now().Format("2006-01-02"),
+			}
+			return m, m.loadPurchaseSources
+		}
+	case "d":
+		if m.selectedIndex < len(m.rows) {
+			m.loading = true
+			return m, m.deleteItem
+		}
+	case "r":
+		m.loading = true
+		return m, m.loadWishlist
+	case "b":
+		return m, func() tea.Msg { return BackToDashboardMsg{} }
+	}
+
+	return m, nil
+}
+
+func (m *WishlistModel) handleFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.form
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = WishlistViewList
+		return m, nil
+	case "tab", "down":
+		form.focusedField = (form.focusedField + 1) % 7
+		return m, nil
+	case "shift+tab", "up":
+		form.focusedField = (form.focusedField - 1 + 7) % 7
+		return m, nil
+	case "enter":
+		if form.focusedField == 5 {
+			return m.submitForm()
+		} else if form.focusedField == 6 {
+			// Cancel button
+			m.viewMode = WishlistViewList
+		}
+		return m, nil
+	}
+
+	key := msg.String()
+	switch form.focusedField {
+	case 0: // Name
+		form.nameInput = editTextInput(form.nameInput, msg)
+	case 1: // Estimated cost
+		form.costInput = editAmountInput(form.costInput, msg)
+	case 2: // Priority
+		form.priorityIndex = cycleOption(form.priorityIndex, len(wishlistPriorityOptions), key)
+	case 3: // Target date
+		form.targetDateInput = editDateInput(form.targetDateInput, msg)
+	case 4: // Category
+		form.categoryIndex = cycleOption(form.categoryIndex, len(transactionCategories()), key)
+	}
+
+	return m, nil
+}
+
+func (m *WishlistModel) handlePurchaseKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	purchase := m.purchase
+
+	switch msg.String() {
+	case "esc":
+		m.viewMode = WishlistViewList
+		m.purchase = nil
+		return m, nil
+	case "tab", "down":
+		purchase.focusedField = (purchase.focusedField + 1) % 5
+		return m, nil
+	case "shift+tab", "up":
+		purchase.focusedField = (purchase.focusedField - 1 + 5) % 5
+		return m, nil
+	case "enter":
+		if purchase.focusedField == 3 {
+			return m.submitPurchase()
+		} else if purchase.focusedField == 4 {
+			// Cancel button
+			m.viewMode = WishlistViewList
+			m.purchase = nil
+		}
+		return m, nil
+	}
+
+	key := msg.String()
+	switch purchase.focusedField {
+	case 0: // Paid with
+		if len(purchase.sources) > 0 {
+			purchase.sourceIndex = cycleOption(purchase.sourceIndex, len(purchase.sources), key)
+		}
+	case 1: // Amount
+		purchase.amountInput = editAmountInput(purchase.amountInput, msg)
+	case 2: // Date
+		purchase.dateInput = editDateInput(purchase.dateInput, msg)
+	}
+
+	return m, nil
+}
+
+func (m *WishlistModel) submitForm() (tea.Model, tea.Cmd) {
+	form := m.form
+
+	if strings.TrimSpace(form.nameInput) == "" {
+		m.err = fmt.Errorf("item name is required")
+		return m, nil
+	}
+
+	cost, err := strconv.ParseFloat(form.costInput, 64)
+	if err != nil || cost <= 0 {
+		m.err = fmt.Errorf("invalid estimated cost")
+		return m, nil
+	}
+
+	targetDate, err := time.ParseInLocation("2006-01-02", form.targetDateInput, time.Local)
+	if err != nil {
+		m.err = fmt.Errorf("invalid target date format (use YYYY-MM-DD)")
+		return m, nil
+	}
+
+	priority := wishlistPriorityOptions[form.priorityIndex]
+	category := transactionCategories()[form.categoryIndex]
+
+	m.loading = true
+	return m, func() tea.Msg {
+		_, err := m.wishlistUseCase.AddItem(m.ctx, form.nameInput, cost, "BRL", priority, targetDate, category)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return wishlistActionMsg{}
+	}
+}
+
+func (m *WishlistModel) submitPurchase() (tea.Model, tea.Cmd) {
+	purchase := m.purchase
+
+	if len(purchase.sources) == 0 {
+		m.err = fmt.Errorf("create an account or credit card to pay with first")
+		return m, nil
+	}
+
+	amount, err := strconv.ParseFloat(purchase.amountInput, 64)
+	if err != nil || amount <= 0 {
+		m.err = fmt.Errorf("invalid amount")
+		return m, nil
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", purchase.dateInput, time.Local)
+	if err != nil {
+		m.err = fmt.Errorf("invalid date format (use YYYY-MM-DD)")
+		return m, nil
+	}
+
+	source := purchase.sources[purchase.sourceIndex]
+
+	m.loading = true
+	return m, func() tea.Msg {
+		_, err := m.wishlistUseCase.PurchaseItem(m.ctx, purchase.item.ID, source.accountID, source.creditCardID, amount, date)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return wishlistActionMsg{}
+	}
+}
+
+func (m *WishlistModel) loadWishlist() tea.Msg {
+	items, err := m.wishlistUseCase.ListItems(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	projection, affordability, err := m.wishlistUseCase.AnalyzeAffordability(m.ctx, time.Now())
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	return wishlistLoadedMsg{items: items, projection: projection, affordability: affordability}
+}
+
+func (m *WishlistModel) loadPurchaseSources() tea.Msg {
+	accounts, err := m.accountUseCase.ListAccounts(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	cards, err := m.creditCardUseCase.ListCreditCards(m.ctx)
+	if err != nil {
+		return errMsg{err: err}
+	}
+
+	var sources []purchaseSource
+	for _, account := range accounts {
+		id := account.ID
+		sources = append(sources, purchaseSource{label: "🏦 " + account.Name, accountID: &id})
+	}
+	for _, card := range cards {
+		id := card.ID
+		sources = append(sources, purchaseSource{label: fmt.Sprintf("💳 %s (*%s)", card.Name, card.LastFourDigits), creditCardID: &id})
+	}
+
+	return purchaseSourcesLoadedMsg{sources: sources}
+}
+
+func (m *WishlistModel) deleteItem() tea.Msg {
+	item := m.rows[m.selectedIndex].item
+	if err := m.wishlistUseCase.DeleteItem(m.ctx, item.ID); err != nil {
+		return errMsg{err: err}
+	}
+
+	return wishlistActionMsg{}
+}
+
+func buildWishlistRows(items []*entity.WishlistItem, affordability []*usecase.WishlistAffordability) []*wishlistRow {
+	rows := make([]*wishlistRow, 0, len(items))
+	for _, result := range affordability {
+		rows = append(rows, &wishlistRow{item: result.Item, affordability: result})
+	}
+	for _, item := range items {
+		if item.IsPurchased() {
+			rows = append(rows, &wishlistRow{item: item})
+		}
+	}
+	return rows
+}
+
+func (m *WishlistModel) View() string {
+	if m.loading {
+		return style.InfoStyle.Render("Loading wishlist...")
+	}
+
+	var errLine string
+	if m.err != nil {
+		errLine = style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	}
+
+	var content string
+	switch m.viewMode {
+	case WishlistViewList:
+		content = m.renderList()
+	case WishlistViewForm:
+		content = m.renderForm()
+	case WishlistViewPurchase:
+		content = m.renderPurchaseForm()
+	}
+
+	if errLine != "" {
+		return lipgloss.JoinVertical(lipgloss.Top, errLine, content)
+	}
+	return content
+}
+
+func (m *WishlistModel) renderList() string {
+	var sections []string
+
+	sections = append(sections, style.TitleStyle.Render("🛍️ Wishlist"))
+
+	if m.projection != nil {
+		sections = append(sections, m.renderCashFlowSummary())
+	}
+
+	if len(m.rows) == 0 {
+		sections = append(sections, style.InfoStyle.Render("No planned purchases. Press 'n' to add one."))
+	} else {
+		sections = append(sections, m.renderTable())
+	}
+
+	help := "[↑/↓] Navigate • [n] New • [p] Mark Purchased • [d] Delete • [r] Refresh • [b] Back"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *WishlistModel) renderCashFlowSummary() string {
+	summaryStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Info).
+		Padding(1, 2).
+		MarginTop(1)
+
+	planned := 0.0
+	for _, row := range m.rows {
+		if !row.item.IsPurchased() {
+			planned += row.item.EstimatedCost.Amount()
+		}
+	}
+
+	summary := []string{
+		fmt.Sprintf("Cash Now: %s", formatMoney(m.projection.CurrentCash)),
+		fmt.Sprintf("Monthly Net: %s", formatMoney(m.projection.MonthlyNet)),
+		fmt.Sprintf("Total Planned: %s", formatAmount(planned)),
+	}
+
+	return summaryStyle.Render(strings.Join(summary, " • "))
+}
+
+func (m *WishlistModel) renderTable() string {
+	tableStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1)
+
+	headerRow := style.TableHeaderStyle.Render(
+		fmt.Sprintf("%-8s %-22s %-12s %-12s %-14s %s",
+			"Priority", "Item", "Cost", "Target", "Projected", "Status"),
+	)
+
+	rows := []string{headerRow}
+	for i, row := range m.rows {
+		item := row.item
+
+		projected := "-"
+		if row.affordability != nil {
+			projected = formatMoney(row.affordability.ProjectedCash)
+		}
+
+		line := fmt.Sprintf("%-8s %-22s %-12s %-12s %-14s %s",
+			wishlistPriorityLabel(item.Priority),
+			truncateString(item.Name, 22),
+			formatMoney(item.EstimatedCost),
+			item.TargetDate.Format("2006-01-02"),
+			projected,
+			renderWishlistStatus(row))
+
+		if i == m.selectedIndex {
+			line = style.SelectedMenuItemStyle.Render("► " + line)
+		} else {
+			line = style.MenuItemStyle.Render("  " + line)
+		}
+		rows = append(rows, line)
+	}
+
+	return tableStyle.Render(strings.Join(rows, "\n"))
+}
+
+func renderWishlistStatus(row *wishlistRow) string {
+	if row.item.IsPurchased() {
+		return fmt.Sprintf("🛒 Bought %s", row.item.PurchasedAt.Format("2006-01-02"))
+	}
+
+	result := row.affordability
+	if result.Affordable {
+		return style.SuccessStyle.Render("✅ Fits")
+	}
+
+	status := fmt.Sprintf("⚠️ Short %s", formatMoney(result.Shortfall))
+	if result.AffordableOn != nil {
+		status += fmt.Sprintf(", fits by %s", result.AffordableOn.Format("Jan 2006"))
+	}
+	return style.WarningStyle.Render(status)
+}
+
+func wishlistPriorityLabel(priority entity.WishlistPriority) string {
+	switch priority {
+	case entity.WishlistPriorityHigh:
+		return "High"
+	case entity.WishlistPriorityMedium:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+func (m *WishlistModel) renderForm() string {
+	form := m.form
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render("📝 New Planned Purchase"))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(2, 4).
+		MarginTop(1)
+
+	fields := []string{
+		renderTextField("Item:", form.nameInput, form.focusedField == 0),
+		renderTextField("Estimated Cost:", form.costInput, form.focusedField == 1),
+		renderDefaultSelector("Priority:", wishlistPriorityLabel(wishlistPriorityOptions[form.priorityIndex]), form.focusedField == 2),
+		renderTextField("Target Date:", form.targetDateInput, form.focusedField == 3),
+		renderDefaultSelector("Category:", categoryDisplayName(transactionCategories()[form.categoryIndex]), form.focusedField == 4),
+		renderSubmitCancelButtons("Add Item", form.focusedField, 5),
+	}
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *WishlistModel) renderPurchaseForm() string {
+	purchase := m.purchase
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🛒 Purchase: %s", purchase.item.Name)))
+
+	formStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Success).
+		Padding(2, 4).
+		MarginTop(1)
+
+	source := "No accounts or cards"
+	if len(purchase.sources) > 0 {
+		source = purchase.sources[purchase.sourceIndex].label
+	}
+
+	fields := []string{
+		style.InfoStyle.Render(fmt.Sprintf("Estimated Cost: %s", formatMoney(purchase.item.EstimatedCost))),
+		renderDefaultSelector("Paid With:", source, purchase.focusedField == 0),
+		renderTextField("Amount Paid:", purchase.amountInput, purchase.focusedField == 1),
+		renderTextField("Date:", purchase.dateInput, purchase.focusedField == 2),
+		renderSubmitCancelButtons("Record Purchase", purchase.focusedField, 3),
+	}
+
+	sections = append(sections, formStyle.Render(strings.Join(fields, "\n\n")))
+
+	help := "[Tab] Next Field • [←/→] Change Selection • [Enter] Confirm • [Esc] Cancel"
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render(help))
+
+	return lipgloss.JoinVertical(lipgloss.Top, sections...)
+}
+
+func (m *WishlistModel) IsInFormMode() bool {
+	return m.viewMode == WishlistViewForm || m.viewMode == WishlistViewPurchase
+}
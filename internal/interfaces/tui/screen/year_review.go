@@ -0,0 +1,254 @@
+package screen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"financli/internal/application/usecase"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guptarohit/asciigraph"
+)
+
+// yearReviewPages are the slides of the year review deck, in order
+var yearReviewPages = []string{"The Year in Numbers", "Top Categories", "Highlights", "Savings Rate", "Best and Worst Months"}
+
+// yearReviewDeck shows the year review one page at a time, like a slide deck
+type yearReviewDeck struct {
+	year   int
+	page   int
+	review *usecase.YearReview
+}
+
+type yearReviewLoadedMsg struct {
+	year   int
+	review *usecase.YearReview
+}
+
+type yearReviewExportedMsg struct {
+	path string
+}
+
+// openYearReview opens the deck on the year of the selected period
+func (m *ReportsModel) openYearReview() (tea.Model, tea.Cmd) {
+	m.yearReview = &yearReviewDeck{year: m.period.start.Year()}
+	return m, m.loadYearReview()
+}
+
+func (m *ReportsModel) loadYearReview() tea.Cmd {
+	year := m.yearReview.year
+	m.yearReview.review = nil
+	return func() tea.Msg {
+		review, err := m.reportUseCase.GetYearReview(m.ctx, year)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return yearReviewLoadedMsg{year: year, review: review}
+	}
+}
+
+func (m *ReportsModel) exportYearReview() tea.Cmd {
+	year := m.yearReview.year
+	return func() tea.Msg {
+		path, err := m.yearReviewExportUseCase.ExportYearReview(m.ctx, year)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return yearReviewExportedMsg{path: path}
+	}
+}
+
+func (m *ReportsModel) handleYearReviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	deck := m.yearReview
+	m.err = nil
+	m.message = ""
+
+	switch msg.String() {
+	case "esc", "b":
+		m.yearReview = nil
+	case "right", "l", "enter", " ":
+		if deck.page < len(yearReviewPages)-1 {
+			deck.page++
+		}
+	case "left", "h":
+		if deck.page > 0 {
+			deck.page--
+		}
+	case "up", "k":
+		deck.year--
+		return m, m.loadYearReview()
+	case "down", "j":
+		if deck.year < time.Now().Year() {
+			deck.year++
+			return m, m.loadYearReview()
+		}
+	case "m":
+		if m.yearReviewExportUseCase != nil {
+			return m, m.exportYearReview()
+		}
+	}
+	return m, nil
+}
+
+func (m *ReportsModel) renderYearReview() string {
+	deck := m.yearReview
+
+	var sections []string
+	sections = append(sections, style.TitleStyle.Render(fmt.Sprintf("🎁 %d Wrapped — %s", deck.year, yearReviewPages[deck.page])))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.Border).
+		Padding(1, 2).
+		MarginTop(1).
+		Width(80)
+
+	switch {
+	case m.err != nil:
+		sections = append(sections, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case deck.review == nil:
+		sections = append(sections, style.InfoStyle.Render("Loading year review..."))
+	case deck.review.Purchases == 0 && deck.review.Income == 0:
+		sections = append(sections, boxStyle.Render(style.InfoStyle.Render(fmt.Sprintf("Nothing recorded in %d.", deck.year))))
+	default:
+		var page []string
+		switch deck.page {
+		case 0:
+			page = renderYearNumbers(deck.review)
+		case 1:
+			page = renderYearCategories(deck.review)
+		case 2:
+			page = renderYearHighlights(deck.review)
+		case 3:
+			page = renderYearSavingsRate(deck.review)
+		case 4:
+			page = renderYearBestWorst(deck.review)
+		}
+		sections = append(sections, boxStyle.Render(strings.Join(page, "\n")))
+	}
+
+	// Where the deck is, as a row of dots
+	dots := make([]string, len(yearReviewPages))
+	for i := range yearReviewPages {
+		dots[i] = "○"
+		if i == deck.page {
+			dots[i] = "●"
+		}
+	}
+	sections = append(sections, lipgloss.NewStyle().MarginTop(1).Render(strings.Join(dots, " ")))
+
+	if m.message != "" {
+		sections = append(sections, style.SuccessStyle.MarginTop(1).Render(m.message))
+	}
+	sections = append(sections, style.HelpStyle.MarginTop(1).Render("[←/→] Page • [↑/↓] Year • [m] Export Markdown • [Esc] Back to Reports"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+func renderYearNumbers(review *usecase.YearReview) []string {
+	savedStyle := style.SuccessStyle
+	if review.Savings() < 0 {
+		savedStyle = style.ErrorStyle
+	}
+	return []string{
+		style.SubtitleStyle.Render("You spent"),
+		style.HeaderStyle.Render(formatAmount(review.Expenses)),
+		fmt.Sprintf("over %d purchases", review.Purchases),
+		"",
+		fmt.Sprintf("Income: %s", formatAmount(review.Income)),
+		fmt.Sprintf("Saved:  %s (%.1f%% of income)", savedStyle.Render(formatAmount(review.Savings())), review.SavingsRate()),
+	}
+}
+
+func renderYearCategories(review *usecase.YearReview) []string {
+	if len(review.TopCategories) == 0 {
+		return []string{style.InfoStyle.Render("No spending recorded.")}
+	}
+
+	lines := []string{style.SubtitleStyle.Render("Where the money went"), ""}
+	largest := review.TopCategories[0].Total
+	for i, category := range review.TopCategories {
+		filled := int(category.Total / largest * reportBarWidth)
+		if filled < 1 {
+			filled = 1
+		}
+		bar := lipgloss.NewStyle().Foreground(categoryColor(category.Category)).Render(strings.Repeat("█", filled)) +
+			strings.Repeat(" ", reportBarWidth-filled)
+		lines = append(lines, fmt.Sprintf("%d. %s %s %5.1f%% %14s",
+			i+1, renderCategoryCell(category.Category, 20), bar, category.Share, formatAmount(category.Total)))
+	}
+	return lines
+}
+
+func renderYearHighlights(review *usecase.YearReview) []string {
+	lines := []string{style.SubtitleStyle.Render("Biggest purchase")}
+	if purchase := review.BiggestPurchase; purchase != nil {
+		lines = append(lines,
+			style.HeaderStyle.Render(formatMoney(purchase.Amount)),
+			fmt.Sprintf("%s, %s on %s", purchase.Description, categoryDisplayName(purchase.Category), purchase.Date.Format("02/01/2006")))
+	} else {
+		lines = append(lines, style.InfoStyle.Render("No purchases."))
+	}
+
+	lines = append(lines, "", style.SubtitleStyle.Render("Most frequent merchant"))
+	if merchant := review.TopMerchant; merchant != nil {
+		lines = append(lines,
+			style.HeaderStyle.Render(merchant.Name),
+			fmt.Sprintf("%d purchases totaling %s", merchant.Purchases, formatAmount(merchant.Total)))
+	} else {
+		lines = append(lines, style.InfoStyle.Render("No purchases."))
+	}
+	return lines
+}
+
+// renderYearSavingsRate charts the savings rate of each month and of the year
+// so far, up to the last active month
+func renderYearSavingsRate(review *usecase.YearReview) []string {
+	var monthly, yearToDate []float64
+	var rows []string
+	for _, month := range review.Months {
+		if !month.Active() {
+			continue
+		}
+		monthly = append(monthly, month.SavingsRate)
+		yearToDate = append(yearToDate, month.YearToDateRate)
+		rows = append(rows, fmt.Sprintf("%-10s %7.1f%% %7.1f%%", month.Month.Format("January"), month.SavingsRate, month.YearToDateRate))
+	}
+
+	lines := []string{style.SubtitleStyle.Render(fmt.Sprintf("You saved %.1f%% of your income", review.SavingsRate()))}
+	if len(monthly) > 1 {
+		lines = append(lines, "", asciigraph.PlotMany([][]float64{monthly, yearToDate},
+			asciigraph.Height(8),
+			asciigraph.Width(60),
+			asciigraph.SeriesColors(asciigraph.Blue, asciigraph.Green),
+			asciigraph.Caption("Savings rate (%) each month (blue) and year to date (green)"),
+		))
+	}
+	lines = append(lines, "", style.TableHeaderStyle.Render(fmt.Sprintf("%-10s %8s %8s", "Month", "Month", "YTD")))
+	return append(lines, rows...)
+}
+
+func renderYearBestWorst(review *usecase.YearReview) []string {
+	var lines []string
+	if best, ok := review.BestMonth(); ok {
+		lines = append(lines,
+			style.SubtitleStyle.Render("Best month"),
+			style.SuccessStyle.Render(fmt.Sprintf("%s: saved %s", best.Month.Format("January"), formatAmount(best.Net()))),
+			fmt.Sprintf("Income %s, expenses %s", formatAmount(best.Income), formatAmount(best.Expenses)),
+			"")
+	}
+	if worst, ok := review.WorstMonth(); ok {
+		netStyle := style.WarningStyle
+		if worst.Net() < 0 {
+			netStyle = style.ErrorStyle
+		}
+		lines = append(lines,
+			style.SubtitleStyle.Render("Worst month"),
+			netStyle.Render(fmt.Sprintf("%s: saved %s", worst.Month.Format("January"), formatAmount(worst.Net()))),
+			fmt.Sprintf("Income %s, expenses %s", formatAmount(worst.Income), formatAmount(worst.Expenses)))
+	}
+	return lines
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"strings"
+
+	"financli/internal/interfaces/tui/screen"
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// StartupJobsDoneMsg reports that the catch-up jobs run in the background on
+// launch finished, with the warnings of those that failed
+type StartupJobsDoneMsg struct {
+	Warnings []string
+}
+
+// startupState follows the launch: the TUI draws right away while the
+// dashboard loads and the catch-up jobs run behind it
+type startupState struct {
+	// profile is set with --profile-startup, which quits once everything is in
+	profile        *screen.StartupProfile
+	drawn          bool
+	dashboardReady bool
+	jobsDone       bool
+
+	// warnings of the failed jobs, shown until the next key press
+	warnings []string
+}
+
+// SetStartupProfile times the launch into profile and quits the TUI once the
+// dashboard and the catch-up jobs are done
+func (a *App) SetStartupProfile(profile *screen.StartupProfile) {
+	a.startup.profile = profile
+	if profiler, ok := a.dashboardModel.(screen.StartupProfiler); ok {
+		profiler.SetStartupProfile(profile)
+	}
+}
+
+// updateStartup handles the messages owned by the launch, reporting whether
+// msg was consumed
+func (a *App) updateStartup(msg tea.Msg) (tea.Cmd, bool) {
+	s := &a.startup
+
+	switch msg := msg.(type) {
+	case screen.DashboardReadyMsg:
+		s.dashboardReady = true
+		return s.quitWhenProfiled(), true
+
+	case StartupJobsDoneMsg:
+		s.jobsDone = true
+		s.warnings = msg.Warnings
+		s.profile.Mark("startup jobs: done")
+		if s.profile != nil {
+			return s.quitWhenProfiled(), true
+		}
+		// The jobs may have posted yields, fees or transfers, so show them
+		if a.currentScreen == DashboardScreen {
+			return a.dashboardModel.Init(), true
+		}
+		return nil, true
+
+	case tea.KeyMsg:
+		s.warnings = nil
+	}
+
+	return nil, false
+}
+
+func (s *startupState) quitWhenProfiled() tea.Cmd {
+	if s.profile != nil && s.dashboardReady && s.jobsDone {
+		return tea.Quit
+	}
+	return nil
+}
+
+// markDrawn records the first frame, which shows the dashboard skeleton
+func (s *startupState) markDrawn() {
+	if !s.drawn {
+		s.drawn = true
+		s.profile.Mark("first frame")
+	}
+}
+
+func (a *App) renderStartupWarnings() string {
+	if len(a.startup.warnings) == 0 {
+		return ""
+	}
+	return style.WarningStyle.Render("⚠ Warning: " + strings.Join(a.startup.warnings, "\n⚠ Warning: "))
+}
@@ -71,6 +71,14 @@ var (
 				BorderStyle(lipgloss.NormalBorder()).
 				BorderForeground(Border)
 
+	TableFooterStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(Text).
+				PaddingLeft(2).
+				BorderTop(true).
+				BorderStyle(lipgloss.NormalBorder()).
+				BorderForeground(Border)
+
 	HelpStyle = lipgloss.NewStyle().
 			Foreground(TextMuted).
 			MarginTop(1)
@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// UnsavedChangesChecker interface for screens whose forms can hold changes not saved yet
+type UnsavedChangesChecker interface {
+	HasUnsavedChanges() bool
+}
+
+// FormDrafter interface for screens that can keep half filled forms as drafts
+type FormDrafter interface {
+	SetFormDrafts(enabled bool)
+}
+
+// DraftFlusher interface for screens saving their drafts in storage as
+// they're typed
+type DraftFlusher interface {
+	FlushDraft() error
+}
+
+// unsavedGuard asks before quitting or switching workspaces would throw away
+// what the forms hold
+type unsavedGuard struct {
+	confirming bool
+	// action is what is waiting for the answer, such as "quit"
+	action  string
+	screens []string
+	then    tea.Cmd
+}
+
+// SetFormDrafts makes the long forms keep what was typed into them as a
+// draft when left without saving, instead of asking to discard it
+func (a *App) SetFormDrafts(enabled bool) {
+	a.formDrafts = enabled
+	for _, model := range []tea.Model{a.accountsModel, a.creditCardsModel, a.billsModel, a.transactionsModel} {
+		if drafter, ok := model.(FormDrafter); ok {
+			drafter.SetFormDrafts(enabled)
+		}
+	}
+}
+
+// FlushDrafts saves what's typed into the forms and not stored yet, for when
+// the app quits before typing pauses
+func (a *App) FlushDrafts() error {
+	for _, model := range []tea.Model{a.billsModel, a.transactionsModel} {
+		if flusher, ok := model.(DraftFlusher); ok {
+			if err := flusher.FlushDraft(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unsavedScreens names the screens holding changes or drafts not saved yet
+func (a *App) unsavedScreens() []string {
+	screens := []struct {
+		name  string
+		model tea.Model
+	}{
+		{"Accounts", a.accountsModel},
+		{"Credit Cards", a.creditCardsModel},
+		{"Bills", a.billsModel},
+		{"Transactions", a.transactionsModel},
+	}
+
+	var names []string
+	for _, s := range screens {
+		if checker, ok := s.model.(UnsavedChangesChecker); ok && checker.HasUnsavedChanges() {
+			names = append(names, s.name)
+		}
+	}
+	return names
+}
+
+// updateUnsaved handles the keys that would lose unsaved changes, asking
+// first, reporting whether msg was consumed
+func (a *App) updateUnsaved(msg tea.Msg) (tea.Cmd, bool) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil, false
+	}
+
+	g := &a.unsaved
+	if g.confirming {
+		switch key.String() {
+		case "y", "Y":
+			g.confirming = false
+			return g.then, true
+		case "n", "N", "esc":
+			g.confirming = false
+		case "ctrl+c":
+			return tea.Quit, true
+		}
+		return nil, true
+	}
+
+	var action string
+	var then tea.Cmd
+	switch key.String() {
+	case "ctrl+c":
+		action, then = "quit", tea.Quit
+	case "q":
+		if a.inFormMode() || a.workspaces.picking {
+			return nil, false
+		}
+		action, then = "quit", tea.Quit
+	case "ctrl+w":
+		// The screens are rebuilt over the other workspace's data
+		if a.inFormMode() || !a.workspaces.enabled() || a.workspaces.picking {
+			return nil, false
+		}
+		action, then = "switch workspaces", func() tea.Msg { return pickWorkspaceMsg{} }
+	default:
+		return nil, false
+	}
+
+	screens := a.unsavedScreens()
+	if len(screens) == 0 {
+		return nil, false
+	}
+	*g = unsavedGuard{confirming: true, action: action, screens: screens, then: then}
+	return nil, true
+}
+
+func (a *App) renderUnsavedPrompt() string {
+	g := &a.unsaved
+	lines := []string{
+		style.TitleStyle.Render("⚠️  Unsaved changes"),
+		style.WarningStyle.Render(fmt.Sprintf("%s %s unsaved changes or drafts, lost if you %s.",
+			strings.Join(g.screens, ", "), pluralHave(len(g.screens)), g.action)),
+		style.HelpStyle.MarginTop(1).Render(fmt.Sprintf("[y] Discard and %s • [n/Esc] Go back", g.action)),
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func pluralHave(count int) string {
+	if count == 1 {
+		return "has"
+	}
+	return "have"
+}
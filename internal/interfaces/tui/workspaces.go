@@ -0,0 +1,205 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"financli/internal/interfaces/tui/style"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WorkspaceOpener opens the named workspace's database and wires the use cases
+// over it. startupJobs runs the workspace's catch-up jobs, returning the
+// warnings of those that failed.
+type WorkspaceOpener func(ctx context.Context, name string) (useCases UseCases, startupJobs func(ctx context.Context) []string, err error)
+
+type workspaceOpenedMsg struct {
+	name        string
+	useCases    UseCases
+	startupJobs func(ctx context.Context) []string
+}
+
+type workspaceErrMsg struct {
+	err error
+}
+
+// pickWorkspaceMsg opens the workspace picker, once switching is confirmed
+type pickWorkspaceMsg struct{}
+
+// workspaceSwitcher moves the app between the configured workspaces, such as
+// "personal" and "side-business", each with its own database
+type workspaceSwitcher struct {
+	names   []string
+	current string
+	open    WorkspaceOpener
+
+	picking   bool
+	selected  int
+	switching bool
+
+	err error
+}
+
+func (w *workspaceSwitcher) enabled() bool {
+	return len(w.names) > 1 && w.open != nil
+}
+
+// SetWorkspaces lets Ctrl+W switch between the named workspaces, current being
+// the one the app was built over. open is called with each workspace picked.
+func (a *App) SetWorkspaces(names []string, current string, open WorkspaceOpener) {
+	a.workspaces = workspaceSwitcher{
+		names:   names,
+		current: current,
+		open:    open,
+	}
+}
+
+// updateWorkspaces handles the messages owned by the workspace switcher,
+// reporting whether msg was consumed
+func (a *App) updateWorkspaces(msg tea.Msg) (tea.Cmd, bool) {
+	w := &a.workspaces
+	if !w.enabled() {
+		return nil, false
+	}
+
+	switch msg := msg.(type) {
+	case workspaceOpenedMsg:
+		w.current = msg.name
+		w.picking = false
+		w.switching = false
+		return a.switchUseCases(msg.useCases, msg.startupJobs), true
+
+	case workspaceErrMsg:
+		w.switching = false
+		w.err = msg.err
+		return nil, true
+
+	case pickWorkspaceMsg:
+		a.pickWorkspace()
+		return nil, true
+
+	case tea.KeyMsg:
+		if w.picking {
+			return a.handleWorkspaceKeys(msg), true
+		}
+		// A half-filled form would be lost with its screen
+		if msg.String() == "ctrl+w" && !a.inFormMode() {
+			a.pickWorkspace()
+			return nil, true
+		}
+	}
+
+	return nil, false
+}
+
+// pickWorkspace opens the picker on the current workspace
+func (a *App) pickWorkspace() {
+	w := &a.workspaces
+	w.picking = true
+	w.err = nil
+	for i, name := range w.names {
+		if name == w.current {
+			w.selected = i
+		}
+	}
+}
+
+func (a *App) handleWorkspaceKeys(msg tea.KeyMsg) tea.Cmd {
+	w := &a.workspaces
+	if w.switching {
+		return nil
+	}
+
+	switch msg.String() {
+	case "esc", "ctrl+w", "b":
+		w.picking = false
+	case "up", "k":
+		if w.selected > 0 {
+			w.selected--
+		}
+	case "down", "j":
+		if w.selected < len(w.names)-1 {
+			w.selected++
+		}
+	case "enter":
+		name := w.names[w.selected]
+		if name == w.current {
+			w.picking = false
+			return nil
+		}
+		w.switching = true
+		w.err = nil
+		return func() tea.Msg {
+			useCases, startupJobs, err := w.open(a.ctx, name)
+			if err != nil {
+				return workspaceErrMsg{err: fmt.Errorf("failed to open workspace %q: %w", name, err)}
+			}
+			return workspaceOpenedMsg{name: name, useCases: useCases, startupJobs: startupJobs}
+		}
+	}
+
+	return nil
+}
+
+// switchUseCases rebuilds the screens over the use cases of another workspace
+// and loads it from the dashboard, as on launch
+func (a *App) switchUseCases(useCases UseCases, startupJobs func(ctx context.Context) []string) tea.Cmd {
+	a.setUseCases(useCases)
+	a.SetRefreshInterval(a.refreshInterval)
+	if a.itemsPerPage > 0 {
+		a.SetItemsPerPage(a.itemsPerPage)
+	}
+	a.SetBusinessMode(a.businessMode)
+	a.SetFormDrafts(a.formDrafts)
+
+	cmds := []tea.Cmd{
+		a.dashboardModel.Init(),
+		a.categoriesModel.Init(),
+	}
+	if a.macros.useCase != nil {
+		cmds = append(cmds, a.loadMacros)
+	}
+	if a.notifications.useCase != nil {
+		cmds = append(cmds, a.loadNotifications)
+	}
+	if a.width > 0 {
+		size := tea.WindowSizeMsg{Width: a.width, Height: a.height}
+		cmds = append(cmds, func() tea.Msg { return size })
+	}
+	if startupJobs != nil {
+		cmds = append(cmds, func() tea.Msg {
+			return StartupJobsDoneMsg{Warnings: startupJobs(a.ctx)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+func (a *App) renderWorkspacePicker() string {
+	w := &a.workspaces
+
+	lines := []string{style.TitleStyle.Render("📁 Workspaces")}
+	if w.err != nil {
+		lines = append(lines, style.ErrorStyle.Render(fmt.Sprintf("Error: %v", w.err)))
+	}
+
+	for i, name := range w.names {
+		line := name
+		if name == w.current {
+			line += " (current)"
+		}
+		if i == w.selected {
+			lines = append(lines, style.SelectedMenuItemStyle.Render("► "+line))
+		} else {
+			lines = append(lines, style.MenuItemStyle.Render("  "+line))
+		}
+	}
+
+	if w.switching {
+		lines = append(lines, style.InfoStyle.MarginTop(1).Render(fmt.Sprintf("Opening %s...", w.names[w.selected])))
+	}
+	lines = append(lines, style.HelpStyle.MarginTop(1).Render("[↑/↓] Navigate • [Enter] Switch • [Esc] Close"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}